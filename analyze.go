@@ -0,0 +1,282 @@
+package regexp2
+
+import (
+	"strings"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// Dialect is a regexp engine other than this one that patterns are
+// written for.
+type Dialect int
+
+const (
+	DialectRE2        Dialect = iota // Go's regexp package, RE2 and engines like it
+	DialectECMAScript                // JavaScript, as of ES2018
+	DialectPCRE2                     // PCRE2, as used by PHP and grep -P
+	DialectNET                       // .NET's System.Text.RegularExpressions
+)
+
+var dialectNames = []string{"RE2", "ECMAScript", "PCRE2", ".NET"}
+
+func (d Dialect) String() string {
+	return dialectNames[d]
+}
+
+// Feature is something a pattern can do that not every dialect can.
+type Feature int
+
+const (
+	FeatureBackreference      Feature = iota // \1 or \k<name>
+	FeatureLookahead                         // (?=...) or (?!...)
+	FeatureLookbehind                        // (?<=...) or (?<!...) of a fixed length in each alternative
+	FeatureVariableLookbehind                // a lookbehind that can match texts of different lengths
+	FeatureAtomicGroup                       // (?>...), or a possessive quantifier such as a++
+	FeatureConditional                       // (?(1)yes|no) or (?(?=x)yes|no)
+	FeatureBalancingGroup                    // (?<open-close>...)
+	FeatureRecursion                         // (?R), (?1) or (?&name), a group called as a subroutine
+	FeatureBacktrackingVerb                  // (*COMMIT), (*PRUNE), (*SKIP) or (*MARK)
+	FeatureStartAnchor                       // \G, where the search started
+	FeatureRightToLeft                       // the RightToLeft option
+	FeatureScopedIgnoreCase                  // case-insensitivity for part of the pattern, as in a(?i:b)
+	FeatureClassSubtraction                  // [a-z-[aeiou]]
+	FeatureNestedClass                       // a class with another inside, as in Java's [a-z&&[^aeiou]]
+	FeatureGraphemeCluster                   // \X
+	FeatureSegmentBoundary                   // \b{wb} or \b{g}
+	FeatureLargeRepeat                       // a counted repeat of more than 1000
+)
+
+// the dialects that have each feature, as bits of 1<<Dialect
+var features = []struct {
+	name     string
+	dialects uint
+}{
+	FeatureBackreference:      {"backreference", 1<<DialectECMAScript | 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureLookahead:          {"lookahead", 1<<DialectECMAScript | 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureLookbehind:         {"lookbehind", 1<<DialectECMAScript | 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureVariableLookbehind: {"variable-length lookbehind", 1<<DialectECMAScript | 1<<DialectNET},
+	FeatureAtomicGroup:        {"atomic group", 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureConditional:        {"conditional", 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureBalancingGroup:     {"balancing group", 1 << DialectNET},
+	FeatureRecursion:          {"recursion", 1 << DialectPCRE2},
+	FeatureBacktrackingVerb:   {"backtracking control verb", 1 << DialectPCRE2},
+	FeatureStartAnchor:        {`\G`, 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureRightToLeft:        {"right to left matching", 1 << DialectNET},
+	FeatureScopedIgnoreCase:   {"scoped case-insensitivity", 1<<DialectRE2 | 1<<DialectPCRE2 | 1<<DialectNET},
+	FeatureClassSubtraction:   {"class subtraction", 1 << DialectNET},
+	FeatureNestedClass:        {"nested class", 0},
+	FeatureGraphemeCluster:    {"grapheme cluster", 1 << DialectPCRE2},
+	FeatureSegmentBoundary:    {"text segment boundary", 0},
+	FeatureLargeRepeat:        {"repeat count over 1000", 1<<DialectECMAScript | 1<<DialectPCRE2 | 1<<DialectNET},
+}
+
+func (f Feature) String() string {
+	return features[f].name
+}
+
+// Dialects returns the dialects that have f.
+func (f Feature) Dialects() []Dialect {
+	var ds []Dialect
+	for d := range dialectNames {
+		if features[f].dialects&(1<<uint(d)) != 0 {
+			ds = append(ds, Dialect(d))
+		}
+	}
+	return ds
+}
+
+// Report says which features a pattern uses and so which dialects can run
+// it.  It's about what can be written in each: a pattern a dialect can
+// run may still match a little differently there, as \d and \w do in
+// RE2, which only knows ASCII for them, and $ does in RE2 and ECMAScript,
+// where it doesn't match before a final \n.
+type Report struct {
+	Features []Feature // those the pattern uses, in the order of their constants
+	Dialects []Dialect // those that have all of them
+}
+
+// Supports says whether d has all the features the pattern uses.
+func (r *Report) Supports(d Dialect) bool {
+	return len(r.Unsupported(d)) == 0
+}
+
+// Unsupported returns the features the pattern uses that d lacks.
+func (r *Report) Unsupported(d Dialect) []Feature {
+	var fs []Feature
+	for _, f := range r.Features {
+		if features[f].dialects&(1<<uint(d)) == 0 {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	b.WriteString("uses ")
+	if len(r.Features) == 0 {
+		b.WriteString("no special features")
+	}
+	for i, f := range r.Features {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(f.String())
+	}
+	for d := range dialectNames {
+		b.WriteString("; " + Dialect(d).String() + ": ")
+		if fs := r.Unsupported(Dialect(d)); len(fs) > 0 {
+			b.WriteString("no " + fs[0].String())
+			for _, f := range fs[1:] {
+				b.WriteString(", no " + f.String())
+			}
+		} else {
+			b.WriteString("yes")
+		}
+	}
+	return b.String()
+}
+
+// Analyze reports the features the pattern uses, such as lookbehind, back
+// references and balancing groups, and which dialects have them all, for
+// deciding whether the pattern can be moved to another engine or needs
+// this one.
+func (re *Regexp) Analyze() *Report {
+	tree, err := syntax.Parse(re.pattern, syntax.RegexOptions(re.options))
+	if err != nil {
+		return &Report{}
+	}
+	root := syntax.Canonical(tree.AST())
+
+	used := make([]bool, len(features))
+	used[FeatureRightToLeft] = root.Flags&syntax.RightToLeft != 0
+	ignoreCase := map[bool]bool{}
+	syntax.Inspect(root, func(n *syntax.Node) bool {
+		if n == nil {
+			return true
+		}
+		switch n.Op {
+		case syntax.OpBackref:
+			used[FeatureBackreference] = true
+		case syntax.OpLookahead, syntax.OpNegativeLookahead:
+			used[FeatureLookahead] = true
+		case syntax.OpLookbehind, syntax.OpNegativeLookbehind:
+			if fixedWidth(n.Children[0]) {
+				used[FeatureLookbehind] = true
+			} else {
+				used[FeatureVariableLookbehind] = true
+			}
+		case syntax.OpAtomic:
+			used[FeatureAtomicGroup] = true
+		case syntax.OpCondCapture, syntax.OpCond:
+			used[FeatureConditional] = true
+		case syntax.OpCapture:
+			used[FeatureBalancingGroup] = used[FeatureBalancingGroup] || n.Uncapture != 0
+		case syntax.OpCall:
+			used[FeatureRecursion] = true
+		case syntax.OpVerb:
+			used[FeatureBacktrackingVerb] = true
+		case syntax.OpStartMatch:
+			used[FeatureStartAnchor] = true
+		case syntax.OpGrapheme:
+			used[FeatureGraphemeCluster] = true
+		case syntax.OpWordSegmentBoundary, syntax.OpNoWordSegmentBoundary, syntax.OpGraphemeBoundary, syntax.OpNoGraphemeBoundary:
+			used[FeatureSegmentBoundary] = true
+		case syntax.OpRepeat:
+			used[FeatureLargeRepeat] = used[FeatureLargeRepeat] || n.Min > 1000 || n.Max > 1000
+		case syntax.OpCharClass:
+			used[FeatureClassSubtraction] = used[FeatureClassSubtraction] || n.Set.HasSubtraction()
+			used[FeatureNestedClass] = used[FeatureNestedClass] || n.Set.HasNestedSets()
+		}
+		if n.Op == syntax.OpLiteral || n.Op == syntax.OpCharClass || n.Op == syntax.OpBackref {
+			// Canonical has cleared IgnoreCase where it makes no difference
+			ignoreCase[n.Flags&syntax.IgnoreCase != 0] = true
+		}
+		return true
+	})
+	used[FeatureScopedIgnoreCase] = len(ignoreCase) > 1
+
+	r := &Report{}
+	for f, u := range used {
+		if u {
+			r.Features = append(r.Features, Feature(f))
+		}
+	}
+	for d := range dialectNames {
+		if r.Supports(Dialect(d)) {
+			r.Dialects = append(r.Dialects, Dialect(d))
+		}
+	}
+	return r
+}
+
+// fixedWidth says whether each alternative of n always matches the same
+// number of runes, as PCRE2 wants of a lookbehind
+func fixedWidth(n *syntax.Node) bool {
+	if n.Op == syntax.OpAlternate {
+		for _, child := range n.Children {
+			if !fixedWidth(child) {
+				return false
+			}
+		}
+		return true
+	}
+	min, max := width(n)
+	return min == max
+}
+
+// width returns the fewest and most runes n can match, with max -1 for no
+// limit
+func width(n *syntax.Node) (min, max int) {
+	switch n.Op {
+	case syntax.OpLiteral:
+		return len(n.Runes), len(n.Runes)
+	case syntax.OpCharClass:
+		return 1, 1
+	case syntax.OpGrapheme:
+		return 1, -1
+	case syntax.OpBackref, syntax.OpCall:
+		return 0, -1
+	case syntax.OpCapture, syntax.OpAtomic:
+		return width(n.Children[0])
+	case syntax.OpConcat:
+		for _, child := range n.Children {
+			cmin, cmax := width(child)
+			min += cmin
+			if cmax < 0 {
+				max = -1
+			} else if max >= 0 {
+				max += cmax
+			}
+		}
+		return min, max
+	case syntax.OpRepeat:
+		cmin, cmax := width(n.Children[0])
+		min = cmin * n.Min
+		if cmax < 0 || n.Max < 0 && cmax > 0 {
+			return min, -1
+		}
+		return min, cmax * n.Max
+	case syntax.OpAlternate, syntax.OpCondCapture, syntax.OpCond:
+		branches := n.Children
+		if n.Op == syntax.OpCond {
+			branches = branches[1:]
+		}
+		min, max = width(branches[0])
+		for _, child := range branches[1:] {
+			cmin, cmax := width(child)
+			if cmin < min {
+				min = cmin
+			}
+			if max >= 0 && (cmax < 0 || cmax > max) {
+				max = cmax
+			}
+		}
+		if n.Op != syntax.OpAlternate && len(branches) == 1 {
+			// no "no" branch, which matches the empty string
+			min = 0
+		}
+		return min, max
+	}
+	return 0, 0
+}
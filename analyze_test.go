@@ -0,0 +1,52 @@
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		opt      RegexOptions
+		features []Feature
+		dialects []Dialect
+	}{
+		{`a+b*|[cd]`, 0, nil, []Dialect{DialectRE2, DialectECMAScript, DialectPCRE2, DialectNET}},
+		{`(?i)ab1`, 0, nil, []Dialect{DialectRE2, DialectECMAScript, DialectPCRE2, DialectNET}},
+		{`a(?i:b)`, 0, []Feature{FeatureScopedIgnoreCase}, []Dialect{DialectRE2, DialectPCRE2, DialectNET}},
+		{`a(?i:1)`, 0, nil, []Dialect{DialectRE2, DialectECMAScript, DialectPCRE2, DialectNET}},
+		{`(\w)\1(?=x)`, 0, []Feature{FeatureBackreference, FeatureLookahead}, []Dialect{DialectECMAScript, DialectPCRE2, DialectNET}},
+		{`(?<=ab|cd)x`, 0, []Feature{FeatureLookbehind}, []Dialect{DialectECMAScript, DialectPCRE2, DialectNET}},
+		{`(?<!a+)x`, 0, []Feature{FeatureVariableLookbehind}, []Dialect{DialectECMAScript, DialectNET}},
+		{`(?<=a(?:b|cd))x`, 0, []Feature{FeatureVariableLookbehind}, []Dialect{DialectECMAScript, DialectNET}},
+		{`a++b|(?>c)`, 0, []Feature{FeatureAtomicGroup}, []Dialect{DialectPCRE2, DialectNET}},
+		{`(a)?(?(1)b|c)`, 0, []Feature{FeatureConditional}, []Dialect{DialectPCRE2, DialectNET}},
+		{`(?<o>\()(?<-o>\))`, 0, []Feature{FeatureBalancingGroup}, []Dialect{DialectNET}},
+		{`\((?:[^()]|(?R))*\)`, PCRE2, []Feature{FeatureRecursion}, []Dialect{DialectPCRE2}},
+		{`\Ga`, 0, []Feature{FeatureStartAnchor}, []Dialect{DialectPCRE2, DialectNET}},
+		{`ab`, RightToLeft, []Feature{FeatureRightToLeft}, []Dialect{DialectNET}},
+		{`[a-z-[aeiou]]`, 0, []Feature{FeatureClassSubtraction}, []Dialect{DialectNET}},
+		{`\X{2000}`, 0, []Feature{FeatureGraphemeCluster, FeatureLargeRepeat}, []Dialect{DialectPCRE2}},
+	}
+	for _, test := range tests {
+		r := MustCompile(test.pattern, test.opt).Analyze()
+		if !reflect.DeepEqual(r.Features, test.features) || !reflect.DeepEqual(r.Dialects, test.dialects) {
+			t.Errorf("%v: got %v and %v, want %v and %v", test.pattern, r.Features, r.Dialects, test.features, test.dialects)
+		}
+	}
+
+	r := MustCompile(`(?<=a+)\1(x)`, 0).Analyze()
+	if got, want := r.Unsupported(DialectPCRE2), []Feature{FeatureVariableLookbehind}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if r.Supports(DialectRE2) || !r.Supports(DialectECMAScript) {
+		t.Errorf("wrong dialects supported: %v", r)
+	}
+	if got, want := r.String(), "uses backreference, variable-length lookbehind; RE2: no backreference, no variable-length lookbehind; ECMAScript: yes; PCRE2: no variable-length lookbehind; .NET: yes"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := FeatureAtomicGroup.Dialects(), []Dialect{DialectPCRE2, DialectNET}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+package regexp2
+
+import "github.com/jviksne/regexp2/syntax"
+
+// The bit-state backtracker runs the code's capture NFA on short texts.  It
+// tries the splits in the order the backtracker would, so it finds the same
+// match, but marks each instruction and position it has been at in a bit
+// vector and never tries one twice: without backreferences, how the rest
+// of the match goes from there doesn't depend on how it got there.  That
+// bounds the work by the program's size times the text's, and the memory
+// by maxBitStateBits, for services matching lots of short strings against
+// modest patterns.
+
+const (
+	maxBitStateInsts = 500
+	maxBitStateBits  = 256 * 1024
+)
+
+type bitState struct {
+	width   int // positions per instruction in visited
+	visited []uint32
+	jobs    []bitStateJob
+	starts  []int         // where each group the path is in started
+	caps    []bitStateCap // the captures along the path, in order
+}
+
+// bitStateJob is a way still to try, pc at pos, or something to undo on the
+// way back
+type bitStateJob struct {
+	pc, pos int
+	undo    uint8
+}
+
+const (
+	bitStateTry   = iota
+	bitStateStart // set starts[pc] back to pos
+	bitStateCaps  // cut caps back to pos
+)
+
+type bitStateCap struct {
+	slot, start, end int
+}
+
+// bitStateFits says if the text is short enough for the code's NFA
+func (r *runner) bitStateFits() bool {
+	n := len(r.code.CapNFA.Insts)
+	return n <= maxBitStateInsts && n*(r.runtextend+1) <= maxBitStateBits
+}
+
+// bitStateReset clears what's been visited, for a new scan
+func (r *runner) bitStateReset() {
+	b := &r.bits
+	b.width = r.runtextend + 1
+	n := (len(r.code.CapNFA.Insts)*b.width + 31) / 32
+	if cap(b.visited) < n {
+		b.visited = make([]uint32, n)
+	} else {
+		b.visited = b.visited[:n]
+		clear(b.visited)
+	}
+	if cap(b.starts) < r.code.Capsize {
+		b.starts = make([]int, r.code.Capsize)
+	}
+	b.starts = b.starts[:r.code.Capsize]
+}
+
+// visit marks pc at pos, and says if it hadn't been before
+func (b *bitState) visit(pc, pos int) bool {
+	n := uint(pc*b.width + pos)
+	if b.visited[n/32]&(1<<(n&31)) != 0 {
+		return false
+	}
+	b.visited[n/32] |= 1 << (n & 31)
+	return true
+}
+
+// bitStateMatch looks for a match starting at runtextpos.  What it visits
+// stays marked for the next starting position: a way that failed from one
+// fails from them all.
+func (r *runner) bitStateMatch() bool {
+	b := &r.bits
+	nfa := r.code.CapNFA
+	b.jobs = append(b.jobs[:0], bitStateJob{pc: nfa.Start, pos: r.runtextpos})
+	b.caps = b.caps[:0]
+
+	for len(b.jobs) > 0 {
+		j := b.jobs[len(b.jobs)-1]
+		b.jobs = b.jobs[:len(b.jobs)-1]
+		switch j.undo {
+		case bitStateStart:
+			b.starts[j.pc] = j.pos
+			continue
+		case bitStateCaps:
+			b.caps = b.caps[:j.pos]
+			continue
+		}
+
+		pc, pos := j.pc, j.pos
+	path:
+		for b.visit(pc, pos) {
+			inst := &nfa.Insts[pc]
+			switch inst.Op {
+			case syntax.NFAMatch:
+				if r.fullMatch && pos != r.runtextend {
+					break path
+				}
+				for _, c := range b.caps {
+					r.capture(c.slot, c.start, c.end)
+				}
+				r.runtextpos = pos
+				return true
+
+			case syntax.NFAChar, syntax.NFANotChar, syntax.NFASet:
+				if pos == r.runtextend || !r.nfaConsumes(inst, r.runtext[pos]) {
+					break path
+				}
+				pos++
+				pc = inst.Out
+
+			case syntax.NFASplit:
+				b.jobs = append(b.jobs, bitStateJob{pc: inst.Out1, pos: pos})
+				pc = inst.Out
+
+			case syntax.NFAAssert:
+				if !r.nfaAssert(inst.Assert, pos) {
+					break path
+				}
+				pc = inst.Out
+
+			case syntax.NFACapture:
+				if slot := inst.Cap / 2; inst.Cap%2 == 0 {
+					b.jobs = append(b.jobs, bitStateJob{pc: slot, pos: b.starts[slot], undo: bitStateStart})
+					b.starts[slot] = pos
+				} else {
+					b.jobs = append(b.jobs, bitStateJob{pos: len(b.caps), undo: bitStateCaps})
+					b.caps = append(b.caps, bitStateCap{slot, b.starts[slot], pos})
+				}
+				pc = inst.Out
+
+			case syntax.NFANop:
+				pc = inst.Out
+
+			default:
+				break path
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBitState(t *testing.T) {
+	patterns := []string{
+		`a+?`, `(a|ab)(c|bcd)(d*)`, `(\w+)\s(\w+)`, `(a)+`, `(?:(a)|(b))+`,
+		`(a|b)*?c`, `\b\w{2,3}\b`, `(?m)^\w+$`, `(?i)straSSe`, `[^"]*"`,
+		`(\d+)-(\d+)?`, `(a{2,4}?)(a*)`, `(?:ab|a)(?:c|bc)`, `(?s).+`, `.*?x`,
+		`\G\w`, `((a)|b)+`, `(?:(\d)|x){1,3}`, `\Bb`, `\s*$`, `\w+\Z`,
+		`(\w+?)(\d*)`, `^(?:\w+\s?)+$`,
+	}
+	inputs := []string{
+		"", "a", "abcd", "acd", "aab", "aaaa", "hello world", "ab\ncd\n",
+		"strasse STRAẞE", `say "hi" ok`, "12-34 5-", "abab c", "x1x2x3", "aaaaab",
+	}
+	for _, pattern := range patterns {
+		re := MustCompile(pattern, 0)
+		if re.code.CapNFA == nil {
+			t.Errorf("%v: no capture NFA", pattern)
+			continue
+		}
+
+		// a Tracer makes the engine run the program
+		engine := MustCompile(pattern, 0)
+		engine.Tracer = TracerFunc(func(TraceEvent) {})
+
+		for _, input := range inputs {
+			want, got := Std(engine).FindAllStringSubmatchIndex(input, -1), Std(re).FindAllStringSubmatchIndex(input, -1)
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%v on %q: wanted %v, got %v", pattern, input, want, got)
+			}
+
+			wm, _ := engine.FindStringMatch(input)
+			gm, _ := re.FindStringMatch(input)
+			if wm == nil || gm == nil {
+				continue
+			}
+			for i := range wm.Groups() {
+				if want, got := wm.CaptureHistory(i), gm.CaptureHistory(i); !reflect.DeepEqual(want, got) {
+					t.Errorf("%v on %q: wanted captures %v of group %v, got %v", pattern, input, want, i, got)
+				}
+			}
+		}
+	}
+
+	// a loop that can go round without reading anything is the
+	// backtracker's, as are the other things it alone can do
+	for _, pattern := range []string{`(a*)+`, `(a|)+b`, `(a)\1`, `a(?=b)`, `(?>a+)b`} {
+		if MustCompile(pattern, 0).code.CapNFA != nil {
+			t.Errorf("%v: has a capture NFA", pattern)
+		}
+	}
+
+	// a long text, or a timeout, takes the backtracker
+	re := MustCompile(`(\w+)@(\w+)`, 0)
+	r := re.getRunner()
+	if r.runtextend = 100; !r.bitStateFits() {
+		t.Error("a short text doesn't fit")
+	}
+	if r.runtextend = 1e5; r.bitStateFits() {
+		t.Error("a long text fits")
+	}
+	re = MustCompile(`(.+)*\?`, 0)
+	if _, err := re.FindStringMatch("Do you think you found the problem string!", WithTimeout(time.Millisecond)); err == nil {
+		t.Error("expected a timeout")
+	}
+}
@@ -0,0 +1,215 @@
+// Command regexp2gen compiles regexp2 patterns ahead of time and writes a
+// Go file with a matcher for each of them, so a program doesn't pay for
+// compiling its patterns at startup and the Go compiler can optimize each
+// one's matching code on its own.
+//
+//	regexp2gen -pkg mypkg -o patterns_gen.go patterns.txt
+//
+// Each line of the input names a variable, gives the options the pattern is
+// compiled with, if any, and then the pattern itself after an equals sign:
+//
+//	# comments and blank lines are ignored
+//	Date = (?<year>\d{4})-(?<month>\d\d)-(?<day>\d\d)
+//	Keyword IgnoreCase|ExplicitCapture = \b(if|else|for|while)\b
+//
+// and becomes a *regexp2.Regexp variable of that name.  Patterns that use
+// something the generator can't translate, such as subroutine calls or
+// right-to-left matching, are still loaded precompiled but run by the
+// interpreter.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jviksne/regexp2"
+	"github.com/jviksne/regexp2/syntax"
+)
+
+var (
+	pkg    = flag.String("pkg", "main", "package of the generated file")
+	output = flag.String("o", "", "file to write, standard output if empty")
+)
+
+var optionNames = map[string]regexp2.RegexOptions{
+	"None":                    regexp2.None,
+	"IgnoreCase":              regexp2.IgnoreCase,
+	"Multiline":               regexp2.Multiline,
+	"ExplicitCapture":         regexp2.ExplicitCapture,
+	"Compiled":                regexp2.Compiled,
+	"Singleline":              regexp2.Singleline,
+	"IgnorePatternWhitespace": regexp2.IgnorePatternWhitespace,
+	"RightToLeft":             regexp2.RightToLeft,
+	"ECMAScript":              regexp2.ECMAScript,
+	"RE2":                     regexp2.RE2,
+	"Memoize":                 regexp2.Memoize,
+	"PCRE2":                   regexp2.PCRE2,
+	"Python":                  regexp2.Python,
+	"Java":                    regexp2.Java,
+	"UnicodeSets":             regexp2.UnicodeSets,
+	"CultureInvariant":        regexp2.CultureInvariant,
+	"DupNames":                regexp2.DupNames,
+}
+
+type pattern struct {
+	line    int
+	name    string
+	options regexp2.RegexOptions
+	expr    string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("regexp2gen: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: regexp2gen [-pkg name] [-o file] [patterns file]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	in := io.Reader(os.Stdin)
+	inName := "stdin"
+	switch flag.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		in, inName = f, flag.Arg(0)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	patterns, err := readPatterns(in)
+	if err != nil {
+		log.Fatalf("%v:%v", inName, err)
+	}
+	src, err := generate(*pkg, patterns)
+	if err != nil {
+		log.Fatalf("%v:%v", inName, err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readPatterns(r io.Reader) ([]pattern, error) {
+	var patterns []pattern
+	seen := map[string]bool{}
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimLeftFunc(sc.Text(), unicode.IsSpace)
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		eq := strings.Index(text, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("%v: expected Name [Options] = pattern", line)
+		}
+		p := pattern{line: line, expr: strings.TrimPrefix(text[eq+1:], " ")}
+		fields := strings.Fields(text[:eq])
+		if len(fields) == 0 || len(fields) > 2 || !isIdent(fields[0]) {
+			return nil, fmt.Errorf("%v: expected Name [Options] = pattern", line)
+		}
+		p.name = fields[0]
+		if seen[p.name] {
+			return nil, fmt.Errorf("%v: %v declared twice", line, p.name)
+		}
+		seen[p.name] = true
+		if len(fields) == 2 {
+			for _, opt := range strings.Split(fields[1], "|") {
+				o, ok := optionNames[opt]
+				if !ok {
+					return nil, fmt.Errorf("%v: unknown option %v", line, opt)
+				}
+				p.options |= o
+			}
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns, sc.Err()
+}
+
+func isIdent(s string) bool {
+	for i, ch := range s {
+		if !unicode.IsLetter(ch) && ch != '_' && (i == 0 || !unicode.IsDigit(ch)) {
+			return false
+		}
+	}
+	return s != "" && s != "_"
+}
+
+// generate writes the file for patterns: each one's compiled program, as
+// saved by MarshalBinary, and the Go version of it where there is one
+func generate(pkg string, patterns []pattern) ([]byte, error) {
+	vars := &bytes.Buffer{}
+	funcs := &bytes.Buffer{}
+	for _, p := range patterns {
+		re, err := regexp2.Compile(p.expr, p.options)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", p.line, err)
+		}
+		data, err := re.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", p.line, err)
+		}
+
+		matcher := "nil"
+		fn := "match" + upperFirst(p.name)
+		src, err := regexp2.GenerateGo(re, fn)
+		if err == nil {
+			matcher = fn
+			fmt.Fprintf(funcs, "\n%s", src)
+		} else if err != syntax.ErrNotGeneratable {
+			return nil, fmt.Errorf("%v: %v", p.line, err)
+		}
+
+		fmt.Fprintf(vars, "\n// %s matches %s\n", p.name, quote(p.expr))
+		fmt.Fprintf(vars, "var %s = regexp2.MustLoadGenerated([]byte(%s), %s)\n", p.name, strconv.Quote(string(data)), matcher)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by regexp2gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	if bytes.Contains(funcs.Bytes(), []byte("syntax.")) {
+		fmt.Fprintf(buf, "import (\n\"github.com/jviksne/regexp2\"\n\"github.com/jviksne/regexp2/syntax\"\n)\n")
+	} else {
+		fmt.Fprintf(buf, "import \"github.com/jviksne/regexp2\"\n")
+	}
+	buf.Write(vars.Bytes())
+	buf.Write(funcs.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// quote returns a pattern as a raw string if it can, since those keep the
+// backslashes readable
+func quote(s string) string {
+	if strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+func upperFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
@@ -0,0 +1,318 @@
+// Command regexp2grep searches files for lines that match a regexp2
+// pattern, like grep -P but with the whole of the engine's syntax:
+// lookbehinds, back references, balancing groups, right-to-left matching
+// and the rest.
+//
+//	regexp2grep [flags] pattern [file ...]
+//
+// With no files it reads standard input.  Like grep, it exits with status
+// 0 if a line was selected, 1 if none was, and 2 if there was an error.
+// It's also handy for trying out patterns against real text by hand.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jviksne/regexp2"
+)
+
+var (
+	ignoreCase  = flag.Bool("i", false, "ignore case (IgnoreCase)")
+	multiline   = flag.Bool("m", false, "^ and $ match at line breaks (Multiline), useful with -z")
+	singleline  = flag.Bool("s", false, ". matches \\n too (Singleline), useful with -z")
+	extended    = flag.Bool("x", false, "ignore white space and # comments in the pattern (IgnorePatternWhitespace)")
+	rightToLeft = flag.Bool("r", false, "match from right to left (RightToLeft)")
+	syntaxName  = flag.String("syntax", "dotnet", "pattern syntax: dotnet, ecmascript, pcre2, python, java or re2")
+
+	invert     = flag.Bool("v", false, "select the lines that don't match")
+	count      = flag.Bool("c", false, "print only how many lines were selected in each file")
+	list       = flag.Bool("l", false, "print only the names of files with a selected line")
+	onlyMatch  = flag.Bool("o", false, "print only the matches, one to a line")
+	lineNumber = flag.Bool("n", false, "print line numbers")
+	withName   = flag.Bool("H", false, "print the file name with each line, even for one file")
+	noName     = flag.Bool("h", false, "never print file names")
+	whole      = flag.Bool("z", false, "match each file as a whole rather than line by line, so matches can span lines")
+	color      = flag.String("color", "auto", "highlight matches: auto, always or never")
+	timeout    = flag.Duration("timeout", 0, "give up on a line or file that takes longer than this to match")
+)
+
+var syntaxes = map[string]regexp2.RegexOptions{
+	"dotnet":     regexp2.None,
+	"ecmascript": regexp2.ECMAScript,
+	"pcre2":      regexp2.PCRE2,
+	"python":     regexp2.Python,
+	"java":       regexp2.Java,
+	"re2":        regexp2.RE2,
+}
+
+// GNU grep's default colors; matches are colored by
+// regexp2.ANSIHighlighter
+const (
+	colorName  = "\x1b[35m"
+	colorLine  = "\x1b[32m"
+	colorSep   = "\x1b[36m"
+	colorReset = "\x1b[0m"
+)
+
+type grepper struct {
+	re        *regexp2.Regexp
+	w         *bufio.Writer
+	showNames bool
+	color     bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("regexp2grep: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: regexp2grep [flags] pattern [file ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opt, ok := syntaxes[*syntaxName]
+	if !ok {
+		log.Printf("unknown syntax %q", *syntaxName)
+		os.Exit(2)
+	}
+	for _, o := range []struct {
+		set bool
+		opt regexp2.RegexOptions
+	}{
+		{*ignoreCase, regexp2.IgnoreCase},
+		{*multiline, regexp2.Multiline},
+		{*singleline, regexp2.Singleline},
+		{*extended, regexp2.IgnorePatternWhitespace},
+		{*rightToLeft, regexp2.RightToLeft},
+	} {
+		if o.set {
+			opt |= o.opt
+		}
+	}
+	re, err := regexp2.Compile(flag.Arg(0), opt)
+	if err != nil {
+		log.Print(err)
+		os.Exit(2)
+	}
+	if *timeout > 0 {
+		re.MatchTimeout = *timeout
+	}
+
+	g := &grepper{
+		re: re,
+		w:  bufio.NewWriter(os.Stdout),
+	}
+	files := flag.Args()[1:]
+	g.showNames = (len(files) > 1 || *withName) && !*noName
+	switch *color {
+	case "always":
+		g.color = true
+	case "auto":
+		fi, err := os.Stdout.Stat()
+		g.color = err == nil && fi.Mode()&os.ModeCharDevice != 0 && os.Getenv("TERM") != "dumb"
+	case "never":
+	default:
+		log.Printf("-color must be auto, always or never")
+		os.Exit(2)
+	}
+
+	found, failed := false, false
+	if len(files) == 0 {
+		ok, err := g.grep("(standard input)", os.Stdin)
+		found = ok
+		if err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	for _, name := range files {
+		ok, err := g.grepFile(name)
+		found = found || ok
+		if err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	if err := g.w.Flush(); err != nil {
+		log.Print(err)
+		failed = true
+	}
+
+	switch {
+	case failed:
+		os.Exit(2)
+	case !found:
+		os.Exit(1)
+	}
+}
+
+func (g *grepper) grepFile(name string) (bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	return g.grep(name, f)
+}
+
+// grep prints what's selected from r, and reports whether anything was
+func (g *grepper) grep(name string, r io.Reader) (bool, error) {
+	selected := 0
+	check := func(line string, n int) (bool, error) {
+		spans, err := g.matches(line)
+		if err != nil {
+			return false, fmt.Errorf("%v:%v: %v", name, n, err)
+		}
+		if (len(spans) > 0) == *invert {
+			return false, nil
+		}
+		selected++
+		if *list {
+			return true, nil
+		}
+		if !*count {
+			if err := g.print(name, n, line, spans); err != nil {
+				return false, fmt.Errorf("%v:%v: %v", name, n, err)
+			}
+		}
+		return false, nil
+	}
+
+	if *whole {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return false, err
+		}
+		if _, err := check(string(b), 1); err != nil {
+			return false, err
+		}
+	} else {
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 64<<10), 1<<30)
+		for n := 1; sc.Scan(); n++ {
+			done, err := check(sc.Text(), n)
+			if err != nil {
+				return selected > 0, err
+			}
+			if done {
+				break
+			}
+		}
+		if err := sc.Err(); err != nil {
+			return selected > 0, fmt.Errorf("%v: %v", name, err)
+		}
+	}
+
+	switch {
+	case *list && selected > 0:
+		g.name(name)
+		g.w.WriteByte('\n')
+	case *count:
+		if g.showNames {
+			g.name(name)
+			g.sep(':')
+		}
+		fmt.Fprintln(g.w, selected)
+	}
+	return selected > 0, nil
+}
+
+// matches returns the byte offsets of the matches in line, in the order
+// they're in the line
+func (g *grepper) matches(line string) ([][2]int, error) {
+	var runes [][2]int
+	m, err := g.re.FindStringMatch(line)
+	for ; m != nil; m, err = g.re.FindNextMatch(m) {
+		runes = append(runes, [2]int{m.Index, m.Index + m.Length})
+	}
+	if err != nil || len(runes) == 0 {
+		return nil, err
+	}
+
+	// rune indexes to byte offsets
+	offs := make([]int, 0, len(line)+1)
+	for i := range line {
+		offs = append(offs, i)
+	}
+	offs = append(offs, len(line))
+	spans := make([][2]int, len(runes))
+	for i, r := range runes {
+		spans[i] = [2]int{offs[r[0]], offs[r[1]]}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	return spans, nil
+}
+
+func (g *grepper) print(name string, n int, line string, spans [][2]int) error {
+	prefix := func() {
+		if g.showNames {
+			g.name(name)
+			g.sep(':')
+		}
+		if *lineNumber {
+			g.colored(colorLine, fmt.Sprint(n))
+			g.sep(':')
+		}
+	}
+
+	if *onlyMatch {
+		for _, s := range spans {
+			if s[0] == s[1] {
+				continue
+			}
+			prefix()
+			if g.color {
+				g.w.WriteString(regexp2.ANSIHighlighter.Before)
+			}
+			g.w.WriteString(line[s[0]:s[1]])
+			if g.color {
+				g.w.WriteString(regexp2.ANSIHighlighter.After)
+			}
+			g.w.WriteByte('\n')
+		}
+		return nil
+	}
+
+	prefix()
+	if g.color && len(spans) > 0 {
+		var err error
+		if line, err = g.re.Highlight(line, regexp2.ANSIHighlighter); err != nil {
+			return err
+		}
+	}
+	g.w.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		g.w.WriteByte('\n')
+	}
+	return nil
+}
+
+func (g *grepper) name(name string) {
+	g.colored(colorName, name)
+}
+
+func (g *grepper) sep(c byte) {
+	g.colored(colorSep, string(c))
+}
+
+func (g *grepper) colored(color, s string) {
+	if !g.color {
+		g.w.WriteString(s)
+		return
+	}
+	g.w.WriteString(color)
+	g.w.WriteString(s)
+	g.w.WriteString(colorReset)
+}
@@ -0,0 +1,237 @@
+// Command regexp2vet reports regexp2 patterns in Go source that risk
+// catastrophic backtracking, the way go vet reports suspicious code.  It
+// looks at the calls to regexp2.Compile, MustCompile and CompileCulture
+// whose pattern and options are constants, and prints what
+// regexp2.Regexp.Risks finds in them, along with patterns that don't
+// compile at all.
+//
+//	regexp2vet [flags] [file.go | directory ...]
+//	regexp2vet [flags] -e pattern
+//
+// Directories are searched recursively, skipping testdata, vendor and
+// those whose names start with . or _, so ./... means the same as .  It exits with status 1 if it
+// reported anything, and 2 if there was an error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jviksne/regexp2"
+)
+
+const importPath = "github.com/jviksne/regexp2"
+
+var (
+	expr        = flag.String("e", "", "check this pattern instead of Go source")
+	exponential = flag.Bool("exponential", false, "report only exponential risks")
+)
+
+// the option constants a pattern's options can be made of
+var optionNames = map[string]regexp2.RegexOptions{
+	"None":                    regexp2.None,
+	"IgnoreCase":              regexp2.IgnoreCase,
+	"Multiline":               regexp2.Multiline,
+	"ExplicitCapture":         regexp2.ExplicitCapture,
+	"Compiled":                regexp2.Compiled,
+	"Singleline":              regexp2.Singleline,
+	"IgnorePatternWhitespace": regexp2.IgnorePatternWhitespace,
+	"RightToLeft":             regexp2.RightToLeft,
+	"Debug":                   regexp2.Debug,
+	"ECMAScript":              regexp2.ECMAScript,
+	"RE2":                     regexp2.RE2,
+	"Memoize":                 regexp2.Memoize,
+	"PCRE2":                   regexp2.PCRE2,
+	"Python":                  regexp2.Python,
+	"Java":                    regexp2.Java,
+	"UnicodeSets":             regexp2.UnicodeSets,
+	"CultureInvariant":        regexp2.CultureInvariant,
+	"CaseConversion":          regexp2.CaseConversion,
+	"DupNames":                regexp2.DupNames,
+}
+
+type vetter struct {
+	fset     *token.FileSet
+	reported bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("regexp2vet: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: regexp2vet [flags] [file.go | directory ...]\n       regexp2vet [flags] -e pattern\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	v := &vetter{fset: token.NewFileSet()}
+	failed := false
+	if *expr != "" {
+		v.check("-e", *expr, 0, func(start int) int { return start + 1 })
+	} else {
+		paths := flag.Args()
+		if len(paths) == 0 {
+			paths = []string{"."}
+		}
+		for _, path := range paths {
+			if err := v.vetPath(path); err != nil {
+				log.Print(err)
+				failed = true
+			}
+		}
+	}
+
+	switch {
+	case failed:
+		os.Exit(2)
+	case v.reported:
+		os.Exit(1)
+	}
+}
+
+func (v *vetter) vetPath(path string) error {
+	// dir/... as for the go command, though dir is searched all the same
+	if path == "..." {
+		path = "."
+	}
+	path = strings.TrimSuffix(path, "/...")
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return v.vetFile(path)
+	}
+	return filepath.Walk(path, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		base := fi.Name()
+		if fi.IsDir() {
+			if name != path && (base == "testdata" || base == "vendor" || strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(base, ".go") {
+			return nil
+		}
+		return v.vetFile(name)
+	})
+}
+
+func (v *vetter) vetFile(name string) error {
+	f, err := parser.ParseFile(v.fset, name, nil, 0)
+	if err != nil {
+		return err
+	}
+	pkg := ""
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == importPath {
+			pkg = "regexp2"
+			if imp.Name != nil {
+				pkg = imp.Name.Name
+			}
+		}
+	}
+	if pkg == "" || pkg == "_" {
+		return nil
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isPackage(sel.X, pkg) {
+			return true
+		}
+		switch sel.Sel.Name {
+		case "Compile", "MustCompile", "CompileCulture":
+		default:
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		pattern, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+		opt, ok := options(call.Args[1], pkg)
+		if !ok {
+			return true
+		}
+		p := v.fset.Position(lit.Pos())
+		col := func(start int) int { return p.Column }
+		if lit.Value[0] == '`' && !strings.Contains(pattern, "\n") {
+			// a raw string's columns line up with the pattern, in bytes
+			col = func(start int) int { return p.Column + 1 + len(string([]rune(pattern)[:start])) }
+		}
+		v.check(fmt.Sprintf("%v:%v", p.Filename, p.Line), pattern, opt, col)
+		return true
+	})
+	return nil
+}
+
+// check reports the risks in pattern, found at pos, with col giving the
+// column of each rune of the pattern
+func (v *vetter) check(pos, pattern string, opt regexp2.RegexOptions, col func(start int) int) {
+	re, err := regexp2.Compile(pattern, opt)
+	if err != nil {
+		fmt.Printf("%v:%v: %v\n", pos, col(0), err)
+		v.reported = true
+		return
+	}
+	for _, r := range re.Risks() {
+		if *exponential && r.Severity != regexp2.RiskExponential {
+			continue
+		}
+		fmt.Printf("%v:%v: %v\n", pos, col(r.Start), r)
+		v.reported = true
+	}
+}
+
+func isPackage(x ast.Expr, pkg string) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && id.Name == pkg
+}
+
+// options evaluates the constant options passed with a pattern, if it's
+// made of option names, numbers and |
+func options(x ast.Expr, pkg string) (regexp2.RegexOptions, bool) {
+	switch x := x.(type) {
+	case *ast.ParenExpr:
+		return options(x.X, pkg)
+	case *ast.BasicLit:
+		if x.Kind != token.INT {
+			return 0, false
+		}
+		i, err := strconv.ParseInt(x.Value, 0, 32)
+		return regexp2.RegexOptions(i), err == nil
+	case *ast.SelectorExpr:
+		opt, ok := optionNames[x.Sel.Name]
+		return opt, ok && isPackage(x.X, pkg)
+	case *ast.BinaryExpr:
+		if x.Op != token.OR && x.Op != token.ADD {
+			return 0, false
+		}
+		l, ok := options(x.X, pkg)
+		if !ok {
+			return 0, false
+		}
+		r, ok := options(x.Y, pkg)
+		return l | r, ok
+	}
+	return 0, false
+}
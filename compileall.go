@@ -0,0 +1,142 @@
+package regexp2
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// ParseErrors is what CompileAll returns for a pattern with errors, in the
+// order they come in the pattern.
+type ParseErrors []*ParseError
+
+func (errs ParseErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap gives errors.As and errors.Is each of the errors.
+func (errs ParseErrors) Unwrap() []error {
+	all := make([]error, len(errs))
+	for i, err := range errs {
+		all[i] = err
+	}
+	return all
+}
+
+// the most errors CompileAll looks for in one pattern
+const maxParseErrors = 100
+
+// CompileAll is like Compile, but rather than stop at the first thing
+// wrong with the pattern it goes on past it, as a compiler does, and
+// returns everything it finds as ParseErrors, for checking a pattern
+// someone is writing in one go.  Going on means guessing what was meant,
+// closing a group left open or dropping a bad escape, so errors after the
+// first can be knock-on ones, though it tries to avoid that.
+func CompileAll(expr string, opt RegexOptions) (*Regexp, error) {
+	re, err := Compile(expr, opt)
+	first, ok := err.(*ParseError)
+	if !ok {
+		return re, err
+	}
+
+	errs := ParseErrors{first}
+	r := newRecovery(expr)
+	for last := first; len(errs) < maxParseErrors && r.recover(last); {
+		_, err := syntax.Parse(string(r.runes), syntax.RegexOptions(opt))
+		if last, ok = err.(*ParseError); !ok {
+			break
+		}
+		e := r.original(last)
+		if prev := errs[len(errs)-1]; e.Code == prev.Code && e.RuneOffset == prev.RuneOffset {
+			// the patch didn't help
+			break
+		}
+		errs = append(errs, e)
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].RuneOffset < errs[j].RuneOffset })
+	return nil, errs
+}
+
+// recovery is the pattern with each error so far patched over, and where
+// each of its runes was in the pattern as written
+type recovery struct {
+	expr  string
+	runes []rune
+	pos   []int // pos[i] is where runes[i] came from; there's one more for the end
+}
+
+func newRecovery(expr string) *recovery {
+	r := &recovery{expr: expr, runes: []rune(expr)}
+	for i := 0; i <= len(r.runes); i++ {
+		r.pos = append(r.pos, i)
+	}
+	return r
+}
+
+// recover patches over err, whose offsets are in the patched pattern, and
+// reports whether it could
+func (r *recovery) recover(err *ParseError) bool {
+	start := err.RuneOffset
+	end := start + len([]rune(err.Token))
+	if start > len(r.runes) || end > len(r.runes) {
+		return false
+	}
+	switch {
+	case err.Code == syntax.ErrMissingParen:
+		// the group goes on to the end
+		r.splice(len(r.runes), len(r.runes), ")")
+	case err.Code == syntax.ErrUnterminatedBracket:
+		r.splice(len(r.runes), len(r.runes), "]")
+	case err.Code == syntax.ErrReversedCharRange || err.Code == syntax.ErrBadClassInCharRange:
+		// keep the start of the range, so the class isn't left empty
+		r.splice(start+1, end, "")
+	case strings.HasPrefix(err.Token, "(") && !strings.HasSuffix(err.Token, ")"):
+		// a bad group opening; keep the group
+		r.splice(start, end, "(?:")
+	case start < end:
+		r.splice(start, end, "")
+	default:
+		return false
+	}
+	return true
+}
+
+// splice replaces runes[start:end] with s, whose runes came from where
+// runes[start] did
+func (r *recovery) splice(start, end int, s string) {
+	from := r.pos[start]
+	runes := append([]rune(s), r.runes[end:]...)
+	pos := r.pos[end:]
+	for range s {
+		pos = append([]int{from}, pos...)
+	}
+	r.runes = append(r.runes[:start:start], runes...)
+	r.pos = append(r.pos[:start:start], pos...)
+}
+
+// original returns err with its offsets and token in the pattern as
+// written rather than patched
+func (r *recovery) original(err *ParseError) *ParseError {
+	start := err.RuneOffset
+	end := start + len([]rune(err.Token))
+	if end > len(r.runes) {
+		end = len(r.runes)
+	}
+	written := []rune(r.expr)
+	from, to := r.pos[start], r.pos[end]
+	if to <= from && from < len(written) {
+		// all of the token was put in by a patch
+		to = from + 1
+	}
+	e := *err
+	e.Expr = r.expr
+	e.RuneOffset = from
+	e.Offset = len(string(written[:from]))
+	e.Token = string(written[from:to])
+	return &e
+}
@@ -0,0 +1,57 @@
+package regexp2
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+func TestCompileAll(t *testing.T) {
+	type found struct {
+		code   syntax.ErrorCode
+		offset int
+		token  string
+	}
+	tests := []struct {
+		pattern string
+		errs    []found
+	}{
+		{`a(b(c`, []found{{syntax.ErrMissingParen, 1, "("}, {syntax.ErrMissingParen, 3, "("}}},
+		{`ab)c)`, []found{{syntax.ErrUnexpectedParen, 2, ")"}, {syntax.ErrUnexpectedParen, 4, ")"}}},
+		{`a**b{3,2}`, []found{{syntax.ErrInvalidRepeatOp, 2, "*"}, {syntax.ErrInvalidRepeatSize, 4, "{3,2}"}}},
+		{`é[z-a]\q`, []found{{syntax.ErrReversedCharRange, 3, "z-a"}, {syntax.ErrUnrecognizedEscape, 7, `\q`}}},
+		{`foo(?<1bar>x)\k<y>`, []found{{syntax.ErrInvalidGroupName, 3, "(?<1"}, {syntax.ErrUndefinedNameRef, 13, `\k<y>`}}},
+		{`(?<=a\qb`, []found{{syntax.ErrMissingParen, 0, "("}, {syntax.ErrUnrecognizedEscape, 5, `\q`}}},
+		{`(?Pz)[b`, []found{{syntax.ErrUnrecognizedGrouping, 0, "(?P"}, {syntax.ErrUnterminatedBracket, 5, "[b"}}},
+		{`a\`, []found{{syntax.ErrIllegalEndEscape, 1, `\`}}},
+	}
+	for _, test := range tests {
+		_, err := CompileAll(test.pattern, 0)
+		var errs ParseErrors
+		if !errors.As(err, &errs) {
+			t.Errorf("%v: got %v, want ParseErrors", test.pattern, err)
+			continue
+		}
+		var got []found
+		for _, e := range errs {
+			if e.Expr != test.pattern {
+				t.Errorf("%v: error for %v", test.pattern, e.Expr)
+			}
+			got = append(got, found{e.Code, e.Offset, e.Token})
+		}
+		if !reflect.DeepEqual(got, test.errs) {
+			t.Errorf("%v: got %v, want %v", test.pattern, got, test.errs)
+		}
+	}
+
+	if re, err := CompileAll(`a(b)`, 0); err != nil || re == nil {
+		t.Errorf("got %v, %v for a good pattern", re, err)
+	}
+	_, err := CompileAll(`a)`, 0)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Code != syntax.ErrUnexpectedParen {
+		t.Errorf("errors.As got %v", pe)
+	}
+}
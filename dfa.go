@@ -0,0 +1,262 @@
+package regexp2
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// The lazy DFA answers "does the pattern match anywhere from here on" in
+// linear time for patterns simple enough to have a syntax.NFA.  States are
+// sets of NFA threads plus what we know about the previous rune, and are
+// built the first time a transition is needed.  Each runner owns its own
+// DFA, so nothing here needs locking.
+//
+// The DFA only says whether there is a match, not where it starts or ends,
+// so only MatchString and MatchRunes take their answer from it.  The Find
+// methods, Count and the rest still run the backtracker for the match's
+// bounds and groups, and only gain when the DFA rules a match out.
+
+// maxDFAStates is how many states we cache before giving up on the DFA
+// and letting the backtracker do the work
+const maxDFAStates = 10000
+
+// what the zero-width assertions need to know about the text to the left
+const (
+	dfaBeginning    uint8 = 1 << iota // at the very beginning of the text
+	dfaStart                          // at the position the search started from (\G)
+	dfaPrevNewline                    // previous rune is '\n'
+	dfaPrevWord                       // previous rune is a word char
+	dfaPrevECMAWord                   // previous rune is an ECMAScript word char
+)
+
+type dfaState struct {
+	insts []int // NFA instructions waiting to consume the next rune
+	flags uint8
+
+	// cached transitions; a nil entry hasn't been computed yet
+	ascii [128]*dfaState
+	other map[rune]*dfaState
+}
+
+type lazyDFA struct {
+	nfa    *syntax.NFA
+	states map[string]*dfaState
+
+	// sentinels for transitions that find a match or can never match
+	matched, dead *dfaState
+
+	// scratch space for closures
+	visited []bool
+	stack   []int
+	buf     []int
+}
+
+func newLazyDFA(nfa *syntax.NFA) *lazyDFA {
+	return &lazyDFA{
+		nfa:     nfa,
+		states:  make(map[string]*dfaState),
+		matched: &dfaState{},
+		dead:    &dfaState{},
+		visited: make([]bool, len(nfa.Insts)),
+	}
+}
+
+// dfaEnd stands in for the rune after the last one
+const dfaEnd rune = -1
+
+// dfaSearch reports whether the pattern matches at any position from start
+// on.  ok is false if the DFA grew too large and the caller should fall back
+// to the backtracker; the cache is flushed so the next search starts afresh.
+func (r *runner) dfaSearch(start int) (matched, ok bool, err error) {
+	d := r.dfa
+	text := r.runtext
+
+	var flags uint8
+	if start == r.runtextstart {
+		flags = dfaStart
+	}
+	if start == 0 {
+		flags |= dfaBeginning
+	} else {
+		flags |= prevFlags(text[start-1])
+	}
+	s := d.state([]int{d.nfa.Start}, flags)
+	if s == nil {
+		d.reset()
+		return false, false, nil
+	}
+
+	for i := start; i < len(text); i++ {
+		if err := r.checkTimeout(); err != nil {
+			return false, true, err
+		}
+		c := text[i]
+		var next *dfaState
+		if i == len(text)-1 {
+			// \Z looks two runes ahead, so the last rune isn't cached
+			next = d.step(s, c, true)
+		} else if c >= 0 && c < 128 {
+			if next = s.ascii[c]; next == nil {
+				next = d.step(s, c, false)
+				s.ascii[c] = next
+			}
+		} else {
+			if next = s.other[c]; next == nil {
+				next = d.step(s, c, false)
+				if s.other == nil {
+					s.other = make(map[rune]*dfaState)
+				}
+				s.other[c] = next
+			}
+		}
+		if next == nil {
+			d.reset()
+			return false, false, nil
+		}
+		if next == d.matched {
+			return true, true, nil
+		}
+		s = next
+	}
+	return d.step(s, dfaEnd, true) == d.matched, true, nil
+}
+
+func (d *lazyDFA) reset() {
+	d.states = make(map[string]*dfaState)
+}
+
+func prevFlags(c rune) uint8 {
+	var f uint8
+	if c == '\n' {
+		f |= dfaPrevNewline
+	}
+	if syntax.IsWordChar(c) {
+		f |= dfaPrevWord
+	}
+	if syntax.IsECMAWordChar(c) {
+		f |= dfaPrevECMAWord
+	}
+	return f
+}
+
+// state returns the cached state for insts and flags, or nil when the
+// cache is full
+func (d *lazyDFA) state(insts []int, flags uint8) *dfaState {
+	sort.Ints(insts)
+	var b bytes.Buffer
+	b.WriteByte(flags)
+	for _, pc := range insts {
+		b.WriteString(strconv.Itoa(pc))
+		b.WriteByte(',')
+	}
+	key := b.String()
+	if s, ok := d.states[key]; ok {
+		return s
+	}
+	if len(d.states) >= maxDFAStates {
+		return nil
+	}
+	s := &dfaState{insts: append([]int(nil), insts...), flags: flags}
+	d.states[key] = s
+	return s
+}
+
+// step follows the assertions of s knowing the next rune is c, then
+// consumes c.  last tells whether c is the final rune of the text.
+func (d *lazyDFA) step(s *dfaState, c rune, last bool) *dfaState {
+	insts := d.nfa.Insts
+	for i := range d.visited {
+		d.visited[i] = false
+	}
+	d.buf = d.buf[:0]
+	d.stack = append(d.stack[:0], s.insts...)
+
+	for len(d.stack) > 0 {
+		pc := d.stack[len(d.stack)-1]
+		d.stack = d.stack[:len(d.stack)-1]
+		if d.visited[pc] {
+			continue
+		}
+		d.visited[pc] = true
+
+		inst := &insts[pc]
+		switch inst.Op {
+		case syntax.NFAMatch:
+			return d.matched
+		case syntax.NFASplit:
+			d.stack = append(d.stack, inst.Out1, inst.Out)
+		case syntax.NFANop:
+			d.stack = append(d.stack, inst.Out)
+		case syntax.NFAAssert:
+			if assertHolds(inst.Assert, s.flags, c, last) {
+				d.stack = append(d.stack, inst.Out)
+			}
+		case syntax.NFAChar, syntax.NFANotChar, syntax.NFASet:
+			if c != dfaEnd && consumes(inst, c) {
+				d.buf = append(d.buf, inst.Out)
+			}
+		}
+	}
+
+	if c == dfaEnd {
+		return d.dead
+	}
+
+	// unanchored search: a new attempt starts at every position
+	d.buf = append(d.buf, d.nfa.Start)
+	d.buf = dedupe(d.buf)
+	return d.state(d.buf, prevFlags(c))
+}
+
+func consumes(inst *syntax.NFAInst, c rune) bool {
+	if inst.IgnoreCase {
+		c = syntax.CaseFold(c)
+	}
+	switch inst.Op {
+	case syntax.NFAChar:
+		return c == inst.Ch
+	case syntax.NFANotChar:
+		return c != inst.Ch
+	default:
+		return inst.Set.CharIn(c)
+	}
+}
+
+// assertHolds mirrors the zero-width cases in runner.execute
+func assertHolds(op syntax.InstOp, flags uint8, c rune, last bool) bool {
+	switch op {
+	case syntax.Bol:
+		return flags&(dfaBeginning|dfaPrevNewline) != 0
+	case syntax.Eol:
+		return c == dfaEnd || c == '\n'
+	case syntax.Boundary, syntax.Nonboundary:
+		b := (flags&dfaPrevWord != 0) != (c != dfaEnd && syntax.IsWordChar(c))
+		return b == (op == syntax.Boundary)
+	case syntax.ECMABoundary, syntax.NonECMABoundary:
+		b := (flags&dfaPrevECMAWord != 0) != (c != dfaEnd && syntax.IsECMAWordChar(c))
+		return b == (op == syntax.ECMABoundary)
+	case syntax.Beginning:
+		return flags&dfaBeginning != 0
+	case syntax.Start:
+		return flags&dfaStart != 0
+	case syntax.EndZ:
+		return c == dfaEnd || (c == '\n' && last)
+	case syntax.End:
+		return c == dfaEnd
+	}
+	return false
+}
+
+func dedupe(a []int) []int {
+	sort.Ints(a)
+	out := a[:0]
+	for i, v := range a {
+		if i == 0 || v != a[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,216 @@
+package regexp2
+
+import (
+	"errors"
+	"unicode"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+var (
+	// ErrInfiniteLanguage is returned by Enumerate for a pattern that can
+	// match infinitely many strings, with a *, a + or another repeat with
+	// no upper bound, a subroutine call or \X.
+	ErrInfiniteLanguage = errors.New("regexp2: pattern matches infinitely many strings")
+
+	// ErrEnumerationLimit is returned by Enumerate for a pattern that
+	// matches more strings than the limit it was given.
+	ErrEnumerationLimit = errors.New("regexp2: pattern matches more strings than the limit")
+)
+
+// Enumerate returns every string that the pattern matches the whole of,
+// if there are no more than limit of them, for turning a small validation
+// pattern into an allowlist.  If there are more, it returns the first
+// limit of them and ErrEnumerationLimit.  The strings come in the order
+// the pattern would try them: alternatives in turn, repeats from fewest
+// to most, and the runes of classes in order.
+func (re *Regexp) Enumerate(limit int) ([]string, error) {
+	var all []string
+	more := false
+	err := re.EnumerateFunc(func(s string) bool {
+		if more = len(all) == limit; more {
+			return false
+		}
+		all = append(all, s)
+		return true
+	})
+	if err == nil && more {
+		err = ErrEnumerationLimit
+	}
+	return all, err
+}
+
+// EnumerateFunc calls f with each string that the pattern matches the
+// whole of, in the order Enumerate gives them, until f returns false.
+// It's for languages too big to hold, but it fails with
+// ErrInfiniteLanguage, before calling f, for those that never end.
+func (re *Regexp) EnumerateFunc(f func(string) bool) error {
+	tree, err := syntax.Parse(re.pattern, syntax.RegexOptions(re.options))
+	if err != nil {
+		return err
+	}
+	root := tree.AST()
+	infinite := false
+	syntax.Inspect(root, func(n *syntax.Node) bool {
+		if n != nil {
+			infinite = infinite || n.Op == syntax.OpRepeat && n.Max < 0 || n.Op == syntax.OpCall || n.Op == syntax.OpGrapheme
+		}
+		return !infinite
+	})
+	if infinite {
+		return ErrInfiniteLanguage
+	}
+
+	// what the tree gives is only a guess where anchors, boundaries and
+	// lookarounds rule some of it out, so each string is checked with a
+	// pattern that has to match all of it
+	whole, err := Compile(syntax.Canonical(&syntax.Node{
+		Op:       syntax.OpConcat,
+		Flags:    root.Flags,
+		Children: []*syntax.Node{{Op: syntax.OpBeginText}, root, {Op: syntax.OpEndText}},
+	}).String(), re.options&RightToLeft)
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	e := &enumerator{ranges: map[*syntax.CharSet][][2]rune{}}
+	e.node(root, nil, nil, func(out []rune, _ map[int][]rune) bool {
+		s := string(out)
+		if seen[s] {
+			return true
+		}
+		seen[s] = true
+		if ok, _ := whole.MatchRunes(out); !ok {
+			return true
+		}
+		return f(s)
+	})
+	return nil
+}
+
+type enumerator struct {
+	ranges map[*syntax.CharSet][][2]rune // the runes of each class, found when first needed
+}
+
+// more is what's called with each way to match a node: the text so far
+// and the groups captured in it.  It returns false to stop.
+type more func(out []rune, captures map[int][]rune) bool
+
+// node calls k with each text n can add to out, and returns false if k
+// did to stop
+func (e *enumerator) node(n *syntax.Node, out []rune, captures map[int][]rune, k more) bool {
+	// so that appending to it in one branch doesn't change it for another
+	out = out[:len(out):len(out)]
+
+	switch n.Op {
+	case syntax.OpNothing:
+		return true
+	case syntax.OpLiteral:
+		return e.runes(n, n.Runes, out, captures, k)
+	case syntax.OpCharClass:
+		ranges, ok := e.ranges[n.Set]
+		if !ok {
+			ranges = n.Set.RuneRanges()
+			e.ranges[n.Set] = ranges
+		}
+		for _, rg := range ranges {
+			for r := rg[0]; r <= rg[1]; r++ {
+				if !e.runes(n, []rune{r}, out, captures, k) {
+					return false
+				}
+			}
+		}
+		return true
+	case syntax.OpBackref:
+		captured, ok := captures[n.Group]
+		if !ok && n.Flags&syntax.ECMAScript == 0 {
+			return true
+		}
+		return e.runes(n, captured, out, captures, k)
+	case syntax.OpConcat:
+		return e.seq(n.Children, out, captures, k)
+	case syntax.OpAlternate:
+		for _, child := range n.Children {
+			if !e.node(child, out, captures, k) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpRepeat:
+		for times := n.Min; times <= n.Max; times++ {
+			if !e.repeat(n.Children[0], times, out, captures, k) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpCapture:
+		start := len(out)
+		return e.node(n.Children[0], out, captures, func(out []rune, captures map[int][]rune) bool {
+			c := make(map[int][]rune, len(captures)+1)
+			for group, text := range captures {
+				c[group] = text
+			}
+			if n.Group > 0 {
+				c[n.Group] = out[start:len(out):len(out)]
+			}
+			if n.Uncapture > 0 {
+				delete(c, n.Uncapture)
+			}
+			return k(out, c)
+		})
+	case syntax.OpAtomic:
+		return e.node(n.Children[0], out, captures, k)
+	case syntax.OpCondCapture:
+		if _, ok := captures[n.Group]; ok {
+			return e.node(n.Children[0], out, captures, k)
+		}
+		return e.seq(n.Children[1:], out, captures, k)
+	case syntax.OpCond:
+		if !e.node(n.Children[1], out, captures, k) {
+			return false
+		}
+		return e.seq(n.Children[2:], out, captures, k)
+	}
+	// anchors, boundaries, lookarounds and verbs add nothing
+	return k(out, captures)
+}
+
+// seq calls k with each text nodes can add to out, one after another
+func (e *enumerator) seq(nodes []*syntax.Node, out []rune, captures map[int][]rune, k more) bool {
+	if len(nodes) == 0 {
+		return k(out, captures)
+	}
+	return e.node(nodes[0], out, captures, func(out []rune, captures map[int][]rune) bool {
+		return e.seq(nodes[1:], out, captures, k)
+	})
+}
+
+// repeat calls k with each text n can add to out matched times times
+func (e *enumerator) repeat(n *syntax.Node, times int, out []rune, captures map[int][]rune, k more) bool {
+	if times == 0 {
+		return k(out, captures)
+	}
+	return e.node(n, out, captures, func(out []rune, captures map[int][]rune) bool {
+		return e.repeat(n, times-1, out, captures, k)
+	})
+}
+
+// runes calls k with rs added to out, in each of their cases if n
+// ignores case
+func (e *enumerator) runes(n *syntax.Node, rs []rune, out []rune, captures map[int][]rune, k more) bool {
+	if len(rs) == 0 {
+		return k(out, captures)
+	}
+	r := rs[0]
+	for {
+		if !e.runes(n, rs[1:], append(out[:len(out):len(out)], r), captures, k) {
+			return false
+		}
+		if n.Flags&syntax.IgnoreCase == 0 {
+			return true
+		}
+		if r = unicode.SimpleFold(r); r == rs[0] {
+			return true
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		want    []string
+	}{
+		{`^(red|green|blue)$`, 0, []string{"red", "green", "blue"}},
+		{`[a-c]x?`, 0, []string{"a", "ax", "b", "bx", "c", "cx"}},
+		{`a{1,3}`, 0, []string{"a", "aa", "aaa"}},
+		{`(?i)no`, 0, []string{"no", "nO", "No", "NO"}},
+		{`a|a|b`, 0, []string{"a", "b"}},
+		{`([ab])\1`, 0, []string{"aa", "bb"}},
+		{`(?:a|b)(?<!aa)c?`, 0, []string{"a", "ac", "b", "bc"}},
+		{`x(?=y)`, 0, nil},
+		{`[ab]\b[cd]`, 0, nil},
+		{`[0-2]{2}`, RightToLeft, []string{"00", "01", "02", "10", "11", "12", "20", "21", "22"}},
+		{`(a)?(?(1)b|c)`, 0, []string{"c", "ab"}},
+	}
+	for _, test := range tests {
+		got, err := MustCompile(test.pattern, test.opt).Enumerate(100)
+		if err != nil {
+			t.Errorf("%v: %v", test.pattern, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v: got %q, want %q", test.pattern, got, test.want)
+		}
+	}
+
+	got, err := MustCompile(`[0-9]{3}`, 0).Enumerate(5)
+	if want := []string{"000", "001", "002", "003", "004"}; err != ErrEnumerationLimit || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q and %v, want %q and ErrEnumerationLimit", got, err, want)
+	}
+	if _, err := MustCompile(`[0-9]{3}`, 0).Enumerate(1000); err != nil {
+		t.Errorf("limit reached with exactly as many strings as the limit: %v", err)
+	}
+	for _, pattern := range []string{`a+`, `a{2,}`, `(a(?1)?b)`} {
+		if _, err := MustCompile(pattern, PCRE2).Enumerate(10); err != ErrInfiniteLanguage {
+			t.Errorf("%v: got %v, want ErrInfiniteLanguage", pattern, err)
+		}
+	}
+
+	n := 0
+	MustCompile(`[0-9]{4}`, 0).EnumerateFunc(func(s string) bool {
+		n++
+		return s != "0042"
+	})
+	if n != 43 {
+		t.Errorf("EnumerateFunc didn't stop: called %v times", n)
+	}
+}
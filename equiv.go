@@ -0,0 +1,257 @@
+package regexp2
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"unicode"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+var (
+	// ErrNeedsBacktracking is returned when comparing a pattern with back
+	// references, lookarounds, atomic groups, conditionals or balancing
+	// groups, which have no finite automaton, or one that's right to left
+	// or compiled with a culture.
+	ErrNeedsBacktracking = errors.New("regexp2: pattern needs backtracking, so it can't be compared")
+
+	// ErrCompareTooComplex is returned when comparing patterns needs more
+	// automaton states than the lazy DFA keeps.
+	ErrCompareTooComplex = errors.New("regexp2: patterns are too complex to compare")
+)
+
+// Equivalent reports whether a and b match the same texts: whether
+// MatchString gives the same answer for them on every string.  It works
+// by building their DFAs together, so it only handles patterns that
+// don't need the backtracker.
+func Equivalent(a, b *Regexp) (bool, error) {
+	_, found, err := compareLanguages(a, b, func(matchA, matchB bool) bool { return matchA != matchB })
+	return !found && err == nil, err
+}
+
+// Includes reports whether a matches every text b matches, so that a rule
+// for b after a rule for a would never be reached, as Equivalent does.
+func Includes(a, b *Regexp) (bool, error) {
+	_, found, err := Difference(b, a)
+	return !found && err == nil, err
+}
+
+// Difference returns a shortest string that a matches and b doesn't, or
+// found false if there is none, as Equivalent does.
+func Difference(a, b *Regexp) (s string, found bool, err error) {
+	return compareLanguages(a, b, func(matchA, matchB bool) bool { return matchA && !matchB })
+}
+
+// a pair of states of the two DFAs, one of the product automaton's
+type dfaPair struct {
+	a, b *dfaState
+}
+
+// compareLanguages searches the product of the DFAs of a and b, shortest
+// strings first, for one on which differ says their answers differ
+func compareLanguages(a, b *Regexp, differ func(matchA, matchB bool) bool) (string, bool, error) {
+	da, err := comparisonDFA(a)
+	if err != nil {
+		return "", false, err
+	}
+	db, err := comparisonDFA(b)
+	if err != nil {
+		return "", false, err
+	}
+	alphabet := runeClasses(da.nfa, db.nfa)
+
+	start := dfaPair{da.state([]int{da.nfa.Start}, dfaBeginning|dfaStart), db.state([]int{db.nfa.Start}, dfaBeginning|dfaStart)}
+	if start.a == nil || start.b == nil {
+		return "", false, ErrCompareTooComplex
+	}
+	if differ(da.ends(start.a), db.ends(start.b)) {
+		return "", true, nil
+	}
+
+	// how each pair was first reached, to write out the string
+	type edge struct {
+		from dfaPair
+		c    rune
+	}
+	seen := map[dfaPair]edge{start: {}}
+	path := func(p dfaPair, c rune) string {
+		rs := []rune{c}
+		for p != start {
+			e := seen[p]
+			rs = append(rs, e.c)
+			p = e.from
+		}
+		for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+			rs[i], rs[j] = rs[j], rs[i]
+		}
+		return string(rs)
+	}
+
+	queue := []dfaPair{start}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if p.a == da.matched && p.b == db.matched {
+			// both match whatever comes next
+			continue
+		}
+		for _, c := range alphabet {
+			endA, endB := da.endsWith(p.a, c), db.endsWith(p.b, c)
+			if endA == nil || endB == nil {
+				return "", false, ErrCompareTooComplex
+			}
+			if differ(*endA, *endB) {
+				return path(p, c), true, nil
+			}
+			next := dfaPair{da.next(p.a, c), db.next(p.b, c)}
+			if next.a == nil || next.b == nil || len(seen) >= maxDFAStates {
+				return "", false, ErrCompareTooComplex
+			}
+			if _, ok := seen[next]; !ok {
+				seen[next] = edge{p, c}
+				queue = append(queue, next)
+			}
+		}
+	}
+	return "", false, nil
+}
+
+func comparisonDFA(re *Regexp) (*lazyDFA, error) {
+	tree, err := syntax.ParseCulture(re.pattern, syntax.RegexOptions(re.options), re.culture)
+	if err != nil {
+		return nil, err
+	}
+	nfa := tree.NFA()
+	if nfa == nil {
+		return nil, ErrNeedsBacktracking
+	}
+	return newLazyDFA(nfa), nil
+}
+
+// next returns the state after c, when more of the text follows it
+func (d *lazyDFA) next(s *dfaState, c rune) *dfaState {
+	if s == d.matched {
+		return s
+	}
+	return d.step(s, c, false)
+}
+
+// ends says whether the pattern has matched if the text ends at s
+func (d *lazyDFA) ends(s *dfaState) bool {
+	return s == d.matched || d.step(s, dfaEnd, true) == d.matched
+}
+
+// endsWith says whether the pattern has matched if the text ends with c
+// after s, or returns nil if the DFA has grown too big
+func (d *lazyDFA) endsWith(s *dfaState, c rune) *bool {
+	matched := true
+	if s != d.matched {
+		last := d.step(s, c, true)
+		if last == nil {
+			return nil
+		}
+		matched = d.ends(last)
+	}
+	return &matched
+}
+
+// runeClasses returns a rune for each set of runes that the NFAs can't
+// tell apart, so that trying those is as good as trying all of them
+func runeClasses(nfas ...*syntax.NFA) []rune {
+	cuts := map[rune]bool{0: true, '\n': true, '\n' + 1: true, 0xd800: true, 0xe000: true}
+	for _, r := range foldCuts() {
+		cuts[r] = true
+	}
+	var insts []*syntax.NFAInst
+	sets := map[*syntax.CharSet]bool{}
+	for _, nfa := range nfas {
+		for i := range nfa.Insts {
+			inst := &nfa.Insts[i]
+			switch inst.Op {
+			case syntax.NFAChar, syntax.NFANotChar:
+				cuts[inst.Ch], cuts[inst.Ch+1] = true, true
+			case syntax.NFASet:
+				if sets[inst.Set] {
+					break
+				}
+				sets[inst.Set] = true
+				for _, rg := range setRanges(inst.Set) {
+					cuts[rg[0]], cuts[rg[1]+1] = true, true
+				}
+			default:
+				continue
+			}
+			insts = append(insts, inst)
+		}
+	}
+	var starts []rune
+	for r := range cuts {
+		if r <= unicode.MaxRune && (r < 0xd800 || r >= 0xe000) {
+			starts = append(starts, r)
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	// the runes between two cuts all act the same; different intervals
+	// often do too, so keep one of each kind
+	var alphabet []rune
+	kinds := map[string]bool{}
+	for _, c := range starts {
+		kind := []byte{prevFlags(c)}
+		for _, inst := range insts {
+			if consumes(inst, c) {
+				kind = append(kind, 1)
+			} else {
+				kind = append(kind, 0)
+			}
+		}
+		if !kinds[string(kind)] {
+			kinds[string(kind)] = true
+			alphabet = append(alphabet, c)
+		}
+	}
+	return alphabet
+}
+
+var (
+	foldCutsOnce sync.Once
+	foldCutList  []rune
+
+	// the RuneRanges of the classes compared so far, by their String, as
+	// working them out for \w and the like takes a while
+	setRangesMu    sync.Mutex
+	setRangesCache = map[string][][2]rune{}
+)
+
+func setRanges(set *syntax.CharSet) [][2]rune {
+	key := set.String()
+	setRangesMu.Lock()
+	ranges, ok := setRangesCache[key]
+	setRangesMu.Unlock()
+	if !ok {
+		ranges = set.RuneRanges()
+		setRangesMu.Lock()
+		setRangesCache[key] = ranges
+		setRangesMu.Unlock()
+	}
+	return ranges
+}
+
+// foldCuts returns the cuts that put each rune case folding changes, and
+// each change in whether runes are word characters, on its own
+func foldCuts() []rune {
+	foldCutsOnce.Do(func() {
+		word, ecmaWord := false, false
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if syntax.CaseFold(r) != r || unicode.SimpleFold(r) != r {
+				foldCutList = append(foldCutList, r, r+1)
+			}
+			if w, e := syntax.IsWordChar(r), syntax.IsECMAWordChar(r); w != word || e != ecmaWord {
+				foldCutList = append(foldCutList, r)
+				word, ecmaWord = w, e
+			}
+		}
+	})
+	return foldCutList
+}
@@ -0,0 +1,81 @@
+package regexp2
+
+import "testing"
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b string
+		opt  RegexOptions
+		want bool
+	}{
+		{`a|b`, `[ab]`, 0, true},
+		{`(a|b)*`, `[ab]*`, 0, true},
+		{`x(a|b)*`, `x`, 0, true}, // as searches, both just look for an x
+		{`^x(a|b)*$`, `^x[ab]*$`, 0, true},
+		{`^x(a|b)*$`, `^x[ab]+$`, 0, false},
+		{`(?i)abc`, `[aA][bB][cC]`, 0, true},
+		{`(?i)k`, `[kK]`, 0, false}, // the Kelvin sign
+		{`\d+`, `[0-9]+`, 0, false},
+		{`\d+`, `[0-9]+`, ECMAScript, true},
+		{`^a{2,3}$`, `^(aa|aaa)$`, 0, true},
+		{`\bfoo\b`, `(?<!\w)foo(?!\w)`, 0, false}, // can't compare lookarounds
+		{`^$`, `\A\Z`, 0, true},
+		{`^$`, `\A\z`, 0, false},
+	}
+	for _, test := range tests {
+		a, b := MustCompile(test.a, test.opt), MustCompile(test.b, test.opt)
+		got, err := Equivalent(a, b)
+		if err == ErrNeedsBacktracking {
+			if test.want {
+				t.Errorf("%v and %v: %v", test.a, test.b, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v and %v: %v", test.a, test.b, err)
+		} else if got != test.want {
+			t.Errorf("Equivalent(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+		diff string // a string b matches and a doesn't
+	}{
+		{`^[a-z]+$`, `^(cat|dog)$`, true, ""},
+		{`^(cat|dog)$`, `^[a-z]+$`, false, "a"},
+		{`error`, `^fatal error: `, true, ""},
+		{`^\w+@\w+\.com$`, `^admin@example\.com$`, true, ""},
+		{`^\w+@\w+\.com$`, `^admin@example\.(com|org)$`, false, "admin@example.org"},
+		{`^a{2,}$`, `^a{3}$`, true, ""},
+		{`^a{3,}$`, `^a{2}$`, false, "aa"},
+	}
+	for _, test := range tests {
+		a, b := MustCompile(test.a, 0), MustCompile(test.b, 0)
+		got, err := Includes(a, b)
+		if err != nil {
+			t.Errorf("%v and %v: %v", test.a, test.b, err)
+		} else if got != test.want {
+			t.Errorf("Includes(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+		diff, found, err := Difference(b, a)
+		if err != nil || found == test.want || diff != test.diff {
+			t.Errorf("Difference(%v, %v) = %q, %v, %v, want %q", test.b, test.a, diff, found, err, test.diff)
+		}
+		if found {
+			if ok, _ := b.MatchString(diff); !ok {
+				t.Errorf("%v doesn't match %q", test.b, diff)
+			}
+			if ok, _ := a.MatchString(diff); ok {
+				t.Errorf("%v matches %q", test.a, diff)
+			}
+		}
+	}
+
+	if _, err := Includes(MustCompile(`(a)\1`, 0), MustCompile(`a`, 0)); err != ErrNeedsBacktracking {
+		t.Errorf("got %v, want ErrNeedsBacktracking", err)
+	}
+}
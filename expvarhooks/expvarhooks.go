@@ -0,0 +1,72 @@
+// Package expvarhooks keeps counts of how regexp2 patterns' searches go
+// in an expvar.Map, which is published as /debug/vars:
+//
+//	regexp2.DefaultHooks = expvarhooks.New("regexp2")
+//
+// Each pattern's entry is a map of its searches, matches, timeouts, errors
+// and the nanoseconds spent searching.  Since there's an entry for every
+// pattern it sees, it's for a program's own patterns, not ones its users
+// give it.
+//
+// It's a package of its own so that regexp2 itself doesn't import expvar,
+// which registers a handler with net/http.
+package expvarhooks
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/jviksne/regexp2"
+)
+
+// Hooks is regexp2.Hooks that keeps counts in an expvar.Map.
+type Hooks struct {
+	vars *expvar.Map
+	mu   sync.Mutex // for adding patterns
+}
+
+// New publishes an expvar.Map called name and returns Hooks that keep
+// counts in it.  Like expvar.Publish, it panics if name is already taken.
+func New(name string) *Hooks {
+	return &Hooks{vars: expvar.NewMap(name)}
+}
+
+// Map is the map the counts are kept in, by pattern.
+func (h *Hooks) Map() *expvar.Map {
+	return h.vars
+}
+
+// OnMatchStart counts a search of re.
+func (h *Hooks) OnMatchStart(re *regexp2.Regexp) {
+	h.counts(re).Add("searches", 1)
+}
+
+// OnMatchEnd counts how the search went.
+func (h *Hooks) OnMatchEnd(re *regexp2.Regexp, end regexp2.MatchEnd) {
+	counts := h.counts(re)
+	switch {
+	case end.Matched:
+		counts.Add("matches", 1)
+	case end.Timeout:
+		counts.Add("timeouts", 1)
+	case end.Err != nil:
+		counts.Add("errors", 1)
+	}
+	counts.Add("nanoseconds", int64(end.Elapsed))
+}
+
+// counts is the map for re's pattern
+func (h *Hooks) counts(re *regexp2.Regexp) *expvar.Map {
+	pattern := re.String()
+	if counts, ok := h.vars.Get(pattern).(*expvar.Map); ok {
+		return counts
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if counts, ok := h.vars.Get(pattern).(*expvar.Map); ok {
+		return counts
+	}
+	counts := new(expvar.Map).Init()
+	h.vars.Set(pattern, counts)
+	return counts
+}
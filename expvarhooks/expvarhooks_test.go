@@ -0,0 +1,34 @@
+package expvarhooks
+
+import (
+	"expvar"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jviksne/regexp2"
+)
+
+func TestHooks(t *testing.T) {
+	// expvar names can only be used once, even with -count
+	name := fmt.Sprintf("regexp2_test_%v", time.Now().UnixNano())
+	h := New(name)
+	re := regexp2.MustCompile(`(.+)*\?`, 0)
+	re.Hooks = h
+	re.MatchString("what?")
+	re.MatchString("what")
+	re.MatchString("Do you think you found the problem string!", regexp2.WithTimeout(time.Millisecond))
+
+	if expvar.Get(name) != h.Map() {
+		t.Fatal("the map wasn't published")
+	}
+	counts := h.Map().Get(re.String()).(*expvar.Map)
+	for name, want := range map[string]string{"searches": "3", "matches": "1", "timeouts": "1"} {
+		if got := counts.Get(name); got == nil || got.String() != want {
+			t.Errorf("wanted %v %v, got %v", want, name, got)
+		}
+	}
+	if got := counts.Get("errors"); got != nil {
+		t.Errorf("wanted no errors, got %v", got)
+	}
+}
@@ -0,0 +1,104 @@
+package regexp2
+
+import (
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// GeneratedMatcher is a pattern's program translated to Go by
+// cmd/regexp2gen.  It tries a match starting at pos and reports whether
+// one was found, leaving the captures it made in in.Captures.
+type GeneratedMatcher func(in *GeneratedInput, pos int) bool
+
+// GeneratedInput is what a GeneratedMatcher works on.  It's only meant to
+// be used by generated code.
+type GeneratedInput struct {
+	Text  []rune
+	Start int // where the search began, for \G
+
+	Sets  []*syntax.CharSet
+	Tries []*syntax.LiteralTrie
+
+	// Captures holds the captures made so far, three ints each: the group
+	// slot, the index and the length, in the order they were made
+	Captures []int
+
+	// the backtracking and grouping stacks, kept between calls so their
+	// space can be reused
+	Track, Stack []int
+}
+
+// Capture records that the group in slot matched text[start:end].
+func (in *GeneratedInput) Capture(slot, start, end int) {
+	if end < start {
+		start, end = end, start
+	}
+	in.Captures = append(in.Captures, slot, start, end-start)
+}
+
+// Uncapture undoes the latest Capture.
+func (in *GeneratedInput) Uncapture() {
+	in.Captures = in.Captures[:len(in.Captures)-3]
+}
+
+// LastCapture returns the latest capture of the group in slot.
+func (in *GeneratedInput) LastCapture(slot int) (index, length int, ok bool) {
+	for i := len(in.Captures) - 3; i >= 0; i -= 3 {
+		if in.Captures[i] == slot {
+			return in.Captures[i+1], in.Captures[i+2], true
+		}
+	}
+	return 0, 0, false
+}
+
+// LoadGenerated returns the Regexp saved in data by MarshalBinary, which
+// uses m in place of the interpreter where it can.  m may be nil for
+// patterns regexp2gen couldn't translate, which still skips compiling them
+// at run time.
+//
+// m is not used when a search has a timeout, a context or a step limit, or
+// with Longest, since it doesn't check for those; such searches run as if
+// the Regexp had been compiled.
+func LoadGenerated(data []byte, m GeneratedMatcher) (*Regexp, error) {
+	re := &Regexp{}
+	if err := re.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	re.generated = m
+	return re, nil
+}
+
+// MustLoadGenerated is like LoadGenerated but panics if data can't be
+// loaded.  It's what the variables written by regexp2gen are set with.
+func MustLoadGenerated(data []byte, m GeneratedMatcher) *Regexp {
+	re, err := LoadGenerated(data, m)
+	if err != nil {
+		panic(`regexp2: LoadGenerated: ` + err.Error())
+	}
+	return re
+}
+
+// GenerateGo returns the source of a Go function called name that matches
+// re, for use with LoadGenerated.  It returns syntax.ErrNotGeneratable if
+// re uses features the generator doesn't handle.
+func GenerateGo(re *Regexp, name string) (string, error) {
+	return syntax.GenerateGo(re.code, name, syntax.RegexOptions(re.options))
+}
+
+// runGenerated tries the generated matcher at the current position and, if
+// it matches, fills in runmatch the way execute would
+func (r *runner) runGenerated() bool {
+	in := &r.genInput
+	in.Text = r.runtext
+	in.Start = r.runtextstart
+	in.Sets = r.code.Sets
+	in.Tries = r.code.Tries
+	if !r.re.generated(in, r.runtextpos) {
+		return false
+	}
+	for i := 0; i < len(in.Captures); i += 3 {
+		r.runmatch.addMatch(in.Captures[i], in.Captures[i+1], in.Captures[i+2])
+	}
+	n := len(in.Captures)
+	r.runtextpos = in.Captures[n-2] + in.Captures[n-1]
+	return true
+}
@@ -0,0 +1,125 @@
+package regexp2_test
+
+//go:generate go run ./cmd/regexp2gen -pkg regexp2_test -o generated_test.go testdata/generated.txt
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jviksne/regexp2"
+)
+
+var generatedInputs = []string{
+	"",
+	"on 2019-04-01 and 2020-11-30, or 2021-1-1",
+	"Hello World ÉCOLE École naïve",
+	"user@example.com",
+	"USER.Name+tag@Example.CO",
+	"<a><bb> <c",
+	"ababx cdcdcdy abcdabcdx abababy",
+	"aabbbaabc abc c",
+	"hello hello world  world foo bar",
+	`say "hi" and 'bye" or 'ok'`,
+	"aaab aaac aaa",
+	"one, two three,",
+	"(123) 45 (6 7)",
+	"if x then RETURN y else goto z; func f() { switch c { case 1: break } }",
+	"SELECT a FROM t WHERE x ORDER BY y LIMIT 3 offset 2",
+	"x\n  # comment\n#\ny",
+	"foo\n",
+	"foo\nfoo",
+	"ababab",
+	"a\nbz\naz",
+	"abbac bc c ac",
+	"xyxz xyxyxz xz",
+	"1,234,567 12,34 999,",
+	"b ab aab",
+	`"quoted" "open`,
+	"e\u0301e\u0301 🇫🇷🇩🇪 ab",
+	"can't stop won't",
+	"eggA obbz ax9",
+	"(a(b)c) ((x) (y",
+	"12 345 6",
+}
+
+func dumpMatches(re *regexp2.Regexp, input string) string {
+	buf := &bytes.Buffer{}
+	m, err := re.FindStringMatch(input)
+	for ; m != nil; m, err = re.FindNextMatch(m) {
+		for _, g := range m.Groups() {
+			fmt.Fprintf(buf, "%v:", g.Name)
+			for _, c := range g.Captures {
+				fmt.Fprintf(buf, "%v+%v,", c.Index, c.Length)
+			}
+			buf.WriteByte(' ')
+		}
+		buf.WriteByte('\n')
+	}
+	if err != nil {
+		fmt.Fprintf(buf, "error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestGenerated(t *testing.T) {
+	generated := []*regexp2.Regexp{
+		Date, Words, Email, Lazy, Repeat, Nested, Backref, BackrefCI, Atomic,
+		Lookahead, Conditional, Keywords, KeywordsCI, Anchors, EndZ, Start,
+		Lines, Loop, LazyCount, Counted, ECMA, Unicode, NotOne, Grapheme,
+		WordSeg, Sets, Recursive, RTL,
+	}
+	for _, re := range generated {
+		// the same program without the generated matcher
+		data, err := re.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		interp, err := regexp2.LoadGenerated(data, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range generatedInputs {
+			if want, got := dumpMatches(interp, input), dumpMatches(re, input); want != got {
+				t.Errorf("%v on %q:\nwanted %v\ngot    %v", re, input, want, got)
+			}
+		}
+	}
+}
+
+func TestGenerated_Used(t *testing.T) {
+	data, _ := Date.MarshalBinary()
+	calls := 0
+	re := regexp2.MustLoadGenerated(data, func(in *regexp2.GeneratedInput, pos int) bool {
+		calls++
+		return matchDate(in, pos)
+	})
+	if m, _ := re.FindStringMatch("on 2019-04-01"); m == nil || m.GroupByName("month").String() != "04" {
+		t.Fatalf("unexpected match %v", m)
+	}
+	if calls == 0 {
+		t.Error("expected the generated matcher to be used")
+	}
+
+	// it has no timeout checks, so the interpreter takes over
+	calls = 0
+	re.MatchTimeout = time.Second
+	if ok, _ := re.MatchString("on 2019-04-01"); !ok {
+		t.Error("expected a match")
+	}
+	if calls != 0 {
+		t.Error("expected the interpreter to run searches with a timeout")
+	}
+}
+
+func TestGenerateGo_NotGeneratable(t *testing.T) {
+	for _, pattern := range []string{`\((?:[^()]|(?R))*\)`, `a(*COMMIT)b`, `(?<o>\()[^()]*(?<c-o>\))`} {
+		if _, err := regexp2.GenerateGo(regexp2.MustCompile(pattern, 0), "f"); err == nil {
+			t.Errorf("%v: expected an error", pattern)
+		}
+	}
+	if _, err := regexp2.GenerateGo(regexp2.MustCompile(`\d+`, regexp2.RightToLeft), "f"); err == nil {
+		t.Error("expected an error for RightToLeft")
+	}
+}
@@ -0,0 +1,3193 @@
+// Code generated by regexp2gen; DO NOT EDIT.
+
+package regexp2_test
+
+import (
+	"github.com/jviksne/regexp2"
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// Date matches `(?<year>\d{4})-(?<month>\d\d)-(?<day>\d\d)`
+var Date = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01\xb9\xff\x80\x01*(?<year>\\d{4})-(?<month>\\d\\d)-(?<day>\\d\\d)\x02\x04\x01\x010\x00\x01\x03day\x01\x06\x00\x01\x05month\x01\x04\x00\x01\x04year\x01\x02\x00\x01\x04\x010\x04year\x05month\x03day\x02\x01\x01-\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x01>regexp2\x00\bD.B>>\x04\x00\b@\x02\x01\x12Z>\x16\x00\x16\x00@\x04\x01\x12Z>\x16\x00\x16\x00@\x06\x01@\x00\x01P\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x12\x00\x00\b\x02\x00\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02$&\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x10\x02\x00\x00\x00\x00\x00\x0e\x06\x04\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x10\b\x00\x00\x00\x00\x00\f\x02\n\x00Z\x00\x00\x00\x00\x10\f\x00\x00\x00\x00\x00\n\x06\x0e\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\x10\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x10\x12\x00\x00\x00\x00\x00\b\x02\x14\x00Z\x00\x00\x00\x00\x10\x16\x00\x00\x00\x00\x00\x06\x06\x18\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\x1a\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\x1c\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\x1e\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x10 \x00\x00\x00\x00\x00\x04\x10\"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchDate)
+
+// Words matches `\b\w+\b`
+var Words = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xb7\xff\x80\x01\a\\b\\w+\\b\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\x98regexp2\x00\b\x1e.\x1c> \x04\x00\x02\n\x00\xfe\xff\xff\xff\x0f @\x00\x01P\x00\x02\x00\x00\x00\x02\x02W\x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x80\x01\x00\x00\x00\x02\x0e\x10\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\n\x02\x00\x00\x00 \x00\x00\b\b\x04\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\n\n\x00\x00\x00 \x00\x00\x10\f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchWords)
+
+// Email matches `^[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}$`
+var Email = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01\xc9\xff\x80\x01'^[a-z0-9._%+-]+@[a-z0-9.-]+\\.[a-z]{2,}$\x01\x02\a\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x01\x87regexp2\x00\b>.<>$\x84\b\x00\x02\x8a\b\x00\xfe\xff\xff\xff\x0f\x92\b\x80\x01\x84\b\x02\x02\x8a\b\x02\xfe\xff\xff\xff\x0f\x92\b\\\x84\b\x04\x04\x8a\b\x04\xfe\xff\xff\xff\x0f(@\x00\x01P\x00\x06\x00\x00\fJJVVZ\\`r\xbe\x01\xbe\x01\xc2\x01\xf4\x01\x00\x00\x00\x00\x00\x06Z\\`r\xc2\x01\xf4\x01\x00\x00\x00\x00\x00\x02\xc2\x01\xf4\x01\x00\x00\x00\f\x00\x00\x02\x02\x00\x00\x00\fJJVVZ\\`r\xbe\x01\xbe\x01\xc2\x01\xf4\x01\x00\x00\x00\x02\x00\x02\x00\x00\x00\x02 \"\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\n\x02\x00\x00\x00(\x00\x00\b\b\x04\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x02\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x06\x06\x00\x00\x02\x00\x00\x02\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x06\n\x00\x00\x02\x00\x00\x02\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x02\f\x00\\\x00\x00\x02\x00\b\x12\x0e\x00\x00\x00\x00\x00\x06\x10\x00\x00\x02\x00\x00\x06Z\\`r\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x06\x10\x00\x00\x02\x00\x00\x06Z\\`r\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x02\x14\x00\x80\x01\x00\x00\x02\x00\b\x1a\x16\x00\x00\x00\x00\x00\x06\x18\x00\x00\x02\x00\x00\fJJVVZ\\`r\xbe\x01\xbe\x01\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\x06\x18\x00\x00\x02\x00\x00\fJJVVZ\\`r\xbe\x01\xbe\x01\xc2\x01\xf4\x01\x00\x00\x00\x00\x02\x00\n\x1c\x00\x00\x00$\x00\x00\x10\x1e\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x01\x00"), matchEmail)
+
+// Lazy matches `<(.+?)>`
+var Lazy = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xbd\xff\x80\x01\a<(.+?)>\x04\x01<\x01\x02\x01<\x01>\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\x95regexp2\x00\b*.(>\x12x>\x02\x14\x02\x0e\x14\xfe\xff\xff\xff\x0f@\x02\x01\x12|@\x00\x01P\x00\x00\f\x00\x00\x04\x02\x00\x00\x00\x02xx\x00\x00\x00\x00\x02\x02x\x00\x00\x00\x00\x00\x00\x02\x12\x14\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00|\x00\x00\x00\x00\x10\x04\x00\x00\x00\x00\x00\x06\b\x06\n\x00\x00\x00\x00\x00\x04\b\x00\x14\x00\x00\x00\x00\x04\b\x00\x14\x00\x00\x00\x00\x10\f\x00\x00\x00\x00\x00\x04\x02\x0e\x00x\x00\x00\x00\x00\x10\x10\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchLazy)
+
+// Repeat matches `(ab|cd){2,4}?x|(ab|cd){1,3}y`
+var Repeat = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x02\x96\xff\x80\x01\x1c(ab|cd){2,4}?x|(ab|cd){1,3}y\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x02aregexp2\x00\bb.`>.46\x01>.\x1c\x18\x00L \x18\x02@\x02\x01:\x0e\x04\x12\xf0\x01LZ6\x00>.F\x18\x00LJ\x18\x02@\x04\x0188\x04\x12\xf2\x01@\x00\x01P\x04\x04\xc2\x01\xc4\x01\x04\xc6\x01\xc8\x01\x00\"\x00\x00\x06\x02\x00\x00\x00\x04\xc2\x01\xc2\x01\xc6\x01\xc6\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02tv\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00\xf2\x01\x00\x00\x00\x00\x10\x04\x00\x00\x00\x00\x00\n\x02\x06\x00\xc8\x01\x00\x00\x00\x00\x02\b\x00\xc6\x01\x00\x00\x00\x00\x02\x06\x00\xc4\x01\x00\x00\x00\x00\x02\f\x00\xc2\x01\x00\x00\x00\x00\b\x0e\n\x00\x00\x00\x00\x00\x10\x10\x00\x00\x00\x00\x00\b\b\x12\x04\x00\x00\x00\x00\x00\x10\x14\x00\x00\x00\x00\x00\n\x02\x16\x00\xc8\x01\x00\x00\x00\x00\x02\x18\x00\xc6\x01\x00\x00\x00\x00\x02\x16\x00\xc4\x01\x00\x00\x00\x00\x02\x1c\x00\xc2\x01\x00\x00\x00\x00\b\x1e\x1a\x00\x00\x00\x00\x00\x10 \x00\x00\x00\x00\x00\b\b\"\x04\x00\x00\x00\x00\x00\x10$\x00\x00\x00\x00\x00\n\x02&\x00\xc8\x01\x00\x00\x00\x00\x02(\x00\xc6\x01\x00\x00\x00\x00\x02&\x00\xc4\x01\x00\x00\x00\x00\x02,\x00\xc2\x01\x00\x00\x00\x00\b.*\x00\x00\x00\x00\x00\x100\x00\x00\x00\x00\x00\b\x02\x02\x00\xf0\x01\x00\x00\x00\x00\x104\x00\x00\x00\x00\x00\x06\x026\x00\xc8\x01\x00\x00\x00\x00\x028\x00\xc6\x01\x00\x00\x00\x00\x026\x00\xc4\x01\x00\x00\x00\x00\x02<\x00\xc2\x01\x00\x00\x00\x00\b>:\x00\x00\x00\x00\x00\x10@\x00\x00\x00\x00\x00\x04\b4B\x00\x00\x00\x00\x00\x10D\x00\x00\x00\x00\x00\x06\x02F\x00\xc8\x01\x00\x00\x00\x00\x02H\x00\xc6\x01\x00\x00\x00\x00\x02F\x00\xc4\x01\x00\x00\x00\x00\x02L\x00\xc2\x01\x00\x00\x00\x00\bNJ\x00\x00\x00\x00\x00\x10P\x00\x00\x00\x00\x00\x04\b4R\x00\x00\x00\x00\x00\x10T\x00\x00\x00\x00\x00\x06\x02V\x00\xc8\x01\x00\x00\x00\x00\x02X\x00\xc6\x01\x00\x00\x00\x00\x02V\x00\xc4\x01\x00\x00\x00\x00\x02\\\x00\xc2\x01\x00\x00\x00\x00\b^Z\x00\x00\x00\x00\x00\x10`\x00\x00\x00\x00\x00\x04\x10b\x00\x00\x00\x00\x00\x06\x02d\x00\xc8\x01\x00\x00\x00\x00\x02f\x00\xc6\x01\x00\x00\x00\x00\x02d\x00\xc4\x01\x00\x00\x00\x00\x02j\x00\xc2\x01\x00\x00\x00\x00\blh\x00\x00\x00\x00\x00\x10n\x00\x00\x00\x00\x00\x04\bp2\x00\x00\x00\x00\x00\x10r\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchRepeat)
+
+// Nested matches `((a+)b*)+c`
+var Nested = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01;\xff\x80\x01\n((a+)b*)+c\x05\x02\x01a\x01c\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x01\x12regexp2\x00\b:.8>>>>\x00\xc2\x01\x02\x06\xc2\x01\xfe\xff\xff\xff\x0f@\x04\x01\x06\xc4\x01\xfe\xff\xff\xff\x0f@\x02\x010\b\x12\xc6\x01@\x00\x01P\x00\x00\x16\x00\x00\x06\x02\x00\x00\x00\x02\xc2\x01\xc2\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02,.\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00\xc6\x01\x00\x00\x00\x00\b\x18\x04\x00\x00\x00\x00\x00\x10\x06\x00\x00\x00\x00\x00\x06\b\f\b\x00\x00\x00\x00\x00\x02\n\x00\xc4\x01\x00\x00\x00\x00\x10\n\x00\x00\x00\x00\x00\n\b\x12\x0e\x00\x00\x00\x00\x00\x02\x10\x00\xc2\x01\x00\x00\x00\x00\x02\x10\x00\xc2\x01\x00\x00\x00\x00\x10\x14\x00\x00\x00\x00\x00\b\x10\x16\x00\x00\x00\x00\x00\x04\x10\x06\x00\x00\x00\x00\x00\x06\b\x1e\x1a\x00\x00\x00\x00\x00\x02\x1c\x00\xc4\x01\x00\x00\x00\x00\x10\x1c\x00\x00\x00\x00\x00\n\b$ \x00\x00\x00\x00\x00\x02\"\x00\xc2\x01\x00\x00\x00\x00\x02\"\x00\xc2\x01\x00\x00\x00\x00\x10&\x00\x00\x00\x00\x00\b\x10(\x00\x00\x00\x00\x00\x04\x10*\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchNested)
+
+// Backref matches `(\w+)\s+\1`
+var Backref = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00{\xff\x80\x01\n(\\w+)\\s+\\1\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Zregexp2\x00\b2.0>>\x04\x00\x02\n\x00\xfe\xff\xff\xff\x0f@\x02\x01\x04\x02\x02\n\x02\xfe\xff\xff\xff\x0f\x1a\x02@\x00\x01P\x00\x04\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\x02\x02 \x00\x00\x00\x0e\x00\x00\x04\x02\x00\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchBackref)
+
+// BackrefCI matches `(?<q>['"])(.*?)\k<q>`
+var BackrefCI = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\x96\xff\x80\x01\x14(?<q>['\"])(.*?)\\k<q>\x01\x02\x01\x03\x01\x010\x00\x01\x011\x01\x02\x00\x01\x01q\x01\x04\x00\x01\x03\x010\x011\x01q\x05\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Oregexp2\x00\b,.*>>\x96\b\x00@\x04\x01>\x8e\b\x14\xfe\xff\xff\xff\x0f@\x02\x01\x9a\b\x04@\x00\x01P\x00\x02\x00\x00\x04DDNN\x00\x00\x00\x10\x00\x00\x06\x02\x00\x00\x00\x04DDNN\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchBackrefCI)
+
+// Atomic matches `(?>a+)b|a+c`
+var Atomic = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00u\xff\x80\x01\v(?>a+)b|a+c\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Sregexp2\x00\b:.8>.\"D\x00\xc2\x01\x02\x06\xc2\x01\xfe\xff\xff\xff\x0fH\x12\xc4\x01L2\x00\xc2\x01\x02\x06\xc2\x01\xfe\xff\xff\xff\x0f\x12\xc6\x01@\x00\x01P\x00\x00\x12\x00\x00\x02\x02\x00\x00\x00\x02\xc2\x01\xc2\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchAtomic)
+
+// Lookahead matches `\w+(?=,)|\w+(?!\w)`
+var Lookahead = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\x85\xff\x80\x01\x12\\w+(?=,)|\\w+(?!\\w)\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\\regexp2\x00\bH.F>.&\x04\x00\x02\n\x00\xfe\xff\xff\xff\x0fD>\x12XBHL@\x04\x00\x02\n\x00\xfe\xff\xff\xff\x0fD.>\x16\x00FH@\x00\x01P\x00\x02\x00\x00\x00\x02\x02W\x00\x00\x00\x1e\x00\x00\x02\x02\x00\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchLookahead)
+
+// Conditional matches `(\()?\d+(?(1)\))`
+var Conditional = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\x84\xff\x80\x01\x10(\\()?\\d+(?(1)\\))\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04]regexp2\x00\bJ.H>4\x00L\x1a>\x12P@\x02\x018\x0e\x02\x04\x00\x02\n\x00\xfe\xff\xff\xff\x0fD.@J\x02H\x12RLBH@\x00\x01P\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x1c\x00\x00\x04\x02\x00\x00\x00\x02PP\x02\x04Nd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchConditional)
+
+// Keywords matches `\b(?:if|else|for|while|switch|case|break|return|goto|func)\b`
+var Keywords = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x02\xf0\xff\x80\x01<\\b(?:if|else|for|while|switch|case|break|return|goto|func)\\b\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x02\x9bregexp2\x00\b\x16.\x14> f\x00 @\x00\x01P\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\n\xc4\x01\xc6\x01\xca\x01\xce\x01\xd2\x01\xd2\x01\xe4\x01\xe6\x01\xee\x01\xee\x01\x00\x00\x00\x00\x00\x80\x01\x00\x00\x00\x02pr\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\n\x02\x00\x00\x00 \x00\x00\x02\x04\x00\xc6\x01\x00\x00\x00\x00\x02\x06\x00\xdc\x01\x00\x00\x00\x00\x02\b\x00\xea\x01\x00\x00\x00\x00\x02\n\x00\xcc\x01\x00\x00\x00\x00\x02\x04\x00\xde\x01\x00\x00\x00\x00\x02\x0e\x00\xe8\x01\x00\x00\x00\x00\x02\x10\x00\xde\x01\x00\x00\x00\x00\x02\x12\x00\xce\x01\x00\x00\x00\x00\b\x14\f\x00\x00\x00\x00\x00\x02\x04\x00\xdc\x01\x00\x00\x00\x00\x02\x18\x00\xe4\x01\x00\x00\x00\x00\x02\x1a\x00\xea\x01\x00\x00\x00\x00\x02\x1c\x00\xe8\x01\x00\x00\x00\x00\x02\x1e\x00\xca\x01\x00\x00\x00\x00\x02 \x00\xe4\x01\x00\x00\x00\x00\b\"\x16\x00\x00\x00\x00\x00\x02\x04\x00\xd6\x01\x00\x00\x00\x00\x02&\x00\xc2\x01\x00\x00\x00\x00\x02(\x00\xca\x01\x00\x00\x00\x00\x02*\x00\xe4\x01\x00\x00\x00\x00\x02,\x00\xc4\x01\x00\x00\x00\x00\b.$\x00\x00\x00\x00\x00\x02\x04\x00\xca\x01\x00\x00\x00\x00\x022\x00\xe6\x01\x00\x00\x00\x00\x024\x00\xc2\x01\x00\x00\x00\x00\x026\x00\xc6\x01\x00\x00\x00\x00\b80\x00\x00\x00\x00\x00\x02\x04\x00\xd0\x01\x00\x00\x00\x00\x02<\x00\xc6\x01\x00\x00\x00\x00\x02>\x00\xe8\x01\x00\x00\x00\x00\x02@\x00\xd2\x01\x00\x00\x00\x00\x02B\x00\xee\x01\x00\x00\x00\x00\x02D\x00\xe6\x01\x00\x00\x00\x00\bF:\x00\x00\x00\x00\x00\x02\x04\x00\xca\x01\x00\x00\x00\x00\x02J\x00\xd8\x01\x00\x00\x00\x00\x02L\x00\xd2\x01\x00\x00\x00\x00\x02N\x00\xd0\x01\x00\x00\x00\x00\x02P\x00\xee\x01\x00\x00\x00\x00\bRH\x00\x00\x00\x00\x00\x02\x04\x00\xe4\x01\x00\x00\x00\x00\x02V\x00\xde\x01\x00\x00\x00\x00\x02X\x00\xcc\x01\x00\x00\x00\x00\bZT\x00\x00\x00\x00\x00\x02\x04\x00\xca\x01\x00\x00\x00\x00\x02^\x00\xe6\x01\x00\x00\x00\x00\x02`\x00\xd8\x01\x00\x00\x00\x00\x02b\x00\xca\x01\x00\x00\x00\x00\bd\\\x00\x00\x00\x00\x00\x02\x04\x00\xcc\x01\x00\x00\x00\x00\x02h\x00\xd2\x01\x00\x00\x00\x00\bjf\x00\x00\x00\x00\x00\nl\x00\x00\x00 \x00\x00\x10n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x14\x04\xd2\x01\xcc\x01\b\xca\x01\xd8\x01\xe6\x01\xca\x01\x06\xcc\x01\xde\x01\xe4\x01\n\xee\x01\xd0\x01\xd2\x01\xd8\x01\xca\x01\f\xe6\x01\xee\x01\xd2\x01\xe8\x01\xc6\x01\xd0\x01\b\xc6\x01\xc2\x01\xe6\x01\xca\x01\n\xc4\x01\xe4\x01\xca\x01\xc2\x01\xd6\x01\f\xe4\x01\xca\x01\xe8\x01\xea\x01\xe4\x01\xdc\x01\b\xce\x01\xde\x01\xe8\x01\xde\x01\b\xcc\x01\xea\x01\xdc\x01\xc6\x01\x00\x00\x00"), matchKeywords)
+
+// KeywordsCI matches `(?:select|from|where|group|order|having|limit|offset)\s`
+var KeywordsCI = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x02\xda\xff\x80\x017(?:select|from|where|group|order|having|limit|offset)\\s\x01\x02\a\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x02\x88regexp2\x00\b\x16.\x14>f\x00\x96\b\x00@\x00\x01P\x00\x02\x00\x00\x00\x02\x02 \x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\n\xcc\x01\xd0\x01\xd8\x01\xd8\x01\xde\x01\xde\x01\xe6\x01\xe6\x01\xee\x01\xee\x01\x00\x00\x00\x02\x00\x00\x00\x00\x00\x02hj\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x06\x02\x00\x00\x02\x00\x00\x00\x02\x02 \x00\x00\x00\x00\x02\x00\x02\x04\x00\xe8\x01\x00\x00\x02\x00\x02\x06\x00\xca\x01\x00\x00\x02\x00\x02\b\x00\xe6\x01\x00\x00\x02\x00\x02\n\x00\xcc\x01\x00\x00\x02\x00\x02\f\x00\xcc\x01\x00\x00\x02\x00\x02\x0e\x00\xde\x01\x00\x00\x02\x00\x02\x04\x00\xe8\x01\x00\x00\x02\x00\x02\x12\x00\xd2\x01\x00\x00\x02\x00\x02\x14\x00\xda\x01\x00\x00\x02\x00\x02\x16\x00\xd2\x01\x00\x00\x02\x00\x02\x18\x00\xd8\x01\x00\x00\x02\x00\b\x1a\x10\x00\x00\x00\x00\x00\x02\x04\x00\xce\x01\x00\x00\x02\x00\x02\x1e\x00\xdc\x01\x00\x00\x02\x00\x02 \x00\xd2\x01\x00\x00\x02\x00\x02\"\x00\xec\x01\x00\x00\x02\x00\x02$\x00\xc2\x01\x00\x00\x02\x00\x02&\x00\xd0\x01\x00\x00\x02\x00\b(\x1c\x00\x00\x00\x00\x00\x02\x04\x00\xe4\x01\x00\x00\x02\x00\x02,\x00\xca\x01\x00\x00\x02\x00\x02.\x00\xc8\x01\x00\x00\x02\x00\x020\x00\xe4\x01\x00\x00\x02\x00\x022\x00\xde\x01\x00\x00\x02\x00\b4*\x00\x00\x00\x00\x00\x02\x04\x00\xe0\x01\x00\x00\x02\x00\x028\x00\xea\x01\x00\x00\x02\x00\x02:\x00\xde\x01\x00\x00\x02\x00\x02<\x00\xe4\x01\x00\x00\x02\x00\x02>\x00\xce\x01\x00\x00\x02\x00\b@6\x00\x00\x00\x00\x00\x02\x04\x00\xca\x01\x00\x00\x02\x00\x02D\x00\xe4\x01\x00\x00\x02\x00\x02F\x00\xca\x01\x00\x00\x02\x00\x02H\x00\xd0\x01\x00\x00\x02\x00\x02J\x00\xee\x01\x00\x00\x02\x00\bLB\x00\x00\x00\x00\x00\x02\x04\x00\xda\x01\x00\x00\x02\x00\x02P\x00\xde\x01\x00\x00\x02\x00\x02R\x00\xe4\x01\x00\x00\x02\x00\x02T\x00\xcc\x01\x00\x00\x02\x00\bVN\x00\x00\x00\x00\x00\x02\x04\x00\xe8\x01\x00\x00\x02\x00\x02Z\x00\xc6\x01\x00\x00\x02\x00\x02\\\x00\xca\x01\x00\x00\x02\x00\x02^\x00\xd8\x01\x00\x00\x02\x00\x02`\x00\xca\x01\x00\x00\x02\x00\x02b\x00\xe6\x01\x00\x00\x02\x00\bdX\x00\x00\x00\x00\x00\x10f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x10\f\xe6\x01\xca\x01\xd8\x01\xca\x01\xc6\x01\xe8\x01\b\xcc\x01\xe4\x01\xde\x01\xda\x01\n\xee\x01\xd0\x01\xca\x01\xe4\x01\xca\x01\n\xce\x01\xe4\x01\xde\x01\xea\x01\xe0\x01\n\xde\x01\xe4\x01\xc8\x01\xca\x01\xe4\x01\f\xd0\x01\xc2\x01\xec\x01\xd2\x01\xdc\x01\xce\x01\n\xd8\x01\xd2\x01\xda\x01\xd2\x01\xe8\x01\f\xde\x01\xcc\x01\xcc\x01\xe6\x01\xca\x01\xe8\x01\x02\x00\x00"), matchKeywordsCI)
+
+// Anchors matches `^\s*#.*$`
+var Anchors = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xcc\xff\x80\x01\b^\\s*#.*$\x01\x04\x04\x01\x01#\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\xa6regexp2\x00\b\". >\x1c\n\x00\xfe\xff\xff\xff\x0f\x12F\b\x14\xfe\xff\xff\xff\x0f\x1e@\x00\x01P\x00\x02\x00\x00\x00\x02\x02 \x00\x00\x00\n\x00\x00\x02\x02\x00\x00\x00\x02FF\x02\x02 \x00\x00\x00\x00\x00\x04\x00\x00\x00\x02\x12\x14\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\n\x02\x00\x00\x00\x1e\x00\x00\b\b\x04\x00\x00\x00\x00\x00\x04\x06\x00\x14\x00\x00\x00\x00\x02\x06\x00F\x00\x00\x00\x00\b\x0e\n\x00\x00\x00\x00\x00\x06\f\x00\x00\x02\x00\x00\x00\x02\x02 \x00\x00\x00\x00\x00\x00\n\f\x00\x00\x00\x1c\x00\x00\x10\x10\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchAnchors)
+
+// EndZ matches `foo\Z`
+var EndZ = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xa6\xff\x80\x01\x05foo\\Z\x04\x03foo\x01\x01\x03foo\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\x7fregexp2\x00\b\x14.\x12>\x18\x00(@\x00\x01P\x02\x06\xcc\x01\xde\x01\xde\x01\x00\x06\x00\x00\x02\x02\x00\x00\x00\x02\xcc\x01\xcc\x01\x00\x00\x00\x00\x02\x06\xcc\x01\xde\x01\xde\x01\x00\x00\x00\x00\x00\x00\x02\f\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\n\x02\x00\x00\x00(\x00\x00\x02\x04\x00\xde\x01\x00\x00\x00\x00\x02\x06\x00\xde\x01\x00\x00\x00\x00\x02\b\x00\xcc\x01\x00\x00\x00\x00\x10\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchEndZ)
+
+// Start matches `\Gab`
+var Start = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\x96\xff\x80\x01\x04\\Gab\x04\x02ab\x01\x01\x02ab\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04rregexp2\x00\b\x14.\x12>&\x18\x00@\x00\x01P\x02\x04\xc2\x01\xc4\x01\x00\x06\x00\x00\x02\x02\x00\x00\x00\x02\xc2\x01\xc2\x01\x00\x00\x00\x00\x02\x04\xc2\x01\xc4\x01\x00\x00\b\x00\x00\x00\x02\n\f\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00\xc4\x01\x00\x00\x00\x00\x02\x04\x00\xc2\x01\x00\x00\x00\x00\n\x06\x00\x00\x00&\x00\x00\x10\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchStart)
+
+// Lines matches `a.*z`
+var Lines = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xba\xff\x80\x01\x04a.*z\x01 \x03\x01a\x01\x02\x01a\x01z\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\x93regexp2\x00\b\x1c.\x1a>\x12\xc2\x01\n\x00\xfe\xff\xff\xff\x0f\x12\xf4\x01@\x00\x01P\x00\x02\x00\x00\x02\x00\xfe\xff\x87\x01\x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\x02\xc2\x01\xc2\x01\x00\x00\x00\x00\x02\x02\xc2\x01\x00\x00\x00\x00\x00\x00\x02\f\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00\xf4\x01\x00\x00\x00\x00\b\b\x04\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x02\x00\xfe\xff\x87\x01\x00\x00\x00\x00\x00\x00\x02\x06\x00\xc2\x01\x00\x00\x00\x00\x10\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchLines)
+
+// Loop matches `(?:a|b?)*c`
+var Loop = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00g\xff\x80\x01\n(?:a|b?)*c\x05\x01\x01c\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Bregexp2\x00\b..,><L\x1e.\x18\x12\xc2\x01L\x1e\x06\xc4\x01\x020\f\x12\xc6\x01@\x00\x01P\x00\x00\x10\x00\x00\x02\x02\x00\x00\x00\x02\xc2\x01\xc6\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchLoop)
+
+// LazyCount matches `(?:x|y){3,}?z`
+var LazyCount = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xe3\xff\x80\x01\r(?:x|y){3,}?z\x05\x01\x01z\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\xbaregexp2\x00\b .\x1e>6\x03\x16\x00:\n\xfe\xff\xff\xff\x0f\x12\xf4\x01@\x00\x01P\x00\x02\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\n\x00\x00\x02\x02\x00\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x10\x12\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00\xf4\x01\x00\x00\x00\x00\b\x04\b\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\x00\x00\x00\x06\n\x00\x00\x02\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\x00\x00\x00\x06\f\x00\x00\x02\x00\x00\x02\xf0\x01\xf2\x01\x00\x00\x00\x00\x00\x00\x10\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchLazyCount)
+
+// Counted matches `(\d{1,3})(?:,(\d{3}))*`
+var Counted = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01F\xff\x80\x01\x16(\\d{1,3})(?:,(\\d{3}))*\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x01\x17regexp2\x00\b>.<>>\x04\x00\x02\n\x00\x04@\x02\x01<L2\x12X>\x04\x00\x06@\x04\x010 @\x00\x01P\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x14\x00\x00\x06\x02\x00\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02 \"\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\b\x10\x02\x00\x00\x00\x00\x00\x10\x04\x00\x00\x00\x00\x00\n\x06\x06\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\b\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x06\n\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x10\f\x00\x00\x00\x00\x00\b\x02\x0e\x00X\x00\x00\x00\x00\x10\x04\x00\x00\x00\x00\x00\x06\x06\x12\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\b\x14\x12\x00\x00\x00\x00\x00\x06\x16\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\b\x18\x12\x00\x00\x00\x00\x00\x06\x1a\x00\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x10\x1c\x00\x00\x00\x00\x00\x04\x10\x1e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchCounted)
+
+// ECMA matches `(a)?\1b`
+var ECMA = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00i\xff\x80\x01\a(a)?\\1b\x01\xfe\x02\x00\x04\x01\x01b\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Cregexp2\x00\b0..>4\x00L\x1a>\x12\xc2\x01@\x02\x018\x0e\x02\x1a\x02\x12\xc4\x01@\x00\x01P\x00\x00\x10\x00\x00\x04\x02\x00\x00\x00\x02\x00\xfe\xff\x87\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchECMA)
+
+// Unicode matches `\p{Lu}\p{Ll}+|[^\x00-\x7f]+`
+var Unicode = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01\x17\xff\x80\x01\x1b\\p{Lu}\\p{Ll}+|[^\\x00-\\x7f]+\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\xe4regexp2\x00\b2.0>.\x1e\x16\x00\x04\x02\x02\n\x02\xfe\xff\xff\xff\x0fL*\x04\x04\x02\n\x04\xfe\xff\xff\xff\x0f@\x00\x01P\x00\x06\x00\x00\x00\x02\x04Lu\x00\x00\x00\x00\x00\x00\x02\x04Ll\x00\x00\x00\x02\x00\x02\x00\xfe\x01\x00\x00\x00\x0e\x00\x00\x02\x00\x00\x00\x00\x00\x00\x02\x14\x16\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\b\x06\x02\x00\x00\x00\x00\x00\x06\x04\x00\x00\x02\x02\x00\x02\x00\xfe\x01\x00\x00\x00\x00\x00\x00\x06\x04\x00\x00\x02\x02\x00\x02\x00\xfe\x01\x00\x00\x00\x00\x00\x00\b\f\x02\x00\x00\x00\x00\x00\x06\n\x00\x00\x02\x00\x00\x00\x02\x04Ll\x00\x00\x00\x00\x00\x00\x06\n\x00\x00\x02\x00\x00\x00\x02\x04Ll\x00\x00\x00\x00\x00\x00\x06\x0e\x00\x00\x02\x00\x00\x00\x02\x04Lu\x00\x00\x00\x00\x00\x00\b\x10\b\x00\x00\x00\x00\x00\x10\x12\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchUnicode)
+
+// NotOne matches `"[^"\n]*"`
+var NotOne = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\xb2\xff\x80\x01\t\"[^\"\\n]*\"\x04\x01\"\x01\x01\x01\"\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xff\x8aregexp2\x00\b\x1c.\x1a>\x12D\n\x00\xfe\xff\xff\xff\x0f\x12D@\x00\x01P\x00\x02\x02\x00\x04\x14\x14DD\x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\x02DD\x00\x00\x00\x00\x02\x02D\x00\x00\x00\x00\x00\x00\x02\f\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x02\x02\x00D\x00\x00\x00\x00\b\b\x04\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x02\x00\x04\x14\x14DD\x00\x00\x00\x00\x00\x00\x02\x06\x00D\x00\x00\x00\x00\x10\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchNotOne)
+
+// Grapheme matches `\X\X`
+var Grapheme = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00M\xff\x80\x01\x04\\X\\X\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x042regexp2\x00\b\x12.\x10>\\\\@\x00\x01P\x00\x00\x06\x00\x00\x02\x02\x00\x00\x00\x02\x00\xfe\xff\x87\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchGrapheme)
+
+// WordSeg matches `\b{wb}\w+\b{wb}`
+var WordSeg = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00i\xff\x80\x01\x0f\\b{wb}\\w+\\b{wb}\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Cregexp2\x00\b\x1e.\x1c>^\x04\x00\x02\n\x00\xfe\xff\xff\xff\x0f^@\x00\x01P\x00\x02\x00\x00\x00\x02\x02W\x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\x00\x02\x02W\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchWordSeg)
+
+// Sets matches `[aeiou][^aeiou\s]{2}[0-9a-fA-F]`
+var Sets = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xfe\x01c\xff\x80\x01\x1f[aeiou][^aeiou\\s]{2}[0-9a-fA-F]\b\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04\xfe\x01+regexp2\x00\b\x1c.\x1a>\x16\x00\x04\x02\x04\x16\x04@\x00\x01P\x00\x06\x00\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x00\x00\x00\x02\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x02\x02 \x00\x00\x00\x00\x00\x06`r\x82\x01\x8c\x01\xc2\x01\xcc\x01\x00\x00\x00\x06\x00\x00\x02\x02\x00\x00\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\f\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x00\x00\x00\x02\x06\x02\x00\x00\x02\x00\x00\x06`r\x82\x01\x8c\x01\xc2\x01\xcc\x01\x00\x00\x00\x00\x00\x00\x06\x04\x00\x00\x02\x02\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x02\x02 \x00\x00\x00\x00\x00\x00\x06\x06\x00\x00\x02\x02\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x02\x02 \x00\x00\x00\x00\x00\x00\x06\b\x00\x00\x02\x00\x00\n\xc2\x01\xc2\x01\xca\x01\xca\x01\xd2\x01\xd2\x01\xde\x01\xde\x01\xea\x01\xea\x01\x00\x00\x00\x00\x00\x00\x10\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), matchSets)
+
+// Recursive matches `\((?:[^()]|(?R))*\)`
+var Recursive = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00\xff\x80\xff\x80\x01\x13\\((?:[^()]|(?R))*\\)\x04\x01(\x01\x02\x01(\x01)\x03\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Mregexp2\x00\b6.4>\x12P<L\".\x1c\x16\x00L\"V\x04\x000\x10\x12R@\x00\x01X\x00P\x00\x02\x02\x00\x02PR\x00\x00\x00\x12\x00\x00\x02\x02\x00\x00\x00\x02PP\x00\x00\x00\x00\x02\x02P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00"), nil)
+
+// RTL matches `\d+`
+var RTL = regexp2.MustLoadGenerated([]byte("\xfe\x01\x06\x7f\x03\x01\x01\nregexpData\x01\xff\x80\x00\x01\x11\x01\aPattern\x01\f\x00\x01\aOptions\x01\x04\x00\x01\bCapnames\x01\xff\x84\x00\x01\bCapslist\x01\xff\x86\x00\x01\x06Prefix\x01\f\x00\x01\bLiterals\x01\xff\x86\x00\x01\aLongest\x01\x02\x00\x01\aCulture\x01\xff\x8c\x00\x01\rOptimizations\x01\x06\x00\x01\x06Joined\x01\xff\x8e\x00\x01\fMatchTimeout\x01\x04\x00\x01\bMaxSteps\x01\x04\x00\x01\x11MaxRecursionDepth\x01\x04\x00\x01\tMaxMemory\x01\x04\x00\x01\tStackSize\x01\x04\x00\x01\rDetachMatches\x01\x02\x00\x01\x04Code\x01\n\x00\x00\x00 \xff\x83\x02\x01\x01\x11[]regexp2.capname\x01\xff\x84\x00\x01\xff\x82\x00\x00(\xff\x81\x03\x01\x01\acapname\x01\xff\x82\x00\x01\x02\x01\x04Name\x01\f\x00\x01\x05Index\x01\x04\x00\x00\x00\x16\xff\x85\x02\x01\x01\b[]string\x01\xff\x86\x00\x01\f\x00\x00\x1a\xff\x8b\x02\x01\x01\vSpecialCase\x01\xff\x8c\x00\x01\xff\x88\x00\x000\xff\x87\x03\x01\x01\tCaseRange\x01\xff\x88\x00\x01\x03\x01\x02Lo\x01\x06\x00\x01\x02Hi\x01\x06\x00\x01\x05Delta\x01\xff\x8a\x00\x00\x00\x11\xff\x89\x01\x01\x01\x01d\x01\xff\x8a\x00\x01\x04\x01\x06\x00\x00\x13\xff\x8d\x02\x01\x01\x05[]int\x01\xff\x8e\x00\x01\x04\x00\x00b\xff\x80\x01\x03\\d+\x01\xff\x80\a\x0f\x02\xf8\xff\xff\xff\xff\xff\xff\xff\xfe\x02\xfe\a\xd0\x04Eregexp2\x00\b\x1a.\x18>\x84\x01\x00\x02\x8a\x01\x00\xfe\xff\xff\xff\x0f@\x00\x01P\x00\x02\x00\x00\x00\x02\x04Nd\x00\x00\x00\b\x00\x00\x02\x02\x00\x00\x00\x00\x02\x04Nd\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x01\x00"), nil)
+
+func matchDate(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 33)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 3)
+	// 000004  Setrep(Set = [\p{Nd}], Rep = 4)
+	if end-pos < 4 {
+		goto backtrack
+	}
+	for i := 0; i < 4; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 4
+	// 000007 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 7)
+	stack = stack[:len(stack)-1]
+	// 000010  One(Ch = -)
+	if pos >= end || !(text[pos] == '-') {
+		goto backtrack
+	}
+	pos++
+	// 000012 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 12)
+	// 000013  Set(Set = [\p{Nd}])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000015  Set(Set = [\p{Nd}])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000017 *Capturemark(Index = 2)
+	in.Capture(2, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 17)
+	stack = stack[:len(stack)-1]
+	// 000020  One(Ch = -)
+	if pos >= end || !(text[pos] == '-') {
+		goto backtrack
+	}
+	pos++
+	// 000022 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 22)
+	// 000023  Set(Set = [\p{Nd}])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000025  Set(Set = [\p{Nd}])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000027 *Capturemark(Index = 3)
+	in.Capture(3, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 27)
+	stack = stack[:len(stack)-1]
+	// 000030 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 30)
+	stack = stack[:len(stack)-1]
+l33:
+	// 000033  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l33
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 12:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 17:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 22:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 27:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 30:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchWords(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 14)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Boundary()
+	if ((pos > 0 && syntax.IsWordChar(text[pos-1])) != (pos < end && syntax.IsWordChar(text[pos]))) == false {
+		goto backtrack
+	}
+	// 000004  Setrep(Set = [\w], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000007 *Setloop(Set = [\w], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 7)
+		}
+	}
+l10:
+	// 000010  Boundary()
+	if ((pos > 0 && syntax.IsWordChar(text[pos-1])) != (pos < end && syntax.IsWordChar(text[pos]))) == false {
+		goto backtrack
+	}
+	// 000011 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 11)
+	stack = stack[:len(stack)-1]
+l14:
+	// 000014  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l14
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 7)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l10
+	case 11:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchEmail(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 30)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Beginning()
+	if pos > 0 {
+		goto backtrack
+	}
+	// 000004  Setrep-Ci(Set = [%\+-\.0-9_a-z], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(syntax.CaseFold(text[pos+i]) == '%' || syntax.CaseFold(text[pos+i]) == '+' || (syntax.CaseFold(text[pos+i]) >= '-' && syntax.CaseFold(text[pos+i]) <= '.') || (syntax.CaseFold(text[pos+i]) >= '0' && syntax.CaseFold(text[pos+i]) <= '9') || syntax.CaseFold(text[pos+i]) == '_' || (syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z')) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000007 *Setloop-Ci(Set = [%\+-\.0-9_a-z], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && (syntax.CaseFold(text[pos+i]) == '%' || syntax.CaseFold(text[pos+i]) == '+' || (syntax.CaseFold(text[pos+i]) >= '-' && syntax.CaseFold(text[pos+i]) <= '.') || (syntax.CaseFold(text[pos+i]) >= '0' && syntax.CaseFold(text[pos+i]) <= '9') || syntax.CaseFold(text[pos+i]) == '_' || (syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z')) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 7)
+		}
+	}
+l10:
+	// 000010  One-Ci(Ch = @)
+	if pos >= end || !(syntax.CaseFold(text[pos]) == '@') {
+		goto backtrack
+	}
+	pos++
+	// 000012  Setrep-Ci(Set = [-\.0-9a-z], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !((syntax.CaseFold(text[pos+i]) >= '-' && syntax.CaseFold(text[pos+i]) <= '.') || (syntax.CaseFold(text[pos+i]) >= '0' && syntax.CaseFold(text[pos+i]) <= '9') || (syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z')) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000015 *Setloop-Ci(Set = [-\.0-9a-z], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && ((syntax.CaseFold(text[pos+i]) >= '-' && syntax.CaseFold(text[pos+i]) <= '.') || (syntax.CaseFold(text[pos+i]) >= '0' && syntax.CaseFold(text[pos+i]) <= '9') || (syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z')) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 15)
+		}
+	}
+l18:
+	// 000018  One-Ci(Ch = \.)
+	if pos >= end || !(syntax.CaseFold(text[pos]) == '.') {
+		goto backtrack
+	}
+	pos++
+	// 000020  Setrep-Ci(Set = [a-z], Rep = 2)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	for i := 0; i < 2; i++ {
+		if !(syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z') {
+			goto backtrack
+		}
+	}
+	pos += 2
+	// 000023 *Setloop-Ci(Set = [a-z], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && (syntax.CaseFold(text[pos+i]) >= 'a' && syntax.CaseFold(text[pos+i]) <= 'z') {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 23)
+		}
+	}
+l26:
+	// 000026  EndZ()
+	if end-pos > 1 || end-pos == 1 && text[pos] != '\n' {
+		goto backtrack
+	}
+	// 000027 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 27)
+	stack = stack[:len(stack)-1]
+l30:
+	// 000030  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l30
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 7)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l10
+	case 15:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 15)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l18
+	case 23:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 23)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l26
+	case 27:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchLazy(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 20)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  One(Ch = <)
+	if pos >= end || !(text[pos] == '<') {
+		goto backtrack
+	}
+	pos++
+	// 000005 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 5)
+	// 000006  Notonerep(Ch = \n, Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(text[pos+i] != 0xa) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000009 *Notonelazy(Ch = \n, Rep = inf)
+	if c := end - pos; c > 0 {
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		track = append(track, c-1, pos, 9)
+	}
+l12:
+	// 000012 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 12)
+	stack = stack[:len(stack)-1]
+	// 000015  One(Ch = >)
+	if pos >= end || !(text[pos] == '>') {
+		goto backtrack
+	}
+	pos++
+	// 000017 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 17)
+	stack = stack[:len(stack)-1]
+l20:
+	// 000020  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l20
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 5:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 9:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		i := track[len(track)-2]
+		track = track[:len(track)-2]
+		if !(text[pos] != 0xa) {
+			goto backtrack
+		}
+		pos++
+		if i > 0 {
+			track = append(track, i-1, pos, 9)
+		}
+		goto l12
+	case 12:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 17:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchRepeat(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 48)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Lazybranch(Addr = 26)
+	track = append(track, pos, 3)
+	// 000005 *Setcount(Value = -1)
+	stack = append(stack, pos, -1)
+	track = append(track, 5)
+l7:
+	// 000007 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 7)
+	// 000008 *Lazybranch(Addr = 14)
+	track = append(track, pos, 8)
+	// 000010  Multi(String = ab)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	if text[pos+0] != 'a' || text[pos+1] != 'b' {
+		goto backtrack
+	}
+	pos += 2
+	// 000012 *Goto(Addr = 16)
+	goto l16
+l14:
+	// 000014  Multi(String = cd)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	if text[pos+0] != 'c' || text[pos+1] != 'd' {
+		goto backtrack
+	}
+	pos += 2
+l16:
+	// 000016 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 16)
+	stack = stack[:len(stack)-1]
+	// 000019 *Lazybranchcount(Addr = 7, Limit = 2)
+	if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count < 0 {
+		track = append(track, mark, -20)
+		stack[len(stack)-2], stack[len(stack)-1] = pos, count+1
+		goto l7
+	} else {
+		track = append(track, mark, count, pos, 19)
+		stack = stack[:len(stack)-2]
+	}
+	// 000022  One(Ch = x)
+	if pos >= end || !(text[pos] == 'x') {
+		goto backtrack
+	}
+	pos++
+	// 000024 *Goto(Addr = 45)
+	goto l45
+l26:
+	// 000026 *Setcount(Value = 0)
+	stack = append(stack, pos, 0)
+	track = append(track, 26)
+l28:
+	// 000028 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 28)
+	// 000029 *Lazybranch(Addr = 35)
+	track = append(track, pos, 29)
+	// 000031  Multi(String = ab)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	if text[pos+0] != 'a' || text[pos+1] != 'b' {
+		goto backtrack
+	}
+	pos += 2
+	// 000033 *Goto(Addr = 37)
+	goto l37
+l35:
+	// 000035  Multi(String = cd)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	if text[pos+0] != 'c' || text[pos+1] != 'd' {
+		goto backtrack
+	}
+	pos += 2
+l37:
+	// 000037 *Capturemark(Index = 2)
+	in.Capture(2, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 37)
+	stack = stack[:len(stack)-1]
+	// 000040 *Branchcount(Addr = 28, Limit = 2)
+	if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count >= 2 || (pos == mark && count >= 0) {
+		track = append(track, mark, count, -41)
+		stack = stack[:len(stack)-2]
+	} else {
+		track = append(track, mark, 40)
+		stack[len(stack)-2], stack[len(stack)-1] = pos, count+1
+		goto l28
+	}
+l43:
+	// 000043  One(Ch = y)
+	if pos >= end || !(text[pos] == 'y') {
+		goto backtrack
+	}
+	pos++
+l45:
+	// 000045 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 45)
+	stack = stack[:len(stack)-1]
+l48:
+	// 000048  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -41:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-2], track[len(track)-1])
+		track = track[:len(track)-2]
+		goto backtrack
+	case -20:
+		track = track[:len(track)-1]
+		mark := track[len(track)-1]
+		track = track[:len(track)-1]
+		stack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l48
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l26
+	case 5:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 8:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l14
+	case 16:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 19:
+		track = track[:len(track)-1]
+		mark, count, p := track[len(track)-3], track[len(track)-2], track[len(track)-1]
+		track = track[:len(track)-3]
+		if count < 2 && p != mark {
+			pos = p
+			stack = append(stack, p, count+1)
+			track = append(track, mark, -20)
+			goto l7
+		}
+		stack = append(stack, mark, count)
+		goto backtrack
+	case 26:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 28:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 29:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l35
+	case 37:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 40:
+		track = track[:len(track)-1]
+		mark := track[len(track)-1]
+		track = track[:len(track)-1]
+		if count := stack[len(stack)-1]; count > 0 {
+			pos = stack[len(stack)-2]
+			stack = stack[:len(stack)-2]
+			track = append(track, mark, count-1, -41)
+			goto l43
+		}
+		stack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1
+		goto backtrack
+	case 45:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchNested(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 28)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 3)
+l4:
+	// 000004 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 4)
+	// 000005 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 5)
+	// 000006  Onerep(Ch = a, Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(text[pos+i] == 'a') {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000009 *Oneloop(Ch = a, Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && text[pos+i] == 'a' {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 9)
+		}
+	}
+l12:
+	// 000012 *Capturemark(Index = 2)
+	in.Capture(2, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 12)
+	stack = stack[:len(stack)-1]
+	// 000015 *Oneloop(Ch = b, Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && text[pos+i] == 'b' {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 15)
+		}
+	}
+l18:
+	// 000018 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 18)
+	stack = stack[:len(stack)-1]
+	// 000021 *Branchmark(Addr = 4)
+	if mark := stack[len(stack)-1]; pos != mark {
+		track = append(track, mark, pos, 21)
+		stack[len(stack)-1] = pos
+		goto l4
+	} else {
+		track = append(track, mark, -22)
+		stack = stack[:len(stack)-1]
+	}
+l23:
+	// 000023  One(Ch = c)
+	if pos >= end || !(text[pos] == 'c') {
+		goto backtrack
+	}
+	pos++
+	// 000025 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 25)
+	stack = stack[:len(stack)-1]
+l28:
+	// 000028  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -22:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l28
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 4:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 5:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 9:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 9)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l12
+	case 12:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 15:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 15)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l18
+	case 18:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 21:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		pos = track[len(track)-1]
+		track[len(track)-1] = -22
+		goto l23
+	case 25:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchBackref(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 24)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 3)
+	// 000004  Setrep(Set = [\w], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000007 *Setloop(Set = [\w], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 7)
+		}
+	}
+l10:
+	// 000010 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+	// 000013  Setrep(Set = [\s], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[1].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000016 *Setloop(Set = [\s], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[1].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 16)
+		}
+	}
+l19:
+	// 000019  Ref(Index = 1)
+	if index, length, ok := in.LastCapture(1); ok {
+		if end-pos < length {
+			goto backtrack
+		}
+		for i := 0; i < length; i++ {
+			if text[index+i] != text[pos+i] {
+				goto backtrack
+			}
+		}
+		pos += length
+	} else {
+		goto backtrack
+	}
+	// 000021 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 21)
+	stack = stack[:len(stack)-1]
+l24:
+	// 000024  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l24
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 7)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l10
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 16:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 16)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l19
+	case 21:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchBackrefCI(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 21)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 3)
+	// 000004  Set-Ci(Set = ["'])
+	if pos >= end || !(syntax.CaseFold(text[pos]) == '"' || syntax.CaseFold(text[pos]) == 0x27) {
+		goto backtrack
+	}
+	pos++
+	// 000006 *Capturemark(Index = 2)
+	in.Capture(2, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 6)
+	stack = stack[:len(stack)-1]
+	// 000009 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 9)
+	// 000010 *Notonelazy-Ci(Ch = \n, Rep = inf)
+	if c := end - pos; c > 0 {
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		track = append(track, c-1, pos, 10)
+	}
+l13:
+	// 000013 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 13)
+	stack = stack[:len(stack)-1]
+	// 000016  Ref-Ci(Index = 2)
+	if index, length, ok := in.LastCapture(2); ok {
+		if end-pos < length {
+			goto backtrack
+		}
+		for i := 0; i < length; i++ {
+			if syntax.CaseFold(text[index+i]) != syntax.CaseFold(text[pos+i]) {
+				goto backtrack
+			}
+		}
+		pos += length
+	} else {
+		goto backtrack
+	}
+	// 000018 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 18)
+	stack = stack[:len(stack)-1]
+l21:
+	// 000021  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l21
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 6:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 9:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 10:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		i := track[len(track)-2]
+		track = track[:len(track)-2]
+		if !(syntax.CaseFold(text[pos]) != 0xa) {
+			goto backtrack
+		}
+		pos++
+		if i > 0 {
+			track = append(track, i-1, pos, 10)
+		}
+		goto l13
+	case 13:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 18:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchAtomic(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 28)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Lazybranch(Addr = 17)
+	track = append(track, pos, 3)
+	// 000005 *Setjump()
+	stack = append(stack, len(track), len(in.Captures))
+	track = append(track, 5)
+	// 000006  Onerep(Ch = a, Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(text[pos+i] == 'a') {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000009 *Oneloop(Ch = a, Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && text[pos+i] == 'a' {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 9)
+		}
+	}
+l12:
+	// 000012 *Forejump()
+	track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], 12)
+	stack = stack[:len(stack)-2]
+	// 000013  One(Ch = b)
+	if pos >= end || !(text[pos] == 'b') {
+		goto backtrack
+	}
+	pos++
+	// 000015 *Goto(Addr = 25)
+	goto l25
+l17:
+	// 000017  Onerep(Ch = a, Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(text[pos+i] == 'a') {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000020 *Oneloop(Ch = a, Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && text[pos+i] == 'a' {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 20)
+		}
+	}
+l23:
+	// 000023  One(Ch = c)
+	if pos >= end || !(text[pos] == 'c') {
+		goto backtrack
+	}
+	pos++
+l25:
+	// 000025 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 25)
+	stack = stack[:len(stack)-1]
+l28:
+	// 000028  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l28
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l17
+	case 5:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 9:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 9)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l12
+	case 12:
+		track = track[:len(track)-1]
+		for len(in.Captures) != track[len(track)-1] {
+			in.Uncapture()
+		}
+		track = track[:len(track)-1]
+		goto backtrack
+	case 20:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 20)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l23
+	case 25:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchLookahead(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 35)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Lazybranch(Addr = 19)
+	track = append(track, pos, 3)
+	// 000005  Setrep(Set = [\w], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000008 *Setloop(Set = [\w], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 8)
+		}
+	}
+l11:
+	// 000011 *Setjump()
+	stack = append(stack, len(track), len(in.Captures))
+	track = append(track, 11)
+	// 000012 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 12)
+	// 000013  One(Ch = ,)
+	if pos >= end || !(text[pos] == ',') {
+		goto backtrack
+	}
+	pos++
+	// 000015 *Getmark()
+	track = append(track, stack[len(stack)-1], 15)
+	pos = stack[len(stack)-1]
+	stack = stack[:len(stack)-1]
+	// 000016 *Forejump()
+	track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], 16)
+	stack = stack[:len(stack)-2]
+	// 000017 *Goto(Addr = 32)
+	goto l32
+l19:
+	// 000019  Setrep(Set = [\w], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000022 *Setloop(Set = [\w], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 22)
+		}
+	}
+l25:
+	// 000025 *Setjump()
+	stack = append(stack, len(track), len(in.Captures))
+	track = append(track, 25)
+	// 000026 *Lazybranch(Addr = 31)
+	track = append(track, pos, 26)
+	// 000028  Set(Set = [\w])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000030 *Backjump()
+	track = track[:stack[len(stack)-2]]
+	for len(in.Captures) != stack[len(stack)-1] {
+		in.Uncapture()
+	}
+	stack = stack[:len(stack)-2]
+	goto backtrack
+l31:
+	// 000031 *Forejump()
+	track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], 31)
+	stack = stack[:len(stack)-2]
+l32:
+	// 000032 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 32)
+	stack = stack[:len(stack)-1]
+l35:
+	// 000035  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l35
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l19
+	case 8:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 8)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l11
+	case 11:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 12:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 15:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		goto backtrack
+	case 16:
+		track = track[:len(track)-1]
+		for len(in.Captures) != track[len(track)-1] {
+			in.Uncapture()
+		}
+		track = track[:len(track)-1]
+		goto backtrack
+	case 22:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 22)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l25
+	case 25:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 26:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l31
+	case 31:
+		track = track[:len(track)-1]
+		for len(in.Captures) != track[len(track)-1] {
+			in.Uncapture()
+		}
+		track = track[:len(track)-1]
+		goto backtrack
+	case 32:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchConditional(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 36)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Nullcount(Value = 0)
+	stack = append(stack, -1, 0)
+	track = append(track, 3)
+	// 000005 *Goto(Addr = 13)
+	goto l13
+l7:
+	// 000007 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 7)
+	// 000008  One(Ch = \()
+	if pos >= end || !(text[pos] == '(') {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+l13:
+	// 000013 *Branchcount(Addr = 7, Limit = 1)
+	if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count >= 1 || (pos == mark && count >= 0) {
+		track = append(track, mark, count, -14)
+		stack = stack[:len(stack)-2]
+	} else {
+		track = append(track, mark, 13)
+		stack[len(stack)-2], stack[len(stack)-1] = pos, count+1
+		goto l7
+	}
+l16:
+	// 000016  Setrep(Set = [\p{Nd}], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000019 *Setloop(Set = [\p{Nd}], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 19)
+		}
+	}
+l22:
+	// 000022 *Setjump()
+	stack = append(stack, len(track), len(in.Captures))
+	track = append(track, 22)
+	// 000023 *Lazybranch(Addr = 32)
+	track = append(track, pos, 23)
+	// 000025  Testref(Index = 1)
+	if _, _, ok := in.LastCapture(1); !ok {
+		goto backtrack
+	}
+	// 000027 *Forejump()
+	track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], 27)
+	stack = stack[:len(stack)-2]
+	// 000028  One(Ch = \))
+	if pos >= end || !(text[pos] == ')') {
+		goto backtrack
+	}
+	pos++
+	// 000030 *Goto(Addr = 33)
+	goto l33
+l32:
+	// 000032 *Forejump()
+	track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], 32)
+	stack = stack[:len(stack)-2]
+l33:
+	// 000033 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 33)
+	stack = stack[:len(stack)-1]
+l36:
+	// 000036  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -14:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-2], track[len(track)-1])
+		track = track[:len(track)-2]
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l36
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 13:
+		track = track[:len(track)-1]
+		mark := track[len(track)-1]
+		track = track[:len(track)-1]
+		if count := stack[len(stack)-1]; count > 0 {
+			pos = stack[len(stack)-2]
+			stack = stack[:len(stack)-2]
+			track = append(track, mark, count-1, -14)
+			goto l16
+		}
+		stack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1
+		goto backtrack
+	case 19:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 19)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l22
+	case 22:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 23:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l32
+	case 27:
+		track = track[:len(track)-1]
+		for len(in.Captures) != track[len(track)-1] {
+			in.Uncapture()
+		}
+		track = track[:len(track)-1]
+		goto backtrack
+	case 32:
+		track = track[:len(track)-1]
+		for len(in.Captures) != track[len(track)-1] {
+			in.Uncapture()
+		}
+		track = track[:len(track)-1]
+		goto backtrack
+	case 33:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchKeywords(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 10)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Boundary()
+	if ((pos > 0 && syntax.IsWordChar(text[pos-1])) != (pos < end && syntax.IsWordChar(text[pos]))) == false {
+		goto backtrack
+	}
+	// 000004 *Trie(Literals = if|else|for|while|switch|case|break|return|goto|func)
+	if b, e := in.Tries[0].Match(text, pos, -1); b < 0 {
+		goto backtrack
+	} else {
+		track = append(track, pos, b, 4)
+		pos = e
+	}
+l6:
+	// 000006  Boundary()
+	if ((pos > 0 && syntax.IsWordChar(text[pos-1])) != (pos < end && syntax.IsWordChar(text[pos]))) == false {
+		goto backtrack
+	}
+	// 000007 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 7)
+	stack = stack[:len(stack)-1]
+l10:
+	// 000010  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l10
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 4:
+		track = track[:len(track)-1]
+		if b, e := in.Tries[0].Match(text, track[len(track)-2], track[len(track)-1]); b < 0 {
+			track = track[:len(track)-2]
+		} else {
+			track[len(track)-1] = b
+			track = append(track, 4)
+			pos = e
+			goto l6
+		}
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchKeywordsCI(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 10)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Trie(Literals = (?i:select|from|where|group|order|having|limit|offset))
+	if b, e := in.Tries[0].Match(text, pos, -1); b < 0 {
+		goto backtrack
+	} else {
+		track = append(track, pos, b, 3)
+		pos = e
+	}
+l5:
+	// 000005  Set-Ci(Set = [\s])
+	if pos >= end || !(in.Sets[0].CharIn(syntax.CaseFold(text[pos]))) {
+		goto backtrack
+	}
+	pos++
+	// 000007 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 7)
+	stack = stack[:len(stack)-1]
+l10:
+	// 000010  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l10
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		if b, e := in.Tries[0].Match(text, track[len(track)-2], track[len(track)-1]); b < 0 {
+			track = track[:len(track)-2]
+		} else {
+			track[len(track)-1] = b
+			track = append(track, 3)
+			pos = e
+			goto l5
+		}
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchAnchors(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 16)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Bol()
+	if pos > 0 && text[pos-1] != '\n' {
+		goto backtrack
+	}
+	// 000004 *Setloop(Set = [\s], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 4)
+		}
+	}
+l7:
+	// 000007  One(Ch = \#)
+	if pos >= end || !(text[pos] == '#') {
+		goto backtrack
+	}
+	pos++
+	// 000009 *Notoneloop(Ch = \n, Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && text[pos+i] != 0xa {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 9)
+		}
+	}
+l12:
+	// 000012  Eol()
+	if pos < end && text[pos] != '\n' {
+		goto backtrack
+	}
+	// 000013 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 13)
+	stack = stack[:len(stack)-1]
+l16:
+	// 000016  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l16
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 4:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 4)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l7
+	case 9:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 9)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l12
+	case 13:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchEndZ(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 9)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Multi(String = foo)
+	if end-pos < 3 {
+		goto backtrack
+	}
+	if text[pos+0] != 'f' || text[pos+1] != 'o' || text[pos+2] != 'o' {
+		goto backtrack
+	}
+	pos += 3
+	// 000005  EndZ()
+	if end-pos > 1 || end-pos == 1 && text[pos] != '\n' {
+		goto backtrack
+	}
+	// 000006 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 6)
+	stack = stack[:len(stack)-1]
+l9:
+	// 000009  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l9
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 6:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchStart(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 9)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Start()
+	if pos != in.Start {
+		goto backtrack
+	}
+	// 000004  Multi(String = ab)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	if text[pos+0] != 'a' || text[pos+1] != 'b' {
+		goto backtrack
+	}
+	pos += 2
+	// 000006 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 6)
+	stack = stack[:len(stack)-1]
+l9:
+	// 000009  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l9
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 6:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchLines(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 13)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  One(Ch = a)
+	if pos >= end || !(text[pos] == 'a') {
+		goto backtrack
+	}
+	pos++
+	// 000005 *Setloop(Set = [\x00-\u10ffff], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && (text[pos+i] >= 0x0 && text[pos+i] <= 0x10ffff) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 5)
+		}
+	}
+l8:
+	// 000008  One(Ch = z)
+	if pos >= end || !(text[pos] == 'z') {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+l13:
+	// 000013  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l13
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 5:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 5)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l8
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchLoop(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 22)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Nullmark()
+	stack = append(stack, -1)
+	track = append(track, 3)
+	// 000004 *Goto(Addr = 15)
+	goto l15
+l6:
+	// 000006 *Lazybranch(Addr = 12)
+	track = append(track, pos, 6)
+	// 000008  One(Ch = a)
+	if pos >= end || !(text[pos] == 'a') {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Goto(Addr = 15)
+	goto l15
+l12:
+	// 000012 *Oneloop(Ch = b, Rep = 1)
+	{
+		c := end - pos
+		if c > 1 {
+			c = 1
+		}
+		i := 0
+		for i < c && text[pos+i] == 'b' {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 12)
+		}
+	}
+l15:
+	// 000015 *Branchmark(Addr = 6)
+	if mark := stack[len(stack)-1]; pos != mark {
+		track = append(track, mark, pos, 15)
+		stack[len(stack)-1] = pos
+		goto l6
+	} else {
+		track = append(track, mark, -16)
+		stack = stack[:len(stack)-1]
+	}
+l17:
+	// 000017  One(Ch = c)
+	if pos >= end || !(text[pos] == 'c') {
+		goto backtrack
+	}
+	pos++
+	// 000019 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 19)
+	stack = stack[:len(stack)-1]
+l22:
+	// 000022  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -16:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l22
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 6:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l12
+	case 12:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 12)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l15
+	case 15:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		pos = track[len(track)-1]
+		track[len(track)-1] = -16
+		goto l17
+	case 19:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchLazyCount(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 15)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setcount(Value = -2)
+	stack = append(stack, pos, -2)
+	track = append(track, 3)
+l5:
+	// 000005  Set(Set = [xy])
+	if pos >= end || !(text[pos] >= 'x' && text[pos] <= 'y') {
+		goto backtrack
+	}
+	pos++
+	// 000007 *Lazybranchcount(Addr = 5, Limit = inf)
+	if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count < 0 {
+		track = append(track, mark, -8)
+		stack[len(stack)-2], stack[len(stack)-1] = pos, count+1
+		goto l5
+	} else {
+		track = append(track, mark, count, pos, 7)
+		stack = stack[:len(stack)-2]
+	}
+	// 000010  One(Ch = z)
+	if pos >= end || !(text[pos] == 'z') {
+		goto backtrack
+	}
+	pos++
+	// 000012 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 12)
+	stack = stack[:len(stack)-1]
+l15:
+	// 000015  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -8:
+		track = track[:len(track)-1]
+		mark := track[len(track)-1]
+		track = track[:len(track)-1]
+		stack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l15
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		mark, count, p := track[len(track)-3], track[len(track)-2], track[len(track)-1]
+		track = track[:len(track)-3]
+		if count < 2147483647 && p != mark {
+			pos = p
+			stack = append(stack, p, count+1)
+			track = append(track, mark, -8)
+			goto l5
+		}
+		stack = append(stack, mark, count)
+		goto backtrack
+	case 12:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchCounted(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 30)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 3)
+	// 000004  Setrep(Set = [\p{Nd}], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000007 *Setloop(Set = [\p{Nd}], Rep = 2)
+	{
+		c := end - pos
+		if c > 2 {
+			c = 2
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 7)
+		}
+	}
+l10:
+	// 000010 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+	// 000013  Nullmark()
+	stack = append(stack, -1)
+	track = append(track, 13)
+	// 000014 *Goto(Addr = 25)
+	goto l25
+l16:
+	// 000016  One(Ch = ,)
+	if pos >= end || !(text[pos] == ',') {
+		goto backtrack
+	}
+	pos++
+	// 000018 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 18)
+	// 000019  Setrep(Set = [\p{Nd}], Rep = 3)
+	if end-pos < 3 {
+		goto backtrack
+	}
+	for i := 0; i < 3; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 3
+	// 000022 *Capturemark(Index = 2)
+	in.Capture(2, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 22)
+	stack = stack[:len(stack)-1]
+l25:
+	// 000025 *Branchmark(Addr = 16)
+	if mark := stack[len(stack)-1]; pos != mark {
+		track = append(track, mark, pos, 25)
+		stack[len(stack)-1] = pos
+		goto l16
+	} else {
+		track = append(track, mark, -26)
+		stack = stack[:len(stack)-1]
+	}
+l27:
+	// 000027 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 27)
+	stack = stack[:len(stack)-1]
+l30:
+	// 000030  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -26:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l30
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 7)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l10
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 13:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 18:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 22:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 25:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		pos = track[len(track)-1]
+		track[len(track)-1] = -26
+		goto l27
+	case 27:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchECMA(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 23)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Nullcount(Value = 0)
+	stack = append(stack, -1, 0)
+	track = append(track, 3)
+	// 000005 *Goto(Addr = 13)
+	goto l13
+l7:
+	// 000007 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 7)
+	// 000008  One(Ch = a)
+	if pos >= end || !(text[pos] == 'a') {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Capturemark(Index = 1)
+	in.Capture(1, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+l13:
+	// 000013 *Branchcount(Addr = 7, Limit = 1)
+	if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count >= 1 || (pos == mark && count >= 0) {
+		track = append(track, mark, count, -14)
+		stack = stack[:len(stack)-2]
+	} else {
+		track = append(track, mark, 13)
+		stack[len(stack)-2], stack[len(stack)-1] = pos, count+1
+		goto l7
+	}
+l16:
+	// 000016  Ref(Index = 1)
+	if index, length, ok := in.LastCapture(1); ok {
+		if end-pos < length {
+			goto backtrack
+		}
+		for i := 0; i < length; i++ {
+			if text[index+i] != text[pos+i] {
+				goto backtrack
+			}
+		}
+		pos += length
+	}
+	// 000018  One(Ch = b)
+	if pos >= end || !(text[pos] == 'b') {
+		goto backtrack
+	}
+	pos++
+	// 000020 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 20)
+	stack = stack[:len(stack)-1]
+l23:
+	// 000023  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case -14:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-2], track[len(track)-1])
+		track = track[:len(track)-2]
+		goto backtrack
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l23
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-2]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	case 13:
+		track = track[:len(track)-1]
+		mark := track[len(track)-1]
+		track = track[:len(track)-1]
+		if count := stack[len(stack)-1]; count > 0 {
+			pos = stack[len(stack)-2]
+			stack = stack[:len(stack)-2]
+			track = append(track, mark, count-1, -14)
+			goto l16
+		}
+		stack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1
+		goto backtrack
+	case 20:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchUnicode(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 24)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003 *Lazybranch(Addr = 15)
+	track = append(track, pos, 3)
+	// 000005  Set(Set = [\p{Lu}])
+	if pos >= end || !(in.Sets[0].CharIn(text[pos])) {
+		goto backtrack
+	}
+	pos++
+	// 000007  Setrep(Set = [\p{Ll}], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[1].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000010 *Setloop(Set = [\p{Ll}], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[1].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 10)
+		}
+	}
+l13:
+	// 000013 *Goto(Addr = 21)
+	goto l21
+l15:
+	// 000015  Setrep(Set = [^\x00-\x7f], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(!(text[pos+i] >= 0x0 && text[pos+i] <= 0x7f)) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000018 *Setloop(Set = [^\x00-\x7f], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && !(text[pos+i] >= 0x0 && text[pos+i] <= 0x7f) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 18)
+		}
+	}
+l21:
+	// 000021 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 21)
+	stack = stack[:len(stack)-1]
+l24:
+	// 000024  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l24
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 3:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l15
+	case 10:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 10)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l13
+	case 18:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 18)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l21
+	case 21:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchNotOne(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 13)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  One(Ch = ")
+	if pos >= end || !(text[pos] == '"') {
+		goto backtrack
+	}
+	pos++
+	// 000005 *Setloop(Set = [^\n"], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && !(text[pos+i] == 0xa || text[pos+i] == '"') {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 5)
+		}
+	}
+l8:
+	// 000008  One(Ch = ")
+	if pos >= end || !(text[pos] == '"') {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+l13:
+	// 000013  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l13
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 5:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 5)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l8
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchGrapheme(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 8)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Grapheme()
+	if pos >= end {
+		goto backtrack
+	}
+	pos = syntax.NextGraphemeBoundary(text, pos)
+	// 000004  Grapheme()
+	if pos >= end {
+		goto backtrack
+	}
+	pos = syntax.NextGraphemeBoundary(text, pos)
+	// 000005 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 5)
+	stack = stack[:len(stack)-1]
+l8:
+	// 000008  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l8
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 5:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchWordSeg(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 14)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  WordSegBoundary()
+	if syntax.IsWordSegBoundary(text, pos) != true {
+		goto backtrack
+	}
+	// 000004  Setrep(Set = [\w], Rep = 1)
+	if end-pos < 1 {
+		goto backtrack
+	}
+	for i := 0; i < 1; i++ {
+		if !(in.Sets[0].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 1
+	// 000007 *Setloop(Set = [\w], Rep = inf)
+	{
+		c := end - pos
+		if c > 2147483647 {
+			c = 2147483647
+		}
+		i := 0
+		for i < c && in.Sets[0].CharIn(text[pos+i]) {
+			i++
+		}
+		pos += i
+		if i > 0 {
+			track = append(track, i-1, pos-1, 7)
+		}
+	}
+l10:
+	// 000010  WordSegBoundary()
+	if syntax.IsWordSegBoundary(text, pos) != true {
+		goto backtrack
+	}
+	// 000011 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 11)
+	stack = stack[:len(stack)-1]
+l14:
+	// 000014  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l14
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 7:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		if i := track[len(track)-2]; i > 0 {
+			track[len(track)-2], track[len(track)-1] = i-1, pos-1
+			track = append(track, 7)
+		} else {
+			track = track[:len(track)-2]
+		}
+		goto l10
+	case 11:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
+
+func matchSets(in *regexp2.GeneratedInput, pos int) bool {
+	text := in.Text
+	end := len(text)
+	track, stack := in.Track[:0], in.Stack[:0]
+	_ = end
+	in.Captures = in.Captures[:0]
+
+	// 000000 *Lazybranch(Addr = 13)
+	track = append(track, pos, 0)
+	// 000002 *Setmark()
+	stack = append(stack, pos)
+	track = append(track, 2)
+	// 000003  Set(Set = [aeiou])
+	if pos >= end || !(text[pos] == 'a' || text[pos] == 'e' || text[pos] == 'i' || text[pos] == 'o' || text[pos] == 'u') {
+		goto backtrack
+	}
+	pos++
+	// 000005  Setrep(Set = [^aeiou\s], Rep = 2)
+	if end-pos < 2 {
+		goto backtrack
+	}
+	for i := 0; i < 2; i++ {
+		if !(in.Sets[1].CharIn(text[pos+i])) {
+			goto backtrack
+		}
+	}
+	pos += 2
+	// 000008  Set(Set = [0-9A-Fa-f])
+	if pos >= end || !((text[pos] >= '0' && text[pos] <= '9') || (text[pos] >= 'A' && text[pos] <= 'F') || (text[pos] >= 'a' && text[pos] <= 'f')) {
+		goto backtrack
+	}
+	pos++
+	// 000010 *Capturemark(Index = 0)
+	in.Capture(0, stack[len(stack)-1], pos)
+	track = append(track, stack[len(stack)-1], 10)
+	stack = stack[:len(stack)-1]
+l13:
+	// 000013  Stop()
+	in.Track, in.Stack = track, stack
+	return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0
+
+backtrack:
+	if len(track) == 0 {
+		in.Track, in.Stack = track, stack
+		return false
+	}
+	switch track[len(track)-1] {
+	case 0:
+		track = track[:len(track)-1]
+		pos = track[len(track)-1]
+		track = track[:len(track)-1]
+		goto l13
+	case 2:
+		track = track[:len(track)-1]
+		stack = stack[:len(stack)-1]
+		goto backtrack
+	case 10:
+		track = track[:len(track)-1]
+		stack = append(stack, track[len(track)-1])
+		track = track[:len(track)-1]
+		in.Uncapture()
+		goto backtrack
+	}
+	panic("regexp2: bad backtrack")
+}
@@ -0,0 +1,219 @@
+package regexp2
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// GlobPattern returns a pattern that matches the paths the shell-style glob
+// does.  * matches any run of runes but /, ? any one rune but /, and
+// [abc], [a-z] and [!abc] or [^abc] one rune of a set, which may use
+// POSIX classes like [[:digit:]].  ** as a whole path segment matches any
+// number of segments, so a/**/b matches a/b and a/x/y/b, and a/** all
+// that's under a.  {a,b,c} matches any of the globs between its commas,
+// and a backslash makes the rune after it literal.  A [ or { with no
+// match in the glob is literal too, as it is in the shell.  The pattern
+// is anchored at both ends.
+func GlobPattern(glob string) (string, error) {
+	g := &globParser{s: []rune(glob)}
+	var b strings.Builder
+	b.WriteString(`\A(?:`)
+	if err := g.seq(&b, -1); err != nil {
+		return "", err
+	}
+	b.WriteString(`)\z`)
+	return b.String(), nil
+}
+
+// CompileGlob compiles the glob, as GlobPattern translates it, with opt.
+func CompileGlob(glob string, opt RegexOptions) (*Regexp, error) {
+	expr, err := GlobPattern(glob)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(expr, opt)
+}
+
+type globParser struct {
+	s []rune
+	i int
+}
+
+// the runes of the POSIX classes a glob's sets can use
+var globClasses = map[string]string{
+	"alnum":  `0-9A-Za-z`,
+	"alpha":  `A-Za-z`,
+	"blank":  `\t `,
+	"cntrl":  `\x00-\x1f\x7f`,
+	"digit":  `0-9`,
+	"graph":  `!-~`,
+	"lower":  `a-z`,
+	"print":  ` -~`,
+	"punct":  "!-/:-@\\[-`{-~",
+	"space":  `\t-\r `,
+	"upper":  `A-Z`,
+	"word":   `0-9A-Z_a-z`,
+	"xdigit": `0-9A-Fa-f`,
+}
+
+// seq writes the glob up to end, or the end of the glob if end is -1
+func (g *globParser) seq(b *strings.Builder, end int) error {
+	for g.i < len(g.s) && g.i != end {
+		switch r := g.s[g.i]; r {
+		case '\\':
+			if g.i+1 == len(g.s) {
+				return errors.New("regexp2: glob ends with a backslash")
+			}
+			b.WriteString(syntax.Escape(string(g.s[g.i+1])))
+			g.i += 2
+		case '*':
+			g.star(b)
+		case '?':
+			b.WriteString(`[^/]`)
+			g.i++
+		case '[':
+			if err := g.class(b); err != nil {
+				return err
+			}
+		case '{':
+			if err := g.braces(b); err != nil {
+				return err
+			}
+		default:
+			b.WriteString(syntax.Escape(string(r)))
+			g.i++
+		}
+	}
+	return nil
+}
+
+// star writes a run of *s, which is ** if it's a whole path segment
+func (g *globParser) star(b *strings.Builder) {
+	start := g.i
+	for g.i < len(g.s) && g.s[g.i] == '*' {
+		g.i++
+	}
+	segment := (start == 0 || g.s[start-1] == '/') && (g.i == len(g.s) || g.s[g.i] == '/')
+	switch {
+	case g.i-start < 2 || !segment:
+		b.WriteString(`[^/]*`)
+	case g.i == len(g.s):
+		b.WriteString(`(?s:.*)`)
+	default:
+		// take the / as well, so a/**/b matches a/b
+		b.WriteString(`(?:[^/]*/)*`)
+		g.i++
+	}
+}
+
+// class writes a set in brackets, or a literal [ if it isn't closed
+func (g *globParser) class(b *strings.Builder) error {
+	i := g.i + 1
+	negate := i < len(g.s) && (g.s[i] == '!' || g.s[i] == '^')
+	if negate {
+		i++
+	}
+	var items strings.Builder
+	for first := true; ; first = false {
+		if i == len(g.s) {
+			b.WriteString(`\[`)
+			g.i++
+			return nil
+		}
+		r := g.s[i]
+		if r == ']' && !first {
+			break
+		}
+		if r == '[' && i+1 < len(g.s) && g.s[i+1] == ':' {
+			if end := strings.Index(string(g.s[i+2:]), ":]"); end >= 0 {
+				name := string(g.s[i+2:])[:end]
+				class, ok := globClasses[name]
+				if !ok {
+					return errors.New("regexp2: unknown class [:" + name + ":] in glob")
+				}
+				items.WriteString(class)
+				i += 2 + len([]rune(name)) + 2
+				continue
+			}
+		}
+		if r == '\\' && i+1 < len(g.s) {
+			i++
+			r = g.s[i]
+		}
+		items.WriteString(classEscape(r))
+		i++
+		if i+1 < len(g.s) && g.s[i] == '-' && g.s[i+1] != ']' {
+			hi := g.s[i+1]
+			i += 2
+			if hi == '\\' && i < len(g.s) {
+				hi = g.s[i]
+				i++
+			}
+			items.WriteString("-" + classEscape(hi))
+		}
+	}
+	g.i = i + 1
+	if negate {
+		// a set doesn't match the / between segments, even if it's negated
+		b.WriteString("[^/" + items.String() + "]")
+	} else {
+		b.WriteString("[" + items.String() + "]")
+	}
+	return nil
+}
+
+func classEscape(r rune) string {
+	if strings.ContainsRune(`\[]^-`, r) {
+		return `\` + string(r)
+	}
+	return string(r)
+}
+
+// braces writes {a,b} as an alternation, or a literal { if there's no
+// comma in it or it isn't closed
+func (g *globParser) braces(b *strings.Builder) error {
+	commas, end := g.matchBrace(g.i)
+	if end < 0 || len(commas) == 0 {
+		b.WriteString(`\{`)
+		g.i++
+		return nil
+	}
+	b.WriteString("(?:")
+	g.i++
+	for _, comma := range append(commas, end) {
+		if err := g.seq(b, comma); err != nil {
+			return err
+		}
+		if comma != end {
+			b.WriteByte('|')
+		}
+		g.i = comma + 1
+	}
+	b.WriteByte(')')
+	return nil
+}
+
+// matchBrace returns the positions of the commas of the braces that open
+// at i, and of the } that closes them, or -1 if none does
+func (g *globParser) matchBrace(i int) (commas []int, end int) {
+	depth := 0
+	for ; i < len(g.s); i++ {
+		switch g.s[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return commas, i
+			}
+		case ',':
+			if depth == 1 {
+				commas = append(commas, i)
+			}
+		}
+	}
+	return nil, -1
+}
@@ -0,0 +1,58 @@
+package regexp2
+
+import "testing"
+
+func TestCompileGlob(t *testing.T) {
+	tests := []struct {
+		glob     string
+		match    []string
+		nonMatch []string
+	}{
+		{`*.go`, []string{"a.go", ".go", "glob_test.go"}, []string{"a.gox", "dir/a.go", "a.g"}},
+		{`file?.txt`, []string{"file1.txt", "fileA.txt"}, []string{"file.txt", "file12.txt", "file/.txt"}},
+		{`**/*.go`, []string{"a.go", "x/a.go", "x/y/z/a.go"}, []string{"a.c", "x/a.c"}},
+		{`src/**/test`, []string{"src/test", "src/a/test", "src/a/b/test"}, []string{"srctest", "src/atest", "lib/test"}},
+		{`logs/**`, []string{"logs/", "logs/a", "logs/a/b.log"}, []string{"logs", "log/a"}},
+		{`a**b`, []string{"ab", "axxb"}, []string{"a/b"}},
+		{`[abc]x`, []string{"ax", "cx"}, []string{"dx", "x"}},
+		{`[!a-c]x`, []string{"dx", "-x"}, []string{"ax", "bx", "/x"}},
+		{`[^a-c]x`, []string{"dx"}, []string{"bx"}},
+		{`[]-]`, []string{"]", "-"}, []string{"a"}},
+		{`[[:digit:]_]*`, []string{"1abc", "_", "9"}, []string{"abc", ""}},
+		{`*.{jpg,png,gi{f,ff}}`, []string{"a.jpg", "b.png", "c.gif", "c.giff"}, []string{"a.jpeg", "a.{jpg,png}"}},
+		{`{a,}b`, []string{"ab", "b"}, []string{"aab"}},
+		{`{abc}`, []string{"{abc}"}, []string{"abc"}},
+		{`a{b`, []string{"a{b"}, []string{"ab"}},
+		{`a[b`, []string{"a[b"}, []string{"ab"}},
+		{`\*.(x)+$`, []string{"*.(x)+$"}, []string{"a.(x)+$", "*.(xx)"}},
+		{`[\]]`, []string{"]"}, []string{`\`}},
+	}
+	for _, test := range tests {
+		re, err := CompileGlob(test.glob, 0)
+		if err != nil {
+			t.Errorf("%v: %v", test.glob, err)
+			continue
+		}
+		for _, s := range test.match {
+			if ok, _ := re.MatchString(s); !ok {
+				t.Errorf("%v (%v) doesn't match %q", test.glob, re, s)
+			}
+		}
+		for _, s := range test.nonMatch {
+			if ok, _ := re.MatchString(s); ok {
+				t.Errorf("%v (%v) matches %q", test.glob, re, s)
+			}
+		}
+	}
+
+	if re, err := CompileGlob(`*.TXT`, IgnoreCase); err != nil {
+		t.Error(err)
+	} else if ok, _ := re.MatchString("notes.txt"); !ok {
+		t.Error("IgnoreCase not used")
+	}
+	for _, glob := range []string{`a\`, `[[:nope:]]`, `[z-a]`} {
+		if _, err := CompileGlob(glob, 0); err == nil {
+			t.Errorf("%v: no error", glob)
+		}
+	}
+}
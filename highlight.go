@@ -0,0 +1,133 @@
+package regexp2
+
+import (
+	"html"
+	"slices"
+	"strings"
+)
+
+// Markers are the strings put before and after some highlighted text.
+type Markers struct {
+	Before, After string
+}
+
+// Highlighter says how Highlight marks the matches in a text.
+type Highlighter struct {
+	// Markers go around each match
+	Markers
+
+	// Groups has the markers to put around what named groups captured
+	// within each match, by name.  Captures that overlap others without
+	// nesting in them, or that are outside the match, aren't marked.
+	Groups map[string]Markers
+
+	// Escape, if set, is applied to all the text between the markers, as
+	// html.EscapeString is for HTML
+	Escape func(string) string
+
+	// Reopen writes a match's or group's Before again after the After of
+	// a group inside it, for markers like ANSI colors that end all the
+	// highlighting there is, not just the innermost
+	Reopen bool
+}
+
+var (
+	// ANSIHighlighter colors matches for a terminal, in GNU grep's bold
+	// red.
+	ANSIHighlighter = Highlighter{Markers: Markers{"\x1b[01;31m", "\x1b[0m"}, Reopen: true}
+
+	// HTMLHighlighter puts matches in <mark> tags and escapes the text.
+	HTMLHighlighter = Highlighter{Markers: Markers{"<mark>", "</mark>"}, Escape: html.EscapeString}
+)
+
+type highlightSpan struct {
+	start, end int
+	markers    *Markers
+}
+
+// Highlight returns s with each match, and each capture of the named
+// groups in h.Groups, between the markers h gives.  Matches next to each
+// other are marked one by one, and empty matches aren't marked.
+func (re *Regexp) Highlight(s string, h Highlighter, opts ...MatchOption) (string, error) {
+	var groups []int
+	var markers []Markers
+	nums := re.GetGroupNumbers()
+	for i, name := range re.SubexpNames() {
+		if mk, ok := h.Groups[name]; ok && name != "" {
+			groups = append(groups, nums[i])
+			markers = append(markers, mk)
+		}
+	}
+
+	// every span there is to mark, each match's ahead of its groups', so
+	// that where they're the same the match's markers go outside
+	input := getRunes(s)
+	var spans []highlightSpan
+	if !re.cannotMatch(s) {
+		err := re.scanAll(input, func(m *Match) bool {
+			if m.Length == 0 {
+				return true
+			}
+			end := m.Index + m.Length
+			spans = append(spans, highlightSpan{m.Index, end, &h.Markers})
+			for i, num := range groups {
+				for _, c := range m.GroupByNumber(num).Captures {
+					if c.Length > 0 && c.Index >= m.Index && c.Index+c.Length <= end {
+						spans = append(spans, highlightSpan{c.Index, c.Index + c.Length, &markers[i]})
+					}
+				}
+			}
+			return true
+		}, opts)
+		if err != nil {
+			return "", err
+		}
+	}
+	if len(spans) == 0 && h.Escape == nil {
+		return s, nil
+	}
+
+	// outer spans first where they start together; RightToLeft ones are
+	// found from the end
+	slices.SortStableFunc(spans, func(a, b highlightSpan) int {
+		if a.start != b.start {
+			return a.start - b.start
+		}
+		return b.end - a.end
+	})
+
+	var b strings.Builder
+	pos := 0
+	text := func(end int) {
+		if h.Escape != nil {
+			b.WriteString(h.Escape(string(input[pos:end])))
+		} else {
+			b.WriteString(string(input[pos:end]))
+		}
+		pos = end
+	}
+	var open []highlightSpan
+	closeTo := func(at int) {
+		for len(open) > 0 && open[len(open)-1].end <= at {
+			sp := open[len(open)-1]
+			open = open[:len(open)-1]
+			text(sp.end)
+			b.WriteString(sp.markers.After)
+			if h.Reopen && len(open) > 0 {
+				b.WriteString(open[len(open)-1].markers.Before)
+			}
+		}
+	}
+	for _, sp := range spans {
+		closeTo(sp.start)
+		if len(open) > 0 && sp.end > open[len(open)-1].end {
+			continue
+		}
+		text(sp.start)
+		b.WriteString(sp.markers.Before)
+		open = append(open, sp)
+	}
+	closeTo(len(input))
+	text(len(input))
+	return b.String(), nil
+}
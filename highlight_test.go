@@ -0,0 +1,40 @@
+package regexp2
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	brackets := Highlighter{Markers: Markers{"[", "]"}}
+	groups := Highlighter{
+		Markers: Markers{"[", "]"},
+		Groups:  map[string]Markers{"user": {"<", ">"}, "host": {"{", "}"}},
+	}
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		h       Highlighter
+		input   string
+		want    string
+	}{
+		{`\d+`, 0, brackets, "a1 22b", "a[1] [22]b"},
+		// next to each other, and empty
+		{`\w`, 0, brackets, "ab c", "[a][b] [c]"},
+		{`x*`, 0, brackets, "axxb", "a[xx]b"},
+		{`\d+`, RightToLeft, brackets, "1 23", "[1] [23]"},
+		{`(?<user>\w+)@(?<host>\w+)`, 0, groups, "mail me@here, é@là", "mail [<me>@{here}], [<é>@{là}]"},
+		{`(?<user>\w+)`, 0, groups, "ok", "[<ok>]"},
+		{`(?:(?<user>\w),?)+`, 0, groups, "a,b", "[<a>,<b>]"},
+		// a group outside the match isn't marked
+		{`a(?=(?<user>b))`, 0, groups, "ab", "[a]b"},
+		{`\d`, 0, brackets, "none", "none"},
+		{`<\w+>`, 0, HTMLHighlighter, "a <b> & c", "a <mark>&lt;b&gt;</mark> &amp; c"},
+		{`x`, 0, HTMLHighlighter, "<none>", "&lt;none&gt;"},
+		{`(?<user>\w+)@\w+`, 0, Highlighter{Markers: ANSIHighlighter.Markers, Groups: map[string]Markers{"user": {"\x1b[1m", "\x1b[0m"}}, Reopen: true},
+			"me@host", "\x1b[01;31m\x1b[1mme\x1b[0m\x1b[01;31m@host\x1b[0m"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		if got, err := re.Highlight(tt.input, tt.h); got != tt.want || err != nil {
+			t.Errorf("%v on %q: wanted %q, got %q %v", tt.pattern, tt.input, tt.want, got, err)
+		}
+	}
+}
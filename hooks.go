@@ -0,0 +1,30 @@
+package regexp2
+
+import "time"
+
+// Hooks is told when each search a Regexp runs starts and ends, for a
+// service to keep metrics on its patterns, such as how often each one
+// matches or times out and how long it takes, without wrapping every call.
+// Set one as a Regexp's Hooks, or as DefaultHooks to have every Regexp
+// compiled after that use it.  It's called from the goroutine doing the
+// search, so it has to be safe for concurrent use.  Package expvarhooks
+// has one that publishes counts with expvar.
+//
+// A call that looks for many matches, like Replace or Count, runs a search
+// for each one.  Searches that are ruled out before the engine runs, say
+// because the text doesn't have a literal every match needs, aren't seen.
+type Hooks interface {
+	OnMatchStart(re *Regexp)
+	OnMatchEnd(re *Regexp, end MatchEnd)
+}
+
+// MatchEnd is how a search went.
+type MatchEnd struct {
+	Matched bool
+	Timeout bool  // the search ran out of time
+	Err     error // what stopped the search, if anything did
+	Elapsed time.Duration
+}
+
+// DefaultHooks is the Hooks that Regexps get when they're compiled.
+var DefaultHooks Hooks
@@ -0,0 +1,72 @@
+package regexp2
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordHooks struct {
+	mu     sync.Mutex
+	starts int
+	ends   []MatchEnd
+}
+
+func (h *recordHooks) OnMatchStart(re *Regexp) {
+	h.mu.Lock()
+	h.starts++
+	h.mu.Unlock()
+}
+
+func (h *recordHooks) OnMatchEnd(re *Regexp, end MatchEnd) {
+	h.mu.Lock()
+	h.ends = append(h.ends, end)
+	h.mu.Unlock()
+}
+
+func TestHooks(t *testing.T) {
+	h := &recordHooks{}
+	re := MustCompile(`(.+)*\?`, 0)
+	re.Hooks = h
+
+	re.MatchString("what?")
+	re.MatchString("what")
+	re.FindStringMatch("Do you think you found the problem string!", WithTimeout(time.Millisecond))
+
+	if h.starts != 3 || len(h.ends) != 3 {
+		t.Fatalf("wanted 3 searches, got %v starts and %v ends", h.starts, len(h.ends))
+	}
+	if e := h.ends[0]; !e.Matched || e.Timeout || e.Err != nil {
+		t.Errorf("wanted a match, got %+v", e)
+	}
+	if e := h.ends[1]; e.Matched || e.Timeout || e.Err != nil {
+		t.Errorf("wanted no match, got %+v", e)
+	}
+	if e := h.ends[2]; e.Matched || !e.Timeout || e.Err == nil || e.Elapsed < time.Millisecond {
+		t.Errorf("wanted a timeout, got %+v", e)
+	}
+
+	// each match of a Replace is a search, and the one that finds none
+	h = &recordHooks{}
+	re = MustCompile(`a`, 0)
+	re.Hooks = h
+	re.Replace("banana", "o", -1, -1)
+	if h.starts != 4 {
+		t.Errorf("wanted 4 searches, got %v", h.starts)
+	}
+}
+
+func TestDefaultHooks(t *testing.T) {
+	h := &recordHooks{}
+	DefaultHooks = h
+	re := MustCompile(`a`, 0)
+	DefaultHooks = nil
+
+	re.Clone().MatchString("a")
+	if h.starts != 1 {
+		t.Fatalf("wanted 1 search, got %v", h.starts)
+	}
+	if MustCompile(`a`, 0).Hooks != nil {
+		t.Fatal("DefaultHooks was kept")
+	}
+}
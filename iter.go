@@ -0,0 +1,73 @@
+//go:build go1.23
+// +build go1.23
+
+package regexp2
+
+import (
+	"context"
+	"iter"
+)
+
+// Matches returns an iterator over the successive matches of the regex in
+// s, the ones FindStringMatch and FindNextMatch would return.  If a search
+// fails with a timeout, it's yielded along with a nil Match and the
+// iteration ends.  Breaking out of the loop stops the search.
+//
+//	for m, err := range re.Matches(s) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(m.Index, m.String())
+//	}
+func (re *Regexp) Matches(s string, opts ...MatchOption) iter.Seq2[*Match, error] {
+	return func(yield func(*Match, error) bool) {
+		if re.cannotMatch(s) {
+			return
+		}
+		re.yieldMatches(getRunes(s), yield, opts)
+	}
+}
+
+// AllCaptures returns an iterator over CaptureHistory(num).
+//
+//	for c := range m.AllCaptures(1) {
+//		fmt.Println(s[c.ByteIndex : c.ByteIndex+c.ByteLength])
+//	}
+func (m *Match) AllCaptures(num int) iter.Seq[CaptureSpan] {
+	return func(yield func(CaptureSpan) bool) {
+		for _, c := range m.CaptureHistory(num) {
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// MatchesRunes is like Matches for a rune slice.
+func (re *Regexp) MatchesRunes(r []rune, opts ...MatchOption) iter.Seq2[*Match, error] {
+	return func(yield func(*Match, error) bool) {
+		re.yieldMatches(r, yield, opts)
+	}
+}
+
+// MatchesBytes is like Matches for UTF-8 encoded bytes.  The indexes of
+// the matches are rune indexes, as with Matches.
+func (re *Regexp) MatchesBytes(b []byte, opts ...MatchOption) iter.Seq2[*Match, error] {
+	return re.Matches(string(b), opts...)
+}
+
+func (re *Regexp) yieldMatches(input []rune, yield func(*Match, error) bool, opts []MatchOption) {
+	cfg := re.config(opts)
+	m, err := re.search(context.Background(), false, -1, input, false, cfg)
+	for m != nil {
+		// where to go on from, before m is detached
+		startAt, ok := re.nextStart(m, &cfg)
+		if !yield(re.detach(m, nil)) || !ok {
+			return
+		}
+		m, err = re.search(context.Background(), false, startAt, input, false, cfg)
+	}
+	if err != nil {
+		yield(nil, err)
+	}
+}
@@ -0,0 +1,103 @@
+//go:build go1.23
+// +build go1.23
+
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMatches(t *testing.T) {
+	re := MustCompile(`\w+`, 0)
+	var got []string
+	for m, err := range re.Matches("one two  three") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, m.String())
+	}
+	if want := []string{"one", "two", "three"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+
+	got = got[:0]
+	for m := range re.MatchesRunes([]rune("é ü ö")) {
+		got = append(got, m.String())
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"é", "ü"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+
+	n := 0
+	for range re.MatchesBytes([]byte("a b")) {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("wanted 2 matches, got %v", n)
+	}
+
+	for range MustCompile(`x`, 0).Matches("abc") {
+		t.Error("unexpected match")
+	}
+
+	// right to left, the loop ends after the empty match at the start
+	var spans [][2]int
+	for m, err := range MustCompile(`a*`, RightToLeft).Matches("ba") {
+		if err != nil || len(spans) == 10 {
+			t.Fatalf("got %v after %v", err, spans)
+		}
+		spans = append(spans, [2]int{m.Index, m.Length})
+	}
+	if want := [][2]int{{1, 1}, {1, 0}, {0, 0}}; !reflect.DeepEqual(spans, want) {
+		t.Errorf("wanted %v, got %v", want, spans)
+	}
+}
+
+func TestMatches_Detached(t *testing.T) {
+	re := MustCompile(`\d+`, 0)
+	re.DetachMatches = true
+	var got []string
+	for m := range re.Matches("a 12 b 345 c") {
+		if len(m.text) != m.Length {
+			t.Errorf("expected %v to be detached", m)
+		}
+		got = append(got, m.String())
+	}
+	if want := []string{"12", "345"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+}
+
+func TestMatches_Timeout(t *testing.T) {
+	re := MustCompile(`(a+)+$`, 0)
+	re.MatchTimeout = time.Millisecond
+	var errs int
+	for m, err := range re.Matches("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa!") {
+		if m != nil || err == nil {
+			t.Fatalf("unexpected match %v %v", m, err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("wanted one error, got %v", errs)
+	}
+}
+
+func TestAllCaptures(t *testing.T) {
+	m, _ := MustCompile(`(?:(\d+)\.?)+`, 0).FindStringMatch("v10.2.33")
+	var got []string
+	for c := range m.AllCaptures(1) {
+		got = append(got, "v10.2.33"[c.ByteIndex:c.ByteIndex+c.ByteLength])
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"10", "2"}; !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,133 @@
+package regexp2
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// Join returns a Regexp that matches wherever one of patterns does, trying
+// them in order as a|b|c would, for a router or rule engine to run all its
+// rules as one search.  Each pattern's numbered groups are numbered after
+// those of the patterns before it, and its named groups keep their names,
+// so two patterns can't have a group with the same name.  Then each
+// pattern gets a group of its own, numbered after all the others, which
+// is how Joined says which pattern matched.  The patterns must all be
+// RightToLeft or none of them, and have the same culture.
+func Join(patterns ...*Regexp) (*Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("regexp2: nothing to join")
+	}
+	rtl, culture := patterns[0].options&RightToLeft, patterns[0].culture
+
+	roots := make([]*syntax.Node, len(patterns))
+	names := map[string]int{} // the pattern each group name is from
+	offset := 0
+	for i, re := range patterns {
+		if re.options&RightToLeft != rtl {
+			return nil, errors.New("regexp2: can't join RightToLeft patterns with others")
+		}
+		if !reflect.DeepEqual(re.culture, culture) {
+			return nil, errors.New("regexp2: can't join patterns with different cultures")
+		}
+		tree, err := syntax.ParseCulture(re.pattern, syntax.RegexOptions(re.options), re.culture)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = tree.AST()
+
+		named := map[int]bool{}
+		numbered := 0
+		for name, num := range tree.Capnames {
+			if _, err := strconv.Atoi(name); err == nil {
+				continue
+			}
+			if j, ok := names[name]; ok && j != i {
+				return nil, fmt.Errorf("regexp2: patterns %v and %v both have a group named %q", j, i, name)
+			}
+			names[name] = i
+			named[num] = true
+		}
+		var bad error
+		syntax.Inspect(roots[i], func(n *syntax.Node) bool {
+			if n == nil {
+				return false
+			}
+			if n.Op == syntax.OpCapture && n.Group > 0 && !named[n.Group] && n.Group > numbered {
+				numbered = n.Group
+			}
+			if n.Uncapture > 0 && named[n.Uncapture] {
+				bad = fmt.Errorf("regexp2: can't join pattern %v, whose balancing group pops a named group", i)
+			}
+			return bad == nil
+		})
+		if bad != nil {
+			return nil, bad
+		}
+		renumber(roots[i], offset, named)
+		offset += numbered
+	}
+
+	// each pattern in a group that says it matched
+	joined := make([]int, len(patterns))
+	alt := &syntax.Node{Op: syntax.OpAlternate, Flags: syntax.RegexOptions(rtl)}
+	for i, root := range roots {
+		joined[i] = offset + 1 + i
+		syntax.Inspect(root, func(n *syntax.Node) bool {
+			if n != nil && n.Op == syntax.OpCall && n.Group == 0 {
+				// (?R) runs just this pattern now
+				n.Group = joined[i]
+			}
+			return true
+		})
+		alt.Children = append(alt.Children, &syntax.Node{
+			Op:       syntax.OpCapture,
+			Flags:    root.Flags,
+			Group:    joined[i],
+			Children: []*syntax.Node{root},
+		})
+	}
+
+	re, err := CompileCulture(alt.String(), RegexOptions(rtl), culture)
+	if err != nil {
+		return nil, err
+	}
+	re.joined = joined
+	return re, nil
+}
+
+// renumber moves the numbered groups in the tree from n up by offset
+func renumber(n *syntax.Node, offset int, named map[int]bool) {
+	syntax.Inspect(n, func(n *syntax.Node) bool {
+		if n == nil {
+			return false
+		}
+		switch n.Op {
+		case syntax.OpCapture, syntax.OpBackref, syntax.OpCondCapture, syntax.OpCall:
+			if n.Group > 0 && !named[n.Group] {
+				n.Group += offset
+			}
+		}
+		if n.Uncapture > 0 {
+			n.Uncapture += offset
+		}
+		return true
+	})
+}
+
+// Joined returns which of the patterns given to Join matched, or -1 if the
+// Regexp didn't come from Join.
+func (m *Match) Joined() int {
+	if m.regex == nil {
+		return -1
+	}
+	for i, group := range m.regex.joined {
+		if g := m.GroupByNumber(group); g != nil && g.Success() {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,63 @@
+package regexp2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	re, err := Join(
+		MustCompile(`/users/(\d+)`, 0),
+		MustCompile(`/posts/(?<slug>[a-z-]+)/(\d+)`, IgnoreCase),
+		MustCompile(`/(a)(b)?\2\1`, 0),
+		MustCompile(`<(?:[^<>]|(?R))*>`, 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text   string
+		joined int
+		match  string
+		groups map[string]string
+	}{
+		{"/users/42", 0, "/users/42", map[string]string{"1": "42"}},
+		{"/POSTS/hello-world/7", 1, "/POSTS/hello-world/7", map[string]string{"slug": "hello-world", "2": "7"}},
+		{"/abba", 2, "/abba", map[string]string{"3": "a", "4": "b"}},
+		{"x <a<b>c> y", 3, "<a<b>c>", nil},
+	}
+	for _, test := range tests {
+		m, err := re.FindStringMatch(test.text)
+		if err != nil || m == nil {
+			t.Errorf("%v: no match (%v)", test.text, err)
+			continue
+		}
+		if m.Joined() != test.joined || m.String() != test.match {
+			t.Errorf("%v: got %q from pattern %v, want %q from %v", test.text, m.String(), m.Joined(), test.match, test.joined)
+		}
+		for name, want := range test.groups {
+			if got := m.GroupByName(name).String(); got != want {
+				t.Errorf("%v: group %v is %q, want %q", test.text, name, got, want)
+			}
+		}
+	}
+
+	if m, _ := MustCompile(`a`, 0).FindStringMatch("a"); m.Joined() != -1 {
+		t.Errorf("Joined for a pattern not from Join is %v", m.Joined())
+	}
+
+	for _, patterns := range [][]*Regexp{
+		{MustCompile(`(?<x>a)`, 0), MustCompile(`(?<x>b)`, 0)},
+		{MustCompile(`a`, 0), MustCompile(`b`, RightToLeft)},
+		{},
+	} {
+		if _, err := Join(patterns...); err == nil {
+			var s []string
+			for _, re := range patterns {
+				s = append(s, re.String())
+			}
+			t.Errorf("%v: no error", strings.Join(s, ", "))
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package lexer splits text into tokens with a list of regexp2 patterns,
+// the way lexers for small languages and data formats usually work.  At
+// each position every rule is tried, the longest match wins, and of the
+// rules that match the same length the one added first wins, so keywords
+// go before identifiers:
+//
+//	l := lexer.New()
+//	l.Skip(`\s+`, 0)
+//	l.Add("if", `if\b`, 0)
+//	l.Add("ident", `[a-zA-Z_]\w*`, 0)
+//	l.Add("number", `\d+`, 0)
+//	l.Add("op", `[-+*/=<>]=?`, 0)
+//	tokens, err := l.Tokenize("if x >= 10")
+package lexer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jviksne/regexp2"
+)
+
+// Token is a piece of the input matched by one of the rules.
+type Token struct {
+	Type int    // the rule's position among those added with Add, from 0
+	Name string // the rule's name
+	Text string // the text matched
+
+	Pos    int // byte offset of the token in the input
+	Line   int // line of the token, from 1
+	Column int // column of the token in runes, from 1
+}
+
+func (t Token) String() string {
+	return fmt.Sprintf("%d:%d %s %q", t.Line, t.Column, t.Name, t.Text)
+}
+
+// Error is returned where no rule matches the input.
+type Error struct {
+	Pos, Line, Column int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("lexer: no rule matches at line %d, column %d", e.Line, e.Column)
+}
+
+// Lexer is a list of rules.  Rules are added before it's used, and then
+// it's safe for concurrent use by multiple goroutines.
+type Lexer struct {
+	rules []rule
+	types int // how many rules are returned as tokens
+}
+
+type rule struct {
+	name string
+	re   *regexp2.Regexp
+	typ  int // -1 for a rule that's skipped
+}
+
+// New returns a Lexer with no rules.
+func New() *Lexer {
+	return &Lexer{}
+}
+
+// Add adds a rule that makes tokens of the given name.  The pattern is
+// compiled with opt and only matches where the last token ended.
+func (l *Lexer) Add(name, pattern string, opt regexp2.RegexOptions) error {
+	if err := l.add(name, pattern, opt, l.types); err != nil {
+		return err
+	}
+	l.types++
+	return nil
+}
+
+// Skip adds a rule for text that's matched like any other, but that isn't
+// returned as a token, such as white space and comments.
+func (l *Lexer) Skip(pattern string, opt regexp2.RegexOptions) error {
+	return l.add("", pattern, opt, -1)
+}
+
+func (l *Lexer) add(name, pattern string, opt regexp2.RegexOptions, typ int) error {
+	if opt&regexp2.RightToLeft != 0 {
+		return errors.New("lexer: rules can't be RightToLeft")
+	}
+	// \G holds the match to where the search starts
+	re, err := regexp2.Compile(`\G(?:`+pattern+`)`, opt)
+	if err != nil {
+		return err
+	}
+	l.rules = append(l.rules, rule{name: name, re: re, typ: typ})
+	return nil
+}
+
+// Tokenize returns all the tokens in input.  If a part of it matches no
+// rule, it returns the tokens before that and an *Error.
+func (l *Lexer) Tokenize(input string) ([]Token, error) {
+	var tokens []Token
+	s := l.Scan(input)
+	for {
+		t, err := s.Next()
+		if err == io.EOF {
+			return tokens, nil
+		}
+		if err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, t)
+	}
+}
+
+// Scanner returns the tokens of one input one at a time.
+type Scanner struct {
+	l     *Lexer
+	input string
+	text  []rune
+	offs  []int // byte offset of each rune, and of the end
+	pos   int   // rune index of the next token
+
+	line, col int
+	err       error
+}
+
+// Scan returns a Scanner over input.
+func (l *Lexer) Scan(input string) *Scanner {
+	s := &Scanner{
+		l:     l,
+		input: input,
+		text:  []rune(input),
+		line:  1,
+		col:   1,
+	}
+	s.offs = make([]int, 0, len(s.text)+1)
+	for i := range input {
+		s.offs = append(s.offs, i)
+	}
+	s.offs = append(s.offs, len(input))
+	return s
+}
+
+// Next returns the next token.  At the end of the input it returns io.EOF,
+// and after an error it keeps returning that error.
+func (s *Scanner) Next() (Token, error) {
+	for s.err == nil {
+		if s.pos == len(s.text) {
+			s.err = io.EOF
+			break
+		}
+
+		best, length := -1, 0
+		for i, r := range s.l.rules {
+			m, err := r.re.FindRunesMatchStartingAt(s.text, s.pos)
+			if err != nil {
+				s.err = err
+				return Token{}, err
+			}
+			if m != nil && m.Length > length {
+				best, length = i, m.Length
+			}
+		}
+		if best < 0 {
+			s.err = &Error{Pos: s.offs[s.pos], Line: s.line, Column: s.col}
+			break
+		}
+
+		r := s.l.rules[best]
+		t := Token{
+			Type:   r.typ,
+			Name:   r.name,
+			Text:   s.input[s.offs[s.pos]:s.offs[s.pos+length]],
+			Pos:    s.offs[s.pos],
+			Line:   s.line,
+			Column: s.col,
+		}
+		for _, ch := range s.text[s.pos : s.pos+length] {
+			if ch == '\n' {
+				s.line++
+				s.col = 1
+			} else {
+				s.col++
+			}
+		}
+		s.pos += length
+		if r.typ >= 0 {
+			return t, nil
+		}
+	}
+	return Token{}, s.err
+}
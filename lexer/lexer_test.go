@@ -0,0 +1,69 @@
+package lexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jviksne/regexp2"
+)
+
+func TestTokenize(t *testing.T) {
+	l := New()
+	rules := []struct {
+		name, pattern string
+	}{
+		{"if", `if\b`},
+		{"ident", `[\p{L}_]\w*`},
+		{"number", `\d+(\.\d+)?`},
+		{"op", `[-+*/=<>]=?`},
+		{"string", `"(\\.|[^"\\])*"`},
+	}
+	if err := l.Skip(`\s+|//.*`, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rules {
+		if err := l.Add(r.name, r.pattern, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tokens, err := l.Tokenize("if iffy >= 1.5 // note\n  café = \"a \\\" b\"")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, tok := range tokens {
+		got = append(got, tok.String())
+	}
+	want := []string{
+		`1:1 if "if"`,
+		`1:4 ident "iffy"`,
+		`1:9 op ">="`,
+		`1:12 number "1.5"`,
+		`2:3 ident "café"`,
+		`2:8 op "="`,
+		`2:10 string "\"a \\\" b\""`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+	if tokens[4].Pos != 25 || tokens[5].Pos != 31 || tokens[4].Type != 1 {
+		t.Errorf("got positions %v, %v and type %v", tokens[4].Pos, tokens[5].Pos, tokens[4].Type)
+	}
+
+	tokens, err = l.Tokenize("x = 1\n  y # 2")
+	lexErr, ok := err.(*Error)
+	if !ok || lexErr.Line != 2 || lexErr.Column != 5 || lexErr.Pos != 10 {
+		t.Errorf("got error %v", err)
+	}
+	if len(tokens) != 4 {
+		t.Errorf("got %v tokens before the error", len(tokens))
+	}
+
+	if err := l.Add("bad", `(`, 0); err == nil {
+		t.Error("expected an error for a bad pattern")
+	}
+	if err := l.Add("rtl", `a`, regexp2.RightToLeft); err == nil {
+		t.Error("expected an error for a RightToLeft rule")
+	}
+}
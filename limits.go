@@ -0,0 +1,73 @@
+package regexp2
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// CompileLimits caps what compiling a pattern may take, for services that
+// compile patterns from untrusted sources.  A zero field is no limit.
+type CompileLimits struct {
+	MaxPatternLength int // runes in the pattern
+	MaxNestingDepth  int // groups inside groups, so ((a)) is 2 deep
+	MaxCaptures      int // capture groups, not counting the whole match
+	MaxProgramSize   int // ints in the compiled program, about 2 per pattern rune
+}
+
+// DefaultCompileLimits are the limits Compile and the other compile
+// functions use, but for CompileWithLimits.  There are none by default.
+var DefaultCompileLimits CompileLimits
+
+// CompileLimitError is returned when compiling a pattern would go past one
+// of its CompileLimits.
+type CompileLimitError struct {
+	Limit string // the limit: "pattern length", "nesting depth", "captures" or "program size"
+	Max   int    // what it was set to
+	Size  int    // what the pattern needed, or at least that much
+}
+
+func (e *CompileLimitError) Error() string {
+	return fmt.Sprintf("regexp2: %v of %v is over the limit of %v", e.Limit, e.Size, e.Max)
+}
+
+// CompileWithLimits is like Compile, but fails with a *CompileLimitError
+// rather than compile a pattern that goes past limits.
+func CompileWithLimits(expr string, opt RegexOptions, limits CompileLimits) (*Regexp, error) {
+	re := &Regexp{}
+	if err := re.compile(expr, opt, DefaultCulture, DefaultOptimizations, limits); err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+// checkLength fails for a pattern that's too long to parse
+func (l CompileLimits) checkLength(expr string) error {
+	if l.MaxPatternLength > 0 && len(expr) > l.MaxPatternLength {
+		// only count the runes once the bytes are too many
+		if n := utf8.RuneCountInString(expr); n > l.MaxPatternLength {
+			return &CompileLimitError{"pattern length", l.MaxPatternLength, n}
+		}
+	}
+	return nil
+}
+
+// checkTree fails for a pattern whose parse tree is too deep to write out
+func (l CompileLimits) checkTree(tree *syntax.RegexTree) error {
+	if l.MaxNestingDepth > 0 && tree.MaxDepth > l.MaxNestingDepth {
+		return &CompileLimitError{"nesting depth", l.MaxNestingDepth, tree.MaxDepth}
+	}
+	return nil
+}
+
+// checkCode fails for a program that's too big to keep
+func (l CompileLimits) checkCode(code *syntax.Code) error {
+	if n := code.Capsize - 1; l.MaxCaptures > 0 && n > l.MaxCaptures {
+		return &CompileLimitError{"captures", l.MaxCaptures, n}
+	}
+	if l.MaxProgramSize > 0 && len(code.Codes) > l.MaxProgramSize {
+		return &CompileLimitError{"program size", l.MaxProgramSize, len(code.Codes)}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package regexp2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCompileWithLimits(t *testing.T) {
+	tests := []struct {
+		pattern string
+		limits  CompileLimits
+		limit   string // the one that's gone past, or "" if none is
+		size    int
+	}{
+		{`abc`, CompileLimits{}, "", 0},
+		{`abcd`, CompileLimits{MaxPatternLength: 4}, "", 0},
+		{`abcde`, CompileLimits{MaxPatternLength: 4}, "pattern length", 5},
+		{`ééé`, CompileLimits{MaxPatternLength: 3}, "", 0},
+		{`((a)(?:b))`, CompileLimits{MaxNestingDepth: 2}, "", 0},
+		{`((a(?:b)))`, CompileLimits{MaxNestingDepth: 2}, "nesting depth", 3},
+		{`(?=(?<x>a))`, CompileLimits{MaxNestingDepth: 1}, "nesting depth", 2},
+		{`(a)(b)(?:c)`, CompileLimits{MaxCaptures: 2}, "", 0},
+		{`(a)(b)(?<c>c)`, CompileLimits{MaxCaptures: 2}, "captures", 3},
+		{`(a)(?<x>b)(?<x>c)`, CompileLimits{MaxCaptures: 2}, "", 0},
+		{strings.Repeat("a?b", 20), CompileLimits{MaxProgramSize: 20}, "program size", 0},
+		{`a`, CompileLimits{MaxProgramSize: 100}, "", 0},
+	}
+	for _, test := range tests {
+		_, err := CompileWithLimits(test.pattern, 0, test.limits)
+		if test.limit == "" {
+			if err != nil {
+				t.Errorf("%v: %v", test.pattern, err)
+			}
+			continue
+		}
+		var le *CompileLimitError
+		if !errors.As(err, &le) {
+			t.Errorf("%v: got %v, want a CompileLimitError", test.pattern, err)
+		} else if le.Limit != test.limit || test.size > 0 && le.Size != test.size {
+			t.Errorf("%v: got %v of %v, want %v of %v", test.pattern, le.Limit, le.Size, test.limit, test.size)
+		}
+	}
+
+	// Compile uses DefaultCompileLimits
+	defer func(limits CompileLimits) { DefaultCompileLimits = limits }(DefaultCompileLimits)
+	DefaultCompileLimits = CompileLimits{MaxPatternLength: 2}
+	if _, err := Compile(`abc`, 0); err == nil {
+		t.Error("DefaultCompileLimits not used")
+	}
+}
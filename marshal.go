@@ -0,0 +1,250 @@
+package regexp2
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// regexpData is what MarshalBinary saves of a Regexp besides its code
+type regexpData struct {
+	Pattern  string
+	Options  RegexOptions
+	Capnames []capname // sorted by name; gob writes maps in random order
+	Capslist []string
+	Prefix   string
+	Literals []string
+	Longest  bool
+
+	Culture       unicode.SpecialCase
+	Optimizations Optimization
+	Joined        []int
+
+	MatchTimeout      time.Duration
+	MaxSteps          int
+	MaxRecursionDepth int
+	MaxMemory         int
+	StackSize         int
+	DetachMatches     bool
+
+	Code []byte // from syntax.Code.MarshalBinary
+}
+
+type capname struct {
+	Name  string
+	Index int
+}
+
+// MarshalBinary encodes the compiled form of re, so a program that compiles
+// many patterns at startup can cache them and load them with UnmarshalBinary
+// instead of parsing them again.  The culture, the optimizations, what
+// Join recorded for Match.Joined, and the MatchTimeout, MaxSteps,
+// MaxRecursionDepth, MaxMemory, StackSize and DetachMatches settings are
+// saved along with the pattern.  Tracer and Hooks aren't: a loaded Regexp
+// has no Tracer and DefaultHooks.
+//
+// The encoding is tied to the version of this package that wrote it.
+// Unicode properties are looked up by name when the code is loaded, so any
+// added with RegisterUnicodeProperty or RegisterCharClass must be
+// registered first.
+func (re *Regexp) MarshalBinary() ([]byte, error) {
+	code, err := re.code.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var capnames []capname
+	for name, i := range re.capnames {
+		capnames = append(capnames, capname{name, i})
+	}
+	sort.Slice(capnames, func(i, j int) bool { return capnames[i].Name < capnames[j].Name })
+
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(regexpData{
+		Pattern:           re.pattern,
+		Options:           re.options,
+		Capnames:          capnames,
+		Capslist:          re.capslist,
+		Prefix:            re.prefix,
+		Literals:          re.literals,
+		Longest:           re.longest,
+		Culture:           re.culture,
+		Optimizations:     re.optimizations,
+		Joined:            re.joined,
+		MatchTimeout:      re.MatchTimeout,
+		MaxSteps:          re.MaxSteps,
+		MaxRecursionDepth: re.MaxRecursionDepth,
+		MaxMemory:         re.MaxMemory,
+		StackSize:         re.StackSize,
+		DetachMatches:     re.DetachMatches,
+		Code:              code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces re with the Regexp encoded in data by
+// MarshalBinary.  It must not be called while re is in use.
+func (re *Regexp) UnmarshalBinary(data []byte) error {
+	var d regexpData
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&d); err != nil {
+		return err
+	}
+	code, err := syntax.UnmarshalCode(d.Code)
+	if err != nil {
+		return err
+	}
+
+	var capnames map[string]int
+	if d.Capnames != nil {
+		capnames = make(map[string]int, len(d.Capnames))
+		for _, c := range d.Capnames {
+			capnames[c.Name] = c.Index
+		}
+	}
+
+	*re = Regexp{
+		pattern:           d.Pattern,
+		options:           d.Options,
+		caps:              code.Caps,
+		capnames:          capnames,
+		capslist:          d.Capslist,
+		dupnames:          dupNames(d.Capslist, code.Caps),
+		capsize:           code.Capsize,
+		code:              code,
+		prefix:            d.Prefix,
+		literals:          d.Literals,
+		longest:           d.Longest,
+		culture:           d.Culture,
+		optimizations:     d.Optimizations,
+		joined:            d.Joined,
+		MatchTimeout:      d.MatchTimeout,
+		MaxSteps:          d.MaxSteps,
+		MaxRecursionDepth: d.MaxRecursionDepth,
+		MaxMemory:         d.MaxMemory,
+		StackSize:         d.StackSize,
+		DetachMatches:     d.DetachMatches,
+		Hooks:             DefaultHooks,
+	}
+	return nil
+}
+
+// optionNames are the names MarshalText writes options with, and their
+// letters, which are the inline option letters where there is one
+var optionNames = []struct {
+	opt    RegexOptions
+	letter byte
+	name   string
+}{
+	{IgnoreCase, 'i', "IgnoreCase"},
+	{Multiline, 'm', "Multiline"},
+	{ExplicitCapture, 'n', "ExplicitCapture"},
+	{Compiled, 'c', "Compiled"},
+	{Singleline, 's', "Singleline"},
+	{IgnorePatternWhitespace, 'x', "IgnorePatternWhitespace"},
+	{RightToLeft, 'r', "RightToLeft"},
+	{Debug, 'd', "Debug"},
+	{ECMAScript, 'e', "ECMAScript"},
+	{RE2, 0, "RE2"},
+	{Memoize, 0, "Memoize"},
+	{PCRE2, 0, "PCRE2"},
+	{Python, 0, "Python"},
+	{Java, 0, "Java"},
+	{UnicodeSets, 0, "UnicodeSets"},
+	{CultureInvariant, 0, "CultureInvariant"},
+	{CaseConversion, 0, "CaseConversion"},
+	{DupNames, 0, "DupNames"},
+}
+
+// MarshalText returns the pattern, so a Regexp can be a field of a
+// configuration struct read from JSON, YAML or TOML.  A pattern with
+// options is written /pattern/flags, where flags are the options' inline
+// letters, as in /^\d+$/im, or their names when some option has no letter,
+// as in /\d+/IgnoreCase|RE2.  So is a pattern that starts with a slash, with
+// no flags, so it isn't read back as one with options.
+func (re *Regexp) MarshalText() ([]byte, error) {
+	if re.options == 0 && !strings.HasPrefix(re.pattern, "/") {
+		return []byte(re.pattern), nil
+	}
+
+	letters := true
+	for _, o := range optionNames {
+		if re.options&o.opt != 0 && o.letter == 0 {
+			letters = false
+		}
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteByte('/')
+	buf.WriteString(re.pattern)
+	buf.WriteByte('/')
+	for _, o := range optionNames {
+		if re.options&o.opt == 0 {
+			continue
+		}
+		if letters {
+			buf.WriteByte(o.letter)
+			continue
+		}
+		if buf.Bytes()[buf.Len()-1] != '/' {
+			buf.WriteByte('|')
+		}
+		buf.WriteString(o.name)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalText compiles the pattern in text, in the form MarshalText
+// writes.  Like Compile, it leaves MatchTimeout at DefaultMatchTimeout and
+// uses DefaultCulture.  It must not be called while re is in use.
+func (re *Regexp) UnmarshalText(text []byte) error {
+	pattern, opt := string(text), RegexOptions(None)
+	if end := strings.LastIndexByte(pattern, '/'); strings.HasPrefix(pattern, "/") && end > 0 {
+		var err error
+		if opt, err = parseFlags(pattern[end+1:]); err != nil {
+			return err
+		}
+		pattern = pattern[1:end]
+	}
+
+	return re.compile(pattern, opt, DefaultCulture, DefaultOptimizations, DefaultCompileLimits)
+}
+
+// parseFlags reads the flags after /pattern/, either option letters or
+// names separated by |
+func parseFlags(flags string) (RegexOptions, error) {
+	var opt RegexOptions
+	if flags == "" {
+		return opt, nil
+	}
+	if strings.Trim(flags, "abcdefghijklmnopqrstuvwxyz") == "" {
+	letters:
+		for i := 0; i < len(flags); i++ {
+			for _, o := range optionNames {
+				if o.letter == flags[i] {
+					opt |= o.opt
+					continue letters
+				}
+			}
+			return 0, errors.New("regexp2: unknown option letter '" + flags[i:i+1] + "'")
+		}
+		return opt, nil
+	}
+names:
+	for _, name := range strings.Split(flags, "|") {
+		for _, o := range optionNames {
+			if o.name == name {
+				opt |= o.opt
+				continue names
+			}
+		}
+		return 0, errors.New("regexp2: unknown option '" + name + "'")
+	}
+	return opt, nil
+}
@@ -0,0 +1,218 @@
+package regexp2
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+func TestMarshalBinary_RoundTrip(t *testing.T) {
+	keywords := `\b(?:if|else|for|while|switch|case|break|return|goto)\b`
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`(?<year>\d{4})-(?<month>\d\d)`, 0, "on 2019-04 and 2020-11"},
+		{`a(b|c)*d`, RE2, "xabcbd acd ad"},
+		{`(?i)straße|ǅ`, 0, "STRASSE Straße ǆ"},
+		{`(\w+)\s\1`, 0, "hello hello world world"},
+		{`(?<=\$)\d+(?:\.\d\d)?`, 0, "cost $12.50 or $7"},
+		{`\p{Lu}\p{Ll}+`, 0, "Hello World ÉCOLE École"},
+		{`[a-z-[aeiou]]+`, 0, "rhythm and blues"},
+		{`\d+`, RightToLeft, "12 345 6"},
+		{keywords, 0, "if x then return y else goto z"},
+		{keywords, IgnoreCase, "IF x Then RETURN"},
+		{`(a|ab)(c|bcd)(d*)`, Memoize, "abcd"},
+		{`^\s*#.*$`, Multiline, "x\n  # comment\ny"},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		data, err := re.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v: %v", test.pattern, err)
+		}
+		var loaded Regexp
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%v: %v", test.pattern, err)
+		}
+
+		if loaded.String() != re.String() {
+			t.Errorf("wanted pattern %v, got %v", re.String(), loaded.String())
+		}
+		if !reflect.DeepEqual(loaded.GetGroupNames(), re.GetGroupNames()) {
+			t.Errorf("%v: wanted groups %v, got %v", test.pattern, re.GetGroupNames(), loaded.GetGroupNames())
+		}
+		if want, got := re.FindAllStringSubmatchIndex(test.input, -1), loaded.FindAllStringSubmatchIndex(test.input, -1); !reflect.DeepEqual(want, got) {
+			t.Errorf("%v on %q: wanted %v, got %v", test.pattern, test.input, want, got)
+		}
+		want, _ := re.Replace(test.input, "<$0>", -1, -1)
+		got, _ := loaded.Replace(test.input, "<$0>", -1, -1)
+		if want != got {
+			t.Errorf("%v: Replace wanted %q, got %q", test.pattern, want, got)
+		}
+
+		// the same code encodes the same way
+		again, _ := loaded.MarshalBinary()
+		if string(again) != string(data) {
+			t.Errorf("%v: encoding changed after a round trip", test.pattern)
+		}
+	}
+}
+
+func TestMarshalBinary_Settings(t *testing.T) {
+	re := MustCompile(`(a+)+b`, 0)
+	re.MatchTimeout = time.Second
+	re.MaxSteps = 1000
+	re.Longest()
+	data, err := re.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded Regexp
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.MatchTimeout != time.Second || loaded.MaxSteps != 1000 || !loaded.longest {
+		t.Errorf("settings weren't kept: %v %v %v", loaded.MatchTimeout, loaded.MaxSteps, loaded.longest)
+	}
+	if _, err := loaded.MatchString(strings.Repeat("a", 40)); err == nil {
+		t.Error("expected the step limit to stop the match")
+	}
+
+	re, _ = CompileOptimized(`a|b`, 0, 0)
+	re.StackSize = 64
+	re.DetachMatches = true
+	data, _ = re.MarshalBinary()
+	loaded = Regexp{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.StackSize != 64 || !loaded.DetachMatches || loaded.optimizations != 0 {
+		t.Errorf("settings weren't kept: %v %v %v", loaded.StackSize, loaded.DetachMatches, loaded.optimizations)
+	}
+
+	// a joined pattern still says which one matched
+	joined, err := Join(MustCompile(`\d+`, 0), MustCompile(`[a-z]+`, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ = joined.MarshalBinary()
+	loaded = Regexp{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := loaded.FindStringMatch("-- abc"); m == nil || m.Joined() != 1 {
+		t.Errorf("wanted a match of pattern 1, got %v", m)
+	}
+}
+
+func TestMarshalBinary_Culture(t *testing.T) {
+	re, err := CompileCulture(`istanbul`, IgnoreCase, unicode.TurkishCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := re.MarshalBinary()
+	var loaded Regexp
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := loaded.MatchString("İSTANBUL"); !ok {
+		t.Error("expected İSTANBUL to match with Turkish casing")
+	}
+	if ok, _ := loaded.MatchString("ISTANBUL"); ok {
+		t.Error("expected ISTANBUL not to match with Turkish casing")
+	}
+
+	// and the culture is there to compile it again with
+	if ci, err := loaded.WithOptions(IgnoreCase | Multiline); err != nil {
+		t.Fatal(err)
+	} else if ok, _ := ci.MatchString("ISTANBUL"); ok {
+		t.Error("expected ISTANBUL not to match after WithOptions")
+	}
+}
+
+func TestMarshalBinary_Corrupt(t *testing.T) {
+	data, _ := MustCompile(`a[bc]+d`, 0).MarshalBinary()
+	var re Regexp
+	for _, bad := range [][]byte{nil, []byte("nonsense"), data[:len(data)/2]} {
+		if err := re.UnmarshalBinary(bad); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+
+	// damaged code is an error, never a panic
+	code, _ := MustCompile(`(?<x>a[bc]+d|e\1)`, 0).code.MarshalBinary()
+	for i := range code {
+		damaged := append([]byte(nil), code...)
+		damaged[i] ^= 0x55
+		syntax.UnmarshalCode(damaged)
+	}
+	if _, err := syntax.UnmarshalCode(code[:len(code)-1]); err == nil {
+		t.Error("expected an error for truncated code")
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		text    string
+	}{
+		{`^\d+$`, 0, `^\d+$`},
+		{`^\d+$`, IgnoreCase | Multiline, `/^\d+$/im`},
+		{`a/b`, Singleline, `/a/b/s`},
+		{`/usr/.*`, 0, `//usr/.*/`},
+		{`\d+`, IgnoreCase | RE2, `/\d+/IgnoreCase|RE2`},
+		{``, 0, ``},
+	}
+	for _, test := range tests {
+		text, err := MustCompile(test.pattern, test.opt).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != test.text {
+			t.Errorf("%v: wanted %v, got %v", test.pattern, test.text, string(text))
+		}
+		var re Regexp
+		if err := re.UnmarshalText(text); err != nil {
+			t.Fatalf("%v: %v", string(text), err)
+		}
+		if re.String() != test.pattern || re.options != test.opt {
+			t.Errorf("%v: read back as %v with options %v", string(text), re.String(), re.options)
+		}
+	}
+
+	for _, bad := range []string{`/a/q`, `/a/Nope`, `/a(/i`, `a(`} {
+		var re Regexp
+		if err := re.UnmarshalText([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %v", bad)
+		}
+	}
+}
+
+func TestMarshalText_JSON(t *testing.T) {
+	var config struct {
+		Name  string
+		Match *Regexp
+	}
+	if err := json.Unmarshal([]byte(`{"Name": "ids", "Match": "/id-(\\d+)/i"}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := config.Match.FindStringMatch("ID-42"); m == nil || m.GroupByNumber(1).String() != "42" {
+		t.Errorf("unexpected match %v", m)
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Name":"ids","Match":"/id-(\\d+)/i"}`; string(data) != want {
+		t.Errorf("wanted %v, got %v", want, string(data))
+	}
+}
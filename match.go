@@ -3,6 +3,8 @@ package regexp2
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"unicode/utf8"
 )
 
 // Match is a single regex result match that contains groups and repeated captures
@@ -29,6 +31,18 @@ type Match struct {
 	// whether we've done any balancing with this match.  If we
 	// have done balancing, we'll need to do extra work in Tidy().
 	balancing bool
+
+	// name of the last (*MARK) on the matching path
+	mark string
+
+	// whether the text ended before the match was complete
+	partial bool
+
+	// whether text is only the part of the input the match spans
+	detached bool
+
+	// the byte offset of base in the input, or -1 if it's not known
+	baseByte int
 }
 
 // Group is an explicit or implit (group 0) matched group within the pattern
@@ -39,10 +53,21 @@ type Group struct {
 	Captures []Capture // captures of this group
 }
 
+// Success reports whether the group took part in the match, as .NET's
+// Group.Success does.  A group that didn't has no Captures, and it and its
+// embedded Capture are empty, which also describes a group that matched
+// the empty string.
+func (g *Group) Success() bool {
+	return len(g.Captures) > 0
+}
+
 // Capture is a single capture of text within the larger original string
 type Capture struct {
 	// the original string
 	text []rune
+	// where text starts in the original string, which is only after its
+	// start in a detached Match
+	base int
 	// the position in the original string where the first character of
 	// captured substring was found.
 	Index int
@@ -52,12 +77,18 @@ type Capture struct {
 
 // String returns the captured text as a String
 func (c *Capture) String() string {
-	return string(c.text[c.Index : c.Index+c.Length])
+	return string(c.Runes())
 }
 
 // Runes returns the captured text as a rune slice
 func (c *Capture) Runes() []rune {
-	return c.text[c.Index : c.Index+c.Length]
+	if c.Length == 0 {
+		// the Index of a group that didn't match may be outside the text
+		// a detached Match kept
+		return c.text[:0]
+	}
+	i := c.Index - c.base
+	return c.text[i : i+c.Length]
 }
 
 func newMatch(regex *Regexp, capcount int, text []rune, startpos int) *Match {
@@ -87,6 +118,12 @@ func (m *Match) reset(text []rune, textstart int) {
 		m.matchcount[i] = 0
 	}
 	m.balancing = false
+	m.mark = ""
+	m.partial = false
+	m.detached = false
+	m.base = 0
+	m.baseByte = 0
+	m.otherGroups = m.otherGroups[:0]
 }
 
 func (m *Match) tidy(textpos int) {
@@ -96,8 +133,9 @@ func (m *Match) tidy(textpos int) {
 	m.Length = interval[1]
 	m.textpos = textpos
 	m.capcount = m.matchcount[0]
-	//copy our root capture to the list
-	m.Group.Captures = []Capture{m.Group.Capture}
+	//copy our root capture to the list, in the slice we had if this Match
+	//is being reused
+	m.Group.Captures = append(m.Group.Captures[:0], m.Group.Capture)
 
 	if m.balancing {
 		// The idea here is that we want to compact all of our unbalanced captures.  To do that we
@@ -220,17 +258,29 @@ func (m *Match) removeMatch(c int) {
 	m.matchcount[c]--
 }
 
+// Mark returns the name of the last (*MARK:NAME), (*PRUNE:NAME) or
+// (*COMMIT:NAME) passed on the way to the match, or "" if there was none.
+func (m *Match) Mark() string {
+	return m.mark
+}
+
 // GroupCount returns the number of groups this match has matched
 func (m *Match) GroupCount() int {
 	return len(m.matchcount)
 }
 
-// GroupByName returns a group based on the name of the group, or nil if the group name does not exist
+// GroupByName returns a group based on the name of the group, or nil if the group name does not exist.
+// With DupNames, it's the first group of that name that matched, if any did.
 func (m *Match) GroupByName(name string) *Group {
 	num := m.regex.GroupNumberFromName(name)
 	if num < 0 {
 		return nil
 	}
+	for _, n := range m.regex.dupnames[name] {
+		if g := m.GroupByNumber(n); g.Success() {
+			return g
+		}
+	}
 	return m.GroupByNumber(num)
 }
 
@@ -265,15 +315,147 @@ func (m *Match) Groups() []Group {
 }
 
 func (m *Match) populateOtherGroups() {
-	// Construct all the Group objects first time called
-	if m.otherGroups == nil {
-		m.otherGroups = make([]Group, len(m.matchcount)-1)
-		for i := 0; i < len(m.otherGroups); i++ {
-			m.otherGroups[i] = newGroup(m.regex.GroupNameFromNumber(i+1), m.text, m.matches[i+1], m.matchcount[i+1])
+	// Construct all the Group objects first time called, reusing the ones
+	// from before if this Match is being reused
+	if len(m.otherGroups) == 0 && len(m.matchcount) > 1 {
+		groups := m.otherGroups[:cap(m.otherGroups)]
+		if len(groups) < len(m.matchcount)-1 {
+			groups = make([]Group, len(m.matchcount)-1)
+		}
+		groups = groups[:len(m.matchcount)-1]
+		for i := 0; i < len(groups); i++ {
+			groups[i] = newGroup(m.regex.GroupNameFromNumber(i+1), m.text, m.base, m.matches[i+1], m.matchcount[i+1], groups[i].Captures)
 		}
+		m.otherGroups = groups
 	}
 }
 
+// Detach copies the part of the input that m and its groups span, and
+// drops m's reference to the rest, so that keeping m doesn't keep all of
+// the input in memory.  Indexes are still into the input.  A detached
+// Match can't be passed to FindNextMatch or the others that search on
+// from it.
+func (m *Match) Detach() {
+	m.detachAt(0)
+}
+
+// detachAt detaches m and moves its indexes offset runes on, for a match
+// found in a piece of some longer text
+func (m *Match) detachAt(offset int) {
+	if m.detached {
+		return
+	}
+	lo, hi := m.Index, m.Index+m.Length
+	for i, count := range m.matchcount {
+		for j := 0; j < count; j++ {
+			index, length := m.matches[i][j*2], m.matches[i][j*2+1]
+			if index < lo {
+				lo = index
+			}
+			if index+length > hi {
+				hi = index + length
+			}
+		}
+	}
+
+	m.baseByte = -1
+	if offset == 0 {
+		m.baseByte = utf8Len(m.text[:lo])
+	}
+	m.text = append([]rune(nil), m.text[lo:hi]...)
+	m.base = lo + offset
+	if offset != 0 {
+		for i, count := range m.matchcount {
+			for j := 0; j < count; j++ {
+				m.matches[i][j*2] += offset
+			}
+		}
+		m.Index += offset
+		m.textpos += offset
+		m.textstart += offset
+	}
+	m.Group.Captures = append(m.Group.Captures[:0], m.Group.Capture)
+	// built again from the copy when they're asked for
+	m.otherGroups = m.otherGroups[:0]
+	m.detached = true
+}
+
+// CaptureSpan is where a capture is in the text, in runes and in bytes.
+type CaptureSpan struct {
+	Index, Length int // in runes, as a Capture has them
+
+	// in the bytes of the text's UTF-8 encoding, so into the string
+	// searched if it's valid UTF-8, or -1 for most matches from
+	// FindSourceMatch, where they aren't known
+	ByteIndex, ByteLength int
+}
+
+// CaptureHistory returns every capture the group numbered num made, such
+// as each repetition of (\w+,)+, in the order of the text, even for a
+// RightToLeft pattern, which makes them from the end back.  It's nil if
+// there's no such group, or it didn't take part in the match.
+func (m *Match) CaptureHistory(num int) []CaptureSpan {
+	g := m.GroupByNumber(num)
+	if g == nil || !g.Success() {
+		return nil
+	}
+	spans := make([]CaptureSpan, len(g.Captures))
+	for i, c := range g.Captures {
+		spans[i] = CaptureSpan{Index: c.Index, Length: c.Length}
+	}
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].Index < spans[j].Index })
+
+	// the byte offsets in one pass over the text, but for lengths, as
+	// nested captures can overlap
+	pos, bytePos := m.base, m.baseByte
+	for i := range spans {
+		s := &spans[i]
+		if m.baseByte < 0 {
+			s.ByteIndex, s.ByteLength = -1, -1
+			continue
+		}
+		bytePos += utf8Len(m.text[pos-m.base : s.Index-m.base])
+		pos = s.Index
+		s.ByteIndex = bytePos
+		s.ByteLength = utf8Len(m.text[s.Index-m.base : s.Index-m.base+s.Length])
+	}
+	return spans
+}
+
+// utf8Len is how many bytes r takes in UTF-8, with invalid runes taking
+// the 3 of the utf8.RuneError they're written as
+func utf8Len(r []rune) int {
+	n := 0
+	for _, c := range r {
+		if l := utf8.RuneLen(c); l > 0 {
+			n += l
+		} else {
+			n += 3
+		}
+	}
+	return n
+}
+
+// Release gives m back to its Regexp, whose later searches can fill it in
+// again instead of allocating a new Match.  Neither m nor anything got
+// from it, such as its groups and captures, may be used afterwards.
+// Calling it is optional; a Match that's not released is garbage
+// collected as usual.
+func (m *Match) Release() {
+	if m == nil || m.regex == nil {
+		return
+	}
+	// don't keep the text alive from the pool
+	m.text = nil
+	m.Group = Group{Name: m.Name, Captures: m.Group.Captures[:0]}
+	for i := range m.otherGroups {
+		m.otherGroups[i].text = nil
+		m.otherGroups[i].Captures = m.otherGroups[i].Captures[:0]
+	}
+	m.otherGroups = m.otherGroups[:0]
+	m.regex.matchPool.Put(m)
+}
+
 func (m *Match) groupValueAppendToBuf(groupnum int, buf *bytes.Buffer) {
 	c := m.matchcount[groupnum]
 	if c == 0 {
@@ -282,7 +464,7 @@ func (m *Match) groupValueAppendToBuf(groupnum int, buf *bytes.Buffer) {
 
 	matches := m.matches[groupnum]
 
-	index := matches[(c-1)*2]
+	index := matches[(c-1)*2] - m.base
 	last := index + matches[(c*2)-1]
 
 	for ; index < last; index++ {
@@ -290,18 +472,25 @@ func (m *Match) groupValueAppendToBuf(groupnum int, buf *bytes.Buffer) {
 	}
 }
 
-func newGroup(name string, text []rune, caps []int, capcount int) Group {
+// newGroup builds a group from its captures, reusing the space of
+// captures if there's enough
+func newGroup(name string, text []rune, base int, caps []int, capcount int, captures []Capture) Group {
 	g := Group{}
 	g.text = text
+	g.base = base
 	if capcount > 0 {
 		g.Index = caps[(capcount-1)*2]
 		g.Length = caps[(capcount*2)-1]
 	}
 	g.Name = name
-	g.Captures = make([]Capture, capcount)
+	if captures == nil || cap(captures) < capcount {
+		captures = make([]Capture, capcount)
+	}
+	g.Captures = captures[:capcount]
 	for i := 0; i < capcount; i++ {
 		g.Captures[i] = Capture{
 			text:   text,
+			base:   base,
 			Index:  caps[i*2],
 			Length: caps[i*2+1],
 		}
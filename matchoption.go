@@ -0,0 +1,104 @@
+package regexp2
+
+import "time"
+
+// A MatchOption changes how a single call matches, such as
+// FindStringMatch(s, WithTimeout(50*time.Millisecond)), without changing
+// the Regexp's fields, which every goroutine using it shares.
+type MatchOption func(*matchConfig)
+
+// matchConfig is what a search runs with: the Regexp's settings, changed
+// by the call's options
+type matchConfig struct {
+	timeout   time.Duration
+	deadline  time.Time
+	maxSteps  int
+	maxMemory int
+	anchored  bool
+	stats     *MatchStats
+
+	// the search goes on one past an empty match, so \G, which is where
+	// that match ended, can't match
+	afterEmpty bool
+
+	// if not 0, the last place a left to right search tries a match at,
+	// for FindAllParallel's chunks
+	stop int
+}
+
+// WithTimeout gives the call a timeout of d instead of MatchTimeout.
+func WithTimeout(d time.Duration) MatchOption {
+	return func(c *matchConfig) {
+		c.timeout = d
+	}
+}
+
+// WithDeadline gives up on the call at t, as well as after the timeout.
+// Unlike a timeout, which starts over for each match, a deadline holds for
+// all the matches a call like Replace or Count looks for.
+func WithDeadline(t time.Time) MatchOption {
+	return func(c *matchConfig) {
+		c.deadline = t
+	}
+}
+
+// WithMaxSteps gives the call a step budget of n instead of MaxSteps; 0 is
+// no limit.
+func WithMaxSteps(n int) MatchOption {
+	return func(c *matchConfig) {
+		c.maxSteps = n
+	}
+}
+
+// WithMaxMemory gives the call a memory budget of n bytes instead of
+// MaxMemory; 0 is no limit.
+func WithMaxMemory(n int) MatchOption {
+	return func(c *matchConfig) {
+		c.maxMemory = n
+	}
+}
+
+// WithStartAnchor only accepts a match that starts where the search does:
+// at the start of the input, at startAt, or for FindNextMatch where the
+// previous match ended, as if the pattern began with \G.
+func WithStartAnchor() MatchOption {
+	return func(c *matchConfig) {
+		c.anchored = true
+	}
+}
+
+// MatchStats is what the engine did for the calls it's given to with
+// WithStats, to find the patterns in a rule set that cost the most.
+type MatchStats struct {
+	Steps      int           // engine steps, as MaxSteps counts them
+	Backtracks int           // times the engine went back to try another way
+	PeakStack  int           // most entries on the backtracking stacks at once
+	Elapsed    time.Duration // time spent searching
+}
+
+// WithStats adds what the call does to s.  One MatchStats can be given to
+// many calls to sum them up, but not to calls running at the same time.
+// A search that's ruled out before the engine runs, say because the text
+// doesn't have a literal every match needs, takes no steps.
+func WithStats(s *MatchStats) MatchOption {
+	return func(c *matchConfig) {
+		c.stats = s
+	}
+}
+
+// config is the matchConfig for a call with opts
+func (re *Regexp) config(opts []MatchOption) matchConfig {
+	c := matchConfig{timeout: re.MatchTimeout, maxSteps: re.MaxSteps, maxMemory: re.MaxMemory}
+	if len(opts) > 0 {
+		// c itself would escape to the heap on every call
+		c = c.with(opts)
+	}
+	return c
+}
+
+func (c matchConfig) with(opts []MatchOption) matchConfig {
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
@@ -0,0 +1,158 @@
+package regexp2
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	re := MustCompile(`(.+)*\?`, 0)
+	if _, err := re.FindStringMatch("Do you think you found the problem string!", WithTimeout(time.Millisecond)); err == nil {
+		t.Fatal("expected timeout err")
+	}
+	if re.MatchTimeout != DefaultMatchTimeout {
+		t.Fatalf("MatchTimeout changed to %v", re.MatchTimeout)
+	}
+
+	// the option overrides the Regexp's own timeout both ways
+	re.MatchTimeout = time.Millisecond
+	if ok, err := re.MatchString("x?", WithTimeout(time.Minute)); err != nil || !ok {
+		t.Fatalf("wanted a match, got %v, %v", ok, err)
+	}
+}
+
+func TestWithMaxSteps(t *testing.T) {
+	re := MustCompile(`(.+)*\?`, 0)
+	_, err := re.FindStringMatch("Do you think you found the problem string!", WithMaxSteps(1000))
+	serr, ok := err.(*StepLimitError)
+	if !ok {
+		t.Fatalf("expected *StepLimitError, got %v", err)
+	}
+	if serr.MaxSteps != 1000 {
+		t.Fatalf("wanted MaxSteps 1000, got %v", serr.MaxSteps)
+	}
+	if re.MaxSteps != 0 {
+		t.Fatalf("MaxSteps changed to %v", re.MaxSteps)
+	}
+
+	// and the next call has no limit again
+	if _, err := re.FindStringMatch("Do you think you found the problem string?"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+func TestWithStartAnchor(t *testing.T) {
+	re := MustCompile(`\d+`, 0)
+
+	if m, err := re.FindStringMatch("ab12", WithStartAnchor()); err != nil || m != nil {
+		t.Fatalf("wanted no match, got %v, %v", m, err)
+	}
+	if ok, err := re.MatchString("ab12", WithStartAnchor()); err != nil || ok {
+		t.Fatalf("wanted no match, got %v, %v", ok, err)
+	}
+	if ok, _ := re.MatchString("12ab", WithStartAnchor()); !ok {
+		t.Fatal("wanted a match")
+	}
+
+	m, err := re.FindStringMatchStartingAt("ab12", 2, WithStartAnchor())
+	if err != nil || m == nil || m.Index != 2 || m.String() != "12" {
+		t.Fatalf("wanted 12 at 2, got %v, %v", m, err)
+	}
+
+	// FindNextMatch is anchored where the last match ended, so this only
+	// finds the digits up to the first gap
+	var got []string
+	for m, _ := re.FindStringMatch("1 2 3", WithStartAnchor()); m != nil; m, _ = re.FindNextMatch(m, WithStartAnchor()) {
+		got = append(got, m.String())
+	}
+	if len(got) != 1 || got[0] != "1" {
+		t.Fatalf("wanted [1], got %v", got)
+	}
+	re = MustCompile(`\d,?`, 0)
+	got = nil
+	for m, _ := re.FindStringMatch("1,2,3 4", WithStartAnchor()); m != nil; m, _ = re.FindNextMatch(m, WithStartAnchor()) {
+		got = append(got, m.String())
+	}
+	if want := []string{"1,", "2,", "3"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("wanted %v, got %v", want, got)
+	}
+
+	re = MustCompile(`\d+`, RightToLeft)
+	if m, _ := re.FindStringMatch("12ab", WithStartAnchor()); m != nil {
+		t.Fatalf("wanted no match, got %v", m)
+	}
+	if m, _ := re.FindStringMatch("ab12", WithStartAnchor()); m == nil || m.String() != "12" {
+		t.Fatalf("wanted 12, got %v", m)
+	}
+}
+
+func TestWithDeadline(t *testing.T) {
+	re := MustCompile(`(.+)*\?`, 0)
+	re.MatchTimeout = time.Minute
+	start := time.Now()
+	_, err := re.FindStringMatch("Do you think you found the problem string!", WithDeadline(start.Add(time.Millisecond)))
+	if err == nil || !strings.Contains(err.Error(), "deadline") {
+		t.Fatalf("expected deadline err, got %v", err)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Fatalf("took %v to time out", d)
+	}
+
+	// the timeout still applies when it's sooner
+	re.MatchTimeout = time.Millisecond
+	_, err = re.FindStringMatch("Do you think you found the problem string!", WithDeadline(time.Now().Add(time.Hour)))
+	if err == nil || strings.Contains(err.Error(), "deadline") {
+		t.Fatalf("expected timeout err, got %v", err)
+	}
+}
+
+func TestDeadlinePassed(t *testing.T) {
+	re := MustCompile(`a`, 0)
+	past := time.Now().Add(-time.Second)
+
+	if ok, err := re.MatchStringDeadline("a", past); ok || err == nil {
+		t.Fatalf("wanted a timeout, got %v, %v", ok, err)
+	}
+	if ok, err := re.MatchStringDeadline("a", time.Now().Add(time.Minute)); !ok || err != nil {
+		t.Fatalf("wanted a match, got %v, %v", ok, err)
+	}
+
+	if s, err := re.Replace("banana", "o", -1, -1, WithDeadline(past)); err == nil {
+		t.Fatalf("wanted a timeout, got %q", s)
+	}
+	if s, err := re.Replace("banana", "o", -1, -1, WithDeadline(time.Now().Add(time.Minute))); s != "bonono" || err != nil {
+		t.Fatalf("wanted bonono, got %q, %v", s, err)
+	}
+	if n, err := re.Count("banana", WithDeadline(past)); n != 0 || err == nil {
+		t.Fatalf("wanted a timeout, got %v, %v", n, err)
+	}
+}
+
+func TestWithStats(t *testing.T) {
+	re := MustCompile(`(a|b)*\1`, 0)
+	var stats MatchStats
+	if m, err := re.FindStringMatch("ababb", WithStats(&stats)); err != nil || m == nil {
+		t.Fatalf("expected match, got %v, %v", m, err)
+	}
+	if stats.Steps == 0 || stats.Backtracks == 0 || stats.PeakStack == 0 {
+		t.Fatalf("missing stats: %+v", stats)
+	}
+
+	// the steps are the ones MaxSteps counts
+	if _, err := re.FindStringMatch("ababb", WithMaxSteps(stats.Steps)); err != nil {
+		t.Fatalf("unexpected err with MaxSteps %v: %v", stats.Steps, err)
+	}
+	if _, err := re.FindStringMatch("ababb", WithMaxSteps(stats.Steps-1)); err == nil {
+		t.Fatalf("expected an err with MaxSteps %v", stats.Steps-1)
+	}
+
+	// and they add up over the matches of a call
+	var all MatchStats
+	if n, _ := re.Count("ababb ababb", WithStats(&all)); n != 2 {
+		t.Fatalf("wanted 2 matches, got %v", n)
+	}
+	if all.Steps <= stats.Steps || all.PeakStack != stats.PeakStack {
+		t.Fatalf("wanted more steps than %+v, got %+v", stats, all)
+	}
+}
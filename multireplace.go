@@ -0,0 +1,148 @@
+package regexp2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// ReplaceRule is one rule of a MultiReplacer: a pattern, and what to put in
+// place of its matches, Func's result if it's set, or else Template, a
+// replacement pattern as Replace takes.  In the pattern, \G matches where
+// the last match of any of the rules ended.
+type ReplaceRule struct {
+	Pattern  string
+	Template string
+	Func     MatchEvaluator
+}
+
+// MultiReplacer applies a list of rules to a text in one pass, like
+// strings.Replacer does with fixed strings, rather than one Replace after
+// another, where a rule can match what an earlier one put in.  Going left
+// to right, the match that starts first is replaced, and of those that
+// start at the same place, the one of the rule that comes first; the text
+// it replaced, and what it was replaced with, isn't looked at again.  A
+// MultiReplacer is safe for concurrent use by multiple goroutines.
+type MultiReplacer struct {
+	rules []multiRule
+}
+
+type multiRule struct {
+	re   *Regexp
+	data *syntax.ReplacerData
+	fn   MatchEvaluator
+
+	// with \G or backtracking control verbs, where a match is depends on
+	// where the search starts, so the rule is searched again from each
+	// position
+	fromPos bool
+}
+
+// CompileMultiReplacer compiles the rules' patterns with opt.  They keep
+// their order, which is their precedence.  RightToLeft can't be used.
+func CompileMultiReplacer(rules []ReplaceRule, opt RegexOptions) (*MultiReplacer, error) {
+	if opt&RightToLeft != 0 {
+		return nil, errors.New("a MultiReplacer's rules can't be RightToLeft")
+	}
+	mr := &MultiReplacer{rules: make([]multiRule, len(rules))}
+	for i, rule := range rules {
+		re, err := Compile(rule.Pattern, opt)
+		if err != nil {
+			return nil, fmt.Errorf("rule %v: %v", i, err)
+		}
+		mr.rules[i] = multiRule{re: re, fn: rule.Func}
+		if rule.Func == nil {
+			if mr.rules[i].data, err = re.replacerData(rule.Template); err != nil {
+				return nil, fmt.Errorf("rule %v: replacement %q: %v", i, rule.Template, err)
+			}
+		}
+		for _, f := range re.Analyze().Features {
+			if f == FeatureStartAnchor || f == FeatureBacktrackingVerb {
+				mr.rules[i].fromPos = true
+			}
+		}
+	}
+	return mr, nil
+}
+
+// MustCompileMultiReplacer is like CompileMultiReplacer but panics if any
+// of the rules can't be parsed.
+func MustCompileMultiReplacer(rules []ReplaceRule, opt RegexOptions) *MultiReplacer {
+	mr, err := CompileMultiReplacer(rules, opt)
+	if err != nil {
+		panic(`regexp2: CompileMultiReplacer: ` + err.Error())
+	}
+	return mr
+}
+
+// Regexp returns the compiled pattern of rule i, whose settings such as
+// MatchTimeout the rule's searches use.
+func (mr *MultiReplacer) Regexp(i int) *Regexp {
+	return mr.rules[i].re
+}
+
+// Replace returns s with the rules applied.  opts apply to each search.
+func (mr *MultiReplacer) Replace(s string, opts ...MatchOption) (string, error) {
+	input := getRunes(s)
+
+	// each rule's first match from where it was last searched, which is
+	// still its first from pos if it starts at pos or later
+	next := make([]*Match, len(mr.rules))
+	searched := make([]bool, len(mr.rules))
+
+	buf := &bytes.Buffer{}
+	done, pos, n := 0, 0, 0
+	for pos <= len(input) {
+		best := -1
+		for i := range mr.rules {
+			rule := &mr.rules[i]
+			if !searched[i] || rule.fromPos || next[i] != nil && next[i].Index < pos {
+				m, err := rule.re.run(false, pos, input, opts...)
+				if err != nil {
+					return "", err
+				}
+				next[i], searched[i] = m, true
+			}
+			if m := next[i]; m != nil && (best < 0 || m.Index < next[best].Index) {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		m, rule := next[best], &mr.rules[best]
+		buf.WriteString(string(input[done:m.Index]))
+		if rule.fn != nil {
+			buf.WriteString(rule.fn(*m))
+		} else {
+			replacementImpl(rule.data, buf, m)
+		}
+		n++
+
+		// after an empty match, the rune there is copied with the text
+		// before the next one
+		done = m.Index + m.Length
+		pos = done
+		if m.Length == 0 {
+			pos++
+		}
+	}
+
+	if n == 0 {
+		return s, nil
+	}
+	buf.WriteString(string(input[done:]))
+	return buf.String(), nil
+}
+
+// ReplaceBytes is like Replace for UTF-8 encoded bytes.
+func (mr *MultiReplacer) ReplaceBytes(b []byte, opts ...MatchOption) ([]byte, error) {
+	result, err := mr.Replace(string(b), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(result), nil
+}
@@ -0,0 +1,48 @@
+package regexp2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMultiReplacer(t *testing.T) {
+	upper := func(m Match) string { return strings.ToUpper(m.String()) }
+	tests := []struct {
+		rules []ReplaceRule
+		input string
+		want  string
+	}{
+		// each rule's output isn't matched by the other
+		{[]ReplaceRule{{Pattern: `a`, Template: "b"}, {Pattern: `b`, Template: "a"}}, "abba", "baab"},
+		// the first match wins, then the first rule
+		{[]ReplaceRule{{Pattern: `foobar`, Template: "1"}, {Pattern: `o+`, Template: "2"}}, "foobar foo", "1 f2"},
+		{[]ReplaceRule{{Pattern: `foo`, Template: "1"}, {Pattern: `foobar`, Template: "2"}}, "foobar", "1bar"},
+		{[]ReplaceRule{{Pattern: `foobar`, Template: "2"}, {Pattern: `foo`, Template: "1"}}, "foobar foo", "2 1"},
+		{[]ReplaceRule{{Pattern: `(\w+)@(\w+)`, Template: "$2 at $1"}, {Pattern: `\d+`, Func: upper}, {Pattern: `[a-z]+`, Func: upper}}, "me@host 42 ok", "host at me 42 OK"},
+		{[]ReplaceRule{{Pattern: `x*`, Template: "-"}, {Pattern: `a`, Template: "b"}}, "xab", "--a-b-"},
+		{[]ReplaceRule{{Pattern: `(?<=a)b`, Template: "c"}, {Pattern: `a`, Template: "x"}}, "abab", "xcxc"},
+		// \G is where any rule's last match ended
+		{[]ReplaceRule{{Pattern: `\G\d`, Template: "#"}, {Pattern: `é`, Template: "e"}}, "12é3 4", "##e# 4"},
+		{[]ReplaceRule{{Pattern: `q`, Template: "z"}}, "none", "none"},
+		{nil, "none", "none"},
+	}
+	for _, tt := range tests {
+		mr := MustCompileMultiReplacer(tt.rules, 0)
+		if got, err := mr.Replace(tt.input); got != tt.want || err != nil {
+			t.Errorf("%v on %q: wanted %q, got %q %v", tt.rules, tt.input, tt.want, got, err)
+		}
+		if got, _ := mr.ReplaceBytes([]byte(tt.input)); string(got) != tt.want {
+			t.Errorf("%v on %q bytes: wanted %q, got %q", tt.rules, tt.input, tt.want, got)
+		}
+	}
+
+	if _, err := CompileMultiReplacer([]ReplaceRule{{Pattern: `a`}, {Pattern: `(`}}, 0); err == nil || !strings.HasPrefix(err.Error(), "rule 1:") {
+		t.Errorf("expected an error for rule 1, got %v", err)
+	}
+	if _, err := CompileMultiReplacer([]ReplaceRule{{Pattern: `a`, Template: `$5000000000`}}, 0); err == nil {
+		t.Error("expected an error for a bad template")
+	}
+	if _, err := CompileMultiReplacer([]ReplaceRule{{Pattern: `a`}}, RightToLeft); err == nil {
+		t.Error("expected an error for RightToLeft")
+	}
+}
@@ -0,0 +1,142 @@
+package regexp2
+
+import "github.com/jviksne/regexp2/syntax"
+
+// onePass matches the code's OnePass at the start of the text, taking the
+// one way the next rune leaves open at each split, with no backtracking.
+// ok is false if the text leaves both ways open, and the backtracker has to
+// find the match after all.
+func (r *runner) onePass() (matched, ok bool, err error) {
+	op := r.code.OnePass
+	if cap(r.onePassStarts) < r.code.Capsize {
+		r.onePassStarts = make([]int, r.code.Capsize)
+	}
+	starts := r.onePassStarts[:r.code.Capsize]
+
+	pc, pos := op.Start, 0
+	for empty := 0; ; {
+		inst := &op.Insts[pc]
+		switch inst.Op {
+		case syntax.NFAMatch:
+			if r.fullMatch && pos != r.runtextend {
+				return false, true, nil
+			}
+			r.runtextpos = pos
+			return true, true, nil
+
+		case syntax.NFAChar, syntax.NFANotChar, syntax.NFASet:
+			if pos == r.runtextend || !r.nfaConsumes(inst, r.runtext[pos]) {
+				return false, true, nil
+			}
+			if err := r.checkTimeout(); err != nil {
+				return false, false, err
+			}
+			pos++
+			pc = inst.Out
+			empty = 0
+			continue
+
+		case syntax.NFASplit:
+			first := op.First[pc]
+			out, out1 := r.onePassAccepts(first[0], pos), r.onePassAccepts(first[1], pos)
+			switch {
+			case out && out1:
+				return false, false, nil
+			case out:
+				pc = inst.Out
+			case out1:
+				pc = inst.Out1
+			default:
+				return false, true, nil
+			}
+
+		case syntax.NFAAssert:
+			if !r.nfaAssert(inst.Assert, pos) {
+				return false, true, nil
+			}
+			pc = inst.Out
+
+		case syntax.NFACapture:
+			if slot := inst.Cap / 2; inst.Cap%2 == 0 {
+				starts[slot] = pos
+			} else {
+				r.capture(slot, starts[slot], pos)
+			}
+			pc = inst.Out
+
+		case syntax.NFANop:
+			pc = inst.Out
+
+		default:
+			return false, true, nil
+		}
+
+		// a loop that can go round without reading anything
+		if empty++; empty > len(op.Insts) {
+			return false, false, nil
+		}
+	}
+}
+
+// onePassAccepts says if any of a split's first instructions can go on
+// from pos
+func (r *runner) onePassAccepts(first []int, pos int) bool {
+	for _, pc := range first {
+		inst := &r.code.OnePass.Insts[pc]
+		switch inst.Op {
+		case syntax.NFAMatch:
+			return true
+		case syntax.NFAAssert:
+			if r.nfaAssert(inst.Assert, pos) {
+				return true
+			}
+		default:
+			if pos < r.runtextend && r.nfaConsumes(inst, r.runtext[pos]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nfaConsumes says if inst reads ch, folding it with the code's culture
+func (r *runner) nfaConsumes(inst *syntax.NFAInst, ch rune) bool {
+	if inst.IgnoreCase {
+		ch = r.fold(ch)
+	}
+	switch inst.Op {
+	case syntax.NFAChar:
+		return ch == inst.Ch
+	case syntax.NFANotChar:
+		return ch != inst.Ch
+	default:
+		return inst.Set.CharIn(ch)
+	}
+}
+
+// nfaAssert mirrors the zero-width cases in execute
+func (r *runner) nfaAssert(op syntax.InstOp, pos int) bool {
+	switch op {
+	case syntax.Bol:
+		return pos == 0 || r.runtext[pos-1] == '\n'
+	case syntax.Eol:
+		return pos == r.runtextend || r.runtext[pos] == '\n'
+	case syntax.Boundary:
+		return r.isBoundary(pos, 0, r.runtextend)
+	case syntax.Nonboundary:
+		return !r.isBoundary(pos, 0, r.runtextend)
+	case syntax.ECMABoundary:
+		return r.isECMABoundary(pos, 0, r.runtextend)
+	case syntax.NonECMABoundary:
+		return !r.isECMABoundary(pos, 0, r.runtextend)
+	case syntax.Beginning:
+		return pos == 0
+	case syntax.Start:
+		return pos == r.runtextstart
+	case syntax.EndZ:
+		return pos == r.runtextend || pos == r.runtextend-1 && r.runtext[pos] == '\n'
+	case syntax.End:
+		return pos == r.runtextend
+	}
+	return false
+}
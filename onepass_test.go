@@ -0,0 +1,80 @@
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestOnePass(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		inputs  []string
+	}{
+		{`^\d{4}-\d{2}-\d{2}$`, 0, []string{"2024-01-31", "2024-01-31\n", "2024-1-31", "x2024-01-31", ""}},
+		{`^(?<y>\d{4})-(?<m>\d\d)-(?<d>\d\d)`, 0, []string{"2024-01-31T10", "2024-01-3"}},
+		{`^([a-z]+)@([a-z]+)\.com$`, 0, []string{"me@example.com", "me@example.org", "@x.com"}},
+		{`^(?:(a)|(b))+c`, 0, []string{"abac", "ab", "c"}},
+		{`^(\w+)\s*=\s*(\d+)$`, 0, []string{"x = 1", "abc=22", "x = 1 2"}},
+		{`^\d+(?:\.\d+)?$`, 0, []string{"3.14", "3.", "3", "3.1.4"}},
+		{`^[+-]?\d+\b`, 0, []string{"-12 apples", "+1x", "12"}},
+		{`^abc$`, IgnoreCase, []string{"ABC", "aBc\n", "abd"}},
+		{`^(?m)x$`, 0, []string{"x\ny", "xy"}},
+		{`^(a*)(ab)?b`, 0, []string{"aab", "ab", "b"}},     // ambiguous on some text
+		{`^(a|ab)(c|bcd)(d*)`, 0, []string{"abcd", "acd"}}, // the same
+		{`^(?:a?)*b`, 0, []string{"aab", "b"}},
+		{`^\w+?x`, 0, []string{"abx", "xx"}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		if re.code.OnePass == nil {
+			t.Errorf("%v: no one-pass NFA", tt.pattern)
+			continue
+		}
+
+		// a Tracer makes the engine run the program
+		engine := MustCompile(tt.pattern, tt.opt)
+		engine.Tracer = TracerFunc(func(TraceEvent) {})
+
+		for _, input := range tt.inputs {
+			want, got := Std(engine).FindAllStringSubmatchIndex(input, -1), Std(re).FindAllStringSubmatchIndex(input, -1)
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%v on %q: wanted %v, got %v", tt.pattern, input, want, got)
+			}
+			wantFull, _ := engine.FullMatchString(input)
+			gotFull, _ := re.FullMatchString(input)
+			if wantFull != gotFull {
+				t.Errorf("%v on %q: wanted full match %v, got %v", tt.pattern, input, wantFull, gotFull)
+			}
+		}
+	}
+
+	// the backtracker doesn't run at all
+	var stats MatchStats
+	if ok, _ := MustCompile(`^\d{4}-\d{2}-\d{2}$`, 0).MatchString("2024-01-31", WithStats(&stats)); !ok || stats.Steps != 0 {
+		t.Errorf("wanted a match in no steps, got %v, %+v", ok, stats)
+	}
+
+	// every capture of a repeated group is kept
+	re := MustCompile(`^(?:(\d)-)+$`, 0)
+	m, _ := re.FindStringMatch("1-2-3-")
+	if h := m.CaptureHistory(1); len(h) != 3 || h[0].Index != 0 || h[2].Index != 4 {
+		t.Errorf("wanted 3 captures, got %v", h)
+	}
+
+	for _, pattern := range []string{`\d+$`, `^(a)\1`, `^(?=a)a`, `^(?>a+)b`} {
+		if MustCompile(pattern, 0).code.OnePass != nil {
+			t.Errorf("%v: has a one-pass NFA", pattern)
+		}
+	}
+
+	// the culture's case folding applies
+	tr, _ := CompileCulture(`^istanbul$`, IgnoreCase, unicode.TurkishCase)
+	if ok, _ := tr.MatchString("İSTANBUL"); !ok {
+		t.Error("İSTANBUL didn't match in Turkish")
+	}
+	if ok, _ := tr.MatchString("ISTANBUL"); ok {
+		t.Error("ISTANBUL matched in Turkish")
+	}
+}
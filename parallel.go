@@ -0,0 +1,226 @@
+package regexp2
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// minParallelChunk is the least text, in runes, worth a goroutine of its own
+const minParallelChunk = 1 << 16
+
+// FindAllParallel returns where each match in s and its groups are, as
+// byte offsets, as FindAllStringSubmatchIndex(s, -1) does, but splits a
+// large s into chunks and searches them on up to GOMAXPROCS goroutines.
+// Each goroutine looks for the matches that start in its chunk, reading
+// past the end of it as a match or lookaround needs to, and where the
+// matches of one chunk run into the next, its matches are checked against
+// where a search of the whole text would have gone on from, and searched
+// again from there if they differ, so the result is the same.
+//
+// Patterns whose matches depend on where a search starts, with \G or
+// backtracking control verbs, RightToLeft ones, and calls given
+// WithStartAnchor or WithStats, are searched in one go.
+func (re *Regexp) FindAllParallel(s string, opts ...MatchOption) ([][]int, error) {
+	input := getRunes(s)
+	offs := newByteOffsets(s)
+	cfg := re.config(opts)
+
+	var locs [][]int
+	var err error
+	if chunks := re.parallelChunks(input, cfg); len(chunks) < 2 {
+		locs, err = re.findAllSequential(input, cfg)
+	} else {
+		locs, err = re.findAllChunks(input, chunks, cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if offs != nil {
+		for _, loc := range locs {
+			for i, v := range loc {
+				if v >= 0 {
+					loc[i] = offs.at(v)
+				}
+			}
+		}
+	}
+	return locs, nil
+}
+
+// parallelChunks returns where the chunks of input start, ending each one
+// after a newline if there's one close by, so few matches span two, or nil
+// if it shouldn't be split
+func (re *Regexp) parallelChunks(input []rune, cfg matchConfig) []int {
+	procs := runtime.GOMAXPROCS(0)
+	if procs < 2 || len(input) < 2*minParallelChunk || re.RightToLeft() || cfg.anchored || cfg.stats != nil {
+		return nil
+	}
+	for _, f := range re.Analyze().Features {
+		if f == FeatureStartAnchor || f == FeatureBacktrackingVerb {
+			return nil
+		}
+	}
+
+	n := min(4*procs, len(input)/minParallelChunk)
+	size := len(input) / n
+	starts := []int{0}
+	for i := 1; i < n; i++ {
+		at := i * size
+		if nl := slices.Index(input[at:min(at+1024, len(input))], '\n'); nl >= 0 {
+			at += nl + 1
+		}
+		if at > starts[len(starts)-1] && at < len(input) {
+			starts = append(starts, at)
+		}
+	}
+	return starts
+}
+
+// findAllSequential is the whole search in one go
+func (re *Regexp) findAllSequential(input []rune, cfg matchConfig) ([][]int, error) {
+	r := re.getRunner()
+	defer re.putRunner(r)
+	r.fullMatch = false
+
+	startAt := 0
+	if re.RightToLeft() {
+		startAt = len(input)
+	}
+	var locs [][]int
+	for {
+		m, err := r.scan(input, startAt, false, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if m == nil {
+			return locs, nil
+		}
+		r.runmatch = m
+		locs = append(locs, appendLoc(nil, m, true, nil))
+
+		var ok bool
+		if startAt, ok = re.nextStart(m, &cfg); !ok {
+			return locs, nil
+		}
+	}
+}
+
+// chunkMatches is what a goroutine found in its chunk: each match, and
+// where the search that found it started.  next is where the search after
+// the last one started, which found no match starting in the chunk.
+type chunkMatches struct {
+	from []int
+	locs [][]int
+	next int
+	err  error
+}
+
+// after is where the search after the one for match k started
+func (c *chunkMatches) after(k int) int {
+	if k+1 < len(c.from) {
+		return c.from[k+1]
+	}
+	return c.next
+}
+
+func (re *Regexp) findAllChunks(input []rune, starts []int, cfg matchConfig) ([][]int, error) {
+	// the last chunk has the empty text at the end
+	ends := append(starts[1:len(starts):len(starts)], len(input)+1)
+	chunks := make([]chunkMatches, len(starts))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(c *chunkMatches, pos, end int) {
+			defer func() { <-sem; wg.Done() }()
+			r := re.getRunner()
+			defer re.putRunner(r)
+			c.next = pos
+			for c.next < end {
+				loc, next, err := r.findFrom(input, c.next, end, cfg)
+				if err != nil || loc == nil {
+					c.err = err
+					return
+				}
+				c.from = append(c.from, c.next)
+				c.locs = append(c.locs, loc)
+				c.next = next
+			}
+		}(&chunks[i], starts[i], ends[i])
+	}
+	wg.Wait()
+
+	r := re.getRunner()
+	defer re.putRunner(r)
+
+	// a search of the whole text would go on from pos, and no match
+	// starts between pos and the start of the chunk, if pos is before it
+	var locs [][]int
+	pos := 0
+	for i, c := range chunks {
+		if c.err != nil {
+			return nil, c.err
+		}
+		for pos < ends[i] {
+			if pos <= starts[i] {
+				locs = append(locs, c.locs...)
+				pos = c.next
+				break
+			}
+
+			// the chunk's search that started at or last before pos finds
+			// what a search from pos does, unless its match starts before
+			// pos
+			k := 0
+			for k < len(c.from) && c.after(k) <= pos {
+				k++
+			}
+			if k == len(c.from) {
+				break
+			}
+			if c.locs[k][0] >= pos {
+				locs = append(locs, c.locs[k:]...)
+				pos = c.next
+				break
+			}
+
+			// it spans pos, so search from there
+			loc, next, err := r.findFrom(input, pos, ends[i], cfg)
+			if err != nil {
+				return nil, err
+			}
+			if loc == nil {
+				break
+			}
+			locs = append(locs, loc)
+			pos = next
+		}
+	}
+	return locs, nil
+}
+
+// findFrom finds the first match from pos that starts before end, and
+// where the search after it starts, past the end of the text if there's
+// no more to search
+func (r *runner) findFrom(input []rune, pos, end int, cfg matchConfig) ([]int, int, error) {
+	if end < len(input) {
+		cfg.stop = end
+	}
+	m, err := r.scan(input, pos, false, cfg)
+	if err != nil || m == nil {
+		return nil, 0, err
+	}
+	r.runmatch = m
+	if m.Index >= end {
+		return nil, 0, nil
+	}
+	loc := appendLoc(nil, m, true, nil)
+	next, ok := r.re.nextStart(m, &cfg)
+	if !ok {
+		next = len(input) + 1
+	}
+	return loc, next, nil
+}
@@ -0,0 +1,81 @@
+package regexp2
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFindAllParallel(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	var b strings.Builder
+	for i := 0; b.Len() < 6*minParallelChunk; i++ {
+		switch i % 5 {
+		case 0:
+			b.WriteString("key=value; ключ=значение 42\n")
+		case 1:
+			// long lines, so some chunks can't end at a newline
+			b.WriteString(strings.Repeat("abc ", 700) + "[" + strings.Repeat("x", 3000) + "]\n")
+		case 2:
+			b.WriteString("aaaa bbbb\n\n")
+		default:
+			b.WriteString("é1 2é 3\r\n")
+		}
+	}
+	text := b.String()
+	// matches thousands of runes long, across the ends of chunks
+	spans := strings.Repeat("<"+strings.Repeat("y", 40000)+">", 8)
+
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`(key|ключ)=(\w+)`, 0, text},
+		{`\[[^\]]*\]`, 0, text},
+		{`(?m)^(\w+)?`, 0, text},
+		{`x*`, 0, text},
+		{`(?<=a)b|c\b`, 0, text},
+		{`\d$`, Multiline, text},
+		{`[^\n]{2000,}`, 0, text},
+		{`<y+>`, 0, spans},
+		{`y>|<y{30000}`, 0, spans},
+		{`\G\w`, 0, text},          // searched in one go
+		{`\w+`, RightToLeft, text}, // the same
+		{`x*`, RightToLeft, text},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		want := re.FindAllStringSubmatchIndex(tt.input, -1)
+		got, err := re.FindAllParallel(tt.input)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.pattern, err)
+		}
+		if len(got) != len(want) {
+			t.Errorf("%v: wanted %v matches, got %v", tt.pattern, len(want), len(got))
+			continue
+		}
+		for i := range want {
+			if !reflect.DeepEqual(want[i], got[i]) {
+				t.Errorf("%v: match %v: wanted %v, got %v", tt.pattern, i, want[i], got[i])
+				break
+			}
+		}
+	}
+
+	// a short text isn't split
+	re := MustCompile(`\d`, 0)
+	if got, _ := re.FindAllParallel("a1é2"); !reflect.DeepEqual(got, [][]int{{1, 2}, {4, 5}}) {
+		t.Errorf("wanted [[1 2] [4 5]], got %v", got)
+	}
+	if got, _ := re.FindAllParallel("abc"); got != nil {
+		t.Errorf("wanted nothing, got %v", got)
+	}
+
+	// right to left, the empty match at the start of the text is the last
+	if got, _ := MustCompile(`a*`, RightToLeft).FindAllParallel("ba"); !reflect.DeepEqual(got, [][]int{{1, 2}, {1, 1}, {0, 0}}) {
+		t.Errorf("wanted [[1 2] [1 1] [0 0]], got %v", got)
+	}
+}
@@ -0,0 +1,227 @@
+package regexp2
+
+import "errors"
+
+// PartialMode selects how FindStringPartialMatch treats a text that ends
+// while a match is still under way, the way PCRE's PARTIAL_SOFT and
+// PARTIAL_HARD options do.
+type PartialMode int
+
+const (
+	// PartialSoft prefers a complete match: a partial one is returned only
+	// if there's no complete match anywhere in the text.
+	PartialSoft PartialMode = iota + 1
+
+	// PartialHard returns a partial match as soon as the engine runs into
+	// the end of the text, even if backtracking would have found a
+	// complete match, since more text might have made a longer one.  The
+	// assertions \b, \B, $, \Z and \z also count as running into the end
+	// when they're tested there.
+	PartialHard
+)
+
+// FindStringPartialMatch is like FindStringMatch, but also reports where s
+// could be the start of a match that more text would complete.  Such a
+// match has Partial set, and runs from where it starts to the end of s;
+// its groups other than 0 are empty.  This suits checking input as it's
+// typed, where a partial match means the input isn't wrong yet, and
+// scanning data that arrives in pieces.
+//
+// A partial match is never empty, unless s is.  Partial matching skips the
+// scans that find where a match can start, so it's slower than
+// FindStringMatch, and it isn't done for RightToLeft patterns.
+func (re *Regexp) FindStringPartialMatch(s string, mode PartialMode) (*Match, error) {
+	return re.detach(re.partialSearch(getRunes(s), -1, mode, re.config(nil)))
+}
+
+// FindRunesPartialMatch is like FindStringPartialMatch for a rune slice.
+func (re *Regexp) FindRunesPartialMatch(r []rune, mode PartialMode) (*Match, error) {
+	return re.detach(re.partialSearch(r, -1, mode, re.config(nil)))
+}
+
+// FindRunesPartialMatchStartingAt is like FindRunesPartialMatch, searching
+// from startAt, with the runes before it there for lookbehinds and \b.
+// Code that scans text in pieces can use it to carry on after a match.
+func (re *Regexp) FindRunesPartialMatchStartingAt(r []rune, startAt int, mode PartialMode) (*Match, error) {
+	if startAt < 0 || startAt > len(r) {
+		return nil, errors.New("startAt must be no less than 0 and no more than the length of the input")
+	}
+	return re.detach(re.partialSearch(r, startAt, mode, re.config(nil)))
+}
+
+// Partial reports whether the match ran into the end of the text before it
+// was complete.  Only FindStringPartialMatch and FindRunesPartialMatch
+// return such matches.
+func (m *Match) Partial() bool {
+	return m.partial
+}
+
+// partialSearch searches input from textstart, or the start of it if
+// textstart is -1, with partial matching if mode isn't 0
+func (re *Regexp) partialSearch(input []rune, textstart int, mode PartialMode, cfg matchConfig) (*Match, error) {
+	runner := re.getRunner()
+	defer re.putRunner(runner)
+
+	if textstart < 0 {
+		textstart = 0
+		if re.RightToLeft() {
+			textstart = len(input)
+		}
+	}
+	if !re.RightToLeft() {
+		runner.partial = mode
+		defer func() { runner.partial = 0 }()
+	}
+	runner.fullMatch = false
+	return runner.scan(input, textstart, false, cfg)
+}
+
+// endIf notes that the current attempt ran into the end of the text, if
+// every character left passes test (all of them do if it's nil).  It's
+// called where an instruction needs more characters than there are.
+func (r *runner) endIf(test func(ch rune) bool) {
+	if !r.noteEnd || r.rightToLeft {
+		return
+	}
+	if test != nil {
+		for _, ch := range r.runtext[r.runtextpos:r.runtextend] {
+			if !test(r.foldIf(ch)) {
+				return
+			}
+		}
+	}
+	r.sawEnd = true
+}
+
+// assertAtEnd notes the end of the text for an assertion tested there,
+// which with PartialHard counts as needing what comes next
+func (r *runner) assertAtEnd() {
+	if r.noteEnd && r.textPos() == r.runtextend {
+		r.assertEnd()
+	}
+}
+
+// assertEndZ is assertAtEnd for \Z, and $ without Multiline, which also
+// pass just before a '\n' that ends the text, and don't once more follows
+func (r *runner) assertEndZ() {
+	if !r.noteEnd {
+		return
+	}
+	if pos := r.textPos(); pos == r.runtextend || pos == r.runtextend-1 && r.runtext[pos] == '\n' {
+		r.assertEnd()
+	}
+}
+
+func (r *runner) assertEnd() {
+	if r.partial == PartialHard || r.trackEnd {
+		r.sawEnd = true
+	}
+	r.requireEnd = true
+}
+
+// trieEnd notes the end of the text if a literal of the trie that's tried
+// before branch, the one that matched, would have run into it
+func (r *runner) trieEnd(pos, after, branch int) {
+	if !r.noteEnd {
+		return
+	}
+	if u := r.code.Tries[r.operand(0)].Unfinished(r.runtext[:r.runtextend], pos, after); u >= 0 && (branch < 0 || u < branch) {
+		r.sawEnd = true
+	}
+}
+
+func (r *runner) foldIf(ch rune) rune {
+	if r.caseInsensitive {
+		return r.fold(ch)
+	}
+	return ch
+}
+
+// partialMatch returns the partial match that began at partialStart
+func (r *runner) partialMatch() *Match {
+	r.runmatch.reset(r.runtext, r.runtextstart)
+	r.runmatch.addMatch(0, r.partialStart, r.runtextend-r.partialStart)
+	r.runtextpos = r.runtextend
+	m := r.tidyMatch(false)
+	m.partial = true
+	return m
+}
+
+// noMatch ends a search that found no complete match, with the partial
+// one if there was one
+func (r *runner) noMatch() *Match {
+	if r.partialStart >= 0 {
+		return r.partialMatch()
+	}
+	r.tidyMatch(true)
+	return nil
+}
+
+// EndState tells how the outcome of a search depended on where the text
+// ended, like hitEnd and requireEnd of Java's Matcher.  It's for scanners
+// reading input a piece at a time, which need to know whether to wait for
+// more before acting on a result.
+type EndState struct {
+	// HitEnd is set when the engine ran into the end of the text while
+	// looking for a match, so that more text could have given a different
+	// match, or one where there was none.
+	HitEnd bool
+
+	// RequireEnd is set when a match was found that relies on the text
+	// ending where it does, as foo$ or foo\b do, so that more text could
+	// mean no match there at all.
+	RequireEnd bool
+}
+
+// FindStringMatchEnd is like FindStringMatch, but also reports how the
+// result depended on the end of s.  Like partial matching, it can't use
+// the scans that find where a match may start, so it's slower than
+// FindStringMatch.  RightToLeft patterns don't read past the end, and
+// give an empty EndState.
+func (re *Regexp) FindStringMatchEnd(s string) (*Match, EndState, error) {
+	return re.endSearch(getRunes(s), -1, re.config(nil))
+}
+
+// FindRunesMatchEnd is like FindStringMatchEnd for a rune slice.
+func (re *Regexp) FindRunesMatchEnd(r []rune) (*Match, EndState, error) {
+	return re.endSearch(r, -1, re.config(nil))
+}
+
+// FindNextMatchEnd is like FindNextMatch, but also reports how the result
+// depended on the end of the text, as FindStringMatchEnd does.
+func (re *Regexp) FindNextMatchEnd(m *Match) (*Match, EndState, error) {
+	if m == nil {
+		return nil, EndState{}, nil
+	}
+	if m.detached {
+		return nil, EndState{}, errDetached
+	}
+	cfg := re.config(nil)
+	startAt, ok := re.nextStart(m, &cfg)
+	if !ok {
+		// there's nothing left to search, but more text could match
+		return nil, EndState{HitEnd: !re.RightToLeft()}, nil
+	}
+	return re.endSearch(m.text, startAt, cfg)
+}
+
+func (re *Regexp) endSearch(input []rune, textstart int, cfg matchConfig) (*Match, EndState, error) {
+	runner := re.getRunner()
+	defer re.putRunner(runner)
+
+	if textstart < 0 {
+		textstart = 0
+		if re.RightToLeft() {
+			textstart = len(input)
+		}
+	}
+	runner.trackEnd = true
+	defer func() { runner.trackEnd = false }()
+	runner.fullMatch = false
+
+	m, err := re.detach(runner.scan(input, textstart, false, cfg))
+	if err != nil {
+		return nil, EndState{}, err
+	}
+	return m, EndState{HitEnd: runner.hitEnd, RequireEnd: m != nil && runner.requireEnd}, nil
+}
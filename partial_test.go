@@ -0,0 +1,121 @@
+package regexp2
+
+import "testing"
+
+func TestPartialMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		mode    PartialMode
+		input   string
+
+		// the match as index, length and whether it's partial, or nothing
+		want []int
+	}{
+		{`\d{4}-\d\d-\d\d`, 0, PartialSoft, "on 2019-0", []int{3, 6, 1}},
+		{`\d{4}-\d\d-\d\d`, 0, PartialSoft, "2019-01-02 and 2020", []int{0, 10, 0}},
+		{`\d{4}-\d\d-\d\d`, 0, PartialSoft, "20x9-0a", nil},
+		{`^\d{4}-\d\d-\d\d$`, 0, PartialSoft, "2019-0", []int{0, 6, 1}},
+		{`dog(sbody)?`, 0, PartialSoft, "dog", []int{0, 3, 0}},
+		{`dog(sbody)?`, 0, PartialHard, "dog", []int{0, 3, 1}},
+		{`dog(sbody)?`, 0, PartialHard, "dogsb", []int{0, 5, 1}},
+		{`dog(sbody)?`, 0, PartialHard, "dogs!", []int{0, 3, 0}},
+		{`\d+`, 0, PartialSoft, "12", []int{0, 2, 0}},
+		{`\d+`, 0, PartialHard, "12", []int{0, 2, 1}},
+		{`\d+?`, 0, PartialHard, "12", []int{0, 1, 0}},
+		{`a+?b`, 0, PartialSoft, "xaa", []int{1, 2, 1}},
+		{`[a-c]{3}`, 0, PartialSoft, "zab", []int{1, 2, 1}},
+		{`abc`, 0, PartialSoft, "xab", []int{1, 2, 1}},
+		{`abc`, 0, PartialSoft, "xyz", nil},
+		{`abc`, 0, PartialSoft, "", []int{0, 0, 1}},
+		{`HELLO`, IgnoreCase, PartialSoft, "say hel", []int{4, 3, 1}},
+		{`(\w)\1`, 0, PartialSoft, "ab", []int{1, 1, 1}},
+		{`\bfoo\b`, 0, PartialSoft, "foo", []int{0, 3, 0}},
+		{`\bfoo\b`, 0, PartialHard, "foo", []int{0, 3, 1}},
+		{`foo$`, 0, PartialHard, "a foo", []int{2, 3, 1}},
+		{`$`, 0, PartialHard, "abc", []int{3, 0, 0}},
+		{`x$`, 0, PartialHard, "x\n", []int{0, 2, 1}},
+		{`x\Z`, 0, PartialSoft, "x\n", []int{0, 1, 0}},
+		{`if|else|for|while|switch|case|break|return`, 0, PartialSoft, "x = retu", []int{4, 4, 1}},
+		{`category|cat|if|else|for|while|switch|case`, 0, PartialHard, "categ", []int{0, 5, 1}},
+		{`cat|category|if|else|for|while|switch|case`, 0, PartialHard, "categ", []int{0, 3, 0}},
+		{`abc`, RightToLeft, PartialSoft, "ab", nil},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		m, err := re.FindStringPartialMatch(test.input, test.mode)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", test.pattern, err)
+		}
+		var got []int
+		if m != nil {
+			got = []int{m.Index, m.Length, 0}
+			if m.Partial() {
+				got[2] = 1
+			}
+		}
+		if len(got) != len(test.want) || len(got) > 0 && (got[0] != test.want[0] || got[1] != test.want[1] || got[2] != test.want[2]) {
+			t.Errorf("%v on %q (mode %v): wanted %v, got %v", test.pattern, test.input, test.mode, test.want, got)
+		}
+	}
+
+	// the runner goes back to complete matches only
+	re := MustCompile(`abc`, 0)
+	re.FindStringPartialMatch("ab", PartialSoft)
+	if m, _ := re.FindStringMatch("ab"); m != nil {
+		t.Errorf("unexpected match %v", m)
+	}
+}
+
+func TestFindMatchEnd(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		input      string
+		want       string // "-" for no match
+		hitEnd     bool
+		requireEnd bool
+	}{
+		{`foo`, "foo", "foo", false, false},
+		{`foo`, "xfo", "-", true, false},
+		{`foo`, "bar", "-", true, false},
+		{`^foo`, "bar", "-", false, false},
+		{`\d+`, "a12", "12", true, false},
+		{`\d+`, "12a", "12", false, false},
+		{`\d+?`, "12", "1", false, false},
+		{`foo$`, "foo", "foo", true, true},
+		{`x$`, "x\n", "x", true, true},
+		{`(?m)x$`, "x\n", "x", false, false},
+		{`foo\b`, "foo bar", "foo", false, false},
+		{`\bfoo\b`, "a foo", "foo", true, true},
+		{`foo`, "", "-", true, false},
+	}
+	for _, test := range tests {
+		m, st, err := MustCompile(test.pattern, 0).FindStringMatchEnd(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := "-"
+		if m != nil {
+			got = m.String()
+		}
+		if got != test.want || st.HitEnd != test.hitEnd || st.RequireEnd != test.requireEnd {
+			t.Errorf("%v on %q: wanted %v %v %v, got %v %+v", test.pattern, test.input, test.want, test.hitEnd, test.requireEnd, got, st)
+		}
+	}
+
+	re := MustCompile(`\d+`, 0)
+	m, st, _ := re.FindStringMatchEnd("1 23")
+	if m.String() != "1" || st.HitEnd {
+		t.Errorf("unexpected first match %v %+v", m, st)
+	}
+	m, st, _ = re.FindNextMatchEnd(m)
+	if m.String() != "23" || !st.HitEnd {
+		t.Errorf("unexpected second match %v %+v", m, st)
+	}
+	if m, st, _ = re.FindNextMatchEnd(m); m != nil || !st.HitEnd {
+		t.Errorf("unexpected third match %v %+v", m, st)
+	}
+	if m, _ = re.FindStringMatch("1 23"); m.String() != "1" {
+		t.Errorf("unexpected match %v", m)
+	}
+}
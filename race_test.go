@@ -0,0 +1,7 @@
+//go:build race
+
+package regexp2
+
+// the race detector drops sync.Pool items at random, so allocation counts
+// aren't steady under it
+const raceEnabled = true
@@ -0,0 +1,77 @@
+package regexp2
+
+// RedactPolicy says how Redact masks the named groups of each match.
+type RedactPolicy struct {
+	Redaction
+
+	// Groups has the groups that are masked another way than Redaction
+	// says, by name
+	Groups map[string]Redaction
+}
+
+// Redaction is how a group is masked: every rune of each capture of it,
+// after the first KeepPrefix, becomes Mask, or '*' if Mask is zero.
+// Skip leaves the group as it is.
+type Redaction struct {
+	Mask       rune
+	KeepPrefix int
+	Skip       bool
+}
+
+// Redact returns s with what the named groups of each match captured
+// masked as p says, and the rest of s as it is, so that a pattern like
+// `(?<cc>\d{4}(?:[ -]?\d{4}){3})|SSN: (?<ssn>\d{3}-\d{2}-\d{4})` takes the
+// numbers out of a log line and leaves the labels.  Where groups nest, a
+// rune in both is masked if either masks it, with the inner group's Mask.
+func (re *Regexp) Redact(s string, p RedactPolicy, opts ...MatchOption) (string, error) {
+	var groups []int
+	var redactions []Redaction
+	nums := re.GetGroupNumbers()
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		r, ok := p.Groups[name]
+		if !ok {
+			r = p.Redaction
+		}
+		if r.Skip {
+			continue
+		}
+		if r.Mask == 0 {
+			r.Mask = '*'
+		}
+		groups = append(groups, nums[i])
+		redactions = append(redactions, r)
+	}
+	if len(groups) == 0 || re.cannotMatch(s) {
+		return s, nil
+	}
+
+	input := getRunes(s)
+	var out []rune
+	err := re.scanAll(input, func(m *Match) bool {
+		for i, num := range groups {
+			r := redactions[i]
+			for _, c := range m.GroupByNumber(num).Captures {
+				if c.Length <= r.KeepPrefix {
+					continue
+				}
+				if out == nil {
+					out = append([]rune(nil), input...)
+				}
+				for j := c.Index + max(r.KeepPrefix, 0); j < c.Index+c.Length; j++ {
+					out[j] = r.Mask
+				}
+			}
+		}
+		return true
+	}, opts)
+	if err != nil {
+		return "", err
+	}
+	if out == nil {
+		return s, nil
+	}
+	return string(out), nil
+}
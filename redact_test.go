@@ -0,0 +1,31 @@
+package regexp2
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	const pii = `(?<cc>\d{4}(?:[ -]?\d{4}){3})|SSN: (?<ssn>\d{3}-\d{2}-\d{4})`
+	tests := []struct {
+		pattern string
+		policy  RedactPolicy
+		input   string
+		want    string
+	}{
+		{pii, RedactPolicy{}, "card 1234-5678-9012-3456, SSN: 123-45-6789.", "card *******************, SSN: ***********."},
+		{pii, RedactPolicy{Redaction: Redaction{Mask: 'x', KeepPrefix: 4}}, "card 1234 5678 9012 3456", "card 1234xxxxxxxxxxxxxxx"},
+		{pii, RedactPolicy{Groups: map[string]Redaction{"ssn": {Skip: true}}}, "1234567890123456 SSN: 123-45-6789", "**************** SSN: 123-45-6789"},
+		{pii, RedactPolicy{Groups: map[string]Redaction{"cc": {Mask: '#'}}}, "1234567890123456 SSN: 123-45-6789", "################ SSN: ***********"},
+		{pii, RedactPolicy{}, "nothing here", "nothing here"},
+		// unnamed groups are left alone
+		{`(\w+)=(?<v>\w+)`, RedactPolicy{}, "ключ=значение a=b", "ключ=******** a=*"},
+		{`(?:(?<d>\d+),?)+`, RedactPolicy{Redaction: Redaction{KeepPrefix: 1}}, "1,22,3", "1,2*,3"},
+		{`(?<all>\w+@(?<host>\w+))`, RedactPolicy{Redaction: Redaction{KeepPrefix: 2}, Groups: map[string]Redaction{"host": {Mask: '-'}}}, "me@example", "me*-------"},
+		{`(?<word>\w+)`, RedactPolicy{Redaction: Redaction{KeepPrefix: 3}}, "an end", "an end"},
+		{`(\d+)`, RedactPolicy{}, "no named 42", "no named 42"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		if got, err := re.Redact(tt.input, tt.policy); got != tt.want || err != nil {
+			t.Errorf("%v on %q: wanted %q, got %q %v", tt.pattern, tt.input, tt.want, got, err)
+		}
+	}
+}
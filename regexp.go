@@ -9,11 +9,15 @@ need to write very complex patterns or require compatibility with .NET.
 package regexp2
 
 import (
+	"context"
 	"errors"
+	"io"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/jviksne/regexp2/syntax"
@@ -22,54 +26,183 @@ import (
 // Default timeout used when running regexp matches -- "forever"
 var DefaultMatchTimeout = time.Duration(math.MaxInt64)
 
+// Default limit on how deeply subroutine calls like (?R) may nest
+var DefaultMaxRecursionDepth = 1000
+
+// DefaultCulture gives the casing rules IgnoreCase follows in patterns
+// compiled with Compile, for example unicode.TurkishCase to fold I to ı and
+// İ to i.  Nil, the default, is culture invariant.
+var DefaultCulture unicode.SpecialCase
+
 // Regexp is the representation of a compiled regular expression.
 // A Regexp is safe for concurrent use by multiple goroutines.
 type Regexp struct {
 	//timeout when trying to find matches
 	MatchTimeout time.Duration
 
+	// MaxSteps limits the number of engine steps (opcodes executed, including
+	// backtracking) a single search may take before it fails with a
+	// *StepLimitError.  Unlike MatchTimeout the limit is deterministic and
+	// does not depend on machine load.  Zero means no limit.
+	MaxSteps int
+
+	// MaxRecursionDepth limits how deeply subroutine calls such as (?R),
+	// (?1) or (?&name) may nest before the match fails with a
+	// *RecursionLimitError.  It keeps patterns like (?R)a, which would
+	// otherwise recurse forever, from exhausting memory.
+	MaxRecursionDepth int
+
+	// MaxMemory limits the bytes the stacks the engine backtracks with may
+	// grow to in a search before it fails with a *MemoryLimitError.  A
+	// timeout only notices a match running long, by which time one that
+	// saves a lot of state can have taken a great deal of memory.  Zero
+	// means no limit.
+	MaxMemory int
+
+	// StackSize, if set, is how many entries the stacks the engine
+	// backtracks with start out with, instead of a size worked out from the
+	// pattern.  They grow as a search needs, so it's only worth setting to
+	// save growing them for a pattern known to backtrack deeply, or to save
+	// memory when there are a great many small searches going on at once.
+	// It must be set before re is first used.
+	StackSize int
+
+	// DetachMatches makes the functions that return a Match, such as
+	// FindStringMatch, Detach it first, so that keeping the Match doesn't
+	// keep the whole input in memory.  A detached Match can't be passed to
+	// FindNextMatch or the others that search on from it; range over
+	// Matches instead, whose matches are detached too.
+	DetachMatches bool
+
+	// Tracer, if set, is told each step the engine takes in a search.  It's
+	// for debugging, and makes searches far slower.
+	Tracer Tracer
+
+	// Hooks, if set, is told when each search starts and ends, for
+	// keeping metrics.  It's DefaultHooks to begin with.
+	Hooks Hooks
+
 	// read-only after Compile
 	pattern string       // as passed to Compile
 	options RegexOptions // options
 
-	caps     map[int]int    // capnum->index
-	capnames map[string]int //capture group name -> index
-	capslist []string       //sorted list of capture group names
-	capsize  int            // size of the capture array
+	culture       unicode.SpecialCase // as passed to CompileCulture
+	optimizations Optimization        // as passed to CompileOptimized
+
+	caps     map[int]int      // capnum->index
+	capnames map[string]int   //capture group name -> index
+	capslist []string         //sorted list of capture group names
+	dupnames map[string][]int // with DupNames, the groups of each name there's more than one of
+	capsize  int              // size of the capture array
+
+	code     *syntax.Code // compiled program
+	prefix   string       // literal every match starts with, if case-sensitive
+	literals []string     // literals every match contains
+
+	longest bool // whether regexp prefers leftmost-longest match
+
+	joined []int // from Join, the group each joined pattern is in
 
-	code *syntax.Code // compiled program
+	// cache of machines for running regexp; a pool rather than a
+	// mutex-guarded slice so concurrent matches don't contend on one lock
+	runners sync.Pool
 
-	// cache of machines for running regexp
-	muRun  sync.Mutex
-	runner []*runner
+	// Matches given back with Release, for searches to fill in again
+	matchPool sync.Pool
+
+	generated GeneratedMatcher // from LoadGenerated, used in place of the interpreter
+
+	// the replacement patterns parsed most recently, so Replace in a loop
+	// doesn't parse the same one every time
+	replacerMu   sync.Mutex
+	replacers    [replacerCacheSize]*syntax.ReplacerData
+	replacerNext int // the slot to fill next
 }
 
+// ParseError is what Compile returns for a pattern that doesn't parse.
+// Its Code says what's wrong and its Offset and Token where.
+type ParseError = syntax.Error
+
 // Compile parses a regular expression and returns, if successful,
 // a Regexp object that can be used to match against text.
 func Compile(expr string, opt RegexOptions) (*Regexp, error) {
+	return CompileCulture(expr, opt, DefaultCulture)
+}
+
+// CompileCulture is like Compile, but IgnoreCase follows the casing rules
+// of culture unless opt has CultureInvariant.
+func CompileCulture(expr string, opt RegexOptions, culture unicode.SpecialCase) (*Regexp, error) {
+	re := &Regexp{}
+	if err := re.compile(expr, opt, culture, DefaultOptimizations, DefaultCompileLimits); err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+// CompileOptimized is like Compile, but makes just the optimizations in
+// optimizations.  They're meant never to change what a pattern matches,
+// only how fast, so this is for seeing what they do, with Optimized, and
+// for turning on the ones that aren't made by default.
+func CompileOptimized(expr string, opt RegexOptions, optimizations Optimization) (*Regexp, error) {
+	re := &Regexp{}
+	if err := re.compile(expr, opt, DefaultCulture, optimizations, DefaultCompileLimits); err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+// compile replaces re with the compiled form of expr, if it's within limits
+func (re *Regexp) compile(expr string, opt RegexOptions, culture unicode.SpecialCase, optimizations Optimization, limits CompileLimits) error {
+	if err := limits.checkLength(expr); err != nil {
+		return err
+	}
+
 	// parse it
-	tree, err := syntax.Parse(expr, syntax.RegexOptions(opt))
+	tree, err := syntax.ParseOptimized(expr, syntax.RegexOptions(opt), culture, syntax.Optimization(optimizations))
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if err := limits.checkTree(tree); err != nil {
+		return err
 	}
 
 	// translate it to code
 	code, err := syntax.Write(tree)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if err := limits.checkCode(code); err != nil {
+		return err
+	}
+
+	// a U+FFFD in the prefix matches invalid UTF-8 too, which a substring
+	// search wouldn't find
+	var prefix string
+	if code.BmPrefix != nil && !code.BmPrefix.CaseInsensitive() {
+		prefix = code.BmPrefix.String()
+		if strings.ContainsRune(prefix, utf8.RuneError) {
+			prefix = ""
+		}
 	}
 
-	// return it
-	return &Regexp{
-		pattern:      expr,
-		options:      opt,
-		caps:         code.Caps,
-		capnames:     tree.Capnames,
-		capslist:     tree.Caplist,
-		capsize:      code.Capsize,
-		code:         code,
-		MatchTimeout: DefaultMatchTimeout,
-	}, nil
+	*re = Regexp{
+		pattern:           expr,
+		options:           opt,
+		culture:           culture,
+		optimizations:     optimizations,
+		caps:              code.Caps,
+		capnames:          tree.Capnames,
+		capslist:          tree.Caplist,
+		dupnames:          dupNames(tree.Caplist, code.Caps),
+		capsize:           code.Capsize,
+		code:              code,
+		prefix:            prefix,
+		literals:          tree.RequiredLiterals(),
+		MatchTimeout:      DefaultMatchTimeout,
+		MaxRecursionDepth: DefaultMaxRecursionDepth,
+		Hooks:             DefaultHooks,
+	}
+	return nil
 }
 
 // MustCompile is like Compile but panics if the expression cannot be parsed.
@@ -83,6 +216,64 @@ func MustCompile(str string, opt RegexOptions) *Regexp {
 	return regexp
 }
 
+// Clone returns a Regexp that shares re's compiled program but not its
+// settings, so that changing MatchTimeout or calling Longest on the clone
+// leaves re as it was.
+func (re *Regexp) Clone() *Regexp {
+	c := &Regexp{
+		pattern:       re.pattern,
+		options:       re.options,
+		culture:       re.culture,
+		optimizations: re.optimizations,
+		caps:          re.caps,
+		capnames:      re.capnames,
+		capslist:      re.capslist,
+		dupnames:      re.dupnames,
+		capsize:       re.capsize,
+		code:          re.code,
+		prefix:        re.prefix,
+		literals:      re.literals,
+		generated:     re.generated,
+		joined:        re.joined,
+	}
+	c.copySettings(re)
+	return c
+}
+
+// WithOptions returns re's pattern compiled with opt instead of the
+// options it has, and re's other settings, for a case-insensitive twin of
+// a pattern, say: re.WithOptions(re.Options() | IgnoreCase).
+func (re *Regexp) WithOptions(opt RegexOptions) (*Regexp, error) {
+	if opt == re.options {
+		return re.Clone(), nil
+	}
+	c := &Regexp{}
+	if err := c.compile(re.pattern, opt, re.culture, re.optimizations, DefaultCompileLimits); err != nil {
+		return nil, err
+	}
+	c.joined = re.joined
+	c.copySettings(re)
+	return c, nil
+}
+
+// Options returns the options re was compiled with.
+func (re *Regexp) Options() RegexOptions {
+	return re.options
+}
+
+// copySettings gives re the settings of from that aren't its pattern's
+func (re *Regexp) copySettings(from *Regexp) {
+	re.MatchTimeout = from.MatchTimeout
+	re.MaxSteps = from.MaxSteps
+	re.MaxRecursionDepth = from.MaxRecursionDepth
+	re.MaxMemory = from.MaxMemory
+	re.StackSize = from.StackSize
+	re.DetachMatches = from.DetachMatches
+	re.Tracer = from.Tracer
+	re.Hooks = from.Hooks
+	re.longest = from.longest
+}
+
 // Escape adds backslashes to any special characters in the input string
 func Escape(input string) string {
 	return syntax.Escape(input)
@@ -93,11 +284,77 @@ func Unescape(input string) (string, error) {
 	return syntax.Unescape(input)
 }
 
+// Canonical returns pattern, parsed with opt, in a normal form meant for
+// comparing patterns: what they match decides how it's written, so that
+// a|b and [ab], \x41 and A, or (?i:1) and 1 come out the same.  Runes
+// are escaped one way, classes are written with their ranges sorted and
+// merged, and groups only where they're needed.  The options are written
+// into the pattern, but for RightToLeft, which it must still be compiled
+// with, and ECMAScript, whose back references to groups that haven't
+// matched match the empty string rather than fail.  See syntax.Canonical
+// for the details.
+func Canonical(pattern string, opt RegexOptions) (string, error) {
+	tree, err := syntax.Parse(pattern, syntax.RegexOptions(opt))
+	if err != nil {
+		return "", err
+	}
+	return syntax.Canonical(tree.AST()).String(), nil
+}
+
+// TranslateRE2 returns pattern, parsed with opt, rewritten in the syntax of
+// Go's regexp package, for when a pattern that needs none of what only a
+// backtracker can do should run in linear time, or be handed to another
+// RE2 engine.  The result matches what pattern does, with the same
+// groups.  If pattern uses something RE2 can't say, the error is a
+// *syntax.RE2Error listing each such part.
+func TranslateRE2(pattern string, opt RegexOptions) (string, error) {
+	tree, err := syntax.Parse(pattern, syntax.RegexOptions(opt))
+	if err != nil {
+		return "", err
+	}
+	return tree.AST().RE2()
+}
+
+// RegisterUnicodeProperty makes \p{name} match the runes in table, adding a
+// property or replacing a built-in one.  Patterns compiled earlier keep the
+// tables they were compiled with.
+func RegisterUnicodeProperty(name string, table *unicode.RangeTable) error {
+	return syntax.RegisterProperty(name, table)
+}
+
+// RegisterCharClass makes \p{name}, and [[:name:]] where POSIX classes are
+// allowed, match the runes fn returns true for.  It's for classes that are
+// awkward to list as ranges; fn is called while matching, from any number
+// of goroutines, and under IgnoreCase is given case folded runes.
+func RegisterCharClass(name string, fn func(rune) bool) error {
+	return syntax.RegisterClass(name, fn)
+}
+
 // String returns the source text used to compile the regular expression.
 func (re *Regexp) String() string {
 	return re.pattern
 }
 
+// Optimized returns the pattern as the compiler sees it once it's
+// optimized, written out as described for syntax.Node.String.  The
+// optimizations show as the syntax they're equivalent to, such as [ab] for
+// a|b or an atomic group around a loop that OptAutoAtomic made atomic;
+// OptLiteralTries doesn't change the pattern, only its program.
+func (re *Regexp) Optimized() string {
+	tree, err := syntax.ParseOptimized(re.pattern, syntax.RegexOptions(re.options), re.culture, syntax.Optimization(re.optimizations))
+	if err != nil {
+		return ""
+	}
+	return tree.AST().String()
+}
+
+// ProgramDOT returns the program the pattern compiled to as a Graphviz
+// graph; see syntax.Code.DOT.  The parse tree it came from is drawn by the
+// DOT method of the syntax.RegexTree that syntax.Parse returns.
+func (re *Regexp) ProgramDOT() string {
+	return re.code.DOT()
+}
+
 func quote(s string) string {
 	if strconv.CanBackquote(s) {
 		return "`" + s + "`"
@@ -112,18 +369,47 @@ type RegexOptions int32
 
 const (
 	None                    RegexOptions = 0x0
-	IgnoreCase                           = 0x0001 // "i"
-	Multiline                            = 0x0002 // "m"
-	ExplicitCapture                      = 0x0004 // "n"
-	Compiled                             = 0x0008 // "c"
-	Singleline                           = 0x0010 // "s"
-	IgnorePatternWhitespace              = 0x0020 // "x"
-	RightToLeft                          = 0x0040 // "r"
-	Debug                                = 0x0080 // "d"
-	ECMAScript                           = 0x0100 // "e"
-	RE2                                  = 0x0200 // RE2 (regexp package) compatibility mode
+	IgnoreCase                           = 0x0001  // "i"
+	Multiline                            = 0x0002  // "m"
+	ExplicitCapture                      = 0x0004  // "n"
+	Compiled                             = 0x0008  // "c"
+	Singleline                           = 0x0010  // "s"
+	IgnorePatternWhitespace              = 0x0020  // "x"
+	RightToLeft                          = 0x0040  // "r"
+	Debug                                = 0x0080  // "d"
+	ECMAScript                           = 0x0100  // "e"
+	RE2                                  = 0x0200  // RE2 (regexp package) compatibility mode
+	Memoize                              = 0x0400  // remember failed loop iterations to avoid catastrophic backtracking
+	PCRE2                                = 0x0800  // PCRE2 (PHP, nginx, grep -P) compatibility mode
+	Python                               = 0x1000  // Python re module compatibility mode
+	Java                                 = 0x2000  // Java java.util.regex compatibility mode
+	UnicodeSets                          = 0x4000  // ECMAScript v flag: nested classes, set difference and intersection, strings
+	CultureInvariant                     = 0x8000  // case-insensitive matching ignores DefaultCulture and the culture passed to CompileCulture
+	CaseConversion                       = 0x10000 // \U, \L, \u, \l and \E change the case of what follows them in replacement patterns
+	DupNames                             = 0x20000 // "J" in PCRE2: groups with the same name get numbers of their own, and the name finds the first that matched
+)
+
+// Optimization is a set of the optimizations the compiler makes to a
+// pattern, for CompileOptimized; see syntax.Optimization for what each
+// one does.
+type Optimization uint
+
+const (
+	OptMergeSets     = Optimization(syntax.OptMergeSets)     // a|b as [ab]
+	OptJoinStrings   = Optimization(syntax.OptJoinStrings)   // adjacent runes as one string
+	OptCoalesceLoops = Optimization(syntax.OptCoalesceLoops) // (?:a+)* as a*
+	OptLiteralTries  = Optimization(syntax.OptLiteralTries)  // alternations of literals matched with a trie
+	OptAutoAtomic    = Optimization(syntax.OptAutoAtomic)    // \d+x as (?>\d+)x
+	OptHoistSuffixes = Optimization(syntax.OptHoistSuffixes) // cat|bat as [cb]at
+
+	DefaultOptimizations = Optimization(syntax.DefaultOptimizations) // those Compile makes
+	AllOptimizations     = Optimization(syntax.AllOptimizations)
 )
 
+func (o Optimization) String() string {
+	return syntax.Optimization(o).String()
+}
+
 func (re *Regexp) RightToLeft() bool {
 	return re.options&RightToLeft != 0
 }
@@ -132,92 +418,390 @@ func (re *Regexp) Debug() bool {
 	return re.options&Debug != 0
 }
 
+// Longest makes future searches use leftmost-longest semantics, mirroring
+// regexp.Regexp.Longest.  At each starting position the engine explores
+// every alternative and keeps the longest match instead of the first one
+// the backtracker finds, so a|ab against "ab" matches "ab".  This can be
+// considerably slower since no alternative is ever pruned by success.
+// This method modifies the Regexp and may not be called concurrently
+// with any other methods.
+func (re *Regexp) Longest() {
+	re.longest = true
+}
+
+// RequiredLiterals returns literal strings that every match of re must
+// contain, so callers can cheaply pre-filter input (for example with
+// strings.Contains, or by querying a search index) before running the
+// full engine.  The result is conservative: parts of the pattern that
+// are case-insensitive or that don't pin down specific text, such as
+// alternations and character classes, contribute nothing, so an empty
+// result doesn't mean the pattern has no literal text.
+func (re *Regexp) RequiredLiterals() []string {
+	return append([]string(nil), re.literals...)
+}
+
 // Replace searches the input string and replaces each match found with the replacement text.
 // Count will limit the number of matches attempted and startAt will allow
 // us to skip past possible matches at the start of the input (left or right depending on RightToLeft option).
 // Set startAt and count to -1 to go through the whole string
-func (re *Regexp) Replace(input, replacement string, startAt, count int) (string, error) {
-	data, err := syntax.NewReplacerData(replacement, re.caps, re.capsize, re.capnames, syntax.RegexOptions(re.options))
+func (re *Regexp) Replace(input, replacement string, startAt, count int, opts ...MatchOption) (string, error) {
+	data, err := re.replacerData(replacement)
 	if err != nil {
 		return "", err
 	}
-	//TODO: cache ReplacerData
 
-	return replace(re, data, nil, input, startAt, count)
+	result, _, err := replace(re, data, nil, input, startAt, count, opts...)
+	return result, err
+}
+
+// ReplaceCount is like Replace, and also returns how many matches it
+// replaced, so that a caller can tell when nothing was, or hold them to a
+// quota, without searching the input again.
+func (re *Regexp) ReplaceCount(input, replacement string, startAt, count int, opts ...MatchOption) (string, int, error) {
+	data, err := re.replacerData(replacement)
+	if err != nil {
+		return "", 0, err
+	}
+	return replace(re, data, nil, input, startAt, count, opts...)
 }
 
 // ReplaceFunc searches the input string and replaces each match found using the string from the evaluator
 // Count will limit the number of matches attempted and startAt will allow
 // us to skip past possible matches at the start of the input (left or right depending on RightToLeft option).
 // Set startAt and count to -1 to go through the whole string.
-func (re *Regexp) ReplaceFunc(input string, evaluator MatchEvaluator, startAt, count int) (string, error) {
-	return replace(re, nil, evaluator, input, startAt, count)
+func (re *Regexp) ReplaceFunc(input string, evaluator MatchEvaluator, startAt, count int, opts ...MatchOption) (string, error) {
+	result, _, err := replace(re, nil, func(m Match) (string, error) {
+		return evaluator(m), nil
+	}, input, startAt, count, opts...)
+	return result, err
+}
+
+// ReplaceFuncErr is like ReplaceFunc, but the evaluator can return SkipMatch
+// to keep a match's text unchanged, StopReplacing to keep it and everything
+// after it unchanged, or any other error to give up on the replacement.
+// Skipped matches still count towards count.
+func (re *Regexp) ReplaceFuncErr(input string, evaluator MatchEvaluatorErr, startAt, count int, opts ...MatchOption) (string, error) {
+	result, _, err := replace(re, nil, evaluator, input, startAt, count, opts...)
+	return result, err
+}
+
+// ReplaceFuncCount is like ReplaceFuncErr, and also returns how many
+// matches it replaced.  Those the evaluator skipped, or stopped at, aren't
+// counted.
+func (re *Regexp) ReplaceFuncCount(input string, evaluator MatchEvaluatorErr, startAt, count int, opts ...MatchOption) (string, int, error) {
+	return replace(re, nil, evaluator, input, startAt, count, opts...)
+}
+
+// ReplaceWriter reads UTF-8 text from src and writes it to dst with each
+// match replaced by the replacement pattern, without holding all of the
+// text in memory.  The matches are found as a StreamMatcher finds them, so
+// $`, $' and $_ can't be used, and RightToLeft patterns aren't supported.
+func (re *Regexp) ReplaceWriter(dst io.Writer, src io.Reader, replacement string) error {
+	data, err := re.replacerData(replacement)
+	if err != nil {
+		return err
+	}
+	return replaceStream(re, data, nil, dst, src)
+}
+
+// ReplaceFuncWriter is like ReplaceWriter, with the replacement for each
+// match coming from the evaluator.  The Match is only valid until the
+// evaluator returns.
+func (re *Regexp) ReplaceFuncWriter(dst io.Writer, src io.Reader, evaluator MatchEvaluator) error {
+	return replaceStream(re, nil, evaluator, dst, src)
 }
 
-// FindStringMatch searches the input string for a Regexp match
-func (re *Regexp) FindStringMatch(s string) (*Match, error) {
+// FindStringMatch searches the input string for a Regexp match.  opts
+// change how this call matches; see MatchOption.
+func (re *Regexp) FindStringMatch(s string, opts ...MatchOption) (*Match, error) {
+	if re.cannotMatch(s) {
+		return nil, nil
+	}
 	// convert string to runes
-	return re.run(false, -1, getRunes(s))
+	return re.detach(re.run(false, -1, getRunes(s), opts...))
 }
 
 // FindRunesMatch searches the input rune slice for a Regexp match
-func (re *Regexp) FindRunesMatch(r []rune) (*Match, error) {
-	return re.run(false, -1, r)
+func (re *Regexp) FindRunesMatch(r []rune, opts ...MatchOption) (*Match, error) {
+	return re.detach(re.run(false, -1, r, opts...))
 }
 
 // FindStringMatchStartingAt searches the input string for a Regexp match starting at the startAt index
-func (re *Regexp) FindStringMatchStartingAt(s string, startAt int) (*Match, error) {
+func (re *Regexp) FindStringMatchStartingAt(s string, startAt int, opts ...MatchOption) (*Match, error) {
+	return re.detach(re.findStringMatchStartingAt(s, startAt, opts...))
+}
+
+func (re *Regexp) findStringMatchStartingAt(s string, startAt int, opts ...MatchOption) (*Match, error) {
 	if startAt > len(s) {
 		return nil, errors.New("startAt must be less than the length of the input string")
 	}
+	if (startAt < 0 || startAt < len(s) && utf8.RuneStart(s[startAt])) && re.cannotMatch(s) {
+		// only once we know startAt is valid, so the error isn't swallowed
+		return nil, nil
+	}
 	r, startAt := re.getRunesAndStart(s, startAt)
 	if startAt == -1 {
 		// we didn't find our start index in the string -- that's a problem
 		return nil, errors.New("startAt must align to the start of a valid rune in the input string")
 	}
 
-	return re.run(false, startAt, r)
+	return re.run(false, startAt, r, opts...)
 }
 
 // FindRunesMatchStartingAt searches the input rune slice for a Regexp match starting at the startAt index
-func (re *Regexp) FindRunesMatchStartingAt(r []rune, startAt int) (*Match, error) {
-	return re.run(false, startAt, r)
+func (re *Regexp) FindRunesMatchStartingAt(r []rune, startAt int, opts ...MatchOption) (*Match, error) {
+	return re.detach(re.run(false, startAt, r, opts...))
 }
 
 // FindNextMatch returns the next match in the same input string as the match parameter.
 // Will return nil if there is no next match or if given a nil match.
-func (re *Regexp) FindNextMatch(m *Match) (*Match, error) {
+func (re *Regexp) FindNextMatch(m *Match, opts ...MatchOption) (*Match, error) {
+	if m != nil && m.detached {
+		return nil, errDetached
+	}
+	return re.detach(re.findNext(m, opts...))
+}
+
+// findNext is FindNextMatch for the package's own loops, whose matches
+// aren't detached
+func (re *Regexp) findNext(m *Match, opts ...MatchOption) (*Match, error) {
 	if m == nil {
 		return nil, nil
 	}
 
+	cfg := re.config(opts)
+	startAt, ok := re.nextStart(m, &cfg)
+	if !ok {
+		return nil, nil
+	}
+	return re.search(context.Background(), false, startAt, m.text, false, cfg)
+}
+
+// FindNextMatchInto is like FindNextMatch, but stores the next match in m
+// itself rather than in a new Match, and returns m, or nil if there's no
+// next match.  Anything got from m before, such as its groups, must not be
+// used after the call, and if it returns nil, neither must m.  A loop over
+// all the matches then makes no garbage once it's under way:
+//
+//	for m, err := re.FindStringMatch(s); m != nil; m, err = re.FindNextMatchInto(m) {
+//		...
+//	}
+func (re *Regexp) FindNextMatchInto(m *Match) (*Match, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if m.detached {
+		return nil, errDetached
+	}
+
+	cfg := re.config(nil)
+	startAt, ok := re.nextStart(m, &cfg)
+	if !ok {
+		m.Release()
+		return nil, nil
+	}
+
+	runner := re.getRunner()
+	defer re.putRunner(runner)
+	runner.runmatch = m
+	runner.fullMatch = false
+	return re.detach(runner.scan(m.text, startAt, false, cfg))
+}
+
+// FindNextOverlappingMatch is like FindNextMatch, but looks for the next
+// match starting one character after m does, rather than after m ends, so
+// matches that overlap m are found too.  With RightToLeft, the search
+// resumes one character before m ends.
+func (re *Regexp) FindNextOverlappingMatch(m *Match) (*Match, error) {
+	if m != nil && m.detached {
+		return nil, errDetached
+	}
+	return re.detach(re.findNextOverlapping(m))
+}
+
+func (re *Regexp) findNextOverlapping(m *Match) (*Match, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	startAt := m.Index + 1
+	if re.RightToLeft() {
+		startAt = m.Index + m.Length - 1
+	}
+	if startAt < 0 || startAt > len(m.text) {
+		return nil, nil
+	}
+	return re.run(false, startAt, m.text)
+}
+
+// FindAllOverlappingStringIndex is like FindAllStringIndex, but uses
+// FindNextOverlappingMatch, so it returns every match at each position
+// where one starts, including those that overlap others.  For example
+// aba against "ababa" gives [[0 3] [2 5]].
+func (re *Regexp) FindAllOverlappingStringIndex(s string, n int) [][]int {
+	var result [][]int
+
+	if n < 0 {
+		n = len(s) + 1
+	}
+
+	offs := newByteOffsets(s)
+	m, _ := re.run(false, -1, getRunes(s))
+	for c := 0; m != nil && c < n; c++ {
+		result = append(result, []int{offs.at(m.Index), offs.at(m.Index + m.Length)})
+		m, _ = re.findNextOverlapping(m)
+	}
+
+	return result
+}
+
+var errDetached = errors.New("regexp2: can't search on from a detached Match")
+
+// detach is for the functions that return a Match to the caller, and
+// detaches it if DetachMatches is set
+func (re *Regexp) detach(m *Match, err error) (*Match, error) {
+	if m != nil && re.DetachMatches {
+		m.Detach()
+	}
+	return m, err
+}
+
+// nextStart returns the position to resume searching from after m, and
+// notes in cfg if that's past an empty match,
+// or false if there is nowhere left to search.
+func (re *Regexp) nextStart(m *Match, cfg *matchConfig) (int, bool) {
 	// If previous match was empty, advance by one before matching to prevent
 	// infinite loop
 	startAt := m.textpos
+	cfg.afterEmpty = m.Length == 0
 	if m.Length == 0 {
-		if m.textpos == len(m.text) {
-			return nil, nil
-		}
-
+		// right to left, the search ends at the start of the text
 		if re.RightToLeft() {
+			if m.textpos == 0 {
+				return 0, false
+			}
 			startAt--
 		} else {
+			if m.textpos == len(m.text) {
+				return 0, false
+			}
 			startAt++
 		}
 	}
-	return re.run(false, startAt, m.text)
+	return startAt, true
 }
 
 // MatchString return true if the string matches the regex
 // error will be set if a timeout occurs
-func (re *Regexp) MatchString(s string) (bool, error) {
-	m, err := re.run(true, -1, getRunes(s))
+func (re *Regexp) MatchString(s string, opts ...MatchOption) (bool, error) {
+	if re.cannotMatch(s) {
+		return false, nil
+	}
+	m, err := re.run(true, -1, getRunes(s), opts...)
+	if err != nil {
+		return false, err
+	}
+	return m != nil, nil
+}
+
+// MatchStringDeadline is MatchString with WithDeadline(deadline), for a
+// caller that has a deadline, such as what's left of a request's, rather
+// than a timeout.
+func (re *Regexp) MatchStringDeadline(s string, deadline time.Time) (bool, error) {
+	return re.MatchString(s, WithDeadline(deadline))
+}
+
+// FullMatchString reports whether the whole of s matches the regex, as if
+// it were written \A(?:pattern)\z but without having to change it.  Unlike
+// adding ^ and $, this isn't affected by Multiline, and a trailing newline
+// isn't ignored.
+func (re *Regexp) FullMatchString(s string) (bool, error) {
+	if re.cannotMatch(s) {
+		return false, nil
+	}
+	m, err := re.search(context.Background(), true, -1, getRunes(s), true, re.config(nil))
+	if err != nil {
+		return false, err
+	}
+	return m != nil, nil
+}
+
+// FullMatchRunes is like FullMatchString for a rune slice.
+func (re *Regexp) FullMatchRunes(r []rune) (bool, error) {
+	m, err := re.search(context.Background(), true, -1, r, true, re.config(nil))
+	if err != nil {
+		return false, err
+	}
+	return m != nil, nil
+}
+
+// FindStringFullMatch is like FullMatchString but returns the match, with
+// its groups, or nil if the whole of s doesn't match.
+func (re *Regexp) FindStringFullMatch(s string) (*Match, error) {
+	return re.detach(re.search(context.Background(), false, -1, getRunes(s), true, re.config(nil)))
+}
+
+// FindRunesFullMatch is like FindStringFullMatch for a rune slice.
+func (re *Regexp) FindRunesFullMatch(r []rune) (*Match, error) {
+	return re.detach(re.search(context.Background(), false, -1, r, true, re.config(nil)))
+}
+
+// MatchStringContext is like MatchString, but the match is abandoned
+// with ctx.Err() if ctx is cancelled or its deadline passes while the
+// engine is running.  Cancellation is polled on the same schedule as
+// MatchTimeout, so it is noticed within a few thousand engine steps.
+func (re *Regexp) MatchStringContext(ctx context.Context, s string) (bool, error) {
+	return re.MatchRunesContext(ctx, getRunes(s))
+}
+
+// MatchRunesContext is like MatchRunes, but can be cancelled via ctx
+// (see MatchStringContext).
+func (re *Regexp) MatchRunesContext(ctx context.Context, r []rune) (bool, error) {
+	m, err := re.runContext(ctx, true, -1, r)
 	if err != nil {
 		return false, err
 	}
 	return m != nil, nil
 }
 
+// FindStringMatchContext is like FindStringMatch, but can be cancelled
+// via ctx (see MatchStringContext).
+func (re *Regexp) FindStringMatchContext(ctx context.Context, s string) (*Match, error) {
+	return re.detach(re.runContext(ctx, false, -1, getRunes(s)))
+}
+
+// FindRunesMatchContext is like FindRunesMatch, but can be cancelled
+// via ctx (see MatchStringContext).
+func (re *Regexp) FindRunesMatchContext(ctx context.Context, r []rune) (*Match, error) {
+	return re.detach(re.runContext(ctx, false, -1, r))
+}
+
+// FindNextMatchContext is like FindNextMatch, but can be cancelled
+// via ctx (see MatchStringContext).
+func (re *Regexp) FindNextMatchContext(ctx context.Context, m *Match) (*Match, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if m.detached {
+		return nil, errDetached
+	}
+	cfg := re.config(nil)
+	startAt, ok := re.nextStart(m, &cfg)
+	if !ok {
+		return nil, nil
+	}
+	return re.detach(re.search(ctx, false, startAt, m.text, false, cfg))
+}
+
+// cannotMatch reports whether s can be ruled out without running the engine
+// because it doesn't contain the literal every match starts with.
+// strings.Index is much faster than converting s to runes and scanning them.
+// In a text that does contain it, it's the runner's Boyer-Moore scan of
+// the prefix that skips from one place it's at to the next.
+func (re *Regexp) cannotMatch(s string) bool {
+	return re.prefix != "" && !strings.Contains(s, re.prefix)
+}
+
 func (re *Regexp) getRunesAndStart(s string, startAt int) ([]rune, int) {
 	if startAt < 0 {
 		if re.RightToLeft() {
@@ -249,16 +833,156 @@ func getRunes(s string) []rune {
 	return ret[:i]
 }
 
+// byteOffsets translates rune indexes of a string into byte offsets, or
+// of UTF-16 text into code unit offsets.
+// A nil byteOffsets is the identity mapping, which is what we use for
+// ASCII-only input and when callers explicitly ask for rune offsets.
+type byteOffsets []int
+
+// newByteOffsets builds the rune index -> byte offset table for s.  The
+// table has one entry per rune plus a final entry for len(s) so that
+// end-of-match positions translate too.
+func newByteOffsets(s string) byteOffsets {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return nil
+	}
+
+	offs := make(byteOffsets, 0, len(s)+1)
+	for i := range s {
+		offs = append(offs, i)
+	}
+	return append(offs, len(s))
+}
+
+// at returns the byte offset of rune index i
+func (b byteOffsets) at(i int) int {
+	if b == nil {
+		return i
+	}
+	return b[i]
+}
+
 // MatchRunes return true if the runes matches the regex
 // error will be set if a timeout occurs
-func (re *Regexp) MatchRunes(r []rune) (bool, error) {
-	m, err := re.run(true, -1, r)
+func (re *Regexp) MatchRunes(r []rune, opts ...MatchOption) (bool, error) {
+	m, err := re.run(true, -1, r, opts...)
 	if err != nil {
 		return false, err
 	}
 	return m != nil, nil
 }
 
+// Count returns the number of non-overlapping matches of the regex in s,
+// the ones FindNextMatch would go through, without building a Match for
+// each or, where the pattern doesn't refer back to them, keeping track of
+// what its groups capture.  If a timeout occurs it returns the matches
+// counted before it along with the error.
+func (re *Regexp) Count(s string, opts ...MatchOption) (int, error) {
+	if re.cannotMatch(s) {
+		return 0, nil
+	}
+	return re.count(getRunes(s), opts)
+}
+
+// CountRunes is like Count for a rune slice.
+func (re *Regexp) CountRunes(r []rune, opts ...MatchOption) (int, error) {
+	return re.count(r, opts)
+}
+
+// CountBytes is like Count for UTF-8 encoded bytes.
+func (re *Regexp) CountBytes(b []byte, opts ...MatchOption) (int, error) {
+	return re.Count(string(b), opts...)
+}
+
+func (re *Regexp) count(input []rune, opts []MatchOption) (int, error) {
+	r := re.getRunner()
+	defer re.putRunner(r)
+	r.fullMatch = false
+
+	// nothing but where each match is is needed
+	r.skipCaptures = !r.readsCaptures
+	defer func() { r.skipCaptures = false }()
+
+	startAt := 0
+	if re.RightToLeft() {
+		startAt = len(input)
+	}
+	cfg := re.config(opts)
+	n := 0
+	for {
+		m, err := r.scan(input, startAt, false, cfg)
+		if err != nil || m == nil {
+			return n, err
+		}
+		n++
+
+		// the runner can fill in the same Match for the next one
+		r.runmatch = m
+
+		var ok bool
+		if startAt, ok = re.nextStart(m, &cfg); !ok {
+			return n, nil
+		}
+	}
+}
+
+// Scan calls fn with each match in s, the ones FindNextMatch would go
+// through, until fn returns false.  The Match is filled in again for the
+// next one, so fn mustn't hold on to it or its groups after it returns,
+// unless it calls Detach on it or DetachMatches is set.  The error is from
+// the search, such as a timeout, after the matches found before it.
+func (re *Regexp) Scan(s string, fn func(*Match) bool, opts ...MatchOption) error {
+	if re.cannotMatch(s) {
+		return nil
+	}
+	return re.scanAll(getRunes(s), fn, opts)
+}
+
+// ScanRunes is like Scan for a rune slice.
+func (re *Regexp) ScanRunes(r []rune, fn func(*Match) bool, opts ...MatchOption) error {
+	return re.scanAll(r, fn, opts)
+}
+
+func (re *Regexp) scanAll(input []rune, fn func(*Match) bool, opts []MatchOption) error {
+	r := re.getRunner()
+	defer re.putRunner(r)
+	r.fullMatch = false
+
+	startAt := 0
+	if re.RightToLeft() {
+		startAt = len(input)
+	}
+	cfg := re.config(opts)
+	for {
+		m, err := r.scan(input, startAt, false, cfg)
+		if err != nil || m == nil {
+			return err
+		}
+		// where to go on from, before m can be detached
+		var ok bool
+		startAt, ok = re.nextStart(m, &cfg)
+		if re.DetachMatches {
+			m.Detach()
+		}
+		// the runner would take a start before the text for one at its end
+		if !fn(m) || !ok || startAt < 0 {
+			return nil
+		}
+
+		// a Match fn detached is its own now
+		if !m.detached {
+			r.runmatch = m
+		}
+	}
+}
+
 // GetGroupNames Returns the set of strings used to name capturing groups in the expression.
 func (re *Regexp) GetGroupNames() []string {
 	var result []string
@@ -324,6 +1048,39 @@ func (re *Regexp) GroupNameFromNumber(i int) string {
 	return ""
 }
 
+// dupNames finds the names in capslist that more than one group has, and
+// those groups' numbers
+func dupNames(capslist []string, caps map[int]int) map[string][]int {
+	count := make(map[string]int, len(capslist))
+	for _, name := range capslist {
+		count[name]++
+	}
+	var dups map[string][]int
+	for i, name := range capslist {
+		if count[name] < 2 {
+			continue
+		}
+		if dups == nil {
+			dups = make(map[string][]int)
+		}
+		dups[name] = append(dups[name], i)
+	}
+	if dups == nil || caps == nil {
+		return dups
+	}
+	// the numbers so far are indexes into capslist
+	nums := make(map[int]int, len(caps))
+	for num, i := range caps {
+		nums[i] = num
+	}
+	for _, group := range dups {
+		for j, i := range group {
+			group[j] = nums[i]
+		}
+	}
+	return dups
+}
+
 // GroupNumberFromName returns a group number that corresponds to a group name.
 // Returns -1 if the name is not a recognized group name.  Numbered groups
 // automatically get a group name that is the decimal string equivalent of its number.
@@ -358,6 +1115,48 @@ func (re *Regexp) GroupNumberFromName(name string) int {
 	return -1
 }
 
+// NumSubexp returns the number of parenthesized subexpressions in this Regexp.
+// Group 0 (the whole match) is not counted, matching regexp.Regexp.NumSubexp.
+func (re *Regexp) NumSubexp() int {
+	return re.capsize - 1
+}
+
+// SubexpNames returns the names of the parenthesized subexpressions
+// in this Regexp. The name for the first sub-expression is names[1],
+// so that if m is a match slice, the name for m[i] is SubexpNames()[i].
+// Since the Regexp as a whole cannot be named, names[0] is always
+// the empty string. Unnamed groups (including groups with explicit
+// numeric names such as (?<5>...)) also get the empty string, unlike
+// GetGroupNames which returns their decimal number.
+func (re *Regexp) SubexpNames() []string {
+	names := re.GetGroupNames()
+	nums := re.GetGroupNumbers()
+
+	for i := range names {
+		if names[i] == strconv.Itoa(nums[i]) {
+			names[i] = ""
+		}
+	}
+
+	return names
+}
+
+// SubexpIndex returns the index of the first subexpression with the given name,
+// or -1 if there is no subexpression with that name.
+//
+// The index is positional, the same as used by SubexpNames and the
+// Find*SubmatchIndex methods, and not necessarily the group number.
+func (re *Regexp) SubexpIndex(name string) int {
+	if name != "" {
+		for i, s := range re.SubexpNames() {
+			if name == s {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // FindAllStringIndex is the 'All' version of FindStringIndex; it returns a
 // slice of all successive matches of the expression.
 // A return value of nil indicates no match.
@@ -369,36 +1168,16 @@ func (re *Regexp) GroupNumberFromName(name string) int {
 // fmt.Println(r.FindAllStringIndex("peach punch", -1))
 //
 // [[0 5] [6 11]]
-// 
+//
 func (re *Regexp) FindAllStringIndex(s string, n int) [][]int {
+	return re.findAllIndex(s, n, false, newByteOffsets(s))
+}
 
-	var result [][]int
-
-	if n < 0 {
-		n = len(s) + 1
-	}	
-
-	c := 0
-
-	m, _ := re.FindStringMatch(s);
-
-	// Loop through all matches and append pairs of full match indexes
-	for m != nil && c < n {
-
-		c = c + 1
-
-		groups := m.Groups()
-
-		if len(groups) > 0 {
-			result = append(result, []int{groups[0].Index, groups[0].Index + groups[0].Length})
-		}
-
-		m, _ = re.FindNextMatch(m)
-
-	}
-
-	return result
-
+// FindAllStringRuneIndex is like FindAllStringIndex, but the returned
+// offsets are rune indexes into s rather than byte offsets, which is
+// the same unit used by Capture.Index.
+func (re *Regexp) FindAllStringRuneIndex(s string, n int) [][]int {
+	return re.findAllIndex(s, n, false, nil)
 }
 
 // FindStringIndex returns a two-element slice of integers defining the
@@ -414,23 +1193,25 @@ func (re *Regexp) FindAllStringIndex(s string, n int) [][]int {
 //
 // [0 5]
 func (re *Regexp) FindStringIndex(s string) (loc []int) {
-
-	if m, _ := re.FindStringMatch(s); m != nil {
-
-		groups := m.Groups()
-
-		if len(groups) > 0 {
-			return []int{groups[0].Index, groups[0].Index + groups[0].Length}
-		}
-
+	if a := re.findAllIndex(s, 1, false, newByteOffsets(s)); a != nil {
+		return a[0]
 	}
+	return nil
+}
 
+// FindStringRuneIndex is like FindStringIndex, but the returned offsets
+// are rune indexes into s rather than byte offsets.
+func (re *Regexp) FindStringRuneIndex(s string) (loc []int) {
+	if a := re.findAllIndex(s, 1, false, nil); a != nil {
+		return a[0]
+	}
 	return nil
 }
 
 // FindStringSubmatchIndex returns a slice holding the index pairs
 // identifying the leftmost match of the regular expression in s and the
-// matches, if any, of its subexpressions.
+// matches, if any, of its subexpressions, with -1 for those that didn't
+// take part in the match.
 // A return value of nil indicates no match.
 //
 // Ported from https://golang.org/src/regexp/regexp.go
@@ -440,21 +1221,19 @@ func (re *Regexp) FindStringIndex(s string) (loc []int) {
 //
 // [0 5 1 3]
 func (re *Regexp) FindStringSubmatchIndex(s string) []int {
-
-	var result []int
-
-	if m, _ := re.FindStringMatch(s); m != nil {
-
-		for _, g := range m.Groups() {
-
-			result = append(result, g.Index)
-
-			result = append(result, g.Index + g.Length)
-		}
-
+	if a := re.findAllIndex(s, 1, true, newByteOffsets(s)); a != nil {
+		return a[0]
 	}
+	return nil
+}
 
-	return result
+// FindStringSubmatchRuneIndex is like FindStringSubmatchIndex, but the
+// returned offsets are rune indexes into s rather than byte offsets.
+func (re *Regexp) FindStringSubmatchRuneIndex(s string) []int {
+	if a := re.findAllIndex(s, 1, true, nil); a != nil {
+		return a[0]
+	}
+	return nil
 }
 
 // FindAllStringSubmatchIndex is the 'All' version of
@@ -469,52 +1248,148 @@ func (re *Regexp) FindStringSubmatchIndex(s string) []int {
 // fmt.Println(r.FindAllStringSubmatchIndex("peach punch pinch", -1))
 //
 // [[0 5 1 3] [6 11 7 9] [12 17 13 15]]
-// 
+//
 func (re *Regexp) FindAllStringSubmatchIndex(s string, n int) [][]int {
+	return re.findAllIndex(s, n, true, newByteOffsets(s))
+}
 
-	var result [][]int
+// FindAllStringSubmatchRuneIndex is like FindAllStringSubmatchIndex, but the
+// returned offsets are rune indexes into s rather than byte offsets.
+func (re *Regexp) FindAllStringSubmatchRuneIndex(s string, n int) [][]int {
+	return re.findAllIndex(s, n, true, nil)
+}
 
-	if n < 0 {
-		n = len(s) + 1
-	}
+// FindAllSubmatchIndex is the 'All' version of FindSubmatchIndex; it returns
+// a slice of all successive matches of the expression, as defined by the
+// 'All' description in the package comment.
+// A return value of nil indicates no match.
+//
+// Ported from https://golang.org/src/regexp/regexp.go
+//
+func (re *Regexp) FindAllSubmatchIndex(b []byte, n int) [][]int {
+	return re.FindAllStringSubmatchIndex(string(b), n)
+}
 
-	m, _ := re.FindStringMatch(s);
+// findAllIndex collects the index pairs of up to n successive matches (all
+// of them if n < 0), optionally including the subexpressions.  The
+// offsets are rune indexes into s, translated through offs when it is
+// non-nil.
+func (re *Regexp) findAllIndex(s string, n int, submatch bool, offs byteOffsets) [][]int {
+	return re.appendAllIndex(nil, s, n, submatch, offs)
+}
+
+// appendAllIndex is findAllIndex appending to dst.  It runs the searches
+// on one runner and lets it fill in the same Match each time, since only
+// the offsets leave here, and reuses the []int in dst's spare capacity.
+func (re *Regexp) appendAllIndex(dst [][]int, s string, n int, submatch bool, offs byteOffsets) [][]int {
+	if n == 0 || re.cannotMatch(s) {
+		return dst
+	}
+	return re.appendAllRunesIndex(dst, getRunes(s), n, submatch, offs)
+}
 
-	c := 0
+func (re *Regexp) appendAllRunesIndex(dst [][]int, input []rune, n int, submatch bool, offs byteOffsets) [][]int {
+	if n < 0 {
+		n = len(input) + 1
+	}
 
-	// Loop through all matches and append pairs of full match indexes
-	for m != nil && c < n {
+	r := re.getRunner()
+	defer re.putRunner(r)
+	r.fullMatch = false
 
-		c = c + 1
+	startAt := 0
+	if re.RightToLeft() {
+		startAt = len(input)
+	}
+	cfg := re.config(nil)
+	for c := 0; c < n; c++ {
+		m, err := r.scan(input, startAt, false, cfg)
+		if err != nil || m == nil {
+			break
+		}
+		r.runmatch = m
 
-		var subres []int
+		var loc []int
+		if len(dst) < cap(dst) {
+			loc = dst[:len(dst)+1][len(dst)][:0]
+		}
+		dst = append(dst, appendLoc(loc, m, submatch, offs))
 
-		for _, g := range m.Groups() {
+		var ok bool
+		if startAt, ok = re.nextStart(m, &cfg); !ok {
+			break
+		}
+	}
 
-			subres = append(subres, g.Index)
+	return dst
+}
 
-			subres = append(subres, g.Index + g.Length)
+// appendLoc appends where m is, and with submatch where the last capture
+// of each of its groups is, as Groups has them, with -1 for a group that
+// didn't take part
+func appendLoc(loc []int, m *Match, submatch bool, offs byteOffsets) []int {
+	if !submatch {
+		return append(loc, offs.at(m.Index), offs.at(m.Index+m.Length))
+	}
+	for i, count := range m.matchcount {
+		if count == 0 {
+			loc = append(loc, -1, -1)
+			continue
 		}
+		index, length := m.matches[i][(count-1)*2], m.matches[i][count*2-1]
+		loc = append(loc, offs.at(index), offs.at(index+length))
+	}
+	return loc
+}
 
-		result = append(result, subres)
+// AppendFindAllStringIndex is like FindAllStringIndex, but appends the
+// index pairs to dst and returns the extended slice.  The []int slices in
+// dst's spare capacity, past len(dst), are reused for the pairs, so a
+// loop that passes its last result[:0] back in stops allocating them once
+// they're big enough:
+//
+//	var locs [][]int
+//	for _, line := range lines {
+//		locs = re.AppendFindAllStringIndex(locs[:0], line, -1)
+//		...
+//	}
+func (re *Regexp) AppendFindAllStringIndex(dst [][]int, s string, n int) [][]int {
+	return re.appendAllIndex(dst, s, n, false, newByteOffsets(s))
+}
 
-		m, _ = re.FindNextMatch(m)
+// AppendFindAllIndex is like AppendFindAllStringIndex for a byte slice.
+func (re *Regexp) AppendFindAllIndex(dst [][]int, b []byte, n int) [][]int {
+	return re.AppendFindAllStringIndex(dst, string(b), n)
+}
 
-	}
+// AppendFindAllStringSubmatchIndex is like FindAllStringSubmatchIndex,
+// but appends to dst and reuses its spare capacity, as
+// AppendFindAllStringIndex does.
+func (re *Regexp) AppendFindAllStringSubmatchIndex(dst [][]int, s string, n int) [][]int {
+	return re.appendAllIndex(dst, s, n, true, newByteOffsets(s))
+}
 
-	return result
+// AppendFindAllSubmatchIndex is like AppendFindAllStringSubmatchIndex for
+// a byte slice.
+func (re *Regexp) AppendFindAllSubmatchIndex(dst [][]int, b []byte, n int) [][]int {
+	return re.AppendFindAllStringSubmatchIndex(dst, string(b), n)
+}
 
+// FindStringSubmatchIndexInto is like FindStringSubmatchIndex, but stores
+// the index pairs in dst, from its start, when it has room for them.  It
+// returns the pairs, or nil if there's no match.
+func (re *Regexp) FindStringSubmatchIndexInto(dst []int, s string) []int {
+	a := [1][]int{dst}
+	if locs := re.appendAllIndex(a[:0], s, 1, true, newByteOffsets(s)); len(locs) > 0 {
+		return locs[0]
+	}
+	return nil
 }
 
-// FindAllSubmatchIndex is the 'All' version of FindSubmatchIndex; it returns
-// a slice of all successive matches of the expression, as defined by the
-// 'All' description in the package comment.
-// A return value of nil indicates no match.
-//
-// Ported from https://golang.org/src/regexp/regexp.go
-//
-func (re *Regexp) FindAllSubmatchIndex(b []byte, n int) [][]int {
-	return re.FindAllStringSubmatchIndex(string(b), n)
+// FindSubmatchIndexInto is like FindStringSubmatchIndexInto for a byte
+// slice.
+func (re *Regexp) FindSubmatchIndexInto(dst []int, b []byte) []int {
+	return re.FindStringSubmatchIndexInto(dst, string(b))
 }
 
 // ReplaceAllFunc returns a copy of src in which all matches of the
@@ -533,18 +1408,20 @@ func (re *Regexp) ReplaceAllFunc(src []byte, repl func([]byte) []byte) []byte {
 //
 // Ported from https://golang.org/src/regexp/regexp.go
 //
-// TODO: verify that UTF8 can safely be used here
+// The match positions handed to repl are byte offsets into src.
 //
 func (re *Regexp) replaceAll(src string, repl func(dst []byte, m []int) []byte) []byte {
 	lastMatchEnd := 0 // end position of the most recent match
 	searchPos := 0    // position where we next look for a match
 	var buf []byte
 
-	m, _ := re.FindStringMatch(src);
+	offs := newByteOffsets(src)
+
+	m, _ := re.run(false, -1, getRunes(src))
 	
 	for m != nil {
 
-		a := []int{m.Group.Index, m.Group.Index + m.Group.Length};
+		a := []int{offs.at(m.Group.Index), offs.at(m.Group.Index + m.Group.Length)};
 
 		// Copy the unmatched characters before this match.
 		buf = append(buf, src[lastMatchEnd:a[0]]...)
@@ -571,7 +1448,7 @@ func (re *Regexp) replaceAll(src string, repl func(dst []byte, m []int) []byte)
 			searchPos = a[1]
 		}
 
-		m, _ = re.FindNextMatch(m)
+		m, _ = re.findNext(m)
 	}
 
 	// Copy the unmatched characters after the last match.	
@@ -580,6 +1457,124 @@ func (re *Regexp) replaceAll(src string, repl func(dst []byte, m []int) []byte)
 	return buf
 }
 
+// Split slices s into substrings separated by the expression and returns
+// a slice of the substrings between those expression matches.
+//
+// The count determines the number of substrings to return:
+//
+//	n > 0: at most n substrings; the last substring will be the unsplit remainder.
+//	n == 0: the result is nil (zero substrings)
+//	n < 0: all substrings
+//
+// With RightToLeft the substrings are still in the order they appear in s.
+//
+// Ported from https://golang.org/src/regexp/regexp.go
+//
+// Regexp equivalent:
+// s := regexp.MustCompile("a*").Split("abaabaccadaaae", 5)
+// // s: ["", "b", "b", "c", "cadaaae"]
+func (re *Regexp) Split(s string, n int) []string {
+	return re.split(s, n, false)
+}
+
+// SplitWithGroups is like Split, but the text of the groups in the
+// separator that took part in its match follows the substring before it,
+// as in .NET's Regex.Split and JavaScript's String.split.  n still limits
+// only the substrings between separators.
+//
+// s := regexp2.MustCompile(`\s*([,;])\s*`, 0).SplitWithGroups("a, b;c", -1)
+// // s: ["a", ",", "b", ";", "c"]
+func (re *Regexp) SplitWithGroups(s string, n int) []string {
+	return re.split(s, n, true)
+}
+
+func (re *Regexp) split(s string, n int, withGroups bool) []string {
+	if n == 0 {
+		return nil
+	}
+
+	if len(re.pattern) > 0 && len(s) == 0 {
+		return []string{""}
+	}
+
+	// every match used can be followed by an empty one that's skipped
+	limit := 2 * n
+	if n < 0 || re.RightToLeft() {
+		limit = -1
+	}
+	var (
+		matches [][]int
+		groups  [][]string
+	)
+	if withGroups {
+		matches, groups = re.findAllGroupText(s, limit)
+	} else {
+		matches = re.FindAllStringIndex(s, limit)
+	}
+	if re.RightToLeft() {
+		// found from the right, but split in text order
+		for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+			matches[i], matches[j] = matches[j], matches[i]
+			if withGroups {
+				groups[i], groups[j] = groups[j], groups[i]
+			}
+		}
+	}
+	strings := make([]string, 0, len(matches))
+
+	beg := 0
+	end := 0
+	pieces := 0
+	for i, match := range matches {
+		if n > 0 && pieces == n-1 {
+			break
+		}
+		// as in regexp, an empty match right after another match doesn't count
+		if i > 0 && match[0] == match[1] && match[0] == beg {
+			continue
+		}
+
+		end = match[0]
+		if match[1] != 0 {
+			strings = append(strings, s[beg:end])
+			pieces++
+			if withGroups {
+				strings = append(strings, groups[i]...)
+			}
+		}
+		beg = match[1]
+	}
+
+	if end != len(s) {
+		strings = append(strings, s[beg:])
+	}
+
+	return strings
+}
+
+// findAllGroupText is FindAllStringIndex that also returns, for each match,
+// the text of the groups after group 0 that matched
+func (re *Regexp) findAllGroupText(s string, n int) ([][]int, [][]string) {
+	var (
+		matches [][]int
+		groups  [][]string
+	)
+	offs := newByteOffsets(s)
+	m, _ := re.run(false, -1, getRunes(s))
+	for m != nil && (n < 0 || len(matches) < n) {
+		matches = append(matches, []int{offs.at(m.Index), offs.at(m.Index + m.Length)})
+		var text []string
+		for _, g := range m.Groups()[1:] {
+			if g.Success() {
+				text = append(text, g.String())
+			}
+		}
+		groups = append(groups, text)
+		m, _ = re.findNext(m)
+	}
+	return matches, groups
+}
+
 // QuoteMeta returns a string that escapes all regular expression metacharacters
 // inside the argument text; the returned string is a regular expression matching
 // the literal text.
@@ -17,6 +17,19 @@ func BenchmarkLiteral(b *testing.B) {
 	}
 }
 
+func BenchmarkLiteralMissLong(b *testing.B) {
+	x := strings.Repeat("x", 1<<20)
+	b.StopTimer()
+	re := MustCompile("needle", 0)
+	b.StartTimer()
+	b.SetBytes(int64(len(x)))
+	for i := 0; i < b.N; i++ {
+		if m, err := re.MatchString(x); m || err != nil {
+			b.Fatalf("match or error! %v", err)
+		}
+	}
+}
+
 func BenchmarkNotLiteral(b *testing.B) {
 	x := strings.Repeat("x", 50) + "y"
 	b.StopTimer()
@@ -56,6 +69,19 @@ func BenchmarkMatchClass_InRange(b *testing.B) {
 	}
 }
 
+func BenchmarkMatchParallel(b *testing.B) {
+	x := strings.Repeat("x", 50) + "y"
+	re := MustCompile("[xy]y", 0)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if m, err := re.MatchString(x); !m || err != nil {
+				b.Fatalf("no match or error! %v", err)
+			}
+		}
+	})
+}
+
 /*
 func BenchmarkReplaceAll(b *testing.B) {
 	x := "abcdefghijklmnopqrstuvwxyz"
@@ -96,3 +96,67 @@ func TestRE2NamedAscii_Concat(t *testing.T) {
 		t.Fatal("Expected match")
 	}
 }
+
+func TestRE2DFA_SameAsBacktracker(t *testing.T) {
+	patterns := []string{
+		`abc`, `a.c`, `^abc`, `abc$`, `(?m)^b$`, `\bfoo\b`, `\Bo`, `\Aab`, `ab\z`, `b\Z`,
+		`(?i)ABC`, `(?i)[a-c]+x`, `a{2,3}b`, `(ab){2}`, `(a|bc)+d`, `x*`, `[^\n]+\n?$`,
+		`[[:alpha:]]+[[:digit:]]`, `a(?:b|)c`, `\d{3}-\d{4}`,
+	}
+	inputs := []string{
+		"", "abc", "xabcx", "ab\n", "a\nb\nc", "foo bar", "foobar", "ABC", "aaab",
+		"ababab", "bcbcad", "tel 555-1234", "abc\n", "xaBcx", "Épée9",
+	}
+	for _, p := range patterns {
+		dfa := MustCompile(p, RE2)
+		if dfa.code.NFA == nil {
+			t.Fatalf("%v: expected an NFA", p)
+		}
+		plain := MustCompile(p, RE2)
+		plain.code.NFA = nil
+		for _, in := range inputs {
+			want, _ := plain.MatchString(in)
+			got, _ := dfa.MatchString(in)
+			if want != got {
+				t.Errorf("%v on %q: wanted %v, got %v", p, in, want, got)
+			}
+		}
+	}
+}
+
+func TestRE2DFA_NotUsed(t *testing.T) {
+	for _, p := range []string{`(a)\1`, `a(?=b)`, `(?>a+)`, `(?<=a)b`} {
+		if r := MustCompile(p, RE2); r.code.NFA != nil {
+			t.Errorf("%v: unexpected NFA", p)
+		}
+	}
+	if r := MustCompile(`abc`, 0); r.code.NFA != nil {
+		t.Error("unexpected NFA outside RE2 mode")
+	}
+}
+
+func TestRE2DFA_Catastrophic(t *testing.T) {
+	r := MustCompile(`(.+)*\?`, RE2)
+	r.MaxSteps = 10000
+	if m, err := r.FindStringMatch("Do you think you found the problem string!"); m != nil || err != nil {
+		t.Fatalf("expected no match and no error, got %v, %v", m, err)
+	}
+}
+
+func TestRE2DFA_OnlyMatchStringSkipsBacktracker(t *testing.T) {
+	// the DFA only knows whether there's a match, not where: MatchString
+	// answers from it alone, while the Find methods still run the
+	// backtracker for the match's bounds and groups
+	r := MustCompile(`(a|b)+c`, RE2)
+	r.MaxSteps = 1
+	if m, err := r.MatchString("xababc"); !m || err != nil {
+		t.Fatalf("MatchString: expected a match and no error, got %v, %v", m, err)
+	}
+	if _, err := r.FindStringMatch("xababc"); err == nil {
+		t.Fatal("FindStringMatch: expected the backtracker to run out of steps")
+	}
+	// a text with no match is ruled out before the backtracker runs
+	if m, err := r.FindStringMatch("xababx"); m != nil || err != nil {
+		t.Fatalf("FindStringMatch: expected no match and no error, got %v, %v", m, err)
+	}
+}
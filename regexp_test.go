@@ -1,10 +1,16 @@
 package regexp2
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
+	"unicode"
 
 	"github.com/jviksne/regexp2/syntax"
 )
@@ -22,6 +28,124 @@ func TestBacktrack_CatastrophicTimeout(t *testing.T) {
 	}
 }
 
+func TestBacktrack_CatastrophicMaxSteps(t *testing.T) {
+	r := MustCompile("(.+)*\\?", 0)
+	r.MaxSteps = 10000
+	m, err := r.FindStringMatch("Do you think you found the problem string!")
+	serr, ok := err.(*StepLimitError)
+	if !ok {
+		t.Fatalf("expected *StepLimitError, got %v", err)
+	}
+	if want, got := 10000, serr.MaxSteps; want != got {
+		t.Fatalf("wanted MaxSteps %v, got %v", want, got)
+	}
+	if m != nil {
+		t.Errorf("Expected no match")
+	}
+
+	// the budget covers a whole search, but simple searches fit easily
+	if ok, err := r.MatchString("what?"); err != nil || !ok {
+		t.Fatalf("expected match, got %v, %v", ok, err)
+	}
+}
+
+func TestBacktrack_MaxMemory(t *testing.T) {
+	// every iteration of the loop leaves something to backtrack to
+	r := MustCompile(`(a|b)*\1`, 0)
+	r.MaxMemory = 64 << 10
+	long := strings.Repeat("ab", 50000) + "b"
+	m, err := r.FindStringMatch(long)
+	merr, ok := err.(*MemoryLimitError)
+	if !ok {
+		t.Fatalf("expected *MemoryLimitError, got %v", err)
+	}
+	if merr.MaxMemory != 64<<10 {
+		t.Fatalf("wanted MaxMemory %v, got %v", 64<<10, merr.MaxMemory)
+	}
+	if m != nil {
+		t.Errorf("Expected no match")
+	}
+
+	if ok, err := r.MatchString("abb"); err != nil || !ok {
+		t.Fatalf("expected match, got %v, %v", ok, err)
+	}
+	if m, err := r.FindStringMatch(long, WithMaxMemory(0)); err != nil || m == nil {
+		t.Fatalf("expected match, got %v, %v", m, err)
+	}
+}
+
+func TestStackSize(t *testing.T) {
+	patterns := []string{`(a|b)*\1`, `((a)|(b))+c`, `\((?:[^()]|(?R))*\)`, `(?<=(a+))b`, `(?>(\w+))\s(\w+)`}
+	input := "abab abbc (a(b)(c(d))) aab " + strings.Repeat("ab", 500) + "b"
+	for _, pattern := range patterns {
+		want := MustCompile(pattern, 0).FindAllStringSubmatchIndex(input, -1)
+		for _, size := range []int{1, 8, 1 << 16} {
+			re := MustCompile(pattern, 0)
+			re.StackSize = size
+			if got := re.FindAllStringSubmatchIndex(input, -1); !reflect.DeepEqual(want, got) {
+				t.Errorf("%v with StackSize %v: wanted %v, got %v", pattern, size, want, got)
+			}
+		}
+	}
+}
+
+func TestBacktrack_CatastrophicMemoize(t *testing.T) {
+	// the same pattern that blows the step budget above finishes quickly
+	// once failed loop iterations are remembered
+	r := MustCompile("(.+)*\\?", Memoize)
+	r.MaxSteps = 10000
+	m, err := r.FindStringMatch("Do you think you found the problem string!")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m != nil {
+		t.Errorf("Expected no match")
+	}
+
+	r = MustCompile(`(a+)+$`, Memoize)
+	r.MaxSteps = 100000
+	if ok, err := r.MatchString(strings.Repeat("a", 40) + "b"); err != nil || ok {
+		t.Fatalf("expected no match, got %v, %v", ok, err)
+	}
+}
+
+func TestMemoize_SameMatches(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+	}{
+		{`(a+)+$`, "aaab aaa"},
+		{`(a|ab)*c`, "ababac abc"},
+		{`(x+x+)+y`, "xxxxxxy"},
+		{`^(\w+\s?)*$`, "the quick brown fox!"},
+		{`(a*)*b`, "aaab"},
+		{`((a+)+b)+c`, "aabab abc"},
+		{`(a+)+(?=b)`, "aaab"},
+		{`(a+)+\1`, "aaaa"},
+		{`(?<x>a+)+(?<-x>b)`, "aab"},
+	}
+	for _, tt := range tests {
+		plain := MustCompile(tt.pattern, 0)
+		memo := MustCompile(tt.pattern, Memoize)
+		if want, got := mustFindAll(t, plain, tt.input), mustFindAll(t, memo, tt.input); want != got {
+			t.Errorf("%v on %q: wanted %v, got %v", tt.pattern, tt.input, want, got)
+		}
+	}
+}
+
+func mustFindAll(t *testing.T, re *Regexp, s string) string {
+	var res []string
+	m, err := re.FindStringMatch(s)
+	for ; m != nil; m, err = re.FindNextMatch(m) {
+		for _, g := range m.Groups() {
+			res = append(res, fmt.Sprintf("%v:%q", g.Index, g.String()))
+		}
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return strings.Join(res, " ")
+}
+
 func TestSetPrefix(t *testing.T) {
 	r := MustCompile(`^\s*-TEST`, 0)
 	if r.code.FcPrefix == nil {
@@ -323,6 +447,69 @@ func TestErr_GroupName(t *testing.T) {
 
 }
 
+func TestErr_Position(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		code       syntax.ErrorCode
+		offset     int
+		runeOffset int
+		token      string
+	}{
+		{`a(b(c)`, syntax.ErrMissingParen, 1, 1, "("},
+		{`ab)`, syntax.ErrUnexpectedParen, 2, 2, ")"},
+		{`a**`, syntax.ErrInvalidRepeatOp, 2, 2, "*"},
+		{`x{3,2}`, syntax.ErrInvalidRepeatSize, 1, 1, "{3,2}"},
+		{`é[z-a]`, syntax.ErrReversedCharRange, 3, 2, "z-a"},
+		{`ab[cd`, syntax.ErrUnterminatedBracket, 2, 2, "[cd"},
+		{`foo(?<1bar>)`, syntax.ErrInvalidGroupName, 3, 3, "(?<1"},
+		{`é\q`, syntax.ErrUnrecognizedEscape, 2, 1, `\q`},
+		{`(?#ab`, syntax.ErrUnterminatedComment, 0, 0, "(?#ab"},
+		{`[a-\d]`, syntax.ErrBadClassInCharRange, 1, 1, `a-\d`},
+		{`[a-z-[b]x]`, syntax.ErrSubtractionMustBeLast, 8, 8, "x"},
+		{`a\p{Foo}`, syntax.ErrUnknownSlashP, 1, 1, `\p{Foo}`},
+	}
+	for _, test := range tests {
+		_, err := Compile(test.pattern, 0)
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("%v: got %v, want a ParseError", test.pattern, err)
+			continue
+		}
+		if e.Code != test.code || e.Offset != test.offset || e.RuneOffset != test.runeOffset || e.Token != test.token {
+			t.Errorf("%v: got %q at %v (%v) in %q, want %q at %v (%v) in %q", test.pattern,
+				e.Code, e.Offset, e.RuneOffset, e.Token, test.code, test.offset, test.runeOffset, test.token)
+		}
+	}
+}
+
+func TestErr_Hint(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		hint    string
+	}{
+		{`{2}a`, 0, `there's nothing before the { to repeat; to match it, escape it as \{`},
+		{`a**`, 0, "to repeat something already repeated, group it first, as in (?:a+)*"},
+		{`(?<name)a`, 0, "did you mean (?<name>...)?"},
+		{`(?<a|b)c`, 0, "for a lookbehind, write (?<=...) or (?<!...)"},
+		{`(?P<n>x)`, 0, "(?P<name>...) is RE2 and Python syntax; write (?<name>...), or compile with RE2"},
+		{`(?U)a*`, 0, "the U flag, making quantifiers lazy, isn't supported; write *?, +? and ?? instead"},
+		{`[z-a]`, 0, "did you mean a-z?"},
+		{`[]a`, 0, "a ] just after the [ is in the class, not its end"},
+		{`\u{1F600}`, 0, `\u{...} is ECMAScript syntax; write \x{...}`},
+		{`a\qb`, 0, ""},
+	}
+	for _, test := range tests {
+		_, err := Compile(test.pattern, test.opt)
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("%v: got %v, want a ParseError", test.pattern, err)
+		} else if e.Hint != test.hint {
+			t.Errorf("%v: got hint %q, want %q", test.pattern, e.Hint, test.hint)
+		}
+	}
+}
+
 func TestConstantUneffected(t *testing.T) {
 	// had a bug where "constant" sets would get modified with alternations and be broken in memory until restart
 	// this meant that if you used a known-set (like \s) in a larger set it would "poison" \s for the process
@@ -605,7 +792,7 @@ func TestHexadecimalCurlyBraces(t *testing.T) {
 	}
 
 	re = MustCompile(`\x{0010ffff}`, 0)
-	if m, err := re.MatchString(string(0x10ffff)); err != nil {
+	if m, err := re.MatchString(string(rune(0x10ffff))); err != nil {
 		t.Fatalf("Unexpected err: %v", err)
 	} else if !m {
 		t.Fatalf("Expected match")
@@ -737,6 +924,105 @@ func TestECMANegateRange(t *testing.T) {
 	}
 }
 
+func TestECMAModern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    string
+	}{
+		{`(?<year>\d{4})-\k<year>`, 0, "1999-2020-2020", "2020-2020"},
+		{`(?<=\$\d+)\d`, 0, "7 $42", "2"},
+		{`(?<!\$)\b\d+`, 0, "$42 7", "7"},
+		{`\p{Script=Greek}+`, 0, "abγδ", "γδ"},
+		{`\p{sc=Greek}+`, 0, "abγδ", "γδ"},
+		{`\p{Letter}+`, 0, "12ab", "ab"},
+		{`\p{gc=Lu}`, 0, "aB", "B"},
+		{`\p{General_Category=Decimal_Number}+`, 0, "ab12", "12"},
+		{`\P{L}+`, 0, "ab12", "12"},
+		{`\p{Alphabetic}+`, 0, "1ⅣZ", "ⅣZ"},
+		{`\p{White_Space}`, 0, "a\u00a0", "\u00a0"},
+		{`\p{Any}`, 0, "\n", "\n"},
+		{`\u{1F600}+`, 0, "😀😀", "😀😀"},
+		{`[\u{1F600}]`, 0, "😀", "😀"},
+		{`\uD83D\uDE00`, 0, "😀", "😀"},
+		{`a.b`, 0, "a\u2028b a\rb axb", "axb"},
+		{`a.b`, Singleline, "a\nb", "a\nb"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, ECMAScript|tt.opt).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// scripts need Script=, and the values have to be of the right kind
+	for _, p := range []string{`\p{Latin}`, `\p{Script=Lu}`, `\p{gc=Greek}`, `\p{Letters}`, `\u{110000}`} {
+		if _, err := Compile(p, ECMAScript); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+}
+
+func TestUnicodeSets(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`[\p{L}--[aeiou]]+`, "aebcdi", "bcd"},
+		{`[[a-z]--[aeiou]]+`, "aebcdi", "bcd"},
+		{`[\w&&\d]+`, "ab12", "12"},
+		{`[\w--\d--_]+`, "_1ab2", "ab"},
+		{`[a[b-d]]+`, "xabdz", "abd"},
+		{`[^\d--3]`, "123a", "3"},
+		{`[\q{abc|d}x]+`, "zabcxdq", "abcxd"},
+		{`[\q{ab|abc}]`, "abc", "abc"},
+		{`[\q{abc|d}--\q{abc}]+`, "abcd", "d"},
+		{`[\q{abc|d}&&\q{abc|e}]`, "dabc", "abc"},
+		{`x[\q{}a]y`, "xy", "xy"},
+		{`(?i)[\q{ABC}]`, "abc", "abc"},
+		{`[\p{Emoji_Keycap_Sequence}]`, "a1\ufe0f\u20e3", "1\ufe0f\u20e3"},
+		{`[\p{RGI_Emoji_Tag_Sequence}]`, "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+		{`[\(\-]+`, "a(-b", "(-"},
+		{`[^]`, "\n", "\n"},
+		{`[]`, "a", ""},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, ECMAScript|UnicodeSets).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	for _, p := range []string{`[a-z--b]`, `[a--b&&c]`, `[a--b c]`, `[a--]`, `[(]`, `[a-]`, `[a&&&b]`,
+		`[^\q{ab}]`, `[\P{Emoji_Keycap_Sequence}]`, `[\q{ab]`, `[a`} {
+		if _, err := Compile(p, ECMAScript|UnicodeSets); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+
+	// the emoji properties of strings need tables we don't have
+	for _, p := range []string{`[\p{RGI_Emoji}]`, `\p{RGI_Emoji}`, `[a\p{Basic_Emoji}]`, `[\p{RGI_Emoji_ZWJ_Sequence}--a]`} {
+		_, err := Compile(p, ECMAScript|UnicodeSets)
+		if err == nil || !strings.Contains(err.Error(), "unsupported property of strings") {
+			t.Errorf("%v: wanted an unsupported property error, got %v", p, err)
+		}
+	}
+}
+
 func TestThreeByteUnicode_InputOnly(t *testing.T) {
 	// confirm the bmprefix properly ignores 3-byte unicode in the input value
 	// this used to panic
@@ -955,3 +1241,2100 @@ func TestBadGroupConstruct(t *testing.T) {
 		}
 	}
 }
+
+func TestSubexpNames(t *testing.T) {
+	re := MustCompile(`((?<One>abc)\d+)?(?<Two>xyz)(?<7>.*)`, 0)
+	if want, got := 4, re.NumSubexp(); want != got {
+		t.Fatalf("NumSubexp wanted %v, got %v", want, got)
+	}
+	if want, got := []string{"", "", "One", "Two", ""}, re.SubexpNames(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("SubexpNames wanted %#v, got %#v", want, got)
+	}
+	if want, got := 3, re.SubexpIndex("Two"); want != got {
+		t.Fatalf("SubexpIndex(Two) wanted %v, got %v", want, got)
+	}
+	for _, name := range []string{"", "7", "missing"} {
+		if got := re.SubexpIndex(name); got != -1 {
+			t.Fatalf("SubexpIndex(%q) wanted -1, got %v", name, got)
+		}
+	}
+
+	loc := re.FindStringSubmatchIndex("abc208923xyzanqnakl")
+	if want, got := []int{9, 12}, loc[2*re.SubexpIndex("Two"):2*re.SubexpIndex("Two")+2]; !reflect.DeepEqual(want, got) {
+		t.Fatalf("submatch for Two wanted %v, got %v", want, got)
+	}
+}
+
+func TestSubexpNames_NoGroups(t *testing.T) {
+	re := MustCompile(`abc`, 0)
+	if want, got := 0, re.NumSubexp(); want != got {
+		t.Fatalf("NumSubexp wanted %v, got %v", want, got)
+	}
+	if want, got := []string{""}, re.SubexpNames(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("SubexpNames wanted %#v, got %#v", want, got)
+	}
+}
+
+func TestFindStringIndex_ByteOffsets(t *testing.T) {
+	re := MustCompile(`p([a-zé]+)ch`, 0)
+	s := "ééé peach péach"
+
+	loc := re.FindStringIndex(s)
+	if want := []int{7, 12}; !reflect.DeepEqual(want, loc) {
+		t.Fatalf("FindStringIndex wanted %v, got %v", want, loc)
+	}
+	if want, got := "peach", s[loc[0]:loc[1]]; want != got {
+		t.Fatalf("wanted %q, got %q", want, got)
+	}
+	if want, got := []int{4, 9}, re.FindStringRuneIndex(s); !reflect.DeepEqual(want, got) {
+		t.Fatalf("FindStringRuneIndex wanted %v, got %v", want, got)
+	}
+
+	all := re.FindAllStringSubmatchIndex(s, -1)
+	if want := [][]int{{7, 12, 8, 10}, {13, 19, 14, 17}}; !reflect.DeepEqual(want, all) {
+		t.Fatalf("FindAllStringSubmatchIndex wanted %v, got %v", want, all)
+	}
+	if want, got := "éa", s[all[1][2]:all[1][3]]; want != got {
+		t.Fatalf("wanted %q, got %q", want, got)
+	}
+	if want, got := [][]int{{4, 9, 5, 7}, {10, 15, 11, 13}}, re.FindAllStringSubmatchRuneIndex(s, -1); !reflect.DeepEqual(want, got) {
+		t.Fatalf("FindAllStringSubmatchRuneIndex wanted %v, got %v", want, got)
+	}
+	if want, got := [][]int{{7, 12}}, re.FindAllStringIndex(s, 1); !reflect.DeepEqual(want, got) {
+		t.Fatalf("FindAllStringIndex wanted %v, got %v", want, got)
+	}
+	if got := re.FindStringIndex("no match here"); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
+
+func TestReplaceAllFunc_NonASCII(t *testing.T) {
+	re := MustCompile(`b+`, 0)
+	got := re.ReplaceAllFunc([]byte("ébbé b"), func(b []byte) []byte {
+		return []byte(strings.ToUpper(string(b)))
+	})
+	if want := "éBBé B"; want != string(got) {
+		t.Fatalf("wanted %q, got %q", want, got)
+	}
+}
+
+func TestLongest(t *testing.T) {
+	re := MustCompile(`a(|b)`, 0)
+	if want, got := "a", mustFindString(t, re, "ab"); want != got {
+		t.Fatalf("first match wanted %q, got %q", want, got)
+	}
+
+	re.Longest()
+	if want, got := "ab", mustFindString(t, re, "ab"); want != got {
+		t.Fatalf("longest match wanted %q, got %q", want, got)
+	}
+
+	re = MustCompile(`(a+?)(a*?)`, 0)
+	re.Longest()
+	m, err := re.FindStringMatch("xaaay")
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if want, got := "aaa", m.String(); want != got {
+		t.Fatalf("wanted %q, got %q", want, got)
+	}
+	if want, got := 1, m.Index; want != got {
+		t.Fatalf("wanted index %v, got %v", want, got)
+	}
+	if want, got := "a", m.GroupByNumber(1).String(); want != got {
+		t.Fatalf("group 1 wanted %q, got %q", want, got)
+	}
+	if want, got := "aa", m.GroupByNumber(2).String(); want != got {
+		t.Fatalf("group 2 wanted %q, got %q", want, got)
+	}
+
+	// matching continues after the longest match
+	re = MustCompile(`if|ifdef|i`, 0)
+	re.Longest()
+	if want, got := [][]int{{0, 5}, {6, 8}, {9, 10}}, re.FindAllStringIndex("ifdef if i", -1); !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestFullMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    bool
+	}{
+		{`\d+`, 0, "12345", true},
+		{`\d+`, 0, "123a", false},
+		{`\d+`, 0, "a123", false},
+		{`a|ab`, 0, "ab", true}, // the first alternative isn't enough
+		{`(a+)+b?`, 0, "aaab", true},
+		{`^\w+$`, Multiline, "abc\ndef", false},
+		{`\w+$`, 0, "abc\n", false},
+		{`.*`, 0, "", true},
+		{`x*`, 0, "", true},
+		{`a+?`, 0, "aaa", true},
+		{`(?=abc)ab`, 0, "abc", false},
+		{`\d+`, RightToLeft, "123", true},
+		{`\d+`, RightToLeft, "1x3", false},
+		{`[a-z]+`, RE2, "hello", true},
+		{`[a-z]+`, RE2, "hello!", false},
+		{`(?i)abc`, 0, "ABC", true},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		got, err := re.FullMatchString(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%v on %q: wanted %v, got %v", test.pattern, test.input, test.want, got)
+		}
+		if got, _ := re.FullMatchRunes([]rune(test.input)); got != test.want {
+			t.Errorf("%v on %q runes: wanted %v, got %v", test.pattern, test.input, test.want, got)
+		}
+	}
+
+	m, err := MustCompile(`(\w+)@(\w+)`, 0).FindStringFullMatch("me@home")
+	if err != nil || m == nil {
+		t.Fatalf("expected a match, got %v %v", m, err)
+	}
+	if m.GroupByNumber(1).String() != "me" || m.GroupByNumber(2).String() != "home" {
+		t.Errorf("unexpected groups %v %v", m.GroupByNumber(1), m.GroupByNumber(2))
+	}
+	if m, _ := MustCompile(`\w+`, 0).FindStringFullMatch("me@home"); m != nil {
+		t.Errorf("expected no match, got %v", m)
+	}
+
+	// Longest doesn't change what a full match is
+	re := MustCompile(`a|ab|abc`, 0)
+	re.Longest()
+	if ok, _ := re.FullMatchString("ab"); !ok {
+		t.Error("expected ab to match in full")
+	}
+}
+
+func TestOverlappingMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    [][]int
+	}{
+		{`aba`, 0, "ababa", [][]int{{0, 3}, {2, 5}}},
+		{`aa`, 0, "aaaa", [][]int{{0, 2}, {1, 3}, {2, 4}}},
+		{`\w+`, 0, "abc d", [][]int{{0, 3}, {1, 3}, {2, 3}, {4, 5}}},
+		{`TA[TA]A`, 0, "TATAAATA", [][]int{{0, 4}, {2, 6}}},
+		{`x*`, 0, "ab", [][]int{{0, 0}, {1, 1}, {2, 2}}},
+		{`aa`, RightToLeft, "aaaa", [][]int{{2, 4}, {1, 3}, {0, 2}}},
+		{`é.`, 0, "ééé", [][]int{{0, 4}, {2, 6}}},
+		{`z`, 0, "abc", nil},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		if got := re.FindAllOverlappingStringIndex(test.input, -1); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v on %q: wanted %v, got %v", test.pattern, test.input, test.want, got)
+		}
+	}
+
+	re := MustCompile(`(\d)(\d)`, 0)
+	m, _ := re.FindStringMatch("1234")
+	var pairs []string
+	for ; m != nil; m, _ = re.FindNextOverlappingMatch(m) {
+		pairs = append(pairs, m.GroupByNumber(1).String()+m.GroupByNumber(2).String())
+	}
+	if want := []string{"12", "23", "34"}; !reflect.DeepEqual(pairs, want) {
+		t.Errorf("wanted %v, got %v", want, pairs)
+	}
+	if got := re.FindAllOverlappingStringIndex("1234", 2); len(got) != 2 {
+		t.Errorf("wanted 2 matches, got %v", got)
+	}
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`\d+`, 0, "1 22 333 x 4444"},
+		{`a*`, 0, "baaac"},
+		{`(\w)\1`, 0, "aabbcd ee"},
+		{`\s`, RightToLeft, "a b c "},
+		{`foo`, 0, "no match here"},
+		{`[a-z]+`, RE2, "some words, and more"},
+		{``, 0, "abc"},
+		{`é`, 0, "ééaé"},
+		{`a*`, RightToLeft, "ba"},
+		{`x*`, RightToLeft, "abc"},
+		// the groups are read while matching
+		{`(a)(?(1)b|c)`, 0, "ab c abc"},
+		{`(?:(?<o>\()|(?<-o>\)))+(?(o)(?!))`, 0, "(()) )( ()"},
+		{`(?<=(a))b(?>(c)+)`, 0, "abcc bc abc"},
+		{`(a|b)(?1)`, PCRE2, "ab ba a"},
+		// too long for the bit-state matcher, so the backtracker counts
+		// without the groups
+		{`(?:(\w)|(\())+?(?<=(\d|\)))`, 0, strings.Repeat("ab1 cd2 (x) ", 500)},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		want := len(re.FindAllStringIndex(test.input, -1))
+		if got, err := re.Count(test.input); got != want || err != nil {
+			t.Errorf("%v on %q: wanted %v, got %v %v", test.pattern, test.input, want, got, err)
+		}
+		if got, _ := re.CountRunes([]rune(test.input)); got != want {
+			t.Errorf("%v on %q runes: wanted %v, got %v", test.pattern, test.input, want, got)
+		}
+		if got, _ := re.CountBytes([]byte(test.input)); got != want {
+			t.Errorf("%v on %q bytes: wanted %v, got %v", test.pattern, test.input, want, got)
+		}
+	}
+
+	re := MustCompile(`if|ifdef`, 0)
+	re.Longest()
+	if got, _ := re.Count("ifdef if"); got != 2 {
+		t.Errorf("wanted 2 longest matches, got %v", got)
+	}
+
+	// right to left, the empty match at the start of the text is the last
+	if got, err := MustCompile(`a*`, RightToLeft).Count("ba"); got != 3 || err != nil {
+		t.Errorf("wanted 3 right to left matches, got %v %v", got, err)
+	}
+
+	// counting leaves the groups out, but the next search fills them in
+	re = MustCompile(`(\d)(\d)`, 0)
+	if got, _ := re.Count("12 34"); got != 2 {
+		t.Errorf("wanted 2 matches, got %v", got)
+	}
+	if m, _ := re.FindStringMatch("56"); m == nil || m.GroupByNumber(2).String() != "6" {
+		t.Errorf("wanted group 2 to be 6, got %v", m)
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    []string
+	}{
+		{`(\w)(\d)`, 0, "a1 b2 c x3", []string{"a1:a", "b2:b", "x3:x"}},
+		{`a*`, 0, "baac", []string{":", "aa:", ":", ":"}},
+		{`\w+`, RightToLeft, "one two", []string{"two:", "one:"}},
+		{`(é)`, 0, "aéé", []string{"é:é", "é:é"}},
+		{`foo`, 0, "bar", nil},
+		{`(a*)`, RightToLeft, "ba", []string{"a:a", ":", ":"}},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		var got []string
+		err := re.Scan(test.input, func(m *Match) bool {
+			s := m.String() + ":"
+			if g := m.GroupByNumber(1); g != nil {
+				s += g.String()
+			}
+			if m.Index < 0 {
+				s += fmt.Sprint("@", m.Index)
+			}
+			got = append(got, s)
+			return len(got) < 10
+		})
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v on %q: wanted %q, got %q %v", test.pattern, test.input, test.want, got, err)
+		}
+	}
+
+	// fn stops it, and what it detaches it keeps
+	re := MustCompile(`\d`, 0)
+	var kept []*Match
+	re.ScanRunes([]rune("1 2 3 4"), func(m *Match) bool {
+		m.Detach()
+		kept = append(kept, m)
+		return len(kept) < 2
+	})
+	if len(kept) != 2 || kept[0].String() != "1" || kept[1].String() != "2" {
+		t.Errorf("wanted 1 and 2, got %v", kept)
+	}
+
+	re = MustCompile(`(.+)*\?`, 0)
+	err := re.Scan("Do you think you found the problem string!", func(*Match) bool { return true }, WithTimeout(time.Millisecond))
+	if err == nil {
+		t.Error("expected a timeout")
+	}
+}
+
+func TestAppendFindAllIndex(t *testing.T) {
+	re := MustCompile(`(\w)(\d)?`, 0)
+	s := "a1 b é3 c"
+	want := re.FindAllStringIndex(s, -1)
+	locs := re.AppendFindAllStringIndex([][]int{{7, 7}}, s, -1)
+	if !reflect.DeepEqual(locs[0], []int{7, 7}) || !reflect.DeepEqual(locs[1:], want) {
+		t.Errorf("wanted %v after [7 7], got %v", want, locs)
+	}
+	if got := re.AppendFindAllSubmatchIndex(nil, []byte(s), 2); !reflect.DeepEqual(got, re.FindAllStringSubmatchIndex(s, 2)) {
+		t.Errorf("unexpected submatches %v", got)
+	}
+
+	// the spare []int are reused
+	first, second := &locs[0][0], &locs[1][0]
+	locs = re.AppendFindAllStringIndex(locs[:0], "zz", -1)
+	if len(locs) != 2 || &locs[0][0] != first || &locs[1][0] != second {
+		t.Errorf("expected the slices to be reused, got %v", locs)
+	}
+
+	ascii := strings.Repeat("k=v ", 20)
+	re = MustCompile(`\w+`, 0)
+	locs = re.AppendFindAllIndex(nil, []byte(ascii), -1)
+	allocs := testing.AllocsPerRun(20, func() {
+		locs = re.AppendFindAllStringIndex(locs[:0], ascii, -1)
+	})
+	if allocs > 2 && !raceEnabled {
+		t.Errorf("wanted at most 2 allocations, got %v", allocs)
+	}
+
+	dst := make([]int, 0, 6)
+	re = MustCompile(`(\w+)=(\w+)`, 0)
+	if got := re.FindStringSubmatchIndexInto(dst, "é k=v"); !reflect.DeepEqual(got, []int{3, 6, 3, 4, 5, 6}) || &got[0] != &dst[:1][0] {
+		t.Errorf("unexpected submatch %v", got)
+	}
+	if got := re.FindSubmatchIndexInto(dst, []byte("kv")); got != nil {
+		t.Errorf("unexpected submatch %v", got)
+	}
+
+	// right to left, the search stops after an empty match at the start
+	re = MustCompile(`^(?:(?:[^a][a-c]*?)*?){1,2}`, RightToLeft)
+	if got, want := re.FindAllStringSubmatchIndex(" AcBéb", -1), [][]int{{0, 7}, {0, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+	if got, want := MustCompile(`a*`, RightToLeft).FindAllStringIndex("ba", -1), [][]int{{1, 2}, {1, 1}, {0, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestFindNextMatchInto(t *testing.T) {
+	re := MustCompile(`(?<k>\w+)=(?<v>\w*)`, 0)
+	s := "a=1 bb= c=333 d=4"
+
+	var want, got []string
+	for m, _ := re.FindStringMatch(s); m != nil; m, _ = re.FindNextMatch(m) {
+		want = append(want, fmt.Sprint(m.Index, m.GroupByName("k"), m.Groups()[2].Captures))
+	}
+	for m, _ := re.FindStringMatch(s); m != nil; m, _ = re.FindNextMatchInto(m) {
+		got = append(got, fmt.Sprint(m.Index, m.GroupByName("k"), m.Groups()[2].Captures))
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+
+	// a released Match is filled in properly again
+	m, _ := re.FindStringMatch(s)
+	m.Release()
+	m, _ = re.FindStringMatch("x=y")
+	if m.GroupByName("v").String() != "y" || m.Name != "0" || len(m.Captures) != 1 {
+		t.Errorf("unexpected match %v", m)
+	}
+	m.Release()
+
+	long := strings.Repeat("key=value ", 50)
+	allocs := testing.AllocsPerRun(20, func() {
+		for m, _ := re.FindStringMatch(long); m != nil; m, _ = re.FindNextMatchInto(m) {
+			m.GroupByNumber(2)
+		}
+	})
+	if allocs > 5 && !raceEnabled {
+		t.Errorf("wanted at most 5 allocations, got %v", allocs)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	re := MustCompile(`(?<k>\w+)=(?<v>\w*)|(?<x>!)`, 0)
+	s := strings.Repeat(".", 1000) + "key=val!" + strings.Repeat(".", 1000)
+	describe := func(m *Match) string {
+		buf := &bytes.Buffer{}
+		for _, g := range m.Groups() {
+			fmt.Fprintf(buf, "%v:%v+%v=%q ", g.Name, g.Index, g.Length, g.String())
+		}
+		return buf.String()
+	}
+	m, _ := re.FindStringMatch(s)
+	want := describe(m)
+	m.Detach()
+	if len(m.text) != 7 {
+		t.Errorf("wanted 7 runes kept, got %v", len(m.text))
+	}
+	if got := describe(m); got != want {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+	if g := m.GroupByName("v"); g.String() != "val" || string(g.Captures[0].Runes()) != "val" || g.Index != 1004 {
+		t.Errorf("unexpected group %+v", g)
+	}
+	if g := m.GroupByName("x"); g.String() != "" {
+		t.Errorf("unexpected group %+v", g)
+	}
+	if _, err := re.FindNextMatch(m); err == nil {
+		t.Error("expected an error searching on from a detached Match")
+	}
+
+	re.DetachMatches = true
+	if r, _ := re.Replace("a=b!", "[$2]", -1, -1); r != "[b][]" {
+		t.Errorf("unexpected replacement %q", r)
+	}
+	if m, _ := re.FindStringMatch("a=b"); !m.detached {
+		t.Error("expected FindStringMatch to detach the match")
+	}
+}
+
+func mustFindString(t *testing.T, re *Regexp, s string) string {
+	m, err := re.FindStringMatch(s)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if m == nil {
+		t.Fatalf("expected match for %q in %q", re.String(), s)
+	}
+	return m.String()
+}
+
+func TestMatchStringContext_Cancel(t *testing.T) {
+	re := MustCompile(`(.+)*\?`, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m, err := re.FindStringMatchContext(ctx, "Do you think you found the problem string!")
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if m != nil {
+		t.Fatalf("expected no match")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := re.MatchStringContext(ctx, "Do you think you found the problem string!"); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	// the runner is reused afterwards without the context
+	if ok, err := re.MatchString("what?"); err != nil || !ok {
+		t.Fatalf("expected match, got %v, %v", ok, err)
+	}
+}
+
+func TestFindNextMatchContext(t *testing.T) {
+	re := MustCompile(`\d+`, 0)
+	ctx := context.Background()
+
+	var got []string
+	m, err := re.FindStringMatchContext(ctx, "1 22 333")
+	for ; m != nil && err == nil; m, err = re.FindNextMatchContext(ctx, m) {
+		got = append(got, m.String())
+	}
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if want := []string{"1", "22", "333"}; !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted %v, got %v", want, got)
+	}
+
+	// right to left, an empty match at the start of the text is the last
+	re = MustCompile(`a*`, RightToLeft)
+	var spans [][2]int
+	m, err = re.FindStringMatchContext(ctx, "ba")
+	for ; m != nil && err == nil && len(spans) < 10; m, err = re.FindNextMatchContext(ctx, m) {
+		spans = append(spans, [2]int{m.Index, m.Length})
+	}
+	if want := [][2]int{{1, 1}, {1, 0}, {0, 0}}; err != nil || !reflect.DeepEqual(want, spans) {
+		t.Fatalf("wanted %v, got %v %v", want, spans, err)
+	}
+}
+
+func TestLiteralPrefixPrefilter(t *testing.T) {
+	re := MustCompile(`needle\d+`, 0)
+	if re.prefix != "needle" {
+		t.Fatalf("expected prefix needle, got %q", re.prefix)
+	}
+	if ok, _ := re.MatchString(strings.Repeat("hay ", 100)); ok {
+		t.Fatal("expected no match")
+	}
+	if m, _ := re.FindStringMatch("hay needle42 hay"); m == nil || m.String() != "needle42" {
+		t.Fatalf("expected needle42, got %v", m)
+	}
+
+	// an invalid startAt is still reported even though the prefix is missing
+	if _, err := re.FindStringMatchStartingAt("héy", 2); err == nil {
+		t.Fatal("expected an error for a startAt inside a rune")
+	}
+	if m, err := re.FindStringMatchStartingAt("héy", 1); m != nil || err != nil {
+		t.Fatalf("expected no match and no error, got %v, %v", m, err)
+	}
+
+	// case-insensitive prefixes can't use a plain substring search
+	if re := MustCompile(`needle`, IgnoreCase); re.prefix != "" {
+		t.Fatalf("expected no prefix, got %q", re.prefix)
+	}
+
+	// nor can ones with U+FFFD, which invalid UTF-8 is read as
+	for _, p := range []string{"\uFFFD", "x\uFFFDy"} {
+		re := MustCompile(p, 0)
+		if ok, _ := re.MatchString("a\xffb x\xffy"); !ok {
+			t.Errorf("%v: expected a match of invalid UTF-8", p)
+		}
+	}
+}
+
+func TestRequiredLiterals(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{`hello`, []string{"hello"}},
+		{`foo\d+bar`, []string{"foo", "bar"}},
+		{`(abc)+x`, []string{"abc", "x"}},
+		{`a{3}b`, []string{"aaab"}},
+		{`^ab\bcd$`, []string{"abcd"}},
+		{`(?:ab){2}c`, []string{"ababc"}},
+		{`x(a|b)y`, []string{"x", "y"}},
+		{`(?i)hello`, nil},
+		{`a?b*`, nil},
+		{`[abc]+`, nil},
+		{`foo(?=bar)baz`, []string{"foo", "baz"}},
+		{`foo|foo`, nil},
+	}
+	for _, tt := range tests {
+		got := MustCompile(tt.pattern, 0).RequiredLiterals()
+		if !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%v: wanted %q, got %q", tt.pattern, tt.want, got)
+		}
+	}
+}
+
+func TestPossessiveQuantifiers(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`a++a`, "aaa", ""},
+		{`a*+a`, "aaa", ""},
+		{`a?+a`, "a", ""},
+		{`a?+a`, "aa", "aa"},
+		{`a{1,3}+a`, "aaaa", "aaaa"},
+		{`a{1,3}+a`, "aaa", ""},
+		{`a{2}+`, "aaa", "aa"},
+		{`"[^"]*+"`, `say "hi" now`, `"hi"`},
+		{`(ab)++c`, "ababc", "ababc"},
+		{`(?:a|ab)++c`, "abc", ""},
+		{`(?:a|ab)++b`, "ab", "ab"},
+		{`\d++\.`, "123.", "123."},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, 0).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// a lazy quantifier can't also be possessive
+	if _, err := Compile(`a+?+`, 0); err == nil {
+		t.Fatal("expected an error for a+?+")
+	}
+}
+
+func TestRecursion(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`\((?:[^()]|(?R))*\)`, "x(a(b)c)y", "(a(b)c)"},
+		{`\((?:[^()]|(?0))*\)`, "((a)", "(a)"},
+		{`^(\((?:[^()]|(?1))*\))$`, "(()())", "(()())"},
+		{`(?<br>\[(?:[^\[\]]|(?&br))*\])`, "x[a[b][c]]", "[a[b][c]]"},
+		{`(?<br>\[(?:[^\[\]]|(?P>br))*\])`, "[[]]", "[[]]"},
+		{`(a|b(?-1))`, "bba", "bba"},
+		{`(?+1)-(\d+)`, "12-345", "12-345"},
+		{`(?1)(?1)(x|y)`, "xyx", "xyx"},
+		{`(?i)a(?-i)b`, "Ab", "Ab"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, 0).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected err: %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	if ok, _ := MustCompile(`^(\((?:[^()]|(?1))*\))$`, 0).MatchString("(()"); ok {
+		t.Error("unbalanced parens should not match")
+	}
+	if ok, _ := MustCompile(`(?i)a(?-i)b`, 0).MatchString("AB"); ok {
+		t.Error("(?-i) should still turn off case-insensitivity")
+	}
+
+	// backreferences see captures made inside the same call
+	pal := MustCompile(`^((.)(?:(?1)|.?)\2)$`, 0)
+	for in, want := range map[string]bool{"racecar": true, "abba": true, "abca": false, "a": false} {
+		if got, _ := pal.MatchString(in); got != want {
+			t.Errorf("palindrome %q: wanted %v, got %v", in, want, got)
+		}
+	}
+
+	// but a call's captures are undone when it returns
+	m, _ := MustCompile(`^(\w)(?1)$`, 0).FindStringMatch("ab")
+	if want, got := "a", m.GroupByNumber(1).String(); want != got {
+		t.Errorf("wanted group 1 %q, got %q", want, got)
+	}
+	if want, got := 1, len(m.GroupByNumber(1).Captures); want != got {
+		t.Errorf("wanted %v captures, got %v", want, got)
+	}
+}
+
+func TestRecursion_Invalid(t *testing.T) {
+	for _, p := range []string{`(?2)(a)`, `(?&nope)(?<yep>a)`, `(?1x)(a)`, `(?-2)(a)`, `(?&)`} {
+		if _, err := Compile(p, 0); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+}
+
+func TestRecursion_DepthLimit(t *testing.T) {
+	re := MustCompile(`(?R)a`, 0)
+	re.MaxRecursionDepth = 50
+	_, err := re.MatchString("aaa")
+	rerr, ok := err.(*RecursionLimitError)
+	if !ok {
+		t.Fatalf("expected *RecursionLimitError, got %v", err)
+	}
+	if want, got := 50, rerr.MaxDepth; want != got {
+		t.Fatalf("wanted MaxDepth %v, got %v", want, got)
+	}
+
+	// deep but bounded nesting is fine
+	re = MustCompile(`^(\((?1)*\))$`, 0)
+	s := strings.Repeat("(", 200) + strings.Repeat(")", 200)
+	if ok, err := re.MatchString(s); !ok || err != nil {
+		t.Fatalf("expected match, got %v, %v", ok, err)
+	}
+}
+
+func TestBranchReset(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		groups         []string
+	}{
+		{`(?|(a)|(b))`, "b", []string{"b", "b"}},
+		{`(?|(a)(b)|(c))(d)`, "cd", []string{"cd", "c", "", "d"}},
+		{`(?|(a)(b)|(c))(d)`, "abd", []string{"abd", "a", "b", "d"}},
+		{`(x)(?|(a)|(b)(c)|(d))(e)`, "xbce", []string{"xbce", "x", "b", "c", "e"}},
+		{`(?|(a)|(?|(b)|(c)(d)))(e)`, "cde", []string{"cde", "c", "d", "e"}},
+		{`(?|(?i)(a)|(b))\1`, "AA", []string{"AA", "A"}},
+		{`(?|(?:(a)|(b))|(c))`, "b", []string{"b", "", "b"}},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, 0).FindStringMatch(tt.input)
+		if err != nil || m == nil {
+			t.Fatalf("%v on %q: expected match, got %v", tt.pattern, tt.input, err)
+		}
+		gs := m.Groups()
+		if len(gs) != len(tt.groups) {
+			t.Errorf("%v on %q: wanted %v groups, got %v", tt.pattern, tt.input, len(tt.groups), len(gs))
+			continue
+		}
+		for i, want := range tt.groups {
+			if got := gs[i].String(); got != want {
+				t.Errorf("%v on %q: group %v wanted %q, got %q", tt.pattern, tt.input, i, want, got)
+			}
+		}
+	}
+
+	// the numbers are shared, so a backreference sees whichever branch matched
+	re := MustCompile(`^(?|(a)|(b))\1$`, 0)
+	for in, want := range map[string]bool{"aa": true, "bb": true, "ab": false} {
+		if got, _ := re.MatchString(in); got != want {
+			t.Errorf("%q: wanted %v, got %v", in, want, got)
+		}
+	}
+}
+
+func TestLiteralPatterns(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`abc`, 0, "xabcabc ABC abc"},
+		{`abc`, IgnoreCase, "xabcabc ABC aBc"},
+		{`abc`, IgnoreCase | RightToLeft, "xabcabc ABC aBc"},
+		{`a`, IgnoreCase, "bAaba"},
+		{`ΣΑΣ`, IgnoreCase, "σας ΣΑΣ σας"},
+		{`izmir`, IgnoreCase, "IZMIR İzmir"},
+		{`aa`, 0, "aaaaa"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		if r := re.getRunner(); r.literal == nil {
+			t.Errorf("%v: not matched as a literal", tt.pattern)
+		}
+
+		// a Tracer makes the engine run the program
+		engine := MustCompile(tt.pattern, tt.opt)
+		engine.Tracer = TracerFunc(func(TraceEvent) {})
+
+		want, got := engine.FindAllStringIndex(tt.input, -1), re.FindAllStringIndex(tt.input, -1)
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%v on %q: wanted %v, got %v", tt.pattern, tt.input, want, got)
+		}
+		for i := range tt.input {
+			want, _ := engine.FindStringMatchStartingAt(tt.input, i, WithStartAnchor())
+			got, _ := re.FindStringMatchStartingAt(tt.input, i, WithStartAnchor())
+			if (want == nil) != (got == nil) || want != nil && (want.Index != got.Index || want.Length != got.Length) {
+				t.Errorf("%v on %q at %v: wanted %v, got %v", tt.pattern, tt.input, i, want, got)
+			}
+		}
+	}
+
+	// the culture's case folding still applies
+	tr, _ := CompileCulture(`izmir`, IgnoreCase, unicode.TurkishCase)
+	if r := tr.getRunner(); r.literal == nil {
+		t.Fatal("not matched as a literal")
+	}
+	if ok, _ := tr.MatchString("İZMİR"); !ok {
+		t.Error("İZMİR didn't match in Turkish")
+	}
+	if ok, _ := tr.MatchString("IZMIR"); ok {
+		t.Error("IZMIR matched in Turkish")
+	}
+}
+
+func TestStartAnchor_Continuation(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    []string
+	}{
+		{`\G\d,?`, 0, "1,2,3 4", []string{"1,", "2,", "3"}},
+		{`\G\d*`, 0, "12 3", []string{"12", ""}},
+		{`\G\d*`, 0, " 3", []string{""}},
+		{`\G(?:\d|$)`, 0, "12", []string{"1", "2", ""}},
+		{`,?\d\G`, RightToLeft, "4 3,2,1", []string{",1", ",2", "3"}},
+		{`\d*\G`, RightToLeft, "1 23", []string{"23", ""}},
+		{`\d*`, 0, "12 3", []string{"12", "", "3", ""}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		var got []string
+		for m, err := re.FindStringMatch(tt.input); m != nil || err != nil; m, err = re.FindNextMatch(m) {
+			if err != nil {
+				t.Fatalf("%v: %v", tt.pattern, err)
+			}
+			got = append(got, m.String())
+		}
+		if !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+		if n, _ := re.Count(tt.input); n != len(tt.want) {
+			t.Errorf("%v on %q: wanted a count of %v, got %v", tt.pattern, tt.input, len(tt.want), n)
+		}
+	}
+
+	// WithStartAnchor stops at a gap the same way
+	re := MustCompile(`\d*`, 0)
+	var got []string
+	for m, _ := re.FindStringMatch("12 3", WithStartAnchor()); m != nil; m, _ = re.FindNextMatch(m, WithStartAnchor()) {
+		got = append(got, m.String())
+	}
+	if want := []string{"12", ""}; !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+}
+
+func TestDupNames(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    string // group d, or - for no match
+	}{
+		{`(?<d>\d{4})-(?<d>\d{2})|(?<d>\d{8})`, DupNames, "2024-01", "2024"},
+		{`(?<d>\d{4})-(?<d>\d{2})|(?<d>\d{8})`, DupNames, "20240102", "20240102"},
+		{`(?<d>\d{4})-(?<d>\d{2})|(?<d>\d{8})`, 0, "2024-01", "01"},
+		{`(?J)(?<d>\d{4})-(?<d>\d{2})|(?<d>\d{8})`, PCRE2, "2024-01", "2024"},
+		{`(?J)(?:(?<d>a)|(?<d>b))\k<d>`, PCRE2, "bb", "b"},
+		{`(?J)(?:(?<d>a)|(?<d>b))\k<d>`, PCRE2, "ba", "-"},
+		{`(?J)(?:(?<d>a)|(?<d>b))\g{d}`, PCRE2, "aa", "a"},
+		{`(?J)(?<d>\d{4})-(?<d>\d{2})|(?<d>\d{8})`, 0, "2024-01", "2024"},
+		{`(?J)(?:(?<d>a)|(?<d>b))\k<d>`, 0, "ba", "-"},
+		{`(?J-J)(?<d>\d{4})-(?<d>\d{2})`, 0, "2024-01", "01"},
+		{`(?iJ)(?<d>A)(?<d>B)`, 0, "ab", "a"},
+		{`(?<x>.)(?:(?<d>a)|(?<d>b))(?<y>.)\k<d>`, DupNames, "xbyb", "b"},
+		{`(?:(?<d>a)|(?<d>b))\k<d>`, 0, "bb", "b"}, // without DupNames the two groups are one
+	}
+	for _, tt := range tests {
+		re, err := Compile(tt.pattern, tt.opt)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.pattern, err)
+		}
+		m, err := re.FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.pattern, err)
+		}
+		got := "-"
+		if m != nil {
+			got = m.GroupByName("d").String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// each group keeps its number, and the name
+	re := MustCompile(`(?<d>a)(?<x>b)|(?<d>c)`, DupNames)
+	if want, got := []int{0, 1, 2, 3}, re.GetGroupNumbers(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted groups %v, got %v", want, got)
+	}
+	if want, got := []string{"0", "d", "x", "d"}, re.GetGroupNames(); !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted names %v, got %v", want, got)
+	}
+	if n := re.GroupNumberFromName("d"); n != 1 {
+		t.Fatalf("wanted d to be group 1, got %v", n)
+	}
+	m, _ := re.FindStringMatch("c")
+	if g := m.GroupByName("d"); g.String() != "c" || m.GroupByNumber(1).Success() {
+		t.Fatalf("wanted d from group 3, got %q", g.String())
+	}
+
+	// and the Regexp loaded from MarshalBinary finds them the same way
+	b, err := re.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var re2 Regexp
+	if err := re2.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := re2.FindStringMatch("c"); m.GroupByName("d").String() != "c" {
+		t.Fatalf("wanted c, got %q", m.GroupByName("d").String())
+	}
+}
+
+func TestBacktrackingVerbs(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`a(*FAIL)|b`, "ab", `1:"b"`},
+		{`a(*F)|b`, "ab", `1:"b"`},
+		{`a+(*COMMIT)b`, "xxaab", `2:"aab"`},
+		{`a+(*COMMIT)b`, "aacaab", ``},
+		{`a+(*PRUNE)b`, "aacaab", `3:"aab"`},
+		{`"[^"]*"(*PRUNE)(*FAIL)|\w+`, `foo "bar baz" qux`, `0:"foo" 5:"bar" 9:"baz" 14:"qux"`},
+		{`"[^"]*"(*SKIP)(*FAIL)|\w+`, `foo "bar baz" qux`, `0:"foo" 14:"qux"`},
+		{`a(*MARK:M)b+(*SKIP)c|b`, "abbbd", ``},
+		{`a(*MARK:M)b+(*SKIP:M)c|b`, "abbbd", `1:"b" 2:"b" 3:"b"`},
+		{`a(*SKIP:N)c|ab`, "ab", `0:"ab"`},
+		{`(?:a(*COMMIT)b)?c`, "ac", ``},
+		{`(?>a(*COMMIT)b|a)c`, "ac", ``},
+		{`(?>a(*COMMIT))b|ac`, "ac", `0:"ac"`},
+		// like PCRE, the first-char scan never tries positions that can't match
+		{`(*COMMIT)(a)`, "ba", `1:"a" 1:"a"`},
+	}
+	for _, tt := range tests {
+		if got := mustFindAll(t, MustCompile(tt.pattern, 0), tt.input); got != tt.want {
+			t.Errorf("%v on %q: wanted %v, got %v", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestBacktrackingVerbs_Mark(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`(?:x(*MARK:A)|y(*MARK:B))z`, "yz", "B"},
+		{`(*:A)a(*:B)c|ab`, "ab", ""},
+		{`(*:A)a(*:B)b`, "ab", "B"},
+		{`a(*PRUNE:P)b`, "ab", "P"},
+		{`(?=(*:A)a)a`, "a", "A"},
+		{`(?!(*:A)b)a`, "a", ""},
+		{`(?<g>(*:A)x)(?&g)`, "xx", "A"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, 0).FindStringMatch(tt.input)
+		if err != nil || m == nil {
+			t.Fatalf("%v on %q: expected match, got %v", tt.pattern, tt.input, err)
+		}
+		if got := m.Mark(); got != tt.want {
+			t.Errorf("%v on %q: wanted mark %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestBacktrackingVerbs_Invalid(t *testing.T) {
+	for _, p := range []string{`(*FOO)`, `(*MARK)`, `(*MARK:)`, `(*:`, `(*F:x)`, `(*COMMIT`} {
+		if _, err := Compile(p, 0); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+}
+
+func TestQuoteLiteral(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`\Qa.b*c\E`, "axbbc a.b*c", "a.b*c"},
+		{`x\Q(y)\E+`, "x(y))))", "x(y))))"},
+		{`\Q[a-z]`, "b[a-z]", "[a-z]"},
+		{`a\Q\Eb`, "ab", "ab"},
+		{`a\Eb`, "ab", "ab"},
+		{`(?i)\QA+\E`, "a+", "a+"},
+		{`(?x)\Qa b # c\E`, "a b # c", "a b # c"},
+		{`[\Q]-^\E]+`, "ab]-^c", "]-^"},
+		{`[\Qa-c\E]+`, "b-ad", "-a"},
+		{`[\Qa\E-c]+`, "xbca", "bca"},
+		{`\Q\\E`, `a\b`, `\`},
+		{`\Q(\E(a)\1`, "(aa", "(aa"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		if got := mustFindString(t, re, tt.input); got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// ECMAScript has no \Q, it's just an escaped Q
+	if got := mustFindString(t, MustCompile(`\Qa\E`, ECMAScript), "QaE"); got != "QaE" {
+		t.Errorf("ECMAScript \\Q: wanted %q, got %q", "QaE", got)
+	}
+}
+
+func TestPCRE2_Classes(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`\d+`, "٣3", "3"},
+		{`\w+`, "héllo", "h"},
+		{`\s+`, " \t\v ", "\t\v "},
+		{`[\s]+`, " \t\v ", "\t\v "},
+		{`[^\S]+`, " \t\v ", "\t\v "},
+		{`\bé`, "aé é", "é"},
+		{`[[:alpha:]]+`, "12ab3", "ab"},
+		{`[a-z-[aeiou]]+`, "-]]", "-]]"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, PCRE2).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestPCRE2_References(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`(a)\g1`, "aa", "aa"},
+		{`(a)\g{1}`, "aa", "aa"},
+		{`(a)(b)\g-2`, "aba", "aba"},
+		{`(a)(b)\g{-1}`, "abb", "abb"},
+		{`(?<x>a)\g{x}`, "aa", "aa"},
+		{`(?<x>a)\k{x}`, "aa", "aa"},
+		{`(?P<x>a)(?P=x)`, "aa", "aa"},
+		{`(?<x>a|b)\g<x>`, "ab", "ab"},
+		{`(a|b)\g'1'`, "ba", "ba"},
+		{`\g<+1>-(\d)`, "1-2", "1-2"},
+		{`\o{101}\x{42}`, "AB", "AB"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, PCRE2).FindStringMatch(tt.input)
+		if err != nil || m == nil {
+			t.Fatalf("%v on %q: expected match, got %v", tt.pattern, tt.input, err)
+		}
+		if got := m.String(); got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestPCRE2_Invalid(t *testing.T) {
+	for _, p := range []string{`(?<a-b>x)(?<b>y)`, `(a)\g{2}`, `\g{}`, `(a)\g<1`, `\o{}`, `\o{8}`, `(?P=nope)`} {
+		if _, err := Compile(p, PCRE2); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+	// .NET mode doesn't know about \g
+	if _, err := Compile(`(a)\g1`, 0); err == nil {
+		t.Error(`\g should need the PCRE2 option`)
+	}
+}
+
+func TestPython(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`(?P<q>['"]).*?(?P=q)`, `say "hi" now`, `"hi"`},
+		{`a\Z`, "a\n", ""},
+		{`a$`, "a\n", "a"},
+		{`(?i)ab`, "AB", "AB"},
+		{`(?i)(?s)a.b`, "A\nB", "A\nB"},
+		{`(?i:a)b`, "Ab AB", "Ab"},
+		{`(?a)\w+`, "héllo", "h"},
+		{`\w+`, "héllo", "héllo"},
+		{`(?u)\w+`, "héllo", "héllo"},
+		{`(?a:\s)`, "\u00a0 ", " "},
+		{`a{,2}`, "aaa", "aa"},
+		{`\U0001F600`, "😀", "😀"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, Python).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	for _, p := range []string{`a(?i)b`, `(a(?i))`, `^(?i)a`, `\U00110000`, `(?L)a`} {
+		if _, err := Compile(p, Python); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+	// .NET mode keeps its own meaning for these
+	if ok, _ := MustCompile(`a(?i)b`, 0).MatchString("aB"); !ok {
+		t.Error("(?i) mid-pattern should still work without the Python option")
+	}
+	if ok, _ := MustCompile(`a\Z`, 0).MatchString("a\n"); !ok {
+		t.Error(`\Z should still allow a final newline without the Python option`)
+	}
+}
+
+func TestJava(t *testing.T) {
+	tests := []struct {
+		pattern, input, want string
+	}{
+		{`[a-d[m-p]]+`, "xbmpz", "bmp"},
+		{`[a-z&&[def]]+`, "abdefg", "def"},
+		{`[a-z&&[^aeiou]]+`, "aebcdio", "bcd"},
+		{`[a-z&&def]+`, "cdeg", "de"},
+		{`[^a-z&&[aeiou]]+`, "eXbo", "Xb"},
+		{`[a-z&&]+`, "ab1", "ab"},
+		{`(?i)[a-c&&[B]]`, "aB", "B"},
+		{`[a-z-[aeiou]]+`, "x-u[", "x-u"},
+		{`\w+`, "héllo", "h"},
+		{`(?U)\w+`, "héllo", "héllo"},
+		{`é\b`, "aé", "é"},
+		{`\p{javaLowerCase}+`, "ABcdéF", "cdé"},
+		{`\p{javaWhitespace}`, "  ", " "},
+		{`\p{Alpha}+`, "1abé", "ab"},
+		{`\p{Punct}+`, "a!?b", "!?"},
+		{`\p{IsLatin}+`, "ωab", "ab"},
+		{`\p{IsLu}`, "aB", "B"},
+		{`\p{IsAlphabetic}+`, "1ⅣZ", "ⅣZ"},
+		{`\pL+`, "12ab", "ab"},
+		{`[\pN]+`, "ab12", "12"},
+		{`\0101\0400`, "A 0", "A 0"},
+		{`(?d)a$`, "a\n", "a"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, Java).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	for _, p := range []string{`(?<a-b>x)`, `\p{InGreek}`, `\p`, `\0`, `[a-z&&[b]`, `\p{IsNope}`} {
+		if _, err := Compile(p, Java); err == nil {
+			t.Errorf("%v: expected a compile error", p)
+		}
+	}
+	// the Java property names are only known in Java mode
+	if _, err := Compile(`\p{javaLowerCase}`, 0); err == nil {
+		t.Error(`\p{javaLowerCase} should need the Java option`)
+	}
+}
+
+func TestGraphemeCluster(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		want           []string
+	}{
+		{`\X`, "e\u0301a", []string{"e\u0301", "a"}},
+		{`\X`, "\r\nx", []string{"\r\n", "x"}},
+		{`\X`, "👩‍💻!", []string{"👩‍💻", "!"}},
+		{`\X`, "👍🏽", []string{"👍🏽"}},
+		{`\X`, "🇫🇷🇩🇪🇮", []string{"🇫🇷", "🇩🇪", "🇮"}},
+		{`\X`, "각각", []string{"각", "각"}},
+		{`\X`, "क्षि", []string{"क्", "षि"}},
+		{`(?<=\X)a`, "e\u0301a", []string{"a"}},
+		{`\X{2}`, "e\u0301ab", []string{"e\u0301a"}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		var got []string
+		m, err := re.FindStringMatch(tt.input)
+		for ; m != nil; m, err = re.FindNextMatch(m) {
+			got = append(got, m.String())
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// a cluster is matched whole, \X never gives back part of one
+	if ok, _ := MustCompile(`^\Xa`, 0).MatchString("a\u0301"); ok {
+		t.Error(`\X should not give back part of a cluster`)
+	}
+	if m, _ := MustCompile(`\X`, RightToLeft).FindStringMatch("ae\u0301"); m == nil || m.String() != "e\u0301" {
+		t.Errorf(`right to left \X: got %v`, m)
+	}
+}
+
+func TestUnicodeBoundaries(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		want           []string
+	}{
+		{`\b{wb}.+?\b{wb}`, "can't stop", []string{"can't", " ", "stop"}},
+		{`\b{wb}.+?\b{wb}`, "3.14, e.g.", []string{"3.14", ",", " ", "e.g", "."}},
+		{`\b{wb}.+?\b{wb}`, "日本語", []string{"日", "本", "語"}},
+		{`\b{wb}.+?\b{wb}`, "カタカナ", []string{"カタカナ"}},
+		{`\b{wb}.+?\b{wb}`, "naïve_x1", []string{"naïve_x1"}},
+		{`\b{wb}.+?\b{wb}`, "a  b", []string{"a", "  ", "b"}},
+		{`\B{wb}.`, "ab c", []string{"b"}},
+		{`\b{g}.+?\b{g}`, "e\u0301a", []string{"e\u0301", "a"}},
+		{`.\B{g}`, "e\u0301a", []string{"e"}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		var got []string
+		m, err := re.FindStringMatch(tt.input)
+		for ; m != nil; m, err = re.FindNextMatch(m) {
+			got = append(got, m.String())
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	// the classic \b is unchanged, and \b{...} is only a boundary for wb and g
+	if m, _ := MustCompile(`\b{2}a`, 0).FindStringMatch("a"); m == nil {
+		t.Error(`\b{2} should still be a quantified \b`)
+	}
+}
+
+func TestHorizVertSpace(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    []string
+	}{
+		{`\R`, 0, "a\r\nb\nc\rd\u2028e", []string{"\r\n", "\n", "\r", "\u2028"}},
+		{`\R\n`, 0, "\r\n", nil},
+		{`a\R`, RightToLeft, "a\r\n", []string{"a\r\n"}},
+		{`\h+`, 0, "a \t\u00a0\u3000b\nc", []string{" \t\u00a0\u3000"}},
+		{`\H+`, 0, "a \tb", []string{"a", "b"}},
+		{`[\h,]+`, 0, "a, b", []string{", "}},
+		{`\V+`, 0, "ab\ncd\u0085", []string{"ab", "cd"}},
+		{`\v+`, PCRE2, "a\n\v\f\rb", []string{"\n\v\f\r"}},
+		{`[\v]`, Java, "a\u2029", []string{"\u2029"}},
+		// \v is still the vertical tab outside PCRE2 and Java
+		{`\v`, 0, "\n\v", []string{"\v"}},
+		{`\h`, ECMAScript, "h ", []string{"h"}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		var got []string
+		m, err := re.FindStringMatch(tt.input)
+		for ; m != nil; m, err = re.FindNextMatch(m) {
+			got = append(got, m.String())
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	if _, err := Compile(`[a-\h]`, 0); err == nil {
+		t.Error(`expected an error for a range ending in \h`)
+	}
+}
+
+func TestCaseFolding(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		want           bool
+	}{
+		{`s`, "ſ", true},
+		{`ſ`, "S", true},
+		{`ſ+`, "sSſ", true},
+		{`k`, "\u212a", true},
+		{`\u212a`, "K", true},
+		{`σ`, "ς", true},
+		{`ς`, "Σ", true},
+		{`[ς]`, "σ", true},
+		{`[α-ω]+$`, "ΣΑΣ", true},
+		{`[ρ-τ]`, "ϱ", true},
+		{`µ`, "Μ", true},
+		{`(ς)\1`, "σΣ", true},
+		{`[^s]`, "ſ", false},
+		{`ı`, "I", false},
+		// İ has no simple case folding, only a full one to i̇
+		{`i`, "İ", false},
+		{`İ`, "i", false},
+		// but in a range İ lowercases to i, as in .NET, unless
+		// CultureInvariant
+		{`[İ-ı]`, "i", true},
+		{`[İ-ı]`, "İ", true},
+		{`[\x{100}-\x{17f}]`, "i", true},
+		// Cherokee folds to uppercase
+		{`Ꭰ`, "ꭰ", true},
+		{`ꭰ`, "Ꭰ", true},
+		{`[ꭰ-ꭱ]`, "Ꭱ", true},
+		{`[Ꭰ-Ꭱ]+`, "ꭱꭰ", true},
+		{`ﬅ`, "ﬆ", true},
+		// full case folding, where a rune is equal to several
+		{`Straße`, "STRASSE", true},
+		{`strasse`, "STRAẞE", true},
+		{`ß`, "ſs", true},
+		{`^ß+$`, "ssßSS", true},
+		{`^ß{2}$`, "sss", false},
+		{`ﬁ`, "FI", true},
+		{`^fi$`, "ﬁ", true},
+		{`ǰ`, "J̌", true},
+		{`İ`, "i̇", true},
+		{`i̇`, "İ", true},
+		{`[ßx]`, "ss", false},
+		{`(ß)\1`, "ßss", false},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, IgnoreCase)
+		if m, err := re.MatchString(tt.input); err != nil || m != tt.want {
+			t.Errorf("%v on %q: wanted %v, got %v (err: %v)", tt.pattern, tt.input, tt.want, m, err)
+		}
+	}
+
+	folds := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    []string
+	}{
+		{`ß`, IgnoreCase, "Strasse STRAßE", []string{"ss", "ß"}},
+		{`ss`, IgnoreCase | RightToLeft, "Straße SS", []string{"SS", "ß"}},
+		{`stra(?:ß)e`, IgnoreCase | RightToLeft, "xSTRASSE straẞe", []string{"straẞe", "STRASSE"}},
+		{`ﬃ+`, IgnoreCase, "oFFIﬃce", []string{"FFIﬃ"}},
+		// the other flavours compare rune by rune
+		{`ß`, IgnoreCase | ECMAScript, "ss ß", []string{"ß"}},
+		{`ss`, IgnoreCase | PCRE2, "ß ss", []string{"ss"}},
+	}
+	for _, tt := range folds {
+		re := MustCompile(tt.pattern, tt.opt)
+		var got []string
+		m, err := re.FindStringMatch(tt.input)
+		for ; m != nil; m, err = re.FindNextMatch(m) {
+			got = append(got, m.String())
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	for _, r := range []rune{'K', 'ſ', 'ς', 'ϑ', 'İ', 'ı', 'Ꭰ', 'ꭰ', 'ẞ'} {
+		want := map[rune]rune{'K': 'k', 'ſ': 's', 'ς': 'σ', 'ϑ': 'θ', 'İ': 'İ', 'ı': 'ı', 'Ꭰ': 'Ꭰ', 'ꭰ': 'Ꭰ', 'ẞ': 'ß'}[r]
+		if got := syntax.CaseFold(r); got != want {
+			t.Errorf("CaseFold(%q): wanted %q, got %q", r, want, got)
+		}
+	}
+}
+
+func TestPOSIXClasses(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    string
+	}{
+		{`[[:alpha:]]+`, 0, "12ab3", "ab"},
+		{`[[:digit:][:space:]]+`, 0, "ab1 2c", "1 2"},
+		{`[^[:alnum:]]+`, 0, "ab-+c", "-+"},
+		{`[[:^digit:]]+`, 0, "12ab3", "ab"},
+		{`[x[:upper:]]+`, 0, "axYZb", "xYZ"},
+		{`[[:punct:]]+`, Multiline, "a,.!b", ",.!"},
+		// without the closing :] it's an ordinary class
+		{`[[:a]+`, 0, "b[:a", "[:a"},
+		// modes where [[:alpha:]] is [\[:ahlp]\]
+		{`[[:alpha:]]`, ECMAScript, "a]", "a]"},
+		{`[[:alpha:]]`, Python, "a]", "a]"},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, tt.opt).FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	if _, err := Compile(`[[:alfa:]]`, 0); err == nil {
+		t.Error("expected an error for an unknown POSIX class")
+	}
+}
+
+func TestScriptExtensions(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    bool
+	}{
+		// U+0951 is Inherited, but used with Devanagari and other Indic scripts
+		{`^\p{scx=Devanagari}+$`, 0, "क\u0951", true},
+		{`^\p{Devanagari}+$`, 0, "क\u0951", false},
+		{`^\p{Script_Extensions=Bengali}$`, 0, "\u0951", true},
+		{`^\p{scx=Greek}$`, 0, "\u0951", false},
+		{`^\p{scx=Greek}+$`, 0, "αβ", true},
+		{`^\p{scx=Inherited}$`, 0, "\u0951", false},
+		{`^\p{sc=Inherited}$`, 0, "\u0951", true},
+		{`^\P{scx=Devanagari}$`, 0, "\u0951", false},
+		{`^[\p{scx=Devanagari}a]+$`, 0, "a\u0951", true},
+		{`^\p{scx:Devanagari}$`, PCRE2, "\u0951", true},
+		{`^\p{scx=Devanagari}$`, ECMAScript, "\u0951", true},
+		// the short names from PropertyValueAliases
+		{`^\p{scx=Deva}+$`, 0, "क\u0951", true},
+		{`^\p{scx=Grek}$`, 0, "\u0951", false},
+		{`^\p{sc=Grek}+$`, 0, "αβ", true},
+		{`^\p{Script=Zinh}$`, 0, "\u0951", true},
+		{`^\p{scx:Deva}$`, PCRE2, "\u0951", true},
+		{`^\p{sc=Grek}$`, ECMAScript, "α", true},
+		{`^\p{scx=Beng}$`, ECMAScript, "\u0951", true},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		if m, err := re.MatchString(tt.input); err != nil || m != tt.want {
+			t.Errorf("%v on %q: wanted %v, got %v (err: %v)", tt.pattern, tt.input, tt.want, m, err)
+		}
+	}
+
+	for _, p := range []string{`\p{scx=Klingon}`, `\p{foo=Greek}`, `\p{scx:Greek}`, `\p{sc=Xxxx}`} {
+		if _, err := Compile(p, 0); err == nil {
+			t.Errorf("%v: expected an error", p)
+		}
+	}
+}
+
+func TestCulture(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		opt            RegexOptions
+		want           bool
+	}{
+		{`i`, "I", 0, false},
+		{`ı`, "I", 0, true},
+		{`i`, "İ", 0, true},
+		{`[ı]`, "I", 0, true},
+		{`[H-J]`, "ı", 0, true},
+		{`(I)\1`, "Iı", 0, true},
+		{`title`, "TITLE", 0, false},
+		{`i`, "I", CultureInvariant, true},
+		{`ı`, "I", CultureInvariant, false},
+		// strictly invariant: the dotted and dotless i are only themselves
+		{`i`, "İ", CultureInvariant, false},
+		{`İ`, "i", CultureInvariant, false},
+		{`I`, "ı", CultureInvariant, false},
+		{`[İı]`, "iI", CultureInvariant, false},
+		{`[h-j]`, "İ", CultureInvariant, false},
+		{`[\x{100}-\x{17f}]`, "i", CultureInvariant, false},
+		{`İı`, "İı", CultureInvariant, true},
+	}
+	for _, tt := range tests {
+		re, err := CompileCulture(tt.pattern, IgnoreCase|tt.opt, unicode.TurkishCase)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.pattern, err)
+		}
+		if m, err := re.MatchString(tt.input); err != nil || m != tt.want {
+			t.Errorf("%v on %q: wanted %v, got %v (err: %v)", tt.pattern, tt.input, tt.want, m, err)
+		}
+	}
+
+	// without a culture the casing is invariant
+	if m, _ := MustCompile(`i`, IgnoreCase).MatchString("I"); !m {
+		t.Error("expected i to match I without a culture")
+	}
+	for _, tt := range [][2]string{{`i`, "İ"}, {`İ`, "i"}, {`I`, "ı"}, {`ı`, "I"}} {
+		if m, _ := MustCompile(tt[0], IgnoreCase).MatchString(tt[1]); m {
+			t.Errorf("%v matched %q without a culture", tt[0], tt[1])
+		}
+	}
+}
+
+func TestRegisterUnicodeProperty(t *testing.T) {
+	vowels := &unicode.RangeTable{R16: []unicode.Range16{{'a', 'a', 1}, {'e', 'i', 4}, {'o', 'o', 1}, {'u', 'u', 1}}}
+	before, err := Compile(`\p{Vowel}`, 0)
+	if err == nil {
+		t.Fatalf("expected \\p{Vowel} to be unknown before it's registered, got %v", before)
+	}
+	if err := RegisterUnicodeProperty("Vowel", vowels); err != nil {
+		t.Fatal(err)
+	}
+	re := MustCompile(`\p{Vowel}+\P{Vowel}`, 0)
+	if m, _ := re.FindStringMatch("xyzaeb"); m == nil || m.String() != "aeb" {
+		t.Errorf("wanted aeb, got %v", m)
+	}
+	// ECMAScript takes registered names as binary properties
+	if m, _ := MustCompile(`[\p{Vowel}]`, ECMAScript).FindStringMatch("xi"); m == nil || m.String() != "i" {
+		t.Errorf("wanted i, got %v", m)
+	}
+
+	for _, name := range []string{"", "a b", "x=y"} {
+		if err := RegisterUnicodeProperty(name, vowels); err == nil {
+			t.Errorf("%q: expected an error", name)
+		}
+	}
+	if err := RegisterUnicodeProperty("Nothing", nil); err == nil {
+		t.Error("expected an error for a nil table")
+	}
+}
+
+func TestRegisterCharClass(t *testing.T) {
+	hostchar := func(r rune) bool {
+		return r == '-' || r == '.' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z')
+	}
+	if err := RegisterCharClass("hostchar", hostchar); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterUnicodeProperty("Digit7", &unicode.RangeTable{R16: []unicode.Range16{{'0', '7', 1}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		want    string
+	}{
+		{`\p{hostchar}+`, 0, "http://www.example.com:80/", "http"},
+		{`//\p{hostchar}+`, 0, "http://www.example.com:80/", "//www.example.com"},
+		{`[[:hostchar:]]+:`, 0, "http://www.example.com:80/", "http:"},
+		{`[^\p{hostchar}/:]`, 0, "http://www.ex_ample.com", "_"},
+		{`[[:^hostchar:]]+`, 0, "a.b%%c", "%%"},
+		{`\P{hostchar}+`, 0, "a.b%%c", "%%"},
+		{`\p{hostchar}+`, IgnoreCase, "WWW.Example.com", "WWW.Example.com"},
+		{`[[:Digit7:]]+`, 0, "0129", "012"},
+		// Java reads it as a nested class of the letters
+		{`[[:hostchar:]]+`, Java, "[:hostchar:]", ":hostchar:"},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		m, err := re.FindStringMatch(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m == nil || m.String() != test.want {
+			t.Errorf("%v on %q: wanted %q, got %v", test.pattern, test.input, test.want, m)
+		}
+	}
+
+	// the most recent registration of a name wins
+	if err := RegisterUnicodeProperty("hostchar", &unicode.RangeTable{R16: []unicode.Range16{{'x', 'x', 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := MustCompile(`\p{hostchar}+`, 0).FindStringMatch("abxx"); m == nil || m.String() != "xx" {
+		t.Errorf("wanted xx, got %v", m)
+	}
+
+	if err := RegisterCharClass("a:b", hostchar); err == nil {
+		t.Error("expected an error for a name with a colon")
+	}
+	if err := RegisterCharClass("Nothing", nil); err == nil {
+		t.Error("expected an error for a nil func")
+	}
+}
+
+func TestCharNames(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+		want           []string
+	}{
+		{`\N{LATIN SMALL LETTER A WITH ACUTE}`, "aá", []string{"á"}},
+		{`\N{latin_small_letter_a_with_acute}`, "á", []string{"á"}},
+		{`[\N{GREEK SMALL LETTER ALPHA}-\N{GREEK SMALL LETTER GAMMA}]+`, "xαβγδ", []string{"αβγ"}},
+		{`\N{U+263A}`, "☺", []string{"☺"}},
+		{`\N{CJK UNIFIED IDEOGRAPH-4E2D}`, "中文", []string{"中"}},
+		{`\N{HANGUL SYLLABLE HAN}`, "한국", []string{"한"}},
+		{`\N{NO-BREAK SPACE}`, "a\u00a0", []string{"\u00a0"}},
+		{`\N{HANGUL JUNGSEONG O-E}`, "\u116c\u1180", []string{"\u1180"}},
+		{`\N+`, "ab\ncd", []string{"ab", "cd"}},
+		{`\N{2}`, "abc", []string{"ab"}},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		var got []string
+		m, err := re.FindStringMatch(tt.input)
+		for ; m != nil; m, err = re.FindNextMatch(m) {
+			got = append(got, m.String())
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+
+	for _, p := range []string{`\N{NOT A REAL NAME}`, `\N{}`, `\N{LATIN`, `[\N]`, `\N{CJK UNIFIED IDEOGRAPH-0041}`} {
+		if _, err := Compile(p, 0); err == nil {
+			t.Errorf("%v: expected an error", p)
+		}
+	}
+}
+
+func TestLiteralAlternation(t *testing.T) {
+	words := []string{"fo", "foo", "foobar", "bar", "barn", "abcd", "bc", "b", "if", "else", "foo"}
+	alt := strings.Join(words, "|")
+	// the same alternation with each branch in a group, which the trie
+	// doesn't take, to check it against
+	slow := "(" + strings.Join(words, ")|(") + ")"
+
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`(?:%s)`, 0, "xx foobar"},
+		{`(?:%s)bar`, 0, "foobarbar"},
+		{`(?:%s)n`, 0, "barn"},
+		{`(?:%s)\b`, 0, "fooba abcd"},
+		{`(?:%s)d`, 0, "zabcd"},
+		{`x(?:%s)+y`, 0, "xfoofoobarbarny"},
+		{`(?:%s)$`, 0, "ifelsefoob"},
+		{`(?:%s)`, IgnoreCase, "ELSE IF"},
+		{`(?:%s)z`, 0, "foobarbarn"},
+		{`\b(?:%s)\b`, Multiline, "abc\nelse"},
+	}
+	for _, test := range tests {
+		re := MustCompile(fmt.Sprintf(test.pattern, alt), test.opt)
+		if !strings.Contains(re.code.Dump(), "Trie(") {
+			t.Errorf("%v: expected a Trie instruction", test.pattern)
+		}
+		want := MustCompile(fmt.Sprintf(test.pattern, slow), test.opt)
+
+		var got, wanted []string
+		for m, _ := re.FindStringMatch(test.input); m != nil; m, _ = re.FindNextMatch(m) {
+			got = append(got, fmt.Sprintf("%v@%v", m.String(), m.Index))
+		}
+		for m, _ := want.FindStringMatch(test.input); m != nil; m, _ = want.FindNextMatch(m) {
+			wanted = append(wanted, fmt.Sprintf("%v@%v", m.String(), m.Index))
+		}
+		if !reflect.DeepEqual(got, wanted) {
+			t.Errorf("%v on %q: wanted %v, got %v", test.pattern, test.input, wanted, got)
+		}
+	}
+}
+
+func TestSplitWithGroups(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		n       int
+		want    []string
+	}{
+		{`\s*([,;])\s*`, 0, "a, b;c", -1, []string{"a", ",", "b", ";", "c"}},
+		{`(-)|(\+)`, 0, "1-2+3", -1, []string{"1", "-", "2", "+", "3"}},
+		{`(\d)(x)?`, 0, "a1b2xc", -1, []string{"a", "1", "b", "2", "x", "c"}},
+		{`(,)`, 0, "a,b,c", 2, []string{"a", ",", "b,c"}},
+		{`(?:,)`, 0, "a,b", -1, []string{"a", "b"}},
+		{`(,)`, RightToLeft, "a,b,c", -1, []string{"a", ",", "b", ",", "c"}},
+		{`(,)`, 0, "", -1, []string{""}},
+	}
+	for _, tt := range tests {
+		got := MustCompile(tt.pattern, tt.opt).SplitWithGroups(tt.input, tt.n)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q %q %v: got %q, want %q", tt.pattern, tt.input, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDOT(t *testing.T) {
+	pattern := `a(?<x>b|"c")*?d`
+	prog := MustCompile(pattern, 0).ProgramDOT()
+	if !strings.HasPrefix(prog, "digraph program {") || !strings.HasSuffix(prog, "}\n") {
+		t.Errorf("not a graph:\n%v", prog)
+	}
+	for _, want := range []string{`[style=dashed]`, `Lazybranch`, `Capturemark`, `\"c\"`, `Stop`} {
+		if !strings.Contains(prog, want) {
+			t.Errorf("program graph has no %v:\n%v", want, prog)
+		}
+	}
+
+	tree, err := syntax.Parse(pattern, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph := tree.DOT()
+	nodes, edges := strings.Count(graph, "[label="), strings.Count(graph, " -> ")
+	if nodes < 5 || edges != nodes-1 {
+		t.Errorf("a tree of %v nodes has %v edges:\n%v", nodes, edges, graph)
+	}
+	for _, want := range []string{"Alternate", "Capture", "Lazyloop"} {
+		if !strings.Contains(graph, want) {
+			t.Errorf("tree graph has no %v:\n%v", want, graph)
+		}
+	}
+}
+
+func TestASTString(t *testing.T) {
+	for _, c := range []struct {
+		pattern string
+		opt     RegexOptions
+		want    string
+		input   string
+	}{
+		{`abc|abd`, 0, `abc|abd`, "xabd"},
+		{`(?i)hello (world)+`, 0, `(?i)hello (world)+`, "HELLO WorldWORLD"},
+		{`a(?i:b)c`, 0, `a(?i:b)c`, "aBc"},
+		{`^a.b$`, Multiline | Singleline, `(?ms)^a.b$`, "x\na\nb"},
+		{`\Aa.b\Z`, 0, `^a.b$`, "a-b\n"},
+		{`(?<=ab)c(?<!x y)`, 0, `(?<=ab)c(?<!x y)`, "abc"},
+		{`ab(?<=b)c`, RightToLeft, `ab(?<=b)c`, "abcabc"},
+		{`(?<n>a)(b)\k<n>\1`, 0, `(?<n>a)(b)\k<n>\k<1>`, "abab"},
+		{`(?<o>\()(?<-o>\))`, 0, `(?<o>\()(?<-1>\))`, "(()"},
+		{`(a)?(?(1)b|c)(?(?=d)de|f)(?(g)g)`, 0, `(a)?(?(1)b|c)(?(?=d)de|f)(?((?:g))g)`, "abdeg"},
+		{`(?:a|b)+?c{2,5}|\d*`, 0, `[ab]+?c{2,5}|\d*`, "bacc"},
+		{`(?:ab)*(?>a+)(*COMMIT)`, 0, `(?:ab)*(?>a+)(*COMMIT)`, "abaa"},
+		{`[a-z-[aeiou]]\W[^\s][.*+?]\x01Ā\x{1F600}`, 0, `[a-z-[aeiou]]\W\S[*+.?]\x01Ā😀`, "b !?\x01Ā😀"},
+		{`(?n)(a)(?<x>b)`, 0, `a(?<x>b)`, "ab"},
+		{`(?<x>a)(?<y-x>b)(?&x)`, 0, `(?<x>a)(?<y-1>b)(?&x)`, "aba"},
+		{`[a-f&&[^c]]x`, Java, `(?:(?=[a-f])(?!(?:(?=[\s\S])(?!(?:(?=[^c])[\s\S]))[\s\S]))[\s\S])x`, "cxex"},
+		{`x\b`, ECMAScript, `x(?:(?<=[\p{L}\p{Mn}\p{Nd}\p{Pc}])(?![\p{L}\p{Mn}\p{Nd}\p{Pc}])|(?<![\p{L}\p{Mn}\p{Nd}\p{Pc}])(?=[\p{L}\p{Mn}\p{Nd}\p{Pc}]))`, "xé x"},
+	} {
+		tree, err := syntax.Parse(c.pattern, syntax.RegexOptions(c.opt))
+		if err != nil {
+			t.Fatalf("%v: %v", c.pattern, err)
+		}
+		got := tree.AST().String()
+		if got != c.want {
+			t.Errorf("%v printed as %v, want %v", c.pattern, got, c.want)
+			continue
+		}
+		re, err := Compile(got, c.opt&RightToLeft)
+		if err != nil {
+			t.Errorf("%v printed as %v: %v", c.pattern, got, err)
+			continue
+		}
+		m1, _ := MustCompile(c.pattern, c.opt).FindStringMatch(c.input)
+		m2, _ := re.FindStringMatch(c.input)
+		if fmt.Sprint(m1) != fmt.Sprint(m2) || m1 != nil && m1.Index != m2.Index {
+			t.Errorf("%v matched %v in %q, but %v matched %v", c.pattern, m1, c.input, got, m2)
+		}
+	}
+}
+
+func TestASTRewrite(t *testing.T) {
+	tree, err := syntax.Parse(`(?<year>\d{4})-(\d\d)(?<=\d)|x`, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ast := tree.AST()
+	counts := map[syntax.Op]int{}
+	depth, maxDepth := 0, 0
+	syntax.Inspect(ast, func(n *syntax.Node) bool {
+		if n == nil {
+			depth--
+			return false
+		}
+		counts[n.Op]++
+		if depth++; depth > maxDepth {
+			maxDepth = depth
+		}
+		return true
+	})
+	if counts[syntax.OpCapture] != 2 || counts[syntax.OpRepeat] != 1 || counts[syntax.OpLookbehind] != 1 || depth != 0 || maxDepth != 5 {
+		t.Errorf("counted %v, to depth %v", counts, maxDepth)
+	}
+
+	// make the year group non-capturing and drop the lookbehind
+	before := ast.String()
+	rewritten := syntax.Rewrite(ast, func(n *syntax.Node) *syntax.Node {
+		switch {
+		case n.Op == syntax.OpCapture && n.Name == "year":
+			return n.Children[0]
+		case n.Op == syntax.OpLookbehind:
+			return nil
+		}
+		return n
+	})
+	if got, want := rewritten.String(), `\d{4}-(\d\d)|x`; got != want {
+		t.Errorf("rewritten as %v, want %v", got, want)
+	}
+	if got := ast.String(); got != before {
+		t.Errorf("rewriting changed the tree from %v to %v", before, got)
+	}
+	if syntax.Rewrite(ast, func(n *syntax.Node) *syntax.Node { return n }) != ast {
+		t.Error("a rewrite that changes nothing made a new tree")
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	same := [][]string{
+		{`a|b`, `[ab]`, `[ba]`, `(?:a|b)`},
+		{`\x41B`, `AB`, `(?:A)B`, `\QAB\E`},
+		{`(?i:a)1`, `(?i)a1`, `(?i)A(?-i)1`, `(?i:a)(?i:1)`},
+		{`[\d0-9]+`, `\p{Nd}+`, `\d{1,}`},
+		{`(?m)^x$`, `(?m:^)x(?m:$)`},
+		{`\Ax\Z`, `^x$`, `(?m)\Ax\Z`},
+		{`(?s).`, `[\s\S]`, `(?s:.)`},
+		{`.`, `[^\n]`},
+		{`(a)(?<x>b)\1`, `(a)(?<x>b)\k<1>`, `(?:(a))(?<x>b)\1`},
+	}
+	for _, patterns := range same {
+		want, err := Canonical(patterns[0], 0)
+		if err != nil {
+			t.Fatalf("%v: %v", patterns[0], err)
+		}
+		for _, p := range patterns[1:] {
+			if got, _ := Canonical(p, 0); got != want {
+				t.Errorf("%v is %v, but %v is %v", p, got, patterns[0], want)
+			}
+		}
+	}
+
+	different := [][]string{
+		{`(?i)a`, `a`},
+		{`(?m)^x`, `^x`},
+		{`(a)`, `(?:a)`},
+		{`a|ab`, `ab|a`},
+		{`a*`, `a*?`},
+	}
+	for _, pair := range different {
+		a, _ := Canonical(pair[0], 0)
+		b, _ := Canonical(pair[1], 0)
+		if a == b {
+			t.Errorf("%v and %v are both %v", pair[0], pair[1], a)
+		}
+	}
+
+	if got, want := mustCanonical(t, `(?i)\d+(?-i:x)`, 0), `\d+x`; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := mustCanonical(t, `ab`, IgnoreCase|RightToLeft), `(?i)ab`; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if _, err := Canonical(`(`, 0); err == nil {
+		t.Error("no error for a pattern that doesn't parse")
+	}
+}
+
+func mustCanonical(t *testing.T, pattern string, opt RegexOptions) string {
+	s, err := Canonical(pattern, opt)
+	if err != nil {
+		t.Fatalf("%v: %v", pattern, err)
+	}
+	return s
+}
+
+func TestOptimizations(t *testing.T) {
+	optimized := []struct {
+		pattern       string
+		optimizations Optimization
+		want          string
+	}{
+		{`a|b|[cd]|ef`, 0, `a|b|[cd]|ef`},
+		{`a|b|[cd]|ef`, OptMergeSets, `[a-d]|ef`},
+		{`(?:a+)*`, DefaultOptimizations &^ OptCoalesceLoops, `(?:a+)*`},
+		{`(?:a+)*`, DefaultOptimizations, `a*`},
+		{`\d+x|a+[^a]|y+`, DefaultOptimizations, `\d+x|a+[^a]|y+`},
+		{`\d+x|a+[^a]|y+`, OptAutoAtomic, `(?>\d+)x|(?>a+)[^a]|y+`},
+		{`\d+x\d+`, OptAutoAtomic, `(?>\d+)x(?>\d+)`},
+		{`a+a|a+(?1)|(a+)`, OptAutoAtomic, `a+a|a+(?1)|(a+)`},
+		{`cat|bat|rat`, AllOptimizations, `[bcr]at`},
+		{`Monday|Friday|day`, OptHoistSuffixes, `(?:Mon|Fri|)day`},
+		{`(?i)abc|xbc`, AllOptimizations, `(?i)[ax]bc`},
+		{`abc|(?i:xbc)`, AllOptimizations, `abc|(?i:xbc)`},
+	}
+	for _, c := range optimized {
+		re, err := CompileOptimized(c.pattern, 0, c.optimizations)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := re.Optimized(); got != c.want {
+			t.Errorf("%v with %v optimized to %v, want %v", c.pattern, c.optimizations, got, c.want)
+		}
+	}
+
+	// none of them change what's matched
+	patterns := []string{
+		`\d+x|a+[^a]|y+`, `(?:cat|bat|rat)s?`, `(\w+)day|(\w)+ay`, `a*b|c*d`, `(?:ab|b)+c`,
+		`x(?:foo|bar|baz|qux)+y`, `(?:a{2,}){2}b`, `(?<=\d+)x`, `(?i)KEY|ey`, `[a-c]+c`,
+	}
+	input := "1x aab cats xfoobary aaaaab rats abbc KeY 12x monday abcc tuesday"
+	for _, pattern := range patterns {
+		var want string
+		for i, o := range []Optimization{0, DefaultOptimizations, AllOptimizations} {
+			re, err := CompileOptimized(pattern, 0, o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			for m, _ := re.FindStringMatch(input); m != nil; m, _ = re.FindNextMatch(m) {
+				for _, g := range m.Groups() {
+					got += fmt.Sprintf("%v:%q ", g.Index, g.String())
+				}
+			}
+			if i == 0 {
+				want = got
+			} else if got != want {
+				t.Errorf("%v with %v matched %v, want %v", pattern, o, got, want)
+			}
+		}
+	}
+}
+
+func TestTranslateRE2(t *testing.T) {
+	translated := []struct {
+		pattern string
+		opt     RegexOptions
+		want    string
+	}{
+		{`(?<year>\d{4})-(?<month>\d\d)`, 0, `(?P<year>\p{Nd}{4})-(?P<month>\p{Nd}\p{Nd})`},
+		{`(?i)ab+|c`, 0, `(?i)ab+|c`},
+		{`(?m)^\s*\w+$`, 0, `(?m)^[\t-\r\x{85}\p{Z}]*[\p{L}\p{Mn}\p{Nd}\p{Pc}\x{200c}\x{200d}]+$`},
+		{`x.\e`, Singleline, `(?s)x.\x1b`},
+		{`[^\d]\z`, 0, `\P{Nd}\z`},
+		{`[a-z-[aeiou]]`, 0, `[b-df-hj-np-tv-z]`},
+		{`(a)|(?<n>b)`, 0, `(a)|(?P<n>b)`},
+		{`\Aa{2,5}?`, 0, `^a{2,5}?`},
+	}
+	for _, c := range translated {
+		got, err := TranslateRE2(c.pattern, c.opt)
+		if err != nil {
+			t.Errorf("%v: %v", c.pattern, err)
+		} else if got != c.want {
+			t.Errorf("%v translated to %v, want %v", c.pattern, got, c.want)
+		}
+	}
+
+	// what the translation matches in the regexp package is what the
+	// pattern matches here
+	inputs := []string{"2024-06", "abbb", "  x _é9 ", "x\n\x1b", "ABC\n", "kiwi", "٣٤"}
+	for _, c := range translated {
+		re := MustCompile(c.pattern, c.opt)
+		std := regexp.MustCompile(c.want)
+		for _, in := range inputs {
+			var want []string
+			if m, _ := re.FindStringMatch(in); m != nil {
+				for _, g := range m.Groups() {
+					want = append(want, g.String())
+				}
+			}
+			got := std.FindStringSubmatch(in)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v on %q: regexp matched %q, want %q", c.want, in, got, want)
+			}
+		}
+	}
+
+	blocked := []struct {
+		pattern string
+		want    [][2]string
+	}{
+		{`(a)\1`, [][2]string{{"back reference", `\k<1>`}}},
+		{`(?<=a)b(?!c)`, [][2]string{{"lookbehind", `(?<=a)`}, {"lookahead", `(?!c)`}}},
+		{`^\w+$`, [][2]string{{"end of text or before a final newline", `$`}}},
+		{`(?<n>a)(b)`, [][2]string{{"group numbered 2 rather than 1", `(?<n>a)`}, {"group numbered 1 rather than 2", `(b)`}}},
+		{`\ba{1001}`, [][2]string{{"Unicode word boundary", `\b`}, {"repeat count over 1000", `a{1001}`}}},
+	}
+	for _, c := range blocked {
+		_, err := TranslateRE2(c.pattern, 0)
+		re2err, ok := err.(*syntax.RE2Error)
+		if !ok {
+			t.Errorf("%v: got error %v, want an RE2Error", c.pattern, err)
+			continue
+		}
+		var got [][2]string
+		for _, u := range re2err.Unsupported {
+			got = append(got, [2]string{u.Feature, u.Text})
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v: got %q, want %q", c.pattern, got, c.want)
+		}
+	}
+}
+
+func TestCloneWithOptions(t *testing.T) {
+	re := MustCompile(`h(?<x>e)llo`, 0)
+	re.MatchTimeout = time.Second
+	re.MaxSteps = 1000
+
+	c := re.Clone()
+	c.MatchTimeout = time.Minute
+	c.Longest()
+	if re.MatchTimeout != time.Second || re.longest {
+		t.Error("changing the clone changed the original")
+	}
+	if ok, _ := c.MatchString("say hello"); !ok || c.MaxSteps != 1000 || c.String() != re.String() {
+		t.Error("clone isn't the same pattern")
+	}
+
+	ci, err := re.WithOptions(re.Options() | IgnoreCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := ci.MatchString("HELLO"); !ok {
+		t.Error("WithOptions didn't add IgnoreCase")
+	}
+	if ok, _ := re.MatchString("HELLO"); ok {
+		t.Error("WithOptions changed the original")
+	}
+	if ci.MatchTimeout != time.Second || ci.MaxSteps != 1000 || ci.GroupNumberFromName("x") != 1 {
+		t.Error("WithOptions lost the settings")
+	}
+	if _, err := MustCompile(`[(]`, ECMAScript).WithOptions(ECMAScript | UnicodeSets); err == nil {
+		t.Error("no error for a pattern the new options don't parse")
+	}
+}
+
+func TestGroupSuccess(t *testing.T) {
+	re := MustCompile(`(a)?(b*)c`, 0)
+	m, err := re.FindStringMatch("é c")
+	if err != nil || m == nil {
+		t.Fatalf("expected match, got %v, %v", m, err)
+	}
+	if !m.Success() {
+		t.Error("wanted the match to succeed")
+	}
+	if g := m.GroupByNumber(1); g.Success() || g.String() != "" {
+		t.Errorf("wanted group 1 not to take part, got %q", g.String())
+	}
+	if g := m.GroupByNumber(2); !g.Success() || g.String() != "" {
+		t.Errorf("wanted group 2 to match the empty string, got %v %q", g.Success(), g.String())
+	}
+
+	if want, got := []int{3, 4, -1, -1, 3, 3}, re.FindStringSubmatchIndex("é c"); !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+	if want, got := []int{2, 3, -1, -1, 2, 2}, re.FindStringSubmatchRuneIndex("é c"); !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+	if want, got := [][]int{{0, 2, 0, 1, 1, 1}, {3, 4, -1, -1, 3, 3}}, re.FindAllStringSubmatchIndex("ac c", -1); !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestCaptureHistory(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		group   int
+		want    []CaptureSpan
+	}{
+		{`(\w+,)+`, 0, "é1,ab,ü,", 1, []CaptureSpan{{0, 3, 0, 4}, {3, 3, 4, 3}, {6, 2, 7, 3}}},
+		{`(\w+,)+`, RightToLeft, "é1,ab,ü,", 1, []CaptureSpan{{0, 3, 0, 4}, {3, 3, 4, 3}, {6, 2, 7, 3}}},
+		{`x((a)|b)*`, 0, "xabab", 2, []CaptureSpan{{1, 1, 1, 1}, {3, 1, 3, 1}}},
+		{`x(a)?`, 0, "x", 1, nil},
+		{`x`, 0, "x", 2, nil},
+		{`((é)+)`, 0, "ééé", 1, []CaptureSpan{{0, 3, 0, 6}}},
+	}
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, tt.opt).FindStringMatch(tt.input)
+		if err != nil || m == nil {
+			t.Fatalf("%v: expected match, got %v, %v", tt.pattern, m, err)
+		}
+		if got := m.CaptureHistory(tt.group); !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%v on %q: wanted %v, got %v", tt.pattern, tt.input, tt.want, got)
+		}
+		for _, c := range m.CaptureHistory(tt.group) {
+			if want, got := string([]rune(tt.input)[c.Index:c.Index+c.Length]), tt.input[c.ByteIndex:c.ByteIndex+c.ByteLength]; want != got {
+				t.Errorf("%v on %q: rune offsets give %q, byte offsets %q", tt.pattern, tt.input, want, got)
+			}
+		}
+	}
+
+	// detached, the byte offsets are still into the input
+	re := MustCompile(`(\w)+`, 0)
+	re.DetachMatches = true
+	m, _ := re.FindStringMatch("éé ab")
+	m, _ = re.FindStringMatchStartingAt("éé ab", 4)
+	if want, got := []CaptureSpan{{3, 1, 5, 1}, {4, 1, 6, 1}}, m.CaptureHistory(1); !reflect.DeepEqual(want, got) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestClassLoops(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    string
+	}{
+		{`"[^"]*"`, `x = "a quoted value, long enough" y`, `"a quoted value, long enough"`},
+		{`"[^"]*"`, `"unterminated and long enough`, ``},
+		{`[a-zé ]+x`, "abcdefgh ijklmnoé pqrstuvwx!", "abcdefgh ijklmnoé pqrstuvwx"},
+		{`[a-z]+`, "12abcdefghéijklmnopq", "abcdefgh"},
+		{`[^a-z]+`, "ab12345678é€90cd", "12345678é€90"},
+		{`\s+\w`, "x \t\n\r           y", " \t\n\r           y"},
+		{`\w+\d`, "abcdefghijklmnop12345", "abcdefghijklmnop12345"},
+		{`\w+\d`, "abcdefghijklmnop12345x", "abcdefghijklmnop12345"},
+		{`[a-z]{3,9}\d`, "abcdefghijklmn1", "fghijklmn1"},
+		{`(?i)[a-z]+`, "12ABCdefGHIJ", "ABCdefGHIJ"},
+		{`[a-z]+`, "", ""},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		// a timeout keeps it off the bit-state backtracker
+		re.MatchTimeout = time.Hour
+		m, err := re.FindStringMatch(tt.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := ""
+		if m != nil {
+			got = m.String()
+		}
+		if got != tt.want {
+			t.Errorf("%v on %q: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package regexp2
 import (
 	"bytes"
 	"errors"
+	"io"
+	"unicode"
 
 	"github.com/jviksne/regexp2/syntax"
 )
@@ -18,6 +20,21 @@ const (
 // MatchEvaluator is a function that takes a match and returns a replacement string to be used
 type MatchEvaluator func(Match) string
 
+// MatchEvaluatorErr is a MatchEvaluator that can also return an error.
+// SkipMatch and StopReplacing, or errors wrapping them, steer
+// ReplaceFuncErr; any other error stops it and is returned.
+type MatchEvaluatorErr func(Match) (string, error)
+
+var (
+	// SkipMatch is returned by a MatchEvaluatorErr to leave the match as
+	// it is in the output.
+	SkipMatch = errors.New("skip this match")
+
+	// StopReplacing is returned by a MatchEvaluatorErr to leave the match
+	// and the rest of the input as they are.
+	StopReplacing = errors.New("stop replacing")
+)
+
 // Three very similar algorithms appear below: replace (pattern),
 // replace (evaluator), and split.
 
@@ -28,21 +45,23 @@ type MatchEvaluator func(Match) string
 // with no matches, the input string is returned unchanged.
 // The right-to-left case is split out because StringBuilder
 // doesn't handle right-to-left string building directly very well.
-func replace(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluator, input string, startAt, count int) (string, error) {
+//
+// n is how many matches were replaced, not counting skipped ones.
+func replace(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluatorErr, input string, startAt, count int, opts ...MatchOption) (result string, n int, err error) {
 	if count < -1 {
-		return "", errors.New("Count too small")
+		return "", 0, errors.New("Count too small")
 	}
 	if count == 0 {
-		return "", nil
+		return "", 0, nil
 	}
 
-	m, err := regex.FindStringMatchStartingAt(input, startAt)
+	m, err := regex.findStringMatchStartingAt(input, startAt, opts...)
 
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 	if m == nil {
-		return input, nil
+		return input, 0, nil
 	}
 
 	buf := &bytes.Buffer{}
@@ -51,23 +70,36 @@ func replace(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluator,
 	if !regex.RightToLeft() {
 		prevat := 0
 		for m != nil {
-			if m.Index != prevat {
-				buf.WriteString(string(text[prevat:m.Index]))
+			var repl string
+			if evaluator != nil {
+				repl, err = evaluator(*m)
+				if errors.Is(err, StopReplacing) {
+					break
+				}
+				if err != nil && !errors.Is(err, SkipMatch) {
+					return "", 0, err
+				}
 			}
-			prevat = m.Index + m.Length
-			if evaluator == nil {
-				replacementImpl(data, buf, m)
-			} else {
-				buf.WriteString(evaluator(*m))
+			if !errors.Is(err, SkipMatch) {
+				n++
+				if m.Index != prevat {
+					buf.WriteString(string(text[prevat:m.Index]))
+				}
+				prevat = m.Index + m.Length
+				if evaluator == nil {
+					replacementImpl(data, buf, m)
+				} else {
+					buf.WriteString(repl)
+				}
 			}
 
 			count--
 			if count == 0 {
 				break
 			}
-			m, err = regex.FindNextMatch(m)
+			m, err = regex.findNext(m, opts...)
 			if err != nil {
-				return "", nil
+				return "", 0, err
 			}
 		}
 
@@ -79,23 +111,36 @@ func replace(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluator,
 		var al []string
 
 		for m != nil {
-			if m.Index+m.Length != prevat {
-				al = append(al, string(text[m.Index+m.Length:prevat]))
+			var repl string
+			if evaluator != nil {
+				repl, err = evaluator(*m)
+				if errors.Is(err, StopReplacing) {
+					break
+				}
+				if err != nil && !errors.Is(err, SkipMatch) {
+					return "", 0, err
+				}
 			}
-			prevat = m.Index
-			if evaluator == nil {
-				replacementImplRTL(data, &al, m)
-			} else {
-				al = append(al, evaluator(*m))
+			if !errors.Is(err, SkipMatch) {
+				n++
+				if m.Index+m.Length != prevat {
+					al = append(al, string(text[m.Index+m.Length:prevat]))
+				}
+				prevat = m.Index
+				if evaluator == nil {
+					replacementImplRTL(data, &al, m)
+				} else {
+					al = append(al, repl)
+				}
 			}
 
 			count--
 			if count == 0 {
 				break
 			}
-			m, err = regex.FindNextMatch(m)
+			m, err = regex.findNext(m, opts...)
 			if err != nil {
-				return "", nil
+				return "", 0, err
 			}
 		}
 
@@ -108,40 +153,203 @@ func replace(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluator,
 		}
 	}
 
+	return buf.String(), n, nil
+}
+
+// replaceStreamFlush is how much replaced text is gathered before it's
+// written out
+const replaceStreamFlush = 32 << 10
+
+// replaceStream is replace for text read from src and written to dst as it
+// goes.  It's built on a StreamMatcher, so the text before and after a
+// match isn't there to substitute.
+func replaceStream(regex *Regexp, data *syntax.ReplacerData, evaluator MatchEvaluator, dst io.Writer, src io.Reader) error {
+	if data != nil && usesWholeText(data) {
+		return errors.New("replacement patterns with $`, $' or $_ can't be used on a stream")
+	}
+
+	buf := &bytes.Buffer{}
+	done := 0 // how much of the input has been copied or replaced
+	flush := func() error {
+		if buf.Len() < replaceStreamFlush {
+			return nil
+		}
+		_, err := buf.WriteTo(dst)
+		return err
+	}
+	copyTo := func(text []rune, offset, end int) {
+		for i := done - offset; i < end-offset; i++ {
+			buf.WriteRune(text[i])
+		}
+		if end > done {
+			done = end
+		}
+	}
+
+	sm := NewStreamMatcher(regex, func(m *Match, offset int) error {
+		copyTo(m.text, offset, offset+m.Index)
+		if evaluator == nil {
+			replacementImpl(data, buf, m)
+		} else {
+			buf.WriteString(evaluator(*m))
+		}
+		done = offset + m.Index + m.Length
+		return flush()
+	})
+	sm.drop = func(text []rune, offset int) error {
+		copyTo(text, offset, offset+len(text))
+		return flush()
+	}
+
+	if _, err := io.Copy(sm, src); err != nil {
+		return err
+	}
+	if err := sm.Close(); err != nil {
+		return err
+	}
+	copyTo(sm.buf, sm.offset, sm.offset+len(sm.buf))
+	_, err := buf.WriteTo(dst)
+	return err
+}
+
+// usesWholeText reports whether the substitution pattern has $`, $' or $_,
+// which need the text outside the match
+func usesWholeText(data *syntax.ReplacerData) bool {
+	for _, r := range data.Rules {
+		switch -replaceSpecials - 1 - r {
+		case replaceLeftPortion, replaceRightPortion, replaceWholeString:
+			return true
+		}
+	}
+	return false
+}
+
+// Result returns the replacement pattern expanded for this match, as
+// Replace would substitute it, like .NET's Match.Result.  A detached Match
+// no longer has the text around it for $`, $' and $_.
+func (m *Match) Result(replacement string) (string, error) {
+	data, err := m.regex.replacerData(replacement)
+	if err != nil {
+		return "", err
+	}
+	if m.detached && usesWholeText(data) {
+		return "", errors.New("replacement patterns with $`, $' or $_ can't be used on a detached Match")
+	}
+	buf := &bytes.Buffer{}
+	replacementImpl(data, buf, m)
 	return buf.String(), nil
 }
 
+// replacerCacheSize is how many parsed replacement patterns a Regexp keeps
+const replacerCacheSize = 8
+
+// replacerData returns the parsed replacement pattern, from the Regexp's
+// cache if it was used lately.  The cache is small and the oldest pattern
+// in it makes way for a new one.
+func (re *Regexp) replacerData(replacement string) (*syntax.ReplacerData, error) {
+	re.replacerMu.Lock()
+	for _, data := range re.replacers {
+		if data != nil && data.Rep == replacement {
+			re.replacerMu.Unlock()
+			return data, nil
+		}
+	}
+	re.replacerMu.Unlock()
+
+	data, err := syntax.NewReplacerData(replacement, re.caps, re.capsize, re.capnames, syntax.RegexOptions(re.options))
+	if err != nil {
+		return nil, err
+	}
+
+	re.replacerMu.Lock()
+	re.replacers[re.replacerNext] = data
+	re.replacerNext = (re.replacerNext + 1) % replacerCacheSize
+	re.replacerMu.Unlock()
+	return data, nil
+}
+
 // Given a Match, emits into the StringBuilder the evaluated
 // substitution pattern.
 func replacementImpl(data *syntax.ReplacerData, buf *bytes.Buffer, m *Match) {
+	if len(data.Cases) > 0 {
+		replacementCase(data, buf, m)
+		return
+	}
 	for _, r := range data.Rules {
+		replacementRule(data, r, buf, m)
+	}
+}
 
-		if r >= 0 { // string lookup
-			buf.WriteString(data.Strings[r])
-		} else if r < -replaceSpecials { // group lookup
-			m.groupValueAppendToBuf(-replaceSpecials-1-r, buf)
-		} else {
-			switch -replaceSpecials - 1 - r { // special insertion patterns
-			case replaceLeftPortion:
-				for i := 0; i < m.Index; i++ {
-					buf.WriteRune(m.text[i])
-				}
-			case replaceRightPortion:
-				for i := m.Index + m.Length; i < len(m.text); i++ {
-					buf.WriteRune(m.text[i])
-				}
-			case replaceLastGroup:
-				m.groupValueAppendToBuf(m.GroupCount()-1, buf)
-			case replaceWholeString:
-				for i := 0; i < len(m.text); i++ {
-					buf.WriteRune(m.text[i])
-				}
+// replacementRule emits the text for one rule of the substitution pattern
+func replacementRule(data *syntax.ReplacerData, r int, buf *bytes.Buffer, m *Match) {
+	if r >= 0 { // string lookup
+		buf.WriteString(data.Strings[r])
+	} else if r < -replaceSpecials { // group lookup
+		m.groupValueAppendToBuf(-replaceSpecials-1-r, buf)
+	} else {
+		switch -replaceSpecials - 1 - r { // special insertion patterns
+		case replaceLeftPortion:
+			for i := 0; i < m.Index; i++ {
+				buf.WriteRune(m.text[i])
+			}
+		case replaceRightPortion:
+			for i := m.Index + m.Length; i < len(m.text); i++ {
+				buf.WriteRune(m.text[i])
+			}
+		case replaceLastGroup:
+			m.groupValueAppendToBuf(m.GroupCount()-1, buf)
+		case replaceWholeString:
+			for i := 0; i < len(m.text); i++ {
+				buf.WriteRune(m.text[i])
 			}
 		}
 	}
 }
 
+// replacementCase is replacementImpl with case conversions.  \U and \L
+// last until \E or the other one, and \u and \l change just the next
+// character, so \u\L$1 capitalizes a word.
+func replacementCase(data *syntax.ReplacerData, buf *bytes.Buffer, m *Match) {
+	part := &bytes.Buffer{}
+	var mode, next rune
+	c := 0
+	for i, r := range data.Rules {
+		for ; c < len(data.Cases) && data.Cases[c].Pos == i; c++ {
+			switch op := data.Cases[c].Op; op {
+			case 'U', 'L':
+				mode = op
+			case 'E':
+				mode = 0
+			default:
+				next = op
+			}
+		}
+
+		part.Reset()
+		replacementRule(data, r, part, m)
+		for _, ch := range part.String() {
+			op := mode
+			if next != 0 {
+				op, next = next, 0
+			}
+			switch op {
+			case 'U', 'u':
+				ch = unicode.ToUpper(ch)
+			case 'L', 'l':
+				ch = unicode.ToLower(ch)
+			}
+			buf.WriteRune(ch)
+		}
+	}
+}
+
 func replacementImplRTL(data *syntax.ReplacerData, al *[]string, m *Match) {
+	if len(data.Cases) > 0 {
+		buf := &bytes.Buffer{}
+		replacementCase(data, buf, m)
+		*al = append(*al, buf.String())
+		return
+	}
 	l := *al
 	buf := &bytes.Buffer{}
 
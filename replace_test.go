@@ -1,8 +1,14 @@
 package regexp2
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 )
 
 func TestReplace_Basic(t *testing.T) {
@@ -170,3 +176,240 @@ func TestReplace_NestedGroups(t *testing.T) {
 		t.Fatalf("Wrong result: %s", got)
 	}
 }
+
+func TestReplaceWriter(t *testing.T) {
+	long := strings.Repeat("word 12 ", 10000)
+	tests := []struct {
+		pattern, replacement, input string
+	}{
+		{`test`, "unit", "this is a test, test"},
+		{`(\d+)-(\d+)`, "$2-$1", "1-2 and 33-44, 5-"},
+		{`(?<w>\w+)`, "<${w}>", "héllo wörld"},
+		{`a*`, "-", "baaacaa"},
+		{`(?<=\d)x`, "*", "1x x 2x"},
+		{`\d+`, "#", long},
+		{`x`, "y", ""},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		want, err := re.Replace(tt.input, tt.replacement, -1, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := re.ReplaceWriter(&out, iotest.HalfReader(strings.NewReader(tt.input)), tt.replacement); err != nil {
+			t.Fatalf("%q: %v", tt.pattern, err)
+		}
+		if got := out.String(); got != want {
+			t.Errorf("%q: got %q, want %q", tt.pattern, got, want)
+		}
+
+		out.Reset()
+		err = re.ReplaceFuncWriter(&out, iotest.OneByteReader(strings.NewReader(tt.input)), func(m Match) string {
+			return strings.ToUpper(m.String())
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ = re.ReplaceFunc(tt.input, func(m Match) string { return strings.ToUpper(m.String()) }, -1, -1)
+		if got := out.String(); got != want {
+			t.Errorf("%q func: got %q, want %q", tt.pattern, got, want)
+		}
+	}
+
+	if err := MustCompile(`a`, 0).ReplaceWriter(&bytes.Buffer{}, strings.NewReader("a"), "$`"); err == nil {
+		t.Error("expected an error for $` on a stream")
+	}
+}
+
+func TestReplaceFuncErr(t *testing.T) {
+	upperUnlessSkip := func(m Match) (string, error) {
+		switch m.String() {
+		case "skip":
+			return "", SkipMatch
+		case "stop":
+			return "", StopReplacing
+		case "wskip":
+			return "", fmt.Errorf("wrapped: %w", SkipMatch)
+		case "wstop":
+			return "", fmt.Errorf("wrapped: %w", StopReplacing)
+		case "fail":
+			return "", errors.New("failed")
+		}
+		return strings.ToUpper(m.String()), nil
+	}
+	tests := []struct {
+		input string
+		opt   RegexOptions
+		count int
+		want  string
+	}{
+		{"one skip two", 0, -1, "ONE skip TWO"},
+		{"one stop two skip", 0, -1, "ONE stop two skip"},
+		{"skip skip", 0, -1, "skip skip"},
+		{"skip one two", 0, 2, "skip ONE two"},
+		{"one skip two", RightToLeft, -1, "ONE skip TWO"},
+		{"one stop two", RightToLeft, -1, "one stop TWO"},
+		// wrapped, as errors.Is finds them
+		{"one wskip two wstop three", 0, -1, "ONE wskip TWO wstop three"},
+		{"one wstop two wskip three", RightToLeft, -1, "one wstop TWO wskip THREE"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(`\w+`, tt.opt)
+		got, err := re.ReplaceFuncErr(tt.input, upperUnlessSkip, -1, tt.count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := MustCompile(`\w+`, 0).ReplaceFuncErr("ok fail", upperUnlessSkip, -1, -1); err == nil || err.Error() != "failed" {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestReplaceCount(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		count   int
+		want    string
+		n       int
+	}{
+		{`\d`, 0, "a1b2c3", -1, "a#b#c#", 3},
+		{`\d`, 0, "a1b2c3", 2, "a#b#c3", 2},
+		{`\d`, RightToLeft, "a1b2c3", 2, "a1b#c#", 2},
+		{`\d`, 0, "abc", -1, "abc", 0},
+		{`x*`, 0, "ab", -1, "#a#b#", 3},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		got, n, err := re.ReplaceCount(tt.input, "#", -1, tt.count)
+		if err != nil || got != tt.want || n != tt.n {
+			t.Errorf("%v on %q: wanted %q %v, got %q %v %v", tt.pattern, tt.input, tt.want, tt.n, got, n, err)
+		}
+	}
+
+	// skipped matches aren't counted
+	re := MustCompile(`\w+`, 0)
+	got, n, err := re.ReplaceFuncCount("one skip two stop three", func(m Match) (string, error) {
+		switch m.String() {
+		case "skip":
+			return "", SkipMatch
+		case "stop":
+			return "", StopReplacing
+		}
+		return "#", nil
+	}, -1, -1)
+	if err != nil || got != "# skip # stop three" || n != 2 {
+		t.Errorf("got %q %v %v", got, n, err)
+	}
+
+	if _, _, err := re.ReplaceCount("x", `$5000000000`, -1, -1); err == nil {
+		t.Error("expected an error for a bad replacement")
+	}
+}
+
+func TestReplace_CaseConversion(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		opt         RegexOptions
+		replacement string
+		input       string
+		want        string
+	}{
+		{`(\w+) (\w+)`, CaseConversion, `\U$1\E $2`, "hello world", "HELLO world"},
+		{`(\w+) (\w+)`, CaseConversion, `\u$2 \L$1`, "HELLO world", "World hello"},
+		{`\w+`, CaseConversion, `\u\L$0`, "mIxEd CASE", "Mixed Case"},
+		{`(?<w>\w+)`, CaseConversion, `<\U${w}!\E>`, "ab cd", "<AB!> <CD!>"},
+		{`(\w)(\w*)`, CaseConversion, `\l$1\U$2`, "Über", "üBER"},
+		{`x`, CaseConversion, `\U\\E\x`, "x", `\E\X`},
+		{`(\w+)`, CaseConversion | RightToLeft, `\U$1`, "ab cd", "AB CD"},
+		{`(\w+)`, 0, `\U$1`, "ab", `\Uab`},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		got, err := re.Replace(tt.input, tt.replacement, -1, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%q %q: got %q, want %q", tt.pattern, tt.replacement, got, tt.want)
+		}
+	}
+}
+
+func TestMatch_Result(t *testing.T) {
+	re := MustCompile(`(?<key>\w+)=(\w+)`, 0)
+	m, err := re.FindStringMatch("a b=c d")
+	if err != nil || m == nil {
+		t.Fatalf("no match: %v", err)
+	}
+	tests := []struct {
+		replacement, want string
+	}{
+		{"$1:${key}", "c:b"},
+		{"[$&]", "[b=c]"},
+		{"$`|$'", "a | d"},
+		{"$_", "a b=c d"},
+		{"$+", "b"},
+		{"${nope}", "${nope}"},
+		{"$$1", "$1"},
+	}
+	for _, tt := range tests {
+		got, err := m.Result(tt.replacement)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.replacement, got, tt.want)
+		}
+	}
+
+	m.Detach()
+	if got, err := m.Result("$1:${key}"); err != nil || got != "c:b" {
+		t.Errorf("detached: got %q, %v", got, err)
+	}
+	if _, err := m.Result("$`"); err == nil {
+		t.Error("expected an error for $` on a detached Match")
+	}
+}
+
+func TestReplace_CachedReplacement(t *testing.T) {
+	re := MustCompile(`(\w+)@(\w+)`, 0)
+	first, err := re.replacerData("$2 at $1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := re.replacerData("$2 at $1"); again != first {
+		t.Error("the same replacement pattern was parsed twice")
+	}
+
+	// more patterns than the cache holds still replace correctly
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 3*replacerCacheSize; j++ {
+				n := strconv.Itoa(j)
+				got, err := re.Replace("me@home", "${2}"+n+"$1", -1, -1)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if want := "home" + n + "me"; got != want {
+					t.Errorf("got %q, want %q", got, want)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allocs := testing.AllocsPerRun(100, func() { re.replacerData("$2 at $1") }); allocs != 0 {
+		t.Errorf("a cached replacement pattern took %v allocations", allocs)
+	}
+}
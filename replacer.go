@@ -0,0 +1,68 @@
+package regexp2
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// Replacer is a pattern compiled together with the replacement pattern to
+// substitute for its matches, parsed once, for replacing the same way over
+// and over, as log scrubbing does.  A Replacer is safe for concurrent use
+// by multiple goroutines.
+type Replacer struct {
+	re   *Regexp
+	data *syntax.ReplacerData
+}
+
+// CompileReplacer compiles expr with opt, and template as the replacement
+// pattern for its matches, in the syntax Replace takes.
+func CompileReplacer(expr, template string, opt RegexOptions) (*Replacer, error) {
+	re, err := Compile(expr, opt)
+	if err != nil {
+		return nil, err
+	}
+	data, err := syntax.NewReplacerData(template, re.caps, re.capsize, re.capnames, syntax.RegexOptions(re.options))
+	if err != nil {
+		return nil, fmt.Errorf("replacement %q: %v", template, err)
+	}
+	return &Replacer{re: re, data: data}, nil
+}
+
+// MustCompileReplacer is like CompileReplacer but panics if the pattern or
+// the template can't be parsed.
+func MustCompileReplacer(expr, template string, opt RegexOptions) *Replacer {
+	r, err := CompileReplacer(expr, template, opt)
+	if err != nil {
+		panic(`regexp2: CompileReplacer(` + quote(expr) + `): ` + err.Error())
+	}
+	return r
+}
+
+// Regexp returns the compiled pattern, whose settings such as MatchTimeout
+// the Replacer's searches use.
+func (r *Replacer) Regexp() *Regexp {
+	return r.re
+}
+
+// Replace returns s with every match replaced.
+func (r *Replacer) Replace(s string, opts ...MatchOption) (string, error) {
+	result, _, err := replace(r.re, r.data, nil, s, -1, -1, opts...)
+	return result, err
+}
+
+// ReplaceBytes is like Replace for UTF-8 encoded bytes.
+func (r *Replacer) ReplaceBytes(b []byte, opts ...MatchOption) ([]byte, error) {
+	result, err := r.Replace(string(b), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(result), nil
+}
+
+// WriteReplaced copies the UTF-8 text from src to dst with every match
+// replaced, as ReplaceWriter does, so the template can't use $`, $' or $_.
+func (r *Replacer) WriteReplaced(dst io.Writer, src io.Reader) error {
+	return replaceStream(r.re, r.data, nil, dst, src)
+}
@@ -0,0 +1,53 @@
+package regexp2
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReplacer(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		template string
+		opt      RegexOptions
+		input    string
+		want     string
+	}{
+		{`(?<user>\w+)@(\w+)\.com`, `${user}@***`, 0, "mail bob@example.com or ann@test.com", "mail bob@*** or ann@***"},
+		{`\d{4}`, `####`, 0, "card 1234 5678 x", "card #### #### x"},
+		{`(\w+)=\w+`, `$1`, RightToLeft, "a=b c=d", "a c"},
+		{`password=\S+`, `password=[redacted]`, IgnoreCase, "PASSWORD=hunter2 ok", "password=[redacted] ok"},
+		{`x`, `y`, 0, "none here", "none here"},
+	}
+	for _, tt := range tests {
+		r := MustCompileReplacer(tt.pattern, tt.template, tt.opt)
+		if want, _ := r.Regexp().Replace(tt.input, tt.template, -1, -1); want != tt.want {
+			t.Fatalf("%v: Replace gives %q, not %q", tt.pattern, want, tt.want)
+		}
+		if got, err := r.Replace(tt.input); got != tt.want || err != nil {
+			t.Errorf("%v on %q: wanted %q, got %q %v", tt.pattern, tt.input, tt.want, got, err)
+		}
+		if got, _ := r.ReplaceBytes([]byte(tt.input)); string(got) != tt.want {
+			t.Errorf("%v on %q bytes: wanted %q, got %q", tt.pattern, tt.input, tt.want, got)
+		}
+		if tt.opt&RightToLeft != 0 {
+			continue
+		}
+		var w bytes.Buffer
+		if err := r.WriteReplaced(&w, strings.NewReader(tt.input)); err != nil || w.String() != tt.want {
+			t.Errorf("%v on %q written: wanted %q, got %q %v", tt.pattern, tt.input, tt.want, w.String(), err)
+		}
+	}
+
+	if _, err := CompileReplacer(`(a`, `x`, 0); err == nil {
+		t.Error("expected an error for a bad pattern")
+	}
+	if _, err := CompileReplacer(`a`, `$5000000000`, 0); err == nil {
+		t.Error("expected an error for a bad template")
+	}
+	r := MustCompileReplacer(`b`, "$`", 0)
+	if err := r.WriteReplaced(&bytes.Buffer{}, strings.NewReader("abc")); err == nil {
+		t.Error("expected an error for $` on a stream")
+	}
+}
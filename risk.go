@@ -0,0 +1,64 @@
+package regexp2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// RiskSeverity says how fast the time a search takes can grow with the
+// length of the text because of a Risk.
+type RiskSeverity int
+
+const (
+	// RiskPolynomial is time that grows as a power of the length, such as
+	// quadratic time for \d+\d+ on a long run of digits that fails to match.
+	RiskPolynomial = RiskSeverity(syntax.RiskPolynomial)
+
+	// RiskExponential is time that doubles with each rune, as for (a+)+b
+	// on a run of a's; a few dozen runes are enough to hang a search.
+	RiskExponential = RiskSeverity(syntax.RiskExponential)
+)
+
+func (s RiskSeverity) String() string {
+	return syntax.RiskSeverity(s).String()
+}
+
+// Risk is a part of a pattern that can make searches take time that grows
+// faster than the text, the backtracking trouble known as ReDoS.
+type Risk struct {
+	Severity   RiskSeverity
+	Start, End int    // the rune offsets in the pattern of the part to blame
+	Text       string // the part to blame
+	Message    string // what's wrong with it
+}
+
+func (r Risk) String() string {
+	return fmt.Sprintf("%v backtracking in %v: %v", r.Severity, quote(r.Text), r.Message)
+}
+
+// Risks reports the parts of the pattern that look likely to backtrack
+// catastrophically on text that almost matches, most severe first.  It's
+// meant as a gate for patterns from untrusted sources, to be used with
+// MatchTimeout rather than instead of it; see syntax.RegexTree.Risks for
+// what it looks for and how it can be wrong.
+func (re *Regexp) Risks() []Risk {
+	tree, err := syntax.Parse(re.pattern, syntax.RegexOptions(re.options))
+	if err != nil {
+		return nil
+	}
+	pattern := []rune(re.pattern)
+	var risks []Risk
+	for _, r := range tree.Risks() {
+		risks = append(risks, Risk{
+			Severity: RiskSeverity(r.Severity),
+			Start:    r.Pos,
+			End:      r.End,
+			Text:     string(pattern[r.Pos:r.End]),
+			Message:  r.Message,
+		})
+	}
+	sort.SliceStable(risks, func(i, j int) bool { return risks[i].Severity > risks[j].Severity })
+	return risks
+}
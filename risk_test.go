@@ -0,0 +1,62 @@
+package regexp2
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRisks(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		want    []string // severity and text of each risk
+	}{
+		{`(a+)+b`, 0, []string{"exponential (a+)+"}},
+		{`^(\w+\s?)*$`, 0, []string{"exponential (\\w+\\s?)*"}},
+		{`(.*,)*x`, 0, []string{"exponential (.*,)*"}},
+		{`x((ab)+)+c`, 0, []string{"exponential ((ab)+)+"}},
+		{`(?:a|b+)*c`, 0, []string{"exponential (?:a|b+)*"}},
+		{`(ab|a.)*c`, 0, []string{"exponential (ab|a.)*"}},
+		{`(.*a){5}`, 0, []string{"polynomial (.*a){5}"}},
+		{`^\d+\d+$`, 0, []string{"polynomial \\d+\\d+"}},
+		{`[a-z]+\d*[a-z]*?`, 0, []string{"polynomial [a-z]+\\d*[a-z]*?"}},
+		{`(x+x+)+y`, 0, []string{"exponential (x+x+)+", "polynomial x+x+"}},
+		{`(?x) ( a + ) + b`, 0, []string{"exponential ( a + ) + "}},
+		{`(A+)+b`, IgnoreCase, []string{"exponential (A+)+"}},
+		{`b(a+)+`, RightToLeft, []string{"exponential (a+)+"}},
+
+		// the same text can't be split more than one way
+		{`(a+b)+`, 0, nil},
+		{`([^,]*,)*x`, 0, nil},
+		{`\s*,\s*`, 0, nil},
+		{`(\d+\.)+\d+`, 0, nil},
+		{`(a|b)*c`, 0, nil},
+		{`(ab|ac)*`, 0, nil},
+		{`.*=.*`, 0, nil},
+		{`(?:a+)+`, 0, nil}, // simplified to a+
+
+		// never backtracked into
+		{`(a++)+b`, 0, nil},
+		{`(?>a+)+b`, 0, nil},
+		{`(?>(a+)+)`, 0, []string{"exponential (a+)+"}},
+	}
+	for _, test := range tests {
+		re := MustCompile(test.pattern, test.opt)
+		var got []string
+		for _, r := range re.Risks() {
+			if r.Text != test.pattern[r.Start:r.End] || r.Message == "" {
+				t.Errorf("%v: bad risk %#v", test.pattern, r)
+			}
+			got = append(got, fmt.Sprintf("%v %v", r.Severity, r.Text))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v: got %q, want %q", test.pattern, got, test.want)
+		}
+	}
+
+	r := MustCompile(`(a+)+`, 0).Risks()[0].String()
+	if want := "exponential backtracking in `(a+)+`: nested quantifiers can split the same text between their iterations in many ways"; r != want {
+		t.Errorf("got %q", r)
+	}
+}
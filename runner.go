@@ -2,13 +2,14 @@ package regexp2
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 	"strings"
 	"time"
-	"unicode"
 
 	"github.com/jviksne/regexp2/syntax"
 )
@@ -62,19 +63,122 @@ type runner struct {
 	timeout             time.Duration // timeout in milliseconds (needed for actual)
 	timeoutChecksToSkip int
 	timeoutAt           time.Time
+	hasTimeout          bool            // false when timeout is "forever" and there's no deadline
+	deadline            time.Time       // when the call's time is up, or zero
+	timedOut            bool            // the search ran out of time, for Hooks
+	ctx                 context.Context // cancellation polled along with the timeout
+	ctxDone             <-chan struct{}
+
+	maxSteps int // engine step budget, 0 means unlimited
+	steps    int // engine steps taken so far in this scan
+
+	maxMemory  int  // bytes the stacks may take, 0 means unlimited
+	overMemory bool // a stack needed to grow past maxMemory
+
+	stats *MatchStats // where WithStats wants them, during a search
 
 	operator        syntax.InstOp
 	codepos         int
 	rightToLeft     bool
 	caseInsensitive bool
+
+	// leftmost-longest state: when longest is set, every successful match
+	// is recorded and backtracked into so that all alternatives are explored
+	longest        bool
+	hasBest        bool
+	bestTextpos    int
+	bestBalancing  bool
+	bestMatchcount []int
+	bestMatches    [][]int
+
+	// memoization state: one bit per (memoizable loop, text position)
+	// pair that has already been explored without finding a match
+	useMemo bool
+	memo    []uint64
+
+	dfa *lazyDFA // built on first use for patterns with an NFA
+
+	// subroutine calls in progress, three ints each: return address,
+	// group, and the crawl position when the call was made
+	calls    []int
+	maxDepth int
+
+	tracer Tracer // the Regexp's Tracer, during a search
+
+	// (*MARK) names seen on the current path, two ints each: the name's
+	// string index and the text position.  Each one also has a markCrawl
+	// entry on the crawl stack so it is unwound along with the captures.
+	marks    []int
+	bestMark string
+
+	// the verb that cut the last attempt short (0 if none), and for
+	// (*SKIP) where the next attempt starts
+	cut    int
+	skipTo int
+
+	genInput GeneratedInput // scratch for a Regexp's generated matcher
+
+	// the text of a pattern that's only that, as code.Literal gives it,
+	// which is matched without running the program
+	literal   []rune
+	literalCi bool
+
+	// for Count: only the whole match is kept track of, of a program that
+	// doesn't read its groups
+	readsCaptures bool
+	skipCaptures  bool
+
+	onePassStarts []int // where the groups onePass is in started
+	bits          bitState
+
+	fullMatch bool // only a match of the whole text counts
+	anchored  bool // only a match where the scan starts counts
+
+	// partial matching: noteEnd is set for attempts that can be partial,
+	// sawEnd when the current one ran into the end of the text, and
+	// partialStart is where the first such attempt began, or -1
+	partial      PartialMode
+	noteEnd      bool
+	sawEnd       bool
+	partialStart int
+
+	// with trackEnd, every attempt notes the end of the text, and the
+	// scan sums up the attempts in hitEnd and requireEnd
+	trackEnd   bool
+	hitEnd     bool
+	requireEnd bool
 }
 
+// fullMatchEnd is where a match of the whole text ends up
+func (r *runner) fullMatchEnd() int {
+	if r.code.RightToLeft {
+		return 0
+	}
+	return r.runtextend
+}
+
+// markCrawl is pushed on the crawl stack in place of a group number
+// for each (*MARK)
+const markCrawl = -1
+
 // run searches for matches and can continue from the previous match
 //
 // quick is usually false, but can be true to not return matches, just put it in caches
 // textstart is -1 to start at the "beginning" (depending on Right-To-Left), otherwise an index in input
 // input is the string to search for our regex pattern
-func (re *Regexp) run(quick bool, textstart int, input []rune) (*Match, error) {
+// opts are the call's MatchOptions
+func (re *Regexp) run(quick bool, textstart int, input []rune, opts ...MatchOption) (*Match, error) {
+	return re.runContext(context.Background(), quick, textstart, input, opts...)
+}
+
+// runContext is run, but also gives up with ctx.Err() once ctx is done
+func (re *Regexp) runContext(ctx context.Context, quick bool, textstart int, input []rune, opts ...MatchOption) (*Match, error) {
+	return re.search(ctx, quick, textstart, input, false, re.config(opts))
+}
+
+// search is runContext, and when full is set only accepts a match of the
+// whole input
+func (re *Regexp) search(ctx context.Context, quick bool, textstart int, input []rune, full bool, cfg matchConfig) (*Match, error) {
 
 	// get a cached runner
 	runner := re.getRunner()
@@ -88,7 +192,16 @@ func (re *Regexp) run(quick bool, textstart int, input []rune) (*Match, error) {
 		}
 	}
 
-	return runner.scan(input, textstart, quick, re.MatchTimeout)
+	runner.ctx = ctx
+	runner.ctxDone = ctx.Done()
+	defer func() {
+		// don't keep the context alive from the runner cache
+		runner.ctx = nil
+		runner.ctxDone = nil
+	}()
+
+	runner.fullMatch = full
+	return runner.scan(input, textstart, quick, cfg)
 }
 
 // Scans the string to find the first match. Uses the Match object
@@ -101,10 +214,25 @@ func (re *Regexp) run(quick bool, textstart int, input []rune) (*Match, error) {
 // The optimizer can compute a set of candidate starting characters,
 // and we could use a separate method Skip() that will quickly scan past
 // any characters that we know can't match.
-func (r *runner) scan(rt []rune, textstart int, quick bool, timeout time.Duration) (*Match, error) {
-	r.timeout = timeout
-	r.ignoreTimeout = (time.Duration(math.MaxInt64) == timeout)
-	r.runtextstart = textstart
+func (r *runner) scan(rt []rune, textstart int, quick bool, cfg matchConfig) (*Match, error) {
+	h := r.re.Hooks
+	if h == nil {
+		return r.scanText(rt, textstart, quick, cfg)
+	}
+	h.OnMatchStart(r.re)
+	start := time.Now()
+	r.timedOut = false
+	m, err := r.scanText(rt, textstart, quick, cfg)
+	h.OnMatchEnd(r.re, MatchEnd{Matched: m != nil, Timeout: r.timedOut, Err: err, Elapsed: time.Since(start)})
+	return m, err
+}
+
+// scanText is scan without the Hooks
+func (r *runner) scanText(rt []rune, textstart int, quick bool, cfg matchConfig) (*Match, error) {
+	r.timeout = cfg.timeout
+	r.deadline = cfg.deadline
+	r.hasTimeout = (time.Duration(math.MaxInt64) != cfg.timeout) || !cfg.deadline.IsZero()
+	r.ignoreTimeout = !r.hasTimeout && r.ctxDone == nil
 	r.runtext = rt
 	r.runtextend = len(rt)
 
@@ -114,12 +242,99 @@ func (r *runner) scan(rt []rune, textstart int, quick bool, timeout time.Duratio
 	if r.re.RightToLeft() {
 		bump = -1
 		stoppos = 0
+	} else if cfg.stop > 0 {
+		stoppos = cfg.stop
+	}
+
+	// \G is where the last match ended, which is behind the search after
+	// an empty one, so a \G pattern stops at the first gap
+	r.runtextstart = textstart
+	if cfg.afterEmpty {
+		r.runtextstart -= bump
 	}
 
 	r.runtextpos = textstart
+	r.partialStart = -1
+	r.hitEnd, r.requireEnd = false, false
+	everyPos := r.partial != 0 || r.trackEnd
+	r.longest = r.re.longest && !quick && !r.fullMatch
+	r.maxSteps = cfg.maxSteps
+	r.maxMemory = cfg.maxMemory
+	r.overMemory = false
+	if cfg.stats != nil {
+		r.stats = cfg.stats
+		start := time.Now()
+		defer func() {
+			r.stats.Elapsed += time.Since(start)
+			r.stats = nil
+		}()
+	}
+	r.anchored = cfg.anchored
+	if r.anchored && textstart != r.runtextstart {
+		return nil, nil
+	}
+	r.steps = 0
+	r.maxDepth = r.re.MaxRecursionDepth
+	r.tracer = r.re.Tracer
+	r.useMemo = r.code.MemoLoops != nil && !r.longest
+	if r.useMemo {
+		r.resetMemo()
+	}
 	initted := false
 
-	r.startTimeoutWatch()
+	// the generated matcher has no timeout or step checks
+	useGenerated := r.re.generated != nil && r.ignoreTimeout && r.maxSteps == 0 && !r.longest && !r.fullMatch && !everyPos && !r.re.Debug() && r.tracer == nil && r.stats == nil
+
+	// findFirstChar finds where a literal pattern's text is, and all that's
+	// left is to check it's there
+	useLiteral := r.literal != nil && !r.fullMatch && !everyPos && !r.re.Debug() && r.tracer == nil
+
+	// a short text is run on the capture NFA, which never tries the same
+	// thing twice, but doesn't count steps either
+	useBitState := r.code.CapNFA != nil && !useLiteral && r.ignoreTimeout && r.maxSteps == 0 && !r.longest && !everyPos && !r.re.Debug() && r.tracer == nil && r.stats == nil && r.bitStateFits()
+	if useBitState {
+		r.bitStateReset()
+	}
+
+	if err := r.startTimeoutWatch(); err != nil {
+		return nil, err
+	}
+
+	if r.code.OnePass != nil && textstart == 0 && !useGenerated && !everyPos && !r.re.Debug() && r.tracer == nil {
+		// an anchored pattern the text never leaves two ways through
+		// needs no backtracking
+		r.initMatch()
+		matched, ok, err := r.onePass()
+		if err != nil {
+			return nil, err
+		}
+		if ok && matched {
+			return r.tidyMatch(quick), nil
+		}
+		if ok {
+			return r.noMatch(), nil
+		}
+	}
+
+	if r.code.NFA != nil && !r.re.Debug() && !everyPos && r.tracer == nil && !useLiteral {
+		// a quick linear scan rules out searches that can't match, and
+		// answers the yes/no question outright
+		if r.dfa == nil {
+			r.dfa = newLazyDFA(r.code.NFA)
+		}
+		matched, ok, err := r.dfaSearch(textstart)
+		if err != nil {
+			return nil, err
+		}
+		if ok && !matched {
+			return nil, nil
+		}
+		if ok && quick && !r.fullMatch && !r.anchored {
+			r.initMatch()
+			return r.runmatch, nil
+		}
+	}
+
 	for {
 		if r.re.Debug() {
 			//fmt.Printf("\nSearch content: %v\n", string(r.runtext))
@@ -127,7 +342,10 @@ func (r *runner) scan(rt []rune, textstart int, quick bool, timeout time.Duratio
 			fmt.Printf("Firstchar search starting at %v stopping at %v\n", r.runtextpos, stoppos)
 		}
 
-		if r.findFirstChar() {
+		// a full match is only tried where the text starts, and a partial
+		// one, or one that reads the end, can start where the prefix scan
+		// sees no match
+		if r.fullMatch || r.anchored || everyPos || r.findFirstChar() {
 			if err := r.checkTimeout(); err != nil {
 				return nil, err
 			}
@@ -141,26 +359,87 @@ func (r *runner) scan(rt []rune, textstart int, quick bool, timeout time.Duratio
 				fmt.Printf("Executing engine starting at %v\n\n", r.runtextpos)
 			}
 
+			start := r.runtextpos
+			r.noteEnd = r.trackEnd || r.partial != 0 && (start < r.runtextend || r.runtextend == 0)
+			r.sawEnd = false
+			if useGenerated {
+				if r.runGenerated() {
+					return r.tidyMatch(quick), nil
+				}
+				r.runtextpos = start
+				goto bump
+			}
+			if useLiteral {
+				if r.literalMatch() {
+					return r.tidyMatch(quick), nil
+				}
+				r.runtextpos = start
+				goto bump
+			}
+			if useBitState {
+				if r.bitStateMatch() {
+					return r.tidyMatch(quick), nil
+				}
+				if r.fullMatch {
+					return r.noMatch(), nil
+				}
+				r.runtextpos = start
+				goto bump
+			}
+			if r.tracer != nil {
+				r.tracer.Trace(TraceEvent{Kind: TraceAttempt, Pos: start})
+			}
 			if err := r.execute(); err != nil {
 				return nil, err
 			}
+			r.hitEnd = r.hitEnd || r.sawEnd
 
 			if r.runmatch.matchcount[0] > 0 {
+				if r.tracer != nil {
+					index, length := r.runmatch.matchIndex(0), r.runmatch.matchLength(0)
+					r.tracer.Trace(TraceEvent{Kind: TraceMatch, Pos: r.runtextpos, Start: index, End: index + length})
+				}
 				// We'll return a match even if it touches a previous empty match
 				return r.tidyMatch(quick), nil
 			}
+			if r.tracer != nil {
+				r.tracer.Trace(TraceEvent{Kind: TraceFail, Pos: start})
+			}
 
 			// reset state for another go
 			r.runtrackpos = len(r.runtrack)
 			r.runstackpos = len(r.runstack)
 			r.runcrawlpos = len(r.runcrawl)
+
+			if r.sawEnd && r.partial != 0 && r.partialStart < 0 {
+				r.partialStart = start
+				if r.partial == PartialHard {
+					return r.partialMatch(), nil
+				}
+			}
+
+			if r.fullMatch || r.anchored {
+				return r.noMatch(), nil
+			}
+
+			switch r.cut {
+			case syntax.VerbCommit:
+				return r.noMatch(), nil
+
+			case syntax.VerbSkip:
+				if (bump > 0 && r.skipTo > start) || (bump < 0 && r.skipTo < start) {
+					r.runtextpos = r.skipTo
+					continue
+				}
+			}
+			r.runtextpos = start
 		}
 
 		// failure!
-
-		if r.runtextpos == stoppos {
-			r.tidyMatch(true)
-			return nil, nil
+	bump:
+		// the prefix scans can go past a stop short of the end
+		if r.runtextpos == stoppos || r.anchored || bump > 0 && r.runtextpos > stoppos {
+			return r.noMatch(), nil
 		}
 
 		// Recognize leading []* and various anchors, and bump on failure accordingly
@@ -175,19 +454,64 @@ func (r *runner) scan(rt []rune, textstart int, quick bool, timeout time.Duratio
 func (r *runner) execute() error {
 
 	r.goTo(0)
+	r.hasBest = false
+	r.calls = r.calls[:0]
+	r.marks = r.marks[:0]
+	r.cut = 0
 
 	for {
 
 		if r.re.Debug() {
 			r.dumpState()
 		}
+		if r.tracer != nil {
+			r.traceStep()
+		}
 
 		if err := r.checkTimeout(); err != nil {
 			return err
 		}
 
+		if r.sawEnd && r.partial == PartialHard {
+			// the text ended before a match did, that's the answer
+			return nil
+		}
+
+		if r.maxSteps > 0 {
+			r.steps++
+			if r.steps > r.maxSteps {
+				return &StepLimitError{MaxSteps: r.maxSteps}
+			}
+		}
+
+		if r.overMemory {
+			return &MemoryLimitError{MaxMemory: r.maxMemory}
+		}
+
+		if r.stats != nil {
+			r.stats.Steps++
+			if depth := len(r.runtrack) - r.runtrackpos + len(r.runstack) - r.runstackpos; depth > r.stats.PeakStack {
+				r.stats.PeakStack = depth
+			}
+		}
+
 		switch r.operator {
 		case syntax.Stop:
+			if r.fullMatch && r.runmatch.matchcount[0] > 0 && r.textPos() != r.fullMatchEnd() {
+				// not all of the text, keep looking
+				break
+			}
+			if r.longest {
+				if r.runmatch.matchcount[0] > 0 {
+					// remember this match and backtrack to look for a longer one
+					r.saveLongest()
+					break
+				}
+				// we've run out of alternatives, report the longest one we saw
+				r.restoreLongest()
+			} else {
+				r.runmatch.mark = r.lastMark()
+			}
 			return nil
 
 		case syntax.Nothing:
@@ -215,6 +539,32 @@ func (r *runner) execute() error {
 			r.goTo(r.operand(0))
 			continue
 
+		case syntax.Trie:
+			pos := r.textPos()
+			branch, end := r.code.Tries[r.operand(0)].Match(r.runtext, pos, -1)
+			r.trieEnd(pos, -1, branch)
+			if branch < 0 {
+				break
+			}
+			r.trackPush2(pos, branch)
+			r.textto(end)
+			r.advance(1)
+			continue
+
+		case syntax.Trie | syntax.Back:
+			// on to the next branch that matches here
+			r.trackPopN(2)
+			pos := r.trackPeek()
+			branch, end := r.code.Tries[r.operand(0)].Match(r.runtext, pos, r.trackPeekN(1))
+			r.trieEnd(pos, r.trackPeekN(1), branch)
+			if branch < 0 {
+				break
+			}
+			r.trackPush2(pos, branch)
+			r.textto(end)
+			r.advance(1)
+			continue
+
 		case syntax.Setmark:
 			r.stackPush(r.textPos())
 			r.trackPush()
@@ -259,9 +609,71 @@ func (r *runner) execute() error {
 
 			continue
 
+		case syntax.Call:
+			if len(r.calls)/3 >= r.maxDepth {
+				return &RecursionLimitError{MaxDepth: r.maxDepth}
+			}
+			// return address, group, and where its captures start
+			r.calls = append(r.calls, r.codepos+3, r.operand(1), r.crawlpos())
+			r.trackPush()
+			r.ensureStorage()
+			r.goTo(r.operand(0))
+			continue
+
+		case syntax.Call | syntax.Back:
+			r.calls = r.calls[:len(r.calls)-3]
+			break
+
+		case syntax.Return:
+			// only returns if we got here through a call of this group,
+			// otherwise the group was just matched in place
+			if n := len(r.calls); n > 0 && r.calls[n-2] == r.operand(0) {
+				r.returnFromCall()
+				continue
+			}
+			r.advance(1)
+			continue
+
+		case syntax.Return | syntax.Back:
+			r.redoCall()
+			break
+
+		case syntax.Verb:
+			if r.operand(1) != -1 && r.operand(0) != syntax.VerbSkip {
+				r.marks = append(r.marks, r.operand(1), r.textPos())
+				r.crawl(markCrawl)
+			}
+			r.trackPush1(r.textPos())
+			r.advance(2)
+			continue
+
+		case syntax.Verb | syntax.Back:
+			r.trackPop()
+			kind, pos := r.operand(0), r.trackPeek()
+			if r.operand(1) != -1 {
+				if kind != syntax.VerbSkip {
+					r.uncapture()
+				} else if pos = r.findMark(r.operand(1)); pos < 0 {
+					// (*SKIP:NAME) without a matching mark does nothing
+					break
+				}
+			}
+			if kind != syntax.VerbMark {
+				// backtracking past the verb ends this attempt
+				r.cut, r.skipTo = kind, pos
+				if r.longest {
+					r.restoreLongest()
+				}
+				return nil
+			}
+			break
+
 		case syntax.Capturemark | syntax.Back:
 			r.trackPop()
 			r.stackPush(r.trackPeek())
+			if !r.keepsCapture(r.operand(0)) {
+				break
+			}
 			r.uncapture()
 			if r.operand(0) != -1 && r.operand(1) != -1 {
 				r.uncapture()
@@ -275,6 +687,11 @@ func (r *runner) execute() error {
 			matched := r.textPos() - r.stackPeek()
 
 			if matched != 0 { // Nonempty match -> loop now
+				if r.useMemo && r.memoFailed() {
+					// already been here and it didn't work out
+					r.stackPush(r.stackPeek())
+					break
+				}
 				r.trackPush2(r.stackPeek(), r.textPos()) // Save old mark, textpos
 				r.stackPush(r.textPos())                 // Make new mark
 				r.goTo(r.operand(0))                     // Loop
@@ -517,6 +934,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.Eol:
+			r.assertAtEnd()
 			if r.rightchars() > 0 && r.charAt(r.textPos()) != '\n' {
 				break
 			}
@@ -524,6 +942,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.Boundary:
+			r.assertAtEnd()
 			if !r.isBoundary(r.textPos(), 0, r.runtextend) {
 				break
 			}
@@ -531,6 +950,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.Nonboundary:
+			r.assertAtEnd()
 			if r.isBoundary(r.textPos(), 0, r.runtextend) {
 				break
 			}
@@ -538,6 +958,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.ECMABoundary:
+			r.assertAtEnd()
 			if !r.isECMABoundary(r.textPos(), 0, r.runtextend) {
 				break
 			}
@@ -545,12 +966,41 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.NonECMABoundary:
+			r.assertAtEnd()
 			if r.isECMABoundary(r.textPos(), 0, r.runtextend) {
 				break
 			}
 			r.advance(0)
 			continue
 
+		case syntax.WordSegBoundary, syntax.NonWordSegBoundary:
+			if syntax.IsWordSegBoundary(r.runtext[:r.runtextend], r.textPos()) != (r.operator == syntax.WordSegBoundary) {
+				break
+			}
+			r.advance(0)
+			continue
+
+		case syntax.GraphemeBoundary, syntax.NonGraphemeBoundary:
+			if syntax.IsGraphemeBoundary(r.runtext[:r.runtextend], r.textPos()) != (r.operator == syntax.GraphemeBoundary) {
+				break
+			}
+			r.advance(0)
+			continue
+
+		case syntax.Grapheme:
+			// a whole cluster or nothing, there's no backtracking into it
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
+			if !r.rightToLeft {
+				r.textto(syntax.NextGraphemeBoundary(r.runtext[:r.runtextend], r.textPos()))
+			} else {
+				r.textto(syntax.PrevGraphemeBoundary(r.runtext, r.textPos()))
+			}
+			r.advance(0)
+			continue
+
 		case syntax.Beginning:
 			if r.leftchars() > 0 {
 				break
@@ -566,6 +1016,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.EndZ:
+			r.assertEndZ()
 			if r.rightchars() > 1 || r.rightchars() == 1 && r.charAt(r.textPos()) != '\n' {
 				break
 			}
@@ -573,6 +1024,7 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.End:
+			r.assertAtEnd()
 			if r.rightchars() > 0 {
 				break
 			}
@@ -580,7 +1032,11 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.One:
-			if r.forwardchars() < 1 || r.forwardcharnext() != rune(r.operand(0)) {
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
+			if r.forwardcharnext() != rune(r.operand(0)) {
 				break
 			}
 
@@ -588,7 +1044,11 @@ func (r *runner) execute() error {
 			continue
 
 		case syntax.Notone:
-			if r.forwardchars() < 1 || r.forwardcharnext() == rune(r.operand(0)) {
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
+			if r.forwardcharnext() == rune(r.operand(0)) {
 				break
 			}
 
@@ -597,7 +1057,11 @@ func (r *runner) execute() error {
 
 		case syntax.Set:
 
-			if r.forwardchars() < 1 || !r.code.Sets[r.operand(0)].CharIn(r.forwardcharnext()) {
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
+			if !r.code.Sets[r.operand(0)].CharIn(r.forwardcharnext()) {
 				break
 			}
 
@@ -632,13 +1096,13 @@ func (r *runner) execute() error {
 		case syntax.Onerep:
 
 			c := r.operand(1)
+			ch := rune(r.operand(0))
 
 			if r.forwardchars() < c {
+				r.endIf(func(x rune) bool { return x == ch })
 				break
 			}
 
-			ch := rune(r.operand(0))
-
 			for c > 0 {
 				if r.forwardcharnext() != ch {
 					goto BreakBackward
@@ -652,11 +1116,12 @@ func (r *runner) execute() error {
 		case syntax.Notonerep:
 
 			c := r.operand(1)
+			ch := rune(r.operand(0))
 
 			if r.forwardchars() < c {
+				r.endIf(func(x rune) bool { return x != ch })
 				break
 			}
-			ch := rune(r.operand(0))
 
 			for c > 0 {
 				if r.forwardcharnext() == ch {
@@ -671,13 +1136,13 @@ func (r *runner) execute() error {
 		case syntax.Setrep:
 
 			c := r.operand(1)
+			set := r.code.Sets[r.operand(0)]
 
 			if r.forwardchars() < c {
+				r.endIf(set.CharIn)
 				break
 			}
 
-			set := r.code.Sets[r.operand(0)]
-
 			for c > 0 {
 				if !set.CharIn(r.forwardcharnext()) {
 					goto BreakBackward
@@ -706,6 +1171,11 @@ func (r *runner) execute() error {
 				}
 			}
 
+			if i == 0 && c < r.operand(1) {
+				// it stopped only because the text did
+				r.endIf(nil)
+			}
+
 			if c > i {
 				r.trackPush2(c-i-1, r.textPos()-r.bump())
 			}
@@ -724,13 +1194,29 @@ func (r *runner) execute() error {
 			ch := rune(r.operand(0))
 			i := c
 
-			for ; i > 0; i-- {
-				if r.forwardcharnext() == ch {
-					r.backwardnext()
-					break
+			if !r.rightToLeft && !r.caseInsensitive {
+				// [^"]* and the like, with no call per rune
+				text := r.runtext[r.runtextpos : r.runtextpos+c]
+				n := 0
+				for n < len(text) && text[n] != ch {
+					n++
+				}
+				r.runtextpos += n
+				i -= n
+			} else {
+				for ; i > 0; i-- {
+					if r.forwardcharnext() == ch {
+						r.backwardnext()
+						break
+					}
 				}
 			}
 
+			if i == 0 && c < r.operand(1) {
+				// it stopped only because the text did
+				r.endIf(nil)
+			}
+
 			if c > i {
 				r.trackPush2(c-i-1, r.textPos()-r.bump())
 			}
@@ -749,13 +1235,24 @@ func (r *runner) execute() error {
 			set := r.code.Sets[r.operand(0)]
 			i := c
 
-			for ; i > 0; i-- {
-				if !set.CharIn(r.forwardcharnext()) {
-					r.backwardnext()
-					break
+			if !r.rightToLeft && !r.caseInsensitive {
+				n := r.setSpan(r.operand(0), c)
+				r.runtextpos += n
+				i -= n
+			} else {
+				for ; i > 0; i-- {
+					if !set.CharIn(r.forwardcharnext()) {
+						r.backwardnext()
+						break
+					}
 				}
 			}
 
+			if i == 0 && c < r.operand(1) {
+				// it stopped only because the text did
+				r.endIf(nil)
+			}
+
 			if c > i {
 				r.trackPush2(c-i-1, r.textPos()-r.bump())
 			}
@@ -799,6 +1296,10 @@ func (r *runner) execute() error {
 
 			if c > r.forwardchars() {
 				c = r.forwardchars()
+				if r.noteEnd && !r.rightToLeft {
+					// one more try, which finds the end of the text
+					c++
+				}
 			}
 
 			if c > 0 {
@@ -814,6 +1315,10 @@ func (r *runner) execute() error {
 
 			if c > r.forwardchars() {
 				c = r.forwardchars()
+				if r.noteEnd && !r.rightToLeft {
+					// one more try, which finds the end of the text
+					c++
+				}
 			}
 
 			if c > 0 {
@@ -829,6 +1334,10 @@ func (r *runner) execute() error {
 			pos := r.trackPeekN(1)
 			r.textto(pos)
 
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
 			if r.forwardcharnext() != rune(r.operand(0)) {
 				break
 			}
@@ -848,6 +1357,10 @@ func (r *runner) execute() error {
 			pos := r.trackPeekN(1)
 			r.textto(pos)
 
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
 			if r.forwardcharnext() == rune(r.operand(0)) {
 				break
 			}
@@ -867,6 +1380,10 @@ func (r *runner) execute() error {
 			pos := r.trackPeekN(1)
 			r.textto(pos)
 
+			if r.forwardchars() < 1 {
+				r.endIf(nil)
+				break
+			}
 			if !r.code.Sets[r.operand(0)].CharIn(r.forwardcharnext()) {
 				break
 			}
@@ -893,15 +1410,41 @@ func (r *runner) execute() error {
 }
 
 // increase the size of stack and track storage
+//
+// Over the memory budget they're left as they are: there's still room for
+// the steps up to the next check, which stops the search.
 func (r *runner) ensureStorage() {
-	if r.runstackpos < r.runtrackcount*4 {
+	if r.runstackpos < r.runtrackcount*4 && r.canGrow(len(r.runstack)) {
 		doubleIntSlice(&r.runstack, &r.runstackpos)
 	}
-	if r.runtrackpos < r.runtrackcount*4 {
+	if r.runtrackpos < r.runtrackcount*4 && r.canGrow(len(r.runtrack)) {
 		doubleIntSlice(&r.runtrack, &r.runtrackpos)
 	}
 }
 
+// canGrow reports whether a stack of n ints can double in size without
+// going over maxMemory, noting it in overMemory if not
+func (r *runner) canGrow(n int) bool {
+	if r.maxMemory <= 0 {
+		return true
+	}
+	if (len(r.runstack)+len(r.runtrack)+len(r.runcrawl)+n)*(bits.UintSize/8) <= r.maxMemory {
+		return true
+	}
+	r.overMemory = true
+	return false
+}
+
+// MemoryLimitError is returned by the matching methods when a search needs
+// more memory for backtracking than Regexp.MaxMemory allows.
+type MemoryLimitError struct {
+	MaxMemory int // the budget that was exceeded, in bytes
+}
+
+func (e *MemoryLimitError) Error() string {
+	return fmt.Sprintf("match exceeded memory limit of %v bytes", e.MaxMemory)
+}
+
 func doubleIntSlice(s *[]int, pos *int) {
 	oldLen := len(*s)
 	newS := make([]int, oldLen*2)
@@ -914,6 +1457,8 @@ func doubleIntSlice(s *[]int, pos *int) {
 // Save a number on the longjump unrolling stack
 func (r *runner) crawl(i int) {
 	if r.runcrawlpos == 0 {
+		// this one can't wait, but the search stops at the next step
+		r.canGrow(len(r.runcrawl))
 		doubleIntSlice(&r.runcrawl, &r.runcrawlpos)
 	}
 	r.runcrawlpos--
@@ -1028,6 +1573,17 @@ func (r *runner) backtrack() {
 		}
 	}
 
+	if r.tracer != nil {
+		at := newpos
+		if at < 0 {
+			at = -at
+		}
+		r.tracer.Trace(TraceEvent{Kind: TraceBacktrack, Pos: r.runtextpos, Instruction: at})
+	}
+	if r.stats != nil {
+		r.stats.Backtracks++
+	}
+
 	if newpos < 0 {
 		newpos = -newpos
 		r.setOperator(r.code.Codes[newpos] | syntax.Back2)
@@ -1143,17 +1699,70 @@ func (r *runner) forwardcharnext() rune {
 	}
 
 	if r.caseInsensitive {
-		return unicode.ToLower(ch)
+		return r.fold(ch)
 	}
 	return ch
 }
 
+// fold case folds ch the way IgnoreCase compares it
+func (r *runner) fold(ch rune) rune {
+	if r.code.Culture != nil {
+		return syntax.CaseFoldCulture(ch, r.code.Culture)
+	}
+	return syntax.CaseFold(ch)
+}
+
+// literalMatch matches the text of a literal pattern at runtextpos, as
+// running its program would
+func (r *runner) literalMatch() bool {
+	start := r.runtextpos
+	r.rightToLeft, r.caseInsensitive = r.code.RightToLeft, r.literalCi
+	if !r.runematch(r.literal) {
+		return false
+	}
+	r.capture(0, start, r.runtextpos)
+	return true
+}
+
+// setSpan is how many of the next n runes, left to right, are in set
+// number set.  ASCII is looked up in the set's table eight runes at a time,
+// and only the rest goes to CharIn.
+func (r *runner) setSpan(set, n int) int {
+	text := r.runtext[r.runtextpos : r.runtextpos+n]
+	ascii := &r.code.SetsASCII[set]
+	i := 0
+	for i < len(text) {
+		if len(text)-i >= 8 {
+			var in uint8
+			for j, ch := range text[i : i+8] {
+				if ascii.Has(ch) {
+					in |= 1 << j
+				}
+			}
+			if in == 0xff {
+				i += 8
+				continue
+			}
+			i += bits.TrailingZeros8(^in)
+		}
+		if ch := text[i]; !ascii.Has(ch) && (ch < 0x80 || !r.code.Sets[set].CharIn(ch)) {
+			break
+		}
+		i++
+	}
+	return i
+}
+
 func (r *runner) runematch(str []rune) bool {
 	var pos int
 
 	c := len(str)
 	if !r.rightToLeft {
 		if r.runtextend-r.runtextpos < c {
+			if r.noteEnd {
+				i := 0
+				r.endIf(func(ch rune) bool { i++; return ch == str[i-1] })
+			}
 			return false
 		}
 
@@ -1178,7 +1787,7 @@ func (r *runner) runematch(str []rune) bool {
 		for c != 0 {
 			c--
 			pos--
-			if str[c] != unicode.ToLower(r.runtext[pos]) {
+			if str[c] != r.fold(r.runtext[pos]) {
 				return false
 			}
 		}
@@ -1198,6 +1807,10 @@ func (r *runner) refmatch(index, len int) bool {
 
 	if !r.rightToLeft {
 		if r.runtextend-r.runtextpos < len {
+			if r.noteEnd {
+				i := index
+				r.endIf(func(ch rune) bool { i++; return ch == r.foldIf(r.runtext[i-1]) })
+			}
 			return false
 		}
 
@@ -1229,7 +1842,7 @@ func (r *runner) refmatch(index, len int) bool {
 			cmpos--
 			pos--
 
-			if unicode.ToLower(r.runtext[cmpos]) != unicode.ToLower(r.runtext[pos]) {
+			if r.fold(r.runtext[cmpos]) != r.fold(r.runtext[pos]) {
 				return false
 			}
 		}
@@ -1300,6 +1913,15 @@ func (r *runner) findFirstChar() bool {
 			return false
 		}
 
+		return true
+	} else if r.code.LeadingTrie != nil {
+		// every match starts with one of the trie's literals
+		pos := r.code.LeadingTrie.Next(r.runtext, r.runtextpos)
+		if pos == -1 {
+			r.runtextpos = r.runtextend
+			return false
+		}
+		r.runtextpos = pos
 		return true
 	} else if r.code.FcPrefix == nil {
 		return true
@@ -1335,7 +1957,10 @@ func (r *runner) initMatch() {
 	// Use a hashtable'ed Match object if the capture numbers are sparse
 
 	if r.runmatch == nil {
-		if r.re.caps != nil {
+		if m, ok := r.re.matchPool.Get().(*Match); ok {
+			r.runmatch = m
+			m.reset(r.runtext, r.runtextstart)
+		} else if r.re.caps != nil {
 			r.runmatch = newMatchSparse(r.re, r.re.caps, r.re.capsize, r.runtext, r.runtextstart)
 		} else {
 			r.runmatch = newMatch(r.re, r.re.capsize, r.runtext, r.runtextstart)
@@ -1360,6 +1985,7 @@ func (r *runner) initMatch() {
 
 	tracksize := r.runtrackcount * 8
 	stacksize := r.runtrackcount * 8
+	crawlsize := 32
 
 	if tracksize < 32 {
 		tracksize = 32
@@ -1367,6 +1993,18 @@ func (r *runner) initMatch() {
 	if stacksize < 16 {
 		stacksize = 16
 	}
+	if n := r.re.StackSize; n > 0 {
+		// no smaller than ensureStorage keeps them, so there's room to get
+		// as far as its first check
+		least := r.runtrackcount * 4
+		if least < 8 {
+			least = 8
+		}
+		if n < least {
+			n = least
+		}
+		tracksize, stacksize, crawlsize = n, n, n
+	}
 
 	r.runtrack = make([]int, tracksize)
 	r.runtrackpos = tracksize
@@ -1374,8 +2012,8 @@ func (r *runner) initMatch() {
 	r.runstack = make([]int, stacksize)
 	r.runstackpos = stacksize
 
-	r.runcrawl = make([]int, 32)
-	r.runcrawlpos = 32
+	r.runcrawl = make([]int, crawlsize)
+	r.runcrawlpos = crawlsize
 }
 
 func (r *runner) tidyMatch(quick bool) *Match {
@@ -1403,10 +2041,22 @@ func (r *runner) capture(capnum, start, end int) {
 		start = T
 	}
 
+	if !r.keepsCapture(capnum) {
+		return
+	}
+	if r.tracer != nil {
+		r.traceCapture(capnum, start, end)
+	}
 	r.crawl(capnum)
 	r.runmatch.addMatch(capnum, start, end-start)
 }
 
+// keepsCapture reports whether what group capnum captures is kept track
+// of, which for Count is only the whole match's
+func (r *runner) keepsCapture(capnum int) bool {
+	return !r.skipCaptures || capnum == 0 || r.tracer != nil
+}
+
 // transferCapture captures a subexpression. Note that the
 // capnum used here has already been mapped to a non-sparse
 // index (by the code generator RegexWriter).
@@ -1444,33 +2094,153 @@ func (r *runner) transferCapture(capnum, uncapnum, start, end int) {
 	r.runmatch.balanceMatch(uncapnum)
 
 	if capnum != -1 {
+		if r.tracer != nil {
+			r.traceCapture(capnum, start, end)
+		}
 		r.crawl(capnum)
 		r.runmatch.addMatch(capnum, start, end-start)
 	}
 }
 
+// saveLongest snapshots the current match if it is longer than the
+// best one recorded so far during this execute.  Ties keep the earlier
+// match so that the normal backtracking preference breaks them.
+func (r *runner) saveLongest() {
+	m := r.runmatch
+	if r.hasBest && m.matchLength(0) <= r.bestLength() {
+		return
+	}
+
+	if len(r.bestMatches) != len(m.matches) {
+		r.bestMatches = make([][]int, len(m.matches))
+		r.bestMatchcount = make([]int, len(m.matchcount))
+	}
+	for c := range m.matches {
+		n := m.matchcount[c] * 2
+		r.bestMatches[c] = append(r.bestMatches[c][:0], m.matches[c][:n]...)
+		r.bestMatchcount[c] = m.matchcount[c]
+	}
+
+	r.bestTextpos = r.runtextpos
+	r.bestBalancing = m.balancing
+	r.bestMark = r.lastMark()
+	r.hasBest = true
+}
+
+// bestLength returns the length of the saved group 0 capture
+func (r *runner) bestLength() int {
+	i := r.bestMatches[0][r.bestMatchcount[0]*2-1]
+	if i >= 0 {
+		return i
+	}
+	return r.bestMatches[0][-3-i]
+}
+
+// restoreLongest copies the snapshot taken by saveLongest back into the match
+func (r *runner) restoreLongest() {
+	if !r.hasBest {
+		return
+	}
+
+	m := r.runmatch
+	for c := range r.bestMatches {
+		n := r.bestMatchcount[c] * 2
+		if len(m.matches[c]) < n {
+			m.matches[c] = make([]int, n)
+		}
+		copy(m.matches[c], r.bestMatches[c][:n])
+		m.matchcount[c] = r.bestMatchcount[c]
+	}
+
+	m.balancing = r.bestBalancing
+	m.mark = r.bestMark
+	r.runtextpos = r.bestTextpos
+	r.hasBest = false
+}
+
+// resetMemo clears the memo table and sizes it for the current text
+func (r *runner) resetMemo() {
+	n := (len(r.code.MemoLoops)*(r.runtextend+1) + 63) / 64
+	if cap(r.memo) < n {
+		r.memo = make([]uint64, n)
+		return
+	}
+	r.memo = r.memo[:n]
+	for i := range r.memo {
+		r.memo[i] = 0
+	}
+}
+
+// memoFailed reports whether the loop at the current code position has
+// already been entered at the current text position, and records it if not.
+// Memoized loops can never be reached twice from the same position while the
+// first attempt is still being explored, so a repeat visit means the earlier
+// one backtracked out without a match and this one will too.
+func (r *runner) memoFailed() bool {
+	for i, pos := range r.code.MemoLoops {
+		if pos != r.codepos {
+			continue
+		}
+		bit := i*(r.runtextend+1) + r.textPos()
+		if r.memo[bit/64]&(1<<uint(bit%64)) != 0 {
+			return true
+		}
+		r.memo[bit/64] |= 1 << uint(bit%64)
+		return false
+	}
+	return false
+}
+
 // revert the last capture
 func (r *runner) uncapture() {
 	capnum := r.popcrawl()
+	if capnum == markCrawl {
+		r.marks = r.marks[:len(r.marks)-2]
+		return
+	}
 	r.runmatch.removeMatch(capnum)
 }
 
+// lastMark returns the name of the latest (*MARK) on the current path
+func (r *runner) lastMark() string {
+	if len(r.marks) == 0 {
+		return ""
+	}
+	return string(r.code.Strings[r.marks[len(r.marks)-2]])
+}
+
+// findMark returns the text position of the latest (*MARK) named by
+// the string at index name, or -1 if there isn't one
+func (r *runner) findMark(name int) int {
+	for i := len(r.marks) - 2; i >= 0; i -= 2 {
+		if r.marks[i] == name {
+			return r.marks[i+1]
+		}
+	}
+	return -1
+}
+
 //debug
 
 func (r *runner) dumpState() {
-	back := ""
-	if r.operator&syntax.Back != 0 {
-		back = " Back"
-	}
-	if r.operator&syntax.Back2 != 0 {
-		back += " Back2"
-	}
 	fmt.Printf("Text:  %v\nTrack: %v\nStack: %v\n       %s%s\n\n",
 		r.textposDescription(),
 		r.stackDescription(r.runtrack, r.runtrackpos),
 		r.stackDescription(r.runstack, r.runstackpos),
 		r.code.OpcodeDescription(r.codepos),
-		back)
+		backDescription(r.operator))
+}
+
+// backDescription tells whether the operator is being backtracked into
+func backDescription(op syntax.InstOp) string {
+	back := ""
+	if op&syntax.Back != 0 {
+		back = " Back"
+	}
+	if op&syntax.Back2 != 0 {
+		back += " Back2"
+	}
+	return back
 }
 
 func (r *runner) stackDescription(a []int, index int) string {
@@ -1545,13 +2315,29 @@ func (r *runner) isECMABoundary(index, startpos, endpos int) bool {
 // of TimeoutCheckFrequency tended to slow down the execution.
 const timeoutCheckFrequency int = 1000
 
-func (r *runner) startTimeoutWatch() {
+// startTimeoutWatch fails straight away if the deadline has passed, since
+// a call that finds many matches might otherwise make a little progress
+// past it for each one
+func (r *runner) startTimeoutWatch() error {
 	if r.ignoreTimeout {
-		return
+		return nil
 	}
 
 	r.timeoutChecksToSkip = timeoutCheckFrequency
-	r.timeoutAt = time.Now().Add(r.timeout)
+	if !r.hasTimeout {
+		return nil
+	}
+	now := time.Now()
+	r.timeoutAt = r.deadline
+	if r.timeout != time.Duration(math.MaxInt64) {
+		if at := now.Add(r.timeout); r.deadline.IsZero() || at.Before(r.deadline) {
+			r.timeoutAt = at
+		}
+	}
+	if !r.deadline.IsZero() && !now.Before(r.deadline) {
+		return r.timeoutErr()
+	}
+	return nil
 }
 
 func (r *runner) checkTimeout() error {
@@ -1568,6 +2354,18 @@ func (r *runner) checkTimeout() error {
 }
 
 func (r *runner) doCheckTimeout() error {
+	if r.ctxDone != nil {
+		select {
+		case <-r.ctxDone:
+			return r.ctx.Err()
+		default:
+		}
+	}
+
+	if !r.hasTimeout {
+		return nil
+	}
+
 	current := time.Now()
 
 	if current.Before(r.timeoutAt) {
@@ -1584,9 +2382,92 @@ func (r *runner) doCheckTimeout() error {
 		//Debug.WriteLine("About to throw RegexMatchTimeoutException.")
 	}
 
+	return r.timeoutErr()
+}
+
+func (r *runner) timeoutErr() error {
+	r.timedOut = true
+	if r.timeoutAt.Equal(r.deadline) {
+		return fmt.Errorf("match timeout at deadline on input `%v`", string(r.runtext))
+	}
 	return fmt.Errorf("match timeout after %v on input `%v`", r.timeout, string(r.runtext))
 }
 
+// StepLimitError is returned by the matching methods when a match attempt
+// executes more than Regexp.MaxSteps engine steps.
+type StepLimitError struct {
+	MaxSteps int // the budget that was exceeded
+}
+
+func (e *StepLimitError) Error() string {
+	return fmt.Sprintf("match exceeded step limit of %v", e.MaxSteps)
+}
+
+// returnFromCall pops the innermost call and jumps back to the caller.
+// Like PCRE, captures made during the call are undone; they are saved
+// on the backtracking stack so redoCall can put them back.
+func (r *runner) returnFromCall() {
+	n := len(r.calls)
+	ret, group, crawl := r.calls[n-3], r.calls[n-2], r.calls[n-1]
+	r.calls = r.calls[:n-3]
+
+	k := r.crawlpos() - crawl
+	for r.runtrackpos < 3*k+5 {
+		r.canGrow(len(r.runtrack))
+		doubleIntSlice(&r.runtrack, &r.runtrackpos)
+	}
+	for i := 0; i < k; i++ {
+		capnum := r.popcrawl()
+		r.runtrackpos -= 3
+		r.runtrack[r.runtrackpos] = capnum
+		if capnum == markCrawl {
+			n := len(r.marks)
+			r.runtrack[r.runtrackpos+2] = r.marks[n-2]
+			r.runtrack[r.runtrackpos+1] = r.marks[n-1]
+			r.marks = r.marks[:n-2]
+			continue
+		}
+		c := r.runmatch.matchcount[capnum] - 1
+		r.runtrack[r.runtrackpos+2] = r.runmatch.matches[capnum][c*2]
+		r.runtrack[r.runtrackpos+1] = r.runmatch.matches[capnum][c*2+1]
+		r.runmatch.removeMatch(capnum)
+	}
+	r.runtrackpos--
+	r.runtrack[r.runtrackpos] = ret
+	r.trackPush3(crawl, group, k)
+	r.ensureStorage()
+	r.goTo(ret)
+}
+
+// redoCall undoes returnFromCall when backtracking into the call
+func (r *runner) redoCall() {
+	r.trackPopN(3)
+	k, group, crawl := r.trackPeekN(2), r.trackPeekN(1), r.trackPeek()
+	r.trackPop()
+	ret := r.trackPeek()
+	for i := 0; i < k; i++ {
+		r.trackPopN(3)
+		capnum := r.trackPeekN(2)
+		r.crawl(capnum)
+		if capnum == markCrawl {
+			r.marks = append(r.marks, r.trackPeek(), r.trackPeekN(1))
+			continue
+		}
+		r.runmatch.addMatch(capnum, r.trackPeek(), r.trackPeekN(1))
+	}
+	r.calls = append(r.calls, ret, group, crawl)
+}
+
+// RecursionLimitError is returned by the matching methods when subroutine
+// calls such as (?R) or (?1) nest deeper than Regexp.MaxRecursionDepth.
+type RecursionLimitError struct {
+	MaxDepth int // the depth that was exceeded
+}
+
+func (e *RecursionLimitError) Error() string {
+	return fmt.Sprintf("match exceeded recursion depth of %v", e.MaxDepth)
+}
+
 func (r *runner) initTrackCount() {
 	r.runtrackcount = r.code.TrackCount
 }
@@ -1595,27 +2476,21 @@ func (r *runner) initTrackCount() {
 // It uses the re's runner cache if possible, to avoid
 // unnecessary allocation.
 func (re *Regexp) getRunner() *runner {
-	re.muRun.Lock()
-	if n := len(re.runner); n > 0 {
-		z := re.runner[n-1]
-		re.runner = re.runner[:n-1]
-		re.muRun.Unlock()
+	if z, ok := re.runners.Get().(*runner); ok {
 		return z
 	}
-	re.muRun.Unlock()
 	z := &runner{
 		re:   re,
 		code: re.code,
 	}
+	z.literal, z.literalCi, _ = re.code.Literal()
+	z.readsCaptures = re.code.ReadsCaptures()
 	return z
 }
 
 // putRunner returns a runner to the re's cache.
-// There is no attempt to limit the size of the cache, so it will
-// grow to the maximum number of simultaneous matches
-// run using re.  (The cache empties when re gets garbage collected.)
+// The cache is a sync.Pool, so idle runners are released by the
+// garbage collector rather than kept for the lifetime of re.
 func (re *Regexp) putRunner(r *runner) {
-	re.muRun.Lock()
-	re.runner = append(re.runner, r)
-	re.muRun.Unlock()
+	re.runners.Put(r)
 }
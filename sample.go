@@ -0,0 +1,183 @@
+package regexp2
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"unicode"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// Sampler makes random strings that a pattern matches, for fuzzing what
+// consumes them or for test fixtures.  It works from the pattern's syntax
+// tree, then checks each string with the pattern itself, and makes
+// another if that fails, as it can where lookarounds, anchors or back
+// references constrain the text.  A Sampler isn't safe for concurrent use.
+type Sampler struct {
+	// MaxRepeat is the most times a quantifier repeats beyond its minimum,
+	// for * and + as well as for counted repeats.  It's 8 by default.
+	MaxRepeat int
+
+	// MaxTries is how many strings Sample makes before it gives up on
+	// finding one the pattern matches.  It's 100 by default.
+	MaxTries int
+
+	re     *Regexp
+	root   *syntax.Node
+	rand   *rand.Rand
+	groups map[int]*syntax.Node          // the groups subroutine calls can run
+	ranges map[*syntax.CharSet][][2]rune // the runes of each class, found when first needed
+
+	captures map[int][]rune
+	depth    int
+}
+
+// the most nested subroutine calls a sample goes through
+const maxSampleDepth = 16
+
+var errSampleFailed = errors.New("regexp2: sample doesn't match")
+
+// Sampler returns a Sampler for re whose random choices come from seed,
+// so that the same seed makes the same strings.
+func (re *Regexp) Sampler(seed int64) (*Sampler, error) {
+	tree, err := syntax.Parse(re.pattern, syntax.RegexOptions(re.options))
+	if err != nil {
+		return nil, err
+	}
+	s := &Sampler{
+		MaxRepeat: 8,
+		MaxTries:  100,
+		re:        re,
+		root:      tree.AST(),
+		rand:      rand.New(rand.NewSource(seed)),
+		groups:    map[int]*syntax.Node{},
+		ranges:    map[*syntax.CharSet][][2]rune{},
+	}
+	s.groups[0] = s.root
+	syntax.Inspect(s.root, func(n *syntax.Node) bool {
+		if n != nil && n.Op == syntax.OpCapture && n.Group > 0 {
+			s.groups[n.Group] = n.Children[0]
+		}
+		return true
+	})
+	return s, nil
+}
+
+// Sample returns a random string that the pattern matches.
+func (s *Sampler) Sample() (string, error) {
+	for try := 0; try < s.MaxTries; try++ {
+		s.captures = map[int][]rune{}
+		s.depth = 0
+		out, err := s.node(s.root, nil)
+		if err != nil {
+			continue
+		}
+		if ok, err := s.re.MatchRunes(out); err != nil {
+			return "", err
+		} else if ok {
+			return string(out), nil
+		}
+	}
+	return "", errors.New("regexp2: no string the pattern matches found in " + strconv.Itoa(s.MaxTries) + " tries")
+}
+
+// node appends a random text for n to out
+func (s *Sampler) node(n *syntax.Node, out []rune) ([]rune, error) {
+	var err error
+	switch n.Op {
+	case syntax.OpNothing:
+		return nil, errSampleFailed
+	case syntax.OpLiteral:
+		for _, r := range n.Runes {
+			out = append(out, s.fold(n, r))
+		}
+	case syntax.OpCharClass:
+		ranges, ok := s.ranges[n.Set]
+		if !ok {
+			ranges = n.Set.RuneRanges()
+			s.ranges[n.Set] = ranges
+		}
+		if len(ranges) == 0 {
+			return nil, errSampleFailed
+		}
+		// a range, then a rune in it, so that [a-z\x{4e00}-\x{9fff}] isn't
+		// nearly all Han
+		r := ranges[s.rand.Intn(len(ranges))]
+		out = append(out, s.fold(n, r[0]+rune(s.rand.Int63n(int64(r[1]-r[0])+1))))
+	case syntax.OpGrapheme:
+		out = append(out, rune('a'+s.rand.Intn(26)))
+	case syntax.OpBackref:
+		captured, ok := s.captures[n.Group]
+		if !ok && n.Flags&syntax.ECMAScript == 0 {
+			return nil, errSampleFailed
+		}
+		out = append(out, captured...)
+	case syntax.OpConcat:
+		for _, child := range n.Children {
+			if out, err = s.node(child, out); err != nil {
+				return nil, err
+			}
+		}
+	case syntax.OpAlternate:
+		return s.node(n.Children[s.rand.Intn(len(n.Children))], out)
+	case syntax.OpRepeat:
+		extra := s.MaxRepeat
+		if n.Max >= 0 && n.Max-n.Min < extra {
+			extra = n.Max - n.Min
+		}
+		times := n.Min + s.rand.Intn(extra+1)
+		for i := 0; i < times; i++ {
+			if out, err = s.node(n.Children[0], out); err != nil {
+				return nil, err
+			}
+		}
+	case syntax.OpCapture:
+		start := len(out)
+		if out, err = s.node(n.Children[0], out); err != nil {
+			return nil, err
+		}
+		if n.Group > 0 {
+			s.captures[n.Group] = append([]rune(nil), out[start:]...)
+		}
+		if n.Uncapture > 0 {
+			delete(s.captures, n.Uncapture)
+		}
+	case syntax.OpAtomic:
+		return s.node(n.Children[0], out)
+	case syntax.OpCondCapture:
+		if _, ok := s.captures[n.Group]; ok {
+			return s.node(n.Children[0], out)
+		} else if len(n.Children) > 1 {
+			return s.node(n.Children[1], out)
+		}
+	case syntax.OpCond:
+		// whether the condition matches depends on what comes after, so
+		// guess, and leave it to the check
+		if branch := 1 + s.rand.Intn(2); branch < len(n.Children) {
+			return s.node(n.Children[branch], out)
+		}
+	case syntax.OpCall:
+		group, ok := s.groups[n.Group]
+		if !ok || s.depth == maxSampleDepth {
+			return nil, errSampleFailed
+		}
+		s.depth++
+		out, err = s.node(group, out)
+		s.depth--
+		return out, err
+	}
+	// anchors, boundaries, lookarounds and verbs add nothing
+	return out, nil
+}
+
+// fold returns r, or another case of it if n ignores case
+func (s *Sampler) fold(n *syntax.Node, r rune) rune {
+	if n.Flags&syntax.IgnoreCase == 0 {
+		return r
+	}
+	for i := s.rand.Intn(3); i > 0; i-- {
+		r = unicode.SimpleFold(r)
+	}
+	return r
+}
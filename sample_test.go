@@ -0,0 +1,71 @@
+package regexp2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSampler(t *testing.T) {
+	patterns := []struct {
+		pattern string
+		opt     RegexOptions
+	}{
+		{`^[a-z]{3,5}@[a-z]+\.(com|org)$`, 0},
+		{`\d{3}-\d{4}`, 0},
+		{`(?i)hello, \w+!`, 0},
+		{`(a|b)c\1`, 0},
+		{`(?<q>["'])[^"']*\k<q>`, 0},
+		{`\b\w+\b(?<!x)`, 0},
+		{`^(?=.*\d)(?=.*[A-Z])[A-Za-z\d]{8,}$`, 0},
+		{`(?<o>\()+(?<-o>\))+(?(o)(?!))`, 0},
+		{`\((?:[^()]|(?R))*\)`, PCRE2},
+		{`[\p{Lu}-[A-Z]]x`, 0},
+		{`abc`, RightToLeft},
+	}
+	for _, p := range patterns {
+		re := MustCompile(p.pattern, p.opt)
+		s, err := re.Sampler(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 20; i++ {
+			sample, err := s.Sample()
+			if err != nil {
+				t.Errorf("%v: %v", p.pattern, err)
+				break
+			}
+			if ok, _ := re.MatchString(sample); !ok {
+				t.Errorf("%v: sample %q doesn't match", p.pattern, sample)
+			}
+		}
+	}
+
+	// the same seed makes the same strings
+	re := MustCompile(`[a-z]+\d*`, 0)
+	a, _ := re.Sampler(42)
+	b, _ := re.Sampler(42)
+	for i := 0; i < 10; i++ {
+		x, _ := a.Sample()
+		y, _ := b.Sample()
+		if x != y {
+			t.Fatalf("got %q and %q from the same seed", x, y)
+		}
+	}
+
+	s, _ := MustCompile(`a*`, 0).Sampler(1)
+	s.MaxRepeat = 3
+	for i := 0; i < 50; i++ {
+		if sample, _ := s.Sample(); len(sample) > 3 {
+			t.Errorf("got %q with MaxRepeat 3", sample)
+		}
+	}
+	s, _ = MustCompile(`a{1000}`, 0).Sampler(1)
+	if sample, _ := s.Sample(); sample != strings.Repeat("a", 1000) {
+		t.Errorf("got %q", sample)
+	}
+
+	s, _ = MustCompile(`a(?=b)c`, 0).Sampler(1)
+	if _, err := s.Sample(); err == nil {
+		t.Error("no error for a pattern nothing matches")
+	}
+}
@@ -0,0 +1,108 @@
+package regexp2
+
+import (
+	"bufio"
+	"errors"
+	"unicode/utf8"
+)
+
+// SplitFunc returns a bufio.SplitFunc for a Scanner that splits its input
+// at the matches of re, so the tokens are the text between them, the way
+// Split would cut the whole input.  Like bufio.ScanLines, text after the
+// last match is a token but an empty one there isn't.
+//
+// The Scanner asks for more data when a match could still change with
+// it, which is decided with PartialHard partial matching.  Each search
+// sees the unread data as the whole text, so anchors such as ^ and \A and
+// lookbehinds can't see past the start of the current token.  RightToLeft
+// patterns can't be used.
+func (re *Regexp) SplitFunc() bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		text, offs, err := re.splitText(data, atEOF)
+		if err != nil {
+			return 0, nil, err
+		}
+		// an empty match at the start doesn't cut anything off
+		m, more, err := re.splitSearch(text, atEOF, func(m *Match) bool { return m.Index == 0 })
+		if err != nil || more || m != nil && m.Partial() {
+			return 0, nil, err
+		}
+		if m == nil {
+			if atEOF && len(data) > 0 {
+				return len(data), data, nil
+			}
+			return 0, nil, nil
+		}
+		return offs.at(m.Index + m.Length), data[:offs.at(m.Index)], nil
+	}
+}
+
+// TokenSplitFunc returns a bufio.SplitFunc for a Scanner whose tokens are
+// the matches of re, skipping the text between them, as FindAllString
+// would find them in the whole input.  Empty matches aren't tokens.  It
+// decides when to ask for more data in the same way as SplitFunc, and it
+// lets go of text no match can start in, so the Scanner's buffer only has
+// to hold the longest match.
+func (re *Regexp) TokenSplitFunc() bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		text, offs, err := re.splitText(data, atEOF)
+		if err != nil {
+			return 0, nil, err
+		}
+		m, more, err := re.splitSearch(text, atEOF, func(m *Match) bool { return true })
+		if err != nil {
+			return 0, nil, err
+		}
+		if m == nil {
+			return offs.at(len(text)), nil, nil
+		}
+		if more || m.Partial() {
+			return offs.at(m.Index), nil, nil
+		}
+		return offs.at(m.Index + m.Length), data[offs.at(m.Index):offs.at(m.Index+m.Length)], nil
+	}
+}
+
+// splitText decodes data for a split function, leaving off a character
+// the Scanner hasn't read all of yet
+func (re *Regexp) splitText(data []byte, atEOF bool) ([]rune, byteOffsets, error) {
+	if re.RightToLeft() {
+		return nil, nil, errors.New("regexp2: RightToLeft patterns can't split a Scanner's input")
+	}
+	if !atEOF {
+		for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(data[i]) {
+				if !utf8.FullRune(data[i:]) {
+					data = data[:i]
+				}
+				break
+			}
+		}
+	}
+	s := string(data)
+	return getRunes(s), newByteOffsets(s), nil
+}
+
+// splitSearch finds the first match in text that skip doesn't reject,
+// which is only ever asked about empty matches.  more is set if the data
+// isn't at its end and what's found could change with more of it.
+func (re *Regexp) splitSearch(text []rune, atEOF bool, skip func(m *Match) bool) (m *Match, more bool, err error) {
+	mode := PartialHard
+	if atEOF {
+		mode = 0
+	}
+	for start := 0; start <= len(text); start = m.Index + 1 {
+		m, err = re.partialSearch(text, start, mode, re.config(nil))
+		if err != nil || m == nil || m.Partial() {
+			return m, false, err
+		}
+		if m.Length == 0 && m.Index == len(text) && !atEOF {
+			// it could yet be a longer one
+			return m, true, nil
+		}
+		if m.Length > 0 || !skip(m) {
+			return m, false, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,92 @@
+package regexp2
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSplitFunc(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+	}{
+		{`,\s*`, "a, b,c,,  d"},
+		{`\r?\n`, "line one\r\nline two\nlast"},
+		{`\r?\n`, "ends with a newline\n"},
+		{`\d+`, "x12y345z6"},
+		{`-+`, "é--ü---ö-"},
+		{`\s*;\s*`, ""},
+		{`ab*`, "xabbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbby"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		want := re.Split(tt.input, -1)
+		if len(want) > 0 && want[len(want)-1] == "" {
+			// like bufio.ScanLines, nothing after the last separator
+			want = want[:len(want)-1]
+		}
+		if len(want) == 0 {
+			want = nil
+		}
+
+		for _, size := range []int{1, 3, 100} {
+			got := scanAll(t, re.SplitFunc(), tt.input, size)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q %q read %v at a time: got %q, want %q", tt.pattern, tt.input, size, got, want)
+			}
+		}
+	}
+}
+
+func TestTokenSplitFunc(t *testing.T) {
+	tests := []struct {
+		pattern, input string
+	}{
+		{`\d+`, "abc 123 de 4567 f 8"},
+		{`\w+`, "héllo, wörld  again"},
+		{`"[^"]*"`, `say "hello there" and "bye"`},
+		{`a*`, "baaacaa"},
+		{`\d+(?=px)`, "10px 20em 30px"},
+		{`x`, ""},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, 0)
+		var want []string
+		for _, loc := range re.FindAllStringIndex(tt.input, -1) {
+			if loc[0] != loc[1] {
+				want = append(want, tt.input[loc[0]:loc[1]])
+			}
+		}
+
+		for _, size := range []int{1, 3, 100} {
+			got := scanAll(t, re.TokenSplitFunc(), tt.input, size)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q %q read %v at a time: got %q, want %q", tt.pattern, tt.input, size, got, want)
+			}
+		}
+	}
+
+	// text between tokens doesn't have to fit in the buffer
+	sc := bufio.NewScanner(strings.NewReader(strings.Repeat(".", 10000) + "42"))
+	sc.Buffer(make([]byte, 16), 64)
+	sc.Split(MustCompile(`\d+`, 0).TokenSplitFunc())
+	if !sc.Scan() || sc.Text() != "42" {
+		t.Errorf("got %q, %v", sc.Text(), sc.Err())
+	}
+}
+
+func scanAll(t *testing.T, split bufio.SplitFunc, input string, size int) []string {
+	sc := bufio.NewScanner(iotest.HalfReader(strings.NewReader(input)))
+	sc.Buffer(make([]byte, size), 1<<20)
+	sc.Split(split)
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
@@ -0,0 +1,224 @@
+package regexp2
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// RegexpSet matches a list of patterns against a text together and reports
+// which of them match, like Rust's RegexSet.  The patterns that have a
+// syntax.NFA (see the RE2 option) share one automaton and are all checked
+// in a single pass over the text; the others are run one at a time by the
+// backtracker.  A RegexpSet is safe for concurrent use by multiple
+// goroutines.
+type RegexpSet struct {
+	regexps []*Regexp
+
+	// the combined automaton: every NFA's instructions, one after the
+	// other, with owner telling which pattern each instruction came from
+	insts  []syntax.NFAInst
+	owner  []int
+	starts []int // entry point of each pattern in the automaton, by pattern
+
+	linear   []int // the patterns in the automaton
+	fallback []int // the ones the backtracker has to check
+
+	scratch sync.Pool
+}
+
+// CompileSet compiles each of exprs with opt.  The patterns keep their
+// positions in exprs, which is how the results refer to them.
+func CompileSet(exprs []string, opt RegexOptions) (*RegexpSet, error) {
+	s := &RegexpSet{regexps: make([]*Regexp, len(exprs))}
+	for i, expr := range exprs {
+		re, err := Compile(expr, opt)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %v: %v", i, err)
+		}
+		s.regexps[i] = re
+
+		nfa := re.code.NFA
+		if nfa == nil || re.Debug() {
+			s.fallback = append(s.fallback, i)
+			continue
+		}
+		base := len(s.insts)
+		for _, inst := range nfa.Insts {
+			inst.Out += base
+			inst.Out1 += base
+			s.insts = append(s.insts, inst)
+			s.owner = append(s.owner, i)
+		}
+		s.linear = append(s.linear, i)
+		s.starts = append(s.starts, base+nfa.Start)
+	}
+	return s, nil
+}
+
+// MustCompileSet is like CompileSet but panics if any of the expressions
+// cannot be parsed.
+func MustCompileSet(exprs []string, opt RegexOptions) *RegexpSet {
+	s, err := CompileSet(exprs, opt)
+	if err != nil {
+		panic(`regexp2: CompileSet: ` + err.Error())
+	}
+	return s
+}
+
+// Len returns the number of patterns in the set.
+func (s *RegexpSet) Len() int {
+	return len(s.regexps)
+}
+
+// Regexp returns the i'th pattern of the set, for example to find its
+// capture groups once the set says it matches.
+func (s *RegexpSet) Regexp(i int) *Regexp {
+	return s.regexps[i]
+}
+
+// MatchString returns the positions of the patterns that match somewhere
+// in str, in increasing order.  The error comes from the patterns the
+// backtracker runs, for example when one of them times out.
+func (s *RegexpSet) MatchString(str string) ([]int, error) {
+	return s.MatchRunes(getRunes(str))
+}
+
+// MatchRunes is like MatchString for a rune slice.
+func (s *RegexpSet) MatchRunes(r []rune) ([]int, error) {
+	matched, err := s.matches(r)
+	if err != nil {
+		return nil, err
+	}
+	var out []int
+	for i, ok := range matched {
+		if ok {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+// FindStringMatches returns the leftmost match of each pattern in str,
+// indexed like the patterns, with nil for those that don't match.  Only
+// the patterns the set found matching are run again for their positions.
+func (s *RegexpSet) FindStringMatches(str string) ([]*Match, error) {
+	return s.FindRunesMatches(getRunes(str))
+}
+
+// FindRunesMatches is like FindStringMatches for a rune slice.
+func (s *RegexpSet) FindRunesMatches(r []rune) ([]*Match, error) {
+	matched, err := s.matches(r)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Match, len(s.regexps))
+	for i, ok := range matched {
+		if !ok {
+			continue
+		}
+		if out[i], err = s.regexps[i].FindRunesMatch(r); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// matches tells, by pattern, which of them match r
+func (s *RegexpSet) matches(r []rune) ([]bool, error) {
+	matched := make([]bool, len(s.regexps))
+	if len(s.linear) > 0 {
+		s.scan(r, matched)
+	}
+	for _, i := range s.fallback {
+		ok, err := s.regexps[i].MatchRunes(r)
+		if err != nil {
+			return nil, err
+		}
+		matched[i] = ok
+	}
+	return matched, nil
+}
+
+// setScratch is the per-search state of scan, kept in a pool between
+// searches
+type setScratch struct {
+	visited   []uint32 // generation in which each instruction was last seen
+	gen       uint32
+	cur, next []int
+	stack     []int
+}
+
+// scan runs the combined automaton over text, Thompson style: all the
+// threads of all the patterns move forward one rune at a time, and a
+// pattern's threads are dropped once it has matched.
+func (s *RegexpSet) scan(text []rune, matched []bool) {
+	sc, _ := s.scratch.Get().(*setScratch)
+	if sc == nil {
+		sc = &setScratch{visited: make([]uint32, len(s.insts))}
+	}
+	defer s.scratch.Put(sc)
+
+	left := len(s.linear)
+	sc.cur = sc.cur[:0]
+	flags := dfaStart | dfaBeginning
+	for i := 0; i <= len(text); i++ {
+		c, last := dfaEnd, true
+		if i < len(text) {
+			c, last = text[i], i == len(text)-1
+		}
+
+		sc.gen++
+		if sc.gen == 0 {
+			// wrapped around; the old marks could be mistaken for new ones
+			for j := range sc.visited {
+				sc.visited[j] = 0
+			}
+			sc.gen = 1
+		}
+		sc.next = sc.next[:0]
+		// unanchored search: every pattern gets a new attempt here
+		sc.stack = append(sc.stack[:0], sc.cur...)
+		for _, pc := range s.starts {
+			if !matched[s.owner[pc]] {
+				sc.stack = append(sc.stack, pc)
+			}
+		}
+
+		for len(sc.stack) > 0 {
+			pc := sc.stack[len(sc.stack)-1]
+			sc.stack = sc.stack[:len(sc.stack)-1]
+			if sc.visited[pc] == sc.gen || matched[s.owner[pc]] {
+				continue
+			}
+			sc.visited[pc] = sc.gen
+
+			inst := &s.insts[pc]
+			switch inst.Op {
+			case syntax.NFAMatch:
+				matched[s.owner[pc]] = true
+				if left--; left == 0 {
+					return
+				}
+			case syntax.NFASplit:
+				sc.stack = append(sc.stack, inst.Out1, inst.Out)
+			case syntax.NFANop:
+				sc.stack = append(sc.stack, inst.Out)
+			case syntax.NFAAssert:
+				if assertHolds(inst.Assert, flags, c, last) {
+					sc.stack = append(sc.stack, inst.Out)
+				}
+			case syntax.NFAChar, syntax.NFANotChar, syntax.NFASet:
+				if c != dfaEnd && consumes(inst, c) {
+					sc.next = append(sc.next, inst.Out)
+				}
+			}
+		}
+
+		sc.cur, sc.next = sc.next, sc.cur
+		if c != dfaEnd {
+			flags = prevFlags(c)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package regexp2
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegexpSet_Match(t *testing.T) {
+	exprs := []string{
+		`\bselect\b.*\bfrom\b`,
+		`<script`,
+		`\.\./`,
+		`^GET `,
+		`\d{3}-\d{4}$`,
+		`(?i)union\s+all`,
+		`(\w)\1{3}`, // the backreference needs the backtracker
+	}
+	set := MustCompileSet(exprs, RE2)
+	if set.Len() != len(exprs) {
+		t.Fatalf("wanted %v patterns, got %v", len(exprs), set.Len())
+	}
+	if !reflect.DeepEqual(set.fallback, []int{6}) {
+		t.Fatalf("wanted only the backreference left to the backtracker, got %v", set.fallback)
+	}
+
+	tests := []struct {
+		input string
+		want  []int
+	}{
+		{"", nil},
+		{"GET /index.html", []int{3}},
+		{"POST /../../etc/passwd", []int{2}},
+		{"GET /?q=select name from users UNION  ALL", []int{0, 3, 5}},
+		{"selection from x", nil},
+		{"<script>aaaa</script>", []int{1, 6}},
+		{"call 555-1234", []int{4}},
+		{"call 555-1234 now", nil},
+	}
+	for _, test := range tests {
+		got, err := set.MatchString(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: wanted %v, got %v", test.input, test.want, got)
+		}
+
+		// the set agrees with the patterns run on their own
+		var each []int
+		for i := range exprs {
+			if ok, _ := set.Regexp(i).MatchString(test.input); ok {
+				each = append(each, i)
+			}
+		}
+		if !reflect.DeepEqual(got, each) {
+			t.Errorf("%q: the set found %v, the patterns %v", test.input, got, each)
+		}
+	}
+}
+
+func TestRegexpSet_FindMatches(t *testing.T) {
+	set := MustCompileSet([]string{`b+`, `x`, `(?<n>\d+)`}, RE2)
+	ms, err := set.FindStringMatches("aabbb 42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 3 || ms[1] != nil {
+		t.Fatalf("wanted matches for patterns 0 and 2, got %v", ms)
+	}
+	if ms[0].String() != "bbb" || ms[0].Index != 2 {
+		t.Errorf("wanted bbb at 2, got %v at %v", ms[0], ms[0].Index)
+	}
+	if g := ms[2].GroupByName("n"); g == nil || g.String() != "42" {
+		t.Errorf("wanted group n to be 42, got %v", g)
+	}
+}
+
+func TestRegexpSet_ManyPatterns(t *testing.T) {
+	var exprs []string
+	for _, w := range strings.Fields("alpha beta gamma delta epsilon zeta eta theta iota kappa") {
+		exprs = append(exprs, `\b`+w+`\b`)
+	}
+	set := MustCompileSet(exprs, RE2|IgnoreCase)
+	got, err := set.MatchString("Theta, then ZETA and beta-alpha; not kappas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 1, 5, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestRegexpSet_CompileError(t *testing.T) {
+	_, err := CompileSet([]string{`a`, `b(`}, 0)
+	if err == nil || !strings.HasPrefix(err.Error(), "pattern 1: ") {
+		t.Errorf("wanted an error for pattern 1, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package regexp2
+
+import "errors"
+
+// RuneSource is text that can be read a rune at a time, such as an
+// editor's rope, gap buffer or piece table.  Positions run from 0 to
+// Len()-1.
+type RuneSource interface {
+	Len() int
+	RuneAt(i int) rune
+}
+
+// sourceChunk is how many runes are read from a RuneSource at a time
+const sourceChunk = 4096
+
+// FindSourceMatch finds the first match in src at or after startAt, without
+// copying all of src into a []rune.  The text is read a chunk at a time
+// from startAt, the way a StreamMatcher reads it, until the match is
+// settled, so only the runes from shortly before the match to its end are
+// held at once.  Patterns that look more than DefaultStreamLookbehind runes
+// back from where a match starts may not see all they need; those, and
+// RightToLeft patterns, which search back from startAt and copy all of
+// src to do it, are better matched with FindRunesMatchStartingAt.
+//
+// The Match returned is detached, with indexes into src, so its groups can
+// still be read once src changes.  FindNextSourceMatch carries on after it.
+func (re *Regexp) FindSourceMatch(src RuneSource, startAt int) (*Match, error) {
+	n := src.Len()
+	if startAt < 0 || startAt > n {
+		return nil, errors.New("startAt must be no less than 0 and no more than the length of the source")
+	}
+	if re.RightToLeft() {
+		m, err := re.FindRunesMatchStartingAt(readSource(src, 0, n, nil), startAt)
+		if m != nil {
+			m.Detach()
+		}
+		return m, err
+	}
+
+	var found *Match
+	sm := NewStreamMatcher(re, func(m *Match, offset int) error {
+		m.detachAt(offset)
+		found = m
+		return errSourceFound
+	})
+	// the runes before startAt are only there for anchors and lookbehinds
+	pos := startAt - sm.Lookbehind
+	if pos < 0 {
+		pos = 0
+	}
+	sm.buf = readSource(src, pos, startAt, nil)
+	sm.offset = pos
+	sm.next = startAt - pos
+
+	chunk := make([]rune, 0, sourceChunk)
+	for pos = startAt; ; {
+		var err error
+		if pos == n {
+			err = sm.Close()
+		} else {
+			end := pos + sourceChunk
+			if end > n {
+				end = n
+			}
+			err = sm.WriteRunes(readSource(src, pos, end, chunk[:0]))
+			pos = end
+		}
+		if err == errSourceFound {
+			return found, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if sm.err == errStreamClosed {
+			return nil, nil
+		}
+	}
+}
+
+// FindNextSourceMatch returns the match in src after m, which came from
+// FindSourceMatch or an earlier FindNextSourceMatch on the same src.
+func (re *Regexp) FindNextSourceMatch(src RuneSource, m *Match) (*Match, error) {
+	if m == nil {
+		return nil, nil
+	}
+	start := m.textpos
+	if m.Length == 0 {
+		if re.RightToLeft() {
+			if start == 0 {
+				return nil, nil
+			}
+			start--
+		} else {
+			if start >= src.Len() {
+				return nil, nil
+			}
+			start++
+		}
+	}
+	if re.RightToLeft() {
+		m, err := re.FindRunesMatchStartingAt(readSource(src, 0, src.Len(), nil), start)
+		if m != nil {
+			m.Detach()
+		}
+		return m, err
+	}
+	return re.FindSourceMatch(src, start)
+}
+
+// MatchSource reports whether src contains a match of the regular
+// expression.
+func (re *Regexp) MatchSource(src RuneSource) (bool, error) {
+	m, err := re.FindSourceMatch(src, 0)
+	if err != nil {
+		return false, err
+	}
+	return m != nil, nil
+}
+
+var errSourceFound = errors.New("regexp2: source match found")
+
+// readSource appends src[from:to] to dst
+func readSource(src RuneSource, from, to int, dst []rune) []rune {
+	for i := from; i < to; i++ {
+		dst = append(dst, src.RuneAt(i))
+	}
+	return dst
+}
@@ -0,0 +1,101 @@
+package regexp2
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// gapBuffer is a RuneSource the way an editor might keep its text
+type gapBuffer struct {
+	before, after []rune // after is stored reversed
+}
+
+func newGapBuffer(s string, gap int) *gapBuffer {
+	r := []rune(s)
+	g := &gapBuffer{before: append([]rune(nil), r[:gap]...)}
+	for i := len(r) - 1; i >= gap; i-- {
+		g.after = append(g.after, r[i])
+	}
+	return g
+}
+
+func (g *gapBuffer) Len() int { return len(g.before) + len(g.after) }
+
+func (g *gapBuffer) RuneAt(i int) rune {
+	if i < len(g.before) {
+		return g.before[i]
+	}
+	return g.after[len(g.after)-1-(i-len(g.before))]
+}
+
+func TestFindSourceMatch(t *testing.T) {
+	long := strings.Repeat("ab ", 1500) + "key=value" + strings.Repeat(" cd", 1500)
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+	}{
+		{`\d+`, 0, "abc 123 de 4567 f"},
+		{`(\w+)@(\w+)\.com`, 0, "mail me@example.com or you@test.com, ok"},
+		{`a*`, 0, "baaacaa"},
+		{`\bfoo\b`, 0, "foo foobar foo"},
+		{`(?<=x)y`, 0, "xy y xxy"},
+		{`(?m)^\w+$`, 0, "first line\nsecond\nthird"},
+		{`\s+$`, 0, "text  \n  "},
+		{`é+`, 0, "ééé aé"},
+		{`(\w+)=(\w+)`, 0, long},
+		{`ab(?= cd)`, 0, long},
+		{`\d+`, RightToLeft, "abc 123 de 4567 f"},
+		{`x`, 0, ""},
+		{``, 0, "ab"},
+	}
+	for _, tt := range tests {
+		re := MustCompile(tt.pattern, tt.opt)
+		var want []string
+		m, _ := re.FindStringMatch(tt.input)
+		for ; m != nil; m, _ = re.FindNextMatch(m) {
+			want = append(want, fmt.Sprint(m.Index, m.Length, m.Groups()[len(m.Groups())-1].String()))
+		}
+
+		for _, gap := range []int{0, len([]rune(tt.input)) / 2} {
+			src := newGapBuffer(tt.input, gap)
+			var got []string
+			start := 0
+			if re.RightToLeft() {
+				start = src.Len()
+			}
+			m, err := re.FindSourceMatch(src, start)
+			for ; m != nil; m, err = re.FindNextSourceMatch(src, m) {
+				got = append(got, fmt.Sprint(m.Index, m.Length, m.Groups()[len(m.Groups())-1].String()))
+			}
+			if err != nil {
+				t.Fatalf("%q: %v", tt.pattern, err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%q gap %v: got %q, want %q", tt.pattern, gap, got, want)
+			}
+		}
+	}
+
+	// the match still reads right once the source has moved on
+	src := newGapBuffer(long, 10)
+	re := MustCompile(`(\w+)=(\w+)`, 0)
+	m, err := re.FindSourceMatch(src, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.before[0] = 'z'
+	src.after = src.after[:0]
+	if m.Index != 4500 || m.String() != "key=value" || m.GroupByNumber(2).String() != "value" {
+		t.Errorf("got %v %q %q", m.Index, m.String(), m.GroupByNumber(2).String())
+	}
+
+	if ok, err := re.MatchSource(newGapBuffer("no pairs", 3)); ok || err != nil {
+		t.Errorf("MatchSource = %v, %v", ok, err)
+	}
+	if _, err := re.FindSourceMatch(src, src.Len()+1); err == nil {
+		t.Error("expected an error for startAt past the end")
+	}
+}
@@ -0,0 +1,136 @@
+package regexp2
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// LikePattern returns a pattern that matches the strings the SQL LIKE
+// pattern like does: % matches any run of runes and _ any one rune.  If
+// escape isn't 0, it makes a %, _ or itself after it literal; it can't be
+// followed by anything else, or end the pattern.  The pattern is anchored
+// at both ends.
+func LikePattern(like string, escape rune) (string, error) {
+	var b strings.Builder
+	b.WriteString(`\A`)
+	s := []rune(like)
+	for i := 0; i < len(s); i++ {
+		switch r := s[i]; {
+		case r == escape && escape != 0:
+			if i++; i == len(s) {
+				return "", errors.New("regexp2: LIKE pattern ends with its escape character")
+			}
+			if s[i] != '%' && s[i] != '_' && s[i] != escape {
+				return "", errors.New("regexp2: LIKE pattern escapes " + quote(string(s[i])) + ", which isn't %, _ or the escape character")
+			}
+			b.WriteString(syntax.Escape(string(s[i])))
+		case r == '%':
+			b.WriteString(`(?s:.*)`)
+		case r == '_':
+			b.WriteString(`(?s:.)`)
+		default:
+			b.WriteString(syntax.Escape(string(r)))
+		}
+	}
+	b.WriteString(`\z`)
+	return b.String(), nil
+}
+
+// CompileLike compiles the SQL LIKE pattern like, as LikePattern
+// translates it, with opt; IgnoreCase makes it ILIKE.
+func CompileLike(like string, escape rune, opt RegexOptions) (*Regexp, error) {
+	expr, err := LikePattern(like, escape)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(expr, opt)
+}
+
+// SimilarPattern returns a pattern that matches the strings the SQL
+// SIMILAR TO pattern similar does.  As in LIKE, % matches any run of
+// runes and _ any one; |, *, +, ?, {m,n}, parentheses and bracket
+// expressions like [a-z] mean what they do in a regular expression, and
+// everything else, . included, is literal.  If escape isn't 0, it makes
+// the rune after it literal.  The pattern is anchored at both ends, and
+// its parentheses don't capture.
+func SimilarPattern(similar string, escape rune) (string, error) {
+	var b strings.Builder
+	b.WriteString(`\A(?:`)
+	s := []rune(similar)
+	for i := 0; i < len(s); i++ {
+		switch r := s[i]; {
+		case r == escape && escape != 0:
+			if i++; i == len(s) {
+				return "", errors.New("regexp2: SIMILAR TO pattern ends with its escape character")
+			}
+			b.WriteString(syntax.Escape(string(s[i])))
+		case r == '%':
+			b.WriteString(`(?s:.*)`)
+		case r == '_':
+			b.WriteString(`(?s:.)`)
+		case r == '(':
+			b.WriteString(`(?:`)
+		case strings.ContainsRune(`|*+?)`, r):
+			b.WriteRune(r)
+		case r == '{':
+			end := i + 1
+			for end < len(s) && (s[end] >= '0' && s[end] <= '9' || s[end] == ',') {
+				end++
+			}
+			if end == len(s) || s[end] != '}' || end == i+1 || s[i+1] == ',' || strings.Count(string(s[i:end]), ",") > 1 {
+				return "", errors.New("regexp2: SIMILAR TO pattern has a bad repetition at " + quote(string(s[i:])))
+			}
+			b.WriteString(string(s[i : end+1]))
+			i = end
+		case r == '[':
+			end, err := similarClass(&b, s, i, escape)
+			if err != nil {
+				return "", err
+			}
+			i = end
+		default:
+			b.WriteString(syntax.Escape(string(r)))
+		}
+	}
+	b.WriteString(`)\z`)
+	return b.String(), nil
+}
+
+// CompileSimilar compiles the SQL SIMILAR TO pattern similar, as
+// SimilarPattern translates it, with opt.
+func CompileSimilar(similar string, escape rune, opt RegexOptions) (*Regexp, error) {
+	expr, err := SimilarPattern(similar, escape)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(expr, opt)
+}
+
+// similarClass writes the bracket expression that opens at s[i] and
+// returns where it closes
+func similarClass(b *strings.Builder, s []rune, i int, escape rune) (int, error) {
+	b.WriteByte('[')
+	i++
+	if i < len(s) && s[i] == '^' {
+		b.WriteByte('^')
+		i++
+	}
+	for first := true; i < len(s); i, first = i+1, false {
+		r := s[i]
+		switch {
+		case r == ']' && !first:
+			b.WriteByte(']')
+			return i, nil
+		case r == escape && escape != 0 && i+1 < len(s):
+			i++
+			b.WriteString(classEscape(s[i]))
+		case r == '-':
+			b.WriteByte('-')
+		default:
+			b.WriteString(classEscape(r))
+		}
+	}
+	return 0, errors.New("regexp2: SIMILAR TO pattern has an unclosed bracket expression")
+}
@@ -0,0 +1,89 @@
+package regexp2
+
+import "testing"
+
+func TestCompileLike(t *testing.T) {
+	tests := []struct {
+		like     string
+		escape   rune
+		match    []string
+		nonMatch []string
+	}{
+		{`abc%`, 0, []string{"abc", "abcdef", "abc\nd"}, []string{"xabc", "ab"}},
+		{`%b_d`, 0, []string{"bcd", "aabxd"}, []string{"bd", "bxxd"}},
+		{`a.c`, 0, []string{"a.c"}, []string{"abc"}},
+		{`100!%`, '!', []string{"100%"}, []string{"1000", "100"}},
+		{`!_!!%`, '!', []string{"_!", "_!x"}, []string{"a!x"}},
+		{`\%`, '\\', []string{"%"}, []string{`\x`}},
+		{`\%`, 0, []string{`\`, `\x`}, []string{"%"}},
+	}
+	for _, test := range tests {
+		re, err := CompileLike(test.like, test.escape, 0)
+		if err != nil {
+			t.Errorf("%v: %v", test.like, err)
+			continue
+		}
+		for _, s := range test.match {
+			if ok, _ := re.MatchString(s); !ok {
+				t.Errorf("%v (%v) doesn't match %q", test.like, re, s)
+			}
+		}
+		for _, s := range test.nonMatch {
+			if ok, _ := re.MatchString(s); ok {
+				t.Errorf("%v (%v) matches %q", test.like, re, s)
+			}
+		}
+	}
+
+	if re, err := CompileLike(`ABC%`, 0, IgnoreCase); err != nil {
+		t.Error(err)
+	} else if ok, _ := re.MatchString("abcd"); !ok {
+		t.Error("IgnoreCase not used")
+	}
+	for _, like := range []string{`abc!`, `!a`} {
+		if _, err := CompileLike(like, '!', 0); err == nil {
+			t.Errorf("%v: no error", like)
+		}
+	}
+}
+
+func TestCompileSimilar(t *testing.T) {
+	tests := []struct {
+		similar  string
+		escape   rune
+		match    []string
+		nonMatch []string
+	}{
+		{`abc`, 0, []string{"abc"}, []string{"abcd", "xabc"}},
+		{`a%|b_`, 0, []string{"a", "axyz", "bx"}, []string{"b", "bxy", "ca"}},
+		{`(ab)+c?`, 0, []string{"ab", "ababc"}, []string{"abcc", "c"}},
+		{`[a-c]{2,3}.x`, 0, []string{"ab.x", "abc.x"}, []string{"a.x", "abcd.x", "abyx"}},
+		{`[^0-9]*`, 0, []string{"", "abc"}, []string{"a1"}},
+		{`#%#|%`, '#', []string{"%|", "%|abc"}, []string{"x|"}},
+		{`[]#]]`, '#', []string{"]"}, []string{"#"}},
+		{`$^\`, 0, []string{`$^\`}, []string{""}},
+	}
+	for _, test := range tests {
+		re, err := CompileSimilar(test.similar, test.escape, 0)
+		if err != nil {
+			t.Errorf("%v: %v", test.similar, err)
+			continue
+		}
+		for _, s := range test.match {
+			if ok, _ := re.MatchString(s); !ok {
+				t.Errorf("%v (%v) doesn't match %q", test.similar, re, s)
+			}
+		}
+		for _, s := range test.nonMatch {
+			if ok, _ := re.MatchString(s); ok {
+				t.Errorf("%v (%v) matches %q", test.similar, re, s)
+			}
+		}
+	}
+
+	for _, similar := range []string{`a{x}`, `a{,2}`, `a{1,2,3}`, `[abc`, `a#`, `(a`} {
+		if _, err := CompileSimilar(similar, '#', 0); err == nil {
+			t.Errorf("%v: no error", similar)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package regexp2
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCacheSize is how many patterns the package-level functions such as
+// MatchString keep compiled, like .NET's Regex.CacheSize.
+const DefaultCacheSize = 15
+
+// The package-level functions share one cache of compiled patterns, keyed
+// by the pattern and options, from which the least recently used one is
+// dropped when it's full.
+var cache = &regexpCache{
+	size:  DefaultCacheSize,
+	order: list.New(),
+	items: make(map[cacheKey]*list.Element),
+}
+
+type cacheKey struct {
+	pattern string
+	opt     RegexOptions
+}
+
+type cacheEntry struct {
+	key cacheKey
+	re  *Regexp
+}
+
+type regexpCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // of *cacheEntry, most recently used first
+	items map[cacheKey]*list.Element
+}
+
+// get returns the compiled pattern, compiling it if it isn't cached.
+// Patterns are compiled outside the lock, so two goroutines may both
+// compile one that's missing; the second to finish keeps the first's.
+func (c *regexpCache) get(pattern string, opt RegexOptions) (*Regexp, error) {
+	key := cacheKey{pattern, opt}
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*cacheEntry).re, nil
+	}
+	c.mu.Unlock()
+
+	re, err := Compile(pattern, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*cacheEntry).re, nil
+	}
+	if c.size > 0 {
+		c.items[key] = c.order.PushFront(&cacheEntry{key, re})
+		c.trim()
+	}
+	return re, nil
+}
+
+// trim drops the least recently used patterns until there are no more
+// than size
+func (c *regexpCache) trim() {
+	for c.order.Len() > c.size {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.items, e.Value.(*cacheEntry).key)
+	}
+}
+
+// CacheSize returns how many compiled patterns the package-level functions
+// keep.
+func CacheSize() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.size
+}
+
+// SetCacheSize changes how many compiled patterns the package-level
+// functions keep, dropping the least recently used ones if there are more
+// than n.  Zero, or less, turns caching off.
+func SetCacheSize(n int) {
+	if n < 0 {
+		n = 0
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.size = n
+	cache.trim()
+}
+
+// MatchString reports whether input contains a match of pattern.  Like the
+// other package-level functions, it compiles pattern with opt the first time
+// it's used and keeps the result in a cache shared by all of them, whose
+// size is set with SetCacheSize.  They suit code that uses many patterns a
+// few times each, such as scripts and template engines; patterns used in a
+// loop are better compiled once with Compile.
+func MatchString(pattern, input string, opt RegexOptions) (bool, error) {
+	re, err := cache.get(pattern, opt)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(input)
+}
+
+// FindStringMatch returns the first match of pattern in input.  The Match
+// can be passed to FindNextMatch of its Regexp for the ones after it.
+func FindStringMatch(pattern, input string, opt RegexOptions) (*Match, error) {
+	re, err := cache.get(pattern, opt)
+	if err != nil {
+		return nil, err
+	}
+	return re.FindStringMatch(input)
+}
+
+// Replace replaces every match of pattern in input with replacement, as
+// Regexp.Replace does.
+func Replace(pattern, input, replacement string, opt RegexOptions) (string, error) {
+	re, err := cache.get(pattern, opt)
+	if err != nil {
+		return "", err
+	}
+	return re.Replace(input, replacement, -1, -1)
+}
+
+// ReplaceFunc replaces every match of pattern in input with the string
+// evaluator returns for it.
+func ReplaceFunc(pattern, input string, evaluator MatchEvaluator, opt RegexOptions) (string, error) {
+	re, err := cache.get(pattern, opt)
+	if err != nil {
+		return "", err
+	}
+	return re.ReplaceFunc(input, evaluator, -1, -1)
+}
+
+// Split slices input into the substrings between matches of pattern, as
+// Regexp.Split does with n = -1.
+func Split(pattern, input string, opt RegexOptions) ([]string, error) {
+	re, err := cache.get(pattern, opt)
+	if err != nil {
+		return nil, err
+	}
+	return re.Split(input, -1), nil
+}
@@ -0,0 +1,118 @@
+package regexp2
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestStatic(t *testing.T) {
+	if ok, err := MatchString(`\d+`, "abc 123", 0); !ok || err != nil {
+		t.Errorf("expected a match, got %v %v", ok, err)
+	}
+	if ok, _ := MatchString(`^abc$`, "ABC", IgnoreCase); !ok {
+		t.Error("expected the options to be used")
+	}
+	if _, err := MatchString(`a(`, "a(", 0); err == nil {
+		t.Error("expected a compile error")
+	}
+
+	m, err := FindStringMatch(`(?<n>\d+)`, "a1 b22", 0)
+	if err != nil || m.GroupByName("n").String() != "1" {
+		t.Fatalf("unexpected match %v %v", m, err)
+	}
+
+	if s, _ := Replace(`(\w+)@(\w+)`, "me@home you@work", "$2:$1", 0); s != "home:me work:you" {
+		t.Errorf("unexpected replacement %q", s)
+	}
+	if s, _ := ReplaceFunc(`\d`, "a1b2", func(m Match) string { return "<" + m.String() + ">" }, 0); s != "a<1>b<2>" {
+		t.Errorf("unexpected replacement %q", s)
+	}
+	if s, _ := Split(`\s*,\s*`, "a , b,c", 0); !reflect.DeepEqual(s, []string{"a", "b", "c"}) {
+		t.Errorf("unexpected split %q", s)
+	}
+}
+
+func TestStatic_Cache(t *testing.T) {
+	defer SetCacheSize(CacheSize())
+	SetCacheSize(2)
+
+	a, _ := cache.get("a", 0)
+	if again, _ := cache.get("a", 0); again != a {
+		t.Error("expected the cached Regexp")
+	}
+	if ci, _ := cache.get("a", IgnoreCase); ci == a {
+		t.Error("expected the options to be part of the key")
+	}
+	cache.get("a", 0) // now the most recently used
+	cache.get("b", 0) // drops a with IgnoreCase
+	if again, _ := cache.get("a", 0); again != a {
+		t.Error("expected the most recently used pattern to stay")
+	}
+	if len(cache.items) != 2 || cache.order.Len() != 2 {
+		t.Errorf("wanted 2 cached patterns, got %v", len(cache.items))
+	}
+
+	SetCacheSize(0)
+	if len(cache.items) != 0 {
+		t.Errorf("wanted an empty cache, got %v", len(cache.items))
+	}
+	if ok, _ := MatchString("a", "a", 0); !ok || len(cache.items) != 0 {
+		t.Error("expected matching to work without caching")
+	}
+
+	SetCacheSize(2)
+	cache.get("a", 0)
+	SetCacheSize(-1)
+	if CacheSize() != 0 || len(cache.items) != 0 {
+		t.Errorf("wanted a negative size to turn caching off, got size %v with %v cached", CacheSize(), len(cache.items))
+	}
+}
+
+func TestStatic_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				word := fmt.Sprintf("w%d", (g+i)%40)
+				if ok, err := MatchString(`\b`+word+`\b`, strings.Repeat("x ", i%5)+word, 0); !ok || err != nil {
+					t.Errorf("expected %v to match: %v", word, err)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if n := len(cache.items); n > CacheSize() {
+		t.Errorf("cache grew to %v", n)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   string
+		n       int
+		want    []string
+	}{
+		{`a*`, 0, "abaabaccadaaae", 5, []string{"", "b", "b", "c", "cadaaae"}},
+		{`,`, 0, "a,b,c", -1, []string{"a", "b", "c"}},
+		{`,`, 0, "a,b,c", 2, []string{"a", "b,c"}},
+		{`,`, 0, "a,b,c", 0, nil},
+		{`,`, 0, "", -1, []string{""}},
+		{`x`, 0, "abc", -1, []string{"abc"}},
+		{``, 0, "abc", -1, []string{"a", "b", "c"}},
+		{`\s+`, RightToLeft, "a b  c", -1, []string{"a", "b", "c"}},
+		{`é`, 0, "aébéc", -1, []string{"a", "b", "c"}},
+	}
+	for _, test := range tests {
+		if got := MustCompile(test.pattern, test.opt).Split(test.input, test.n); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v.Split(%q, %v): wanted %q, got %q", test.pattern, test.input, test.n, test.want, got)
+		}
+	}
+}
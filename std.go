@@ -0,0 +1,430 @@
+package regexp2
+
+import (
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jviksne/regexp2/syntax"
+)
+
+// StdRegexp has the methods of the standard library's *regexp.Regexp, run
+// by a Regexp, so that it can go where code takes an interface made of
+// those, like a router's or a template engine's.  They behave as the
+// standard library's do: offsets are in bytes, groups that didn't take
+// part in a match are at -1, an empty match right after another doesn't
+// count, and ReplaceAll expands $1 and ${name}, not .NET's replacement
+// syntax.  There are no errors, so a search that fails, by running past
+// MatchTimeout say, is taken as finding nothing.
+type StdRegexp struct {
+	re *Regexp
+}
+
+// Std returns re with the standard library's method set.
+func Std(re *Regexp) *StdRegexp {
+	return &StdRegexp{re}
+}
+
+// Regexp returns the Regexp s runs.
+func (s *StdRegexp) Regexp() *Regexp {
+	return s.re
+}
+
+func (s *StdRegexp) String() string {
+	return s.re.String()
+}
+
+// Copy returns a copy of s, which Longest doesn't change s for.
+func (s *StdRegexp) Copy() *StdRegexp {
+	return &StdRegexp{s.re.Clone()}
+}
+
+// Longest makes future searches prefer leftmost-longest matches.
+func (s *StdRegexp) Longest() {
+	s.re.Longest()
+}
+
+func (s *StdRegexp) NumSubexp() int {
+	return s.re.NumSubexp()
+}
+
+func (s *StdRegexp) SubexpNames() []string {
+	return s.re.SubexpNames()
+}
+
+func (s *StdRegexp) SubexpIndex(name string) int {
+	return s.re.SubexpIndex(name)
+}
+
+// LiteralPrefix returns a literal string that must begin any match, and
+// whether it's the whole pattern.
+func (s *StdRegexp) LiteralPrefix() (prefix string, complete bool) {
+	if s.re.RightToLeft() {
+		return "", false
+	}
+	prefix = s.re.prefix
+	if tree, err := syntax.Parse(s.re.pattern, syntax.RegexOptions(s.re.options)); err == nil {
+		root := tree.AST()
+		complete = root.Op == syntax.OpLiteral && root.Flags&syntax.IgnoreCase == 0 && string(root.Runes) == prefix
+	}
+	return prefix, complete
+}
+
+func (s *StdRegexp) MarshalText() ([]byte, error) {
+	return s.re.MarshalText()
+}
+
+func (s *StdRegexp) AppendText(b []byte) ([]byte, error) {
+	text, err := s.re.MarshalText()
+	return append(b, text...), err
+}
+
+func (s *StdRegexp) UnmarshalText(text []byte) error {
+	re := &Regexp{}
+	if err := re.UnmarshalText(text); err != nil {
+		return err
+	}
+	s.re = re
+	return nil
+}
+
+func (s *StdRegexp) Match(b []byte) bool {
+	return s.MatchString(string(b))
+}
+
+func (s *StdRegexp) MatchString(str string) bool {
+	ok, _ := s.re.MatchString(str)
+	return ok
+}
+
+func (s *StdRegexp) MatchReader(r io.RuneReader) bool {
+	return s.MatchString(readRunes(r))
+}
+
+func (s *StdRegexp) Find(b []byte) []byte {
+	if loc := s.FindIndex(b); loc != nil {
+		return b[loc[0]:loc[1]:loc[1]]
+	}
+	return nil
+}
+
+func (s *StdRegexp) FindIndex(b []byte) []int {
+	return s.FindStringIndex(string(b))
+}
+
+func (s *StdRegexp) FindString(str string) string {
+	if loc := s.FindStringIndex(str); loc != nil {
+		return str[loc[0]:loc[1]]
+	}
+	return ""
+}
+
+func (s *StdRegexp) FindStringIndex(str string) []int {
+	if a := s.all(str, 1, false); a != nil {
+		return a[0]
+	}
+	return nil
+}
+
+func (s *StdRegexp) FindReaderIndex(r io.RuneReader) []int {
+	return s.FindStringIndex(readRunes(r))
+}
+
+func (s *StdRegexp) FindSubmatch(b []byte) [][]byte {
+	if loc := s.FindSubmatchIndex(b); loc != nil {
+		return bytesAt(b, loc)
+	}
+	return nil
+}
+
+func (s *StdRegexp) FindSubmatchIndex(b []byte) []int {
+	return s.FindStringSubmatchIndex(string(b))
+}
+
+func (s *StdRegexp) FindStringSubmatch(str string) []string {
+	if loc := s.FindStringSubmatchIndex(str); loc != nil {
+		return stringsAt(str, loc)
+	}
+	return nil
+}
+
+func (s *StdRegexp) FindStringSubmatchIndex(str string) []int {
+	if a := s.all(str, 1, true); a != nil {
+		return a[0]
+	}
+	return nil
+}
+
+func (s *StdRegexp) FindReaderSubmatchIndex(r io.RuneReader) []int {
+	return s.FindStringSubmatchIndex(readRunes(r))
+}
+
+func (s *StdRegexp) FindAll(b []byte, n int) [][]byte {
+	var all [][]byte
+	for _, loc := range s.FindAllIndex(b, n) {
+		all = append(all, b[loc[0]:loc[1]:loc[1]])
+	}
+	return all
+}
+
+func (s *StdRegexp) FindAllIndex(b []byte, n int) [][]int {
+	return s.FindAllStringIndex(string(b), n)
+}
+
+func (s *StdRegexp) FindAllString(str string, n int) []string {
+	var all []string
+	for _, loc := range s.FindAllStringIndex(str, n) {
+		all = append(all, str[loc[0]:loc[1]])
+	}
+	return all
+}
+
+func (s *StdRegexp) FindAllStringIndex(str string, n int) [][]int {
+	return s.all(str, n, false)
+}
+
+func (s *StdRegexp) FindAllSubmatch(b []byte, n int) [][][]byte {
+	var all [][][]byte
+	for _, loc := range s.FindAllSubmatchIndex(b, n) {
+		all = append(all, bytesAt(b, loc))
+	}
+	return all
+}
+
+func (s *StdRegexp) FindAllSubmatchIndex(b []byte, n int) [][]int {
+	return s.FindAllStringSubmatchIndex(string(b), n)
+}
+
+func (s *StdRegexp) FindAllStringSubmatch(str string, n int) [][]string {
+	var all [][]string
+	for _, loc := range s.FindAllStringSubmatchIndex(str, n) {
+		all = append(all, stringsAt(str, loc))
+	}
+	return all
+}
+
+func (s *StdRegexp) FindAllStringSubmatchIndex(str string, n int) [][]int {
+	return s.all(str, n, true)
+}
+
+func (s *StdRegexp) Split(str string, n int) []string {
+	return s.re.Split(str, n)
+}
+
+func (s *StdRegexp) ReplaceAll(src, repl []byte) []byte {
+	return s.replaceAll(string(src), func(dst []byte, loc []int) []byte {
+		return s.expand(dst, string(repl), string(src), loc)
+	})
+}
+
+func (s *StdRegexp) ReplaceAllString(src, repl string) string {
+	return string(s.replaceAll(src, func(dst []byte, loc []int) []byte {
+		return s.expand(dst, repl, src, loc)
+	}))
+}
+
+func (s *StdRegexp) ReplaceAllLiteral(src, repl []byte) []byte {
+	return s.replaceAll(string(src), func(dst []byte, _ []int) []byte {
+		return append(dst, repl...)
+	})
+}
+
+func (s *StdRegexp) ReplaceAllLiteralString(src, repl string) string {
+	return string(s.replaceAll(src, func(dst []byte, _ []int) []byte {
+		return append(dst, repl...)
+	}))
+}
+
+func (s *StdRegexp) ReplaceAllFunc(src []byte, repl func([]byte) []byte) []byte {
+	return s.replaceAll(string(src), func(dst []byte, loc []int) []byte {
+		return append(dst, repl(src[loc[0]:loc[1]])...)
+	})
+}
+
+func (s *StdRegexp) ReplaceAllStringFunc(src string, repl func(string) string) string {
+	return string(s.replaceAll(src, func(dst []byte, loc []int) []byte {
+		return append(dst, repl(src[loc[0]:loc[1]])...)
+	}))
+}
+
+// Expand appends template to dst with $1, ${name} and the like replaced by
+// the groups of match, a FindSubmatchIndex result for src.
+func (s *StdRegexp) Expand(dst []byte, template []byte, src []byte, match []int) []byte {
+	return s.expand(dst, string(template), string(src), match)
+}
+
+// ExpandString is like Expand for strings.
+func (s *StdRegexp) ExpandString(dst []byte, template string, src string, match []int) []byte {
+	return s.expand(dst, template, src, match)
+}
+
+// all returns the byte offsets of up to n matches in str, all of them if n
+// is negative, leaving out empty ones next to the match before
+func (s *StdRegexp) all(str string, n int, submatch bool) [][]int {
+	if n == 0 {
+		return nil
+	}
+	offs := newByteOffsets(str)
+	var all [][]int
+	m, err := s.re.FindStringMatch(str)
+	prevStart, prevEnd := -1, -1
+	for ; m != nil && err == nil && (n < 0 || len(all) < n); m, err = s.re.FindNextMatch(m) {
+		start, end := offs.at(m.Index), offs.at(m.Index+m.Length)
+		if start == end && (start == prevEnd || end == prevStart) {
+			continue
+		}
+		prevStart, prevEnd = start, end
+		loc := []int{start, end}
+		if submatch {
+			for _, g := range m.Groups()[1:] {
+				if !g.Success() {
+					loc = append(loc, -1, -1)
+				} else {
+					loc = append(loc, offs.at(g.Index), offs.at(g.Index+g.Length))
+				}
+			}
+		}
+		all = append(all, loc)
+	}
+	return all
+}
+
+// replaceAll calls repl for each match in src, with its byte offsets, to
+// append what replaces it
+func (s *StdRegexp) replaceAll(src string, repl func(dst []byte, loc []int) []byte) []byte {
+	locs := s.all(src, -1, true)
+	if s.re.RightToLeft() {
+		for i, j := 0, len(locs)-1; i < j; i, j = i+1, j-1 {
+			locs[i], locs[j] = locs[j], locs[i]
+		}
+	}
+	var buf []byte
+	last := 0
+	for _, loc := range locs {
+		buf = append(buf, src[last:loc[0]]...)
+		buf = repl(buf, loc)
+		last = loc[1]
+	}
+	return append(buf, src[last:]...)
+}
+
+// expand is Expand.
+//
+// Ported from https://golang.org/src/regexp/regexp.go
+func (s *StdRegexp) expand(dst []byte, template string, src string, match []int) []byte {
+	for len(template) > 0 {
+		i := strings.Index(template, "$")
+		if i < 0 {
+			break
+		}
+		dst = append(dst, template[:i]...)
+		template = template[i+1:]
+		if template != "" && template[0] == '$' {
+			// Treat $$ as $.
+			dst = append(dst, '$')
+			template = template[1:]
+			continue
+		}
+		name, num, rest, ok := extractTemplateName(template)
+		if !ok {
+			// Malformed; treat $ as raw text.
+			dst = append(dst, '$')
+			continue
+		}
+		template = rest
+		if num >= 0 {
+			if 2*num+1 < len(match) && match[2*num] >= 0 {
+				dst = append(dst, src[match[2*num]:match[2*num+1]]...)
+			}
+		} else if i := s.re.SubexpIndex(name); i >= 0 && 2*i+1 < len(match) && match[2*i] >= 0 {
+			dst = append(dst, src[match[2*i]:match[2*i+1]]...)
+		}
+	}
+	return append(dst, template...)
+}
+
+// extractTemplateName reads the name or number after a $ in a template,
+// as $name or ${name}, the longest run of letters, digits and _.
+//
+// Ported from https://golang.org/src/regexp/regexp.go
+func extractTemplateName(str string) (name string, num int, rest string, ok bool) {
+	if str == "" {
+		return
+	}
+	brace := false
+	if str[0] == '{' {
+		brace = true
+		str = str[1:]
+	}
+	i := 0
+	for i < len(str) {
+		rune, size := utf8.DecodeRuneInString(str[i:])
+		if !unicode.IsLetter(rune) && !unicode.IsDigit(rune) && rune != '_' {
+			break
+		}
+		i += size
+	}
+	if i == 0 {
+		// empty name is not okay
+		return
+	}
+	name = str[:i]
+	if brace {
+		if i >= len(str) || str[i] != '}' {
+			// missing closing brace
+			return
+		}
+		i++
+	}
+
+	// Parse number.
+	num = 0
+	for i := 0; i < len(name); i++ {
+		if name[i] < '0' || '9' < name[i] || num >= 1e8 {
+			num = -1
+			break
+		}
+		num = num*10 + int(name[i]) - '0'
+	}
+	// Disallow leading zeros.
+	if name[0] == '0' && len(name) > 1 {
+		num = -1
+	}
+
+	rest = str[i:]
+	ok = true
+	return
+}
+
+// readRunes reads all of r
+func readRunes(r io.RuneReader) string {
+	var sb strings.Builder
+	for {
+		c, _, err := r.ReadRune()
+		if err != nil {
+			return sb.String()
+		}
+		sb.WriteRune(c)
+	}
+}
+
+func bytesAt(b []byte, loc []int) [][]byte {
+	sub := make([][]byte, len(loc)/2)
+	for i := range sub {
+		if loc[2*i] >= 0 {
+			sub[i] = b[loc[2*i]:loc[2*i+1]:loc[2*i+1]]
+		}
+	}
+	return sub
+}
+
+func stringsAt(str string, loc []int) []string {
+	sub := make([]string, len(loc)/2)
+	for i := range sub {
+		if loc[2*i] >= 0 {
+			sub[i] = str[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return sub
+}
@@ -0,0 +1,85 @@
+package regexp2
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// the methods StdRegexp shares with *regexp.Regexp
+type stdMethods interface {
+	FindAllString(s string, n int) []string
+	FindAllStringSubmatchIndex(s string, n int) [][]int
+	FindStringSubmatch(s string) []string
+	FindReaderIndex(r io.RuneReader) []int
+	ReplaceAllString(src, repl string) string
+	ReplaceAllLiteralString(src, repl string) string
+	ReplaceAllStringFunc(src string, repl func(string) string) string
+	Split(s string, n int) []string
+	MatchString(s string) bool
+	LiteralPrefix() (string, bool)
+}
+
+var (
+	_ stdMethods = (*regexp.Regexp)(nil)
+	_ stdMethods = (*StdRegexp)(nil)
+)
+
+func TestStd(t *testing.T) {
+	tests := []struct {
+		pattern, text, repl string
+	}{
+		{`a*`, "baaacada", "<$0>"},
+		{`(\w+)@(?P<host>\w+)?`, "x@y z@ w@v", "${host}:$1"},
+		{`(a)|(b)`, "abc", "[$1$2]"},
+		{`é+`, "aéébéc", "$$"},
+		{`x*`, "", "-"},
+		{`abc`, "xabcabc", "${1}z"},
+		{`(?i)ab`, "AbaB", "$x"},
+	}
+	for _, test := range tests {
+		want := regexp.MustCompile(test.pattern)
+		got := Std(MustCompile(test.pattern, RE2))
+		check := func(name string, got, want interface{}) {
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v on %q: %v got %q, want %q", test.pattern, test.text, name, got, want)
+			}
+		}
+		check("FindAllString", got.FindAllString(test.text, -1), want.FindAllString(test.text, -1))
+		check("FindAllStringSubmatchIndex", got.FindAllStringSubmatchIndex(test.text, -1), want.FindAllStringSubmatchIndex(test.text, -1))
+		check("FindAllStringSubmatchIndex 2", got.FindAllStringSubmatchIndex(test.text, 2), want.FindAllStringSubmatchIndex(test.text, 2))
+		check("FindStringSubmatch", got.FindStringSubmatch(test.text), want.FindStringSubmatch(test.text))
+		check("FindAllSubmatch", got.FindAllSubmatch([]byte(test.text), -1), want.FindAllSubmatch([]byte(test.text), -1))
+		check("FindReaderIndex", got.FindReaderIndex(strings.NewReader(test.text)), want.FindReaderIndex(strings.NewReader(test.text)))
+		check("ReplaceAllString", got.ReplaceAllString(test.text, test.repl), want.ReplaceAllString(test.text, test.repl))
+		check("ReplaceAll", got.ReplaceAll([]byte(test.text), []byte(test.repl)), want.ReplaceAll([]byte(test.text), []byte(test.repl)))
+		check("ReplaceAllLiteralString", got.ReplaceAllLiteralString(test.text, test.repl), want.ReplaceAllLiteralString(test.text, test.repl))
+		check("ReplaceAllStringFunc", got.ReplaceAllStringFunc(test.text, strings.ToUpper), want.ReplaceAllStringFunc(test.text, strings.ToUpper))
+		check("Split", got.Split(test.text, -1), want.Split(test.text, -1))
+		check("MatchString", got.MatchString(test.text), want.MatchString(test.text))
+		check("SubexpNames", got.SubexpNames(), want.SubexpNames())
+		p, c := got.LiteralPrefix()
+		wp, wc := want.LiteralPrefix()
+		check("LiteralPrefix", []interface{}{p, c}, []interface{}{wp, wc})
+	}
+}
+
+func TestStdMethodSet(t *testing.T) {
+	std := reflect.TypeOf((*regexp.Regexp)(nil))
+	ours := reflect.TypeOf((*StdRegexp)(nil))
+	for i := 0; i < std.NumMethod(); i++ {
+		want := std.Method(i)
+		got, ok := ours.MethodByName(want.Name)
+		if !ok {
+			t.Errorf("no %v", want.Name)
+			continue
+		}
+		// the receiver, and Copy's result, are our own type
+		wantType := strings.ReplaceAll(want.Type.String(), "*regexp.Regexp", "*regexp2.StdRegexp")
+		if got.Type.String() != wantType {
+			t.Errorf("%v is %v, want %v", want.Name, got.Type, wantType)
+		}
+	}
+}
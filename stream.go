@@ -0,0 +1,176 @@
+package regexp2
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// DefaultStreamLookbehind is how many runes a StreamMatcher keeps from
+// before the point its search has reached, unless told otherwise.
+const DefaultStreamLookbehind = 64
+
+// StreamMatcher finds the matches of a Regexp in text that arrives in
+// pieces, such as a large file or a network connection, without holding
+// all of it in memory.  It's fed with Write or WriteRunes and calls a
+// function for each match as soon as more text can no longer change it,
+// which is decided with PartialHard partial matching.  Close ends the
+// text and reports whatever matches are left.
+//
+// It finds the same matches as FindStringMatch and FindNextMatch would on
+// the whole text, as long as the pattern doesn't look further back than
+// Lookbehind runes from where a match starts.  Only the text from there
+// on is kept, so a match that's still open, like one of a.*b while no b
+// has come, keeps everything after where it started.  RightToLeft
+// patterns can't be matched this way.
+type StreamMatcher struct {
+	// Lookbehind is how many runes before the search position are kept
+	// for lookbehinds, \b and the like.  It's DefaultStreamLookbehind
+	// unless it's changed before the first Write, and at least 1 is kept
+	// so that anchors still know where the stream starts.
+	Lookbehind int
+
+	re *Regexp
+	fn func(m *Match, offset int) error
+
+	buf    []rune // the text kept
+	offset int    // position of buf[0] in the stream
+	next   int    // where in buf the search carries on
+	empty  bool   // whether next is one past an empty match
+	rest   []byte // an incomplete UTF-8 sequence at the end of the last Write
+	err    error
+
+	// drop, if set, is told about text just before it's let go
+	drop func(text []rune, offset int) error
+}
+
+// NewStreamMatcher returns a StreamMatcher that calls fn with each match
+// of re.  The indexes of the match and its groups are into the text kept,
+// which starts offset runes into the stream, and the Match is only valid
+// until fn returns.  If fn returns an error, matching stops and the
+// error is returned by that Write and every call after it.
+func NewStreamMatcher(re *Regexp, fn func(m *Match, offset int) error) *StreamMatcher {
+	return &StreamMatcher{
+		Lookbehind: DefaultStreamLookbehind,
+		re:         re,
+		fn:         fn,
+	}
+}
+
+var errStreamClosed = errors.New("regexp2: write to a closed StreamMatcher")
+
+// Write adds UTF-8 encoded text to the stream.  A character may be split
+// between two Writes.  It consumes all of p, and returns an error from the
+// matcher function or a timeout if there is one.
+func (s *StreamMatcher) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	data := p
+	if len(s.rest) > 0 {
+		// finish the character the last Write cut off
+		data = append(s.rest, p...)
+		s.rest = nil
+	}
+	for len(data) > 0 {
+		if !utf8.FullRune(data) {
+			s.rest = append([]byte(nil), data...)
+			break
+		}
+		r, size := utf8.DecodeRune(data)
+		s.buf = append(s.buf, r)
+		data = data[size:]
+	}
+	return len(p), s.match(false)
+}
+
+// WriteRunes adds text to the stream.
+func (s *StreamMatcher) WriteRunes(r []rune) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.buf = append(s.buf, r...)
+	return s.match(false)
+}
+
+// Close ends the stream and reports the matches that were waiting for
+// more text.  A character left incomplete by the last Write counts as
+// utf8.RuneError.
+func (s *StreamMatcher) Close() error {
+	if s.err != nil {
+		return s.err
+	}
+	if len(s.rest) > 0 {
+		for p := s.rest; len(p) > 0; {
+			r, size := utf8.DecodeRune(p)
+			s.buf = append(s.buf, r)
+			p = p[size:]
+		}
+		s.rest = nil
+	}
+	if err := s.match(true); err != nil {
+		return err
+	}
+	s.err = errStreamClosed
+	return nil
+}
+
+// match reports the matches in buf that are settled, or all of them at
+// the end of the stream, and drops the text that's no longer needed
+func (s *StreamMatcher) match(end bool) error {
+	if s.re.RightToLeft() {
+		s.err = errors.New("regexp2: StreamMatcher can't match RightToLeft patterns")
+		return s.err
+	}
+
+	mode := PartialHard
+	if end {
+		mode = 0
+	}
+	cfg := s.re.config(nil)
+	for s.next <= len(s.buf) {
+		cfg.afterEmpty = s.empty
+		m, err := s.re.partialSearch(s.buf, s.next, mode, cfg)
+		if err != nil {
+			s.err = err
+			return err
+		}
+		if m == nil {
+			s.next, s.empty = len(s.buf), false
+			break
+		}
+		if m.Partial() || !end && m.Index == len(s.buf) {
+			// more text decides this one, and an empty match at the end
+			// could yet be a longer one
+			s.next = m.Index
+			break
+		}
+		if err := s.fn(m, s.offset); err != nil {
+			s.err = err
+			return err
+		}
+		next, ok := s.re.nextStart(m, &cfg)
+		if !ok {
+			s.next, s.empty = len(s.buf), false
+			break
+		}
+		s.next, s.empty = next, cfg.afterEmpty
+	}
+
+	keep := s.Lookbehind
+	if keep < 1 {
+		keep = 1
+	}
+	if cut := s.next - keep; cut > 0 {
+		if s.drop != nil {
+			if err := s.drop(s.buf[:cut], s.offset); err != nil {
+				s.err = err
+				return err
+			}
+		}
+		n := copy(s.buf, s.buf[cut:])
+		s.buf = s.buf[:n]
+		s.offset += cut
+		s.next -= cut
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package regexp2
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStreamMatcher(t *testing.T) {
+	patterns := []string{
+		`\d+`, `\w+@\w+\.com`, `a.*?b`, `\bfoo\b`, `(?<=x)y`, `^\w+`, `(?m)^\w+$`,
+		`a*`, `cat|category`, `\s+$`, `é+`, `(\w)\1`, `(?i)hello`, `x(?=\d\d)`,
+	}
+	inputs := []string{
+		"",
+		"abc 123 de 4567 f",
+		"mail me@example.com or you@test.org, ok",
+		"xaxxb ab aaab",
+		"foo foobar foo",
+		"xy y xxy",
+		"first line\nsecond\nthird",
+		"baaacaa",
+		"categorycat cat",
+		"text  \n  ",
+		"ééé aé",
+		"aabbc dd",
+		"HeLLo hello",
+		"x12 x1 x999",
+	}
+	for _, pattern := range patterns {
+		re := MustCompile(pattern, 0)
+		for _, input := range inputs {
+			var want []string
+			m, _ := re.FindStringMatch(input)
+			for ; m != nil; m, _ = re.FindNextMatch(m) {
+				want = append(want, fmt.Sprintf("%v+%v:%v", m.Index, m.Length, m.String()))
+			}
+
+			for _, size := range []int{1, 2, 3, 7, 100} {
+				var got []string
+				sm := NewStreamMatcher(re, func(m *Match, offset int) error {
+					got = append(got, fmt.Sprintf("%v+%v:%v", offset+m.Index, m.Length, m.String()))
+					return nil
+				})
+				sm.Lookbehind = 2
+				for i := 0; i < len(input); i += size {
+					end := i + size
+					if end > len(input) {
+						end = len(input)
+					}
+					if _, err := sm.Write([]byte(input[i:end])); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := sm.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(want, got) {
+					t.Errorf("%v on %q in pieces of %v: wanted %v, got %v", pattern, input, size, want, got)
+				}
+			}
+		}
+	}
+}
+
+// a chunk that ends in '\n' mustn't settle a $ or \Z before it
+func TestStreamMatcher_Lines(t *testing.T) {
+	patterns := []string{`x$`, `$`, `\w+\Z`, `(?m)^\w+$`, `\n\Z`, `\w+\s*$`}
+	inputs := []string{"x\ny", "ab\ncd", "one\ntwo\n", "x\n\nx\n", "\n"}
+	for _, pattern := range patterns {
+		re := MustCompile(pattern, 0)
+		for _, input := range inputs {
+			want := re.FindAllStringIndex(input, -1)
+
+			var got [][]int
+			sm := NewStreamMatcher(re, func(m *Match, offset int) error {
+				got = append(got, []int{offset + m.Index, offset + m.Index + m.Length})
+				return nil
+			})
+			for _, line := range strings.SplitAfter(input, "\n") {
+				if _, err := sm.Write([]byte(line)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := sm.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%v on %q by lines: wanted %v, got %v", pattern, input, want, got)
+			}
+		}
+	}
+}
+
+func TestStreamMatcher_Memory(t *testing.T) {
+	n := 0
+	sm := NewStreamMatcher(MustCompile(`ERROR: \w+`, 0), func(m *Match, offset int) error {
+		n++
+		return nil
+	})
+	line := strings.Repeat("info: all is well ", 10) + "\n"
+	for i := 0; i < 1000; i++ {
+		sm.Write([]byte(line))
+		if i%100 == 0 {
+			sm.WriteRunes([]rune("ERROR: disk full\n"))
+		}
+		if len(sm.buf) > len(line)+DefaultStreamLookbehind {
+			t.Fatalf("kept %v runes", len(sm.buf))
+		}
+	}
+	sm.Close()
+	if n != 10 {
+		t.Errorf("wanted 10 matches, got %v", n)
+	}
+}
+
+func TestStreamMatcher_Errors(t *testing.T) {
+	stop := errors.New("stop")
+	sm := NewStreamMatcher(MustCompile(`\d`, 0), func(m *Match, offset int) error {
+		return stop
+	})
+	if _, err := sm.Write([]byte("a1b2")); err != stop {
+		t.Errorf("wanted the function's error, got %v", err)
+	}
+	if err := sm.Close(); err != stop {
+		t.Errorf("wanted the error again, got %v", err)
+	}
+
+	sm = NewStreamMatcher(MustCompile(`\d`, RightToLeft), func(m *Match, offset int) error { return nil })
+	if _, err := sm.Write([]byte("1")); err == nil {
+		t.Error("expected an error for RightToLeft")
+	}
+
+	sm = NewStreamMatcher(MustCompile(`\d`, 0), func(m *Match, offset int) error { return nil })
+	sm.Close()
+	if _, err := sm.Write([]byte("1")); err == nil {
+		t.Error("expected an error after Close")
+	}
+}
@@ -0,0 +1,280 @@
+package syntax
+
+import (
+	"math"
+	"strconv"
+)
+
+// Op is the kind of a Node.
+type Op uint8
+
+const (
+	OpNothing               Op = iota + 1 // matches nothing at all
+	OpEmpty                               // matches the empty string
+	OpLiteral                             // the Runes, one after another
+	OpCharClass                           // a rune in Set
+	OpBeginLine                           // ^ with Multiline
+	OpEndLine                             // $ with Multiline
+	OpBeginText                           // \A, or ^ without Multiline
+	OpEndTextNewline                      // \Z, or $ without Multiline: the end, or before a final \n
+	OpEndText                             // \z
+	OpStartMatch                          // \G, where the search started
+	OpWordBoundary                        // \b
+	OpNoWordBoundary                      // \B
+	OpECMAWordBoundary                    // \b with ECMAScript, whose word characters don't include joiners
+	OpNoECMAWordBoundary                  // \B with ECMAScript
+	OpWordSegmentBoundary                 // \b{wb}
+	OpNoWordSegmentBoundary               // \B{wb}
+	OpGraphemeBoundary                    // \b{g}
+	OpNoGraphemeBoundary                  // \B{g}
+	OpGrapheme                            // \X, a grapheme cluster
+	OpBackref                             // the text Group captured
+	OpConcat                              // the Children, one after another
+	OpAlternate                           // one of the Children, tried in order
+	OpRepeat                              // Children[0], Min to Max times
+	OpCapture                             // Children[0], captured as Group
+	OpLookahead                           // (?=Children[0])
+	OpNegativeLookahead                   // (?!Children[0])
+	OpLookbehind                          // (?<=Children[0])
+	OpNegativeLookbehind                  // (?<!Children[0])
+	OpAtomic                              // (?>Children[0])
+	OpCondCapture                         // Children[0] if Group has captured, else Children[1] if there is one
+	OpCond                                // Children[1] if Children[0] matches ahead, else Children[2] if there is one
+	OpCall                                // Group's pattern run as a subroutine, or the whole pattern's for group 0
+	OpVerb                                // a backtracking control verb
+)
+
+var opNames = []string{
+	"", "Nothing", "Empty", "Literal", "CharClass",
+	"BeginLine", "EndLine", "BeginText", "EndTextNewline", "EndText", "StartMatch",
+	"WordBoundary", "NoWordBoundary", "ECMAWordBoundary", "NoECMAWordBoundary",
+	"WordSegmentBoundary", "NoWordSegmentBoundary", "GraphemeBoundary", "NoGraphemeBoundary",
+	"Grapheme", "Backref", "Concat", "Alternate", "Repeat", "Capture",
+	"Lookahead", "NegativeLookahead", "Lookbehind", "NegativeLookbehind", "Atomic",
+	"CondCapture", "Cond", "Call", "Verb",
+}
+
+func (op Op) String() string {
+	if int(op) < len(opNames) && op > 0 {
+		return opNames[op]
+	}
+	return "Op(" + strconv.Itoa(int(op)) + ")"
+}
+
+// Node is a node of the syntax tree of a pattern, a public and stable form
+// of what the parser produces for the compiler.  It's the tree after the
+// simplifications the parser makes: non-capturing groups are gone and,
+// with DefaultOptimizations, a|b is a CharClass of [ab], (?:a+)+ is just
+// a+ and adjacent runes are one Literal.  Under IgnoreCase, Runes and Set
+// are case folded.
+type Node struct {
+	Op       Op
+	Flags    RegexOptions // the options in effect where the node was parsed
+	Children []*Node
+
+	Runes     []rune   // the text of OpLiteral, or the name of OpVerb
+	Set       *CharSet // the runes of OpCharClass
+	Min, Max  int      // the bounds of OpRepeat; Max is -1 for no limit
+	Lazy      bool     // OpRepeat prefers fewer repetitions
+	Group     int      // the group of OpCapture, OpBackref, OpCondCapture and OpCall
+	Name      string   // Group's name, if it has one
+	Uncapture int      // the group a balancing OpCapture pops, if not 0; Group is -1 if it only pops
+	Verb      int      // the kind of OpVerb: VerbCommit, VerbPrune, VerbSkip or VerbMark
+}
+
+// AST returns the tree as Nodes.  Its root is the pattern itself, which
+// is implicitly group 0.
+func (t *RegexTree) AST() *Node {
+	names := map[int]string{}
+	for name, num := range t.Capnames {
+		if name != strconv.Itoa(num) {
+			names[num] = name
+		}
+	}
+	return t.root.children[0].ast(names)
+}
+
+func (n *regexNode) ast(names map[int]string) *Node {
+	flags := n.options &^ noOptimizations
+	node := &Node{Flags: flags}
+	leaf := func(t nodeType) *Node {
+		if t == ntOne {
+			return &Node{Op: OpLiteral, Flags: flags, Runes: []rune{n.ch}}
+		}
+		set := n.set
+		if t == ntNotone {
+			set = &CharSet{negate: true, ranges: []singleRange{{n.ch, n.ch}}}
+		}
+		return &Node{Op: OpCharClass, Flags: flags, Set: set}
+	}
+	group := func(op Op, num int) {
+		node.Op = op
+		node.Group = num
+		node.Name = names[num]
+	}
+
+	switch n.t {
+	case ntOnerep, ntNotonerep, ntSetrep, ntOneloop, ntNotoneloop, ntSetloop, ntOnelazy, ntNotonelazy, ntSetlazy:
+		node.Op = OpRepeat
+		node.Min, node.Max = n.m, n.n
+		node.Lazy = n.t >= ntOnelazy
+		node.Children = []*Node{leaf(ntOne + n.t%3)}
+	case ntOne, ntNotone, ntSet:
+		return leaf(n.t)
+	case ntMulti:
+		node.Op = OpLiteral
+		node.Runes = n.str
+	case ntRef:
+		group(OpBackref, n.m)
+	case ntBol:
+		node.Op = OpBeginLine
+	case ntEol:
+		node.Op = OpEndLine
+	case ntBoundary:
+		node.Op = OpWordBoundary
+	case ntNonboundary:
+		node.Op = OpNoWordBoundary
+	case ntBeginning:
+		node.Op = OpBeginText
+	case ntStart:
+		node.Op = OpStartMatch
+	case ntEndZ:
+		node.Op = OpEndTextNewline
+	case ntEnd:
+		node.Op = OpEndText
+	case ntNothing:
+		node.Op = OpNothing
+	case ntEmpty:
+		node.Op = OpEmpty
+	case ntAlternate:
+		node.Op = OpAlternate
+	case ntConcatenate:
+		node.Op = OpConcat
+	case ntLoop, ntLazyloop:
+		node.Op = OpRepeat
+		node.Min, node.Max = n.m, n.n
+		node.Lazy = n.t == ntLazyloop
+	case ntCapture:
+		group(OpCapture, n.m)
+		if n.n > 0 {
+			node.Uncapture = n.n
+		}
+	case ntGroup:
+		// only left where there was nothing to reduce it to
+		return n.children[0].ast(names)
+	case ntRequire:
+		node.Op = OpLookahead
+		if n.options&RightToLeft != 0 {
+			node.Op = OpLookbehind
+		}
+	case ntPrevent:
+		node.Op = OpNegativeLookahead
+		if n.options&RightToLeft != 0 {
+			node.Op = OpNegativeLookbehind
+		}
+	case ntGreedy:
+		node.Op = OpAtomic
+	case ntTestref:
+		group(OpCondCapture, n.m)
+	case ntTestgroup:
+		node.Op = OpCond
+	case ntECMABoundary:
+		node.Op = OpECMAWordBoundary
+	case ntNonECMABoundary:
+		node.Op = OpNoECMAWordBoundary
+	case ntCall:
+		group(OpCall, n.m)
+	case ntVerb:
+		node.Op = OpVerb
+		node.Verb = n.m
+		node.Runes = n.str
+	case ntGrapheme:
+		node.Op = OpGrapheme
+	case ntWordSegBoundary:
+		node.Op = OpWordSegmentBoundary
+	case ntNonWordSegBoundary:
+		node.Op = OpNoWordSegmentBoundary
+	case ntGraphemeBoundary:
+		node.Op = OpGraphemeBoundary
+	case ntNonGraphemeBoundary:
+		node.Op = OpNoGraphemeBoundary
+	}
+	if node.Op == OpRepeat && node.Max == math.MaxInt32 {
+		node.Max = -1
+	}
+
+	for _, child := range n.children {
+		node.Children = append(node.Children, child.ast(names))
+	}
+	// the parser reverses right to left concatenations; put them back in
+	// the order they're written in
+	if n.t == ntConcatenate && n.options&RightToLeft != 0 {
+		c := node.Children
+		for i, j := 0, len(c)-1; i < j; i, j = i+1, j-1 {
+			c[i], c[j] = c[j], c[i]
+		}
+	}
+	return node
+}
+
+// A Visitor's Visit method is called by Walk for each node.  If the
+// Visitor w it returns isn't nil, Walk visits the node's children with w,
+// and then calls w.Visit(nil).
+type Visitor interface {
+	Visit(n *Node) (w Visitor)
+}
+
+// Walk traverses the tree from n depth-first, in the order the pattern is
+// written, as go/ast.Walk does.
+func Walk(v Visitor, n *Node) {
+	if v = v.Visit(n); v == nil {
+		return
+	}
+	for _, child := range n.Children {
+		Walk(v, child)
+	}
+	v.Visit(nil)
+}
+
+// Inspect walks the tree from n, calling f for each node and, after its
+// children, f(nil).  If f returns false its children aren't visited.
+func Inspect(n *Node, f func(*Node) bool) {
+	Walk(inspector(f), n)
+}
+
+type inspector func(*Node) bool
+
+func (f inspector) Visit(n *Node) Visitor {
+	if f(n) {
+		return f
+	}
+	return nil
+}
+
+// Rewrite returns the tree from n with nodes replaced by f, leaving n as
+// it was.  f is called for each node after its children have been
+// rewritten, and returns the node to put in its place: the node it was
+// given for no change, or a new one, which it mustn't make by modifying
+// the one given.  Returning nil removes the node from a concatenation or
+// alternation, and leaves an empty pattern in its place elsewhere.
+func Rewrite(n *Node, f func(*Node) *Node) *Node {
+	var children []*Node
+	changed := false
+	for _, child := range n.Children {
+		r := Rewrite(child, f)
+		changed = changed || r != child
+		if r == nil {
+			if n.Op == OpConcat || n.Op == OpAlternate {
+				continue
+			}
+			r = &Node{Op: OpEmpty, Flags: child.Flags}
+		}
+		children = append(children, r)
+	}
+	if changed {
+		copied := *n
+		copied.Children = children
+		n = &copied
+	}
+	return f(n)
+}
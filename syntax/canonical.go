@@ -0,0 +1,100 @@
+package syntax
+
+// the options that still change what a node matches once it's parsed
+const matchFlags = IgnoreCase | RightToLeft | ECMAScript | CultureInvariant
+
+// Canonical returns the tree from n in a normal form, so that patterns
+// that differ only in how they're written print the same.  Options that
+// make no difference to a node are cleared from its Flags, such as
+// IgnoreCase on runes that have no case and Multiline everywhere, as the
+// parser has already settled what ^ and $ mean.  Adjacent Literals are
+// joined, nested concatenations and alternations are flattened, and the
+// ranges of a class that its categories already cover are dropped, as in
+// [0-9\d].  Concatenations and alternations left with one child are
+// replaced by it.
+func Canonical(n *Node) *Node {
+	return Rewrite(n, func(n *Node) *Node {
+		c := *n
+		c.Flags &= matchFlags
+		if !cased(&c) {
+			c.Flags &^= IgnoreCase | CultureInvariant
+		}
+		if c.Op != OpBackref {
+			// only back references to groups that haven't matched differ
+			c.Flags &^= ECMAScript
+		}
+
+		switch c.Op {
+		case OpConcat:
+			c.Children = nil
+			for _, child := range flatten(n) {
+				last := len(c.Children) - 1
+				switch {
+				case child.Op == OpEmpty:
+				case child.Op == OpLiteral && last >= 0 && c.Children[last].Op == OpLiteral && c.Children[last].Flags == child.Flags:
+					joined := *c.Children[last]
+					joined.Runes = append(append([]rune(nil), joined.Runes...), child.Runes...)
+					c.Children[last] = &joined
+				default:
+					c.Children = append(c.Children, child)
+				}
+			}
+			switch len(c.Children) {
+			case 0:
+				return &Node{Op: OpEmpty, Flags: c.Flags}
+			case 1:
+				return c.Children[0]
+			}
+		case OpAlternate:
+			c.Children = flatten(n)
+			if len(c.Children) == 1 {
+				return c.Children[0]
+			}
+		case OpCharClass:
+			c.Set = uncoveredRanges(n.Set)
+		}
+		return &c
+	})
+}
+
+// flatten returns the children of n, with those of the same Op replaced
+// by their own children
+func flatten(n *Node) []*Node {
+	var children []*Node
+	for _, child := range n.Children {
+		if child.Op == n.Op {
+			children = append(children, flatten(child)...)
+		} else {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// the biggest range uncoveredRanges checks rune by rune
+const maxCoveredRange = 1 << 16
+
+// uncoveredRanges returns set without the ranges its categories match all
+// of, or set itself if there are none
+func uncoveredRanges(set *CharSet) *CharSet {
+	if len(set.categories) == 0 || len(set.ranges) == 0 {
+		return set
+	}
+	cats := &CharSet{categories: set.categories}
+	var ranges []singleRange
+	for _, r := range set.ranges {
+		covered := r.last-r.first < maxCoveredRange
+		for ch := r.first; covered && ch <= r.last; ch++ {
+			covered = cats.CharIn(ch)
+		}
+		if !covered {
+			ranges = append(ranges, r)
+		}
+	}
+	if len(ranges) == len(set.ranges) {
+		return set
+	}
+	c := set.Copy()
+	c.ranges = ranges
+	return &c
+}
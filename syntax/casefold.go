@@ -0,0 +1,280 @@
+package syntax
+
+import (
+	"unicode"
+)
+
+//go:generate go run gen_fold.go -ucd ucd -version 17.0.0
+
+// foldExceptions holds the runes whose case folding isn't their lowercase:
+// the ones like ſ, ς and ϑ that are lowercase already but share an
+// uppercase letter with another lowercase one (S, Σ, Θ), İ, whose
+// lowercase i isn't equal to it ignoring case, and Cherokee, which folds
+// to uppercase.  Everything else folds to unicode.ToLower.
+var foldExceptions = func() map[rune]rune {
+	m := map[rune]rune{}
+	add := func(r rune) {
+		if f := orbitFold(r); f != unicode.ToLower(r) {
+			m[r] = f
+		}
+	}
+	for _, cr := range unicode.CaseRanges {
+		for r := rune(cr.Lo); r <= rune(cr.Hi); r++ {
+			add(r)
+		}
+	}
+	// lowercase letters like ﬅ and ﬆ have no case mapping, but are equal
+	// ignoring case all the same
+	for _, r16 := range unicode.Ll.R16 {
+		for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+			add(r)
+		}
+	}
+	for _, r32 := range unicode.Ll.R32 {
+		for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+			add(r)
+		}
+	}
+	return m
+}()
+
+// the least and greatest of the foldExceptions keys, so most runes can
+// skip the map
+var foldExceptionsMin, foldExceptionsMax = func() (rune, rune) {
+	lo, hi := rune(unicode.MaxRune), rune(0)
+	for r := range foldExceptions {
+		if r < lo {
+			lo = r
+		}
+		if r > hi {
+			hi = r
+		}
+	}
+	return lo, hi
+}()
+
+// orbitFold folds r the slow way, as the C and S mappings of Unicode's
+// CaseFolding.txt do: to the lowercase of the first uppercase letter among
+// the runes that are equal to r ignoring case, its SimpleFold orbit, or
+// that letter itself for Cherokee.  A rune that's alone in its orbit folds
+// to itself, and one whose orbit has no uppercase letter to the greatest
+// rune in it.
+func orbitFold(r rune) rune {
+	upper, greatest := rune(-1), r
+	for c := unicode.SimpleFold(r); ; c = unicode.SimpleFold(c) {
+		if unicode.IsUpper(c) && (upper < 0 || c < upper) {
+			upper = c
+		}
+		if c == r {
+			break
+		}
+		greatest = max(greatest, c)
+	}
+	switch {
+	case unicode.SimpleFold(r) == r:
+		return r
+	case upper < 0:
+		// like ﬅ and ﬆ, which fold to the later one
+		return greatest
+	case unicode.Is(unicode.Cherokee, upper):
+		return upper
+	}
+	return unicode.ToLower(upper)
+}
+
+// CaseFold maps r to the rune IgnoreCase compares it as, Unicode's simple
+// case folding.  Runes that are equal ignoring case fold to the same rune,
+// which is their lowercase form except for the likes of ſ (folds to s), ς
+// (σ) and µ (μ), while İ only folds to itself.  Full case folding, where ß
+// is equal to ss, maps one rune to several; IgnoreCase does that for the
+// literals of a pattern, see fullFold.
+func CaseFold(r rune) rune {
+	if r <= unicode.MaxASCII {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return r
+	}
+	if r >= foldExceptionsMin && r <= foldExceptionsMax {
+		if f, ok := foldExceptions[r]; ok {
+			return f
+		}
+	}
+	return unicode.ToLower(r)
+}
+
+// CaseFoldCulture is CaseFold with the casing rules of a culture such as
+// unicode.TurkishCase, where I folds to ı and İ to i.  A nil culture is the
+// invariant one.
+func CaseFoldCulture(r rune, culture unicode.SpecialCase) rune {
+	for _, cr := range culture {
+		if rune(cr.Lo) <= r && r <= rune(cr.Hi) {
+			return culture.ToLower(r)
+		}
+	}
+	return CaseFold(r)
+}
+
+// addFoldExceptions adds the folded form of any of the foldExceptions
+// that fall in [first, last]
+func (c *CharSet) addFoldExceptions(first, last rune) {
+	if last < foldExceptionsMin || first > foldExceptionsMax {
+		return
+	}
+	for r, f := range foldExceptions {
+		if r >= first && r <= last {
+			c.ranges = append(c.ranges, singleRange{first: f, last: f})
+		}
+	}
+}
+
+// addCultureFolds adds how culture folds the runes in [first, last] that
+// it has rules of its own for
+func (c *CharSet) addCultureFolds(first, last rune, culture unicode.SpecialCase) {
+	for _, cr := range culture {
+		lo, hi := rune(cr.Lo), rune(cr.Hi)
+		if lo < first {
+			lo = first
+		}
+		if hi > last {
+			hi = last
+		}
+		for r := lo; r <= hi; r++ {
+			f := CaseFoldCulture(r, culture)
+			c.ranges = append(c.ranges, singleRange{first: f, last: f})
+		}
+	}
+}
+
+// foldSources maps each of the fullFolds to the runes that fold to it, as
+// ss to ß and ẞ
+var foldSources = func() map[string][]rune {
+	m := map[string][]rune{}
+	for r, f := range fullFolds {
+		m[f] = append(m[f], r)
+	}
+	return m
+}()
+
+// the most runes a rune fully folds to
+var maxFullFold = func() int {
+	n := 0
+	for _, f := range fullFolds {
+		n = max(n, len([]rune(f)))
+	}
+	return n
+}()
+
+// fullFold rewrites an IgnoreCase literal to also match what it's equal to
+// by full case folding: ß matches ss and SS, and ss, ß and ẞ, in text
+// either way round.  The other flavours, like .NET itself, compare rune by
+// rune.  Only literals are rewritten; classes, back references and
+// foldings that overlap each other, as ﬀi does ffi, are still compared by
+// simple case folding.
+func fullFold(n *regexNode) *regexNode {
+	if n.options&IgnoreCase == 0 || n.options&(ECMAScript|RE2|PCRE2|Python|Java) != 0 {
+		return n
+	}
+	switch n.t {
+	case ntOne:
+		if _, ok := fullFolds[n.ch]; ok {
+			return n.foldAlternate(n.ch)
+		}
+
+	case ntMulti:
+		return n.foldMulti()
+
+	case ntOneloop, ntOnelazy:
+		if _, ok := fullFolds[n.ch]; ok {
+			t := nodeType(ntLoop)
+			if n.t == ntOnelazy {
+				t = ntLazyloop
+			}
+			loop := newRegexNodeMN(t, n.options, n.m, n.n)
+			loop.pos, loop.end = n.pos, n.end
+			loop.addChild(n.foldAlternate(n.ch))
+			return loop
+		}
+	}
+	return n
+}
+
+// foldAlternate is ch, or the runes it fully folds to
+func (n *regexNode) foldAlternate(ch rune) *regexNode {
+	alt := newRegexNode(ntAlternate, n.options)
+	alt.pos, alt.end = n.pos, n.end
+	alt.addChild(newRegexNodeCh(ntOne, n.options, ch))
+	alt.addChild(newRegexNodeStr(ntMulti, n.options, []rune(fullFolds[ch])))
+	return alt
+}
+
+// foldMulti splits n's string into the runes that fully fold to several,
+// the runs of runes that some rune fully folds to, and the rest
+func (n *regexNode) foldMulti() *regexNode {
+	var pieces []*regexNode
+	plain := 0
+	for i := 0; i < len(n.str); {
+		var piece *regexNode
+		width := 1
+		if _, ok := fullFolds[n.str[i]]; ok {
+			piece = n.foldAlternate(n.str[i])
+		} else if fold, sources := foldAt(n.str[i:]); sources != nil {
+			// the text is folded before it's compared to the class,
+			// so the class holds the sources folded too
+			set := &CharSet{}
+			for _, r := range sources {
+				set.addChar(r)
+				set.addChar(CaseFold(r))
+			}
+			piece = newRegexNode(ntAlternate, n.options)
+			piece.addChild(newRegexNodeStr(ntMulti, n.options, fold))
+			piece.addChild(newRegexNodeSet(ntSet, n.options, set))
+			width = len(fold)
+		}
+		if piece == nil {
+			i++
+			continue
+		}
+		if plain < i {
+			pieces = append(pieces, n.literal(n.str[plain:i]))
+		}
+		pieces = append(pieces, piece)
+		i += width
+		plain = i
+	}
+	if pieces == nil {
+		return n
+	}
+	if plain < len(n.str) {
+		pieces = append(pieces, n.literal(n.str[plain:]))
+	}
+
+	concat := newRegexNode(ntConcatenate, n.options)
+	concat.pos, concat.end = n.pos, n.end
+	concat.children = pieces
+	for _, piece := range pieces {
+		piece.next = concat
+	}
+	// right to left, a concatenation's children are in the order they're
+	// matched in, while a string is in the order of the text
+	return concat.reverseLeft()
+}
+
+// literal is a One or Multi node, with n's options, for str
+func (n *regexNode) literal(str []rune) *regexNode {
+	if len(str) == 1 {
+		return newRegexNodeCh(ntOne, n.options, str[0])
+	}
+	return newRegexNodeStr(ntMulti, n.options, append([]rune(nil), str...))
+}
+
+// foldAt returns the longest of the fullFolds str starts with, and the
+// runes that fold to it
+func foldAt(str []rune) ([]rune, []rune) {
+	for l := min(len(str), maxFullFold); l > 1; l-- {
+		if sources, ok := foldSources[string(str[:l])]; ok {
+			return str[:l], sources
+		}
+	}
+	return nil, nil
+}
@@ -13,7 +13,8 @@ import (
 type CharSet struct {
 	ranges     []singleRange
 	categories []category
-	sub        *CharSet //optional subtractor
+	sets       []*CharSet // nested classes whose members are ours too (Java's [a-c[x-z]])
+	sub        *CharSet   //optional subtractor
 	negate     bool
 	anything   bool
 }
@@ -21,6 +22,20 @@ type CharSet struct {
 type category struct {
 	negate bool
 	cat    string
+	table  *unicode.RangeTable // looked up when the set is built; nil for \s and \w
+	fn     func(rune) bool     // for classes from RegisterClass, instead of table
+}
+
+func newCategory(cat string, negate bool) category {
+	table, _ := lookupProperty(cat)
+	return category{cat: cat, negate: negate, table: table, fn: lookupClassFunc(cat)}
+}
+
+func (ct category) contains(ch rune) bool {
+	if ct.fn != nil {
+		return ct.fn(ch)
+	}
+	return unicode.Is(ct.table, ch)
 }
 
 type singleRange struct {
@@ -37,11 +52,15 @@ var (
 	ecmaSpace = []rune{0x0009, 0x000e, 0x0020, 0x0021, 0x00a0, 0x00a1, 0x1680, 0x1681, 0x2000, 0x200b, 0x2028, 0x202a, 0x202f, 0x2030, 0x205f, 0x2060, 0x3000, 0x3001, 0xfeff, 0xff00}
 	ecmaWord  = []rune{0x0030, 0x003a, 0x0041, 0x005b, 0x005f, 0x0060, 0x0061, 0x007b}
 	ecmaDigit = []rune{0x0030, 0x003a}
+	pcreSpace = []rune{0x0009, 0x000e, 0x0020, 0x0021}
+	// \h and \v as PCRE and Java define them
+	horizSpace = []rune{0x0009, 0x000a, 0x0020, 0x0021, 0x00a0, 0x00a1, 0x1680, 0x1681, 0x180e, 0x180f, 0x2000, 0x200b, 0x202f, 0x2030, 0x205f, 0x2060, 0x3000, 0x3001}
+	vertSpace  = []rune{0x000a, 0x000e, 0x0085, 0x0086, 0x2028, 0x202a}
 )
 
 var (
 	AnyClass          = getCharSetFromOldString([]rune{0}, false)
-	ECMAAnyClass      = getCharSetFromOldString([]rune{0, 0x000a, 0x000b, 0x000d, 0x000e}, false)
+	ECMAAnyClass      = getCharSetFromOldString([]rune{0, 0x000a, 0x000b, 0x000d, 0x000e, 0x2028, 0x202a}, false)
 	NoneClass         = getCharSetFromOldString(nil, false)
 	ECMAWordClass     = getCharSetFromOldString(ecmaWord, false)
 	NotECMAWordClass  = getCharSetFromOldString(ecmaWord, true)
@@ -49,6 +68,13 @@ var (
 	NotECMASpaceClass = getCharSetFromOldString(ecmaSpace, true)
 	ECMADigitClass    = getCharSetFromOldString(ecmaDigit, false)
 	NotECMADigitClass = getCharSetFromOldString(ecmaDigit, true)
+	PCRESpaceClass    = getCharSetFromOldString(pcreSpace, false)
+	NotPCRESpaceClass = getCharSetFromOldString(pcreSpace, true)
+
+	HorizSpaceClass    = getCharSetFromOldString(horizSpace, false)
+	NotHorizSpaceClass = getCharSetFromOldString(horizSpace, true)
+	VertSpaceClass     = getCharSetFromOldString(vertSpace, false)
+	NotVertSpaceClass  = getCharSetFromOldString(vertSpace, true)
 
 	WordClass     = getCharSetFromCategoryString(false, false, wordCategoryText)
 	NotWordClass  = getCharSetFromCategoryString(true, false, wordCategoryText)
@@ -69,6 +95,12 @@ var unicodeCategories = func() map[string]*unicode.RangeTable {
 	for k, v := range unicode.Properties {
 		retVal[k] = v
 	}
+	// tables generated from the UCD with the regexp2_ucd build tag
+	for _, tables := range []map[string]*unicode.RangeTable{ucdScripts, ucdCategories, ucdProperties} {
+		for k, v := range tables {
+			retVal[k] = v
+		}
+	}
 	return retVal
 }()
 
@@ -79,13 +111,13 @@ func getCharSetFromCategoryString(negateSet bool, negateCat bool, cats ...string
 
 	c := CharSet{negate: negateSet}
 
-	c.categories = make([]category, len(cats))
-	for i, cat := range cats {
-		c.categories[i] = category{cat: cat, negate: negateCat}
-	}
 	return func() *CharSet {
-		//make a copy each time
+		//make a copy each time, with the tables registered right now
 		local := c
+		local.categories = make([]category, len(cats))
+		for i, cat := range cats {
+			local.categories[i] = newCategory(cat, negateCat)
+		}
 		//return that address
 		return &local
 	}
@@ -149,6 +181,10 @@ func (c CharSet) Copy() CharSet {
 
 	ret.ranges = append(ret.ranges, c.ranges...)
 	ret.categories = append(ret.categories, c.categories...)
+	for _, s := range c.sets {
+		set := s.Copy()
+		ret.sets = append(ret.sets, &set)
+	}
 
 	if c.sub != nil {
 		sub := c.sub.Copy()
@@ -183,6 +219,10 @@ func (c CharSet) String() string {
 		buf.WriteString(c.String())
 	}
 
+	for _, s := range c.sets {
+		buf.WriteString(s.String())
+	}
+
 	if c.sub != nil {
 		buf.WriteRune('-')
 		buf.WriteString(c.sub.String())
@@ -203,6 +243,7 @@ func (c CharSet) mapHashFill(buf *bytes.Buffer) {
 
 	binary.Write(buf, binary.LittleEndian, len(c.ranges))
 	binary.Write(buf, binary.LittleEndian, len(c.categories))
+	binary.Write(buf, binary.LittleEndian, len(c.sets))
 	for _, r := range c.ranges {
 		buf.WriteRune(r.first)
 		buf.WriteRune(r.last)
@@ -215,6 +256,9 @@ func (c CharSet) mapHashFill(buf *bytes.Buffer) {
 			buf.WriteByte(0)
 		}
 	}
+	for _, s := range c.sets {
+		s.mapHashFill(buf)
+	}
 
 	if c.sub != nil {
 		c.sub.mapHashFill(buf)
@@ -260,7 +304,7 @@ func (c CharSet) CharIn(ch rune) bool {
 					val = true
 					break
 				}
-			} else if unicode.Is(unicodeCategories[ct.cat], ch) {
+			} else if ct.contains(ch) {
 				// if we're in this unicode category then we're done
 				// if negate=true on this category then we "failed" our test
 				// otherwise we're good that we found it
@@ -273,6 +317,16 @@ func (c CharSet) CharIn(ch rune) bool {
 		}
 	}
 
+	// then the nested classes
+	if !val {
+		for _, s := range c.sets {
+			if s.CharIn(ch) {
+				val = true
+				break
+			}
+		}
+	}
+
 	// negate the whole char set
 	if c.negate {
 		val = !val
@@ -300,7 +354,7 @@ func (c category) String() string {
 		}
 		return "\\w"
 	}
-	if _, ok := unicodeCategories[c.cat]; ok {
+	if c.table != nil || c.fn != nil {
 
 		if c.negate {
 			return "\\P{" + c.cat + "}"
@@ -358,14 +412,14 @@ func (c CharSet) SingletonChar() rune {
 func (c CharSet) IsSingleton() bool {
 	return !c.negate && //negated is multiple chars
 		len(c.categories) == 0 && len(c.ranges) == 1 && // multiple ranges and unicode classes represent multiple chars
-		c.sub == nil && // subtraction means we've got multiple chars
+		len(c.sets) == 0 && c.sub == nil && // nested sets or subtraction means we've got multiple chars
 		c.ranges[0].first == c.ranges[0].last // first and last equal means we're just 1 char
 }
 
 func (c CharSet) IsSingletonInverse() bool {
 	return c.negate && //same as above, but requires negated
 		len(c.categories) == 0 && len(c.ranges) == 1 && // multiple ranges and unicode classes represent multiple chars
-		c.sub == nil && // subtraction means we've got multiple chars
+		len(c.sets) == 0 && c.sub == nil && // nested sets or subtraction means we've got multiple chars
 		c.ranges[0].first == c.ranges[0].last // first and last equal means we're just 1 char
 }
 
@@ -381,8 +435,59 @@ func (c CharSet) HasSubtraction() bool {
 	return c.sub != nil
 }
 
+// HasNestedSets says whether c has other classes inside it, as the union
+// and intersection of Java's syntax make.
+func (c CharSet) HasNestedSets() bool {
+	return len(c.sets) > 0 || c.sub != nil && len(c.sub.sets) > 0
+}
+
+// ASCIISet is a table of which runes below 0x80 a CharSet has, so a loop
+// over ASCII text can look each one up with a shift and a mask.
+type ASCIISet [2]uint64
+
+// ASCII builds c's table.
+func (c CharSet) ASCII() ASCIISet {
+	var s ASCIISet
+	for ch := rune(0); ch < 0x80; ch++ {
+		if c.CharIn(ch) {
+			s[ch>>6] |= 1 << (ch & 63)
+		}
+	}
+	return s
+}
+
+// Has says if ch is ASCII and in the set.
+func (s *ASCIISet) Has(ch rune) bool {
+	return uint32(ch) < 0x80 && s[ch>>6]&(1<<(ch&63)) != 0
+}
+
 func (c CharSet) IsEmpty() bool {
-	return len(c.ranges) == 0 && len(c.categories) == 0 && c.sub == nil
+	return len(c.ranges) == 0 && len(c.categories) == 0 && len(c.sets) == 0 && c.sub == nil
+}
+
+// RuneRanges returns the runes c matches as sorted ranges, each a first
+// and last rune, with gaps between them.  Unless c is only ranges, it's
+// worked out by trying each rune, which is slow.
+func (c CharSet) RuneRanges() [][2]rune {
+	var ranges [][2]rune
+	if !c.negate && len(c.categories) == 0 && len(c.sets) == 0 && c.sub == nil {
+		cp := c.Copy()
+		cp.canonicalize()
+		for _, r := range cp.ranges {
+			ranges = append(ranges, [2]rune{r.first, r.last})
+		}
+		return ranges
+	}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if c.CharIn(r) {
+			first := r
+			for r < unicode.MaxRune && c.CharIn(r+1) {
+				r++
+			}
+			ranges = append(ranges, [2]rune{first, r})
+		}
+	}
+	return ranges
 }
 
 func (c *CharSet) addDigit(ecma, negate bool, pattern string) {
@@ -393,7 +498,7 @@ func (c *CharSet) addDigit(ecma, negate bool, pattern string) {
 			c.addRanges(ECMADigitClass().ranges)
 		}
 	} else {
-		c.addCategories(category{cat: "Nd", negate: negate})
+		c.addCategories(newCategory("Nd", negate))
 	}
 }
 
@@ -409,7 +514,32 @@ func (c *CharSet) addSpace(ecma, negate bool) {
 			c.addRanges(ECMASpaceClass().ranges)
 		}
 	} else {
-		c.addCategories(category{cat: spaceCategoryText, negate: negate})
+		c.addCategories(newCategory(spaceCategoryText, negate))
+	}
+}
+
+// addPCRESpace adds PCRE2's ASCII-only \s, [\t\n\v\f\r ]
+func (c *CharSet) addPCRESpace(negate bool) {
+	if negate {
+		c.addRanges(NotPCRESpaceClass().ranges)
+	} else {
+		c.addRanges(PCRESpaceClass().ranges)
+	}
+}
+
+func (c *CharSet) addHorizSpace(negate bool) {
+	if negate {
+		c.addRanges(NotHorizSpaceClass().ranges)
+	} else {
+		c.addRanges(HorizSpaceClass().ranges)
+	}
+}
+
+func (c *CharSet) addVertSpace(negate bool) {
+	if negate {
+		c.addRanges(NotVertSpaceClass().ranges)
+	} else {
+		c.addRanges(VertSpaceClass().ranges)
 	}
 }
 
@@ -421,7 +551,7 @@ func (c *CharSet) addWord(ecma, negate bool) {
 			c.addRanges(ECMAWordClass().ranges)
 		}
 	} else {
-		c.addCategories(category{cat: wordCategoryText, negate: negate})
+		c.addCategories(newCategory(wordCategoryText, negate))
 	}
 }
 
@@ -437,6 +567,7 @@ func (c *CharSet) addSet(set CharSet) {
 	// just append here to prevent double-canon
 	c.ranges = append(c.ranges, set.ranges...)
 	c.addCategories(set.categories...)
+	c.sets = append(c.sets, set.sets...)
 	c.canonicalize()
 }
 
@@ -508,8 +639,8 @@ func (c *CharSet) addNegativeRanges(ranges []singleRange) {
 }
 
 func isValidUnicodeCat(catName string) bool {
-	_, ok := unicodeCategories[catName]
-	return ok
+	_, ok := lookupProperty(catName)
+	return ok || lookupClassFunc(catName) != nil
 }
 
 func (c *CharSet) addCategory(categoryName string, negate, caseInsensitive bool, pattern string) {
@@ -522,17 +653,37 @@ func (c *CharSet) addCategory(categoryName string, negate, caseInsensitive bool,
 	if caseInsensitive && (categoryName == "Ll" || categoryName == "Lu" || categoryName == "Lt") {
 		// when RegexOptions.IgnoreCase is specified then {Ll} {Lu} and {Lt} cases should all match
 		c.addCategories(
-			category{cat: "Ll", negate: negate},
-			category{cat: "Lu", negate: negate},
-			category{cat: "Lt", negate: negate})
+			newCategory("Ll", negate),
+			newCategory("Lu", negate),
+			newCategory("Lt", negate))
 	}
-	c.addCategories(category{cat: categoryName, negate: negate})
+	c.addCategories(newCategory(categoryName, negate))
 }
 
 func (c *CharSet) addSubtraction(sub *CharSet) {
 	c.sub = sub
 }
 
+// addNested makes the members of a nested class ours too
+func (c *CharSet) addNested(set *CharSet) {
+	if set.IsMergeable() {
+		c.addSet(*set)
+		return
+	}
+	c.sets = append(c.sets, set)
+}
+
+// complementSet returns the set of every rune not in set
+func complementSet(set *CharSet) *CharSet {
+	return &CharSet{ranges: AnyClass().ranges, sub: set}
+}
+
+// intersectSets returns the set of runes in both a and b, which is what
+// is left of a once everything outside b is subtracted
+func intersectSets(a, b *CharSet) *CharSet {
+	return &CharSet{sets: []*CharSet{a}, sub: complementSet(b)}
+}
+
 func (c *CharSet) addRange(chMin, chMax rune) {
 	c.ranges = append(c.ranges, singleRange{first: chMin, last: chMax})
 	c.canonicalize()
@@ -639,9 +790,11 @@ func (c *CharSet) canonicalize() {
 	}
 }
 
-// Adds to the class any lowercase versions of characters already
-// in the class. Used for case-insensitivity.
-func (c *CharSet) addLowercase() {
+// Adds to the class the case folded versions of characters already
+// in the class. Used for case-insensitivity.  invariant, for
+// CultureInvariant, leaves out İ's lowercase i, which .NET's table has
+// but isn't a case folding.
+func (c *CharSet) addLowercase(culture unicode.SpecialCase, invariant bool) {
 	if c.anything {
 		return
 	}
@@ -649,7 +802,7 @@ func (c *CharSet) addLowercase() {
 	for i := 0; i < len(c.ranges); i++ {
 		r := c.ranges[i]
 		if r.first == r.last {
-			lower := unicode.ToLower(r.first)
+			lower := CaseFoldCulture(r.first, culture)
 			c.ranges[i] = singleRange{first: lower, last: lower}
 		} else {
 			toAdd = append(toAdd, r)
@@ -657,7 +810,9 @@ func (c *CharSet) addLowercase() {
 	}
 
 	for _, r := range toAdd {
-		c.addLowercaseRange(r.first, r.last)
+		c.addLowercaseRange(r.first, r.last, invariant)
+		c.addFoldExceptions(r.first, r.last)
+		c.addCultureFolds(r.first, r.last, culture)
 	}
 	c.canonicalize()
 }
@@ -799,7 +954,7 @@ var lcTable = []lcMap{
 	lcMap{'\uFF21', '\uFF3A', LowercaseAdd, 32},
 }
 
-func (c *CharSet) addLowercaseRange(chMin, chMax rune) {
+func (c *CharSet) addLowercaseRange(chMin, chMax rune, invariant bool) {
 	var i, iMax, iMid int
 	var chMinT, chMaxT rune
 	var lc lcMap
@@ -818,6 +973,9 @@ func (c *CharSet) addLowercaseRange(chMin, chMax rune) {
 		if lc.chMin > chMax {
 			return
 		}
+		if invariant && lc.chMin == '\u0130' {
+			continue
+		}
 		chMinT = lc.chMin
 		if chMinT < chMin {
 			chMinT = chMin
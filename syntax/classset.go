@@ -0,0 +1,395 @@
+package syntax
+
+import (
+	"sort"
+)
+
+// classSet is a character class in UnicodeSets (ECMAScript v flag) mode.
+// Besides single code points it can hold strings, from \q{...} and the
+// properties of strings, which are matched longest first.
+type classSet struct {
+	set  *CharSet
+	strs map[string]bool
+}
+
+func newClassSet() *classSet {
+	return &classSet{set: &CharSet{}, strs: map[string]bool{}}
+}
+
+// addString adds s, which goes into the set when it's a single rune
+func (c *classSet) addString(s []rune) {
+	if len(s) == 1 {
+		c.set.addChar(s[0])
+		return
+	}
+	c.strs[string(s)] = true
+}
+
+func (c *classSet) union(o *classSet) {
+	c.set.addNested(o.set)
+	for s := range o.strs {
+		c.strs[s] = true
+	}
+}
+
+func (c *classSet) difference(o *classSet) *classSet {
+	ret := &classSet{set: &CharSet{sets: []*CharSet{c.set}, sub: o.set}, strs: map[string]bool{}}
+	for s := range c.strs {
+		if !o.strs[s] {
+			ret.strs[s] = true
+		}
+	}
+	return ret
+}
+
+func (c *classSet) intersection(o *classSet) *classSet {
+	ret := &classSet{set: intersectSets(c.set, o.set), strs: map[string]bool{}}
+	for s := range c.strs {
+		if o.strs[s] {
+			ret.strs[s] = true
+		}
+	}
+	return ret
+}
+
+// the class set operators; mixing them needs nested classes
+const (
+	classSetUnion = iota
+	classSetDifference
+	classSetIntersection
+)
+
+// classSetSyntaxChars must be escaped inside a v mode class
+const classSetSyntaxChars = "(){}/|"
+
+// classSetReservedDoubles can't appear twice in a row inside a v mode class
+const classSetReservedDoubles = "&!#$%*+,.:;<=>?@^`~"
+
+// scanClassSet scans the contents of a v mode [...] class, the opening
+// bracket already consumed, and returns the node that matches it.
+func (p *parser) scanClassSet(caseInsensitive, scanOnly bool) (*regexNode, error) {
+	cs, err := p.scanClassSetContents(caseInsensitive)
+	if err != nil || scanOnly {
+		return nil, err
+	}
+
+	if len(cs.strs) == 0 {
+		return newRegexNodeSet(ntSet, p.options, cs.set), nil
+	}
+
+	strs := make([][]rune, 0, len(cs.strs))
+	for s := range cs.strs {
+		strs = append(strs, []rune(s))
+	}
+	sort.Slice(strs, func(i, j int) bool {
+		if len(strs[i]) != len(strs[j]) {
+			return len(strs[i]) > len(strs[j])
+		}
+		return string(strs[i]) < string(strs[j])
+	})
+
+	// single code points go after the longer strings, but before ""
+	alt := newRegexNode(ntAlternate, p.options)
+	for _, s := range strs {
+		if len(s) > 0 {
+			alt.addChild(newRegexNodeStr(ntMulti, p.options, s))
+		}
+	}
+	if !cs.set.IsEmpty() {
+		alt.addChild(newRegexNodeSet(ntSet, p.options, cs.set))
+	}
+	if cs.strs[""] {
+		alt.addChild(newRegexNode(ntEmpty, p.options))
+	}
+	group := newRegexNode(ntGroup, p.options)
+	group.addChild(alt)
+	return group, nil
+}
+
+func (p *parser) scanClassSetContents(caseInsensitive bool) (*classSet, error) {
+	start := p.textpos() - 1 // the [
+	negate := false
+	if p.charsRight() > 0 && p.rightChar(0) == '^' {
+		p.moveRight(1)
+		negate = true
+	}
+
+	cs := newClassSet()
+	op := classSetUnion
+	operands := 0
+	sawRange := false
+
+	for {
+		if p.charsRight() == 0 {
+			return nil, p.getErrAt(start, ErrUnterminatedBracket)
+		}
+		p.tokenPos = p.textpos()
+		ch := p.rightChar(0)
+		if ch == ']' {
+			p.moveRight(1)
+			break
+		}
+
+		// -- and && combine the class so far with the next operand
+		if p.charsRight() > 1 && (ch == '-' || ch == '&') && p.rightChar(1) == ch {
+			next := classSetDifference
+			if ch == '&' {
+				next = classSetIntersection
+			}
+			if (op != classSetUnion && op != next) || (op == classSetUnion && (operands != 1 || sawRange)) {
+				return nil, p.getErr(ErrClassSetOperation)
+			}
+			op = next
+			p.moveRight(2)
+			if p.charsRight() > 0 && p.rightChar(0) == ']' {
+				return nil, p.getErr(ErrClassSetOperation)
+			}
+			right, isRange, err := p.scanClassSetOperand(caseInsensitive)
+			if err != nil {
+				return nil, err
+			}
+			if isRange {
+				return nil, p.getErr(ErrClassSetOperation)
+			}
+			if op == classSetDifference {
+				cs = cs.difference(right)
+			} else {
+				cs = cs.intersection(right)
+			}
+			continue
+		}
+
+		if op != classSetUnion {
+			// [a--b c] needs to be written [[a--b]c]
+			return nil, p.getErr(ErrClassSetOperation)
+		}
+		operand, isRange, err := p.scanClassSetOperand(caseInsensitive)
+		if err != nil {
+			return nil, err
+		}
+		cs.union(operand)
+		operands++
+		sawRange = sawRange || isRange
+	}
+
+	if negate {
+		if len(cs.strs) > 0 {
+			return nil, p.getErrAt(start, ErrNegatedClassStrings)
+		}
+		cs.set = complementSet(cs.set)
+	}
+	return cs, nil
+}
+
+// scanClassSetOperand scans a nested class, an escape, a \q{...} string
+// list, or a single character or range of them
+func (p *parser) scanClassSetOperand(caseInsensitive bool) (cs *classSet, isRange bool, err error) {
+	cs = newClassSet()
+	p.tokenPos = p.textpos()
+	ch := p.moveRightGetChar()
+
+	switch {
+	case ch == '[':
+		cs, err = p.scanClassSetContents(caseInsensitive)
+		return cs, false, err
+
+	case ch == '\\' && p.charsRight() > 0:
+		switch p.rightChar(0) {
+		case 'd', 'D':
+			ch = p.moveRightGetChar()
+			cs.set.addDigit(true, ch == 'D', p.patternRaw)
+			return cs, false, nil
+		case 's', 'S':
+			ch = p.moveRightGetChar()
+			cs.set.addSpace(true, ch == 'S')
+			return cs, false, nil
+		case 'w', 'W':
+			ch = p.moveRightGetChar()
+			cs.set.addWord(true, ch == 'W')
+			return cs, false, nil
+		case 'p', 'P':
+			ch = p.moveRightGetChar()
+			if strs, ok := p.scanStringProperty(); ok {
+				if ch == 'P' {
+					return nil, false, p.getErr(ErrNegatedClassStrings)
+				}
+				for _, s := range strs {
+					cs.addString(s)
+				}
+				return cs, false, nil
+			}
+			prop, err := p.parseProperty()
+			if err != nil {
+				return nil, false, err
+			}
+			cs.set.addCategory(prop, ch == 'P', caseInsensitive, p.patternRaw)
+			return cs, false, nil
+		case 'q':
+			p.moveRight(1)
+			if err := p.scanClassStrings(cs, caseInsensitive); err != nil {
+				return nil, false, err
+			}
+			return cs, false, nil
+		}
+		if ch, err = p.scanCharEscape(); err != nil {
+			return nil, false, err
+		}
+
+	default:
+		if err := p.checkClassSetChar(ch); err != nil {
+			return nil, false, err
+		}
+	}
+
+	last := ch
+	if p.charsRight() > 1 && p.rightChar(0) == '-' && p.rightChar(1) != '-' && p.rightChar(1) != ']' {
+		p.moveRight(1)
+		if last, err = p.scanClassSetChar(); err != nil {
+			return nil, false, err
+		}
+		if ch > last {
+			return nil, false, p.getErr(ErrReversedCharRange)
+		}
+		isRange = true
+	}
+	cs.set.addRange(ch, last)
+	if caseInsensitive {
+		cs.set.addLowercase(p.specialCase, p.useOptionInvariant())
+	}
+	return cs, isRange, nil
+}
+
+// scanClassSetChar scans a single, possibly escaped, character
+func (p *parser) scanClassSetChar() (rune, error) {
+	if p.charsRight() == 0 {
+		return 0, p.getErr(ErrUnterminatedBracket)
+	}
+	ch := p.moveRightGetChar()
+	if ch == '\\' && p.charsRight() > 0 {
+		if c := p.rightChar(0); c == 'd' || c == 'D' || c == 's' || c == 'S' || c == 'w' || c == 'W' || c == 'p' || c == 'P' || c == 'q' {
+			return 0, p.getErr(ErrBadClassInCharRange, string(c))
+		}
+		return p.scanCharEscape()
+	}
+	if ch == '[' || ch == ']' {
+		return 0, p.getErr(ErrClassSetSyntaxChar, string(ch))
+	}
+	return ch, p.checkClassSetChar(ch)
+}
+
+// checkClassSetChar rejects the characters v mode wants escaped
+func (p *parser) checkClassSetChar(ch rune) error {
+	for _, c := range classSetSyntaxChars {
+		if ch == c {
+			return p.getErr(ErrClassSetSyntaxChar, string(ch))
+		}
+	}
+	if ch == '-' {
+		return p.getErr(ErrClassSetSyntaxChar, string(ch))
+	}
+	if p.charsRight() > 0 && p.rightChar(0) == ch {
+		for _, c := range classSetReservedDoubles {
+			if ch == c {
+				return p.getErr(ErrClassSetSyntaxChar, string([]rune{ch, ch}))
+			}
+		}
+	}
+	return nil
+}
+
+// scanClassStrings scans the {a|bc|...} of a \q, adding each alternative
+func (p *parser) scanClassStrings(cs *classSet, caseInsensitive bool) error {
+	if p.charsRight() == 0 || p.moveRightGetChar() != '{' {
+		return p.getErr(ErrMalformedClassString)
+	}
+
+	var cur []rune
+	for {
+		if p.charsRight() == 0 {
+			return p.getErr(ErrMalformedClassString)
+		}
+		ch := p.moveRightGetChar()
+		switch {
+		case ch == '}' || ch == '|':
+			if caseInsensitive {
+				for i := range cur {
+					cur[i] = p.fold(cur[i])
+				}
+			}
+			cs.addString(cur)
+			cur = nil
+			if ch == '}' {
+				return nil
+			}
+			continue
+		case ch == '\\' && p.charsRight() > 0:
+			c, err := p.scanCharEscape()
+			if err != nil {
+				return err
+			}
+			ch = c
+		default:
+			if err := p.checkClassSetChar(ch); err != nil {
+				return err
+			}
+		}
+		cur = append(cur, ch)
+	}
+}
+
+// scanStringProperty checks for a {name} naming a property of strings,
+// and returns its strings if so.  The position is left alone otherwise.
+func (p *parser) scanStringProperty() ([][]rune, bool) {
+	if p.charsRight() == 0 || p.rightChar(0) != '{' {
+		return nil, false
+	}
+	pos := p.textpos()
+	p.moveRight(1)
+	start := p.textpos()
+	for p.charsRight() > 0 && p.rightChar(0) != '}' {
+		p.moveRight(1)
+	}
+	name := string(p.pattern[start:p.textpos()])
+	if gen, ok := stringProperties[name]; ok && p.charsRight() > 0 {
+		p.moveRight(1)
+		return gen(), true
+	}
+	p.textto(pos)
+	return nil, false
+}
+
+// stringProperties are the properties of strings we can list without
+// emoji data tables
+var stringProperties = map[string]func() [][]rune{
+	"Emoji_Keycap_Sequence": func() [][]rune {
+		var out [][]rune
+		for _, c := range "#*0123456789" {
+			out = append(out, []rune{c, 0xFE0F, 0x20E3})
+		}
+		return out
+	},
+	// the flags of England, Scotland and Wales, the only tag sequences
+	// that are RGI
+	"RGI_Emoji_Tag_Sequence": func() [][]rune {
+		var out [][]rune
+		for _, region := range []string{"gbeng", "gbsct", "gbwls"} {
+			seq := []rune{0x1F3F4}
+			for _, c := range region {
+				seq = append(seq, 0xE0000+c)
+			}
+			out = append(out, append(seq, 0xE007F))
+		}
+		return out
+	},
+}
+
+// unsupportedStringProperties are the other properties of strings the v
+// flag has, which need the emoji data files, so that they get an error
+// that says so rather than one for an unknown property
+var unsupportedStringProperties = map[string]bool{
+	"Basic_Emoji":                 true,
+	"RGI_Emoji":                   true,
+	"RGI_Emoji_Flag_Sequence":     true,
+	"RGI_Emoji_Modifier_Sequence": true,
+	"RGI_Emoji_ZWJ_Sequence":      true,
+}
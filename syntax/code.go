@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"unicode"
 )
 
 // similar to prog.go in the go regex package...also with comment 'may not belong in this package'
@@ -80,6 +81,19 @@ const (
 	ECMABoundary    = 41 //                          \b
 	NonECMABoundary = 42 //                          \B
 
+	Call   = 43 // back     jump,group      call group as a subroutine
+	Return = 44 // back     group           return from a call to group
+	Verb   = 45 // back     kind,name       backtracking control verb
+
+	Grapheme = 46 //                          \X, one extended grapheme cluster
+
+	WordSegBoundary     = 47 //                          \b{wb}
+	NonWordSegBoundary  = 48 //                          \B{wb}
+	GraphemeBoundary    = 49 //                          \b{g}
+	NonGraphemeBoundary = 50 //                          \B{g}
+
+	Trie = 51 // back     trie            foo|bar|baz|..., see LiteralTrie
+
 	// Modifiers for alternate modes
 
 	Mask  = 63  // Mask to get unmodified ordinary operator
@@ -89,10 +103,19 @@ const (
 	Ci    = 512 // bit to indicate that we're case-insensitive.
 )
 
+// Kinds of backtracking control verb, the first operand of Verb
+const (
+	VerbCommit = iota + 1 // (*COMMIT): give up on the whole search
+	VerbPrune             // (*PRUNE): give up on the current starting position
+	VerbSkip              // (*SKIP): also don't start again before here
+	VerbMark              // (*MARK:name): just name the path taken
+)
+
 type Code struct {
 	Codes       []int       // the code
 	Strings     [][]rune    // string table
 	Sets        []*CharSet  //character set table
+	SetsASCII   []ASCIISet  // the ASCII members of each of Sets
 	TrackCount  int         // how many instructions use backtracking
 	Caps        map[int]int // mapping of user group numbers -> impl group slots
 	Capsize     int         // number of impl group slots
@@ -100,6 +123,22 @@ type Code struct {
 	BmPrefix    *BmPrefix   // the fixed prefix string as a Boyer-Moore machine (may be null)
 	Anchors     AnchorLoc   // the set of zero-length start anchors (RegexFCD.Bol, etc)
 	RightToLeft bool        // true if right to left
+	MemoLoops   []int       // Branchmark positions whose outcome depends only on the text position (Memoize only)
+	NFA         *NFA        // backtracking-free automaton for the pattern (may be null)
+	CapNFA      *NFA        // the automaton with captures, for the bit-state backtracker (may be null)
+	OnePass     *OnePass    // CapNFA, if the pattern can be matched in one pass (may be null)
+
+	Culture     unicode.SpecialCase // casing rules IgnoreCase follows (nil for invariant)
+	Tries       []*LiteralTrie      // tries for the literal alternations
+	LeadingTrie *LiteralTrie        // the one every match starts with, if any (may be null)
+}
+
+func asciiSets(sets []*CharSet) []ASCIISet {
+	t := make([]ASCIISet, len(sets))
+	for i, s := range sets {
+		t[i] = s.ASCII()
+	}
+	return t
 }
 
 func opcodeBacktracks(op InstOp) bool {
@@ -108,7 +147,7 @@ func opcodeBacktracks(op InstOp) bool {
 	switch op {
 	case Oneloop, Notoneloop, Setloop, Onelazy, Notonelazy, Setlazy, Lazybranch, Branchmark, Lazybranchmark,
 		Nullcount, Setcount, Branchcount, Lazybranchcount, Setmark, Capturemark, Getmark, Setjump, Backjump,
-		Forejump, Goto:
+		Forejump, Goto, Call, Return, Verb, Trie:
 		return true
 
 	default:
@@ -121,14 +160,15 @@ func opcodeSize(op InstOp) int {
 
 	switch op {
 	case Nothing, Bol, Eol, Boundary, Nonboundary, ECMABoundary, NonECMABoundary, Beginning, Start, EndZ,
-		End, Nullmark, Setmark, Getmark, Setjump, Backjump, Forejump, Stop:
+		End, Nullmark, Setmark, Getmark, Setjump, Backjump, Forejump, Stop, Grapheme,
+		WordSegBoundary, NonWordSegBoundary, GraphemeBoundary, NonGraphemeBoundary:
 		return 1
 
 	case One, Notone, Multi, Ref, Testref, Goto, Nullcount, Setcount, Lazybranch, Branchmark, Lazybranchmark,
-		Prune, Set:
+		Prune, Set, Return, Trie:
 		return 2
 
-	case Capturemark, Branchcount, Lazybranchcount, Onerep, Notonerep, Oneloop, Notoneloop, Onelazy, Notonelazy,
+	case Call, Verb, Capturemark, Branchcount, Lazybranchcount, Onerep, Notonerep, Oneloop, Notoneloop, Onelazy, Notonelazy,
 		Setlazy, Setrep, Setloop:
 		return 3
 
@@ -151,6 +191,9 @@ var codeStr = []string{
 	"Setjump", "Backjump", "Forejump", "Testref", "Goto",
 	"Prune", "Stop",
 	"ECMABoundary", "NonECMABoundary",
+	"Call", "Return", "Verb", "Grapheme",
+	"WordSegBoundary", "NonWordSegBoundary", "GraphemeBoundary", "NonGraphemeBoundary",
+	"Trie",
 }
 
 func operatorDescription(op InstOp) string {
@@ -199,9 +242,21 @@ func (c *Code) OpcodeDescription(offset int) string {
 	case Multi:
 		fmt.Fprintf(buf, "String = %s", string(c.Strings[c.Codes[offset+1]]))
 
-	case Ref, Testref:
+	case Trie:
+		fmt.Fprintf(buf, "Literals = %s", c.Tries[c.Codes[offset+1]].String())
+
+	case Ref, Testref, Return:
 		fmt.Fprintf(buf, "Index = %d", c.Codes[offset+1])
 
+	case Call:
+		fmt.Fprintf(buf, "Addr = %d, Index = %d", c.Codes[offset+1], c.Codes[offset+2])
+
+	case Verb:
+		fmt.Fprintf(buf, "Kind = %d", c.Codes[offset+1])
+		if c.Codes[offset+2] != -1 {
+			fmt.Fprintf(buf, ", Name = %s", string(c.Strings[c.Codes[offset+2]]))
+		}
+
 	case Capturemark:
 		fmt.Fprintf(buf, "Index = %d", c.Codes[offset+1])
 		if c.Codes[offset+2] != -1 {
@@ -239,6 +294,45 @@ func (c *Code) OpcodeDescription(offset int) string {
 	return buf.String()
 }
 
+// Literal returns the text the program matches if that's all it does, as
+// for abc or (?i)abc, along with whether it ignores case.  The text is
+// case folded when it does.
+func (c *Code) Literal() (text []rune, ignoreCase, ok bool) {
+	codes := c.Codes
+	if len(codes) != 9 || InstOp(codes[0]) != Lazybranch || InstOp(codes[2]) != Setmark ||
+		InstOp(codes[5]) != Capturemark || codes[6] != 0 || codes[7] != -1 || InstOp(codes[8]) != Stop {
+		return nil, false, false
+	}
+	op := InstOp(codes[3])
+	switch op &^ (Rtl | Ci) {
+	case One:
+		text = []rune{rune(codes[4])}
+	case Multi:
+		text = c.Strings[codes[4]]
+	default:
+		return nil, false, false
+	}
+	return text, op&Ci != 0, true
+}
+
+// ReadsCaptures reports whether the program looks at what its groups have
+// captured while it runs, with back references, conditionals on a group,
+// balancing groups or subroutine calls, so that it can't be run without
+// keeping them.
+func (c *Code) ReadsCaptures() bool {
+	for i := 0; i < len(c.Codes); i += opcodeSize(InstOp(c.Codes[i])) {
+		switch InstOp(c.Codes[i]) & Mask {
+		case Ref, Testref, Call, Return:
+			return true
+		case Capturemark:
+			if c.Codes[i+2] != -1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *Code) Dump() string {
 	buf := &bytes.Buffer{}
 
@@ -0,0 +1,67 @@
+package syntax
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// DOT returns the parse tree as a Graphviz graph, with the children of
+// each node from left to right in the order they match.  It shows the same
+// nodes as Dump, which is easier to take in for big patterns once it's
+// drawn, for example with dot -Tsvg.
+func (t *RegexTree) DOT() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("digraph tree {\n\tnode [shape=box fontname=monospace];\n\tordering=out;\n")
+	ids := map[*regexNode]int{}
+	var walk func(n *regexNode) int
+	walk = func(n *regexNode) int {
+		id := len(ids)
+		ids[n] = id
+		fmt.Fprintf(buf, "\tn%d [label=%s];\n", id, dotQuote(n.description()))
+		for _, child := range n.children {
+			fmt.Fprintf(buf, "\tn%d -> n%d;\n", id, walk(child))
+		}
+		return id
+	}
+	walk(t.root)
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// DOT returns the program as a Graphviz graph of its instructions.  Solid
+// edges go on to the next instruction, and dashed ones are jumps: to the
+// other alternative of a branch, back to the start of a loop, or into a
+// group that's called.  Backtracking isn't drawn, since where it goes is
+// only known when the program runs.
+func (c *Code) DOT() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("digraph program {\n\tnode [shape=box fontname=monospace];\n")
+	for pc := 0; pc < len(c.Codes); pc += opcodeSize(InstOp(c.Codes[pc])) {
+		op := InstOp(c.Codes[pc]) & Mask
+		fmt.Fprintf(buf, "\tp%d [label=%s];\n", pc, dotQuote(c.OpcodeDescription(pc)))
+
+		next := pc + opcodeSize(op)
+		switch op {
+		case Stop:
+		case Goto:
+			fmt.Fprintf(buf, "\tp%d -> p%d;\n", pc, c.Codes[pc+1])
+		case Lazybranch, Branchmark, Lazybranchmark, Branchcount, Lazybranchcount, Call:
+			fmt.Fprintf(buf, "\tp%d -> p%d;\n", pc, next)
+			fmt.Fprintf(buf, "\tp%d -> p%d [style=dashed];\n", pc, c.Codes[pc+1])
+		default:
+			if next < len(c.Codes) {
+				fmt.Fprintf(buf, "\tp%d -> p%d;\n", pc, next)
+			}
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// dotQuote makes s a quoted DOT string
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
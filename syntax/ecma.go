@@ -0,0 +1,125 @@
+package syntax
+
+import (
+	"strings"
+	"unicode"
+)
+
+// assignedTable is every code point with a general category other than Cn
+var assignedTable = mergeTables(unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z,
+	unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+
+// ecmaProperties are the tables behind ECMAScript \p{name} properties that
+// the unicode package may not have.  Older releases of it lack Cn and LC.
+var ecmaProperties = map[string]*unicode.RangeTable{
+	"Any":      rangeTable(0, unicode.MaxRune),
+	"Assigned": assignedTable,
+	"Cn":       complementTable(assignedTable),
+	"LC":       mergeTables(unicode.Lu, unicode.Ll, unicode.Lt),
+}
+
+// ecmaCategoryNames maps the long General_Category values to the short ones
+var ecmaCategoryNames = map[string]string{
+	"Letter": "L", "Cased_Letter": "LC", "Uppercase_Letter": "Lu", "Lowercase_Letter": "Ll",
+	"Titlecase_Letter": "Lt", "Modifier_Letter": "Lm", "Other_Letter": "Lo",
+	"Mark": "M", "Combining_Mark": "M", "Nonspacing_Mark": "Mn", "Spacing_Mark": "Mc", "Enclosing_Mark": "Me",
+	"Number": "N", "Decimal_Number": "Nd", "digit": "Nd", "Letter_Number": "Nl", "Other_Number": "No",
+	"Punctuation": "P", "punct": "P", "Connector_Punctuation": "Pc", "Dash_Punctuation": "Pd",
+	"Open_Punctuation": "Ps", "Close_Punctuation": "Pe", "Initial_Punctuation": "Pi",
+	"Final_Punctuation": "Pf", "Other_Punctuation": "Po",
+	"Symbol": "S", "Math_Symbol": "Sm", "Currency_Symbol": "Sc", "Modifier_Symbol": "Sk", "Other_Symbol": "So",
+	"Separator": "Z", "Space_Separator": "Zs", "Line_Separator": "Zl", "Paragraph_Separator": "Zp",
+	"Other": "C", "Control": "Cc", "cntrl": "Cc", "Format": "Cf", "Surrogate": "Cs",
+	"Private_Use": "Co", "Unassigned": "Cn",
+}
+
+// ecmaBinaryProperties are the binary properties the unicode package has
+// no table of its own for, mapped to the one we use
+var ecmaBinaryProperties = map[string]string{
+	"Alphabetic": "javaAlphabetic",
+	"Lowercase":  "javaLowerCase",
+	"Uppercase":  "javaUpperCase",
+	"ASCII":      "ASCII",
+	"Any":        "Any",
+	"Assigned":   "Assigned",
+}
+
+// ecmaOnlyCategories are the ecmaProperties we had to add, which other
+// modes don't know about
+var ecmaOnlyCategories = map[string]bool{}
+
+func init() {
+	for k, v := range ecmaProperties {
+		if _, ok := unicodeCategories[k]; !ok {
+			unicodeCategories[k] = v
+			ecmaOnlyCategories[k] = true
+		}
+	}
+}
+
+// ecmaPropertyName resolves the name in an ECMAScript \p{name} to the
+// category we know it by.  A lone name is a General_Category value or a
+// binary property; scripts have to be spelled Script=Name or sc=Name.
+func ecmaPropertyName(name string) (string, bool) {
+	if i := strings.IndexByte(name, '='); i >= 0 {
+		value := name[i+1:]
+		switch name[:i] {
+		case "Script", "sc":
+			if script, ok := scriptName(value); ok {
+				return script, true
+			}
+		case "Script_Extensions", "scx":
+			return scriptExtensionsName(value)
+		case "General_Category", "gc":
+			return ecmaCategory(value)
+		}
+		return "", false
+	}
+
+	if cat, ok := ecmaCategory(name); ok {
+		return cat, true
+	}
+	if alias, ok := ecmaBinaryProperties[name]; ok {
+		return alias, true
+	}
+	if isBinaryProperty(name) {
+		return name, true
+	}
+	return "", false
+}
+
+func ecmaCategory(name string) (string, bool) {
+	if short, ok := ecmaCategoryNames[name]; ok {
+		name = short
+	}
+	if isGeneralCategory(name) {
+		return name, true
+	}
+	if _, ok := ecmaProperties[name]; ok && name != "Any" && name != "Assigned" {
+		return name, true
+	}
+	return "", false
+}
+
+// complementTable builds the table of every rune not in t
+func complementTable(t *unicode.RangeTable) *unicode.RangeTable {
+	var rs []singleRange
+	next := rune(0)
+	add := func(lo, hi rune) {
+		if lo > next {
+			rs = append(rs, singleRange{first: next, last: lo - 1})
+		}
+		next = hi + 1
+	}
+	// t came from tableFromRanges, so its ranges are sorted with stride 1
+	for _, r := range t.R16 {
+		add(rune(r.Lo), rune(r.Hi))
+	}
+	for _, r := range t.R32 {
+		add(rune(r.Lo), rune(r.Hi))
+	}
+	if next <= unicode.MaxRune {
+		rs = append(rs, singleRange{first: next, last: unicode.MaxRune})
+	}
+	return tableFromRanges(rs)
+}
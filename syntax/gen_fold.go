@@ -0,0 +1,99 @@
+//go:build ignore
+// +build ignore
+
+// gen_fold reads the full case foldings, status F, from CaseFolding.txt
+// and writes fold_table.go, the runes IgnoreCase lets match several runes,
+// like ß and ss.
+//
+//	go run gen_fold.go -ucd path/to/ucd -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucdDir  = flag.String("ucd", ".", "directory holding the UCD files")
+	version = flag.String("version", "", "Unicode version of the UCD files")
+	output  = flag.String("output", "fold_table.go", "file to write")
+)
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen_fold.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package syntax\n\n")
+	fmt.Fprintf(buf, "const fullFoldVersion = %q\n\n", *version)
+	fmt.Fprintf(buf, "// the runes whose full case folding is several runes\n")
+	fmt.Fprintf(buf, "var fullFolds = map[rune]string{\n")
+	eachLine("CaseFolding.txt", func(fields []string) {
+		if len(fields) < 3 || fields[1] != "F" {
+			return
+		}
+		var fold []rune
+		for _, s := range strings.Fields(fields[2]) {
+			fold = append(fold, parseRune(s))
+		}
+		fmt.Fprintf(buf, "0x%s: %+q,\n", fields[0], string(fold))
+	})
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// eachLine calls f with the trimmed ;-separated fields of each line of a
+// UCD file, comments removed
+func eachLine(name string, f func(fields []string)) {
+	file, err := os.Open(filepath.Join(*ucdDir, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
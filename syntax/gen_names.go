@@ -0,0 +1,169 @@
+//go:build ignore
+// +build ignore
+
+// gen_names reads UnicodeData.txt and writes names_table.go, the character
+// names behind \N{NAME}.  Names that follow from the code point (CJK
+// UNIFIED IDEOGRAPH-4E00, HANGUL SYLLABLE GA, ...) are left out, names.go
+// works those out itself.
+//
+//	go run gen_names.go -data UnicodeData.txt -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	dataFile = flag.String("data", "UnicodeData.txt", "path to UnicodeData.txt")
+	version  = flag.String("version", "", "Unicode version of the data")
+	output   = flag.String("output", "names_table.go", "file to write")
+)
+
+// keep in sync with algorithmicNames in names.go
+var algorithmicPrefixes = []string{
+	"CJK UNIFIED IDEOGRAPH-",
+	"CJK COMPATIBILITY IDEOGRAPH-",
+	"TANGUT IDEOGRAPH-",
+	"KHITAN SMALL SCRIPT CHARACTER-",
+	"NUSHU CHARACTER-",
+}
+
+type entry struct {
+	r    rune
+	name string
+}
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+
+	f, err := os.Open(*dataFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var entries []entry
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Split(sc.Text(), ";")
+		if len(fields) < 2 {
+			continue
+		}
+		name := fields[1]
+		if strings.HasPrefix(name, "<") || isAlgorithmic(name) {
+			continue
+		}
+		r, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			log.Fatalf("bad code point %q: %v", fields[0], err)
+		}
+		entries = append(entries, entry{rune(r), name})
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].r < entries[j].r })
+
+	// number the words, most used first, so most take a single byte
+	counts := map[string]int{}
+	for _, e := range entries {
+		for _, w := range strings.Split(e.name, " ") {
+			counts[w]++
+		}
+	}
+	words := make([]string, 0, len(counts))
+	for w := range counts {
+		words = append(words, w)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if counts[words[i]] != counts[words[j]] {
+			return counts[words[i]] > counts[words[j]]
+		}
+		return words[i] < words[j]
+	})
+	index := make(map[string]int, len(words))
+	for i, w := range words {
+		index[w] = i
+	}
+
+	// each entry is the code point's distance from the last one, the
+	// number of words and their indices, all as uvarints; it goes in the
+	// source as base64, which takes far less room than a quoted string
+	var data []byte
+	var tmp [binary.MaxVarintLen64]byte
+	put := func(v int) {
+		n := binary.PutUvarint(tmp[:], uint64(v))
+		data = append(data, tmp[:n]...)
+	}
+	last := rune(0)
+	for _, e := range entries {
+		ws := strings.Split(e.name, " ")
+		put(int(e.r - last))
+		put(len(ws))
+		for _, w := range ws {
+			put(index[w])
+		}
+		last = e.r
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen_names.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package syntax\n\n")
+	fmt.Fprintf(buf, "// CharNamesVersion is the Unicode version of the \\N{NAME} names\n")
+	fmt.Fprintf(buf, "const CharNamesVersion = %q\n\n", *version)
+	fmt.Fprintf(buf, "// %d words, separated by spaces\n", len(words))
+	writeString(buf, "charNameWords", strings.Join(words, " "))
+	fmt.Fprintf(buf, "\n// %d names, base64 encoded\n", len(entries))
+	writeString(buf, "charNameData", base64.StdEncoding.EncodeToString(data))
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isAlgorithmic(name string) bool {
+	if strings.HasPrefix(name, "HANGUL SYLLABLE ") {
+		return true
+	}
+	for _, p := range algorithmicPrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeString(buf *bytes.Buffer, name, s string) {
+	fmt.Fprintf(buf, "const %s = \"\" +\n", name)
+	for len(s) > 0 {
+		n := 64
+		if n > len(s) {
+			n = len(s)
+		}
+		fmt.Fprintf(buf, "\t%s", strconv.Quote(s[:n]))
+		s = s[n:]
+		if len(s) > 0 {
+			buf.WriteString(" +")
+		}
+		buf.WriteString("\n")
+	}
+}
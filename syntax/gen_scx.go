@@ -0,0 +1,122 @@
+//go:build ignore
+// +build ignore
+
+// gen_scx reads ScriptExtensions.txt and the script names from
+// PropertyValueAliases.txt and writes scx_table.go, the Script_Extensions
+// data behind \p{scx=Name} and the short script names \p{sc=Grek} takes.
+//
+//	go run gen_scx.go -ucd path/to/ucd -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	ucdDir  = flag.String("ucd", ".", "directory holding the UCD files")
+	version = flag.String("version", "", "Unicode version of the UCD files")
+	output  = flag.String("output", "scx_table.go", "file to write")
+)
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+
+	// the short script names ScriptExtensions.txt uses, to the long ones
+	// the unicode package uses
+	long := map[string]string{}
+	eachLine("PropertyValueAliases.txt", func(fields []string) {
+		if len(fields) >= 3 && fields[0] == "sc" {
+			long[fields[1]] = fields[2]
+		}
+	})
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen_scx.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package syntax\n\n")
+	fmt.Fprintf(buf, "const scxVersion = %q\n\n", *version)
+	fmt.Fprintf(buf, "// the runes whose Script_Extensions isn't just their Script\n")
+	fmt.Fprintf(buf, "var scriptExtensions = []scriptExtension{\n")
+	eachLine("ScriptExtensions.txt", func(fields []string) {
+		if len(fields) != 2 {
+			return
+		}
+		lo, hi := fields[0], fields[0]
+		if i := strings.Index(lo, ".."); i >= 0 {
+			lo, hi = lo[:i], lo[i+2:]
+		}
+		var scripts []string
+		for _, s := range strings.Fields(fields[1]) {
+			name, ok := long[s]
+			if !ok {
+				log.Fatalf("unknown script %v", s)
+			}
+			scripts = append(scripts, name)
+		}
+		fmt.Fprintf(buf, "{0x%s, 0x%s, %q},\n", lo, hi, strings.Join(scripts, " "))
+	})
+	fmt.Fprintf(buf, "}\n\n")
+
+	var short []string
+	for s, l := range long {
+		if s != l {
+			short = append(short, s)
+		}
+	}
+	sort.Strings(short)
+	fmt.Fprintf(buf, "// the short script names, to the long ones\n")
+	fmt.Fprintf(buf, "var scriptAliases = map[string]string{\n")
+	for _, s := range short {
+		fmt.Fprintf(buf, "%q: %q,\n", s, long[s])
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// eachLine calls f with the trimmed ;-separated fields of each line of a
+// UCD file, comments removed
+func eachLine(name string, f func(fields []string)) {
+	file, err := os.Open(filepath.Join(*ucdDir, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
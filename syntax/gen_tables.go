@@ -0,0 +1,229 @@
+//go:build ignore
+// +build ignore
+
+// gen_tables reads the general categories, scripts and binary properties
+// out of a directory of UCD files and writes ucd_tables.go, which replaces
+// the unicode package's tables when built with the regexp2_ucd tag.
+//
+//	go run gen_tables.go -ucd path/to/ucd -version 15.1.0
+//
+// UnicodeData.txt is required; Scripts.txt, PropList.txt and
+// DerivedCoreProperties.txt are read if they are there.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucdDir  = flag.String("ucd", ".", "directory holding the UCD files")
+	version = flag.String("version", "", "Unicode version of the UCD files")
+	output  = flag.String("output", "ucd_tables.go", "file to write")
+)
+
+type runeRange struct{ lo, hi rune }
+
+type tables map[string][]runeRange
+
+func (t tables) add(name string, lo, hi rune) {
+	t[name] = append(t[name], runeRange{lo, hi})
+}
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+
+	categories := tables{}
+	readUnicodeData(categories)
+	// the one letter categories, and LC like the unicode package has it
+	for name, rs := range categories {
+		if len(name) == 2 {
+			categories[name[:1]] = append(categories[name[:1]], rs...)
+		}
+	}
+	categories["LC"] = append(append(append([]runeRange{}, categories["Lu"]...), categories["Ll"]...), categories["Lt"]...)
+
+	scripts := tables{}
+	readPropertyFile("Scripts.txt", scripts)
+	properties := tables{}
+	readPropertyFile("PropList.txt", properties)
+	readPropertyFile("DerivedCoreProperties.txt", properties)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "//go:build regexp2_ucd\n// +build regexp2_ucd\n\n")
+	fmt.Fprintf(buf, "// Code generated by gen_tables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package syntax\n\nimport \"unicode\"\n\n")
+	fmt.Fprintf(buf, "const ucdVersion = %q\n\n", *version)
+	writeTables(buf, "ucdCategories", categories)
+	writeTables(buf, "ucdScripts", scripts)
+	writeTables(buf, "ucdProperties", properties)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readUnicodeData takes the general category of each code point from
+// UnicodeData.txt, where big blocks are given as a First, Last pair
+func readUnicodeData(t tables) {
+	f, err := os.Open(filepath.Join(*ucdDir, "UnicodeData.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	first := rune(-1)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Split(sc.Text(), ";")
+		if len(fields) < 3 {
+			continue
+		}
+		r := parseRune(fields[0])
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+		case strings.HasSuffix(fields[1], ", Last>"):
+			t.add(fields[2], first, r)
+		default:
+			t.add(fields[2], r, r)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readPropertyFile reads the "0000..001F ; Name # comment" lines of files
+// like Scripts.txt; a missing file is skipped
+func readPropertyFile(name string, t tables) {
+	f, err := os.Open(filepath.Join(*ucdDir, name))
+	if os.IsNotExist(err) {
+		log.Printf("no %v, skipping", name)
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			// blank, or a property with a value we don't use
+			continue
+		}
+		lo, hi := strings.TrimSpace(fields[0]), ""
+		if i := strings.Index(lo, ".."); i >= 0 {
+			lo, hi = lo[:i], lo[i+2:]
+		} else {
+			hi = lo
+		}
+		t.add(strings.TrimSpace(fields[1]), parseRune(lo), parseRune(hi))
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatalf("bad code point %q: %v", s, err)
+	}
+	return rune(r)
+}
+
+func writeTables(buf *bytes.Buffer, varName string, t tables) {
+	names := make([]string, 0, len(t))
+	for name := range t {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(buf, "var %s = map[string]*unicode.RangeTable{\n", varName)
+	for _, name := range names {
+		fmt.Fprintf(buf, "%q: {\n", name)
+		writeTable(buf, merge(t[name]))
+		fmt.Fprintf(buf, "},\n")
+	}
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+func merge(rs []runeRange) []runeRange {
+	sort.Slice(rs, func(i, j int) bool { return rs[i].lo < rs[j].lo })
+	var out []runeRange
+	for _, r := range rs {
+		if n := len(out); n > 0 && r.lo <= out[n-1].hi+1 {
+			if r.hi > out[n-1].hi {
+				out[n-1].hi = r.hi
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func writeTable(buf *bytes.Buffer, rs []runeRange) {
+	var r16, r32 []runeRange
+	latinOffset := 0
+	for _, r := range rs {
+		if r.lo <= 0xFFFF {
+			hi := r.hi
+			if hi > 0xFFFF {
+				hi = 0xFFFF
+			}
+			r16 = append(r16, runeRange{r.lo, hi})
+			if hi <= 0xFF {
+				latinOffset++
+			}
+		}
+		if r.hi > 0xFFFF {
+			lo := r.lo
+			if lo < 0x10000 {
+				lo = 0x10000
+			}
+			r32 = append(r32, runeRange{lo, r.hi})
+		}
+	}
+	if len(r16) > 0 {
+		fmt.Fprintf(buf, "R16: []unicode.Range16{\n")
+		for _, r := range r16 {
+			fmt.Fprintf(buf, "{0x%04x, 0x%04x, 1},\n", r.lo, r.hi)
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	if len(r32) > 0 {
+		fmt.Fprintf(buf, "R32: []unicode.Range32{\n")
+		for _, r := range r32 {
+			fmt.Fprintf(buf, "{0x%x, 0x%x, 1},\n", r.lo, r.hi)
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	if latinOffset > 0 {
+		fmt.Fprintf(buf, "LatinOffset: %d,\n", latinOffset)
+	}
+}
@@ -0,0 +1,443 @@
+package syntax
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ErrNotGeneratable is returned by GenerateGo for programs that use
+// instructions it has no Go translation for: subroutine calls, backtracking
+// verbs, balancing groups, right-to-left matching and culture-sensitive
+// casing.  Such patterns are left to the interpreter.
+var ErrNotGeneratable = errors.New("regexp2: pattern can't be generated as Go code")
+
+// maxInlineRanges is how many ranges a class may have before the generated
+// code calls CharIn instead of comparing against each of them
+const maxInlineRanges = 8
+
+// GenerateGo writes a Go function named name that runs code at a single
+// text position, the same way the interpreter's execute loop does.  Each
+// instruction becomes a block of straight-line Go with its operands filled
+// in, and backtracking is a switch on the instruction that pushed the
+// frame, so the Go compiler can inline the character tests and there is
+// no decoding of the program at match time.
+//
+// The function has the signature
+//
+//	func name(in *regexp2.GeneratedInput, pos int) bool
+//
+// and leaves the captures it made in in.Captures.  options are the ones the
+// pattern was compiled with.
+func GenerateGo(code *Code, name string, options RegexOptions) (string, error) {
+	if code.RightToLeft || code.Culture != nil {
+		return "", ErrNotGeneratable
+	}
+	g := &goGen{code: code, ecma: options&ECMAScript != 0}
+
+	var insts []*goInst
+	index := map[int]int{}
+	for pc := 0; pc < len(code.Codes); pc += opcodeSize(InstOp(code.Codes[pc])) {
+		g.pc = pc
+		g.cur = &goInst{pc: pc}
+		g.buf.Reset()
+		if err := g.inst(); err != nil {
+			return "", err
+		}
+		g.cur.body = g.buf.String()
+		index[pc] = len(insts)
+		insts = append(insts, g.cur)
+	}
+
+	// Only instructions that can be reached get written, and only the ones
+	// something jumps to get labels, or the result wouldn't compile cleanly.
+	labels := map[int]bool{}
+	var work []int
+	reach := func(pc int, label bool) {
+		i, ok := index[pc]
+		if !ok {
+			return
+		}
+		if label {
+			labels[pc] = true
+		}
+		if !insts[i].reachable {
+			insts[i].reachable = true
+			work = append(work, i)
+		}
+	}
+	reach(0, false)
+	for len(work) > 0 {
+		inst := insts[work[len(work)-1]]
+		work = work[:len(work)-1]
+		for _, pc := range inst.targets {
+			reach(pc, true)
+		}
+		if !inst.terminal {
+			reach(inst.pc+opcodeSize(InstOp(code.Codes[inst.pc])), false)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "func %s(in *regexp2.GeneratedInput, pos int) bool {\n", name)
+	fmt.Fprintf(out, "text := in.Text\nend := len(text)\ntrack, stack := in.Track[:0], in.Stack[:0]\n")
+	fmt.Fprintf(out, "_ = end\nin.Captures = in.Captures[:0]\n\n")
+	for _, inst := range insts {
+		if !inst.reachable {
+			continue
+		}
+		if labels[inst.pc] {
+			fmt.Fprintf(out, "l%d:\n", inst.pc)
+		}
+		fmt.Fprintf(out, "// %s\n", commentText(code.OpcodeDescription(inst.pc)))
+		out.WriteString(inst.body)
+	}
+	fmt.Fprintf(out, "\nbacktrack:\n")
+	fmt.Fprintf(out, "if len(track) == 0 {\nin.Track, in.Stack = track, stack\nreturn false\n}\n")
+	fmt.Fprintf(out, "switch track[len(track)-1] {\n")
+	out.WriteString(backtrackCases(insts))
+	fmt.Fprintf(out, "}\npanic(\"regexp2: bad backtrack\")\n}\n")
+	return out.String(), nil
+}
+
+// commentText makes an instruction description safe to put in a line
+// comment
+func commentText(s string) string {
+	buf := &bytes.Buffer{}
+	for _, ch := range s {
+		if ch < ' ' || ch == 0x7f || ch == 0x85 || ch == 0x2028 || ch == 0x2029 {
+			q := strconv.QuoteRune(ch)
+			buf.WriteString(q[1 : len(q)-1])
+		} else {
+			buf.WriteRune(ch)
+		}
+	}
+	return buf.String()
+}
+
+type goGen struct {
+	code *Code
+	ecma bool
+	pc   int
+	cur  *goInst
+	buf  bytes.Buffer
+}
+
+// goInst is the Go written for one instruction
+type goInst struct {
+	pc        int
+	body      string
+	backs     map[int]string // its Back and Back2 handlers, by the pc they're pushed with
+	targets   []int          // instructions the body and handlers jump to
+	terminal  bool           // whether the body never falls through to the next one
+	reachable bool
+}
+
+func (g *goGen) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteByte('\n')
+}
+
+func (g *goGen) operand(i int) int {
+	return g.code.Codes[g.pc+i+1]
+}
+
+// jump returns the statement that continues at pc
+func (g *goGen) jump(pc int) string {
+	g.cur.targets = append(g.cur.targets, pc)
+	return fmt.Sprintf("goto l%d", pc)
+}
+
+// next returns the statement that continues after the current instruction
+func (g *goGen) next() string {
+	return g.jump(g.pc + opcodeSize(InstOp(g.code.Codes[g.pc])))
+}
+
+// back records the code run when backtracking reaches a frame pushed by
+// the current instruction; back2 frames are pushed with back2Key
+func (g *goGen) back(back2 bool, format string, args ...interface{}) {
+	if g.cur.backs == nil {
+		g.cur.backs = map[int]string{}
+	}
+	key := g.pc
+	if back2 {
+		key = g.back2Key()
+	}
+	g.cur.backs[key] = fmt.Sprintf(format, args...)
+}
+
+// back2Key is what the current instruction pushes for its Back2 handler,
+// which has to differ from every pc, 0 included
+func (g *goGen) back2Key() int {
+	return -g.pc - 1
+}
+
+// backtrackCases writes the cases of the switch that backtracking goes
+// through, for the instructions that are written
+func backtrackCases(insts []*goInst) string {
+	backs := map[int]string{}
+	keys := []int{}
+	for _, inst := range insts {
+		if !inst.reachable {
+			continue
+		}
+		for k, b := range inst.backs {
+			backs[k] = b
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+	buf := &bytes.Buffer{}
+	for _, k := range keys {
+		fmt.Fprintf(buf, "case %d:\ntrack = track[:len(track)-1]\n%s\n", k, backs[k])
+	}
+	return buf.String()
+}
+
+// char returns the expression for the rune at i, case folded if the
+// instruction ignores case
+func (g *goGen) char(ci bool, i string) string {
+	if ci {
+		return "syntax.CaseFold(text[" + i + "])"
+	}
+	return "text[" + i + "]"
+}
+
+func runeLit(r rune) string {
+	if r >= 0x20 && r < 0x7f && r != '\\' && r != '\'' {
+		return "'" + string(r) + "'"
+	}
+	return fmt.Sprintf("0x%x", r)
+}
+
+// setTest returns a Go expression that's true if ch is in the set
+func (g *goGen) setTest(set int, ch string) string {
+	c := g.code.Sets[set]
+	if len(c.categories) > 0 || len(c.sets) > 0 || c.sub != nil || c.anything || len(c.ranges) > maxInlineRanges {
+		return fmt.Sprintf("in.Sets[%d].CharIn(%s)", set, ch)
+	}
+	var terms []string
+	for _, r := range c.ranges {
+		if r.first == r.last {
+			terms = append(terms, fmt.Sprintf("%s == %s", ch, runeLit(r.first)))
+		} else {
+			terms = append(terms, fmt.Sprintf("(%s >= %s && %s <= %s)", ch, runeLit(r.first), ch, runeLit(r.last)))
+		}
+	}
+	expr := "false"
+	if len(terms) > 0 {
+		expr = "(" + joinTerms(terms, " || ") + ")"
+	}
+	if c.negate {
+		return "!" + expr
+	}
+	return expr
+}
+
+func joinTerms(terms []string, sep string) string {
+	buf := &bytes.Buffer{}
+	for i, t := range terms {
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+		buf.WriteString(t)
+	}
+	return buf.String()
+}
+
+// test returns the expression for whether ch passes a One, Notone or Set
+// style instruction
+func (g *goGen) test(op InstOp, ch string) string {
+	switch op {
+	case One, Onerep, Oneloop, Onelazy:
+		return fmt.Sprintf("%s == %s", ch, runeLit(rune(g.operand(0))))
+	case Notone, Notonerep, Notoneloop, Notonelazy:
+		return fmt.Sprintf("%s != %s", ch, runeLit(rune(g.operand(0))))
+	}
+	return g.setTest(g.operand(0), ch)
+}
+
+func (g *goGen) inst() error {
+	op := InstOp(g.code.Codes[g.pc])
+	if op&Rtl != 0 {
+		return ErrNotGeneratable
+	}
+	ci := op&Ci != 0
+	op &= Mask
+	pc := g.pc
+
+	switch op {
+	case Stop:
+		g.cur.terminal = true
+		g.p("in.Track, in.Stack = track, stack")
+		g.p("return len(in.Captures) >= 3 && in.Captures[len(in.Captures)-3] == 0")
+
+	case Nothing:
+		g.cur.terminal = true
+		g.p("goto backtrack")
+
+	case Goto:
+		g.cur.terminal = true
+		g.p("%s", g.jump(g.operand(0)))
+
+	case Testref:
+		g.p("if _, _, ok := in.LastCapture(%d); !ok {\ngoto backtrack\n}", g.operand(0))
+
+	case Lazybranch:
+		g.p("track = append(track, pos, %d)", pc)
+		g.back(false, "pos = track[len(track)-1]\ntrack = track[:len(track)-1]\n%s", g.jump(g.operand(0)))
+
+	case Trie:
+		g.p("if b, e := in.Tries[%d].Match(text, pos, -1); b < 0 {\ngoto backtrack\n} else {\ntrack = append(track, pos, b, %d)\npos = e\n}", g.operand(0), pc)
+		g.back(false, "if b, e := in.Tries[%d].Match(text, track[len(track)-2], track[len(track)-1]); b < 0 {\ntrack = track[:len(track)-2]\n} else {\ntrack[len(track)-1] = b\ntrack = append(track, %d)\npos = e\n%s\n}\ngoto backtrack", g.operand(0), pc, g.next())
+
+	case Setmark, Nullmark:
+		if op == Setmark {
+			g.p("stack = append(stack, pos)")
+		} else {
+			g.p("stack = append(stack, -1)")
+		}
+		g.p("track = append(track, %d)", pc)
+		g.back(false, "stack = stack[:len(stack)-1]\ngoto backtrack")
+
+	case Getmark:
+		g.p("track = append(track, stack[len(stack)-1], %d)\npos = stack[len(stack)-1]\nstack = stack[:len(stack)-1]", pc)
+		g.back(false, "stack = append(stack, track[len(track)-1])\ntrack = track[:len(track)-1]\ngoto backtrack")
+
+	case Capturemark:
+		if g.operand(1) != -1 {
+			// balancing group
+			return ErrNotGeneratable
+		}
+		g.p("in.Capture(%d, stack[len(stack)-1], pos)\ntrack = append(track, stack[len(stack)-1], %d)\nstack = stack[:len(stack)-1]", g.operand(0), pc)
+		g.back(false, "stack = append(stack, track[len(track)-1])\ntrack = track[:len(track)-1]\nin.Uncapture()\ngoto backtrack")
+
+	case Branchmark:
+		g.p("if mark := stack[len(stack)-1]; pos != mark {")
+		g.p("track = append(track, mark, pos, %d)\nstack[len(stack)-1] = pos\n%s", pc, g.jump(g.operand(0)))
+		g.p("} else {\ntrack = append(track, mark, %d)\nstack = stack[:len(stack)-1]\n}", g.back2Key())
+		g.back(false, "stack = stack[:len(stack)-1]\npos = track[len(track)-1]\ntrack[len(track)-1] = %d\n%s", g.back2Key(), g.next())
+		g.back(true, "stack = append(stack, track[len(track)-1])\ntrack = track[:len(track)-1]\ngoto backtrack")
+
+	case Lazybranchmark:
+		g.p("if mark := stack[len(stack)-1]; pos != mark {")
+		g.p("stack = stack[:len(stack)-1]\nif mark != -1 {\ntrack = append(track, mark, pos, %d)\n} else {\ntrack = append(track, pos, pos, %d)\n}", pc, pc)
+		g.p("} else {\ntrack = append(track, mark, %d)\n}", g.back2Key())
+		g.back(false, "p := track[len(track)-1]\ntrack[len(track)-1] = %d\nstack = append(stack, p)\npos = p\n%s", g.back2Key(), g.jump(g.operand(0)))
+		g.back(true, "stack[len(stack)-1] = track[len(track)-1]\ntrack = track[:len(track)-1]\ngoto backtrack")
+
+	case Setcount, Nullcount:
+		mark := "pos"
+		if op == Nullcount {
+			mark = "-1"
+		}
+		g.p("stack = append(stack, %s, %d)\ntrack = append(track, %d)", mark, g.operand(0), pc)
+		g.back(false, "stack = stack[:len(stack)-2]\ngoto backtrack")
+
+	case Branchcount:
+		g.p("if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count >= %d || (pos == mark && count >= 0) {", g.operand(1))
+		g.p("track = append(track, mark, count, %d)\nstack = stack[:len(stack)-2]", g.back2Key())
+		g.p("} else {\ntrack = append(track, mark, %d)\nstack[len(stack)-2], stack[len(stack)-1] = pos, count+1\n%s\n}", pc, g.jump(g.operand(0)))
+		g.back(false, "mark := track[len(track)-1]\ntrack = track[:len(track)-1]\nif count := stack[len(stack)-1]; count > 0 {\npos = stack[len(stack)-2]\nstack = stack[:len(stack)-2]\ntrack = append(track, mark, count-1, %d)\n%s\n}\nstack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1\ngoto backtrack", g.back2Key(), g.next())
+		g.back(true, "stack = append(stack, track[len(track)-2], track[len(track)-1])\ntrack = track[:len(track)-2]\ngoto backtrack")
+
+	case Lazybranchcount:
+		g.p("if mark, count := stack[len(stack)-2], stack[len(stack)-1]; count < 0 {")
+		g.p("track = append(track, mark, %d)\nstack[len(stack)-2], stack[len(stack)-1] = pos, count+1\n%s", g.back2Key(), g.jump(g.operand(0)))
+		g.p("} else {\ntrack = append(track, mark, count, pos, %d)\nstack = stack[:len(stack)-2]\n}", pc)
+		g.back(false, "mark, count, p := track[len(track)-3], track[len(track)-2], track[len(track)-1]\ntrack = track[:len(track)-3]\nif count < %d && p != mark {\npos = p\nstack = append(stack, p, count+1)\ntrack = append(track, mark, %d)\n%s\n}\nstack = append(stack, mark, count)\ngoto backtrack", g.operand(1), g.back2Key(), g.jump(g.operand(0)))
+		g.back(true, "mark := track[len(track)-1]\ntrack = track[:len(track)-1]\nstack[len(stack)-2], stack[len(stack)-1] = mark, stack[len(stack)-1]-1\ngoto backtrack")
+
+	case Setjump:
+		g.p("stack = append(stack, len(track), len(in.Captures))\ntrack = append(track, %d)", pc)
+		g.back(false, "stack = stack[:len(stack)-2]\ngoto backtrack")
+
+	case Backjump:
+		g.cur.terminal = true
+		g.p("track = track[:stack[len(stack)-2]]\nfor len(in.Captures) != stack[len(stack)-1] {\nin.Uncapture()\n}\nstack = stack[:len(stack)-2]\ngoto backtrack")
+
+	case Forejump:
+		g.p("track = append(track[:stack[len(stack)-2]], stack[len(stack)-1], %d)\nstack = stack[:len(stack)-2]", pc)
+		g.back(false, "for len(in.Captures) != track[len(track)-1] {\nin.Uncapture()\n}\ntrack = track[:len(track)-1]\ngoto backtrack")
+
+	case Bol:
+		g.p("if pos > 0 && text[pos-1] != '\\n' {\ngoto backtrack\n}")
+
+	case Eol:
+		g.p("if pos < end && text[pos] != '\\n' {\ngoto backtrack\n}")
+
+	case Boundary, Nonboundary, ECMABoundary, NonECMABoundary:
+		isWord := "syntax.IsWordChar"
+		if op == ECMABoundary || op == NonECMABoundary {
+			isWord = "syntax.IsECMAWordChar"
+		}
+		cmp := "=="
+		if op == Nonboundary || op == NonECMABoundary {
+			cmp = "!="
+		}
+		g.p("if ((pos > 0 && %s(text[pos-1])) != (pos < end && %s(text[pos]))) %s false {\ngoto backtrack\n}", isWord, isWord, cmp)
+
+	case WordSegBoundary, NonWordSegBoundary:
+		g.p("if syntax.IsWordSegBoundary(text, pos) != %v {\ngoto backtrack\n}", op == WordSegBoundary)
+
+	case GraphemeBoundary, NonGraphemeBoundary:
+		g.p("if syntax.IsGraphemeBoundary(text, pos) != %v {\ngoto backtrack\n}", op == GraphemeBoundary)
+
+	case Grapheme:
+		g.p("if pos >= end {\ngoto backtrack\n}\npos = syntax.NextGraphemeBoundary(text, pos)")
+
+	case Beginning:
+		g.p("if pos > 0 {\ngoto backtrack\n}")
+
+	case Start:
+		g.p("if pos != in.Start {\ngoto backtrack\n}")
+
+	case EndZ:
+		g.p("if end-pos > 1 || end-pos == 1 && text[pos] != '\\n' {\ngoto backtrack\n}")
+
+	case End:
+		g.p("if pos < end {\ngoto backtrack\n}")
+
+	case One, Notone, Set:
+		g.p("if pos >= end || !(%s) {\ngoto backtrack\n}\npos++", g.test(op, g.char(ci, "pos")))
+
+	case Multi:
+		str := g.code.Strings[g.operand(0)]
+		g.p("if end-pos < %d {\ngoto backtrack\n}", len(str))
+		var terms []string
+		for i, ch := range str {
+			terms = append(terms, fmt.Sprintf("%s != %s", g.char(ci, "pos+"+strconv.Itoa(i)), runeLit(ch)))
+		}
+		g.p("if %s {\ngoto backtrack\n}\npos += %d", joinTerms(terms, " || "), len(str))
+
+	case Ref:
+		g.p("if index, length, ok := in.LastCapture(%d); ok {", g.operand(0))
+		g.p("if end-pos < length {\ngoto backtrack\n}\nfor i := 0; i < length; i++ {")
+		g.p("if %s != %s {\ngoto backtrack\n}\n}\npos += length", g.char(ci, "index+i"), g.char(ci, "pos+i"))
+		if g.ecma {
+			g.p("}")
+		} else {
+			g.p("} else {\ngoto backtrack\n}")
+		}
+
+	case Onerep, Notonerep, Setrep:
+		g.p("if end-pos < %d {\ngoto backtrack\n}", g.operand(1))
+		g.p("for i := 0; i < %d; i++ {\nif !(%s) {\ngoto backtrack\n}\n}\npos += %d", g.operand(1), g.test(op, g.char(ci, "pos+i")), g.operand(1))
+
+	case Oneloop, Notoneloop, Setloop:
+		g.p("{\nc := end - pos\nif c > %d {\nc = %d\n}\ni := 0\nfor i < c && %s {\ni++\n}", g.operand(1), g.operand(1), g.test(op, g.char(ci, "pos+i")))
+		g.p("pos += i\nif i > 0 {\ntrack = append(track, i-1, pos-1, %d)\n}\n}", pc)
+		g.back(false, "pos = track[len(track)-1]\nif i := track[len(track)-2]; i > 0 {\ntrack[len(track)-2], track[len(track)-1] = i-1, pos-1\ntrack = append(track, %d)\n} else {\ntrack = track[:len(track)-2]\n}\n%s", pc, g.next())
+
+	case Onelazy, Notonelazy, Setlazy:
+		g.p("if c := end - pos; c > 0 {\nif c > %d {\nc = %d\n}\ntrack = append(track, c-1, pos, %d)\n}", g.operand(1), g.operand(1), pc)
+		g.back(false, "pos = track[len(track)-1]\ni := track[len(track)-2]\ntrack = track[:len(track)-2]\nif !(%s) {\ngoto backtrack\n}\npos++\nif i > 0 {\ntrack = append(track, i-1, pos, %d)\n}\n%s", g.test(op, g.char(ci, "pos")), pc, g.next())
+
+	default:
+		// Call, Return and Verb
+		return ErrNotGeneratable
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package syntax
+
+import (
+	"unicode"
+)
+
+// Grapheme_Cluster_Break values from UAX #29, as far as \X needs them
+type graphemeBreak uint8
+
+const (
+	gbOther graphemeBreak = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+var (
+	gbExtendTable = mergeTables(unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend,
+		rangeTable(0x200C, 0x200C, 0x1F3FB, 0x1F3FF))
+	gbControlTable = mergeTables(unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf)
+	gbPrependTable = mergeTables(unicode.Prepended_Concatenation_Mark,
+		rangeTable(0x0D4E, 0x0D4E, 0x111C2, 0x111C3, 0x1193F, 0x1193F, 0x11941, 0x11941,
+			0x11A3A, 0x11A3A, 0x11A84, 0x11A89, 0x11D46, 0x11D46))
+	// spacing combining marks that don't count as SpacingMark
+	gbNotSpacingMarkTable = rangeTable(0x102B, 0x102C, 0x1038, 0x1038, 0x1062, 0x1064, 0x1067, 0x106D,
+		0x1083, 0x1083, 0x1087, 0x108C, 0x108F, 0x108F, 0x109A, 0x109C, 0x1A61, 0x1A61, 0x1A63, 0x1A64,
+		0xAA7B, 0xAA7B, 0xAA7D, 0xAA7D, 0x11720, 0x11721)
+
+	// Extended_Pictographic, which the unicode package has no table for
+	extPictTable = rangeTable(0x00A9, 0x00A9, 0x00AE, 0x00AE, 0x203C, 0x203C, 0x2049, 0x2049,
+		0x2122, 0x2122, 0x2139, 0x2139, 0x2194, 0x2199, 0x21A9, 0x21AA, 0x231A, 0x231B, 0x2328, 0x2328,
+		0x2388, 0x2388, 0x23CF, 0x23CF, 0x23E9, 0x23F3, 0x23F8, 0x23FA, 0x24C2, 0x24C2, 0x25AA, 0x25AB,
+		0x25B6, 0x25B6, 0x25C0, 0x25C0, 0x25FB, 0x25FE, 0x2600, 0x2605, 0x2607, 0x2612, 0x2614, 0x2685,
+		0x2690, 0x2705, 0x2708, 0x2712, 0x2714, 0x2714, 0x2716, 0x2716, 0x271D, 0x271D, 0x2721, 0x2721,
+		0x2728, 0x2728, 0x2733, 0x2734, 0x2744, 0x2744, 0x2747, 0x2747, 0x274C, 0x274C, 0x274E, 0x274E,
+		0x2753, 0x2755, 0x2757, 0x2757, 0x2763, 0x2767, 0x2795, 0x2797, 0x27A1, 0x27A1, 0x27B0, 0x27B0,
+		0x27BF, 0x27BF, 0x2934, 0x2935, 0x2B05, 0x2B07, 0x2B1B, 0x2B1C, 0x2B50, 0x2B50, 0x2B55, 0x2B55,
+		0x3030, 0x3030, 0x303D, 0x303D, 0x3297, 0x3297, 0x3299, 0x3299,
+		0x1F000, 0x1F0FF, 0x1F10D, 0x1F10F, 0x1F12F, 0x1F12F, 0x1F16C, 0x1F171, 0x1F17E, 0x1F17F,
+		0x1F18E, 0x1F18E, 0x1F191, 0x1F19A, 0x1F1AD, 0x1F1E5, 0x1F201, 0x1F20F, 0x1F21A, 0x1F21A,
+		0x1F22F, 0x1F22F, 0x1F232, 0x1F23A, 0x1F23C, 0x1F23F, 0x1F249, 0x1F3FA, 0x1F400, 0x1F53D,
+		0x1F546, 0x1F64F, 0x1F680, 0x1F6FF, 0x1F774, 0x1F77F, 0x1F7D5, 0x1F7FF, 0x1F80C, 0x1F80F,
+		0x1F848, 0x1F84F, 0x1F85A, 0x1F85F, 0x1F888, 0x1F88F, 0x1F8AE, 0x1F8FF, 0x1F90C, 0x1F93A,
+		0x1F93C, 0x1F945, 0x1F947, 0x1FAFF, 0x1FC00, 0x1FFFD)
+)
+
+func graphemeBreakOf(r rune) graphemeBreak {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200D:
+		return gbZWJ
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gbRegionalIndicator
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case r >= 0x1100 && r <= 0x115F || r >= 0xA960 && r <= 0xA97C:
+		return gbL
+	case r >= 0x1160 && r <= 0x11A7 || r >= 0xD7B0 && r <= 0xD7C6:
+		return gbV
+	case r >= 0x11A8 && r <= 0x11FF || r >= 0xD7CB && r <= 0xD7FB:
+		return gbT
+	case unicode.Is(gbExtendTable, r):
+		return gbExtend
+	case unicode.Is(gbPrependTable, r):
+		return gbPrepend
+	case unicode.Is(gbControlTable, r):
+		return gbControl
+	case r == 0x0E33 || r == 0x0EB3 || unicode.Is(unicode.Mc, r) && !unicode.Is(gbNotSpacingMarkTable, r):
+		return gbSpacingMark
+	}
+	return gbOther
+}
+
+// IsGraphemeBoundary reports whether an extended grapheme cluster boundary
+// falls between text[i-1] and text[i]
+func IsGraphemeBoundary(text []rune, i int) bool {
+	if len(text) == 0 {
+		return false
+	}
+	if i <= 0 || i >= len(text) {
+		return true
+	}
+	prev, next := graphemeBreakOf(text[i-1]), graphemeBreakOf(text[i])
+
+	switch {
+	case prev == gbCR && next == gbLF:
+		return false
+	case prev == gbCR || prev == gbLF || prev == gbControl,
+		next == gbCR || next == gbLF || next == gbControl:
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT),
+		(prev == gbLV || prev == gbV) && (next == gbV || next == gbT),
+		(prev == gbLVT || prev == gbT) && next == gbT:
+		return false
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark || prev == gbPrepend:
+		return false
+	case prev == gbZWJ && unicode.Is(extPictTable, text[i]):
+		// emoji ZWJ sequences: ExtPict Extend* ZWJ x ExtPict
+		j := i - 2
+		for j >= 0 && graphemeBreakOf(text[j]) == gbExtend {
+			j--
+		}
+		return j < 0 || !unicode.Is(extPictTable, text[j])
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator:
+		// flags pair up regional indicators from the left
+		n := 0
+		for j := i - 1; j >= 0 && graphemeBreakOf(text[j]) == gbRegionalIndicator; j-- {
+			n++
+		}
+		return n%2 == 0
+	}
+	return true
+}
+
+// NextGraphemeBoundary returns the end of the grapheme cluster starting at i
+func NextGraphemeBoundary(text []rune, i int) int {
+	for i++; !IsGraphemeBoundary(text, i); i++ {
+	}
+	return i
+}
+
+// PrevGraphemeBoundary returns the start of the grapheme cluster ending at i
+func PrevGraphemeBoundary(text []rune, i int) int {
+	for i--; !IsGraphemeBoundary(text, i); i-- {
+	}
+	return i
+}
@@ -0,0 +1,75 @@
+package syntax
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hint suggests a fix for the common mistakes behind an error with code
+// for the token from pos to end, or returns ""
+func (p *parser) hint(code ErrorCode, pos, end int) string {
+	tok := string(p.pattern[pos:end])
+	next := rune(-1)
+	if end < len(p.pattern) {
+		next = p.pattern[end]
+	}
+
+	switch code {
+	case ErrMissingRepeatArgument:
+		return fmt.Sprintf("there's nothing before the %v to repeat; to match it, escape it as \\%v", tok, tok)
+
+	case ErrInvalidRepeatOp:
+		return "to repeat something already repeated, group it first, as in (?:a+)*"
+
+	case ErrInvalidGroupName:
+		if len(tok) < 3 || (tok[2] != '<' && tok[2] != '\'') {
+			return ""
+		}
+		name := tok[3:]
+		if name == "" || next != ')' {
+			if tok[2] == '<' {
+				return "for a lookbehind, write (?<=...) or (?<!...)"
+			}
+			return ""
+		}
+		if tok[2] == '<' {
+			return fmt.Sprintf("did you mean (?<%v>...)?", name)
+		}
+		return fmt.Sprintf("did you mean (?'%v'...)?", name)
+
+	case ErrUnrecognizedGrouping:
+		switch {
+		case tok == "(?P" && next == '<':
+			return "(?P<name>...) is RE2 and Python syntax; write (?<name>...), or compile with RE2"
+		case tok == "(?P" && next == '=':
+			return "(?P=name) is Python syntax; write \\k<name>"
+		case tok == "(?U":
+			return "the U flag, making quantifiers lazy, isn't supported; write *?, +? and ?? instead"
+		case strings.HasPrefix(tok, "(?<") && next == -1:
+			return fmt.Sprintf("did you mean (?<%v>...)?", tok[3:])
+		}
+
+	case ErrReversedCharRange:
+		if r := []rune(tok); len(r) == 3 && r[1] == '-' {
+			return fmt.Sprintf("did you mean %c-%c?", r[2], r[0])
+		}
+
+	case ErrUnexpectedParen:
+		return "to match a ), escape it as \\)"
+
+	case ErrMissingParen:
+		return "close the group with ), or escape the ( as \\( to match it"
+
+	case ErrUnterminatedBracket:
+		if strings.HasPrefix(tok, "[]") || strings.HasPrefix(tok, "[^]") {
+			return "a ] just after the [ is in the class, not its end"
+		}
+		return "close the class with ], or escape the [ as \\[ to match it"
+
+	case ErrTooFewHex:
+		if strings.HasPrefix(tok, `\u{`) {
+			return "\\u{...} is ECMAScript syntax; write \\x{...}"
+		}
+	}
+	return ""
+}
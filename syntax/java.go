@@ -0,0 +1,156 @@
+package syntax
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// javaProperties are the \p{name} properties only Java mode knows about:
+// the java.lang.Character ones and the ASCII-only POSIX ones.  They are
+// added to unicodeCategories at init so sets can look them up by name.
+var javaProperties = map[string]*unicode.RangeTable{
+	"javaLowerCase":     mergeTables(unicode.Ll, unicode.Other_Lowercase),
+	"javaUpperCase":     mergeTables(unicode.Lu, unicode.Other_Uppercase),
+	"javaTitleCase":     unicode.Lt,
+	"javaDigit":         unicode.Nd,
+	"javaLetter":        unicode.L,
+	"javaLetterOrDigit": mergeTables(unicode.L, unicode.Nd),
+	"javaAlphabetic":    mergeTables(unicode.L, unicode.Nl, unicode.Other_Alphabetic),
+	"javaIdeographic":   unicode.Ideographic,
+	"javaSpaceChar":     unicode.Z,
+	"javaISOControl":    rangeTable(0, 0x1f, 0x7f, 0x9f),
+	// Zs, Zl and Zp except the no-break spaces, plus the ASCII controls
+	"javaWhitespace": rangeTable('\t', '\r', 0x1c, ' ', 0x1680, 0x1680, 0x2000, 0x2006,
+		0x2008, 0x200a, 0x2028, 0x2029, 0x205f, 0x205f, 0x3000, 0x3000),
+
+	"Lower":  rangeTable('a', 'z'),
+	"Upper":  rangeTable('A', 'Z'),
+	"ASCII":  rangeTable(0, 0x7f),
+	"Alpha":  rangeTable('A', 'Z', 'a', 'z'),
+	"Digit":  rangeTable('0', '9'),
+	"Alnum":  rangeTable('0', '9', 'A', 'Z', 'a', 'z'),
+	"Punct":  rangeTable('!', '/', ':', '@', '[', '`', '{', '~'),
+	"Graph":  rangeTable('!', '~'),
+	"Print":  rangeTable(' ', '~'),
+	"Blank":  rangeTable('\t', '\t', ' ', ' '),
+	"Cntrl":  rangeTable(0, 0x1f, 0x7f, 0x7f),
+	"XDigit": rangeTable('0', '9', 'A', 'F', 'a', 'f'),
+	"Space":  rangeTable('\t', '\r', ' ', ' '),
+}
+
+// javaBinaryProperties maps Java's \p{IsName} binary properties to the
+// table with the same members
+var javaBinaryProperties = map[string]string{
+	"Alphabetic":              "javaAlphabetic",
+	"Ideographic":             "Ideographic",
+	"Letter":                  "L",
+	"Lowercase":               "javaLowerCase",
+	"Uppercase":               "javaUpperCase",
+	"Titlecase":               "Lt",
+	"Punctuation":             "P",
+	"Control":                 "Cc",
+	"White_Space":             "White_Space",
+	"WhiteSpace":              "White_Space",
+	"Digit":                   "Nd",
+	"Hex_Digit":               "Hex_Digit",
+	"HexDigit":                "Hex_Digit",
+	"Join_Control":            "Join_Control",
+	"JoinControl":             "Join_Control",
+	"Noncharacter_Code_Point": "Noncharacter_Code_Point",
+	"NoncharacterCodePoint":   "Noncharacter_Code_Point",
+}
+
+func init() {
+	for k, v := range javaProperties {
+		unicodeCategories[k] = v
+	}
+}
+
+// javaPropertyName resolves the name in a Java \p{name} to the category
+// we know it by.  Java spells scripts, categories and binary properties
+// with an Is prefix (\p{IsLatin}, \p{IsLu}, \p{IsAlphabetic}).
+func javaPropertyName(name string) (string, bool) {
+	if strings.HasPrefix(name, "Is") {
+		n := name[2:]
+		if alias, ok := javaBinaryProperties[n]; ok {
+			return alias, true
+		}
+		if isScript(n) || isGeneralCategory(n) {
+			return n, true
+		}
+	}
+	if isValidUnicodeCat(name) {
+		return name, true
+	}
+	return "", false
+}
+
+// rangeTable builds a table from pairs of first, last runes
+func rangeTable(pairs ...rune) *unicode.RangeTable {
+	var rs []singleRange
+	for i := 0; i < len(pairs); i += 2 {
+		rs = append(rs, singleRange{first: pairs[i], last: pairs[i+1]})
+	}
+	return tableFromRanges(rs)
+}
+
+// mergeTables builds the union of the given tables
+func mergeTables(tables ...*unicode.RangeTable) *unicode.RangeTable {
+	var rs []singleRange
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			rs = append(rs, singleRange{first: lo, last: hi})
+			return
+		}
+		for c := lo; c <= hi; c += stride {
+			rs = append(rs, singleRange{first: c, last: c})
+		}
+	}
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range t.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+	return tableFromRanges(rs)
+}
+
+// tableFromRanges sorts and merges rs into a table
+func tableFromRanges(rs []singleRange) *unicode.RangeTable {
+	sort.Sort(singleRangeSorter(rs))
+	var merged []singleRange
+	for _, r := range rs {
+		if n := len(merged); n > 0 && r.first <= merged[n-1].last+1 {
+			if r.last > merged[n-1].last {
+				merged[n-1].last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	t := &unicode.RangeTable{}
+	for _, r := range merged {
+		if r.first <= 0xFFFF {
+			hi := r.last
+			if hi > 0xFFFF {
+				hi = 0xFFFF
+			}
+			t.R16 = append(t.R16, unicode.Range16{Lo: uint16(r.first), Hi: uint16(hi), Stride: 1})
+			if hi <= unicode.MaxLatin1 {
+				t.LatinOffset++
+			}
+		}
+		if r.last > 0xFFFF {
+			lo := r.first
+			if lo < 0x10000 {
+				lo = 0x10000
+			}
+			t.R32 = append(t.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(r.last), Stride: 1})
+		}
+	}
+	return t
+}
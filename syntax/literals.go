@@ -0,0 +1,120 @@
+package syntax
+
+import (
+	"math"
+)
+
+// maxLiteralRepeat bounds how far a{n} style repetitions are expanded
+const maxLiteralRepeat = 256
+
+// RequiredLiterals returns literal strings that every match of the tree
+// must contain, in the order they appear in the pattern.  The list is
+// conservative: an empty result only means nothing could be proven, not
+// that the pattern has no literal text.  Case-insensitive and
+// right-to-left parts of the pattern are skipped.
+func (t *RegexTree) RequiredLiterals() []string {
+	exact, ok, req := requiredLiterals(t.root)
+	if ok {
+		req = [][]rune{exact}
+	}
+
+	seen := make(map[string]bool)
+	var out []string
+	for _, lit := range req {
+		s := string(lit)
+		if len(s) == 0 || seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+// requiredLiterals walks node and returns either the exact text it
+// always matches (ok is true), or the literals any text it matches must
+// contain.
+func requiredLiterals(node *regexNode) (exact []rune, ok bool, req [][]rune) {
+	if node.options&(IgnoreCase|RightToLeft) != 0 {
+		return nil, false, nil
+	}
+
+	switch node.t {
+	case ntOne:
+		return []rune{node.ch}, true, nil
+
+	case ntMulti:
+		return node.str, true, nil
+
+	case ntOneloop, ntOnelazy:
+		if node.m == 0 {
+			return nil, false, nil
+		}
+		lit := repeatRunes([]rune{node.ch}, node.m)
+		if node.m == node.n && len(lit) == node.m {
+			return lit, true, nil
+		}
+		return nil, false, [][]rune{lit}
+
+	case ntEmpty, ntBol, ntEol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary,
+		ntBeginning, ntStart, ntEndZ, ntEnd, ntVerb,
+		ntWordSegBoundary, ntNonWordSegBoundary, ntGraphemeBoundary, ntNonGraphemeBoundary:
+		// zero-width, so the text on either side is adjacent
+		return nil, true, nil
+
+	case ntCapture, ntGroup, ntGreedy:
+		return requiredLiterals(node.children[0])
+
+	case ntLoop, ntLazyloop:
+		if node.m == 0 {
+			return nil, false, nil
+		}
+		exact, ok, req := requiredLiterals(node.children[0])
+		if !ok || len(exact) == 0 {
+			return exact, ok, req
+		}
+		lit := repeatRunes(exact, node.m)
+		if node.m == node.n && len(lit) == len(exact)*node.m {
+			return lit, true, nil
+		}
+		return nil, false, [][]rune{lit}
+
+	case ntConcatenate:
+		var cur []rune
+		allExact := true
+		for _, child := range node.children {
+			e, ok, r := requiredLiterals(child)
+			if ok {
+				cur = append(cur, e...)
+				continue
+			}
+			allExact = false
+			if len(cur) > 0 {
+				req = append(req, cur)
+				cur = nil
+			}
+			req = append(req, r...)
+		}
+		if allExact {
+			return cur, true, nil
+		}
+		if len(cur) > 0 {
+			req = append(req, cur)
+		}
+		return nil, false, req
+	}
+
+	// alternations, sets, lookarounds and the like don't pin down any text
+	return nil, false, nil
+}
+
+func repeatRunes(r []rune, n int) []rune {
+	if n == math.MaxInt32 || n*len(r) > maxLiteralRepeat {
+		n = maxLiteralRepeat / len(r)
+	}
+	out := make([]rune, 0, n*len(r))
+	for i := 0; i < n; i++ {
+		out = append(out, r...)
+	}
+	return out
+}
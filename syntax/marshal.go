@@ -0,0 +1,500 @@
+package syntax
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"unicode"
+)
+
+// The binary form of a Code starts with codeMagic and a format version,
+// then has each field in the order they are written below.  Numbers are
+// varints.  Unicode categories are saved by name and looked up again when
+// the code is loaded, so classes given to RegisterProperty or RegisterClass
+// must be registered before that.
+
+const (
+	codeMagic   = "regexp2\x00"
+	codeVersion = 4
+)
+
+var errCorruptCode = errors.New("regexp2: corrupt compiled code")
+
+// MarshalBinary encodes the code so UnmarshalCode can rebuild it without
+// parsing the pattern again.
+func (c *Code) MarshalBinary() ([]byte, error) {
+	e := &encoder{}
+	e.buf.WriteString(codeMagic)
+	e.int(codeVersion)
+
+	e.ints(c.Codes)
+	e.int(len(c.Strings))
+	for _, s := range c.Strings {
+		e.runes(s)
+	}
+	e.int(len(c.Sets))
+	for _, s := range c.Sets {
+		e.set(s)
+	}
+	e.int(c.TrackCount)
+	e.intMap(c.Caps)
+	e.int(c.Capsize)
+
+	e.bool(c.FcPrefix != nil)
+	if c.FcPrefix != nil {
+		e.runes(c.FcPrefix.PrefixStr)
+		e.set(&c.FcPrefix.PrefixSet)
+		e.bool(c.FcPrefix.CaseInsensitive)
+	}
+	e.bool(c.BmPrefix != nil)
+	if c.BmPrefix != nil {
+		e.runes(c.BmPrefix.pattern)
+		e.bool(c.BmPrefix.caseInsensitive)
+		e.bool(c.BmPrefix.rightToLeft)
+	}
+	e.int(int(c.Anchors))
+	e.bool(c.RightToLeft)
+	e.ints(c.MemoLoops)
+
+	e.bool(c.NFA != nil)
+	if c.NFA != nil {
+		e.nfa(c.NFA)
+	}
+	e.bool(c.CapNFA != nil)
+	if c.CapNFA != nil {
+		e.nfa(c.CapNFA)
+	}
+	e.bool(c.OnePass != nil)
+
+	e.bool(c.Culture != nil)
+	e.int(len(c.Culture))
+	for _, cr := range c.Culture {
+		e.int(int(cr.Lo))
+		e.int(int(cr.Hi))
+		for _, d := range cr.Delta {
+			e.int(int(d))
+		}
+	}
+
+	leading := -1
+	e.int(len(c.Tries))
+	for i, t := range c.Tries {
+		e.int(len(t.strs))
+		for _, s := range t.strs {
+			e.runes(s)
+		}
+		e.bool(t.ignoreCase)
+		if t == c.LeadingTrie {
+			leading = i
+		}
+	}
+	e.int(leading)
+
+	return e.buf.Bytes(), nil
+}
+
+// UnmarshalCode rebuilds a Code from the output of MarshalBinary.
+func UnmarshalCode(data []byte) (*Code, error) {
+	if !bytes.HasPrefix(data, []byte(codeMagic)) {
+		return nil, errors.New("regexp2: not compiled code")
+	}
+	d := &decoder{data: data[len(codeMagic):]}
+	if v := d.int(); v != codeVersion {
+		return nil, errors.New("regexp2: unsupported compiled code version")
+	}
+
+	c := &Code{}
+	c.Codes = d.ints()
+	c.Strings = make([][]rune, d.len())
+	for i := range c.Strings {
+		c.Strings[i] = d.runes()
+	}
+	c.Sets = make([]*CharSet, d.len())
+	for i := range c.Sets {
+		c.Sets[i] = d.set()
+	}
+	c.SetsASCII = asciiSets(c.Sets)
+	c.TrackCount = d.int()
+	c.Caps = d.intMap()
+	c.Capsize = d.int()
+
+	if d.bool() {
+		c.FcPrefix = &Prefix{PrefixStr: d.runes()}
+		if s := d.set(); s != nil {
+			c.FcPrefix.PrefixSet = *s
+		}
+		c.FcPrefix.CaseInsensitive = d.bool()
+	}
+	if d.bool() {
+		pattern := d.runes()
+		ci, rtl := d.bool(), d.bool()
+		if d.err == nil && len(pattern) == 0 {
+			d.err = errCorruptCode
+		}
+		if d.err == nil {
+			c.BmPrefix = newBmPrefix(pattern, ci, rtl)
+		}
+	}
+	c.Anchors = AnchorLoc(d.int())
+	c.RightToLeft = d.bool()
+	c.MemoLoops = d.ints()
+
+	if d.bool() {
+		c.NFA = d.nfa()
+	}
+	if d.bool() {
+		c.CapNFA = d.nfa()
+	}
+	onePass := d.bool()
+
+	hasCulture := d.bool()
+	culture := make(unicode.SpecialCase, d.len())
+	for i := range culture {
+		culture[i].Lo = uint32(d.int())
+		culture[i].Hi = uint32(d.int())
+		for j := range culture[i].Delta {
+			culture[i].Delta[j] = rune(d.int())
+		}
+	}
+	if hasCulture {
+		c.Culture = culture
+	}
+
+	c.Tries = make([]*LiteralTrie, d.len())
+	for i := range c.Tries {
+		strs := make([][]rune, d.len())
+		for j := range strs {
+			strs[j] = d.runes()
+		}
+		c.Tries[i] = newLiteralTrie(strs, d.bool(), c.Culture)
+	}
+	if leading := d.int(); leading >= 0 && leading < len(c.Tries) {
+		c.LeadingTrie = c.Tries[leading]
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.data) != 0 {
+		return nil, errCorruptCode
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if onePass {
+		// the first sets are worked out again rather than saved
+		if c.CapNFA == nil {
+			return nil, errCorruptCode
+		}
+		c.OnePass = newOnePass(*c.CapNFA)
+	}
+	return c, nil
+}
+
+// validate checks that the instructions of code loaded by UnmarshalCode
+// only refer to things that are there, so a damaged file is an error
+// rather than a panic while matching
+func (c *Code) validate() error {
+	inRange := func(i, n int) bool { return i >= 0 && i < n }
+	for pc := 0; pc < len(c.Codes); {
+		op := InstOp(c.Codes[pc])
+		if op < 0 || int(op&Mask) >= len(codeStr) || op&^(Mask|Rtl|Ci) != 0 {
+			return errCorruptCode
+		}
+		size := opcodeSize(op)
+		if pc+size > len(c.Codes) {
+			return errCorruptCode
+		}
+		operand := 0
+		if size > 1 {
+			operand = c.Codes[pc+1]
+		}
+		switch op & Mask {
+		case Set, Setrep, Setloop, Setlazy:
+			if !inRange(operand, len(c.Sets)) {
+				return errCorruptCode
+			}
+		case Multi:
+			if !inRange(operand, len(c.Strings)) {
+				return errCorruptCode
+			}
+		case Trie:
+			if !inRange(operand, len(c.Tries)) {
+				return errCorruptCode
+			}
+		case Goto, Lazybranch, Branchmark, Lazybranchmark, Branchcount, Lazybranchcount, Call:
+			if !inRange(operand, len(c.Codes)) {
+				return errCorruptCode
+			}
+		}
+		pc += size
+	}
+
+	if c.NFA != nil && !c.NFA.valid(0) {
+		return errCorruptCode
+	}
+	if c.CapNFA != nil && !c.CapNFA.valid(c.Capsize) {
+		return errCorruptCode
+	}
+	return nil
+}
+
+// valid checks the NFA's instructions the same way, for one with capsize
+// capture slots
+func (n *NFA) valid(capsize int) bool {
+	inRange := func(i, n int) bool { return i >= 0 && i < n }
+	if !inRange(n.Start, len(n.Insts)) {
+		return false
+	}
+	for _, inst := range n.Insts {
+		if !inRange(inst.Out, len(n.Insts)) && inst.Op != NFAMatch && inst.Op != NFAFail {
+			return false
+		}
+		switch inst.Op {
+		case NFASplit:
+			if !inRange(inst.Out1, len(n.Insts)) {
+				return false
+			}
+		case NFASet:
+			if inst.Set == nil {
+				return false
+			}
+		case NFACapture:
+			if !inRange(inst.Cap, capsize*2) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) int(i int) {
+	var b [binary.MaxVarintLen64]byte
+	e.buf.Write(b[:binary.PutVarint(b[:], int64(i))])
+}
+
+func (e *encoder) bool(b bool) {
+	if b {
+		e.int(1)
+	} else {
+		e.int(0)
+	}
+}
+
+func (e *encoder) ints(is []int) {
+	e.int(len(is))
+	for _, i := range is {
+		e.int(i)
+	}
+}
+
+func (e *encoder) runes(rs []rune) {
+	e.int(len(rs))
+	for _, r := range rs {
+		e.int(int(r))
+	}
+}
+
+func (e *encoder) string(s string) {
+	e.int(len(s))
+	e.buf.WriteString(s)
+}
+
+// intMap writes m sorted by key, so the same code always encodes the same
+func (e *encoder) intMap(m map[int]int) {
+	e.bool(m != nil)
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	e.int(len(keys))
+	for _, k := range keys {
+		e.int(k)
+		e.int(m[k])
+	}
+}
+
+func (e *encoder) set(c *CharSet) {
+	e.bool(c.negate)
+	e.bool(c.anything)
+	e.int(len(c.ranges))
+	for _, r := range c.ranges {
+		e.int(int(r.first))
+		e.int(int(r.last))
+	}
+	e.int(len(c.categories))
+	for _, ct := range c.categories {
+		e.string(ct.cat)
+		e.bool(ct.negate)
+	}
+	e.int(len(c.sets))
+	for _, s := range c.sets {
+		e.set(s)
+	}
+	e.bool(c.sub != nil)
+	if c.sub != nil {
+		e.set(c.sub)
+	}
+}
+
+func (e *encoder) nfa(n *NFA) {
+	e.int(n.Start)
+	e.int(len(n.Insts))
+	for _, inst := range n.Insts {
+		e.int(int(inst.Op))
+		e.int(inst.Out)
+		e.int(inst.Out1)
+		e.int(int(inst.Ch))
+		e.bool(inst.Set != nil)
+		if inst.Set != nil {
+			e.set(inst.Set)
+		}
+		e.int(int(inst.Assert))
+		e.bool(inst.IgnoreCase)
+		e.int(inst.Cap)
+	}
+}
+
+// decoder reads what encoder wrote.  After the first error every read
+// returns a zero value and err says what went wrong.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) int() int {
+	if d.err != nil {
+		return 0
+	}
+	i, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errCorruptCode
+		return 0
+	}
+	d.data = d.data[n:]
+	return int(i)
+}
+
+// len reads a length, which can't be more than the bytes that are left
+// since every element takes at least one
+func (d *decoder) len() int {
+	n := d.int()
+	if n < 0 || n > len(d.data) {
+		if d.err == nil {
+			d.err = errCorruptCode
+		}
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) bool() bool {
+	return d.int() != 0
+}
+
+func (d *decoder) ints() []int {
+	n := d.len()
+	if n == 0 {
+		return nil
+	}
+	is := make([]int, n)
+	for i := range is {
+		is[i] = d.int()
+	}
+	return is
+}
+
+func (d *decoder) runes() []rune {
+	n := d.len()
+	if n == 0 {
+		return nil
+	}
+	rs := make([]rune, n)
+	for i := range rs {
+		r := d.int()
+		if r < 0 || r > unicode.MaxRune {
+			d.err = errCorruptCode
+			return nil
+		}
+		rs[i] = rune(r)
+	}
+	return rs
+}
+
+func (d *decoder) string() string {
+	n := d.len()
+	if d.err != nil {
+		return ""
+	}
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *decoder) intMap() map[int]int {
+	if !d.bool() {
+		d.int()
+		return nil
+	}
+	n := d.len()
+	m := make(map[int]int, n)
+	for i := 0; i < n; i++ {
+		k := d.int()
+		m[k] = d.int()
+	}
+	return m
+}
+
+func (d *decoder) set() *CharSet {
+	c := &CharSet{negate: d.bool(), anything: d.bool()}
+	c.ranges = make([]singleRange, d.len())
+	for i := range c.ranges {
+		c.ranges[i].first = rune(d.int())
+		c.ranges[i].last = rune(d.int())
+	}
+	n := d.len()
+	for i := 0; i < n; i++ {
+		name, negate := d.string(), d.bool()
+		if d.err != nil {
+			return c
+		}
+		if name != spaceCategoryText && name != wordCategoryText && !isValidUnicodeCat(name) {
+			d.err = errors.New("regexp2: unknown unicode category, script, or property '" + name + "' in compiled code")
+			return c
+		}
+		c.categories = append(c.categories, newCategory(name, negate))
+	}
+	c.sets = make([]*CharSet, d.len())
+	for i := range c.sets {
+		c.sets[i] = d.set()
+	}
+	if d.bool() {
+		c.sub = d.set()
+	}
+	return c
+}
+
+func (d *decoder) nfa() *NFA {
+	n := &NFA{Start: d.int()}
+	n.Insts = make([]NFAInst, d.len())
+	for i := range n.Insts {
+		inst := &n.Insts[i]
+		inst.Op = NFAOp(d.int())
+		inst.Out = d.int()
+		inst.Out1 = d.int()
+		inst.Ch = rune(d.int())
+		if d.bool() {
+			inst.Set = d.set()
+		}
+		inst.Assert = InstOp(d.int())
+		inst.IgnoreCase = d.bool()
+		inst.Cap = d.int()
+	}
+	return n
+}
@@ -0,0 +1,120 @@
+package syntax
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:generate go run gen_names.go -data UnicodeData.txt -version 14.0.0
+
+// algorithmicNames are the names made up of a prefix and the code point
+// in hex, with the characters that have them
+var algorithmicNames = []struct {
+	prefix string
+	table  *unicode.RangeTable
+}{
+	{"CJKUNIFIEDIDEOGRAPH", unicode.Unified_Ideograph},
+	{"CJKCOMPATIBILITYIDEOGRAPH", rangeTable(0xF900, 0xFA6D, 0xFA70, 0xFAD9, 0x2F800, 0x2FA1D)},
+	{"TANGUTIDEOGRAPH", rangeTable(0x17000, 0x187F7, 0x18D00, 0x18D08)},
+	{"KHITANSMALLSCRIPTCHARACTER", rangeTable(0x18B00, 0x18CD5)},
+	{"NUSHUCHARACTER", rangeTable(0x1B170, 0x1B2FB)},
+}
+
+// the short names of the Hangul jamo that make up a syllable's name
+var (
+	hangulL = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	hangulV = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	hangulT = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+var (
+	charNamesOnce sync.Once
+	charNames     map[string]rune
+)
+
+// loadCharNames unpacks names_table.go into charNames, keyed by looseName
+func loadCharNames() {
+	words := strings.Split(charNameWords, " ")
+	data, err := base64.StdEncoding.DecodeString(charNameData)
+	if err != nil {
+		panic("regexp2: bad character name table: " + err.Error())
+	}
+
+	charNames = make(map[string]rune, 48000)
+	next := func() int {
+		v, n := binary.Uvarint(data)
+		data = data[n:]
+		return int(v)
+	}
+	r := rune(0)
+	var name []string
+	for len(data) > 0 {
+		r += rune(next())
+		name = name[:0]
+		for n := next(); n > 0; n-- {
+			name = append(name, words[next()])
+		}
+		charNames[looseName(strings.Join(name, " "))] = r
+	}
+
+	for i := 0; i < len(hangulL)*len(hangulV)*len(hangulT); i++ {
+		l, v, t := i/(len(hangulV)*len(hangulT)), i/len(hangulT)%len(hangulV), i%len(hangulT)
+		charNames["HANGULSYLLABLE"+hangulL[l]+hangulV[v]+hangulT[t]] = 0xAC00 + rune(i)
+	}
+}
+
+// lookupCharName finds the character with the given Unicode name.  Names
+// are matched loosely, as UAX #44 suggests: case, spaces, underscores and
+// hyphens inside words don't matter.
+func lookupCharName(name string) (rune, bool) {
+	if strings.HasPrefix(name, "U+") {
+		r, err := strconv.ParseUint(name[2:], 16, 32)
+		if err != nil || r > unicode.MaxRune {
+			return 0, false
+		}
+		return rune(r), true
+	}
+
+	key := looseName(name)
+	for _, a := range algorithmicNames {
+		if strings.HasPrefix(key, a.prefix) {
+			r, err := strconv.ParseUint(key[len(a.prefix):], 16, 32)
+			if err == nil && r <= unicode.MaxRune && unicode.Is(a.table, rune(r)) {
+				return rune(r), true
+			}
+		}
+	}
+
+	charNamesOnce.Do(loadCharNames)
+	r, ok := charNames[key]
+	return r, ok
+}
+
+// looseName drops the parts of a name loose matching ignores
+func looseName(name string) string {
+	upper := strings.ToUpper(name)
+	b := make([]byte, 0, len(upper))
+	for i := 0; i < len(upper); i++ {
+		c := upper[i]
+		if c == ' ' || c == '_' {
+			continue
+		}
+		if c == '-' && i > 0 && i < len(upper)-1 && isNameChar(upper[i-1]) && isNameChar(upper[i+1]) {
+			continue
+		}
+		b = append(b, c)
+	}
+	// U+1180 is the one name the medial hyphen tells apart from another
+	if key := string(b); key != "HANGULJUNGSEONGOE" || !strings.HasSuffix(upper, "O-E") {
+		return key
+	}
+	return "HANGULJUNGSEONGO-E"
+}
+
+func isNameChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,6729 @@
+// Code generated by gen_names.go; DO NOT EDIT.
+
+package syntax
+
+// CharNamesVersion is the Unicode version of the \N{NAME} names
+const CharNamesVersion = "14.0.0"
+
+// 13106 words, separated by spaces
+const charNameWords = "" +
+	"LETTER SIGN WITH SMALL SYLLABLE CAPITAL HIEROGLYPH LATIN ARABIC " +
+	"YI CUNEIFORM SYMBOL MATHEMATICAL EGYPTIAN DIGIT FORM VOWEL TANGU" +
+	"T CANADIAN SYLLABICS A SIGNWRITING TIMES BAMUM AND BOLD ANATOLIA" +
+	"N ARROW HANGUL NUMBER LINEAR COMBINING LIGATURE GREEK ETHIOPIC M" +
+	"USICAL OLD RIGHT ABOVE LEFT FOR CYRILLIC MARK SQUARE ITALIC CIRC" +
+	"LED RADICAL SANS-SERIF U ONE FINAL DOUBLE TAI O TWO BLACK E BELO" +
+	"W I DOT VAI MODIFIER B WHITE HENTAIGANA THREE TO VARIATION PATTE" +
+	"RN BRAILLE BYZANTINE KATAKANA STROKE ISOLATED OF HEAVY MIDDLE MY" +
+	"ANMAR FOUR KANGXI KIKAKUI MENDE HOOK VERTICAL TIBETAN FIVE CJK I" +
+	"NITIAL MEEM HMONG KA RIGHTWARDS YEH COPTIC ZNAMENNY CARRIER PLUS" +
+	" UP R S BOX SQUARED GEORGIAN CHEROKEE FACE MONGOLIAN INDEX LOWER" +
+	" DEVANAGARI LEFTWARDS HALF L PHASE-E BLOCK UPPER DOWN EIGHT SIX " +
+	"ALEF BAR CIRCLE MIAO LIGHT VOCALIC KHMER TILE DRAWINGS DUPLOYAN " +
+	"SEVEN BRACKET HA PARENTHESIZED THAM GONDI HUNDRED LOW NINE TONE " +
+	"JONGSEONG DOTS GLAGOLITIC HEBREW HIGH TAMIL THUMB MALAYALAM OVER" +
+	" SIYAQ PAHAWH CHOSEONG BALINESE PA HAND-FIST N HALFWIDTH MEROITI" +
+	"C RA SCRIPT IDEOGRAPHIC RING TURNED IDEOGRAM PHASE-D ALCHEMICAL " +
+	"EQUAL HAH NEUME BRAHMI MA LA LONG THOUSAND HORIZONTAL NA NUMERIC" +
+	" TRIANGLE M OPEN SINHALA YA TA HUNGARIAN DOWNWARDS MEDIUM SA TIL" +
+	"DE BARB DIAGONAL NORTH TAG UPWARDS DA FULLWIDTH G JEEM GA PUNCTU" +
+	"ATION T DOMINO K TELUGU CYPRO-MINOAN FRAKTUR V FRACTION MEDIAL N" +
+	"EGATIVE PHASE-C SIDDHAM BHAIKSUKI D DOUBLE-STRUCK H NEWA ARMENIA" +
+	"N BENGALI CHESS SHARADA CHARACTER HIRAGANA JUNGSEONG OR Z ACUTE " +
+	"BA LARGE Y ZERO GUJARATI IDEOGRAPH JAVANESE MEDEFAIDRIN ORIYA CU" +
+	"RSIVE KANNADA SYRIAC WEST-CREE AA RUNIC TANGSA ADLAM CONSONANT S" +
+	"OUTH C CARD NEW PHASE-A THAI REVERSED GRANTHA P SOGDIAN X CITI L" +
+	"UE MOVEMENT-WALLPLANE SUBJOINED WARANG CHAM DASIA PLAYING SOYOMB" +
+	"O TEN LAO SAURASHTRA TIRHUTA AI CIRCUMFLEX SINGLE TETRAGRAM W DE" +
+	"SERET GURMUKHI HAMZA II J MAYEK MEETEI MODI NOTATION PSILI INDIC" +
+	" NUMERAL POINTING GA2 MOVEMENT-FLOORPLANE TRIANGLE-HEADED ARROWH" +
+	"EAD DIAERESIS NGA AU BOPOMOFO MASARAM JA LAM LEPCHA SHA STOP UU " +
+	"CA OMEGA TURKIC VIET WA AKURU ALPHA DIVES F OSAGE SHORT SUNDANES" +
+	"E ZANABAZAR CHAKMA LITTLE NYIAKENG PUACHUE APL FUNCTIONAL GRAVE " +
+	"LINE VITHKUQI KHUDAWADI MAKSURA TELEGRAPH ACCENT KAITHI KHAH KHA" +
+	"ROSHTHI LIMBU MACRON MARCHEN NOON ON TAKRI CHA INVERTED CENTRE K" +
+	"HA NOT NYA AHOM LI NANDINAGARI ORNAMENT ARABIAN HARPOON HEXAGRAM" +
+	" ROTATED ANGLE EAST GUNJALA IN LAGAB KHOJKI MONOSPACE NKO OXIA S" +
+	"AMARITAN VARIA COMMA OTTOMAN TAIL THA DOGRA GREATER-THAN LESS-TH" +
+	"AN Q STAR TRIPLE NUN TIFINAGH WANCHO HAU AEGEAN BREVE CIN INSCRI" +
+	"PTIONAL PAU ASH BATAK EN ETA GUNU PAHLAVI PHAGS-PA PHASE-B AVEST" +
+	"AN CYPRIOT PHA VA WEST HITTING IOTA PHASE-F ACROPHONIC ALBANIAN " +
+	"ANUSVARA CAUCASIAN DEGREES PERSIAN TEH CROSS FULL PI ROMAN UNIFI" +
+	"ED KAYAH MANICHAEAN ROHINGYA SEEN TTA DDA HANIFI KAF THAANA ZA C" +
+	"ARIAN DISC HEH LE LISU MOUTH OL TWENTY CHIKI DHA OO SHAVIAN UPSI" +
+	"LON VEDIC DIAMOND GHA OPERATOR PE PERISPOMENI TOP YEZIDI ASTERIS" +
+	"K MTAVRULI PARENTHESIS PHAISTOS SHAN SHE AIN BEH CARON NAGRI NNA" +
+	" SYLOTI BHA CLOSED MAHJONG OE SHEEN THE ALI GALI MRO PERMIC DOTT" +
+	"ED EE NEUTRAL ORKHON RETROFLEX TTHA WAW DASH FA HE TENU VIRAMA W" +
+	"IDE-HEADED ALTERNATE ARCHAIC BASSA DDHA DESCENDER ELBASAN MU NAB" +
+	"ATAEAN NO OSMANYA RR SSA SUBSCRIPT TAH YU CURVE MAHAJANI EIGHTH " +
+	"EPSILON FEH MULTANI NINETY QUARTER REH SECTION VISARGA YPOGEGRAM" +
+	"MENI AN BOTTOM CORNER HI INSTRUMENTAL POINTED REJANG TE VAH CURL" +
+	"Y EQUALS FROM JHA LU2 QAF SIDE STRAIGHT ARABIC-INDIC FIFTY GLOTT" +
+	"AL LL NE NI SOMPENG SORA TACK CANDRABINDU DUG HORN ME RAISED SAD" +
+	" SEVENTY STRELA AE INDICATOR PRESENTATION SHIN WAVE AFFIX COLON " +
+	"CROSSING DAD DAY DINGBAT HIEROGLYPHIC IGI NU PALMYRENE SHELL VAR" +
+	"IANT YO ARAMAIC DIGRAPH END EZEN FINGERS HAND HAND-HINGE IMPERIA" +
+	"L RUMI TOTO UGARITIC VERY YENISEI YUS BENT BUGINESE DANDA HEART " +
+	"MI OMICRON PARTHIAN QUOTATION THIRTY TSA CEDILLA CONJOINED GAR K" +
+	"ISIM5 LOGICAL LYCIAN MANDAIC MOON OGHAM PALATAL PHOENICIAN PSALT" +
+	"ER QUESTION SAG TODO VESSEL VOCAL CHORASMIAN EPACT HEAD KNIGHT P" +
+	"ROSGEGRAMMENI RHO SLANTED SUPERSCRIPT UD AT CLOCK DAG FORTY GOTH" +
+	"IC HAND-FLAT HO LLA LOOP LOOPED LYDIAN NEO NINDA2 NOTE POINT URU" +
+	" BETWEEN BU CHI EXCLAMATION EYES GHAIN HATRAN HOUR LAING LU REGI" +
+	"ONAL RO SEPARATOR SHAFT SIGMA SO SUBSET SUPERSET TORTOISE UYGHUR" +
+	" WE INTEGRAL KHAMTI KO MAKASAR NOTEHEAD NUKTA PHI QUAD STRETCHED" +
+	" THETA TU UNDERBAR ATTIC CHE DAGESH EQUILATERAL GI INDEPENDENT K" +
+	"I QUADRANT RIGHT-POINTING SMILING SOLIDUS THEH WO ALTERNATING DI" +
+	"SH EL ELYMAIC OVERLAY RU SI SIBE TAGALOG UE WORD ARM C-SIMPLIFIE" +
+	"D CURL DE EXTENDED GAMMA GAN2 GISH KU LEAF MAI MAN MO PERSON SE " +
+	"SPACE SU TRIANGULAR WI ZE ZHA AB ANTICLOCKWISE ATTACHED DOTLESS " +
+	"HANUNOO KE MANCHU MINUS REVERSE THO TIP ARC BUHID CLAN CURVED DE" +
+	"LTA DO HINGED INSULAR LEFT-POINTING LEG LO MAYAN NG QA RRA UNION" +
+	" AB2 ABBREVIATION ANNOTATION ARCHAION BAD BETA BI DAL DI DU ESH " +
+	"INSIDE KUR MEM RED SIDEWAYS TI TONOS TWO-HEADED VULGAR BLACKFOOT" +
+	" COUNTING EM FILL FLOOR FORWARD FTHORA GHE GURAGE HAND-ANGLE INT" +
+	"ERSECTION KAPPA KAREN KING PAP PO RE REST ROD SPOKED TAGBANWA TA" +
+	"U WALL AL ALEPH ARROWS BETH CEILING CIRCLES DIALYTIKA GU GUD HAN" +
+	"D-CIRCLE HEARTS IE MUSH N-ARY OBLIQUE PSI SAYISI SIXTY SOUND TAW" +
+	" TONGUE UP-POINTING VE ALMOST AVAGRAHA CIM CLOCKWISE EARTH GIMEL" +
+	" IOTIFIED KAPH KOMI MOVEMENT-DIAGONAL OUT QUEEN RI ROTATION SADH" +
+	"E SAMEKH SHAD SHESHIG SLASH SPREAD SUN TAILED TAK4 YE ZAIN ZHE B" +
+	"E BIG CANDRA DENTISTRY EIGHTHS EIGHTY EMPTY EQUIVALENT ER HAND-C" +
+	"UP HETH IM KASKAL KOET LAGAR LAMEDH LENGTH MGO NASKAPI OGONEK QU" +
+	"ARTERS RISING ROC ROTATION-FLOORPLANE ROTATION-WALLPLANE ROUNDED" +
+	" SHADE SHU THROUGH TSHA TWELVE U2 XA XI YODH AS BIRGA BULLET CAN" +
+	" CHECK CLUBS DIAMONDS FARSI FATHA GO HU HUMP KASRA LAMDA NOR NUN" +
+	"UZ NYI PWO SARA SHADDA SOFT SPADES TONAL TSE WATER WRIST XIANGQI" +
+	" ZAH ZAYIN ANG BALLOT BINDU CAT CROSSED DASHED DOWN-POINTING DZA" +
+	" ELEMENT ET ICHOS LOZENGE MARTYRIA MINNAN MON MULTIPLE MULTIPLIC" +
+	"ATION OM OVERBAR PADA PALI POVYSHE PRECEDES PRODUCT PU RESH ROUN" +
+	"D RTAGS SHADOWED SHIM SUCCEEDS THIRD WOODS-CREE ZETA AH AY AYIN " +
+	"BACK BALL BISHOP BO BY CO DESCRIPTION DOES E2 ELEVEN ENG EZH FE " +
+	"FLAG HANGZHOU HYPHEN JE KEHEH KHO KRYZHEM LAL LUM MEASURED MURDA" +
+	" NATTILIK NUBIAN OCLOCK OJIBWAY PAWN PRIME ROOK SEMICOLON SHA3 S" +
+	"HAR2 TEDUNG TELEPHONE UNIT UR2 USH WIDE Y-CREE YANG YIG ZIGZAG Z" +
+	"O ZU BELT BESIDE CHO CLICK DAMMA ES FI FIFTEEN FIRE FO HAA INVER" +
+	"SE JO KIEVAN KIRGHIZ KLYUCHEVAYA KRYUKOVAYA LAK-617 LEK MADDA ME" +
+	"N NOSE OCR PHO QUADRUPLE RAYS RIBBON SALTIRE SCHWA SHO SIMALUNGU" +
+	"N SKAMEYTSA STATYA STEM TENS TETH TSHEG WAVY WIDTH WIND WU YERU " +
+	"ZI ALPAPRAANA AM ASH2 ATTAK BARRED BRACKETED BUT CARET CHINESE C" +
+	"LEF CLOUD CURRENCY DALETH DOUBLE-LINE DYNAMIC DZ EF EH ESH2 EYE " +
+	"FINGER FISH FLEX GAD GAF GE HAND-HOOK HARD HORA KAK LAK-648 LEFT" +
+	"-STEM LITH LUNATE MAHAAPRAANA MALE NORMAL NOTCHED PAGE PARALLEL " +
+	"PREFIXED RDEL RECYCLING SAL SALT SEGMENTED SIMILAR SIXTEEN SVARI" +
+	"TA TAB THESPIAN TICK TONE-2 TONE-5 TRAVEL-WALLPLANE TREE TWELFTH" +
+	"S UNDER VO VOICED WESTERN YOD APOSTROPHE ASTROLOGICAL BAMBOOS BA" +
+	"RREE BUBBLE BUR CE CENTRED CHARACTERS CHILLU CIEUC CONTAINING CO" +
+	"NTAINS CROSSED-TAIL CRYPTOGRAMMIC DIGRAM EI EIGHTEEN EMOJI ENVEL" +
+	"OPE EU FILLER FIRST FRONT FU GAL GESH2 HANDS HHA HORSE IEUNG IMA" +
+	"GE ITERATION KAVYKA KEY LARGEST METRICAL MONOGRAM NEITHER NYO PE" +
+	"E PEH PINWHEEL RECTANGLE REFORMED REPEAT RHA SANDHI SECOND SET S" +
+	"HU2 SLOAN SUIT TEXT THEN THIRDS TONE-3 UM UN UR VI VINE YAT YIN " +
+	"ABKHASIAN AGOGI AIRPLANE AMPERSAND ANO AWAY BACKHAND BEGIN BLUE " +
+	"BUD CAR CHIEUCH COMPRESSED CRESCENTS CUM DIALECT-P DIE DIVISION " +
+	"DODEKATA DRAGON DZE ELLIPSIS ENDING ETH EXTENSION FIGURE FISHHOO" +
+	"K FOURTEEN GOAL GREEN HAND-CLAW HAND-OVAL HIEUH HUB2 ICE JAPANES" +
+	"E JI JOT KHIEUKH KIYEOK LENTICULAR LHA LIMB LIPS LOCATION LOGOGR" +
+	"AM LONSUM MAHAPRANA MARKER MFON MIEUM MOUNTAIN MOVEMENT NAGA NAM" +
+	"E NIEUN NINETEEN NIZKO NJE NUNAVIK OPPOSING OU OVERLINE PAIRED P" +
+	"HARYNGEAL PHIEUPH PIECE PIEUP PROLATIONE QOPH RIEUL RUPEE SASAK " +
+	"SECANT SEVENTEEN SHEI SIMANSIS SIOS STAFF STATERS STICK SUBGROUP" +
+	" SUBUNIT SUKUN SVETLAYA TEMPUS TH TH-CREE THAL THIEUTH THIRTEEN " +
+	"THORN THOUSANDS TIKEUT TOWARDS TRIGRAM TUAREG TUG2 TURNSTILE VEE" +
+	" VERTICALLY VRAKHIYA WOMAN ZAPYATAYA AD ANGLED ARKTIKO BACKSLASH" +
+	" BAN2 BBA BELL CHEST DAGGER DIATONIKI DIESIS DIGA DIM DIPLI DOLL" +
+	"AR DUN3 EAR ENCLOSING EYEBROWS FALLING FATHATAN FEE FITA FLAT FO" +
+	"LDER GAN GI4 GIR2 GU2 HAIR HAT HEEL JOINER JU KATAKANA-HIRAGANA " +
+	"KATO KET LUGAL MALO MASH MEASURE MID MILLIONS MOUSE MOVEMENT-HIN" +
+	"GE NASALIZED NGO ODD OPENING ORE OUTLINED PLACE PLASTICS POWERS " +
+	"RAIN SEE SH SHARP SHIMA SHOE SHWE SPEAKER SPEECH SPIRAL SURROUND" +
+	" TAA TALENTS TALLY TAM TAR TCHEH TEARDROP-SPOKED TEETH TENSE TET" +
+	" THI TIR TONE-4 TRAVEL-FLOORPLANE TSI VOS VU WITHIN WRINKLED YFE" +
+	"SIS -A AEN AIR AITON AK ANTIMONY APPROXIMATELY AV BABY BARLINE B" +
+	"EE BEND BET BLACK-FEATHERED BOOK BOW BRIDGE BROKEN BRUSH BURU BU" +
+	"TTON CHEEKS CONTACT CONTROL COPPER CORNERS CRESCENT CUPPED DEE D" +
+	"IFFERENTIAL DIGAMMA DIVIDER DOG DRUM DUB DVOECHELNAYA EAT ECH EK" +
+	" ENY EPIGRAPHIC EQUIHOPPER ERIN2 ERROR-BARRED EVEN EXTREMELY FEM" +
+	"ALE FLICK GANGIA GAP GGA GIR3 GLASS GREAT GROMOPOVODNAYA HAL HAR" +
+	" HIP HOLDING HOOKED HORI HOUSE IMPERFECTA INFINITY IOTATED IS IZ" +
+	"HE IZHITSA JEE JIHVAMULIYA KAI KAN KEN KHEI KHOKHLOM KISS KNIFE " +
+	"KOPPA KU3 KUSHU2 LAK-449 LEIMMA LLLA LOO LU3 MBAA MUSH3 MYA NAA " +
+	"NABLA NARROW NDA NET NHA NJI NUMERATOR ORANGE OSOKA OTTAVA PALM " +
+	"PARTIAL PEAKS PEN PIRIG POSITION POWER RADI RAE REPA RICE RUSSIA" +
+	"N SAMPI SAN SAR SCISSORS SHARU SHI SHID SHIR SINGLE-LINE SIXTEEN" +
+	"TH SPLIT SQUEEZE STILE SUBSTITUTION SUM SUNG SWASH TALL TATWEEL " +
+	"TEE THAN THREE-D TIE TOO TROEZENIAN TTEH TUR UA UK UPADHMANIYA U" +
+	"RUDA VAV VOLAPUK VRACHY VYSOKO WEIGHT WIGGLY XE YAA YEE YN ZAPYA" +
+	"TOY ADEG AFRICAN ALAYHE ALL ALLAAHU ANUDATTA APLI AQ BAHAR2 BAL " +
+	"BARS BBE BEEH BEHEH BLACK-LETTER BOARD BREATHY BUILDING CASKET C" +
+	"HARIOT CHART CHRIVI CIRCULAR CLOSING CLUSTER-INITIAL COMMERCIAL " +
+	"COW CUBED CUP CURVING DAMMATAN DHE DISK DKAR DOACHASHMEE DOCUMEN" +
+	"T DOUBLED DYEH DZHA EC ELAMITE EO ERA EYEGAZE-WALLPLANE EZ FACIN" +
+	"G FENCE FILE FITZPATRICK FLATTENED FLOURISH FLOWER FRANKS FREE G" +
+	"AA GEE GEMINATION GESHU GHUNNA GOLUBCHIK GORAZDO GORGON GRINNING" +
+	" GTER GUEH HALF-CIRCLE HENG HIE HOO HOT IDIM IMPERFECTUM INPUT I" +
+	"NTERROBANG IR IU JIL JJA JOINED KAD3 KAL KANA KASRATAN KENTIMATA" +
+	" KEYBOARD KOMBUVA KOO KOREAN KPA KSSA LAA LAME LEFT-HAND LEFT-TO" +
+	"-RIGHT LIGHTNING MAP MARK-1 MARK-2 MBA MECHIK MEDAL MOBILE MONKE" +
+	"Y MUCH MV MYSLITE NAG NAR NASAL NDOLE NEPOSTOYANNAYA NESTED NGGA" +
+	" NGOEH NJEE NN NNNA NOTES NYEH OA OH OI ONCOMING ORIGINAL OVAL O" +
+	"VERLAPPING OW PAD PARAGRAPH PARESTIGMENON PATH PEHEH PENTAGON PE" +
+	"R PERCENT PII PLUTO POINTER POLE PON POO PRIZNAK PWA QAR QI QU R" +
+	"AA RANGE REE REPETITION RETURN RNOON ROCKET ROOT ROTUNDA SCREEN " +
+	"SELECTOR SEMICIRCLE SEMIDIRECT SHOULDER SII SIMPLIFIED SMILE SOO" +
+	" START STRESS SUBLIMATE SUMMATION SWIRL TALING TCHEHEH TEHEH THA" +
+	"A THEE THESEOS TINY TITLO TONE-1 TRAFFIC TRIDENT TTE TTEHEH TUM " +
+	"TURTLE TWE U-SHAPED UIGHUR UMBRELLA VEH WAA WALK WAY WITHOUT WOO" +
+	" YAE YAJURVEDIC YELLOW YEN YER YOT ZEE ZEN AC ACE AG ALAYHI ALTA" +
+	" AMAR AO APPROXIMATE AQUA ARROW-TAIL ARROWHEADS ARSEOS AS-SALAAM" +
+	" BAA BAG BALAG BAN BANKNOTE BASE BEAMED BHE BIRD BONE BOTTLE BOW" +
+	"TIE BULUG CAMERA CANCELLATION CANTILLATION CAP CENTRALIZATION CH" +
+	"AR CHECKER CHEE CHIN CHROMA CHU CI CIL CLUSTER-FINAL COMPONENT C" +
+	"ROP CUATRILLO CUSP DALET DANG DDDA DEGREE DEL DELIMITER DEVICE D" +
+	"EZH DIASTOLI DIGORGON DIN DIRECTION DJE DLA DON DOWNWARD DRAUGHT" +
+	"S DREAMY DYO DZELO EASTERN EGYPTOLOGICAL EIE EKFONITIKON ELECTRI" +
+	"C ELLIPSE ENC ENT EP ERR EX EXTRA-HIGH EXTRA-LOW EYEGAZE-FLOORPL" +
+	"ANE FAA FEATHER FINGER-POST FIXED-FORM FLOPPY FLORETTE FOOD FOOT" +
+	" FORK FORMAT FOURTH FRAME FROWN FROWNING GABA GBE GHAN GLOBE GOL" +
+	"D GRASS GROMNAYA GROMOKRYZHEVAYA GUR HAE HAMMER HAND-CURLICUE HE" +
+	"ADED HEAVEN HEE HELMET HOE HOURGLASS HP HV HYPHEN-MINUS IA IDENT" +
+	"ICAL IMIN INSERT INTEGRATION IRON ISOLATE ISOSCELES J-SIMPLIFIED" +
+	" JACK JHAN JOIN JOKER KAA KANG KAPA KENTIMA KHAR KHI KID KISSING" +
+	" KK KLASMA KM KNUCKLE KPO KRYZH KSI KUL KUT KWAA KWE KWI KYEE LA" +
+	"I LAMED LAS LEE LEFT-FACING LENGA LENGTHENER LEZH LIP LJE LOCK L" +
+	"ONGA LOQ LOTUS LOW-FALLING MAILBOX MARBUTA MBE MBEE MCHU MEAT ME" +
+	"MBER MERCURY MIDDLE-WELSH MIM MIN MM MONOCULAR MUG MUSIC MWA MWI" +
+	" NDAA NDE NDU NGAA NGI NIEUN-CIEUC NIEUN-HIEUH NIGHT NIKOLSBURG " +
+	"NOW NTAP NTEUM NWA NYAM NYIS OBOLS OHM OIL OK OOU OT OX PAA PALA" +
+	"UNG PAPER PAR PARENTHESES PATAH PEDESTAL PENCIL PENTAGRAM PERFEC" +
+	"TA PHAR PHONE PIEUP-SIOS PIG PLA POETRY POLICE POSTAL POT POUND " +
+	"POVODNAYA PROSTAYA PWI QAMATS QE QO QUILL QUILT RAD RAFE RAILWAY" +
+	" RAM RAT RECEIVER REGULUS RELATION REN RIEUL-HIEUH RIEUL-KIYEOK " +
+	"RIEUL-MIEUM RIEUL-PIEUP RIEUL-SIOS RIGHT-FACING RIGHT-HAND RIGHT" +
+	"-SHADOWED RIGHT-TO-LEFT RII RUB RUDIMENTA RUM SAA SANYAKA SATANG" +
+	"A SCAN SEBATBEIT SEQUENTIAL SERIFS SGAW SHAKING SHEE SHHA SHII S" +
+	"HITA SHOGI SHORTHAND SHORTS SIG4 SILHOUETTE SIN SIXTEENTHS SKEWE" +
+	"D SLIGHTLY SLOZHITIE SNAKE SOUTH-SLAVEY SPIRIT SQUARES SQUIGGLE " +
+	"SS SSANGCIEUC SSANGKIYEOK SSANGPIEUP SSANGSIOS SSANGTIKEUT SUKU " +
+	"SUR SUU SVASTI SWEAT TAN TESH THIN THUMBS TIGER TIKHAYA TILTING " +
+	"TOGETHER TONE-6 TONE-8 TOOTH TOPBAR TRESVETLAYA TROMIKON TS TSO " +
+	"TSU TTHO TURN TWENTY-FIVE TYPE UC UDATTA UI ULU UMUM US VANE VIN" +
+	" WASLA WEE WHEEL WINE WOMANS WYNN XAN XYEEM YAH YERI YIDDISH YOO" +
+	" YOQ YUT YYA ZAL ZATA ZHAR ZHEE ZZA -KHYIL A2 AELA-PILLA ALIF AL" +
+	"LO ALTERNATIVE ANGER ANJI ANSHE APOSTROFOS AR ARISTERA AROUND AS" +
+	"HGAB ASSYRIAN AW AXE AYB AZU BACK-TILTED BACKSLANTED BALLOON BAL" +
+	"LOON-SPOKED BAMBOO BANK BAT BATH BAYANNA BEAVER BEHIND BEI BEN B" +
+	"ILABIAL BLACKLETTER BOLT BOWL BRANCH BREATH BRICK BROWN BUBBLES " +
+	"BUKY BUON BUS BZHI CAA CAKE CALENDAR CALL CARIK CASTLE CCHE CHAS" +
+	"HKA CHICK CHILD CHRISTMAS CHROA CHRONON CLIFF CLOSE CLOTH CLOTHE" +
+	"S CLUB CM COLD COMPLETION COMPOSITION COMPUTER CONCAVE-SIDED CON" +
+	"STANT CONSTRUCTION CONTAIN CONTOUR COO COUNCIL COVER COVERING CR" +
+	"EDIT CROCUS CRYING CU CUBE CURRENT CUT CWA CWAA CWI DAHAL DANTAJ" +
+	"A DAP DAYANNA DDAHAL DDAL DDAYANNA DDO DECIMAL DEER DENE DENTAL " +
+	"DEPARTING DESCENDING DIM2 DIRECT DIVIDE DIVIDED DJERV DJERVI DM " +
+	"DOBRO DRACHMA DRY DUL DUN DWE EA EB ELEPHANT ENCLOSURE ENN ENTRY" +
+	" EPIDAUREAN ETERNITY EV EW EXO EYELASHES FACTOR FANEROSIS FAX FE" +
+	"ED FENG FERMATA FIFTH FIFTHS FINGERED FIST FLEURON FLORAL FLY FL" +
+	"YING FOM FOO FOREHEAD FORMEE FORTY-FIVE FRITU FROG FRONT-TILTED " +
+	"GATHERING GAYANNA GAYANUKITTA GBA GBU GEAR GERESH GESHTIN GGE GH" +
+	"HA GHOST GIM GJE GLAGOLI GRACE GRASP GSUM GUM HALANTA HARKLEAN H" +
+	"ATAF HEADSTROKE HERMIONIAN HERU HEXAGON HLI HNA HOLAM HON HUMAN " +
+	"HUNDREDS HWE IB IC ICON IH INCREASE INFORMATION ING INI INSERTIO" +
+	"N INTERLINEAR INVISIBLE IO IQ ISH ISON IT IY JAYANNA JEGOGAN JEH" +
+	" JONA JOY KA2 KAD5 KAKO KAPYEOUNMIEUM KAPYEOUNPHIEUPH KAPYEOUNPI" +
+	"EUP KARO KATHAKA KAY KEE KEH KETTI KHE KHUEN KIN KIYEOK-SIOS KKA" +
+	" KNUCKLES KOI KORONIS KRATIMA KUOP KW KWA KXA KYA LAAM LAKH LAP " +
+	"LAST LAZY LEADER LEATHER LEERAEWA LEFT-SHADED LET LETTERS LH LIM" +
+	"MU LINES LISH LJUDIJE LOVE LUH LUL LWA LY MAA MACHINE MAEM MAEMB" +
+	"A MANDAILING MAPIQ MASK MB MBI MBIT MBO MBOO MBU MEDIEVAL MEE ME" +
+	"EEE MEGA MES MEUT MID-LEVEL MIEUM-PIEUP MIEUM-SIOS MILITARY MINI" +
+	"MA MIT MNAS MONOGRAPH MONTH MOO MOOD MOUND MRACHNAYA MULTIMAP MU" +
+	"LTISET MUOY MUURDHAJA MWAA MWE N-CREE NAH NASALIZATION NASHI NAT" +
+	"URAL NDAP NDEE NDI NDO NEE NEN NEWLINE NGGE NGGEN NGGO NGGOO NGG" +
+	"U NGOM NIEUN-SIOS NIEUN-TIKEUT NIM NIN NJAEM NJU NO-BREAK NOO NR" +
+	"A NSHA NSHUT NTEE NUNG NV NWAA NYE NYET NYU NZA OFFICE OLDER ONU" +
+	" OP OPEN-HEADED OPPOSITION OQ OUTER OVERLAID OVERLAP OXEIA OY PA" +
+	"N PANSIOS PARAGRAPHOS PARAKALESMA PARAKLITIKI PART PARTY PAW PEA" +
+	"CE PEDAL PEPET PERFECTUM PERPENDICULAR PEUX PHE PICTURE PIEUP-CI" +
+	"EUC PIEUP-SIOS-TIKEUT PIEUP-TIKEUT PING PIWR PLACEHOLDER PODCHAS" +
+	"HIE POKOJI POLISH POMMEE POP POST POUTING PRAM PRAM-BEI PRAM-BUO" +
+	"N PRAM-MUOY PRAM-PII PREGNANT PRISHTHAMATRA PTHAHA PUAE PURPLE P" +
+	"USHPIN PUT PWAA PWE QAA QAAF QOF QOO QUO QUU RACING RAP RATHA RE" +
+	"PEATED REUX RIEUL-PHIEUPH RIEUL-THIEUTH RIEUL-TIKEUT RIGHT-SHADE" +
+	"D RISH RITSI ROCK ROG ROSETTE RREH RUNNING SAFETY SAM SAT SAW SA" +
+	"YANNA SCHEMA SCHOOL SEAL SEGMENT SEGOL SEMI-VOICED SEMIBREVIS SE" +
+	"MICIRCULAR SEMIMINIMA SEUX SHADED SHCHA SHEEP SHESH SHIELD SHIP " +
+	"SHOO SHOQ SHTA SHWA SILK SIMA SIOS-CIEUC SIOS-KIYEOK SIOS-PIEUP " +
+	"SIOS-TIKEUT SIXTH SIXTY-FOURTH SKLIRON SKULL SLEEPING SLOVO SNOU" +
+	"T SNOW SNOWFLAKE SNOWMAN SOT SOURCE SPACING SPHERICAL SPOON SSAN" +
+	"GIEUNG SSANGNIEUN SSI SSO SSU ST STEP STIGMA STOCK STONE STOPITS" +
+	"A STRANNO STRATUM STRIKE STROKES STUCK-OUT SUA SUBLINEAR SULFUR " +
+	"SUPRALINEAR SURFACE SUSPENSION SV SWA SWAA SYMBOLS SYNAGMA TAALU" +
+	"JA TABLE TAE TARGET TAV TEARS TENT TENTH TESSERA TETARTOS THII T" +
+	"HIRTY-FIVE THIRTY-SECOND THOO THOUGHT THREAD THU THUNDER THWA TH" +
+	"WAA TIGHT TIL TIME TLA TLE TLHA TLI TLO TLU TONE-7 TOP-LIGHTED T" +
+	"OUCH TRA TRAIN TRANSPOSITION TREND TRUCK TRUE TSADI TSATA TT TTH" +
+	"AA TTO TVRIDO TWA TWAA TWENTY-TWO TWO-CIRCLE UH UKRAINIAN UKU UP" +
+	"TURN UUE VAREIA VEDE VERSE VEW VY WAE WAU WAVES WAVING WEO WHEAT" +
+	" WHEELCHAIR WHOLE WON WOOD WORK WRINKLES WUE XEH XW YAGH YAJ YAK" +
+	" YATI YAZH YEO YEORINHIEUH YESIEUNG YESTU YIT YIWN YUQ YWA YWAA " +
+	"YY ZEMLJA ZHI ZHIVETE ZHO ZHU ZQAPHA ZU5 -DZUD -UM A001 A002 A00" +
+	"3 A004 A005 A006 A007 A008 A009 A010 A011 A012 A013 A014 A015 A0" +
+	"16 A017 A018 A019 A020 A021 A022 A023 A024 A025 A026 A027 A028 A" +
+	"029 A030 A031 A032 A033 A034 A035 A036 A037 A038 A039 A040 A041 " +
+	"A042 A043 A044 A045 A045A A046 A047 A048 A049 A050 A051 A052 A05" +
+	"3 A054 A055 A056 A057 A058 A059 A060 A061 A062 A063 A064 A065 A0" +
+	"66 A067 A068 A069 A070 A301 A302 A303 A304 A305 A306 A307 A308 A" +
+	"309A A310 A311 A312 A314 A315 A316 A317 A318 A319 A320 A321 A322" +
+	" A323 A324 A325 A326 A327 A328 A329 A330 A331 A333 A334 A335 A33" +
+	"6 A337 A338 A339 A340 A341 A342 A343 A344 A345 A346 A347 A348 A3" +
+	"49 A350 A351 A352 A353 A354 A355 A356 A357 A358 A359 A360 A361 A" +
+	"362 A363 A364 A365 A366 A367 A368 A369 A370 A371 A501 A502 A503 " +
+	"A504 A505 A506 A508 A509 A510 A511 A512 A513 A515 A516 A520 A521" +
+	" A523 A524 A525 A526 A527 A528 A529 A530 AAI AAN AAY ACADEMY ACC" +
+	"OUNT ACKNOWLEDGE ACTIVATE ACTUALLY ADULT AEB AEDA-PILLA AEE AEG " +
+	"AEK AESC AET AEY AKARA AKHMIMIC ALAPH ALEMBIC ALEUT ALFA ALIEN A" +
+	"LLAAH ALLI ALLIANCE ALVEOLAR AMB ANCHOR ANCORA ANGLICANA ANTARGO" +
+	"MUKHA ANTENNA ANTIMONIATE ANTIRESTRICTION AP APESO APLOUN APODER" +
+	"MA APOSTROFOI APPLE ARAD ARAEA ARCH ARDHAVISARGA ARGI ARGOSYNTHE" +
+	"TON ARPEGGIATO ARTS ASCENDING ASHES ASPIRATION ASYMPTOTICALLY AT" +
+	"HAPASCAN ATIU AUE AUTOMOBILE AWX AX AYAH AYN AZ B001 B002 B003 B" +
+	"004 B005 B006 B007 B008 B009 BADGER BALUDA BASELINE BASHKIR BATH" +
+	"TUB BATTERY BBI BBO BBU BBUT BCAD BEAM BEAR BEAT BEER BEETLE BEN" +
+	"ZENE BERBER BEVERAGE BHETH BHO BICYCLIST BINARY BINDI BINDING BI" +
+	"NOCULAR BIT BITING BLADE BLANK BLENDED BLINK BLOCK-2 BLOCK-3 BLO" +
+	"CK-4 BLOCK-5 BLOCK-6 BLOCK-7 BLOOD BLOSSOM BLOWING BOAR BOAT BOD" +
+	"Y BOHAIRIC BOO BOOKMARK BOOMERANG BOOT BOTH BOUQUET BRA BRDA BRE" +
+	"AD BREVIS BRIGHTNESS BROAD BUILDINGS BULLHORN BWA BWEE BWI BYELO" +
+	"RUSSIAN-UKRAINIAN BZUNG CADUCEUS CAKRA CALYA CAMEL CANCEL CANNON" +
+	" CARPENTRY CART CAUDATE CAULDRON CAYANNA CAYN CC CD CECAK CEE CE" +
+	"NT CENTRELINE CER-WA CH CHAA CHAIR CHAT CHEH CHELYUSTKA CHEVRON " +
+	"CHOREVMA CHRONOU CHURCH CIP CIRCLING CITY CITYSCAPE CLINKING CLU" +
+	"STER COAT CON CONGRATULATION CONJOINING CONSECUTIVE CONVERGING C" +
+	"OOKIE COPRODUCT COPYRIGHT CREAM CRICKET CROSSBAR CROSSBONES CROS" +
+	"SHATCH CROWN CULTIVATION CUOP CURLICUE CWE CWII CWO CWOO CY CYA " +
+	"CYP CYT DAA DAALI DALATH DALDA DAMP DAP-BEI DAP-BUON DAP-MUOY DA" +
+	"P-PII DAP-PRAM DAR DARA3 DARK DARKENING DAT DATE DB DCHE DDAA DD" +
+	"DHA DDE DDHAA DDHO DDI DDU DDUR DEATH DECORATION DECREASE DEI DE" +
+	"LETE DEMESTVENNY DENOMINATOR DEPARTURE DESERT DESKTOP DEXIA DEYT" +
+	"EROS DH DHO DIB DIFONIAS DIGRAMMOS DIMINUTION-1 DIRECTIONAL DIRE" +
+	"CTLY DISAPPOINTED DISIMOU DIVINATION DIZZY DJA DOI DOLLS DONG DO" +
+	"O DOOR DOUBLE-LINED DOVE DRACHMAS DRAM DRESS DRINK DRIVE DROP-SH" +
+	"ADOWED DROPLET DUNG DV DVOECHELNOPOVODNAYA DZEE DZHE DZI DZJE DZ" +
+	"O DZU DZWE DZZE DZZHE EAGLE EARS EARTHLY ECS ED EG EGG EGY EHCHA" +
+	" EHKA EHPA EHTA EHTSA EIGHTIETHS EIN EJ ELIFI ELY EMBEDDING EMP " +
+	"EMPHASIS ENCLOSURES ENGINE ENT-SHAPED ENTER ENTERPRISE EPENTHETI" +
+	"C ERASE EREN ERIS ESASA ESCAPE ESHE3 ESZ ETERON ETY EURO EUROPEA" +
+	"N EWE EYN EZS FAMILY FAN FATHER FEI FEMININE FIELD FILM FINANCIA" +
+	"L FLAME FLATBREAD FLEXUS FLIP FLUTE FLUTTERING FOLLOWING FONT FO" +
+	"OTBALL FOOTNOTE FORKED FORTIETH FOUNTAIN FOX FRENCH FRETBOARD FU" +
+	"E FUNCTION FUR FUSA FWA FWAA FWEE FWI GAETTA-PILLA GAH GAMAL GAM" +
+	"E GAPPED GARSHUNI GATE GAY GBEE GBI GBO GBOO GCIG GENERAL GENIKI" +
+	" GEOMETRICALLY GEP GERSHAYIM GESTURE GGI GGO GGOP GGU GGUO GHAA " +
+	"GHAD GHEUAE GHO GIBBOUS GLISSANDO GNYIS GORGI GORTHMIKON GOT GRA" +
+	"M GREATER GREGORIAN GROUND GROUP GRU GUARD GUG GUL GUR7 GV GWA G" +
+	"WE GWEE GWI GYAS GYON HAN HANDLE HASANTA HATE HAVE HBASA HEADSTO" +
+	"NE HEART-SHAPED HEI HEN HET HETA HG HHAA HHWA HIGH-REVERSED-9 HI" +
+	"GH-SPEED HII HIN HINGE HIRIQ HIT HIYO HLA HMO HOCKEY HOLE HOLLOW" +
+	" HOLO HOMOTHETIC HORNS HORR HOTEL HTA HUGGING HUN HWAIR HXIT HXO" +
+	"P HXUO HYA HYPHENATION HZ IAN IAUDA ICHIMATOS IDENTIFICATION IDE" +
+	"OGRAPH-4E09 IDEOGRAPH-4E8C IDEOGRAPH-6253 IEUNG-KIYEOK IG IJ IJE" +
+	" IKARA IL ILIMMU INHERENT INHIBIT INNER INTERIOR INTERLACED INTE" +
+	"RPOLATION INTERSECTING IS-PILLA ISSHAR IX IYEK JAA JADE JAR JAW " +
+	"JHEH JIIM JJI JJIE JJO JJU JJUT JJY JNYA JOO JWA KAAF KAB KABA K" +
+	"AD2 KAH KAP KAPYEOUNRIEUL KAPYEOUNSSANGPIEUP KASHMIRI KAYANNA KA" +
+	"ZAKH KEEPING KEMPLI KEMPUL KESH2 KEUM KEUX KEYCAP KG KHAA KHAI K" +
+	"HAKASSIAN KHANG KHAPH KHHA KHHO KHMU KINNA KIP KIT KJE KLYUCH KL" +
+	"YUCHENEPOSTOYANNAYA KNIGHT-BISHOP KNIGHT-QUEEN KNIGHT-ROOK KOK K" +
+	"ON KONTEVMA KORANIC KOTO KP KPE KPEE KPI KPOO KPU KRYUK KU4 KUN " +
+	"KVA KWEE KWII KWO KWOO L-SHAPED LABIAL LABOR LAE LAK-050 LAK-079" +
+	" LAK-081 LAKE LAMP LAN LANE LANES LANTANG LARGER LATERAL LATINAT" +
+	"E LAULA LAW LAYANNA LCE LEANING LEEEE LEGETOS LELET LENIS LESH L" +
+	"ESSER LEU LEVEL LHAA LHI LHO LID LIE LING LINK LIQUID LIRA LITTE" +
+	"R LIWN LJ LOCOMOTIVE LOGOTYPE LOM LOOT LOW-9 LOWERED LS LUGGAGE " +
+	"LV LWAA LWE LWI LWII LWO LWOO LYR LZ M001 M002 M003 M004 M005 M0" +
+	"06 M007 M008 M009 M010 M011 M012 M013 M014 M015 M016 M017 M018 M" +
+	"019 M020 M021 M022 M023 M024 M025 M026 M027 M028 M029 M030 M031 " +
+	"M032 M033 M034 M035 M036 M037 M038 M039 M040 M041 M042 M043 M044" +
+	" MADDAH MAE MAESI MAGNIFYING MAH MALAKON MANDARIN MAQ MAR MARK-3" +
+	" MASH2 MAY MBANYI MBEN MBEUM MC MCHAN MDUN MECHANICAL MEEJ MEET " +
+	"MELON MEM-QOPH MERGE MERKHA MESH MESO METAL MEUN MGBA MHA MICROP" +
+	"HONE MIDLINE MIEE MIEUM-KIYEOK MIEUM-PANSIOS MIG MIIM MILLE MIRR" +
+	"OR MLA MODIFIER-2 MODIFIER-3 MODIFIER-4 MODIFIER-5 MODIFIER-6 MO" +
+	"NEY MONOFONIAS MONOGRAMMOS MOP MORTAR MOTHER MOTOR MOVE MPA MUAE" +
+	" MUAN MUCAAD MVOP MW MWEE MWII MWO MWOO MY NA-2 NA2 NAASIKYAYA N" +
+	"AE NAKAARA NAM NAM2 NANA NAQ NATIONAL NAYANNA NBIE NDAM NDOO NE-" +
+	"2 NECK NEGATED NENOE NEPTUNE NEQUDAA NEST NEWSPAPER NGAS NGE NGE" +
+	"N NGGAAM NGGEE NGGEUAET NGGI NGGUOQ NGHA NGKA NGKUE NGKWAEN NGON" +
+	" NGOP NGOU NIB NIEUN-KIYEOK NIEUN-PANSIOS NII NJ NJA NJAEMLI NJA" +
+	"M NJEUX NJO NJOO NJUAE NJUEQ NKAARAE NODE NORTHERN NOTCH NOTEBOO" +
+	"K NOZHKA NSHIEE NSIEE NTAA NTOG NTUU NU11 NUAE NUENG NULL NUT NU" +
+	"TILLU NWE NWI NWII NWO NWOO NY NYAA NYEE NYHA NYIP NYIT NYOP NZU" +
+	"P OAY OCTAGON OEE OEK OIN OKARA OKTO OLIGON OLIVE OMET OMISSION " +
+	"ONE-HUNDRED-AND-SIXTIETH ONG ONN OOE OPEN-CIRCUIT-OUTPUT OPEN-O " +
+	"OPTICAL ORDINAL ORNATE OS OTU OUNCE OV OVERLONG OVERRIDE OVERSTR" +
+	"UCK OYRANISMA PAA-PILLA PAAM PAGES PAKPAK PALOCHKA PAMADA PAMPHY" +
+	"LIAN PAMUDPOD PANGOLAT PANTI PARA PARALLELOGRAM PARAPHRASE PARTN" +
+	"ERSHIP PASANGAN PATTY PAYANNA PAYEROK PEDESTRIAN PELASTON PENNAN" +
+	"T PENTASEME PEOPLE PEPPER PERCUSSIVE PEREVODKA PERNIN PERSONAL P" +
+	"ESH2 PESO PETALLED PHAA PHIEUPH-PIEUP PHILIPPINE PHRASE PHUR PIA" +
+	"SMA PICK PIE PIEUP-CHIEUCH PIEUP-HIEUH PIEUP-KIYEOK PIEUP-PHIEUP" +
+	"H PIEUP-SIOS-KIYEOK PIEUP-THIEUTH PILCROW PIN PIP PIPAEMBA PIPAE" +
+	"MGBIEE PISELEH PIT PITCHFORK PLAGIOS PLANCK PLANE PLOPHU PLUTA P" +
+	"NEUMATA POLI POLLU PORRECTUS POSSESSION POTATO PRECEDE PRECEDING" +
+	" PREPONDERANCE PRINT PRINTER PROLONGED PROPELLER PROPORTION PROT" +
+	"OS PSIFISTON PUE PUP PWEE PWII PWOO PYT QAI QATAN QII QITSA QOT " +
+	"QUA QUE QUI QUINDICESIMA QUOTE QUV QWE QWEE QWI R-CREE RA-2 RABB" +
+	"IT RACQUET RADIO RAISING RAMS RANA RAY RBASA RDO REACH READING R" +
+	"ECORD RECTANGULAR RECTILINEAR REDUPLICATION RELAXED RELIEVED REP" +
+	"LACEMENT RERENGGAN RESOURCE RESPONSE RESTRICTED REVOLVING RGYA R" +
+	"GYAN RGYINGS RIEE RIEUL-KAPYEOUNPIEUP RIEUL-KHIEUKH RIEUL-KIYEOK" +
+	"-SIOS RIEUL-NIEUN RIEUL-PANSIOS RIEUL-PIEUP-SIOS RIEUL-SSANGKIYE" +
+	"OK RIEUL-YEORINHIEUH RIKRIK RINGS RIPPLE RJE RNAM RNYING ROLLER " +
+	"ROLLING ROMANIAN ROO ROTATIONS RRE RRO RULER RUMAI RWA RWAA RY S" +
+	"-SHAPED SA-2 SADE SAFHA SAH SALLA SALLALLAHOU SALTILLO SAMBA SAN" +
+	"DAL SANSKRIT SAP SARI SATELLITE SAY SCANDICUS SCOOTER SCOTS SEGN" +
+	"O SEH SEISMA SELF SEMISOFT SEMIVOWEL SEMKATH SERIF SESAME SEXTUL" +
+	"A SGAB SHA6 SHAA SHANG SHAPES SHAPING SHAT SHELF SHET SHEUX SHIF" +
+	"T SHIQ SHOG SHOP SHOPPING SHOY SHRI SHRIMP SHTAPIC SHUR SHWAA SH" +
+	"WI SHWII SHWO SHWOO SHY SIG SIGMOID SIGNS SIK2 SILA3 SIMULTANEOU" +
+	"S SINDHI SINNYIIYHE SIOS-CHIEUCH SIOS-HIEUH SIOS-MIEUM SIOS-NIEU" +
+	"N SIOS-RIEUL SIOS-THIEUTH SIXTHS SIZE SJE SKATE SKI SLICE SLOPIN" +
+	"G SLOW SLUR SMALLER SMASH SMOKING SOAP SOCIETY SOF SOLDIER SOLID" +
+	" SOM SOROCHYA SOU SOUTHERN SPADE SPARKLE SPEAR SPECIAL SPIDER SP" +
+	"IDERY SPIRITUS SPLAYED SQUAT SQUIRREL SREDNE SSANGHIEUH SSANGRIE" +
+	"UL SSE SSUU STAMPED STANDARD STAVROS STEAM STICKING STORE STRONG" +
+	" STUDY SUBJOINER SUBSTITUTE SUCCEED SUCKED SUE SUHUR SUNGLASSES " +
+	"SUNRISE SUPER SUPERVISE SURANG SW SWAPPING SWE SWEET SWI SWII SW" +
+	"O SWOO SYMBOL-1 SYMBOL-11 SYMBOL-12 SYMBOL-13 SYMBOL-14 SYMBOL-1" +
+	"7 SYMBOL-18 SYMBOL-19 SYMBOL-2 SYMBOL-23 SYMBOL-24 SYMBOL-4 SYMB" +
+	"OL-5 SYMBOL-50 SYMBOL-51 SYMBOL-52 SYMBOL-53 SYMBOL-54 SYMBOL-7 " +
+	"SYMBOL-8 SYMMETRIC TA-2 TA-ROL TABULATION TAMING TANGENT TANNED " +
+	"TAPE TAT TAXI TAYANNA TC TCHE TE-2 TELEIA TELISHA TELOUS TENNIS " +
+	"TERMINAL TETARTIMORION TETRASEME TETRASIMOU TEUAEN TEUAEQ THEMA " +
+	"THEMATISMOS THERE THERMOMETER THETHE THONG THREE-QUARTER TIGHTLY" +
+	"-CLOSED TIKEUT-CIEUC TIKEUT-KIYEOK TIKEUT-PIEUP TIKEUT-RIEUL TIK" +
+	"EUT-SIOS TIKHY TIRONIAN TIT TITA TIWN TJE TLHE TLHI TLHO TLV TOQ" +
+	" TORCULUS TORSO-WALLPLANE TOT TOTAL TOUCHING TOUCHTONE TRACK TRA" +
+	"DITIONAL TRAM TRANSMISSION TRAY TREMOLO-1 TREMOLO-2 TREMOLO-3 TR" +
+	"ESILLO TRIANGLE-ROUND TRICOLON TRIGRAMMOS TRISEME TRISIMOU TRITI" +
+	"MORION TROKUTASTI TROPICAL TRUNK TSEE TSHE TSHES TSHOOK TSSE TSV" +
+	" TSWE TTAA TTAYANNA TTHE TTHI TTI TTTA TTUDDAAG TUK TUOT TUP TUR" +
+	"BAN TWENTIETH TWENTIETHS TWENTY-EIGHTH TWENTY-FOUR TWENTY-ONE TW" +
+	"ENTY-THREE TWI TWII TWO-WAY TWOO TXWV TYPE-3 TYPE-4 TYPE-5 TYPE-" +
+	"6 TZ UB UBADAMA UEX UEZ UKARA UNDERLINE UNDERTIE UNIVERSAL UNK U" +
+	"NN UO UPWARD URANUS USED UY UZ3 VAA VAMAGOMUKHA VEND VEP VICTORY" +
+	" VIDA VIDEO VIETNAMESE VINEGAR VISIGOTHIC VIYO VOICING VOO VOU V" +
+	"UR WAITING WANING WAP WASALLAM WATCH WAW-AYIN-RESH WAX WAXING WC" +
+	" WEARY WEDGE-TAILED WEI WHALE WII WINDOW WINDU WINKING WOLOSO WO" +
+	"OL WRENCH WRITING WULU WUO WV XO XVA XYA XYE YA-YO YAKH YAM YAN " +
+	"YAP YAQ YAR YAWN YAY YAZ YEAR YESIEUNG-PANSIOS YESIEUNG-SIOS YFE" +
+	"N YHE YIE YO-I YO-YA YO-YAE YOGH YPOKRISIS YR YU-I YU-YE YU-YEO " +
+	"YUDH YUJ YUM YUOP YUWOQ YV YWE YWI YWII YWO YWOO ZA7 ZAA ZADERZH" +
+	"KA ZAKRYTAYA ZAQEF ZAYN ZEMLYA ZHWE ZIB ZIDA ZIZ2 ZJE ZLA ZLAMA " +
+	"ZOO ZOT ZUP ZUR ZY ZZE ZZI ZZIET ZZO ZZU -CHAL -CHAR -KHYUD -PHR" +
+	"U A-1 A-2 A-3 A-EU A-O A-U A-WO A005A A006A A006B A010A A014A A0" +
+	"17A A026A A028B A032A A039A A040A A041A A042A A043A A046A A046B " +
+	"A066A A066B A066C A071 A072 A073 A074 A075 A076 A077 A078 A079 A" +
+	"080 A081 A082 A083 A084 A085 A086 A087 A088 A089 A090 A091 A092 " +
+	"A093 A094 A095 A096 A097 A097A A098 A098A A099 A100 A100-102 A10" +
+	"0A A101 A101A A102 A102A A103 A104 A104A A104B A104C A105 A105A " +
+	"A105B A106 A107 A107A A107B A107C A108 A109 A110 A110A A110B A11" +
+	"1 A112 A113 A114 A115 A115A A116 A117 A118 A119 A120 A120B A121 " +
+	"A122 A123 A124 A125 A125A A126 A127 A128 A129 A130 A131 A131C A1" +
+	"32 A133 A134 A135 A135A A136 A137 A138 A139 A140 A141 A142 A143 " +
+	"A144 A145 A146 A147 A148 A149 A150 A151 A152 A153 A154 A155 A156" +
+	" A157 A158 A159 A160 A161 A162 A163 A164 A165 A166 A167 A168 A16" +
+	"9 A170 A171 A172 A173 A174 A175 A176 A177 A178 A179 A180 A181 A1" +
+	"82 A183 A184 A185 A186 A187 A188 A189 A190 A191 A192 A193 A194 A" +
+	"195 A196 A197 A198 A199 A200 A201 A202 A202A A202B A203 A204 A20" +
+	"5 A206 A207 A207A A208 A209 A209A A210 A211 A212 A213 A214 A215 " +
+	"A215A A216 A216A A217 A218 A219 A220 A221 A222 A223 A224 A225 A2" +
+	"26 A227 A227A A228 A229 A230 A231 A232 A233 A234 A235 A236 A237 " +
+	"A238 A239 A240 A241 A242 A243 A244 A245 A246 A247 A248 A249 A250" +
+	" A251 A252 A253 A254 A255 A256 A257 A258 A259 A260 A261 A262 A26" +
+	"3 A264 A265 A266 A267 A267A A268 A269 A270 A271 A272 A273 A274 A" +
+	"275 A276 A277 A278 A279 A280 A281 A282 A283 A284 A285 A286 A287 " +
+	"A288 A289 A289A A290 A291 A292 A293 A294 A294A A295 A296 A297 A2" +
+	"98 A299 A299A A3 A300 A309 A309B A309C A313 A313A A313B A313C A3" +
+	"29A A332 A332A A332B A332C A336A A336B A336C A359A A364A A368A A" +
+	"371A A372 A373 A374 A375 A376 A377 A378 A379 A380 A381 A381A A38" +
+	"2 A383 A383A A384 A385 A386 A386A A387 A388 A389 A390 A391 A392 " +
+	"A393 A394 A395 A396 A397 A398 A399 A400 A400-VAS A401 A401-VAS A" +
+	"402 A402-VAS A403 A403-VAS A404 A404-VAS A405 A405-VAS A406 A406" +
+	"-VAS A407 A407-VAS A408 A408-VAS A409 A409-VAS A410 A410-VAS A41" +
+	"0A A411 A411-VAS A412 A412-VAS A413 A413-VAS A414 A414-VAS A415 " +
+	"A415-VAS A416 A416-VAS A417 A417-VAS A418 A418-VAS A419 A420 A42" +
+	"1 A422 A423 A424 A425 A426 A427 A428 A429 A430 A431 A432 A433 A4" +
+	"34 A435 A436 A437 A438 A439 A440 A441 A442 A443 A444 A445 A446 A" +
+	"447 A448 A449 A450 A450A A451 A452 A453 A454 A455 A456 A457 A457" +
+	"A A458 A459 A460 A461 A462 A463 A464 A465 A466 A467 A468 A469 A4" +
+	"70 A471 A472 A473 A474 A475 A476 A477 A478 A479 A480 A481 A482 A" +
+	"483 A484 A485 A486 A487 A488 A489 A490 A491 A492 A493 A494 A495 " +
+	"A496 A497 A507 A514 A517 A518 A519 A522 A531 A532 A534 A535 A536" +
+	" A537 A538 A539 A540 A541 A542 A545 A547 A548 A549 A550 A551 A55" +
+	"2 A553 A554 A555 A556 A557 A559 A563 A564 A565 A566 A568 A569 A5" +
+	"70 A571 A572 A573 A574 A575 A576 A577 A578 A579 A580 A581 A582 A" +
+	"583 A584 A585 A586 A587 A588 A589 A591 A592 A594 A595 A596 A598 " +
+	"A600 A601 A602 A603 A604 A606 A608 A609 A610 A611 A612 A613 A614" +
+	" A615 A616 A617 A618 A619 A620 A621 A622 A623 A624 A626 A627 A62" +
+	"8 A629 A634 A637 A638 A640 A642 A643 A644 A645 A646 A648 A649 A6" +
+	"51 A652 A653 A654 A655 A656 A657 A658 A659 A660 A661 A662 A663 A" +
+	"664 A701 A702 A703 A704 A705 A706 A707 A708 A709 A709-2 A709-3 A" +
+	"709-4 A709-6 A710 A711 A712 A713 A714 A715 A717 A726 A732 A800 A" +
+	"801 A802 A803 A804 A805 A806 A807 AA001 AA002 AA003 AA004 AA005 " +
+	"AA006 AA007 AA007A AA007B AA008 AA009 AA010 AA011 AA012 AA013 AA" +
+	"014 AA015 AA016 AA017 AA018 AA019 AA020 AA021 AA022 AA023 AA024 " +
+	"AA025 AA026 AA027 AA028 AA029 AA030 AA031 AA032 AABAAFILI AAJ AA" +
+	"K AAM AANG AAO AARU AAU AAW AAYANNA AAYIN AAZHAAKKU AB001 AB002 " +
+	"AB003 AB004 AB005 AB006 AB007 AB008 AB009 AB010 AB011 AB013 AB01" +
+	"6 AB017 AB020 AB021 AB021F AB021M AB022 AB022F AB022M AB023 AB02" +
+	"3M AB024 AB026 AB027 AB028 AB029 AB030 AB031 AB034 AB037 AB038 A" +
+	"B039 AB040 AB041 AB044 AB045 AB046 AB047 AB048 AB049 AB050 AB051" +
+	" AB053 AB054 AB055 AB056 AB057 AB058 AB059 AB060 AB061 AB065 AB0" +
+	"66 AB067 AB069 AB070 AB073 AB074 AB076 AB077 AB078 AB079 AB080 A" +
+	"B081 AB082 AB085 AB086 AB087 AB118 AB120 AB122 AB123 AB131A AB13" +
+	"1B AB164 AB171 AB180 AB188 AB191 ABACUS ABAFILI ABB ABUNDANCE AB" +
+	"YSMAL ACCENT-STACCATO ACCEPT ACCOMMODATION ACCORDION ACCUMULATIO" +
+	"N ACUTE-GRAVE-ACUTE ACUTE-MACRON ADAK ADDAK ADDRESS ADDRESSED AD" +
+	"HESIVE ADI ADMETOS ADMISSION ADO ADVANCE ADVANTAGE AED AEEYANNA " +
+	"AEL AENG AER AERIAL AES AESCULAPIUS AEYANNA AFFRICATION AFGHANI " +
+	"AFOREMENTIONED AFSAAQ AFTER AGAIN AGAINST AGE AGGRAVATED AGGRAVA" +
+	"TION AGUNG AHAD AHAGGAR AHANG AHH AHSA AHSDA AID AIHVUS AIKARA A" +
+	"ILM AINN AINU AIVA AIVILIK AIYANNA AKBAR AKSA AKTIESELSKAB AL-JU" +
+	"Z AL-LAKUNA ALAF ALAN ALARM ALAYHAA ALAYHIM ALAYHIMAA ALAYNAA AL" +
+	"F ALGIZ ALIFU ALIGNED ALKALI ALKALI-2 ALLAH ALLAHOU ALPA ALPAPRA" +
+	"NA ALT ALTERNATION ALUM AMALGAM AMALGAMATION AMBA AMBULANCE AMER" +
+	"ICAN AMERICAS AMOUNT AMPHORA AMPS AMULET AN-NISF ANAP ANATOMICAL" +
+	" ANATRICHISMA ANDAP ANGED ANGEL ANGKA ANGKHANKHU ANGRY ANGSTROM " +
+	"ANGUISHED ANGULAR ANH ANHAA ANHU ANHUM ANHUMAA ANHUNNA ANIMAL AN" +
+	"KH ANN ANNAAU ANNUITY ANPEA ANSUZ ANT ANTICLOCKWISE-ROTATED ANTI" +
+	"FONIA ANTIKENOKYLISMA ANTIKENOMA ANTIMONY-2 ANUSVARAYA AOR AOU A" +
+	"PAATO APART APIN APODEXIA APOLLON APOTHEMA APOTHES APPLICATION A" +
+	"PPROACH APPROACHES APRIL APUN AQUAFORTIS AQUARIUS AR-RAHEEM AR-R" +
+	"AHMAN AR-RUB ARAEA-A ARAEA-E ARAEA-EO ARAEA-I ARAEA-U ARAEAE ARD" +
+	"HACANDRA ARE AREPA ARGON ARGOTERI ARIES ARKAANU ARKAB ARLAUG ARM" +
+	"OUR ARMS ARMY AROUND-PROFILE AROURA AROUSING ARRAY ARRIVE ARRIVI" +
+	"NG ARROWHEAD-SHAPED ARSENIC ARTA ARTABE ARTICULATED ARTIST ARUHU" +
+	"A AS-SAJDA AS-SALAATU ASAL2 ASAT ASCENT ASCIA ASH3 ASH9 ASIA-AUS" +
+	"TRALIA ASPER ASPIRATED ASSALLAM ASSERTION ASTERISCUS ASTERISKS A" +
+	"STERISM ASTONISHED ASTRAEA ASTRONOMICAL ASYURA ASZ ATH-THALATHA " +
+	"ATHARVAVEDIC ATHLETIC ATIKRAMA ATIYA ATMAAU ATNAH ATOM ATT ATTAC" +
+	"HING ATTENTION ATTHACAN AUBERGINE AUGMENTATION AUGUST AUNN AURAM" +
+	"AZDAA AURAMAZDAA-2 AURAMAZDAAHA AURIPIGMENT AUSTRAL AUTO AUTOMAT" +
+	"ED AUTUMN AUYANNA AVAKRAHASANYA AVERAGE AVOCADO AWC AWE AWQ AWZ " +
+	"AYANNA AYER AYIN-DALETH AZZA B005A B010 B011 B012 B013 B014 B015" +
+	" B016 B017 B018 B019 B020 B021 B022 B023 B024 B025 B026 B027 B02" +
+	"8 B029 B030 B031 B032 B033 B034 B036 B037 B038 B039 B040 B041 B0" +
+	"42 B043 B044 B045 B046 B047 B048 B049 B050 B051 B052 B053 B054 B" +
+	"055 B056 B057 B058 B059 B060 B061 B062 B063 B064 B065 B066 B067 " +
+	"B068 B069 B070 B071 B072 B073 B074 B075 B076 B077 B078 B079 B080" +
+	" B081 B082 B083 B085 B086 B087 B089 B090 B091 B100 B102 B104 B10" +
+	"5 B105F B105M B106F B106M B107F B107M B108F B108M B109F B109M B1" +
+	"20 B121 B122 B123 B125 B127 B128 B130 B131 B132 B133 B135 B140 B" +
+	"141 B142 B145 B146 B150 B151 B152 B153 B154 B155 B156 B157 B158 " +
+	"B159 B160 B161 B162 B163 B164 B165 B166 B167 B168 B169 B170 B171" +
+	" B172 B173 B174 B176 B177 B178 B179 B180 B181 B182 B183 B184 B18" +
+	"5 B189 B190 B191 B200 B201 B202 B203 B204 B205 B206 B207 B208 B2" +
+	"09 B210 B211 B212 B213 B214 B215 B216 B217 B218 B219 B220 B221 B" +
+	"222 B225 B226 B227 B228 B229 B230 B231 B232 B233 B234 B236 B240 " +
+	"B241 B242 B243 B245 B246 B247 B248 B249 B250 B251 B252 B253 B254" +
+	" B255 B256 B257 B258 B259 B305 BA-2 BAARERU BACKSPACE BACON BACT" +
+	"RIAN BADGE BADMINTON BAG3 BAGA BAGEL BAGGAGE BAGS BAGUETTE BAH B" +
+	"AHIRGOMUKHA BAHT BAIMAI BAIRKAN BALD BALLET BALLPOINT BANANA BAN" +
+	"D BANDAGE BANG BANJO BANTOC BAP BARA2 BARBER BARIYOOSAN BARLEY B" +
+	"ARREKH BARRIER BASEBALL BASH BASKET BASKETBALL BATHAMASAT BAU BA" +
+	"X BB BBAA BBAP BBAT BBAX BBEE BBEP BBEX BBIE BBIEP BBIET BBIEX B" +
+	"BIP BBIT BBIX BBOP BBOT BBOX BBUO BBUOP BBUOX BBUP BBUR BBURX BB" +
+	"UX BBY BBYP BBYT BBYX BEACH BEADS BEAN BEANS BEARDED BEATING BEC" +
+	"AUSE BED BEEHIVE BEETA BEFORE BEGINNER BEGINNING BEITH BELGTHOR " +
+	"BELLHOP BENDE BENTO BEORC BEP BERKANAN BEX BEYYAL BH BHAA BHAM B" +
+	"HATTIPROLU BHEE BHI BHOO BHU BIB BIBLE-CREE BICEPS BICYCLE BICYC" +
+	"LES BIDENTAL BIE BIEP BIET BIEX BIKINI BILLED BILLIARDS BILLIONS" +
+	" BINOVILE BIOHAZARD BIP BIRTHDAY BIRU BISAH BISECTING BISMILLAH " +
+	"BISMUTH BISON BITCOIN BITE BITTER BIX BJARKAN BKA- BLA BLOCK-135" +
+	"8 BLOND BLOW BLOWFISH BLUEBERRIES BOA BOMB BOOKS BOOTS BOP BORAX" +
+	" BORAX-2 BORAX-3 BORUTO BORZAYA BORZY BOT BOTTOM-LIGHTED BOTTOM-" +
+	"SHADED BOUNDARY BOWING BOWLING BOXING BOY BOYS BQ BRACE BRACKETS" +
+	" BRAIN BRAKCET BRANCHES BRANCHING BREAKTHROUGH BREAST-FEEDING BR" +
+	"EVE-MACRON BRI BRIDE BRIEFCASE BRIEFS BRISTLE BROCCOLI BRONZE BR" +
+	"OOM BSDUS BSKA- BSKUR BSTAR BUCKET BUCKLE BUFFALO BUG BULB BULL " +
+	"BULLS BULLSEYE BUMPY BUNG BUNNY BUO BUOP BUOX BUOY BUP BUR2 BURR" +
+	"ITO BURX BUSINESS BUSSYERU BUST BUSTS BUTTER BUTTERFLY BUUMISH B" +
+	"UX BWE BXG BYP BYR BYRX BYT BYX C-18 C-39 C001 C002 C002A C002B " +
+	"C002C C003 C004 C005 C006 C007 C008 C009 C010 C010A C011 C012 C0" +
+	"13 C014 C015 C016 C017 C018 C019 C020 C021 C022 C023 C024 CAAI C" +
+	"AANG CABBAGE-TREE CABINET CABLEWAY CACTUS CADA CAESURA CAH CAI C" +
+	"AL CALC CALCULATOR CALX CAMNUC CAMPING CANCER CANDLE CANDY CANE " +
+	"CANG CANNED CANOE CAPITULUM CAPO CAPPED CAPRICORN CAPTIVE CAPUT " +
+	"CARDS CARE CAROUSEL CARP CARRIAGE CARROT CARS CARTRIDGE CARTWHEE" +
+	"L CARYSTIAN CATAWA CAU CAUDA CAUTION CAVE CAX CCA CCAA CCE CCEE " +
+	"CCHA CCHAA CCHEE CCHHA CCHHAA CCHHE CCHHEE CCHHI CCHHO CCHHU CCH" +
+	"I CCHO CCHU CCI CCO CCU CEALC CECEK CEDI CEEB CEEV CEIRT CELEBRA" +
+	"TION CELSIUS CELTIC CEN CENTURIAL CEONGCHIEUMCHIEUCH CEONGCHIEUM" +
+	"CIEUC CEONGCHIEUMSIOS CEONGCHIEUMSSANGCIEUC CEONGCHIEUMSSANGSIOS" +
+	" CEP CEREK CEREMONY CERES CEVITU CEX CHAD CHADA CHAINS CHAMILI C" +
+	"HAMILON CHAMKO CHAN CHANG CHANGE CHAP CHAPTER CHATTAWA CHAU CHAV" +
+	"IYANI CHAX CHEEK CHEEM CHEERING CHEESE CHEIKHAN CHEIKHEI CHEINAP" +
+	" CHELNU CHEN CHEP CHEQUERED CHERRIES CHERRY CHERY CHESTNUT CHET " +
+	"CHEX CHHA CHHIM CHICKEN CHIEUCH-HIEUH CHIEUCH-KHIEUKH CHIL CHILD" +
+	"REN CHIM CHIME CHING CHINOOK CHIPMUNK CHIRET CHIRON CHITUEUMCHIE" +
+	"UCH CHITUEUMCIEUC CHITUEUMSIOS CHITUEUMSSANGCIEUC CHITUEUMSSANGS" +
+	"IOS CHOA CHOCOLATE CHOE CHOKE CHOP CHOPSTICKS CHOT CHOX CHOY CHR" +
+	"YSANTHEMUM CHULA CHUO CHUOP CHUOT CHUOX CHUP CHUR CHURX CHUX CHW" +
+	"A CHWV CHY CHYP CHYR CHYRX CHYT CHYX CIE CIEP CIET CIEUC-IEUNG C" +
+	"IEUC-PIEUP CIEUC-SSANGPIEUP CIEX CII CINEMA CINNABAR CIRCULATION" +
+	" CIRCUS CIT CITATION CIVILIAN CIX CL CLAIM CLAMSHELL CLAPPER CLA" +
+	"PPING CLASSICAL CLAW CLEAR CLEAVER CLEF-1 CLEF-2 CLIMACUS CLIMBI" +
+	"NG CLINGING CLIPBOARD CLIVIS CLOSENESS CLOSET CLOSURE CLOVER CLO" +
+	"WN CLUB-SPOKED CM001 CM002 CM004 CM005 CM006 CM007 CM008 CM009 C" +
+	"M010 CM011 CM012 CM012B CM013 CM015 CM017 CM019 CM021 CM023 CM02" +
+	"4 CM025 CM026 CM027 CM028 CM029 CM030 CM033 CM034 CM035 CM036 CM" +
+	"037 CM038 CM039 CM040 CM041 CM044 CM046 CM047 CM049 CM050 CM051 " +
+	"CM052 CM053 CM054 CM055 CM056 CM058 CM059 CM060 CM061 CM062 CM06" +
+	"3 CM064 CM066 CM067 CM068 CM069 CM070 CM071 CM072 CM073 CM074 CM" +
+	"075 CM075B CM076 CM078 CM079 CM080 CM081 CM082 CM083 CM084 CM085" +
+	" CM086 CM087 CM088 CM089 CM090 CM091 CM092 CM094 CM095 CM096 CM0" +
+	"97 CM098 CM099 CM100 CM101 CM102 CM103 CM104 CM105 CM107 CM108 C" +
+	"M109 CM110 CM112 CM114 CM301 CM302 COA COASTER COCKROACH COCKTAI" +
+	"L COCONUT CODA COENG COFFIN COIN COLL COLLISION COLOR COLUMN COM" +
+	"B COMBINATION COMBINED COMET COMING COMMON COMPARE COMPASS COMPL" +
+	"EMENT COMPLETED COMPLIANCE COMPONENT-001 COMPONENT-002 COMPONENT" +
+	"-003 COMPONENT-004 COMPONENT-005 COMPONENT-006 COMPONENT-007 COM" +
+	"PONENT-008 COMPONENT-009 COMPONENT-010 COMPONENT-011 COMPONENT-0" +
+	"12 COMPONENT-013 COMPONENT-014 COMPONENT-015 COMPONENT-016 COMPO" +
+	"NENT-017 COMPONENT-018 COMPONENT-019 COMPONENT-020 COMPONENT-021" +
+	" COMPONENT-022 COMPONENT-023 COMPONENT-024 COMPONENT-025 COMPONE" +
+	"NT-026 COMPONENT-027 COMPONENT-028 COMPONENT-029 COMPONENT-030 C" +
+	"OMPONENT-031 COMPONENT-032 COMPONENT-033 COMPONENT-034 COMPONENT" +
+	"-035 COMPONENT-036 COMPONENT-037 COMPONENT-038 COMPONENT-039 COM" +
+	"PONENT-040 COMPONENT-041 COMPONENT-042 COMPONENT-043 COMPONENT-0" +
+	"44 COMPONENT-045 COMPONENT-046 COMPONENT-047 COMPONENT-048 COMPO" +
+	"NENT-049 COMPONENT-050 COMPONENT-051 COMPONENT-052 COMPONENT-053" +
+	" COMPONENT-054 COMPONENT-055 COMPONENT-056 COMPONENT-057 COMPONE" +
+	"NT-058 COMPONENT-059 COMPONENT-060 COMPONENT-061 COMPONENT-062 C" +
+	"OMPONENT-063 COMPONENT-064 COMPONENT-065 COMPONENT-066 COMPONENT" +
+	"-067 COMPONENT-068 COMPONENT-069 COMPONENT-070 COMPONENT-071 COM" +
+	"PONENT-072 COMPONENT-073 COMPONENT-074 COMPONENT-075 COMPONENT-0" +
+	"76 COMPONENT-077 COMPONENT-078 COMPONENT-079 COMPONENT-080 COMPO" +
+	"NENT-081 COMPONENT-082 COMPONENT-083 COMPONENT-084 COMPONENT-085" +
+	" COMPONENT-086 COMPONENT-087 COMPONENT-088 COMPONENT-089 COMPONE" +
+	"NT-090 COMPONENT-091 COMPONENT-092 COMPONENT-093 COMPONENT-094 C" +
+	"OMPONENT-095 COMPONENT-096 COMPONENT-097 COMPONENT-098 COMPONENT" +
+	"-099 COMPONENT-100 COMPONENT-101 COMPONENT-102 COMPONENT-103 COM" +
+	"PONENT-104 COMPONENT-105 COMPONENT-106 COMPONENT-107 COMPONENT-1" +
+	"08 COMPONENT-109 COMPONENT-110 COMPONENT-111 COMPONENT-112 COMPO" +
+	"NENT-113 COMPONENT-114 COMPONENT-115 COMPONENT-116 COMPONENT-117" +
+	" COMPONENT-118 COMPONENT-119 COMPONENT-120 COMPONENT-121 COMPONE" +
+	"NT-122 COMPONENT-123 COMPONENT-124 COMPONENT-125 COMPONENT-126 C" +
+	"OMPONENT-127 COMPONENT-128 COMPONENT-129 COMPONENT-130 COMPONENT" +
+	"-131 COMPONENT-132 COMPONENT-133 COMPONENT-134 COMPONENT-135 COM" +
+	"PONENT-136 COMPONENT-137 COMPONENT-138 COMPONENT-139 COMPONENT-1" +
+	"40 COMPONENT-141 COMPONENT-142 COMPONENT-143 COMPONENT-144 COMPO" +
+	"NENT-145 COMPONENT-146 COMPONENT-147 COMPONENT-148 COMPONENT-149" +
+	" COMPONENT-150 COMPONENT-151 COMPONENT-152 COMPONENT-153 COMPONE" +
+	"NT-154 COMPONENT-155 COMPONENT-156 COMPONENT-157 COMPONENT-158 C" +
+	"OMPONENT-159 COMPONENT-160 COMPONENT-161 COMPONENT-162 COMPONENT" +
+	"-163 COMPONENT-164 COMPONENT-165 COMPONENT-166 COMPONENT-167 COM" +
+	"PONENT-168 COMPONENT-169 COMPONENT-170 COMPONENT-171 COMPONENT-1" +
+	"72 COMPONENT-173 COMPONENT-174 COMPONENT-175 COMPONENT-176 COMPO" +
+	"NENT-177 COMPONENT-178 COMPONENT-179 COMPONENT-180 COMPONENT-181" +
+	" COMPONENT-182 COMPONENT-183 COMPONENT-184 COMPONENT-185 COMPONE" +
+	"NT-186 COMPONENT-187 COMPONENT-188 COMPONENT-189 COMPONENT-190 C" +
+	"OMPONENT-191 COMPONENT-192 COMPONENT-193 COMPONENT-194 COMPONENT" +
+	"-195 COMPONENT-196 COMPONENT-197 COMPONENT-198 COMPONENT-199 COM" +
+	"PONENT-200 COMPONENT-201 COMPONENT-202 COMPONENT-203 COMPONENT-2" +
+	"04 COMPONENT-205 COMPONENT-206 COMPONENT-207 COMPONENT-208 COMPO" +
+	"NENT-209 COMPONENT-210 COMPONENT-211 COMPONENT-212 COMPONENT-213" +
+	" COMPONENT-214 COMPONENT-215 COMPONENT-216 COMPONENT-217 COMPONE" +
+	"NT-218 COMPONENT-219 COMPONENT-220 COMPONENT-221 COMPONENT-222 C" +
+	"OMPONENT-223 COMPONENT-224 COMPONENT-225 COMPONENT-226 COMPONENT" +
+	"-227 COMPONENT-228 COMPONENT-229 COMPONENT-230 COMPONENT-231 COM" +
+	"PONENT-232 COMPONENT-233 COMPONENT-234 COMPONENT-235 COMPONENT-2" +
+	"36 COMPONENT-237 COMPONENT-238 COMPONENT-239 COMPONENT-240 COMPO" +
+	"NENT-241 COMPONENT-242 COMPONENT-243 COMPONENT-244 COMPONENT-245" +
+	" COMPONENT-246 COMPONENT-247 COMPONENT-248 COMPONENT-249 COMPONE" +
+	"NT-250 COMPONENT-251 COMPONENT-252 COMPONENT-253 COMPONENT-254 C" +
+	"OMPONENT-255 COMPONENT-256 COMPONENT-257 COMPONENT-258 COMPONENT" +
+	"-259 COMPONENT-260 COMPONENT-261 COMPONENT-262 COMPONENT-263 COM" +
+	"PONENT-264 COMPONENT-265 COMPONENT-266 COMPONENT-267 COMPONENT-2" +
+	"68 COMPONENT-269 COMPONENT-270 COMPONENT-271 COMPONENT-272 COMPO" +
+	"NENT-273 COMPONENT-274 COMPONENT-275 COMPONENT-276 COMPONENT-277" +
+	" COMPONENT-278 COMPONENT-279 COMPONENT-280 COMPONENT-281 COMPONE" +
+	"NT-282 COMPONENT-283 COMPONENT-284 COMPONENT-285 COMPONENT-286 C" +
+	"OMPONENT-287 COMPONENT-288 COMPONENT-289 COMPONENT-290 COMPONENT" +
+	"-291 COMPONENT-292 COMPONENT-293 COMPONENT-294 COMPONENT-295 COM" +
+	"PONENT-296 COMPONENT-297 COMPONENT-298 COMPONENT-299 COMPONENT-3" +
+	"00 COMPONENT-301 COMPONENT-302 COMPONENT-303 COMPONENT-304 COMPO" +
+	"NENT-305 COMPONENT-306 COMPONENT-307 COMPONENT-308 COMPONENT-309" +
+	" COMPONENT-310 COMPONENT-311 COMPONENT-312 COMPONENT-313 COMPONE" +
+	"NT-314 COMPONENT-315 COMPONENT-316 COMPONENT-317 COMPONENT-318 C" +
+	"OMPONENT-319 COMPONENT-320 COMPONENT-321 COMPONENT-322 COMPONENT" +
+	"-323 COMPONENT-324 COMPONENT-325 COMPONENT-326 COMPONENT-327 COM" +
+	"PONENT-328 COMPONENT-329 COMPONENT-330 COMPONENT-331 COMPONENT-3" +
+	"32 COMPONENT-333 COMPONENT-334 COMPONENT-335 COMPONENT-336 COMPO" +
+	"NENT-337 COMPONENT-338 COMPONENT-339 COMPONENT-340 COMPONENT-341" +
+	" COMPONENT-342 COMPONENT-343 COMPONENT-344 COMPONENT-345 COMPONE" +
+	"NT-346 COMPONENT-347 COMPONENT-348 COMPONENT-349 COMPONENT-350 C" +
+	"OMPONENT-351 COMPONENT-352 COMPONENT-353 COMPONENT-354 COMPONENT" +
+	"-355 COMPONENT-356 COMPONENT-357 COMPONENT-358 COMPONENT-359 COM" +
+	"PONENT-360 COMPONENT-361 COMPONENT-362 COMPONENT-363 COMPONENT-3" +
+	"64 COMPONENT-365 COMPONENT-366 COMPONENT-367 COMPONENT-368 COMPO" +
+	"NENT-369 COMPONENT-370 COMPONENT-371 COMPONENT-372 COMPONENT-373" +
+	" COMPONENT-374 COMPONENT-375 COMPONENT-376 COMPONENT-377 COMPONE" +
+	"NT-378 COMPONENT-379 COMPONENT-380 COMPONENT-381 COMPONENT-382 C" +
+	"OMPONENT-383 COMPONENT-384 COMPONENT-385 COMPONENT-386 COMPONENT" +
+	"-387 COMPONENT-388 COMPONENT-389 COMPONENT-390 COMPONENT-391 COM" +
+	"PONENT-392 COMPONENT-393 COMPONENT-394 COMPONENT-395 COMPONENT-3" +
+	"96 COMPONENT-397 COMPONENT-398 COMPONENT-399 COMPONENT-400 COMPO" +
+	"NENT-401 COMPONENT-402 COMPONENT-403 COMPONENT-404 COMPONENT-405" +
+	" COMPONENT-406 COMPONENT-407 COMPONENT-408 COMPONENT-409 COMPONE" +
+	"NT-410 COMPONENT-411 COMPONENT-412 COMPONENT-413 COMPONENT-414 C" +
+	"OMPONENT-415 COMPONENT-416 COMPONENT-417 COMPONENT-418 COMPONENT" +
+	"-419 COMPONENT-420 COMPONENT-421 COMPONENT-422 COMPONENT-423 COM" +
+	"PONENT-424 COMPONENT-425 COMPONENT-426 COMPONENT-427 COMPONENT-4" +
+	"28 COMPONENT-429 COMPONENT-430 COMPONENT-431 COMPONENT-432 COMPO" +
+	"NENT-433 COMPONENT-434 COMPONENT-435 COMPONENT-436 COMPONENT-437" +
+	" COMPONENT-438 COMPONENT-439 COMPONENT-440 COMPONENT-441 COMPONE" +
+	"NT-442 COMPONENT-443 COMPONENT-444 COMPONENT-445 COMPONENT-446 C" +
+	"OMPONENT-447 COMPONENT-448 COMPONENT-449 COMPONENT-450 COMPONENT" +
+	"-451 COMPONENT-452 COMPONENT-453 COMPONENT-454 COMPONENT-455 COM" +
+	"PONENT-456 COMPONENT-457 COMPONENT-458 COMPONENT-459 COMPONENT-4" +
+	"60 COMPONENT-461 COMPONENT-462 COMPONENT-463 COMPONENT-464 COMPO" +
+	"NENT-465 COMPONENT-466 COMPONENT-467 COMPONENT-468 COMPONENT-469" +
+	" COMPONENT-470 COMPONENT-471 COMPONENT-472 COMPONENT-473 COMPONE" +
+	"NT-474 COMPONENT-475 COMPONENT-476 COMPONENT-477 COMPONENT-478 C" +
+	"OMPONENT-479 COMPONENT-480 COMPONENT-481 COMPONENT-482 COMPONENT" +
+	"-483 COMPONENT-484 COMPONENT-485 COMPONENT-486 COMPONENT-487 COM" +
+	"PONENT-488 COMPONENT-489 COMPONENT-490 COMPONENT-491 COMPONENT-4" +
+	"92 COMPONENT-493 COMPONENT-494 COMPONENT-495 COMPONENT-496 COMPO" +
+	"NENT-497 COMPONENT-498 COMPONENT-499 COMPONENT-500 COMPONENT-501" +
+	" COMPONENT-502 COMPONENT-503 COMPONENT-504 COMPONENT-505 COMPONE" +
+	"NT-506 COMPONENT-507 COMPONENT-508 COMPONENT-509 COMPONENT-510 C" +
+	"OMPONENT-511 COMPONENT-512 COMPONENT-513 COMPONENT-514 COMPONENT" +
+	"-515 COMPONENT-516 COMPONENT-517 COMPONENT-518 COMPONENT-519 COM" +
+	"PONENT-520 COMPONENT-521 COMPONENT-522 COMPONENT-523 COMPONENT-5" +
+	"24 COMPONENT-525 COMPONENT-526 COMPONENT-527 COMPONENT-528 COMPO" +
+	"NENT-529 COMPONENT-530 COMPONENT-531 COMPONENT-532 COMPONENT-533" +
+	" COMPONENT-534 COMPONENT-535 COMPONENT-536 COMPONENT-537 COMPONE" +
+	"NT-538 COMPONENT-539 COMPONENT-540 COMPONENT-541 COMPONENT-542 C" +
+	"OMPONENT-543 COMPONENT-544 COMPONENT-545 COMPONENT-546 COMPONENT" +
+	"-547 COMPONENT-548 COMPONENT-549 COMPONENT-550 COMPONENT-551 COM" +
+	"PONENT-552 COMPONENT-553 COMPONENT-554 COMPONENT-555 COMPONENT-5" +
+	"56 COMPONENT-557 COMPONENT-558 COMPONENT-559 COMPONENT-560 COMPO" +
+	"NENT-561 COMPONENT-562 COMPONENT-563 COMPONENT-564 COMPONENT-565" +
+	" COMPONENT-566 COMPONENT-567 COMPONENT-568 COMPONENT-569 COMPONE" +
+	"NT-570 COMPONENT-571 COMPONENT-572 COMPONENT-573 COMPONENT-574 C" +
+	"OMPONENT-575 COMPONENT-576 COMPONENT-577 COMPONENT-578 COMPONENT" +
+	"-579 COMPONENT-580 COMPONENT-581 COMPONENT-582 COMPONENT-583 COM" +
+	"PONENT-584 COMPONENT-585 COMPONENT-586 COMPONENT-587 COMPONENT-5" +
+	"88 COMPONENT-589 COMPONENT-590 COMPONENT-591 COMPONENT-592 COMPO" +
+	"NENT-593 COMPONENT-594 COMPONENT-595 COMPONENT-596 COMPONENT-597" +
+	" COMPONENT-598 COMPONENT-599 COMPONENT-600 COMPONENT-601 COMPONE" +
+	"NT-602 COMPONENT-603 COMPONENT-604 COMPONENT-605 COMPONENT-606 C" +
+	"OMPONENT-607 COMPONENT-608 COMPONENT-609 COMPONENT-610 COMPONENT" +
+	"-611 COMPONENT-612 COMPONENT-613 COMPONENT-614 COMPONENT-615 COM" +
+	"PONENT-616 COMPONENT-617 COMPONENT-618 COMPONENT-619 COMPONENT-6" +
+	"20 COMPONENT-621 COMPONENT-622 COMPONENT-623 COMPONENT-624 COMPO" +
+	"NENT-625 COMPONENT-626 COMPONENT-627 COMPONENT-628 COMPONENT-629" +
+	" COMPONENT-630 COMPONENT-631 COMPONENT-632 COMPONENT-633 COMPONE" +
+	"NT-634 COMPONENT-635 COMPONENT-636 COMPONENT-637 COMPONENT-638 C" +
+	"OMPONENT-639 COMPONENT-640 COMPONENT-641 COMPONENT-642 COMPONENT" +
+	"-643 COMPONENT-644 COMPONENT-645 COMPONENT-646 COMPONENT-647 COM" +
+	"PONENT-648 COMPONENT-649 COMPONENT-650 COMPONENT-651 COMPONENT-6" +
+	"52 COMPONENT-653 COMPONENT-654 COMPONENT-655 COMPONENT-656 COMPO" +
+	"NENT-657 COMPONENT-658 COMPONENT-659 COMPONENT-660 COMPONENT-661" +
+	" COMPONENT-662 COMPONENT-663 COMPONENT-664 COMPONENT-665 COMPONE" +
+	"NT-666 COMPONENT-667 COMPONENT-668 COMPONENT-669 COMPONENT-670 C" +
+	"OMPONENT-671 COMPONENT-672 COMPONENT-673 COMPONENT-674 COMPONENT" +
+	"-675 COMPONENT-676 COMPONENT-677 COMPONENT-678 COMPONENT-679 COM" +
+	"PONENT-680 COMPONENT-681 COMPONENT-682 COMPONENT-683 COMPONENT-6" +
+	"84 COMPONENT-685 COMPONENT-686 COMPONENT-687 COMPONENT-688 COMPO" +
+	"NENT-689 COMPONENT-690 COMPONENT-691 COMPONENT-692 COMPONENT-693" +
+	" COMPONENT-694 COMPONENT-695 COMPONENT-696 COMPONENT-697 COMPONE" +
+	"NT-698 COMPONENT-699 COMPONENT-700 COMPONENT-701 COMPONENT-702 C" +
+	"OMPONENT-703 COMPONENT-704 COMPONENT-705 COMPONENT-706 COMPONENT" +
+	"-707 COMPONENT-708 COMPONENT-709 COMPONENT-710 COMPONENT-711 COM" +
+	"PONENT-712 COMPONENT-713 COMPONENT-714 COMPONENT-715 COMPONENT-7" +
+	"16 COMPONENT-717 COMPONENT-718 COMPONENT-719 COMPONENT-720 COMPO" +
+	"NENT-721 COMPONENT-722 COMPONENT-723 COMPONENT-724 COMPONENT-725" +
+	" COMPONENT-726 COMPONENT-727 COMPONENT-728 COMPONENT-729 COMPONE" +
+	"NT-730 COMPONENT-731 COMPONENT-732 COMPONENT-733 COMPONENT-734 C" +
+	"OMPONENT-735 COMPONENT-736 COMPONENT-737 COMPONENT-738 COMPONENT" +
+	"-739 COMPONENT-740 COMPONENT-741 COMPONENT-742 COMPONENT-743 COM" +
+	"PONENT-744 COMPONENT-745 COMPONENT-746 COMPONENT-747 COMPONENT-7" +
+	"48 COMPONENT-749 COMPONENT-750 COMPONENT-751 COMPONENT-752 COMPO" +
+	"NENT-753 COMPONENT-754 COMPONENT-755 COMPONENT-756 COMPONENT-757" +
+	" COMPONENT-758 COMPONENT-759 COMPONENT-760 COMPONENT-761 COMPONE" +
+	"NT-762 COMPONENT-763 COMPONENT-764 COMPONENT-765 COMPONENT-766 C" +
+	"OMPONENT-767 COMPONENT-768 COMPRESSION COMPUTERS CONCAVE-POINTED" +
+	" CONFETTI CONFLICT CONFOUNDED CONFUSED CONGRUENT CONICAL CONJUGA" +
+	"TE CONJUNCTION CONSTANCY CONTEMPLATION CONTENTION CONTINUATION C" +
+	"ONTINUING CONTINUOUS CONTOURED CONTRACTION CONTRARIETY CONVENIEN" +
+	"CE COOKED COOKING COOL COP COPPER-2 COPY COPYLEFT CORAL CORK COR" +
+	"NISH CORONIS CORPORATION CORPSE CORRECT CORRESPONDS COT COUCH CO" +
+	"UNTERBORE COUNTERSINK COUPLE COWBOY COX CRAB CRACKER CRAYON CREA" +
+	"TIVE CRESCENDO CROCODILE CROISSANT CROIX CRUCIBLE CRUCIBLE-2 CRU" +
+	"CIBLE-3 CRUCIBLE-4 CRUCIBLE-5 CRUCIFORM CRUTCH CRUZEIRO CRYSTAL " +
+	"CUAM CUCUMBER CUO CUOX CUPCAKE CUPIDO CUR CURLED CURLING CURRY C" +
+	"URX CUSTARD CUSTOMER CUSTOMS CUX CWEORTH CYAW CYAY CYCLONE CYLIN" +
+	"DRICITY CYPERUS CYR CYRENAIC CYRX CYX D001 D002 D003 D004 D005 D" +
+	"006 D007 D008 D008A D009 D010 D011 D012 D013 D014 D015 D016 D017" +
+	" D018 D019 D020 D021 D022 D023 D024 D025 D026 D027 D027A D028 D0" +
+	"29 D030 D031 D031A D032 D033 D034 D034A D035 D036 D037 D038 D039" +
+	" D040 D041 D042 D043 D044 D045 D046 D046A D047 D048 D048A D049 D" +
+	"050 D050A D050B D050C D050D D050E D050F D050G D050H D050I D051 D" +
+	"052 D052A D053 D054 D054A D055 D056 D057 D058 D059 D060 D061 D06" +
+	"2 D063 D064 D065 D066 D067 D067A D067B D067C D067D D067E D067F D" +
+	"067G D067H D2 DAADHU DAASU DAEG DAENG DAG3 DAGALGA DAGAZ DAGBASI" +
+	"NNA DAGS DAH DAHYAAUSH DAHYAAUSH-2 DAI DAING DAIR DALAT DALETH-R" +
+	"ESH DAM DAMARU DANCER DANCING DANGO DANTAYALAN DARA4 DARGA DART " +
+	"DASEIA DATA DAVID DAVIYANI DAWB DAX DAY-NIGHT DD DDAP DDAT DDAX " +
+	"DDEE DDEP DDEX DDHE DDHEE DDHI DDHU DDIE DDIEP DDIEX DDIP DDIT D" +
+	"DIX DDOA DDOP DDOT DDOX DDUO DDUOP DDUOX DDUP DDURX DDUT DDUX DD" +
+	"WA DEAD DEAF DEBIT DECAYED DECEMBER DECIDUOUS DECISIVENESS DECOR" +
+	"ATIVE DECRESCENDO DEEL DEEPLY DEFECTIVENESS DEFINITION DEHI DEK " +
+	"DEKA DELETION DELICIOUS DELIVERANCE DELIVERY DELPHIC DELT DENARI" +
+	"US DENG DENNEN DEP DEPARTMENT DEPTH DERBITSA DERELICT DERET DERE" +
+	"T-HIDET DESI DESIGN DESK DEUNG DEVELOPMENT DEX DEYTEROU DHAA DHA" +
+	"ALU DHADHE DHAL DHALATH DHALETH DHAMEDH DHARMA DHEE DHHA DHHE DH" +
+	"HEE DHHI DHHO DHHOO DHHU DHI DHII DHOO DHOU DHU DIAERESIS-RING D" +
+	"IAERESIZED DIAMETER DIARGON DIATONON DIEP DIESEL DIEX DIFAT DIFF" +
+	"ERENCE DIFFICULTIES DIFFICULTY DIFTOGGOS DIGITS DIGRAMMA DIL DIM" +
+	"ENSION DIMENSIONAL DIMIDIA DIMINISHMENT DIMINUTION-2 DIMINUTION-" +
+	"3 DIMMING DING DIP DIPLOUN DIPPER DIPTE DIRGA DISABLED DISCONTIN" +
+	"UOUS DISGUISED DISPERSION DISPUTED DISSOLVE DISSOLVE-2 DISTILL D" +
+	"ISTINGUISH DISTORTION DIT DITTO DIVERGENCE DIVIDERS DIVIDES DIVI" +
+	"NG DIVORCE DIX DIYA DJ DL DLE DLEE DLHA DLHYA DLI DLO DLU DNA DO" +
+	"-O DOA DODO DOING DOIT DOKMAI DOLIUM DOLPHIN DOMAIN DOONG DOP DO" +
+	"ROME DORU DOTS-1 DOTS-12 DOTS-123 DOTS-1234 DOTS-12345 DOTS-1234" +
+	"56 DOTS-1234567 DOTS-12345678 DOTS-1234568 DOTS-123457 DOTS-1234" +
+	"578 DOTS-123458 DOTS-12346 DOTS-123467 DOTS-1234678 DOTS-123468 " +
+	"DOTS-12347 DOTS-123478 DOTS-12348 DOTS-1235 DOTS-12356 DOTS-1235" +
+	"67 DOTS-1235678 DOTS-123568 DOTS-12357 DOTS-123578 DOTS-12358 DO" +
+	"TS-1236 DOTS-12367 DOTS-123678 DOTS-12368 DOTS-1237 DOTS-12378 D" +
+	"OTS-1238 DOTS-124 DOTS-1245 DOTS-12456 DOTS-124567 DOTS-1245678 " +
+	"DOTS-124568 DOTS-12457 DOTS-124578 DOTS-12458 DOTS-1246 DOTS-124" +
+	"67 DOTS-124678 DOTS-12468 DOTS-1247 DOTS-12478 DOTS-1248 DOTS-12" +
+	"5 DOTS-1256 DOTS-12567 DOTS-125678 DOTS-12568 DOTS-1257 DOTS-125" +
+	"78 DOTS-1258 DOTS-126 DOTS-1267 DOTS-12678 DOTS-1268 DOTS-127 DO" +
+	"TS-1278 DOTS-128 DOTS-13 DOTS-134 DOTS-1345 DOTS-13456 DOTS-1345" +
+	"67 DOTS-1345678 DOTS-134568 DOTS-13457 DOTS-134578 DOTS-13458 DO" +
+	"TS-1346 DOTS-13467 DOTS-134678 DOTS-13468 DOTS-1347 DOTS-13478 D" +
+	"OTS-1348 DOTS-135 DOTS-1356 DOTS-13567 DOTS-135678 DOTS-13568 DO" +
+	"TS-1357 DOTS-13578 DOTS-1358 DOTS-136 DOTS-1367 DOTS-13678 DOTS-" +
+	"1368 DOTS-137 DOTS-1378 DOTS-138 DOTS-14 DOTS-145 DOTS-1456 DOTS" +
+	"-14567 DOTS-145678 DOTS-14568 DOTS-1457 DOTS-14578 DOTS-1458 DOT" +
+	"S-146 DOTS-1467 DOTS-14678 DOTS-1468 DOTS-147 DOTS-1478 DOTS-148" +
+	" DOTS-15 DOTS-156 DOTS-1567 DOTS-15678 DOTS-1568 DOTS-157 DOTS-1" +
+	"578 DOTS-158 DOTS-16 DOTS-167 DOTS-1678 DOTS-168 DOTS-17 DOTS-17" +
+	"8 DOTS-18 DOTS-2 DOTS-23 DOTS-234 DOTS-2345 DOTS-23456 DOTS-2345" +
+	"67 DOTS-2345678 DOTS-234568 DOTS-23457 DOTS-234578 DOTS-23458 DO" +
+	"TS-2346 DOTS-23467 DOTS-234678 DOTS-23468 DOTS-2347 DOTS-23478 D" +
+	"OTS-2348 DOTS-235 DOTS-2356 DOTS-23567 DOTS-235678 DOTS-23568 DO" +
+	"TS-2357 DOTS-23578 DOTS-2358 DOTS-236 DOTS-2367 DOTS-23678 DOTS-" +
+	"2368 DOTS-237 DOTS-2378 DOTS-238 DOTS-24 DOTS-245 DOTS-2456 DOTS" +
+	"-24567 DOTS-245678 DOTS-24568 DOTS-2457 DOTS-24578 DOTS-2458 DOT" +
+	"S-246 DOTS-2467 DOTS-24678 DOTS-2468 DOTS-247 DOTS-2478 DOTS-248" +
+	" DOTS-25 DOTS-256 DOTS-2567 DOTS-25678 DOTS-2568 DOTS-257 DOTS-2" +
+	"578 DOTS-258 DOTS-26 DOTS-267 DOTS-2678 DOTS-268 DOTS-27 DOTS-27" +
+	"8 DOTS-28 DOTS-3 DOTS-34 DOTS-345 DOTS-3456 DOTS-34567 DOTS-3456" +
+	"78 DOTS-34568 DOTS-3457 DOTS-34578 DOTS-3458 DOTS-346 DOTS-3467 " +
+	"DOTS-34678 DOTS-3468 DOTS-347 DOTS-3478 DOTS-348 DOTS-35 DOTS-35" +
+	"6 DOTS-3567 DOTS-35678 DOTS-3568 DOTS-357 DOTS-3578 DOTS-358 DOT" +
+	"S-36 DOTS-367 DOTS-3678 DOTS-368 DOTS-37 DOTS-378 DOTS-38 DOTS-4" +
+	" DOTS-45 DOTS-456 DOTS-4567 DOTS-45678 DOTS-4568 DOTS-457 DOTS-4" +
+	"578 DOTS-458 DOTS-46 DOTS-467 DOTS-4678 DOTS-468 DOTS-47 DOTS-47" +
+	"8 DOTS-48 DOTS-5 DOTS-56 DOTS-567 DOTS-5678 DOTS-568 DOTS-57 DOT" +
+	"S-578 DOTS-58 DOTS-6 DOTS-67 DOTS-678 DOTS-68 DOTS-7 DOTS-78 DOT" +
+	"S-8 DOTTED-L DOTTED-N DOTTED-P DOUBLE-ENDED DOUBT DOUGHNUT DOWNS" +
+	"CALING DOX DRAFTING DRIL DROMEDARY DROOLING DROP DROPS DRUMSTICK" +
+	"S DUB2 DUCK DUDA DUGUD DUH DUM DUMPLING DUN4 DUO DUOX DUP DUPOND" +
+	"IUS DUR DUR2 DURATION DURX DUSHENNA DUSK DUT DUTIES DUX DVA DVD " +
+	"DVISVARA DVOECHELNOKRYZHEVAYA DVOETOCHIE DVUMYA DWA DWO DYAN DZA" +
+	"A DZAY DZHOI DZITA DZYAY DZYI DZZA E-2 E-3 E-4 E-5 E-6 E-MAIL E0" +
+	"01 E002 E003 E004 E005 E006 E007 E008 E008A E009 E009A E010 E011" +
+	" E012 E013 E014 E015 E016 E016A E017 E017A E018 E019 E020 E020A " +
+	"E021 E022 E023 E024 E025 E026 E027 E028 E028A E029 E030 E031 E03" +
+	"2 E033 E034 E034A E036 E037 E038 EABHADH EADHADH EAMHANCHOLL EAR" +
+	"LY EASE EBEFILI EDD EDIN EDITORIAL EEBEEFILI EEH EEKAA EEN EEYAN" +
+	"NA EGGS EGIR EHWAZ EIGHT-THIRTY EIGHTIETH EIS EJECT EKAM EKARA E" +
+	"KS EKSTREPTON ELAFRON ELECTRICAL ELECTRONICS ELEVATOR ELEVATUS E" +
+	"LEVEN-THIRTY ELF ELIF ELT EMBELLISHMENT EMBLEM EMBROIDERY EMPHAT" +
+	"IC ENARMONIOS ENARXIS ENCOUNTERS ENDEAVOUR ENDEP ENDOFONON ENLAR" +
+	"GEMENT ENNI ENOS ENQUIRY ENTERING ENTHUSIASM ENTRY-1 ENTRY-2 ENU" +
+	"MERATION EO-EU EO-O EO-U EOH EOLHX EPEGERMA EPOCH EQ EQUIANGULAR" +
+	" EQUID ERG ERS ES-1 ES-2 ES-3 ES-TE ESH16 ESH21 ESO ESS ESTIMATE" +
+	"D ESTIMATES ESUKUUDO ETHEL ETNAHTA EU-A EU-E EU-EO EU-EU EU-O EU" +
+	"-U EULER EURO-CURRENCY EUROPE-AFRICA EVENING EVERGREEN EVERY EXC" +
+	"ELLENT EXCESS EXCHANGE EXCITEMENT EXHALE EXHAUSTION EXIST EXISTS" +
+	" EXPLODING EXPONENT EXPRESSIONLESS EXTINGUISHER EXTRA EXTRATERRE" +
+	"STRIAL EYANNA EYBEYFILI EYEBROW EYEGLASSES EYYY F001 F001A F002 " +
+	"F003 F004 F005 F006 F007 F008 F009 F010 F011 F012 F013 F013A F01" +
+	"4 F015 F016 F017 F018 F019 F020 F021 F021A F022 F023 F024 F025 F" +
+	"026 F027 F028 F029 F030 F031 F031A F032 F033 F034 F035 F036 F037" +
+	" F037A F038 F038A F039 F040 F041 F042 F043 F044 F045 F045A F046 " +
+	"F046A F047 F047A F048 F049 F050 F051 F051A F051B F051C F052 F053" +
+	" FAAFU FAAI FAAMAE FACE-1 FACE-2 FACE-3 FACE-4 FACE-5 FACE-6 FAC" +
+	"INGS FACSIMILE FACTORY FAHRENHEIT FAIB FAIHU FAILURE FAIRY FAJ F" +
+	"ALAFEL FALLEN FAM FANG FAP FAQ FAR FAST FAT FATIGUE FAYANNA FEAR" +
+	" FEARFUL FEARN FEBRUARY FEEM FEENG FEHU FELLOWSHIP FENCER FEOH F" +
+	"ERRIS FERRY FESTIVAL FETH FEUFEUAET FEUX FF FFI FFL FHTORA FIGHT" +
+	" FIGURE-1 FIGURE-2 FIGURE-3 FII FILLED FILLER-1 FILLER-2 FINGERN" +
+	"AILS FINITE FIP FIRECRACKER FIREWORK FIREWORKS FIRI FISHEYE FISH" +
+	"ING FISTED FIT FIVE-LINE FIVE-THIRTY FIX FL FLA FLAG-1 FLAG-2 FL" +
+	"AG-3 FLAG-4 FLAG-5 FLAGS FLAMINGO FLASH FLATNESS FLEUR-DE-LIS FL" +
+	"EXED FLIGHT FLOORPLANE FLOWERS FLOWING FLUSHED FM FOG FOGGY FOLD" +
+	"ED FOLLY FON FONDUE FONGMAN FOOL FOOTPRINTS FOOTSTOOL FOP FORCE " +
+	"FORCES FORKING FORMATTING FORMS FORTE FORTUNE FOSTERING FOUR-LIN" +
+	"E FOUR-PER-EM FOUR-STRING FOUR-THIRTY FRAGMENT FRAGRANT FRAMES F" +
+	"RANC FREEZING FRICATIVE FRIED FRIES FRONT-FACING FUA FUEL FUET F" +
+	"UJI FULLNESS FUNERAL FUP FURX FUSE FUT FUX FWE FY FYA FYP FYT FY" +
+	"X G001 G002 G003 G004 G005 G006 G006A G007 G007A G007B G008 G009" +
+	" G010 G011 G011A G012 G013 G014 G015 G016 G017 G018 G019 G020 G0" +
+	"20A G021 G022 G023 G024 G025 G026 G026A G027 G028 G029 G030 G031" +
+	" G032 G033 G034 G035 G036 G036A G037 G037A G038 G039 G040 G041 G" +
+	"042 G043 G043A G044 G045 G045A G046 G047 G048 G049 G050 G051 G05" +
+	"2 G053 G054 G2 GAAFU GAAHLAA GADOL GAG GAI GALAM GAM GAMAN GAML " +
+	"GAMLA GANDA GANMA GAR3 GARDEN GARLIC GARMENT GARON GASHAN GAT GA" +
+	"UNTLET GAX GB GBAKURUNEN GBAYI GBEN GBET GBEUX GBIEE GBON GCAN G" +
+	"DAN GE22 GEBA GEBO GEDE GEDOLA GEEM GEM GEMINATE GEMINI GEN GENE" +
+	"RIC GENIE GENITIVE GENTLE GEOMETRIC GER GERMAN GET GETA GEX GG G" +
+	"GAA GGAP GGAT GGAX GGEE GGEP GGET GGEX GGIE GGIEP GGIEX GGIT GGI" +
+	"X GGOT GGOX GGUOP GGUOT GGUOX GGUP GGUR GGURX GGUT GGUX GGWA GGW" +
+	"AA GGWE GGWEE GGWI GH GHAAMAE GHAINU GHAMAL GHAMMA GHAP GHARAE G" +
+	"HAYN GHEE GHET GHEUAEGHEUAE GHEUAERAE GHEUGHEN GHEUGHEUAEM GHEUN" +
+	" GHEUX GHEYS GHI GHIMEL GHOM GHOU GHU GHWA GHZ GIBA GIDIM GIE GI" +
+	"EP GIET GIEX GIFT GIG GIGA GIMEL-HETH GINII GIP GIRAFFE GIRL GIR" +
+	"LS GIRUDAA GISAL GIT GIX GLA GLASSES GLEICH GLOVE GLOVES GLOWING" +
+	" GN GNAVIYANI GOA GOAT GOBLIN GOGGLES GOING GOK GOLFER GONG GOO " +
+	"GOOD GOP GORA GORGOSYNTHETON GORGOTERI GORILLA GORT GOX GPA GRAD" +
+	"UAL GRADUATION GRAF GRAIN GRAMMA GRAPES GRAPHEME GRATER GRAVE-AC" +
+	"UTE-GRAVE GRAVE-MACRON GRAVEYARD GREATNESS GRIMACING GRONTHISMAT" +
+	"A GROWING GUA GUAN GUARANI GUARDEDNESS GUARDSMAN GUEI GUIDE GUIT" +
+	"AR GUO GUOP GUOT GUOX GUP GURAMU GURAMUTON GURUN GURUSH GURX GUT" +
+	" GUX GVANG GW GWAA GWU GY GYA GYAA GYAN GYE GYEE GYFU GYI GYO GY" +
+	"U H-TYPE H001 H002 H003 H004 H005 H006 H006A H007 H008 HA-1 HA-1" +
+	"0 HA-11 HA-2 HA-3 HA-4 HA-5 HA-6 HA-7 HA-8 HA-9 HA-HA HAAM HAARU" +
+	" HADES HAEGL HAFUKH HAFUKHA HAGL HAGLAZ HAI HAIRCUT HAIS HAITU H" +
+	"ALBERD HALF-1 HALF-2 HALO HALQA HAM HAMBURGER HAMSA HAMSTER HAN-" +
+	"AKAT HANDBAG HANDBALL HANDLES HANDSHAKE HANG HAO HAP HAPPY HARBA" +
+	"HAY HARDNESS HARMONIC HASER HATCHING HATHI HAUPTSTIMME HAWJ HAX " +
+	"HAY HAYANNA HBASA-ESASA HC HDR HE-1 HE-2 HE-3 HE-4 HE-5 HE-6 HE-" +
+	"7 HE-GOAT HEAD-BANDAGE HEADING HEADPHONE HEADSCARF HEAR-NO-EVIL " +
+	"HEARING HEAVENLY HEDGEHOG HEEI HEIGHT HEISEI HEKUTAARU HELICOPTE" +
+	"R HELIX HELLSCHREIBER HELM HEMP HEP HERAEUM HERB HERMES HERMITIA" +
+	"N HERUTU HEX HEXIFORM HEYT HHE HHEE HHI HHO HHU HHWE HHWEE HHWI " +
+	"HHYA HHYAA HHYE HHYEE HHYI HHYO HHYU HI-1 HI-2 HI-3 HI-4 HI-5 HI" +
+	"-6 HI-7 HI-RES HIBISCUS HIDE HIDET HIDING HIEUH-MIEUM HIEUH-NIEU" +
+	"N HIEUH-PIEUP HIEUH-RIEUL HIEUH-SIOS HIEX HIGH-HEELED HIGH-LOW H" +
+	"IKING HINDU HIPPOPOTAMUS HISTORIC HIZB HK HL HLAP HLAT HLAU HLAX" +
+	" HLE HLEP HLEX HLIE HLIEP HLIEX HLIP HLIT HLIX HLO HLOP HLOX HLU" +
+	" HLUO HLUOP HLUOX HLUP HLUR HLURX HLUT HLUX HLY HLYP HLYR HLYRX " +
+	"HLYT HLYX HM HMA HMAP HMAT HMAX HME HMI HMIE HMIEP HMIEX HMIP HM" +
+	"IT HMIX HMOP HMOT HMOX HMU HMUO HMUOP HMUOX HMUP HMUR HMURX HMUT" +
+	" HMUX HMY HMYP HMYR HMYRX HMYX HNAP HNAT HNAU HNAX HNE HNEP HNEX" +
+	" HNI HNIE HNIEP HNIET HNIEX HNIP HNIT HNIX HNOP HNOT HNOX HNUB H" +
+	"NUO HNUOX HNUT HO-1 HO-2 HO-3 HO-4 HO-5 HO-6 HO-7 HO-8 HOA HOCHO" +
+	" HOI HOKA HOM HONEY HONEYBEE HOON HOOP HOORU HOOU HOP HORIZONTAL" +
+	"-00-00 HORIZONTAL-00-01 HORIZONTAL-00-02 HORIZONTAL-00-03 HORIZO" +
+	"NTAL-00-04 HORIZONTAL-00-05 HORIZONTAL-00-06 HORIZONTAL-01-00 HO" +
+	"RIZONTAL-01-01 HORIZONTAL-01-02 HORIZONTAL-01-03 HORIZONTAL-01-0" +
+	"4 HORIZONTAL-01-05 HORIZONTAL-01-06 HORIZONTAL-02-00 HORIZONTAL-" +
+	"02-01 HORIZONTAL-02-02 HORIZONTAL-02-03 HORIZONTAL-02-04 HORIZON" +
+	"TAL-02-05 HORIZONTAL-02-06 HORIZONTAL-03-00 HORIZONTAL-03-01 HOR" +
+	"IZONTAL-03-02 HORIZONTAL-03-03 HORIZONTAL-03-04 HORIZONTAL-03-05" +
+	" HORIZONTAL-03-06 HORIZONTAL-04-00 HORIZONTAL-04-01 HORIZONTAL-0" +
+	"4-02 HORIZONTAL-04-03 HORIZONTAL-04-04 HORIZONTAL-04-05 HORIZONT" +
+	"AL-04-06 HORIZONTAL-05-00 HORIZONTAL-05-01 HORIZONTAL-05-02 HORI" +
+	"ZONTAL-05-03 HORIZONTAL-05-04 HORIZONTAL-05-05 HORIZONTAL-05-06 " +
+	"HORIZONTAL-06-00 HORIZONTAL-06-01 HORIZONTAL-06-02 HORIZONTAL-06" +
+	"-03 HORIZONTAL-06-04 HORIZONTAL-06-05 HORIZONTAL-06-06 HORIZONTA" +
+	"LLY HOSPITAL HOTA HOX HOY HPA HPWG HRYVNIA HTTA HU-1 HU-2 HU-3 H" +
+	"UAN HUARADDO HUB HUIITO HUK HUL2 HUNG HUO HUOP HUOT HUOX HURAN H" +
+	"USH HUSHED HUT HUVA HWA HWAH HWEE HWI HWO HWU HXA HXAP HXAT HXAX" +
+	" HXE HXEP HXEX HXI HXIE HXIEP HXIET HXIEX HXIP HXIX HXO HXOT HXO" +
+	"X HXUOP HXUOT HXUOX HXWG HYGIEA HYGIEIA HYPODIASTOLE HYSTERESIS " +
+	"HZG HZT HZW HZWG HZZ HZZP HZZZ HZZZG I-1 I-2 I-3 I-4 I-A I-ARAEA" +
+	" I-BEAM I-EU I-I I-O I-O-I I-U I-YA I-YA-O I-YAE I-YE I-YEO I-YO" +
+	" I-YU I001 I002 I003 I004 I005 I005A I006 I007 I008 I009 I009A I" +
+	"010 I010A I011 I011A I012 I013 I014 I015 IANG IBIFILI ICELANDIC-" +
+	"YR ICHADIN ICHOU ID IDEOGRAPH-4E00 IDEOGRAPH-4E2D IDEOGRAPH-4EA4" +
+	" IDEOGRAPH-518D IDEOGRAPH-521D IDEOGRAPH-524D IDEOGRAPH-5272 IDE" +
+	"OGRAPH-52DD IDEOGRAPH-53CC IDEOGRAPH-53F3 IDEOGRAPH-5408 IDEOGRA" +
+	"PH-5439 IDEOGRAPH-55B6 IDEOGRAPH-58F0 IDEOGRAPH-591A IDEOGRAPH-5" +
+	"929 IDEOGRAPH-5B57 IDEOGRAPH-5B89 IDEOGRAPH-5DE6 IDEOGRAPH-5F8C " +
+	"IDEOGRAPH-624B IDEOGRAPH-6295 IDEOGRAPH-6307 IDEOGRAPH-6355 IDEO" +
+	"GRAPH-6557 IDEOGRAPH-6599 IDEOGRAPH-65B0 IDEOGRAPH-6620 IDEOGRAP" +
+	"H-6708 IDEOGRAPH-6709 IDEOGRAPH-672C IDEOGRAPH-6E80 IDEOGRAPH-6F" +
+	"14 IDEOGRAPH-70B9 IDEOGRAPH-7121 IDEOGRAPH-751F IDEOGRAPH-7533 I" +
+	"DEOGRAPH-76D7 IDEOGRAPH-7981 IDEOGRAPH-7A7A IDEOGRAPH-7D42 IDEOG" +
+	"RAPH-89E3 IDEOGRAPH-8CA9 IDEOGRAPH-8D70 IDEOGRAPH-904A IDEOGRAPH" +
+	"-914D IDLE IEP IET IEUNG-CHIEUCH IEUNG-CIEUC IEUNG-HIEUH IEUNG-K" +
+	"HIEUKH IEUNG-MIEUM IEUNG-PANSIOS IEUNG-PHIEUPH IEUNG-PIEUP IEUNG" +
+	"-RIEUL IEUNG-SIOS IEUNG-SSANGKIYEOK IEUNG-THIEUTH IEUNG-TIKEUT I" +
+	"EX IF IFIN IGGWS IIYANNA IL2 ILIMMU3 ILIMMU4 ILUT ILUUYANNA ILUY" +
+	" ILUYANNA IMAALA IMIDIARGON IMIFONON IMIFTHORA IMIFTHORON IMIN3 " +
+	"IMISEOS IMN IMP IN-ALAF INAP INBOX INCH INCLUDING INCOMING INCOM" +
+	"PLETE INCREASES INCREMENT INDIAN INDICTION INDIRECT INDUSTRIAL I" +
+	"NFLUENCE INGWAZ INHALE ININGU INK INN INNN INNOCENCE INSECT INTE" +
+	"RCALATE INTEREST INTERLOCKED INTERSYLLABIC INTI INVERTEBRATE INY" +
+	" INYA IODHADH IONG IOR IP IRB IRI IRON-COPPER IRUUYANNA IRUYANNA" +
+	" ISAKIA ISAZ ISEN-ISEN ISHMAAM ISLAND ISS ITEM ITS IUJA IWAZ IWN" +
+	" IYANNA IZ IZAKAYA JACK-O-LANTERN JACKS JAH JAIN JALL JALLAJALAL" +
+	"OUHOU JANUARY JAPAN JAVIYANI JAYIN JAYN JEANS JER JERA JERAN JER" +
+	"USALEM JEU JHAA JHAM JHAYIN JHO JHOX JIA JIE JIEP JIET JIEX JIGS" +
+	"AW JIP JIT JIX JJE JJEE JJIEP JJIET JJIEX JJIP JJIT JJIX JJOP JJ" +
+	"OT JJOX JJUO JJUOP JJUOX JJUP JJUR JJURX JJUX JJYP JJYT JJYX JOA" +
+	" JOINTS JONG JOP JOVE JOX JOYOUS JOYSTICK JUDEO-SPANISH JUDGE JU" +
+	"DUL JUEUI JUGGLING JULY JUNE JUNO JUO JUOP JUOT JUOX JUP JUPITER" +
+	" JUR JURX JUT JUU JUX JY JYP JYR JYRX JYT JYX K001 K002 K003 K00" +
+	"4 K005 K006 K007 K008 K2 KA-1 KA-10 KA-11 KA-2 KA-3 KA-4 KA-5 KA" +
+	"-6 KA-7 KA-8 KA-9 KA-KE KAAB KAABA KAACU KAAFU KAAI KAAN KAANKUU" +
+	" KAAV KACHKA KAD KAD4 KAFA KAIB KAIRI KAIV KAKABAT KAM KAM2 KAM4" +
+	" KANAKO KANGAROO KANTAJA KAPAL KAPO KAQ KAR KARAN KARATTO KAROR " +
+	"KARORAN KARORII KARSHANA KAT KATAVA KATAVASMA KATHISTI KAUB KAUN" +
+	" KAUNA KAUV KAV KAWB KAWI KAWV KAX KB KCAL KE-1 KE-2 KE-3 KE-4 K" +
+	"E-5 KE-6 KEAAE KEB KEEB KEENG KEESU KEEV KEFULA KELVIN KEMBANG K" +
+	"EMPHRENG KENAT KEOW KEP KERET KES KEUAE KEUAEM KEUAERI KEUAETMEU" +
+	"N KEUKAQ KEUKEUTNDA KEUOT KEUP KEUPUQ KEUSEUX KEUSHEUAEP KEUYEUX" +
+	" KEV KEX KHAB KHAF KHAMILO KHAN KHANDA KHAV KHEE KHETH KHIT KHIT" +
+	"AN KHOMUT KHON KHONNA KHOT KHOU KHU KHUAT KHUDAM KHUEN-LUE KHWAI" +
+	" KHZ KI-1 KI-2 KI-3 KI-4 KI-5 KI-6 KI-7 KI-8 KIAB KIAV KIB KICK " +
+	"KIE KIEEM KIEP KIEX KIH KII KIIZH KILLER KIMONO KINDERGARTEN KIN" +
+	"SHIP KIQ KIRO KIROGURAMU KIROMEETORU KIROWATTO KISAL KISH KITE K" +
+	"IV KIW KIWIFRUIT KIX KIYEOK-CHIEUCH KIYEOK-HIEUH KIYEOK-KHIEUKH " +
+	"KIYEOK-NIEUN KIYEOK-PIEUP KIYEOK-RIEUL KIYEOK-SIOS-KIYEOK KIYEOK" +
+	"-TIKEUT KKE KKEE KKI KKO KKU KL KLA KLITON KLYUCHENEPOSTOYANNY K" +
+	"LYUCHEPOVODNAYA KLYUCHEPOVODNY KLYUCHEVOY KNEELING KNOBS KNOT KO" +
+	"-1 KO-2 KO-3 KO-KI KOA KOALA KOB KOBYLA KOGHOM KOH KOINI KOKE KO" +
+	"KO KOMBU KOOB KOOMUUT KOOPO KOOV KOP KOQNDON KORON KORUNA KOT KO" +
+	"UFISMA KOV KOVUU KOX KPAH KPAN KPARAQ KPEN KPEUX KPOQ KRA KRATIM" +
+	"ATA KRATIMOKOUFISMA KRATIMOYPORROON KREMASTI KRONOS KRYZHEVAYA K" +
+	"T KU-1 KU-2 KU-3 KU-4 KU-5 KU-6 KU-7 KU7 KUA KUAB KUAV KUB KUET " +
+	"KUFISMA KUG KUNDDALIYA KUNG KUO KUOM KUOQ KUOX KUP KUPNAYA KUQ K" +
+	"UROONE KURT KURUNI KURUZEIRO KURX KUSMA KUUH KUV KUX KUZHI KV KW" +
+	"AET KWAY KWB KWM KWU318 KWV KXAA KXE KXEE KXI KXO KXU KXWA KXWAA" +
+	" KXWE KXWEE KXWI KYAA KYATHOS KYE KYI KYLISMA KYO KYU KYURII L-T" +
+	"YPE L001 L002 L002A L003 L004 L005 L006 L006A L007 L008 L2 L3 L4" +
+	" L6 LAAI LAAMU LAAN LAANAE LAB LABAT LABEL LABIALIZATION LABOURI" +
+	"NG LACA LACK LACROSSE LADDER LADY LAEV LAGU LAGUS LAH LAHSHU LAJ" +
+	"ANYALAN LAK-003 LAK-020 LAK-021 LAK-025 LAK-030 LAK-051 LAK-062 " +
+	"LAK-080 LAK-092 LAK-130 LAK-142 LAK-210 LAK-219 LAK-220 LAK-225 " +
+	"LAK-228 LAK-238 LAK-265 LAK-266 LAK-343 LAK-347 LAK-348 LAK-383 " +
+	"LAK-384 LAK-390 LAK-441 LAK-450 LAK-457 LAK-470 LAK-483 LAK-490 " +
+	"LAK-492 LAK-493 LAK-495 LAK-550 LAK-608 LAK-636 LAK-668 LAK-724 " +
+	"LAK-749 LAKHAN LAKKHANGYAO LAMADH LAMBDA LAMD LAND LANGUAGE LANT" +
+	"ERN LAPAQ LAQ LARI LARYNGEAL LAT LATE LATIK LAU LAUGHING LAUJ LA" +
+	"UKAZ LAX LAY LAYAR LCI LD LD2 LDAN LEAD LEADING LEAFY LEDGER LEE" +
+	"K LEFT-HANDED LEFT-LIGHTED LEFT-SIDE LEGION LEGS LEI LEMOI LEMON" +
+	" LENGTH-1 LENGTH-2 LENGTH-3 LENGTH-4 LENGTH-5 LENGTH-6 LENGTH-7 " +
+	"LEO LEOPARD LEP LESS LEUAEM LEUAEP LEUM LEVEL-2 LEVEL-3 LEVITATI" +
+	"NG LEX LHAG LHAVIYANI LHE LHEE LHII LHOO LHU LHYA LIABILITY LIBE" +
+	"RTY LIBRA LICKING LIEE LIEP LIET LIEX LIFE LIFTER LIGATING LIGHT" +
+	"HOUSE LII LIL LILITH LILY LIMBS LIME LIMIT LIMITATION LIMITED LI" +
+	"MMU2 LIMMU4 LINE-1 LINE-3 LINE-7 LINE-9 LINGSA LINKED LINKING LI" +
+	"ON LIPSTICK LIQ LIS LIT LITRA LIVRE LIX LIZARD LLAMA LLE LLHA LL" +
+	"L LM LN LOA LOBSTER LOCATION-FLOORPLANE LOCATION-WALLPLANE LOCAT" +
+	"IVE LODESTONE LOG LOGR LOLL LOLLIPOP LOMKA LOMMAE LONG-BRANCH-AR" +
+	" LONG-BRANCH-HAGALL LONG-BRANCH-MADR LONG-BRANCH-OSS LONG-BRANCH" +
+	"-SOL LONG-BRANCH-YR LONG-LEGGED LOOK LOON LOP LORRAINE LORRY LOS" +
+	" LOSSLESS LOT LOTION LOUDLY LOUDSPEAKER LOURE LOW-MID LOW-REVERS" +
+	"ED-9 LOX LUAEP LUB LUHUR LUIS LUNGS LUNGSI LUO LUOP LUOT LUOX LU" +
+	"P LUR LURX LUS LUT LUX LX LYA LYGISMA LYING LYIT LYP LYRX LYT LY" +
+	"X LYY M001A M001B M003A M010A M012A M012B M012C M012D M012E M012" +
+	"F M012G M012H M015A M016A M017A M022A M024A M028A M031A M033A M0" +
+	"33B M040A M045 M046 M047 M048 M049 M050 M051 M052 M053 M054 M055" +
+	" M056 M057 M058 M059 M060 M061 M062 M063 M064 M065 M066 M067 M06" +
+	"8 M069 M070 M071 M072 M073 M074 M075 M076 M077 M078 M079 M080 M0" +
+	"81 M082 M083 M084 M085 M086 M087 M088 M089 M090 M091 M092 M093 M" +
+	"094 M095 M096 M097 M098 M099 M100 M101 M102 M103 M104 M105 M106 " +
+	"M107 M108 M109 M110 M111 M112 M113 M114 M115 M116 M117 M118 M119" +
+	" M120 M121 M122 M123 M124 M125 M126 M127 M128 M129 M130 M131 M13" +
+	"2 M133 M134 M135 M136 M137 M138 M139 M140 M141 M142 M143 M144 M1" +
+	"45 M146 M147 M148 M149 M150 M151 M152 M153 M154 M155 M156 M157 M" +
+	"158 M159 M160 M161 M162 M163 M164 M165 M166 M167 M168 M169 M170 " +
+	"M171 M172 M173 M174 M175 M176 M177 M178 M179 M180 M181 M182 M183" +
+	" M184 M185 M186 M187 M188 M189 M190 M191 M192 M193 M194 M195 M19" +
+	"6 M197 MA-1 MA-2 MA-3 MA-4 MA-5 MA-6 MA-7 MA2 MAAI MAAYYAA MACRO" +
+	"N-ACUTE MACRON-BREVE MACRON-GRAVE MADU MADYA MAEKEUP MAELEE MAEM" +
+	"BGBIEE MAEMGBIEE MAEMKPEN MAEMVEUX MAENJET MAENYI MAGE MAGIC MAG" +
+	"NET MAHAPAKH MAHHA MAIDEN MAIKURO MAIMALAI MAIMUAN MAIRU MAITAIK" +
+	"HU MAIYAMOK MAIZE MALEERI MALTESE MAMMOTH MANACLES MANAT MANGALA" +
+	"M MANGO MANNA MANNAZ MANS MANSUAE MANSYON MANTELPIECE MANUAL MAO" +
+	" MAPLE MAQAF MARCASITE MARCATO MARCATO-STACCATO MARCH MARE MARK-" +
+	"4 MARKS MARRATAN MARRIAGE MARRYING MARTIAL MARUKU MARWARI MARY M" +
+	"ASCULINE MASHFAAT MASORA MASSAGE MASSING MASU MAT MATE MATERIALS" +
+	" MATRIX MATTOCK MAU MAX MAXIMA MAXIMIZE MAYANNA MB2 MB3 MB4 MBAA" +
+	"KET MBAARAE MBAQ MBEEKEET MBERAE MBEURI MBEUX MBIRIEEN MBUAE MBU" +
+	"AEM MBUE MBUO MBUOQ MBUU MD ME-1 ME-2 ME-MA MED MEDICAL MEDICINE" +
+	" MEEMU MEETORU MEGALI MEGAPHONE MEGATON MEIZI MELIK MELODIC MELT" +
+	"ING MEMBERSHIP MEMO MENDUT MENOE MENORAH MENS MERI MERIDIANS MER" +
+	"PERSON MESI MESSENIAN META METEG METEK METOBELUS METRETES METRIA" +
+	" METRO MEUNJOMNDEUQ MEUQ MEX MEZZO MFAA MFEUAE MFEUQ MFEUT MFIEE" +
+	" MFIYAQ MFO MG MGA MGAP MGAT MGAX MGBASA MGBASAQ MGBE MGBEE MGBE" +
+	"N MGBEUN MGBI MGBIEE MGBO MGBOFUM MGBOO MGBU MGE MGEP MGEX MGIE " +
+	"MGIEX MGOP MGOT MGOX MGU MGUO MGUOP MGUOX MGUP MGUR MGURX MGUT M" +
+	"GUX MH MHZ MI-1 MI-2 MI-3 MI-4 MI-5 MI-6 MI-7 MICRO MICROBE MICR" +
+	"OSCOPE MIE MIEP MIEUM-CHIEUCH MIEUM-CIEUC MIEUM-HIEUH MIEUM-NIEU" +
+	"N MIEUM-PIEUP-SIOS MIEUM-RIEUL MIEUM-SSANGNIEUN MIEUM-SSANGSIOS " +
+	"MIEUM-TIKEUT MIEX MII MIIN MIKRI MIKRON MIKURON MIL MILK MILKY M" +
+	"ILL MILLET MIME MINIBUS MINIDISC MINIMIZE MINISTER MINUS-OR-PLUS" +
+	" MINY MIP MIRED MIRI MIRIBAARU MISRA MIX MKPARAQ ML MNYAM MO-1 M" +
+	"O-2 MO-3 MO-4 MO-5 MO-6 MOA MODE MODEL MODELS MODEM MODERN MODES" +
+	"TY MODIFIER-10 MODIFIER-11 MODIFIER-12 MODIFIER-13 MODIFIER-14 M" +
+	"ODIFIER-15 MODIFIER-16 MODIFIER-7 MODIFIER-8 MODIFIER-9 MODULO M" +
+	"OHAMMAD MOKHASSAS MOL MONEY-MOUTH MONGKEUAEQ MONI MONOCLE MONORA" +
+	"IL MONOSTABLE MONSTER MONTIEEN MOOMEUT MOOMPUQ MOOSE-CREE MORNIN" +
+	"G MORPHOLOGICAL MORTUUM MOSQUE MOSQUITO MOT MOTORCYCLE MOTORIZED" +
+	" MOTORWAY MOUNT MOUNTAINS MOVED MOVES MOVIE MOX MOYAI MQ MR MRAC" +
+	"HNO MRACHNOTIKHAYA MRACHNY MS MU-1 MU-2 MU-3 MU-4 MU-GAAHLAA MUA" +
+	"S MUE MUEN MUGS MUIN MUKHA MUKKURUNI MUKPHRENG MULTI MULTIOCULAR" +
+	" MUM MUN MUNAH MUNSUB MUO MUOMAE MUOP MUOT MUOX MUP MUQDAM MUR M" +
+	"URE MURGU2 MURX MUS MUSHROOM MUT MUTHALIYA MUUSIKATOAN MUUVUZHAK" +
+	"KU MUX MVEUAENGAM MVI MX MYP MYT MYX MZ N-MU-MO-1 N-MU-MO-2 N001" +
+	" N002 N003 N004 N005 N006 N007 N008 N009 N010 N011 N012 N013 N01" +
+	"4 N015 N016 N017 N018 N018A N018B N019 N020 N021 N022 N023 N024 " +
+	"N025 N025A N026 N027 N028 N029 N030 N031 N032 N033 N033A N034 N0" +
+	"34A N035 N035A N036 N037 N037A N038 N039 N040 N041 N042 NA-1 NA-" +
+	"3 NA-4 NA-5 NA-6 NA-7 NA-8 NA-9 NA4 NAAI NAAKSIKYAYA NAGAR NAIL " +
+	"NAIRA NAN NAND NANGMONTHO NANO NANSANAQ NAOS NAP NAU NAUD NAUDIZ" +
+	" NAUSEATED NAUTHS NAX NAXIAN NAY NAZAR NBA NBAP NBAT NBAX NBI NB" +
+	"IEP NBIEX NBIP NBIT NBIX NBO NBOP NBOT NBOX NBU NBUP NBUR NBURX " +
+	"NBUT NBUX NBY NBYP NBYR NBYRX NBYT NBYX NCA NCHAU ND NDAANGGEUAE" +
+	"T NDAT NDAX NDEP NDEUAEREE NDEUT NDEUX NDEX NDIAQ NDIDA NDIE NDI" +
+	"EX NDIP NDIQ NDIT NDIX NDOMBU NDON NDOP NDOT NDOX NDUN NDUP NDUR" +
+	" NDURX NDUT NDUX NE-1 NE-3 NE-4 NE-5 NE-6 NE-KO NEBENSTIMME NECK" +
+	"TIE NEEDLE NEGATION NEL NEMKA NENANO NEP NERD NESSUS NESTING NET" +
+	"WORKED NEUTER NEX NEXT NF NGAAI NGAH NGAI NGAN NGANGU NGAP NGAQ " +
+	"NGAT NGAX NGEADAL NGEP NGEUREUT NGEX NGG NGGAA NGGAAMAE NGGAP NG" +
+	"GEEEE NGGEET NGGEU NGGEUAE NGGEUX NGGUA NGGUAEN NGGUAESHAE NGGUE" +
+	"ET NGGUM NGGUOM NGGUON NGGUP NGGURAE NGGWAEN NGIE NGIEP NGIEX NG" +
+	"II NGKAAMI NGKAP NGKAQ NGKEUAEM NGKEUAEQ NGKEURI NGKEUX NGKIEE N" +
+	"GKINDI NGKUENZEUM NGKUM NGKUN NGKUP NGKYEE NGOO NGOQ NGOT NGOX N" +
+	"GU NGUAE NGUAET NGUAN NGUE NGUN NGUO NGUOT NGUOX NGVE NGYE NH NH" +
+	"AY NHJA NHUE NI-1 NI-2 NI-3 NI-4 NI-5 NI-6 NI-7 NI-TE NI2 NIA NI" +
+	"E NIEP NIEUN-CHIEUCH NIEUN-PIEUP NIEUN-RIEUL NIEUN-THIEUTH NIEX " +
+	"NIGGAHITA NIGIDAESH NIGIDAMIN NIHSHVASA NIKA NIKAHIT NIKHAHIT NI" +
+	"N9 NINE-THIRTY NINJA NINTH NION NIP NIRUGU NISAG NIT NITRE NIX N" +
+	"JAA NJAP NJAQ NJEEEE NJEUAEM NJEUAENA NJEUT NJIE NJIEE NJIEP NJI" +
+	"ET NJIEX NJIP NJIT NJIX NJOP NJOT NJOX NJUO NJUOX NJUP NJUQA NJU" +
+	"R NJURX NJUX NJY NJYP NJYR NJYRX NJYT NJYX NKA NKAU NKINDI NKOM " +
+	"NL001 NL002 NL003 NL004 NL005 NL005A NL006 NL007 NL008 NL009 NL0" +
+	"10 NL011 NL012 NL013 NL014 NL015 NL016 NL017 NL017A NL018 NL019 " +
+	"NL020 NLAU NM NNAA NNE NNG NNGA NNGAA NNGI NNGII NNGO NNGOO NNHA" +
+	" NNO NNYA NO-1 NO-2 NO-3 NO-4 NO-5 NOA NOKHUK NOMINAL NOMISMA NO" +
+	"N NON-BREAKING NON-JOINER NON-POTABLE NONFORKING NOONU NOP NORDI" +
+	"C NORTHEAST-POINTING NORTHWEST NOTTO NOVEMBER NOVILE NOWC NOX NO" +
+	"Y NPA NPLA NQA NQIG NRAP NRAT NRAX NRE NREP NRES NRET NREX NRO N" +
+	"ROP NROX NRU NRUA NRUP NRUR NRURX NRUT NRUX NRY NRYP NRYR NRYRX " +
+	"NRYT NRYX NS NSA NSEN NSEUAEN NSHAQ NSHEE NSHUE NSHUET NSHUOP NS" +
+	"IEEP NSIEET NSOM NSUM NSUN NSUOT NTA NTEN NTEUNGBA NTHAU NTIEE N" +
+	"TOQPEN NTSA NTSAU NTU NTUJ NTUM NTXA NTXIV NU-1 NU-2 NU-3 NU001 " +
+	"NU002 NU003 NU004 NU005 NU006 NU007 NU008 NU009 NU010 NU010A NU0" +
+	"11 NU011A NU012 NU013 NU014 NU015 NU016 NU017 NU018 NU018A NU019" +
+	" NU020 NU021 NU022 NU022A NUE NUM NUMBERS NUMERO NUNAVUT NUO NUO" +
+	"P NUOX NUP NUR NURX NUSHU NUUN NUX NW NYAEMAE NYAH NYAJ NYAN NYC" +
+	"A NYD NYEN NYIE NYIEP NYIET NYIEX NYIN NYIN-DO NYIR NYIX NYJA NY" +
+	"OA NYON NYOO NYOT NYOX NYUE NYUN NYUO NYUOP NYUOX NYUP NYUT NYUX" +
+	" NYWA NZAP NZAQ NZAT NZAX NZE NZEUM NZEX NZI NZIE NZIEP NZIEX NZ" +
+	"IP NZIT NZIX NZOP NZOX NZU NZUN NZUO NZUOX NZUQ NZUR NZURX NZUX " +
+	"NZY NZYP NZYR NZYRX NZYT NZYX O-1 O-2 O-3 O-E O-EO O-O O-O-I O-U" +
+	" O-YA O-YAE O-YE O-YEO O001 O001A O002 O003 O004 O005 O005A O006" +
+	" O006A O006B O006C O006D O006E O006F O007 O008 O009 O010 O010A O" +
+	"010B O010C O011 O012 O013 O014 O015 O016 O017 O018 O019 O019A O0" +
+	"20 O020A O021 O022 O023 O024 O024A O025 O025A O026 O027 O028 O02" +
+	"9 O029A O030 O030A O031 O032 O033 O033A O034 O035 O036 O036A O03" +
+	"6B O036C O036D O037 O038 O039 O040 O041 O042 O043 O044 O045 O046" +
+	" O047 O048 O049 O050 O050A O050B O051 OABOAFILI OAK OB OBELOS OB" +
+	"ELUS OBJECT OBLACHKO OBLAKO OBOFILI OBOL OBSERVER OBSTRUCTION OC" +
+	" OCCLUSION OCHKOM OCTAGONAL OCTOBER OCTOPUS ODEN OER OEY OFF OFF" +
+	"ICER OG OGRE OJEON OLE OMALON ON-OFF ONAP ONE-LINE ONE-PIECE ONE" +
+	"-THIRTY ONE-WAY ONESELF ONION ONKAR ONSU OOBOOFILI OOH OOMU OON " +
+	"OOYANNA OOZE OPEN-OUTLINED OPEN-P OPHIUCHUS OPPOSE OPPRESSION OP" +
+	"TION ORANGUTAN ORCHID ORE-2 ORIGIN ORNAMENTS ORTHODOX ORTHOGONAL" +
+	" OTHAL OTHALAN OTHER OTHERS OTSECHKA OTT OTTER OUNKIA OUTBOX OUT" +
+	"LINE OVERHEATED OWL OXEIAI OYANNA OYSTER OZ P001 P001A P002 P003" +
+	" P003A P004 P005 P006 P007 P008 P009 P010 P011 P2 PAAI PAARAE PA" +
+	"ARAM PAASENTO PAATU PACKAGE PACKING PADDLE PADMA PAGER PAGODA PA" +
+	"H PAINTBRUSH PAIRTHRA PAIYANNOI PALATALIZATION PALATALIZED PALET" +
+	"TE PALKA PALLAS PALLAWA PALMS PALUTA PAMAAEH PAMENENG PAMEPET PA" +
+	"MINGKAL PAMSHAE PAMUNGKAH PANAELAENG PANAM PANCAKES PANDA PANEUL" +
+	"EUNG PANG PANGHULU PANGKAT PANGKON PANGLAYAR PANGLONG PANGRANGKE" +
+	"P PANGWISAD PANOLONG PANONGONAN PANSIOS-KAPYEOUNPIEUP PANSIOS-PI" +
+	"EUP PANYAKRA PANYANGGA PANYECEK PANYIKU PANYUKU PAO PAPERCLIP PA" +
+	"PERCLIPS PAPYRUS PARACHUTE PARAGRAPHUS PARAKLIT PAREREN PARICHON" +
+	" PARK PARROT PARTIALLY-RECYCLED PARUM PASEQ PASHAE PASHTA PASSED" +
+	" PASSENGER PASSIMBANG PASSIVE-PULL-DOWN-OUTPUT PASSIVE-PULL-UP-O" +
+	"UTPUT PASSPORT PASUQ PAT PATAK PATHAKKU PATHAMASAT PAUK PAUSE PA" +
+	"VIYANI PAX PAY PAZER PC PD PEACH PEACOCK PEANUTS PEAR PEDESTRIAN" +
+	"S PEEI PEEKING PEEM PEEP PEESHI PEEZI PEITH PENETRATION PENGKAL " +
+	"PENGUIN PENIHI PENNY PENSIVE PENSU PENTATHLON PEORTH PERFORMING " +
+	"PERMANENT PERSEVERING PERSPECTIVE PERTHO PES PESETA PET PETASMA " +
+	"PETASTI PETASTOKOUFISMA PETRI PEUT PEUTAE PF PG PH PHAARKAA PHAB" +
+	" PHAM PHAN PHEE PHIEUPH-HIEUH PHIEUPH-SIOS PHIEUPH-THIEUTH PHILO" +
+	"SOPHERS PHINTHU PHNAEK PHOA PHOLUS PHONES PHU PHUNG PHUTHAO PHWA" +
+	" PIANO PIASTRE PIASUTORU PICKET PICKUP PIEEQ PIEET PIEP PIET PIE" +
+	"UP-KHIEUKH PIEUP-MIEUM PIEUP-NIEUN PIEUP-RIEUL PIEUP-RIEUL-PHIEU" +
+	"PH PIEUP-SIOS-CIEUC PIEUP-SIOS-PIEUP PIEUP-SIOS-THIEUTH PIEUP-SS" +
+	"ANGSIOS PIEX PIKO PIKURU PILE PILL PINARBORAS PINATA PINCHED PIN" +
+	"CHING PINE PINEAPPLE PIPING PIR2 PIRACY PIRIEEN PISCES PISTOL PI" +
+	"X PIZZA PIZZICATO PLACARD PLAK PLANET PLANT PLATE PLAYGROUND PLE" +
+	"ADING PLETHRON PLHAU PLOW PLUG PLUK PLUM PLUMED PLUNGER PLURAL P" +
+	"LUS-MINUS PM POA POCKET PODATUS PODCHASHIEM PODVERTKA POETIC POI" +
+	"NTO POINTS POKRYTIE POLKULIZMY POLNAYA POLO POLUPOVODNAYA PONDO " +
+	"POODLE POON POPCORN POPPER POPPING PORTABLE POSEIDON POSITIONS P" +
+	"OSTBOX POSTPOSITION POTABLE POTTED POUCH POULTRY POURING POVODNY" +
+	" POWDER POWDERED POX POY PPA PPM PPV PR PRAYER PRECEDED PRECIPIT" +
+	"ATE PREFACE PRENKHA PRESCRIPTION PRESENT PRESSED PRETZEL PREVIOU" +
+	"S PRINCE PRINCESS PRINTS PRIVATE PROBING PROFOUND PROGRESS PROHI" +
+	"BITED PROJECTION PROJECTIVE PROJECTOR PROOF PROPERTY PROPORTIONA" +
+	"L PROSERPINA PROTOVARYS PROVE PS PSIFISTOLYGISMA PSIFISTOPARAKAL" +
+	"ESMA PSIFISTOSYNAGMA PSILON PTE PU2 PUAQ PUB PUBLIC PUCK PUFFED " +
+	"PUM PUMP PUNCTUS PUNG PUNGAAM PUO PUOP PUOX PUQ PUR PURIFY PURIT" +
+	"Y PURNAMA PURSE PURX PUSHING PUSHPIKA PUTNAYA PUTREFACTION PUUT " +
+	"PUX PUZZLE PV PW PWOY PY PYP PYR PYRX PYX PZ Q001 Q002 Q003 Q004" +
+	" Q005 Q006 Q007 QAAFU QAAI QADMA QAIRTHRA QALA QAPH QAQ QARNEY Q" +
+	"AU QAY QEE QETANA QGA QHA QHAA QHAU QHE QHEE QHI QHO QHOPH QHU Q" +
+	"HWA QHWAA QHWE QHWEE QHWI QIE QIEP QIET QIEX QIF QIP QIT QIX QN " +
+	"QOA QOP QOPA QOX QP QUADCOLON QUANTITY QUATERNION QUBUTS QUDDISA" +
+	" QUESTIONED QUF QUICK QUINARIUS QUINCUNX QUINTESSENCE QUINTILE Q" +
+	"UK QUOP QUOT QUOX QUP QUR QURX QUSHSHAYA QUT QUUV QUX QWA QWAA Q" +
+	"Y QYA QYAA QYE QYEE QYI QYO QYP QYR QYRX QYT QYU QYX R001 R002 R" +
+	"002A R003 R003A R003B R004 R005 R006 R007 R008 R009 R010 R010A R" +
+	"011 R012 R013 R014 R015 R016 R016A R017 R018 R019 R020 R021 R022" +
+	" R023 R024 R025 R026 R027 R028 R029 RA-1 RA-3 RA-4 RA-KARA RA2 R" +
+	"A3 RAAI RAB RACCOON RADIOACTIVE RAEM RAH RAHIMAHU RAHIMAHUM RAHM" +
+	"ATULLAH RAI RAIDA RAIDO RAIL RAINBOW RAKHANG RAMBAT RAN RAPISMA " +
+	"RAQ RASHA RASOUL RASWADI RATA RATIO RAU RAVNO RAX RAYANNA RAZOR " +
+	"RAZSEKA RE-1 RE-2 RE-3 RE-4 REAHMUK REALGAR REALGAR-2 RECEIPT RE" +
+	"CEPTIVE RECITATIVE RECORDER RECORDING RECREATIONAL RECYCLED REFE" +
+	"RENCE REGIA REGIA-2 REGISTERED REGULUS-2 REGULUS-3 REGULUS-4 REI" +
+	" REID REIWA RELAA RELATIONAL RELEASE RELIGION REMEDY REMINDER RE" +
+	"MU RENTOGEN REP REPH REPHA REPRESENT REREKAN RESH-AYIN RESH-AYIN" +
+	"-DALETH RESIDENCE RESISTANCE RESOLUTION RESTROOM RESUPINUS RETOR" +
+	"T RETREAT REU REVERSED-SCHWA REVIA REVMA REVOLUTION REX RH RHINO" +
+	"CEROS RHOTIC RI-1 RI-2 RI-3 RI-4 RI-5 RI-6 RI-7 RIAL RICEM RICKS" +
+	"HAW RIEL RIEUL-CIEUC RIEUL-KIYEOK-HIEUH RIEUL-MIEUM-HIEUH RIEUL-" +
+	"MIEUM-KIYEOK RIEUL-MIEUM-SIOS RIEUL-PIEUP-HIEUH RIEUL-PIEUP-PHIE" +
+	"UPH RIEUL-PIEUP-TIKEUT RIEUL-SSANGPIEUP RIEUL-SSANGSIOS RIEUL-SS" +
+	"ANGTIKEUT RIEUL-TIKEUT-HIEUH RIEUL-YEORINHIEUH-HIEUH RIEUL-YESIE" +
+	"UNG RIFLE RIGHT-HANDED RIGHT-LIGHTED RIGHT-SIDE RIGHTHAND RIGVED" +
+	"IC RIM RIMGBA RIN RINFORZANDO RINGED RINGING RIP RIRA RITTORU RI" +
+	"TUAL RIVER RJES RMT RO-1 RO-2 RO-3 RO-4 RO-5 RO-6 RO2 ROA ROAR R" +
+	"OASTED ROBAT ROBOT ROGOM ROLL ROLLED-UP ROM ROOF ROOM ROOSTER RO" +
+	"P ROSE ROSH ROT ROUND-TIPPED ROWBOAT ROX RRAX RREP RRET RREX RRO" +
+	"P RROT RROX RRRA RRU RRUO RRUOX RRUP RRUR RRURX RRUT RRUX RRY RR" +
+	"YP RRYR RRYRX RRYT RRYX RTE RTHANG RU-1 RU-2 RU-3 RU-4 RU-5 RU-6" +
+	" RUA RUBLE RUE RUGBY RUIS RUKKAKHA RULAI RULE RULE-DELAYED RUN R" +
+	"UNNER RUNOUT RUO RUOP RUOX RUP RUPII RUR RURX RUSI RUT RUUBURU R" +
+	"UX RWAHA RWE RWEE RWI RWII RWO RWOO RYA RYP RYR RYRX RYT RYX RYY" +
+	" S-W S001 S002 S002A S003 S004 S005 S006 S006A S007 S008 S009 S0" +
+	"10 S011 S012 S013 S014 S014A S014B S015 S016 S017 S017A S018 S01" +
+	"9 S020 S021 S022 S023 S024 S025 S026 S026A S026B S027 S028 S029 " +
+	"S030 S031 S032 S033 S034 S035 S035A S036 S037 S038 S039 S040 S04" +
+	"1 S042 S043 S044 S045 S046 SA-1 SA-3 SA-4 SA-5 SA-6 SA-7 SA-8 SA" +
+	"-I SAADHU SAAI SACRIFICIAL SAGA SAGITTARIUS SAIKURU SAIL SAILBOA" +
+	"T SAJDAH SAKE SAKEUAE SAKHA SAKIN SAKOT SAKTA SAL-AMMONIAC SALA " +
+	"SALAAMUHU SALAD SALAM SALLALLAAHU SALLALLAHU SALT-2 SALUTING SAM" +
+	"KA SAMPHAO SAMVAT SAMYOK SANAH SAND SANDWICH SANGA2 SANNYA SANTI" +
+	"IMU SANYOOGA SAPA SAQ SASH SATCHEL SATKAAN SATKAANKUU SATURN SAU" +
+	"CER SAUIL SAUROPOD SAVOURING SAWAN SAX SAXIMATA SAXOPHONE SBRUL " +
+	"SBUB SCALES SCARF SCEPTER SCHOLAR SCHROEDER SCORE SCORPION SCORP" +
+	"IUS SCREAMING SCREWDRIVER SCROLL SCRUPLE SCWA SD SDONG SE-1 SE-2" +
+	" SE-3 SE-4 SE-5 SEAGULL SEAT SECRET SECTOR SEDNA SEE-NO-EVIL SEE" +
+	"DLING SEENU SEEV SELECTOR-1 SELECTOR-10 SELECTOR-100 SELECTOR-10" +
+	"1 SELECTOR-102 SELECTOR-103 SELECTOR-104 SELECTOR-105 SELECTOR-1" +
+	"06 SELECTOR-107 SELECTOR-108 SELECTOR-109 SELECTOR-11 SELECTOR-1" +
+	"10 SELECTOR-111 SELECTOR-112 SELECTOR-113 SELECTOR-114 SELECTOR-" +
+	"115 SELECTOR-116 SELECTOR-117 SELECTOR-118 SELECTOR-119 SELECTOR" +
+	"-12 SELECTOR-120 SELECTOR-121 SELECTOR-122 SELECTOR-123 SELECTOR" +
+	"-124 SELECTOR-125 SELECTOR-126 SELECTOR-127 SELECTOR-128 SELECTO" +
+	"R-129 SELECTOR-13 SELECTOR-130 SELECTOR-131 SELECTOR-132 SELECTO" +
+	"R-133 SELECTOR-134 SELECTOR-135 SELECTOR-136 SELECTOR-137 SELECT" +
+	"OR-138 SELECTOR-139 SELECTOR-14 SELECTOR-140 SELECTOR-141 SELECT" +
+	"OR-142 SELECTOR-143 SELECTOR-144 SELECTOR-145 SELECTOR-146 SELEC" +
+	"TOR-147 SELECTOR-148 SELECTOR-149 SELECTOR-15 SELECTOR-150 SELEC" +
+	"TOR-151 SELECTOR-152 SELECTOR-153 SELECTOR-154 SELECTOR-155 SELE" +
+	"CTOR-156 SELECTOR-157 SELECTOR-158 SELECTOR-159 SELECTOR-16 SELE" +
+	"CTOR-160 SELECTOR-161 SELECTOR-162 SELECTOR-163 SELECTOR-164 SEL" +
+	"ECTOR-165 SELECTOR-166 SELECTOR-167 SELECTOR-168 SELECTOR-169 SE" +
+	"LECTOR-17 SELECTOR-170 SELECTOR-171 SELECTOR-172 SELECTOR-173 SE" +
+	"LECTOR-174 SELECTOR-175 SELECTOR-176 SELECTOR-177 SELECTOR-178 S" +
+	"ELECTOR-179 SELECTOR-18 SELECTOR-180 SELECTOR-181 SELECTOR-182 S" +
+	"ELECTOR-183 SELECTOR-184 SELECTOR-185 SELECTOR-186 SELECTOR-187 " +
+	"SELECTOR-188 SELECTOR-189 SELECTOR-19 SELECTOR-190 SELECTOR-191 " +
+	"SELECTOR-192 SELECTOR-193 SELECTOR-194 SELECTOR-195 SELECTOR-196" +
+	" SELECTOR-197 SELECTOR-198 SELECTOR-199 SELECTOR-2 SELECTOR-20 S" +
+	"ELECTOR-200 SELECTOR-201 SELECTOR-202 SELECTOR-203 SELECTOR-204 " +
+	"SELECTOR-205 SELECTOR-206 SELECTOR-207 SELECTOR-208 SELECTOR-209" +
+	" SELECTOR-21 SELECTOR-210 SELECTOR-211 SELECTOR-212 SELECTOR-213" +
+	" SELECTOR-214 SELECTOR-215 SELECTOR-216 SELECTOR-217 SELECTOR-21" +
+	"8 SELECTOR-219 SELECTOR-22 SELECTOR-220 SELECTOR-221 SELECTOR-22" +
+	"2 SELECTOR-223 SELECTOR-224 SELECTOR-225 SELECTOR-226 SELECTOR-2" +
+	"27 SELECTOR-228 SELECTOR-229 SELECTOR-23 SELECTOR-230 SELECTOR-2" +
+	"31 SELECTOR-232 SELECTOR-233 SELECTOR-234 SELECTOR-235 SELECTOR-" +
+	"236 SELECTOR-237 SELECTOR-238 SELECTOR-239 SELECTOR-24 SELECTOR-" +
+	"240 SELECTOR-241 SELECTOR-242 SELECTOR-243 SELECTOR-244 SELECTOR" +
+	"-245 SELECTOR-246 SELECTOR-247 SELECTOR-248 SELECTOR-249 SELECTO" +
+	"R-25 SELECTOR-250 SELECTOR-251 SELECTOR-252 SELECTOR-253 SELECTO" +
+	"R-254 SELECTOR-255 SELECTOR-256 SELECTOR-26 SELECTOR-27 SELECTOR" +
+	"-28 SELECTOR-29 SELECTOR-3 SELECTOR-30 SELECTOR-31 SELECTOR-32 S" +
+	"ELECTOR-33 SELECTOR-34 SELECTOR-35 SELECTOR-36 SELECTOR-37 SELEC" +
+	"TOR-38 SELECTOR-39 SELECTOR-4 SELECTOR-40 SELECTOR-41 SELECTOR-4" +
+	"2 SELECTOR-43 SELECTOR-44 SELECTOR-45 SELECTOR-46 SELECTOR-47 SE" +
+	"LECTOR-48 SELECTOR-49 SELECTOR-5 SELECTOR-50 SELECTOR-51 SELECTO" +
+	"R-52 SELECTOR-53 SELECTOR-54 SELECTOR-55 SELECTOR-56 SELECTOR-57" +
+	" SELECTOR-58 SELECTOR-59 SELECTOR-6 SELECTOR-60 SELECTOR-61 SELE" +
+	"CTOR-62 SELECTOR-63 SELECTOR-64 SELECTOR-65 SELECTOR-66 SELECTOR" +
+	"-67 SELECTOR-68 SELECTOR-69 SELECTOR-7 SELECTOR-70 SELECTOR-71 S" +
+	"ELECTOR-72 SELECTOR-73 SELECTOR-74 SELECTOR-75 SELECTOR-76 SELEC" +
+	"TOR-77 SELECTOR-78 SELECTOR-79 SELECTOR-8 SELECTOR-80 SELECTOR-8" +
+	"1 SELECTOR-82 SELECTOR-83 SELECTOR-84 SELECTOR-85 SELECTOR-86 SE" +
+	"LECTOR-87 SELECTOR-88 SELECTOR-89 SELECTOR-9 SELECTOR-90 SELECTO" +
+	"R-91 SELECTOR-92 SELECTOR-93 SELECTOR-94 SELECTOR-95 SELECTOR-96" +
+	" SELECTOR-97 SELECTOR-98 SELECTOR-99 SELENA SELFIE SEMISEXTILE S" +
+	"EMK SEMUNCIA SENTAGON SENTI SENTO SEP SEPARATED SEPTEMBER SEPTUP" +
+	"LE SERIOUS SERVICE SESQUIQUADRATE SESTERTIUS SETFON SEUAEQ SEUNY" +
+	"AM SEVEN-THIRTY SEVENTH SEVERANCE SEWING SEX SEXTANS SEXTANT-1 S" +
+	"EXTANT-12 SEXTANT-123 SEXTANT-1234 SEXTANT-12345 SEXTANT-12346 S" +
+	"EXTANT-1235 SEXTANT-12356 SEXTANT-1236 SEXTANT-124 SEXTANT-1245 " +
+	"SEXTANT-12456 SEXTANT-1246 SEXTANT-125 SEXTANT-1256 SEXTANT-126 " +
+	"SEXTANT-13 SEXTANT-134 SEXTANT-1345 SEXTANT-13456 SEXTANT-1346 S" +
+	"EXTANT-1356 SEXTANT-136 SEXTANT-14 SEXTANT-145 SEXTANT-1456 SEXT" +
+	"ANT-146 SEXTANT-15 SEXTANT-156 SEXTANT-16 SEXTANT-2 SEXTANT-23 S" +
+	"EXTANT-234 SEXTANT-2345 SEXTANT-23456 SEXTANT-2346 SEXTANT-235 S" +
+	"EXTANT-2356 SEXTANT-236 SEXTANT-24 SEXTANT-245 SEXTANT-2456 SEXT" +
+	"ANT-25 SEXTANT-256 SEXTANT-26 SEXTANT-3 SEXTANT-34 SEXTANT-345 S" +
+	"EXTANT-3456 SEXTANT-346 SEXTANT-35 SEXTANT-356 SEXTANT-36 SEXTAN" +
+	"T-4 SEXTANT-45 SEXTANT-456 SEXTANT-46 SEXTANT-5 SEXTANT-56 SEXTA" +
+	"NT-6 SEXTILE SEYK SG SGOR SGRA SH2 SHAB6 SHAK SHAKER SHAKTI SHAL" +
+	"LOW SHALSHELET SHAMROCK SHAP SHAPE SHARA SHARK SHAVED SHAVIYANI " +
+	"SHAX SHAY SHCHOOI SHE-GOAT SHEENU SHEG9 SHEN SHEP SHEQEL SHESH2 " +
+	"SHESHLAM SHEUAE SHEUAEQ SHEUAEQTU SHEUOQ SHEVA SHEX SHIIN SHINDA" +
+	" SHINIG SHINTO SHIRAE SHIRT SHIYYAALAA SHOA SHOCKED SHOES SHOOI " +
+	"SHOOT SHOOTING SHORT-TWIG-AR SHORT-TWIG-BJARKAN SHORT-TWIG-HAGAL" +
+	"L SHORT-TWIG-MADR SHORT-TWIG-NAUD SHORT-TWIG-OSS SHORT-TWIG-SOL " +
+	"SHORT-TWIG-TYR SHORT-TWIG-YR SHORTCAKE SHORTENER SHOT SHOU SHOUL" +
+	"DERED SHOWER SHOX SHRA SHRAA SHRII SHRINE SHRO SHROO SHRUG SHUAN" +
+	"GXI SHUBUR SHUENSHUET SHUEQ SHUFFLE SHUL SHUM SHUO SHUOP SHUOX S" +
+	"HUP SHURX SHUT SHUTTLECOCK SHUX SHV SHWOY SHYA SHYE SHYP SHYR SH" +
+	"YRX SHYT SHYX SI-1 SI-2 SI-3 SI-4 SI-5 SI-6 SIA SICKLE SICKNESS " +
+	"SIDDHI SIE SIEE SIEP SIEX SIGEL SIKI SILIQUA SILVER SINE SINGAAT" +
+	" SINKING SINOLOGICAL SINUSOID SIOS-IEUNG SIOS-KAPYEOUNPIEUP SIOS" +
+	"-KHIEUKH SIOS-PANSIOS SIOS-PHIEUPH SIOS-PIEUP-KIYEOK SIOS-SSANGS" +
+	"IOS SIP SIRINGU SIRRAH SISA SIT SITE SIX-LINE SIX-PER-EM SIX-STR" +
+	"ING SIX-THIRTY SIXTEENTH-1 SIXTEENTH-2 SIXTY-FOURTHS SK SKATEBOA" +
+	"RD SKIER SKIN SKOBA SKUNK SKW SKWA SLAVE SLAVONIC SLED SLEEP SLE" +
+	"EPY SLEUTH SLIDE SLIDER SLIDING SLING SLOPE SLOT SLOTH SLOWLY SM" +
+	"EAR SMIRKING SNA SNAIL SNAP SNEEZING SNOWBOARDER SO-1 SO-2 SO-3 " +
+	"SO-4 SO-5 SO-6 SO-7 SOA SOCCER SOCKS SOFTBALL SOFTNESS SOFTWARE-" +
+	"FUNCTION SON SONG SONJAM SOON SOP SOQ SORI SOS SOUNAP SOW SOWILO" +
+	" SOX SOY SP SPA SPAGHETTI SPARKLER SPARKLES SPARKLING SPATHI SPE" +
+	" SPEAK-NO-EVIL SPEAKING SPEEDBOAT SPENT SPESMILO SPI SPICE SPINE" +
+	" SPIRANT SPLASHING SPLITTING SPO SPONGE SPOOL SPORTS SPOT SPOUTI" +
+	"NG SPRECHGESANG SPRING SPRINGS SPROUT SPUNGS SPWA SPY SQUEEZED S" +
+	"QUID SQUISH SR SSAA SSANGARAEA SSANGCIEUC-HIEUH SSANGMIEUM SSANG" +
+	"RIEUL-KHIEUKH SSANGSIOS-KIYEOK SSANGSIOS-PIEUP SSANGSIOS-TIKEUT " +
+	"SSANGTHIEUTH SSANGTIKEUT-PIEUP SSANGYEORINHIEUH SSAP SSAT SSAX S" +
+	"SEE SSEP SSEX SSHE SSHIN SSIE SSIEP SSIEX SSIP SSIT SSIX SSOP SS" +
+	"OT SSOX SSUP SSUT SSUX SSY SSYP SSYR SSYRX SSYT SSYX ST2 STACCAT" +
+	"ISSIMO STACCATO STACKED STADIUM STALLION STAN STAND STANDING STA" +
+	"NDSTILL STARK STARRED STARS STARTING STATION STATUE STAUROS STAV" +
+	"ROU STEAMING STEAMY STENOGRAPHIC STEREO STETHOSCOPE STILL STIMME" +
+	" STIRRUP STOPPAGE STOPPING STOPWATCH STOVE STRAGGISMATA STRAIF S" +
+	"TRAIGHTNESS STRAINER STRATIAN STRATUM-2 STRAW STRAWBERRY STREAME" +
+	"R STRENGTH STRETCH STRICTLY STRIDE STRIKETHROUGH STRIPE STROKE-1" +
+	" STROKE-10 STROKE-11 STROKE-2 STROKE-3 STROKE-4 STROKE-5 STROKE-" +
+	"6 STROKE-7 STROKE-8 STROKE-9 STUDIO STUFFED STUPA STWA SU-1 SU-2" +
+	" SU-3 SU-4 SU-5 SU-6 SU-7 SU-8 SUAB SUAE SUAEN SUAET SUAM SUBHAA" +
+	"NAHU SUBITO SUBJECT SUBLIMATE-2 SUBLIMATE-3 SUBLIMATION SUBPUNCT" +
+	"IS SUCK SUCKING SUD SUD2 SUITABLE SUMASH SUMMER SUNFLOWER SUNSET" +
+	" SUO SUOP SUOX SUP SUPERFIXED SUPERHERO SUPERIMPOSED SUPERVILLAI" +
+	"N SUR9 SURE SURFER SURX SURYA SUSHI SUT SUTRA SUX SVETLO SVETLY " +
+	"SWAN SWG SWIMMER SWIMMING SWIMSUIT SWORD SWORDS SWUNG SWZ SY SYA" +
+	" SYI SYMBOL-10 SYMBOL-15 SYMBOL-16 SYMBOL-20 SYMBOL-21 SYMBOL-22" +
+	" SYMBOL-25 SYMBOL-26 SYMBOL-27 SYMBOL-29 SYMBOL-3 SYMBOL-30 SYMB" +
+	"OL-32 SYMBOL-36 SYMBOL-37 SYMBOL-38 SYMBOL-39 SYMBOL-40 SYMBOL-4" +
+	"2 SYMBOL-43 SYMBOL-45 SYMBOL-47 SYMBOL-48 SYMBOL-49 SYMBOL-6 SYM" +
+	"BOL-9 SYMMETRY SYNAFI SYNAGOGUE SYNCHRONOUS SYNDESMOS SYNEVMA SY" +
+	"OUWA SYP SYR SYRINGE SYRMA SYRMATIKI SYRX SYT SYX SZ SZA SZAA SZ" +
+	"E SZEE SZI SZO SZU SZWA SZWG SZZ T-REX T-SHIRT T001 T002 T003 T0" +
+	"03A T004 T005 T006 T007 T007A T008 T008A T009 T009A T010 T011 T0" +
+	"11A T012 T013 T014 T015 T016 T016A T017 T018 T019 T020 T021 T022" +
+	" T023 T024 T025 T026 T027 T028 T029 T030 T031 T032 T032A T033 T0" +
+	"33A T034 T035 T036 TA-1 TA-3 TA-4 TA2 TAAALAA TAAF TAAI TAAM TAA" +
+	"Q TAASHAE TABAARAKA TABS TACO TAEN TAHALA TAILLESS TAISYOU TAK T" +
+	"AKE TAKEOUT TAKHALLUS TALENT TAMALE TAMAN TANA TANABATA TANG TAN" +
+	"GERINE TAO TAP TAPER TAQ TARTAR TARTAR-2 TARUNG TAS TASHEEL TASS" +
+	"I TATTOOED TAUM TAURUS TAVIYANI TAWA TAWELLEMET TAX TAY TE-1 TE-" +
+	"3 TE-4 TE-5 TE-6 TE-7 TE-8 TE-9 TE-U TEA TEACUP TEAPOT TEAR TEAR" +
+	"-OFF TEARDROP-BARBED TEARDROP-SHANKED TEDDY TEEEE TEENS TEGEH TE" +
+	"IWS TEK TELESCOPE TELEVISION TELLER TELU TEMPLE TEN-THIRTY TENGE" +
+	" TENUTO TEP TERMINATOR TESSARON TEST TETRAFONIAS TETRAPLI TEU TE" +
+	"UN TEUT TEUTEUWEN TEUTEUX TEVIR TEX THAALU THAHAN THAJ THAMEDH T" +
+	"HANNA THANTHAKHAT THAW THEA THEREFORE THERMODYNAMIC THES THETH T" +
+	"HEY THIAB THICK THIGH THING THINKING THIRD-STAGE THIRTY-ONE THIT" +
+	"A THIUTH THOA THOJ THOM THOU THREE-CIRCLE THREE-DOT THREE-EM THR" +
+	"EE-HUNDRED-AND-TWENTIETH THREE-LEGGED THREE-LINE THREE-PER-EM TH" +
+	"REE-THIRTY THROWING THUNDERSTORM THUNG THURISAZ THURS THWE THWEE" +
+	" THWI THWII THWO THWOO THYOOM THZ TI-1 TI-2 TI-3 TI-4 TI-5 TI-6 " +
+	"TI-7 TI2 TIARA TICKET TICKETS TIEP TIEX TII TIKEUT-CHIEUCH TIKEU" +
+	"T-MIEUM TIKEUT-SIOS-KIYEOK TIKEUT-THIEUTH TILES TILT TIMER TIN T" +
+	"INAGMA TINCTURE TING TINNE TIPEHA TIPPI TIRED TIRTA TIRYAK TITUA" +
+	"EP TIWAZ TIWR TIX TLEE TLHEE TLHOO TLHU TLHWE TLHYA TN TO-1 TO-2" +
+	" TO-3 TO-4 TO-5 TO-6 TO-RA TOA TOANDAKHIAT TOCHKA TOILET TOKYO T" +
+	"OLONG TOMATO TOMPI TON TONE-B TONE-D TONE-G TONE-J TONE-M TONE-S" +
+	" TONE-V TONG TOOLBOX TOON TOOTHBRUSH TORCH TORNADO TORSO TORSO-F" +
+	"LOORPLANE TOS TOUCHES TOURNOIS TOV TOWER TOX TR TRACKBALL TRACTO" +
+	"R TRADE TRAILING TRAMWAY TRANSPLUTO TRANSVERSAL TRAP TRAPEZIUM T" +
+	"READING TREDECILE TRESVETLO TRESVETLY TRI TRIA TRIFOLIATE TRIFON" +
+	"IAS TRIGORGON TRIISAP TRILL TRILLIONS TRION TRIPLI TRIPOD TRITOS" +
+	" TRIUMPH TROLL TROLLEY TROLLEYBUS TROMIKOLYGISMA TROMIKOPARAKALE" +
+	"SMA TROMIKOPSIFISTON TROMIKOSYNAGMA TROPHY TRUMP-1 TRUMP-10 TRUM" +
+	"P-11 TRUMP-12 TRUMP-13 TRUMP-14 TRUMP-15 TRUMP-16 TRUMP-17 TRUMP" +
+	"-18 TRUMP-19 TRUMP-2 TRUMP-20 TRUMP-21 TRUMP-3 TRUMP-4 TRUMP-5 T" +
+	"RUMP-6 TRUMP-7 TRUMP-8 TRUMP-9 TRUMPET TRUNCATED TRUTH TRYASKA T" +
+	"RYASOGLASNAYA TRYASOPOVODNAYA TRYASOSTRELNAYA TRYBLION TSAA TSAA" +
+	"DIY TSAB TSEEB TSERE TSHAB TSHEEJ TSHOOJ TSHUGS TSIU TSOV TSSA T" +
+	"SWA TSWB TT2 TTEE TTH TTHEE TTHOO TTHU TTHWE TTSA TTSE TTSEE TTS" +
+	"I TTSO TTSU TTTHA TTU TTUDDAG TU-1 TU-2 TU-3 TU-4 TU-TO TUAE TUA" +
+	"EP TUB TUBE TUGRIK TUKWENTIS TULIP TUMAE TUMBLER TUMETES TUNNY T" +
+	"UO TUOP TUOX TUPNI TURKEY TURKISH TURO2 TURU TURX TUT TUTEYASAT " +
+	"TUTTY TUUMU TUX TUXEDO TVIMADUR TWELFTH TWELVE-THIRTY TWENTY-EIG" +
+	"HT TWENTY-NINE TWENTY-SEVEN TWENTY-SIX TWISTED TWISTING TWO-EM T" +
+	"WO-LINE TWO-THIRTY TXA TXHEEJ TYA TYAY TYE TYI TYO TYPE-1 TYPE-1" +
+	"-2 TYPE-2 TYPE-7 TYR TZA TZAA TZE TZEE TZI TZIR TZO TZOA TZU U-1" +
+	" U-2 U-3 U-4 U-5 U-A U-AE U-EO-EU U-I-I U-U U-YE U-YEO U001 U002" +
+	" U003 U004 U005 U006 U006A U006B U007 U008 U009 U010 U011 U012 U" +
+	"013 U014 U015 U016 U017 U018 U019 U020 U021 U022 U023 U023A U024" +
+	" U025 U026 U027 U028 U029 U029A U030 U031 U032 U032A U033 U034 U" +
+	"035 U036 U037 U038 U039 U040 U041 U042 UAN UANG UATH UBHAYATO UB" +
+	"UFILI UDAAT UDARKA UDUG UEA UEC UEE UEI UEN UEQ UEY UHD UIC UILL" +
+	"EANN UIQ UIUC UIUQ UIUX UIUZ UIX UIZ UMBIN UNA UNAMUSED UNAP UNA" +
+	"SPIRATED UNBLENDED UNCERTAINTY UNCIA UNDERDOT UNDO UNEVEN UNG UN" +
+	"ICORN UNIFORM UNITED UNITY UNKNOWN UNMARRIED UOG UON UOP UOX UPR" +
+	"IGHT UPSIDE-DOWN UQ UR4 URA URI URI3 URINE URN URUS URUZ USE USH" +
+	"2 USHENNA USHUMX USHX USSU USSU3 UTUKI UUU UUU2 UUU3 UUUU UUYANN" +
+	"A UWU UX UYANNA UZ UZHAKKU UZU V001 V001A V001B V001C V001D V001" +
+	"E V001F V001G V001H V001I V002 V002A V003 V004 V005 V006 V007 V0" +
+	"07A V007B V008 V009 V010 V011 V011A V011B V011C V012 V012A V012B" +
+	" V013 V014 V015 V016 V017 V018 V019 V020 V020A V020B V020C V020D" +
+	" V020E V020F V020G V020H V020I V020J V020K V020L V021 V022 V023 " +
+	"V023A V024 V025 V026 V027 V028 V028A V029 V029A V030 V030A V031 " +
+	"V031A V032 V033 V033A V034 V035 V036 V037 V037A V038 V039 V040 V" +
+	"040A VAAVU VAJ VAKAIYARAA VALLEY VAMPIRE VAP VAPOURS VARAAKAN VA" +
+	"REIAI VARIKA VARYS VASIS VASTNESS VAT VATHY VAU VAX VAYANNA VC V" +
+	"ECTOR VEHICLE VEIL VELI VER VERDIGRIS VERGE VERSICLE VERTICAL-00" +
+	"-00 VERTICAL-00-01 VERTICAL-00-02 VERTICAL-00-03 VERTICAL-00-04 " +
+	"VERTICAL-00-05 VERTICAL-00-06 VERTICAL-01-00 VERTICAL-01-01 VERT" +
+	"ICAL-01-02 VERTICAL-01-03 VERTICAL-01-04 VERTICAL-01-05 VERTICAL" +
+	"-01-06 VERTICAL-02-00 VERTICAL-02-01 VERTICAL-02-02 VERTICAL-02-" +
+	"03 VERTICAL-02-04 VERTICAL-02-05 VERTICAL-02-06 VERTICAL-03-00 V" +
+	"ERTICAL-03-01 VERTICAL-03-02 VERTICAL-03-03 VERTICAL-03-04 VERTI" +
+	"CAL-03-05 VERTICAL-03-06 VERTICAL-04-00 VERTICAL-04-01 VERTICAL-" +
+	"04-02 VERTICAL-04-03 VERTICAL-04-04 VERTICAL-04-05 VERTICAL-04-0" +
+	"6 VERTICAL-05-00 VERTICAL-05-01 VERTICAL-05-02 VERTICAL-05-03 VE" +
+	"RTICAL-05-04 VERTICAL-05-05 VERTICAL-05-06 VERTICAL-06-00 VERTIC" +
+	"AL-06-01 VERTICAL-06-02 VERTICAL-06-03 VERTICAL-06-04 VERTICAL-0" +
+	"6-05 VERTICAL-06-06 VEST VESTA VEUAE VEUAEPEN VEUM VEUX VEX VEYZ" +
+	" VFA VHA VIBRATION VIDEOCASSETTE VIDJ VIDJ-2 VIE VIEP VIEWDATA V" +
+	"IEWER VIEWING VIEX VIGINTILE VILLAGE VINEGAR-2 VINEGAR-3 VIOLIN " +
+	"VIP VIRGA VIRGO VIRIAM VISARGAYA VIT VITAE VITAE-2 VITRIOL VITRI" +
+	"OL-2 VIX VOCALIZATION VOD VOICELESS VOID VOIDED VOLCANO VOLLEYBA" +
+	"LL VOLTAGE VOLUME VOM VOMITING VOOI VOP VOT VOW VOWEL-CARRIER VO" +
+	"X VQ VS VUEQ VULCANUS VUP VURX VUT VUX VW VWA VWJ VX VYP VYR VYR" +
+	"X VYT VYX VZ VZMET W001 W002 W003 W003A W004 W005 W006 W007 W008" +
+	" W009 W009A W010 W010A W011 W012 W013 W014 W014A W015 W016 W017 " +
+	"W017A W018 W018A W019 W020 W021 W022 W023 W024 W024A W025 WA-1 W" +
+	"A-2 WA-3 WA-4 WA-5 WA-AALIH WA-SALLAM WA-TAAALAA WAAALIHEE WAAJI" +
+	"B WAAVU WADDA WAEN WAFFLE WAI WAIST WALLPLANE WAN WAND WANDERER " +
+	"WANGKUOQ WAQFA WARNING WAS-SALAAM WASSALLAM WASTEBASKET WASTING " +
+	"WAT WATERMELON WATTO WB WE-1 WE-2 WE-3 WE-4 WEAPON WEB WEDDING W" +
+	"EDGE WEEN WELL WEN WEP WET WEUX WEX WG WH WHEELED WHITE-FEATHERE" +
+	"D WI-1 WI-2 WI-3 WI-4 WI-5 WIANG WIANGWAAK WIDENING WIGGLES WIGN" +
+	"YAN WILTED WIN WINGS WINJA WINK WINTER WIRED WO-1 WO-2 WO-3 WO-4" +
+	" WO-5 WO-6 WO-7 WOA WOE WOLF WOMEN WOMENS WOON WOP WORDSPACE WOR" +
+	"KER WORLD WORM WORRIED WORSHIP WOW WOX WRAP WRAPPED WREATH WREST" +
+	"LERS WRONG WRY WUAEN WUAET WUI WUN WUNJO WUOP WUOX WUP WVA WVE W" +
+	"VI WZ X-RAY X-X X001 X002 X003 X004 X004A X004B X005 X006 X006A " +
+	"X007 X008 X008A XAA XAPH XAU XAUS XEE XESTES XEYN XG XHEYN XIAB " +
+	"XIE XIEP XIET XIEX XIP XIRON XIT XIX XOA XOP XOPH XOR XOT XOX XS" +
+	"HAAYATHIYA XU XUO XUOX XVE XWA XWAA XWE XWEE XWI XY XYAA XYEE XY" +
+	"I XYO XYOO XYOOJ XYP XYR XYRX XYT XYU XYX Y001 Y001A Y002 Y003 Y" +
+	"004 Y005 Y006 Y007 Y008 YA-1 YA-2 YA-3 YA-4 YA-5 YA-O YA-U YAADO" +
+	" YAAI YAARU YAB YABH YACH YAD YADD YADDH YADH YAEMMAE YAF YAFU Y" +
+	"AG YAGHH YAGN YAHH YAKASH YAKHH YAL YAMAKKAN YAMOK YARN YARR YAS" +
+	" YASH YASS YATH YATT YAU YAV YAW YAWNING YAYANNA YAYD YAZZ YEA Y" +
+	"EEG YEIN YENAP YEO-O YEO-U YEO-YA YERAH YESIEUNG-HIEUH YESIEUNG-" +
+	"MIEUM YETIV YEUAE YEUAET YEUM YEUQ YEURAE YEUX YEW YEY YHA YI-U " +
+	"YIEE YIEP YIET YIEX YIH YII YING YIP YIX YIZET YO-1 YO-2 YO-3 YO" +
+	"-4 YO-5 YO-6 YO-A YO-AE YO-EO YO-O YO-YEO YO-YO YOA YOMO YOP YOR" +
+	"I YOU YOUTHFUL YOUTHFULNESS YOWD YOX YOY YPORROI YPSILI YRY YU-1" +
+	" YU-2 YU-3 YU-4 YU-A YU-AE YU-E YU-EO YU-O YU-U YUAEN YUAN YUE Y" +
+	"UEQ YUI YUN YUO YUOM YUOT YUOX YUP YUR YURX YUUKALEAPINTU YUX YY" +
+	"AA YYE YYP YYR YYRX YYT YYX Z001 Z002 Z002A Z002B Z002C Z002D Z0" +
+	"03 Z003A Z003B Z004 Z004A Z005 Z005A Z006 Z007 Z008 Z009 Z010 Z0" +
+	"11 Z012 Z013 Z014 Z015 Z015A Z015B Z015C Z015D Z015E Z015F Z015G" +
+	" Z015H Z015I Z016 Z016A Z016B Z016C Z016D Z016E Z016F Z016G Z016" +
+	"H ZAEF ZAG ZAI ZAKRYTOE ZAMX ZANOZHEK ZAP ZAPYATYMI ZARL ZARQA Z" +
+	"AT ZAVIYANI ZAX ZAYIN-YODH ZE2 ZEBRA ZELO ZEP ZEUS ZEVOK ZEX ZH " +
+	"ZHAA ZHAIN ZHAP ZHAT ZHAX ZHAYIN ZHEP ZHET ZHEX ZHIL ZHOI ZHOO Z" +
+	"HOP ZHOT ZHOX ZHUO ZHUOP ZHUOX ZHUP ZHUR ZHURX ZHUT ZHUX ZHWA ZH" +
+	"Y ZHYP ZHYR ZHYRX ZHYT ZHYX ZI3 ZIE ZIEP ZIEX ZIG ZILDE ZINOR ZI" +
+	"P ZIPPER-MOUTH ZIQAA ZIT ZIX ZMEYTSA ZOA ZOMBIE ZOP ZOX ZRA ZSA " +
+	"ZSHA ZUBUR ZUM ZUO ZUOP ZUOX ZURX ZUT ZUX ZWA ZWARAKAY ZWJ ZYGOS" +
+	" ZYP ZYR ZYRX ZYT ZYX ZZAA ZZAP ZZAT ZZAX ZZEE ZZEP ZZEX ZZIE ZZ" +
+	"IEP ZZIEX ZZIP ZZIT ZZIX ZZOP ZZOX ZZSA ZZSYA ZZUP ZZUR ZZURX ZZ" +
+	"UX ZZY ZZYA ZZYP ZZYR ZZYRX ZZYT ZZYX"
+
+// 32647 names, base64 encoded
+const charNameData = "" +
+	"IAGxBQEC7AQqAQK8BCoBAh0BAQLgCQEBAsYMAQEB7QgBAaoIAQIntwMBAiW3AwEB" +
+	"tQMBAmABAQHnAgEB+g0BApIDqQIBAZQFAQIO4wEBAg4xAQIONgECDkEBAg5OAQIO" +
+	"VQECDnUBAg6AAQECDnQBAg6IAQEBmwQBAbIHAQLtAgEBAv0DAQEC7AIBAQLLBCoB" +
+	"AtkL2QQBBAcFABQBBAcFAD4BBAcFAPMBAQQHBQDSAQEEBwUAOAEEBwUAswIBBAcF" +
+	"AMEBAQQHBQDUAQEEBwUAOgEEBwUAkwIBBAcFAMcBAQQHBQBvAQQHBQCwAQEEBwUA" +
+	"mQEBBAcFADUBBAcFAPoBAQQHBQDuAgEEBwUAYgEEBwUAYwEEBwUAxQEBBAcFADAB" +
+	"BAcFAMsBAQQHBQCOAgEEBwUA/AEBBAcFAOIBAQQHBQDeAQEDJyuBAQECvwWUBQED" +
+	"JSuBAQECiwLEAgEChwG/AgECvgLEAgEEBwMAFAEEBwMAPgEEBwMA8wEBBAcDANIB" +
+	"AQQHAwA4AQQHAwCzAgEEBwMAwQEBBAcDANQBAQQHAwA6AQQHAwCTAgEEBwMAxwEB" +
+	"BAcDAG8BBAcDALABAQQHAwCZAQEEBwMANQEEBwMA+gEBBAcDAO4CAQQHAwBiAQQH" +
+	"AwBjAQQHAwDFAQEEBwMAMAEEBwMAywEBBAcDAI4CAQQHAwD8AQEEBwMA4gEBBAcD" +
+	"AN4BAQMn/AOBAQECU78CAQMl/AOBAQEBuQEiApQSsQUBA88C7AQqAQLUFgEBAuYO" +
+	"AQEC9wcBAQKFDQEBArgKdwEC8AMBAQGgAgEC7xYBAQP7F90blgQBBcoFM9wCvAQq" +
+	"AQLSAgEBAuUGogcBAulTAQEByQIBArkNAQECzlEBAQLXBDYBAtcEQQEC3wHEAgEC" +
+	"xEkBAQKUHAEBAkw7AQG/BAEC1wQxAQPLSN0blgQBBZIFM9wCvAQqAQTlBcwBMe4D" +
+	"AQTlBcwBMW4BBOUFzAFBwgYBA88CywQqAQYHBQAUAr4CAQYHBQAUAt8BAQYHBQAU" +
+	"AosCAQYHBQAUArkBAQYHBQAUAqACAQcHBQAUAp8BJgEEBwUAlQQBBgcFAPMBAr8E" +
+	"AQYHBQA4Ar4CAQYHBQA4At8BAQYHBQA4AosCAQYHBQA4AqACAQYHBQA6Ar4CAQYH" +
+	"BQA6At8BAQYHBQA6AosCAQYHBQA6AqACAQQHBQCBCQEGBwUAmQECuQEBBgcFADUC" +
+	"vgIBBgcFADUC3wEBBgcFADUCiwIBBgcFADUCuQEBBgcFADUCoAIBAv4GAQEGBwUA" +
+	"NQJIAQYHBQAwAr4CAQYHBQAwAt8BAQYHBQAwAosCAQYHBQAwAqACAQYHBQDiAQLf" +
+	"AQEEBwUAxQkBBQcDAIsKYwEGBwMAFAK+AgEGBwMAFALfAQEGBwMAFAKLAgEGBwMA" +
+	"FAK5AQEGBwMAFAKgAgEHBwMAFAKfASYBBAcDAJUEAQYHAwDzAQK/BAEGBwMAOAK+" +
+	"AgEGBwMAOALfAQEGBwMAOAKLAgEGBwMAOAKgAgEGBwMAOgK+AgEGBwMAOgLfAQEG" +
+	"BwMAOgKLAgEGBwMAOgKgAgEEBwMAgQkBBgcDAJkBArkBAQYHAwA1Ar4CAQYHAwA1" +
+	"At8BAQYHAwA1AosCAQYHAwA1ArkBAQYHAwA1AqACAQL7CAEBBgcDADUCSAEGBwMA" +
+	"MAK+AgEGBwMAMALfAQEGBwMAMAKLAgEGBwMAMAKgAgEGBwMA4gEC3wEBBAcDAMUJ" +
+	"AQYHAwDiAQKgAgEGBwUAFALJAgEGBwMAFALJAgEGBwUAFAL2AgEGBwMAFAL2AgEG" +
+	"BwUAFALBBgEGBwMAFALBBgEGBwUA8wEC3wEBBgcDAPMBAt8BAQYHBQDzAQKLAgEG" +
+	"BwMA8wECiwIBBwcFAPMBAjsmAQcHAwDzAQI7JgEGBwUA8wECvQMBBgcDAPMBAr0D" +
+	"AQYHBQDSAQK9AwEGBwMA0gECvQMBBgcFANIBAkgBBgcDANIBAkgBBgcFADgCyQIB" +
+	"BgcDADgCyQIBBgcFADgC9gIBBgcDADgC9gIBBwcFADgCOyYBBwcDADgCOyYBBgcF" +
+	"ADgCwQYBBgcDADgCwQYBBgcFADgCvQMBBgcDADgCvQMBBgcFAMEBAosCAQYHAwDB" +
+	"AQKLAgEGBwUAwQEC9gIBBgcDAMEBAvYCAQcHBQDBAQI7JgEHBwMAwQECOyYBBgcF" +
+	"AMEBAr8EAQYHAwDBAQK/BAEGBwUA1AECiwIBBgcDANQBAosCAQYHBQDUAQJIAQYH" +
+	"AwDUAQJIAQYHBQA6ArkBAQYHAwA6ArkBAQYHBQA6AskCAQYHAwA6AskCAQYHBQA6" +
+	"AvYCAQYHAwA6AvYCAQYHBQA6AsEGAQYHAwA6AsEGAQcHBQA6AjsmAQUHAwC6BToB" +
+	"BAcFIP4YAQQHAyD+GAEGBwUAkwICiwIBBgcDAJMCAosCAQYHBQDHAQK/BAEGBwMA" +
+	"xwECvwQBBAcDAKBEAQYHBQBvAt8BAQYHAwBvAt8BAQYHBQBvAr8EAQYHAwBvAr8E" +
+	"AQYHBQBvAr0DAQYHAwBvAr0DAQcHBQBvAkw7AQcHAwBvAkw7AQYHBQBvAkgBBgcD" +
+	"AG8CSAEGBwUAmQEC3wEBBgcDAJkBAt8BAQYHBQCZAQK/BAEGBwMAmQECvwQBBgcF" +
+	"AJkBAr0DAQYHAwCZAQK9AwEHBwMAmQH3UZcHqggBBAcFAJ0HAQQHAwCdBwEGBwUA" +
+	"NQLJAgEGBwMANQLJAgEGBwUANQL2AgEGBwMANQL2AgEHBwUANQIz3wEBBwcDADUC" +
+	"M98BAQQHBSDEAwEEBwMgxAMBBgcFAGIC3wEBBgcDAGIC3wEBBgcFAGICvwQBBgcD" +
+	"AGICvwQBBgcFAGICvQMBBgcDAGICvQMBBgcFAGMC3wEBBgcDAGMC3wEBBgcFAGMC" +
+	"iwIBBgcDAGMCiwIBBgcFAGMCvwQBBgcDAGMCvwQBBgcFAGMCvQMBBgcDAGMCvQMB" +
+	"BgcFAMUBAr8EAQYHAwDFAQK/BAEGBwUAxQECvQMBBgcDAMUBAr0DAQYHBQDFAQJI" +
+	"AQYHAwDFAQJIAQYHBQAwArkBAQYHAwAwArkBAQYHBQAwAskCAQYHAwAwAskCAQYH" +
+	"BQAwAvYCAQYHAwAwAvYCAQcHBQAwAp8BJgEHBwMAMAKfASYBBwcFADACM98BAQcH" +
+	"AwAwAjPfAQEGBwUAMALBBgEGBwMAMALBBgEGBwUAjgICiwIBBgcDAI4CAosCAQYH" +
+	"BQDiAQKLAgEGBwMA4gECiwIBBgcFAOIBAqACAQYHBQDeAQLfAQEGBwMA3gEC3wEB" +
+	"BwcFAN4BAjsmAQcHAwDeAQI7JgEGBwUA3gECvQMBBgcDAN4BAr0DAQUHAwCqAWMB" +
+	"BgcDAD4CSAEGBwUAPgJSAQYHBQA+ArcPAQYHAwA+ArcPAQUHBQCJAXUBBQcDAIkB" +
+	"dQEFBwUAsQE1AQYHBQDzAQJSAQYHAwDzAQJSAQUHBQDBC9IBAQYHBQDSAQJSAQYH" +
+	"BQDSAQK3DwEGBwMA0gECtw8BBQcDAKABxgUBBQcFAPgBOAEEBwUA3QcBBQcFALEB" +
+	"OAEGBwUAswICUgEGBwMAswICUgEGBwUAwQECUgEEBwUApwUBBAcDAPkNAQQHBQCI" +
+	"AwEGBwUAOgJIAQYHBQDHAQJSAQYHAwDHAQJSAQYHAwBvAncBBgcDALJFAkgBBQcF" +
+	"AKABsAEBBwcFAJkBAidSAQgHAwCZAQKqASXLBQEHBwUANQJMuQEBBgcFADUCjwQB" +
+	"BgcDADUCjwQBBAcFALkMAQQHAwC5DAEGBwUA+gECUgEGBwMA+gECUgEDBwDNHwEF" +
+	"BwUAiQE2AQUHAwCJATYBBAcFANwFAQUHAPgB3AXhBAEHBwMAxQECyARSAQYHBQDF" +
+	"AQJSAQYHAwDFAQJSAQcHBQDFAQLPA1IBBgcFADACjwQBBgcDADACjwQBBAcFAKwD" +
+	"AQYHBQDLAQJSAQYHBQDiAQJSAQYHAwDiAQJSAQYHBQDeAQJIAQYHAwDeAQJIAQQH" +
+	"BQCeBwEFBwUAngf4AQEFBwMAngf4AQEGBwMAngcC6QIBBQcANgJIAQUHBQCJAVUB" +
+	"BQcDAIkBVQEHBwDPAoYEqQICSAEDBwDODwEEBwC/EMQHAQQHAOIZxAcBBAcA1xXE" +
+	"BwEEBwDPA8QHAQYHBQD7BwK9AwEKBwUA0gECAwDeAQK9AwEGBwMA+wcCvQMBBAcF" +
+	"APwZAQgHBQBvAgMAkwIBBAcDAPwZAQQHBQCkGwEIBwUAmQECAwCTAgEEBwMApBsB" +
+	"BgcFABQCvQMBBgcDABQCvQMBBgcFADoCvQMBBgcDADoCvQMBBgcFADUCvQMBBgcD" +
+	"ADUCvQMBBgcFADACvQMBBgcDADACvQMBCAcFADACoAIYyQIBCAcDADACoAIYyQIB" +
+	"CAcFADACoAIY3wEBCAcDADACoAIY3wEBCAcFADACoAIYvQMBCAcDADACoAIYvQMB" +
+	"CAcFADACoAIYvgIBCAcDADACoAIYvgIBBQcDAKABOAEIBwUAFAKgAhjJAgEIBwMA" +
+	"FAKgAhjJAgEJBwUAFAI7JhjJAgEJBwMAFAI7JhjJAgEGBwUAlQQCyQIBBgcDAJUE" +
+	"AskCAQYHBQDBAQJIAQYHAwDBAQJIAQYHBQDBAQK9AwEGBwMAwQECvQMBBgcFAMcB" +
+	"Ar0DAQYHAwDHAQK9AwEGBwUANQLBBgEGBwMANQLBBgEIBwUANQLBBhjJAgEIBwMA" +
+	"NQLBBhjJAgEGBwUAngcCvQMBBgcDAJ4HAr0DAQYHAwCTAgK9AwEEBwUA+wcBCAcF" +
+	"ANIBAgMA3gEBBAcDAPsHAQYHBQDBAQLfAQEGBwMAwQEC3wEBBAcFAO4YAQQHBQDO" +
+	"DwEGBwUAmQECvgIBBgcDAJkBAr4CAQkHBQAUAp8BJhjfAQEJBwMAFAKfASYY3wEB" +
+	"BgcFAJUEAt8BAQYHAwCVBALfAQEIBwUANQJIGN8BAQgHAwA1AkgY3wEBBwcFABQC" +
+	"M74CAQcHAwAUAjO+AgEHBwUAFALPAvYCAQcHAwAUAs8C9gIBBwcFADgCM74CAQcH" +
+	"AwA4AjO+AgEHBwUAOALPAvYCAQcHAwA4As8C9gIBBwcFADoCM74CAQcHAwA6AjO+" +
+	"AgEHBwUAOgLPAvYCAQcHAwA6As8C9gIBBwcFADUCM74CAQcHAwA1AjO+AgEHBwUA" +
+	"NQLPAvYCAQcHAwA1As8C9gIBBwcFAGICM74CAQcHAwBiAjO+AgEHBwUAYgLPAvYC" +
+	"AQcHAwBiAs8C9gIBBwcFADACM74CAQcHAwAwAjO+AgEHBwUAMALPAvYCAQcHAwAw" +
+	"As8C9gIBBwcFAGMC5wI5AQcHAwBjAucCOQEHBwUAxQEC5wI5AQcHAwDFAQLnAjkB" +
+	"BAcFAMsfAQQHAwDLHwEGBwUA1AECvQMBBgcDANQBAr0DAQgHBQCZAQKqASXLBQEG" +
+	"BwMA0gECpAUBBAcFAKcJAQQHAwCnCQEGBwUA3gECUgEGBwMA3gECUgEHBwUAFAI7" +
+	"JgEHBwMAFAI7JgEGBwUAOAK/BAEGBwMAOAK/BAEIBwUANQKgAhjJAgEIBwMANQKg" +
+	"AhjJAgEIBwUANQK5ARjJAgEIBwMANQK5ARjJAgEHBwUANQI7JgEHBwMANQI7JgEJ" +
+	"BwUANQI7JhjJAgEJBwMANQI7JhjJAgEGBwUA4gECyQIBBgcDAOIBAskCAQYHAwBv" +
+	"AqQFAQYHAwCZAQKkBQEGBwMAxQECpAUBBQcDALoFkwIBBQcDAJEXqAQBBQcDAOtS" +
+	"qAQBBgcFABQCSAEGBwUA8wECSAEGBwMA8wECSAEGBwUAbwJ3AQcHBQDFAQK7AUgB" +
+	"BwcDAGMCpgvpAgEHBwMA3gECpgvpAgEFBwUAhgSpAgEFBwMAhgSpAgEGBwUAPgJI" +
+	"AQUHBQAwdwEFBwUAoAHLAQEGBwUAOAJIAQYHAwA4AkgBBgcFAJMCAkgBBgcDAJMC" +
+	"AkgBCAcFAAPuAgJS6QIBBwcDAO4CAlLpAgEGBwUAYgJIAQYHAwBiAkgBBgcFAOIB" +
+	"AkgBBgcDAOIBAkgBBQcDAKABFAEEBwMAsQIBBQcDAKABsQIBBgcDAD4CUgEFBwMA" +
+	"sQE1AQYHAwDzAQKkBQEGBwMA0gEC6QIBBgcDANIBAlIBBQcDAPgBOAEEBwMA3QcB" +
+	"BgcDAN0HAlIBBQcDALEBOAEGBwMA+AGxATgBCAcDAPgBsQE4AlIBBwcDAMID+AGx" +
+	"ATgBBwcDALoFkwICSAEGBwMAwQECUgEFBwMAnQHBAQEFBwADBcEBAQQHAwCnBQEF" +
+	"BwMAyxyPBAEFBwMAoAHUAQEGBwMA1AECUgEGBwMAggwCUgEGBwMAOgJIAQQHAwCI" +
+	"AwEFBwADBToBBwcDAG8CTLkBAQYHAwBvAsEHAQcHAwBvAs8DUgEEBwMApA4BBQcD" +
+	"AKABsAEBCAcDAKABsAECqgHLBQEGBwMAsAECUgEHBwMAmQECJ1IBBwcDAJkBAs8D" +
+	"UgEFBwADBZkBAQUHAwDwBzUBBQcAAwXEAwEFBwMAwgOsAgEEBwMAhAUBBQcDAKAB" +
+	"YgEIBwMAoAFiAqoBywUBBwcDAKABYgJSAQcHAwBiAqoBywUBBgcDAGIC6QIBBgcD" +
+	"AGIChAkBBwcDAPgBYgKECQEFBwADBWIBBgcAAwXPAmIBBgcDAGMCUgEEBwMA3AUB" +
+	"CQcDALoFkwICSBhSAQYHAwDdHfgB3AUBBgcDANwFAqQFAQUHAwCgAcUBAQcHAwDF" +
+	"AQLPA1IBBQcDADB3AQQHAwCsAwEGBwMAywECUgEFBwMAoAHLAQEFBwMAoAGOAgEF" +
+	"BwMAoAHiAQEFBwADBeIBAQcHAwDeAQLPA1IBBgcDAN4BAqQFAQQHAwCeBwEGBwMA" +
+	"ngcCpAUBBAcAhgSpAgEFBwCqCacIuzsBBQcAzwKGBKkCAQQHAIYF8wEBBAcA/Q/E" +
+	"BwEFBwADBT4BBgcDAMIDsQE4AQcHAAMFwQECUgEFBwADBdQBAQYHAwCTAgK3CAEF" +
+	"BwMAoAHHAQEFBwADBW8BBgcDAO4CAlIBBgcAhgSpAgJIAQcHAPgBhgSpAgJIAQUH" +
+	"AwD7B6gEAQUHAwC9DagEAQcHAwD7B6gEAqQFAQUHAwC6D6gEAQUHAwCtD6gEAQcH" +
+	"AwCeHqgEAqQFAQUHAwDfEKgEAQUHAwCDGqgEAQUHAwCNGqgEAQQHAP0P/xsBBAcA" +
+	"8Cn/GwEHBwMAoAHUAQKECQEJBwMAoAHUAQKECRjpAgEEPQAD1AEBBj0AA9QBAlIB" +
+	"BD0AA5MCAQQ9AANiAQU9AAOgAWIBBz0AA6ABYgJSAQY9AAMFzwJiAQQ9AAOOAgEE" +
+	"PQAD4gEBAz0AsAcBBD0AM7AHAQQ9AKAB5wIBAz0AqggBBD0A+AHnAgEFPQAlbp8B" +
+	"AQU9ACdunwEBBD0AhgSpAgEFPQD4AYYEqQIBBD0AJ58CAQQ9ACWfAgEEPQBhnwIB" +
+	"BD0Ac58CAQQ9AIsCxAIBAb0DAQQ9AFO/AgEDPQDJAgEEPQDfAcQCAQQ9AL4CxAIB" +
+	"BT0AhwFTvwIBBD0AhwHJAgEFPQCHAb4CxAIBBT0AhwHfAcQCAQQ9ALMFmwQBBT0A" +
+	"brMFmwQBBj0AsQglbp8BAQY9ALEIJ26fAQEEPQBhjAQBBD0Ac4wEAQQ9AGABAQQ9" +
+	"AL4FAQEB9gIBAjsmAQKfASYBAcEGAQIDuQEBAzPfAcQCAQQ9AI5UUgEEPQCRA8QC" +
+	"AQQ9AAOnBQEEPQADbwEEPQADYwEEPQAD/AEBBj0AA/gBhgSpAgEFPQDVDYkBdwEF" +
+	"PQCOAYkBdwEFPQD7CYkBdwEFPQCHAYkBdwEFPQDWDYkBdwEGPQDpCMAQiQEqAQY9" +
+	"ALwHwBCJASoBAz0AmB8BAz0Ar2ABBD0AM6oIAQU9AIcBc58CAQU9AIcBYZ8CAQU9" +
+	"AIcBJ58CAQU9AIcBJZ8CAQQ9AIcBnwEBBT0ATL4CxAIBBj0ATDO+AsQCAQY9AEwz" +
+	"3wHEAgEEPQCHAbkBAQQ9AJEEmwQBBT0A8QiOAYkBAQU9AKkEjgGJAQEFPQDxCIcB" +
+	"iQEBBT0AqQSHAYkBAQM9AJ8dAQQ9ALEBnx0BBT0AhwEnGwEDH74CxAIBAx/fAcQC" +
+	"AQMfiwLEAgECH7kBAQIfyQIBAh+oCQECH/YCAQMfOyYBAh+gAgEDH1ImAQMfnwEm" +
+	"AQQfM98BxAIBAh+9AwEEH1O/AiYBBR8zU78CJgEEHzO+AsQCAQIfjQQBAx/PAvYC" +
+	"AQQfoAHnAiYBAx/nAiYBBB/4AecCJgEEH+cCJiUBBB++AsQCOQEEH98BxAI5AQQf" +
+	"J4wEOQEEHyWMBDkBBB8n3AImAQIfjwQBBR8nbp8BOQEEH2GMBDkBBB9zjAQ5AQQf" +
+	"YAE5AQQfvgUBOQEEH5pQUjkBBB/PA1I5AQMfOzkBAx+gAjkBAx+fATkBAx/nAjkB" +
+	"Ah+/BAECH8EGAQQfU78COQEDH7cKOQEFH88CM+gVOQEDH70DOQEEH4sCxAI5AQMf" +
+	"9gI5AQQfzwL2AjkBAx+5ATkBAx/JAjkBAx+HAb8CAQQfM4cBvwIBAx+5AZsFAQQf" +
+	"tQJImwUBBB+qAUibBQEEH7UClAWbBQEEH6oBlAWbBQEFHyVunwE5AQQfzwK3CjkB" +
+	"Ax8rOQEDH59WOQEDH/wBJgEDH1O5AQEDHzOoCQEEH74CiQEqAQQf3wGJASoBAx8h" +
+	"sgMBAx8huhEBBB8hgwbjBQEDHyHyAwEDH7cKJgEEH/0DATkBBR8zU78COQEEHyfc" +
+	"AjkBBB/SArkBJgEDH+cYJgEFH5QGpAFCJgEFHyclGzkBBB++ARs5AQMfrD3yCQEE" +
+	"HyWfAiYBBR8nbp8BJgECH+AQAQMf/AE5AQQfJ58COQEEHyWfAjkBBx8lnwIYYZ8C" +
+	"OQEFHyVunwEmAQQfOyYlAQMftQM5AQQfM58BOQEDH74HJgEEHzP2AjkBAx8z9gIB" +
+	"Ax8zyQIBBB8zyQI5AQMfM7kBAQQfM88C9gIBBR8zWxs5AQUfBwMAFAEFHwcDADgB" +
+	"BR8HAwA6AQUfBwMANQEFHwcDADABBR8HAwDzAQEFHwcDANIBAQUfBwMA1AEBBR8H" +
+	"AwCwAQEFHwcDAGIBBR8HAwDFAQEFHwcDAMsBAQUfBwMA/AEBBCEFANUYAQQhAwDV" +
+	"GAEFIQUA2QOWCwEFIQMA2QOWCwEDIZoCAQEEIWuaAgEBBSEFAO0bxQoBBSEDAO0b" +
+	"xQoDAiHyAwEGIQP4AY0I9wQLAQYhA8sDjQj3BAsBByED+AHLA40I9wQLAQMhywQq" +
+	"AQQhBQCHDQUCIeMFAQMhgwbjBQEGIQUAsQIC4wUBAyHuCKEeAQYhBQDqAwLjBQEG" +
+	"IQUA/QIC4wUBBiEFAIgDAuMFAgYhBQC6BALjBQIGIQUArAMC4wUBBiEFAKwCAuMF" +
+	"AQghAwCIAwKDBhjjBQEEIQUAsQIBBCEFANcFAQQhBQCnBQEEIQUAxgUBBCEFAOoD" +
+	"AQQhBQCPBwEEIQUA/QIBBCEFAIcFAQQhBQCIAwEEIQUA8QUBBCEFAN4GAQQhBQDe" +
+	"AwEEIQUAogQBBCEFAM8GAQQhBQC6BAEEIQUAkwMBBCEFANUEAgQhBQD3BAEEIQUA" +
+	"+wUBBCEFAKwDAQQhBQCEBQEEIQUA6wQBBCEFAIwGAQQhBQCsAgEGIQUAiAMCgwYB" +
+	"BiEFAKwDAoMGAQYhAwCxAgLjBQEGIQMA6gMC4wUBBiEDAP0CAuMFAQYhAwCIAwLj" +
+	"BQEIIQMArAMCgwYY4wUBBCEDALECAQQhAwDXBQEEIQMApwUBBCEDAMYFAQQhAwDq" +
+	"AwEEIQMAjwcBBCEDAP0CAQQhAwCHBQEEIQMAiAMBBCEDAPEFAQQhAwDeBgEEIQMA" +
+	"3gMBBCEDAKIEAQQhAwDPBgEEIQMAugQBBCEDAJMDAQQhAwDVBAEFIQMAMvcEAQQh" +
+	"AwD3BAEEIQMA+wUBBCEDAKwDAQQhAwCEBQEEIQMA6wQBBCEDAIwGAQQhAwCsAgEG" +
+	"IQMAiAMCgwYBBiEDAKwDAoMGAQYhAwC6BALjBQEGIQMArAMC4wUBBiEDAKwCAuMF" +
+	"AQQhBe0KCwEDIdcFCwEDIYcFCwEFIawDAlILAQchrAMC3wEYUgsBByGsAwKgAhhS" +
+	"CwEDIYQFCwEDIZMDCwEDIe0KCwEEIQDZA/QKAQUhAwDZA/QKAQMhAKMTAQQhAwCj" +
+	"EwEDIQDFCgEEIQMAxQoBAyEA9AoBBCEDAPQKAQMhAJYLAQQhAwCWCwEEXQUAtQkB" +
+	"BF0DALUJAQRdBQD6FwEEXQMA+hcBBF0FAPAKAQRdAwDwCgEEXQUA4woBBF0DAOMK" +
+	"AQRdBQDXCgEEXQMA1woBBF0FAIwKAQRdAwCMCgEEXQUAnhcBBF0DAJ4XAQMh8QUL" +
+	"AQMh1QQLAQQhjQj3BAsBAyEAhw0BBCEFhwULAQQhjQjqAwsBBSH4AY0I6gMLAQQh" +
+	"BQDeBwEEIQMA3gcBBSEFjQj3BAsBBCEFAJcLAQQhAwCXCwEFIdUEAkgLAQYhBfgB" +
+	"jQj3BAsBBiEFywONCPcECwEHIQX4AcsDjQj3BAsBBikFAIgGAr4CAQQpBQCcEQEE" +
+	"KQUAwg0BBCkFAP0QAQUpBQDoE4gGAQQpBQD+CAEFKQUAwhY6AQQpBQAJAQQpBQCj" +
+	"BwEEKQUApg4BBCkFAKQJAQQpBQDcHgEEKQUAuxkBBikFADoCvgIBBSkFALUCMAEE" +
+	"KQUAxhcBBCkFABQBBCkFAK4GAQQpBQCTBgEEKQUA7QUBBCkFAKUFAQQpBQCIBgEE" +
+	"KQUArQYBBCkFALUFAQQpBQA6AQUpBQC1AjoBBCkFAFoBBCkFAJkFAQQpBQDoBQEE" +
+	"KQUA/AIBBCkFADUBBCkFALEDAQQpBQC2BgEEKQUAxgcBBCkFAPoDAQQpBQAwAQQp" +
+	"BQD8BwEEKQUAggEBBCkFAOgGAQQpBQCLBQEEKQUAqAIBBCkFAP4SAQUpBQCHCAEB" +
+	"BCkFAOoHAQUpBQDlBgEBBCkFADgBBCkFAOYDAQQpBQCzAQEEKQMAFAEEKQMArgYB" +
+	"BCkDAJMGAQQpAwDtBQEEKQMApQUBBCkDAIgGAQQpAwCtBgEEKQMAtQUBBCkDADoB" +
+	"BSkDALUCOgEEKQMAWgEEKQMAmQUBBCkDAOgFAQQpAwD8AgEEKQMANQEEKQMAsQMB" +
+	"BCkDALYGAQQpAwDGBwEEKQMA+gMBBCkDADABBCkDAPwHAQQpAwCCAQEEKQMA6AYB" +
+	"BCkDAIsFAQQpAwCoAgEEKQMA/hIBBSkDAIcIAQEEKQMA6gcBBSkDAOUGAQEEKQMA" +
+	"OAEEKQMA5gMBBCkDALMBAQYpAwCIBgK+AgEEKQMAnBEBBCkDAMINAQQpAwD9EAEF" +
+	"KQMA6BOIBgEEKQMA/ggBBSkDAMIWOgEEKQMACQEEKQMAowcBBCkDAKYOAQQpAwCk" +
+	"CQEEKQMA3B4BBCkDALsZAQYpAwA6Ar4CAQUpAwC1AjABBCkDAMYXAQQpBQCsAgEE" +
+	"KQMArAIBBCkFAOgIAQQpAwDoCAEFKQUAmgY4AQUpAwCaBjgBBSkFALkCtAQBBSkD" +
+	"ALkCtAQBBikFAJoGuQK0BAEGKQMAmga5ArQEAQUpBQCvBrQEAQUpAwCvBrQEAQYp" +
+	"BQCaBq8GtAQBBikDAJoGrwa0BAEEKQUAlg4BBCkDAJYOAQQpBQCMBgEEKQMAjAYB" +
+	"BCkFAOgJAQQpAwDoCQEEKQUA6goBBCkDAOoKAQgpBQDqCgIzvgLEAgEIKQMA6goC" +
+	"M74CxAIBBCkFALILAQQpAwCyCwEFKQUAiAesAgEFKQMAiAesAgEGKQUArAIC8AwB" +
+	"BikDAKwCAvAMAQQpBQDRDgEEKQMA0Q4BBCkFAPQKAQQpAwD0CgEDKcYJAQEDHynw" +
+	"DAEDHymZUAEEHymDAqEcAQQfKZgCoRwBAx8p2FEBBR8phgHGCQEBBB8p/AkBAQcp" +
+	"BQC1AjoC6QIBBykDALUCOgLpAgEFKQUAkh0BAQUpAwCSHQEBBikFALYGAp8IAQYp" +
+	"AwC2BgKfCAEGKQUA7QUC6hMBBikDAO0FAuoTAQYpBQDtBQJIAQYpAwDtBQJIAQcp" +
+	"BQDtBQJMUgEHKQMA7QUCTFIBBikFAK0GAtwDAQYpAwCtBgLcAwEGKQUAtQUC3AMB" +
+	"BikDALUFAtwDAQYpBQBaAtwDAQYpAwBaAtwDAQcpBQBaAlNIAQcpAwBaAlNIAQYp" +
+	"BQBaAkgBBikDAFoCSAEFKQUAhxZaAQUpAwCHFloBBikFAPwCAtwDAQYpAwD8AgLc" +
+	"AwEFKQUg/ALtBQEFKQMg/ALtBQEHKQUAsQMCTFIBBykDALEDAkxSAQUpBQDqCIIB" +
+	"AQUpAwDqCIIBAQYpBQDGBwLcAwEGKQMAxgcC3AMBBikFAPoDAtwDAQYpAwD6AwLc" +
+	"AwEFKQUAgwQwAQUpAwCDBDABBykFAIMEMAJIAQcpAwCDBDACSAEGKQUAggEC3AMB" +
+	"BikDAIIBAtwDAQUpBSD6A+gGAQUpAyD6A+gGAQYpBQCLBQLcAwEGKQMAiwUC3AMB" +
+	"BykFAIsFAlNIAQcpAwCLBQJTSAEEKQUAjw8BBCkDAI8PAQUpBQDqCIsFAQUpAwDq" +
+	"CIsFAQcpBQDqCIsFAtwDAQcpAwDqCIsFAtwDAQMpAOsbAQYpBQCtBgL2AgEGKQMA" +
+	"rQYC9gIBBikFAFoCUgEGKQMAWgJSAQYpBQCZBQLpAgEGKQMAmQUC6QIBBikFAPwC" +
+	"AlIBBikDAPwCAlIBBikFAPwCAukCAQYpAwD8AgLpAgEFKQUAshmLBQEFKQMAshmL" +
+	"BQEGKQUA6AUC6QIBBikDAOgFAukCAQQpAwDrGwEGKQUAFAL2AgEGKQMAFAL2AgEG" +
+	"KQUAFAKgAgEGKQMAFAKgAgEFKQUgFIgGAQUpAyAUiAYBBikFAIgGAvYCAQYpAwCI" +
+	"BgL2AgEEKQUA3QcBBCkDAN0HAQYpBQDdBwKgAgEGKQMA3QcCoAIBBikFAK0GAqAC" +
+	"AQYpAwCtBgKgAgEGKQUAtQUCoAIBBikDALUFAqACAQUpBQDqCP4IAQUpAwDqCP4I" +
+	"AQYpBQA6AskCAQYpAwA6AskCAQYpBQA6AqACAQYpAwA6AqACAQYpBQA1AqACAQYp" +
+	"AwA1AqACAQUpBQDwBzUBBSkDAPAHNQEHKQUA8Ac1AqACAQcpAwDwBzUCoAIBBikF" +
+	"ADgCoAIBBikDADgCoAIBBikFADACyQIBBikDADACyQIBBikFADACoAIBBikDADAC" +
+	"oAIBBykFADACM98BAQcpAwAwAjPfAQEGKQUAiwUCoAIBBikDAIsFAqACAQYpBQDt" +
+	"BQLcAwEGKQMA7QUC3AMBBikFAOoHAqACAQYpAwDqBwKgAgEIKQUA7QUCSBhSAQgp" +
+	"AwDtBQJIGFIBBikFAIIBAlIBBikDAIIBAlIBBikFAIIBAkgBBikDAIIBAkgBBSkF" +
+	"AJwGpQUBBSkDAJwGpQUBBSkFAJwGwg0BBSkDAJwGwg0BBSkFAJwG5x8BBSkDAJwG" +
+	"5x8BBSkFAJwGyBcBBSkDAJwGyBcBBSkFAJwGpg4BBSkDAJwGpg4BBSkFAJwGpAkB" +
+	"BSkDAJwGpAkBBSkFAJwGwh0BBSkDAJwGwh0BBSkFAJwGvR4BBSkDAJwGvR4BBSkF" +
+	"APgBtQUBBSkDAPgBtQUBBikFAJkFAlIBBikDAJkFAlIBBCkFAJMJAQQpAwCTCQEE" +
+	"KQUA2AgBBCkDANgIAQQpBQCCDQEEKQMAgg0BBCkFAM8FAQQpAwDPBQEEKQUA/QQB" +
+	"BCkDAP0EAQUpBQDRFVoBBSkDANEVWgEHKQUAmQUCTFIBBykDAJkFAkxSAQcpBQD8" +
+	"AgJMUgEHKQMA/AICTFIBBikFALEDAtwDAQYpAwCxAwLcAwEGKQUAjw8C3AMBBikD" +
+	"AI8PAtwDAQcpBQD8AgInUgEHKQMA/AICJ1IBBCkFAM0XAQQpAwDNFwEEKQUAkhcB" +
+	"BCkDAJIXAQYpBQCZBQLcAwEGKQMAmQUC3AMCBNYBBQDuDwEE1gEFAPwPAQTWAQUA" +
+	"/BABBNYBBQC/AQEE1gEFAMwKAQTWAQUAnwMBBNYBBQD9BwEE1gEFAPcGAQTWAQUA" +
+	"QgEE1gEFAK0GAQTWAQUAmBEBBNYBBQD7GQEE1gEFAP4TAQTWAQUAqwIBBNYBBQDv" +
+	"CgEE1gEFAN8EAQTWAQUApQIBBNYBBQCwGAEE1gEFANsWAQTWAQUA1QcBBNYBBQAJ" +
+	"AQTWAQUAxg4BBNYBBQCoAgEE1gEFAKYIAQTWAQUAzgIBBNYBBQDTCAEE1gEFAJIZ" +
+	"AQTWAQUAnAEBBNYBBQCPHQEE1gEFAO8TAQTWAQUAvB4BBNYBBQDvAwEE1gEFAJgH" +
+	"AQTWAQUAihQBBNYBBQDBEgEE1gEFALERAQTWAQUAuAwBBNYBBQDrAwMG1gE9ACdu" +
+	"nwEBAtYBqggBA9YB4hcqAQPWAewEKgEC1gHnAgED1gHLBCoBA9YB0wUqAQXWAQMA" +
+	"oAHuDwEE1gEDAO4PAQTWAQMA/A8BBNYBAwD8EAEE1gEDAL8BAQTWAQMAzAoBBNYB" +
+	"AwCfAwEE1gEDAP0HAQTWAQMA9wYBBNYBAwBCAQTWAQMArQYBBNYBAwCYEQEE1gED" +
+	"APsZAQTWAQMA/hMBBNYBAwCrAgEE1gEDAO8KAQTWAQMA3wQBBNYBAwClAgEE1gED" +
+	"ALAYAQTWAQMA2xYBBNYBAwDVBwEE1gEDAAkBBNYBAwDGDgEE1gEDAKgCAQTWAQMA" +
+	"pggBBNYBAwDOAgEE1gEDANMIAQTWAQMAkhkBBNYBAwCcAQEE1gEDAI8dAQTWAQMA" +
+	"7xMBBNYBAwC8HgEE1gEDAO8DAQTWAQMAmAcBBNYBAwCKFAEE1gEDAMESAQTWAQMA" +
+	"sREBBNYBAwC4DAEE1gEDAOsDAQXWAQMgzAqKFAEG1gEDAAkCSAED1gGSA6kCAQLW" +
+	"AaIHAwT9DtYB1hABAQShDtYB1hABAQPWAbwXAQIDjQHEAuQ5AQONAcQC9xIBA40B" +
+	"xAKIWQEEjQHEAuAfuBwBBI0BxALgH5M8AQONAcQChF4BA40BxAKIVAEDjQHEAsZl" +
+	"AQONAcQCzFABA40BxALGZAEDjQHEArldAQONAcQC9xABBI0BxAL3EMJKAQONAcQC" +
+	"qBgBBI0BxALKUvEbAQSNAcQCoh60PAEDjQHEAt1QAQSNAcQCnSfxPQEDjQHEArpK" +
+	"AQONAcQCokgBA40BxALSGgEEjQHEAtIamUMBA40BxAKJNQEDjQHEAsVSAQSNAcQC" +
+	"oh7OUgEFjQHEAsNk/A/oZAEDjQHEAs1PAQONAcQCtEEBA40BxAK8NQEDjQHEAvdl" +
+	"AQSNASrNSHgBA40B5wSfWQEEjQHnBIUR9xIBBI0B5wSFEdgOAQSNAecEhRHqDgED" +
+	"jQHnBN4YAQONAecE/V4BA40B5wT3EgEDjQHnBNgOAQONAecE6g4BA40B5wSMEQEG" +
+	"jQHnBIwRjj4otQsBA40B5wTvUgEFjQHnBIwF3QHbEQEDjQHnBIhJAQONAcQBvEgB" +
+	"A40B5wTwDgEDjQHEAcpQAQSNAecEmAQ7AQSNAecElw87AQSNAcQBzh3TUAEEjQEq" +
+	"cjsBBI0BKms7AQSNAcQB8QLyPQEEjQHnBOoOuBwJA40BAHYBA40BALMKAQONAQCZ" +
+	"BgEDjQEAtg0BA40BANQDAQONAQC1CwEDjQEA7QYBA40BANQYAQONAQCcCgEDjQEA" +
+	"qQgBBI0BADKdAwEDjQEAnQMBA40BAJ4OAQSNAQAy3wUBA40BAN8FAQSNAQAy8QIB" +
+	"A40BAPECAQONAQCjBgEDjQEAkgcBBI0BADKxAwEDjQEAsQMBBI0BADLdEwEDjQEA" +
+	"3RMBA40BANoSAQONAQCHBwEDjQEAmAQBA40BALsTBQONAakIrwEBBY0BINMPM7UL" +
+	"AQWNASDTD7ULqQgBBY0BINMPM6kIAQONAcQB9xABA40BxAGoGAwDCB0BAQMIAfNV" +
+	"AQMIiBiaCQEDCAH/HAEDCAHxVQEECB0qJgEDhASuENkMAQOEBOIN2QwBAgjNHAEE" +
+	"hATFDOAaAQEFhATFDIYCqwEBAQLzJQEBAgjnAgEDCJAX9QQBBAjVUe4TAQEDCAHo" +
+	"SQEFCAGCHcIL+GIBBAgBwguNJwEECAHCU8ILAQUIAZELnya+JgEDCAH2XAEECAOO" +
+	"AeUDAQkIA44BIHYCpgICXAEECAOOAawGAQMIA9kGAQMIA8UHAQMIA90GAQIIsgcB" +
+	"AwgAKgEFCKkESt8IKgEFCPACO8QBKgEDCMsEKgEECAClGVwBAwgAkQIBBggAdgLU" +
+	"ByYBBggAdgKRAiYBBggA0QMCkQImAQYIAHYCkQI5AQYIAFwCkQImAQMIAHYBAwgA" +
+	"vAMBBAgAkAOtDgEDCACQAwEDCACVBQEDCADCAQEDCAClAQEDCADGAgEDCADZBQED" +
+	"CADCCQEDCADvAwEDCACsBgEDCACZAwEDCADFAwEDCACSBAEDCACdBAEDCADlAwED" +
+	"CADsBgEDCAC7AwEDCADuBAEHCACkBwI2iwEmAQcIAKQHAkGLATkBBwgA2AZcAs8C" +
+	"ywEBCAgA2AZcAjaLASYBCAgA2AZcAkGLASYBAgioCwEDCADrAwEDCACBBAEDCACd" +
+	"AwEDCACmAgEDCABYAQMIAMsCAQMIAKIDAQMIANEDAQQIAHbCAgEDCABcAQII5gkB" +
+	"AgjeCwECCJIMAQII2QYBAgjFBwECCN0GAQII5AYBAgi9CQEDCLoaJgEDCJECJgED" +
+	"CJECOQEDCOQDdgEDCM8CxQcBBAgqywL6CwECCI5mAQYIEAEDywEmAQcIEAHPAgPL" +
+	"ASYBBQgQATs5AQMI+AHFBwEFCNkGAjaLAQEECOYHkQI5AQOEBA7jAQEDhAQOMQED" +
+	"hAQONgEDhAQOQQEDhAQOTgEDhAQOVQEDhAQOdQEDhAQOgAEBA4QEDnQBA4QEDogB" +
+	"AQMIxgwBAQMIvBD1BAEDCMYJ9QQBBAhV+APvAgEECAC6BbwDAQQIALoFgQQBBAgA" +
+	"1wR2AQQIAHbJDwEHCAB2AuYHkQImAQcIAHYC5geRAjkBBAgAjgGRAgEFCACOAZEC" +
+	"dgEFCACOAZEC0QMBBggAMAKRAiYBBQgAjgGRAlwBAwgArwsBAwgA9QwBAwgAzAsB" +
+	"BQgAkAMCnwEBCAgAkAMCQYsBJrYBAQMIANMIAQMIAOsMAQMIAM0LAQYIAKUBApEC" +
+	"JgEICAClAQI2iwFTJgEDCAC2DAEDCADlCwEHCAClAQJBiwEmAQMIAJgKAQMIAOoM" +
+	"AQMIALkQAQUIANkFAp8BAQYIANkFAjs5AQkIANkFAjs5GAPlAwEDCAC0EAEDCAC4" +
+	"EAEDCADMEAEICADZBQJBiwEmtgEBBwgA2QUCTosBJgEDCADsEgEGCADvAwIDywEB" +
+	"BQgA7wMCnwEBBggA7wMCOzkBBwgA7wMCA8sBOQEJCADvAwI7ORg7JgEHCADvAwI2" +
+	"iwEmAQMIAKQRAQcIAO8DAk6LASYBCQgAmQMCOzkYOyYBBwgAmQMCQYsBOQELCACZ" +
+	"AwJBiwE5GEGLASYBBwgAkgQCNosBOQEHCACSBAJBiwEmAQcIAOUDAkGLASYBBwgA" +
+	"uwMCQYsBJgEECAC6BesDAQcIAOsDAjueSjkBBggA6wMCOzkBAwgA/AwBBwgA6wMC" +
+	"QYsBOQEDCADDDAEGCACBBAI7JgEHCACBBAJBiwEmAQMIAKQHAQQIAKYLnQMBBQgA" +
+	"nQMCnwEBBggAnQMCOyYBAwgAzgUBBwgAnQMCQYsBOQEDCACECAEFCACECAKfAQED" +
+	"CACxDAEHCACECAI2iwE5AQMIAIAMAQcIAIQIAkGLASYBBggApgICA8sBAQYIAKYC" +
+	"AjsmAQcIAKYCAkGLASYBBwgApgICQYsBOQEGCADLAgI7OQEECADLAvoLAQMIANcM" +
+	"AQUIAMsCAp8BAQcIAMsCAkGLASYBBAgAogPiCwEGCACYCgI7JgEGCACiAwJcJgEE" +
+	"CACiA4YJAQcIAKIDhgkCkQImAQUIAJADrQ6GCQEFCADRAwKfAQEECADPB8QDAQMI" +
+	"AMQDAQMIADABAwgA5gMBBAgAzwfmAwEHCADRAwI2iwEmAQMIAJMGAQQIANgGXAEF" +
+	"CABcAukCAQYIAFwCA8sBAQYIANEDAjsmAQMIADgBBwgAXAJBiwE5AQQIAFytCAEH" +
+	"CABcrQgCkQImAQMIkgOpAgEDCACVBAEKCAOOASCSBAKmAgJ2wgIBCggDjgEggQQC" +
+	"pgICdsICAQYIA44BWFcPAQUIA44BpgJ2AQQIA44BwgEBBQgDjgFBiwEBBAgDjgGZ" +
+	"AwEECKkESvgVAQYI5AxKgg+ZBeI+AQUIA44BxwbjAQEGCAOOAcFg0xzjAQEHCAOO" +
+	"AboF0gRKxgIBBggDjgFYSQ8BBAgDhwGZAwEECAOOAdQHAQMIA9EDAQMIA1wBBAgD" +
+	"jgFcAQQIA44BywIBBAiFCkrfVQEFCLQG0AKHAakCAQUItAbQAo4BqQIBBwjHBo4B" +
+	"qQIC+zrQAgEECAOHAVgBBggA2QUCzwLLAQEGCADvAwLPAssBAQSmBYQEDuMBAQSm" +
+	"BYQEDjEBBKYFhAQONgEEpgWEBA5BAQSmBYQEDk4BBKYFhAQOVQEEpgWEBA51AQSm" +
+	"BYQEDoABAQSmBYQEDnQBBKYFhAQOiAEBBggAxQMCOzkBBggAnQQCOzkBBggA7gQC" +
+	"OzkBBAgBuB3tCAEFCAG4HedR1QcBBggAogMCzwLLAQEE6wGpBErADAEE6wGvE5ID" +
+	"qQIBBOsBrROSA6kCAQPrAa8TmwQBA+sBrRObBAED6wGsAZsEAQTrAZsEmQ8nAQTr" +
+	"AZsEmQ8lAQXrAa8TmwSZDycBBesBrRObBJkPJQEC6wHRMwED6wGEEbdPAQPrAYQR" +
+	"ikkBA+sBhBGPJwID6wHTBSoBA+sBAM8VAQTrAQDXBM8VAQPrAQCABgED6wEAmRgB" +
+	"BOsBAJkYnBgBA+sBAIMXAQXrAQC6BYMX5xIBA+sBANQDAQPrAQDRAwED6wEArAYB" +
+	"A+sBALgGAQPrAQDkBwEE6wEA5AecGAED6wEA0R8BBOsBANEf1AMBA+sBAJsGAQPr" +
+	"AQCxRQED6wEAtQ4BA+sBAPECAQPrAQCUHQEE6wEAMpQdAQPrAQA4AQPrAQCxAwEE" +
+	"6wEA+AGxAwED6wEAogYBA+sBAMhSAQPrAQDnEgED6wEAmAQBA+sBAJAGAQTrAQCP" +
+	"A5cWAQTrAQCPA+Q8AQTrAQCPA9o1AQPrAdESJgED6wHREjkBA+sB0RLLAwED6wGU" +
+	"FCYBA+sBlBQ5AQPrAZQUywMBA+sBzhwmAQPrAc4cOQEE6wHLA+kfrAEBBOsBywPp" +
+	"H7smAQPrAc8YJgED6wHPGDkBA+sBlj7LAwED6wHsFyYBA+sB7Bc5AQLrAYpVAQPr" +
+	"AfsXOwEC6wH/UgEC6wH4VAEF6wE2U4sBJgEF6wE2U4sBOQEE6wFBiwEmAQTrAUGL" +
+	"ATkBBOsBiwa/AiYBBOsBiwa/AjkBAusBug4BAusBpikDBOsBAPsB1GUBBOsBAPsB" +
+	"tBkBBOsBAPsBnwcBCAgAvAMCQYsB/T85AQoIALwDAjs5GEGLASYBCQgAvAMCQYsB" +
+	"mwK+ATkBDQgAvAMCQYsBmwK+ATkYNosBJgEKCAC8AwI2iwE5GDsmAQgIALwDAs8C" +
+	"A8sBOQEGCAC8AwIDywEBBwgApQECNosBJgEJCAClAQJBiwGbAr4BOQELCADZBQI2" +
+	"iwHOCTkYA+UDAQgIANkFAs8CA8sBOQEFCADvAwJIAQcIAJkDAk6LASYBBwgAuwMC" +
+	"NosBJgEJCAC7AwJBiwGbArYBJgEICAC7AwI2iwHOCSYBBwgA6wMCNosBOQEJCADr" +
+	"AwJBiwGbAr4BOQEGCACkBwI7JgEHCACkBwJBiwEmAQkIAKQHAkGLAZsCvgE5AQYI" +
+	"AFgCOyYBBggAWAI7OQEHCADLAgI2iwE5AQYIAMsCAgPlAwEGCADLAgIDywEBBQgA" +
+	"pgICdwEICADvAwI2iwHOCSYBBggA7wMCkQImAQgIAJkDAjaLAc4JJgEJCAClAQID" +
+	"CADlAzkBCwgApQECAwgA5QMYNosBAQsIAJkDAgMIAOUDGDaLAQELCADvAwIDCADl" +
+	"Axg2iwEBCQgApQECAwgA5QMmAQkIAHYCpgWEBA42JgEJCAB2AqYFhAQOQSYBCggA" +
+	"2AZcAqYFhAQONiYBCggA2AZcAqYFhAQOQSYBCggA2AZcAqYFhAQOTjkBCQgA0QMC" +
+	"pgWEBA42JgEJCADRAwKmBYQEDkEmAQoIAFytCAKmBYQEDjYmAQoIAFytCAKmBYQE" +
+	"DkEmAQkIAKUBAqYFhAQOTjkBCQgAmQMCpgWEBA5OJgEGCACZAwLPAssBAQcIAJ0D" +
+	"AjaLASYBA54DAMsHAQOeAwCPWQEDngMAsU0BA54DANIMAQOeAwCXDQEDngMA6UUB" +
+	"A54DAOFCAQOeAwCaJgEDngMArGEBA54DAPNIAQOeAwDFOgEDngMA1zUBA54DAOwM" +
+	"AQOeAwD0RAEDngMAkTwBA54DAJM9AQOeAwCmVgEDngMAjjUBA54DAMhlAQOeAwCL" +
+	"XQEDngMAvAsBA54DANpQAQOeAwD8QQEDngMA3SsBA54DAOIeAQOeAwDXGAEDngMA" +
+	"sBkBA54DALtdAQOeAwDdHwEDngMAlFkBA54DANdVAQOeAwDxNAEDngMAQgEDngMA" +
+	"vwcBA54DAIgmAQOeAwDjPAEDngMAw1IBA54DAOpiAQKeA9MlAQKeA/UkAQKeA+dA" +
+	"AQKeA6A5AQKeA5ZgAQKeA9lPAQKeA5w5AQKeA4A6AQKeA7tPAQKeA7NPAQKeA70J" +
+	"AQOeAwD/Cg8D4wIO4wEBA+MCDjEBA+MCDjYBA+MCDkEBA+MCDk4BA+MCDlUBA+MC" +
+	"DnUBA+MCDoABAQPjAg50AQPjAg6IAQED4wIAFAED4wIAzAMBA+MCADoBA+MCADgB" +
+	"A+MCADABA+MCAKoDAQPjAgA1AQPjAgD4NAED4wIAmQEBA+MCAOABAQPjAgCXAQED" +
+	"4wIAtAEBA+MCAKUCAQPjAgDOAgED4wIAvwEBA+MCAJwBAQPjAgDQBQED4wIAuAEB" +
+	"A+MCAPQQAQPjAgDTAwED4wIAWgED4wIAqQEBBOMCAK0BrR8BA+MCAKgBAQPjAgDT" +
+	"AgED4wIArQEBA+MCAIIBAQPjAgCvAgED4wIAswEBBOMCANMCrR8BBOMCAKURpQIB" +
+	"BOMCAKURzgIBBOMCAKURnAEBBeMCH7UCjgGJAQEF4wIftQKHAYkBAQXjAh+1AsMG" +
+	"iQEBBeMCH6oBwRCJAQEF4wIfqgGOAYkBAQXjAh+qAYcBiQEBBeMCH6oBwwaJAQEE" +
+	"4wIf/hEqAQXjAh8zOyYBBOMCjgGJAaoIAQTjAocBiQGqCAEE4wILqgPHNQED4wIL" +
+	"pzwBAuMC5wIBA+MC7AQqAQLjAoZFAwLjAoc1AQPjArA2AQED4wL5XAEBA+UCAJEm" +
+	"AQPlAgCeFgED5QIAmDwBA+UCAIA1AQPlAgChEQED5QIAlw0BA+UCAIkNAQPlAgCg" +
+	"EQED5QIAuh4BA+UCANYPAQPlAgCdGQED5QIA+EQBA+UCALUOAQPlAgDxAgED5QIA" +
+	"8FkBA+UCAN8CAQPlAgDHBwED5QIA+l4BA+UCAPJSAQPlAgDnEgED5QIAuQMBA+UC" +
+	"AOdcAQPlAirfAgED5QIqv0EBA+UCKsBPAQPlAiqMBQEF5QI9AOgX1g8BBOUCKugX" +
+	"1g8BBeUCEAGqATgBBOUCEAE4AQXlAhAB4xvtAQEF5QIQAaoB7QEBBOUCEAHtAQEF" +
+	"5QIQAeMbFAEF5QIQAaoBFAEE5QIQARQBBeUCPQC1AhQBBeUCEAG1AhQBBeUCEAGq" +
+	"ATABBOUCEAEwAQTlAj0AOgEF5QIQAaoBOgEE5QIQAToBBOUCEAE1AQTlAhABvQkB" +
+	"A+UCKo4bAwPlAsQBjhsBA+UCxAH1JQED5QLEAbQmAQPlAsQBrSkBA+UCxAGcJwED" +
+	"5QLEAadZAQPlAtMFKgEE5QLEAfpIuhwBA+UCxAH6ZQED5QLEAbocAQPlAsQBvWUB" +
+	"A+UCxAGuXwED5QLEAe8mAQTlAsQBzh3MSAED5QLEAcUmAgPFBAD9PQEDxQQAtwUB" +
+	"A8UEAIwNAQPFBADSCQEDxQQAkAcBA8UEAM5gAQPFBAD6FQEDxQQAoBEBA8UEAJ8n" +
+	"AQPFBACNJgEDxQQAqwoBA8UEAP0FAQPFBADtBwEDxQQA8wMBA8UEANEGAQPFBADf" +
+	"AgEDxQQA4BUBA8UEAJYnAQPFBADHCwEDxQQA5w8BA8UEAPoCAQPFBADZBAEDxQQA" +
+	"0DgBA8UEAOdCAQPFBAC7AwEDxQTyJSoBA8UEnGIqAQPFBPgLKgMCxQTEAQIE6wEA" +
+	"kQGhAgEE6wEAkQGlAgEE6wEAkQHTAgEE6wEAkQGaAwEE6wEAkQG/AwEE6wEAkQG0" +
+	"DAEE6wEAkQHBAwEE6wEAkQGcAQEE6wEAkQHgBAEE6wEAkQH5CgEE6wEAkQHjAwYG" +
+	"CAB2ArkF2QYBCAgAdgK5BbMDJdkGAQcIAHYCJUxIAQcIAHYCJ0xIAQYIAHYCuQXd" +
+	"BgEICAB2ArkF9AMl3QYBCAgAdgK5BYgHOyYBCAgAdgK5BSWIBzsBCAgAdgK5BSeI" +
+	"BzsBCAgAdgK5BYgHOzkBBggAdgI7JgELCAB2ArkFswMl2QYYOyYBCggAdgIlTEgY" +
+	"OyYBCwgAdgK5BfQDJd0GGDsmAQsIAHYCuQWzAyXZBhgnnwEBCggAdgIlTEgYJ58B" +
+	"AQsIAHYCuQX0AyXdBhgnnwEBBwgAdgK5BSWRAgEHCAB2ArkFJ5ECAQUIqAsC5RuR" +
+	"AgEFCKgLAuUb0QMBBgioCwI2iwE5AQQIAK4PXAEECIYWiAc7AQQIkQSIBzsBBwgA" +
+	"ywICzwIDywEBCAgApQECzwIDywE5AQYIAOUDAjs5AQcIAOUDAkGLATkBCAgApAcC" +
+	"NosBzgk5AQMIU+kCAgQI5g4qJgEECJlRKiYHBQgDjgGhBY8mAQUIA4cBoQXpQQEF" +
+	"CAOHAaEFtkEBBQgDhwGhBYZdAQMI1AfpYgEECNcEdoZKAQMI5AvUBwEFCG7UB5IB" +
+	"1AcBBwgAvAMCA8sBOQEGCAC8AwKRAiYBBwgAwgECNosBJgEHCADlAwI2iwEmAQoI" +
+	"AOsDAjs5GEGLASYBBggAgQQCOzkBBggApgICM3cBBwgAWAJBiwEmAQoIAFwCNosB" +
+	"ORiRAiYBCggAXAI2iwE5GDsmAQUIAO8DAuEEAQYIANEDAjukCgEECACYA1wBBAgA" +
+	"hwF2AQcIANkFAkGLATkBBwgAkgQCQYsBOQEGCACECALPAkgBBAgAgwTRAwEHCACs" +
+	"BgLPAssBJgEHCAC7AwJBiwE5AQYIAJ0DAjs5AQoIAIEEAjs5GOADiwEmAQcIALwD" +
+	"AgNYJgEHCADTCAIDWCYBBwgAkAMCA5ADJgEHCADvAwIDywImAQsIAFwCNosBORgD" +
+	"ywImAQQIAMEL6wMBBAgAwQuBBAEECADBC8sCAQYIANMIAgPLAQEGCACQAwIDywEB" +
+	"BggArwsCA8sBAQYIAJgKAgPLAQEGCACkBwIDywEBBwgA7gQCQYsBJgEICADBC4EE" +
+	"AkGLASYBBwgAwgECQYsBJgEHCADCAQJBiwE5AQkIAKYCAgMIAOUDJgEDCACoPQEE" +
+	"CAPYBlwBBQgDjgHYBlwBCQgDjgFcrQgCNosBOQEFCAOOAaEFgB0BBAgDjgHsBgEF" +
+	"COEBiAc7JgEFCOEBiAc7OQEDCL0JOQEECOEBeDkBBwjhAYgHO90FeDkBBAgDhwHR" +
+	"AwEFCAOOAaEF4iYBBAgDjgGSBAEECAOOAbsDAQQIA44BgQQBBggDjgHLAgLdBgEG" +
+	"CAOHAcsCAt0GAQUIA44BoQWXJwEFCAOOAaEFgicBBQgDjgGhBa8mAQUIA44BoQXl" +
+	"VQEFCAOOAaEF4lIBBQgDjgGhBfViAQUIA44BiBiaCQEFCAOOAQH/HAEFCIw2qQRK" +
+	"+BUBBAigAcUHOQEDCPwD2QYBAwj8A8UHAQMI/APdBgEDCPwD5gkBAwj8A94LAQMI" +
+	"/AOSDAEFCIkBMTsmAQUIiQE2iwEmAQQIiQHhBCYBBQiJATE7OQEFCIkBNosBOQEE" +
+	"CIkB4QQ5AQMIsQHmCQEDCLEB3gsBAwixAZIMAQQIA44B0QMBBAjZBgKfAQEFCNkG" +
+	"AjsmAQUI3QYCOzkBBAgnnwImAQQIJZ8CJgEECCefAjkBBAglnwI5AQUIMyWfAiYB" +
+	"BwgzJZ8CJgI7AQYIJZ8CJgI7AQQIxQcCOwEFCCrhBcsC+gsBBGwBzwKNBAEDbAGN" +
+	"BAEDbAGMAwEDbAHxAwEEbAC1AhQBA2wAFAEDbADtAQEDbAA6AQNsAJICAQNsADAB" +
+	"A2wAqgIBBGwAe2IBBGwAe28BBGwAsAY4AQRsALUCOAEDbAA4AQNsAIoCAQRsALAG" +
+	"NQEEbAC1AjUBA2wANQEDbACiAgEDbABaAQNsANECAQNsAMMBAQNsAK8DAQNsAKEC" +
+	"AQNsAKsCAQNsAM4CAQNsAKUCAQNsAP8DAQNsANMCAQNsAJoDAQNsANADAQNsAJsD" +
+	"AQNsANsDAQNsAL8DAQNsALQBAQNsAOoCAQNsAL8BAQNsAKkDAQNsAK0BAQNsALQM" +
+	"AQNsAJcBAQNsAIQDAQNsAOABAQNsAMEDAQNsAKgBAQNsALMBAQNsAJwBAQNsANAF" +
+	"AQNsAKkBAQNsAOAEAQNsAPkKAQNsAIUDAQNsAKgCAQNsAOMDAQNsALgBAQNsAIIB" +
+	"AQRsEAHEAwEEbBAB2RsBA2wBgwUBA2wBlQYBBGwQAe0BAQRsEAE6AQRsEAGSAgEE" +
+	"bBABMAEEbBABqgIBBWwQAXtiAQVsEAF74gMBBWwQAbAGOAEFbBABtQI4AQRsEAE4" +
+	"AQRsEAGKAgEFbBABsAY1AQVsEAG1AjUBBGwQATUBBGwQAaICAQNsAdYDAQVsEAHQ" +
+	"EjgBBGwQAewPAQJs/wYBBGzlDAHCDwEEbOUMAcULAQNsvgLEAgEDbN8BxAIBBmwQ" +
+	"AbAGqgE4AQRsEAGgBQEEbBAB6xMBA2wAzwUBA2wAtRkBA2wA+hABA2wAnwMBA2wA" +
+	"lBcBA2wA2AgBA2wA0wMBA2wA1w8BBGwAe+IDAQRsAHuHBAEFbBABe28BBWwQAXuH" +
+	"BAECbLcEAQNsM7cEAQNsDuMBAQNsDjEBA2wONgEDbA5BAQNsDk4BA2wOVQEDbA51" +
+	"AQNsDoABAQNsDnQBA2wOiAEBA2zTBQEBBWwBjgGZEzsBBGwAsAYUAQNsAMQDAQNs" +
+	"ANkbAQNsAOwPAQNsAKAFAQNsAOsTAQRsAMlImwMBA2wAtgUBBGwAS7MBAQNsANkK" +
+	"AQNsAI0MAQRsAIYEqQIBA2wAuA0BA2wA1wkBAtcB5A8BA9cBAY0EAQPXAQGMAwED" +
+	"1wEB8QMCA9cBABQBA9cBAO0BAQPXAQA6AQPXAQCSAgED1wEAMAED1wEAqgIBBNcB" +
+	"AHtiAQTXAQB7bwMD1wEAOAED1wEAigIDA9cBADUBA9cBAKICAQPXAQBaAQPXAQDR" +
+	"AgED1wEAwwEBA9cBAK8DAQPXAQChAgED1wEAqwIBA9cBAM4CAQPXAQClAgED1wEA" +
+	"/wMBA9cBANMCAQPXAQCaAwED1wEA0AMBA9cBAJsDAQPXAQDbAwED1wEAvwMBA9cB" +
+	"ALQBAQPXAQDqAgED1wEAvwEBA9cBAKkDAQPXAQCtAQID1wEAlwEBA9cBAIQDAQPX" +
+	"AQDgAQED1wEAwQMBA9cBAKgBAQPXAQCzAQED1wEAnAECA9cBAKkBBAPXAQCoAgED" +
+	"1wEA4wMBA9cBALgBAQPXAQCCAQMD1wEBgwUBA9cBAZUGAQTXARAB7QEBBNcBEAE6" +
+	"AQTXARABkgIBBNcBEAEwAQTXARABqgIBBdcBEAF7YgEF1wEQAXviAwME1wEQATgB" +
+	"BNcBEAGKAgME1wEQATUBBNcBEAGiAgED1wEB1gMBBNcBALRDtAEJBNcBogK+BioF" +
+	"A9cBANAFAQPXAQDYCAID1wEA1w8BBNcBAHviAwEE1wEAe4cEAQXXARABe28BBdcB" +
+	"EAF7hwQDA9cBDuMBAQPXAQ4xAQPXAQ42AQPXAQ5BAQPXAQ5OAQPXAQ5VAQPXAQ51" +
+	"AQPXAQ6AAQED1wEOdAED1wEOiAEBBtcBAJwBAky7AQEG1wEAnAECa7sBAQPXAbEJ" +
+	"KgED1wGxCQEBBNcB9weGCzEBBNcB9weGCzYBBNcB9weGC0EBBNcB9weGC04BCNcB" +
+	"9weGCzHgRaoLxgOhFwEE1wH3B6EXmwgBAtcBixkBA9cBmzwqAQTXAQCtA4wDAQPX" +
+	"AdMFAQED1wHZCCoDBJACAd4lmxYBA5ACAZsWAQOQAgHxAwIDkAIAFAEDkAIA7QEB" +
+	"A5ACADoBA5ACAJICAQOQAgAwAQOQAgCqAgUDkAIAzAMBA5ACAIoCAwOQAgCqAwED" +
+	"kAIAogIBA5ACAFoBA5ACANECAQOQAgDDAQEDkAIArwMBA5ACAKECAQOQAgCrAgED" +
+	"kAIAzgIBA5ACAKUCAQOQAgD/AwEDkAIA0wIBA5ACAJoDAQOQAgDQAwEDkAIAmwMB" +
+	"A5ACANsDAQOQAgC/AwEDkAIAtAEBA5ACAOoCAQOQAgC/AQEDkAIAqQMBA5ACAK0B" +
+	"AgOQAgCXAQEDkAIAhAMBA5ACAOABAQOQAgDBAwEDkAIAqAEBA5ACALMBAQOQAgCc" +
+	"AQIDkAIAqQEBA5ACAOAEAgOQAgCFAwEDkAIAqAICA5ACALgBAQOQAgCCAQMDkAIB" +
+	"gwUCBJACEAHtAQEEkAIQAToBBJACEAGSAgEEkAIQATABBJACEAGqAgUEkAIQAcwD" +
+	"AQSQAhABigIDBJACEAGqAwEEkAIQAaICAQOQAgHWAwQDkAIBl2AIA5ACALUZAQOQ" +
+	"AgD6EAEDkAIAnwMBA5ACANAFAgOQAgDTAwgDkAIO4wEBA5ACDjEBA5ACDjYBA5AC" +
+	"DkEBA5ACDk4BA5ACDlUBA5ACDnUBA5ACDoABAQOQAg50AQOQAg6IAQECkAKFXgEC" +
+	"kALfJQECkALiQQECkALFYAEDkALNCtdPAQOQAgGpZAEDkALTBQELA+QBAY0EAQPk" +
+	"AQGMAwED5AEB8QMCA+QBABQBA+QBAO0BAQPkAQA6AQPkAQCSAgED5AEAMAED5AEA" +
+	"qgIBBOQBAHtiAQTkAQB7bwEE5AEQsAY4AgPkAQA4AQPkAQCKAgEE5AEQsAY1AgPk" +
+	"AQA1AQPkAQCiAgED5AEAWgED5AEA0QIBA+QBAMMBAQPkAQCvAwED5AEAoQIBA+QB" +
+	"AKsCAQPkAQDOAgED5AEApQIBA+QBAP8DAQPkAQDTAgED5AEAmgMBA+QBANADAQPk" +
+	"AQCbAwED5AEA2wMBA+QBAL8DAQPkAQC0AQED5AEA6gIBA+QBAL8BAQPkAQCpAwED" +
+	"5AEArQECA+QBAJcBAQPkAQCEAwED5AEA4AEBA+QBAMEDAQPkAQCoAQED5AEAswEB" +
+	"A+QBAJwBAgPkAQCpAQED5AEA4AQCA+QBAIUDAQPkAQCoAgED5AEA4wMBA+QBALgB" +
+	"AQPkAQCCAQMD5AEBgwUBA+QBAZUGAQTkARAB7QEBBOQBEAE6AQTkARABkgIBBOQB" +
+	"EAEwAQTkARABqgIBBeQBEAF7YgEF5AEQAXviAwEF5AEQAbAGOAIE5AEQATgBBOQB" +
+	"EAGKAgEF5AEQAbAGNQIE5AEQATUBBOQBEAGiAgED5AEB1gMDAuQB/wYQBOQBAHvi" +
+	"AwEE5AEAe4cEAQXkARABe28BBeQBEAF7hwQDA+QBDuMBAQPkAQ4xAQPkAQ42AQPk" +
+	"AQ5BAQPkAQ5OAQPkAQ5VAQPkAQ51AQPkAQ6AAQED5AEOdAED5AEOiAEBA+QB0wUB" +
+	"AQPkAbEJAQgD5AEAtgUBA+QBAb0JAQPkAQHkBgED5AEBuhoBBeQBAdZdgwUmAQXk" +
+	"AQF4gwUmAQXkAQHmE4MFJgID6AEBjQQBA+gBAYwDAQPoAQHxAwID6AEAFAED6AEA" +
+	"7QEBA+gBADoBA+gBAJICAQPoAQAwAQPoAQCqAgEE6AEAe2IBBOgBAHtvAwPoAQA4" +
+	"AQPoAQCKAgMD6AEANQED6AEAogIBA+gBAFoBA+gBANECAQPoAQDDAQED6AEArwMB" +
+	"A+gBAKECAQPoAQCrAgED6AEAzgIBA+gBAKUCAQPoAQD/AwED6AEA0wIBA+gBAJoD" +
+	"AQPoAQDQAwED6AEAmwMBA+gBANsDAQPoAQC/AwED6AEAtAEBA+gBAOoCAQPoAQC/" +
+	"AQED6AEAqQMBA+gBAK0BAgPoAQCXAQED6AEAhAMBA+gBAOABAQPoAQDBAwED6AEA" +
+	"qAEBA+gBALMBAQPoAQCcAQID6AEAqQEBA+gBAOAEAgPoAQCFAwED6AEAqAIBA+gB" +
+	"AOMDAQPoAQC4AQED6AEAggEDA+gBAYMFAQPoAQGVBgEE6AEQAe0BAQToARABOgEE" +
+	"6AEQAZICAQToARABMAEE6AEQAaoCAQXoARABe2IBBegBEAF74gMDBOgBEAE4AQTo" +
+	"ARABigIDBOgBEAE1AQToARABogIBA+gBAdYDCAPoAQGoCQEE6AGKAr4GKgEE6AGi" +
+	"Ar4GKgUD6AEA0AUBA+gBANgIAgPoAQDXDwEE6AEAe+IDAQToAQB7hwQBBegBEAF7" +
+	"bwEF6AEQAXuHBAMD6AEO4wEBA+gBDjEBA+gBDjYBA+gBDkEBA+gBDk4BA+gBDlUB" +
+	"A+gBDnUBA+gBDoABAQPoAQ50AQPoAQ6IAQEC6AGLGQED6AEArwIBBOgBzAEx7gMB" +
+	"BOgBzAExbgEE6AHMAUHCBgEE6AHMATGfCwEE6AHMATHpAwEE6AHMAUGYDwsDjwEB" +
+	"jAMBA48BAfEDAgOPAQAUAQOPAQDtAQEDjwEAOgEDjwEAkgIBA48BADABA48BAKoC" +
+	"BAOPAQA4AQOPAQDMAwEDjwEAigICA48BADUBA48BAKoDAQOPAQCiAgEDjwEAWgQD" +
+	"jwEAoQIBA48BAKsCAgOPAQClAgIDjwEA0wIBA48BAJoDBAOPAQC/AwEDjwEAtAEE" +
+	"A48BAK0BAQOPAQC0DAEDjwEAlwEEA48BAKgBAQOPAQCzAQEDjwEAnAEBA48BANAF" +
+	"AQOPAQCpAQEDjwEA4AQBA48BAPkKAQOPAQCFAwEDjwEAqAIBA48BAOMDAQOPAQC4" +
+	"AQEDjwEAggEFBI8BEAHtAQEEjwEQAToBBI8BEAGSAgEEjwEQATABBI8BEAGqAgQE" +
+	"jwEQATgBBI8BEAHMAwEEjwEQAYoCAgSPARABNQEEjwEQAaoDAQSPARABogIBA48B" +
+	"AdYDAwKPAf8GBwSPAaICvgYqDwOPAQ7jAQEDjwEOMQEDjwEONgEDjwEOQQEDjwEO" +
+	"TgEDjwEOVQEDjwEOdQEDjwEOgAEBA48BDnQBA48BDogBAQOPAR2GAgEEjwEdMYYB" +
+	"AQSPAR0xqwEBA48BngQBAQOPAfERAQEDjwHCHwEBA48BsTUBAQOPAaoQAQEEjwHR" +
+	"BiYBAQOPAbEJAQEDjwEdAQYFyAEBH40EJgEDyAEBjQQBA8gBAYwDAQPIAQHxAwEF" +
+	"yAEBH4wDJgEDyAEAFAEDyAEA7QEBA8gBADoBA8gBAJICAQPIAQAwAQPIAQCqAgEE" +
+	"yAEAe2IBBMgBAHtvAgPIAQA4AQPIAQDMAwEDyAEAigICA8gBADUBA8gBAKoDAQPI" +
+	"AQCiAgEDyAEAWgEDyAEA0QIBA8gBAMMBAQPIAQCvAwEDyAEAoQIBA8gBAKsCAQPI" +
+	"AQDOAgEDyAEApQIBA8gBAP8DAQPIAQDTAgEDyAEAmgMBA8gBANADAQPIAQCbAwED" +
+	"yAEA2wMBA8gBAL8DAQPIAQC0AQEDyAEA6gIBA8gBAL8BAQPIAQCpAwEDyAEArQEC" +
+	"A8gBAJcBAQPIAQCEAwEDyAEA4AEBA8gBAMEDAQPIAQCoAQEDyAEAswEBA8gBAJwB" +
+	"AQPIAQDQBQEDyAEAqQEBA8gBAOAEAQPIAQD5CgEDyAEAhQMBA8gBAKgCAQPIAQDj" +
+	"AwEDyAEAuAEBA8gBAIIBAwPIAQGDBQEDyAEBlQYBBMgBEAHtAQEEyAEQAToBBMgB" +
+	"EAGSAgEEyAEQATABBMgBEAGqAgEFyAEQAXtiAQXIARABe+IDAgTIARABOAEEyAEQ" +
+	"AcwDAQTIARABigICBMgBEAE1AQTIARABqgMBBMgBEAGiAgEDyAEB1gMIA8gBvgYq" +
+	"AQTIAYoCvgYqAgPIAQC+BAEDyAEA9QYBA8gBANxUAwTIAQD/GqMcAwTIAQB74gMB" +
+	"BMgBAHuHBAEFyAEQAXtvAQXIARABe4cEAwPIAQ7jAQEDyAEOMQEDyAEONgEDyAEO" +
+	"QQEDyAEOTgEDyAEOVQEDyAEOdQEDyAEOgAEBA8gBDnQBA8gBDogBCAPIAQHQAQEJ" +
+	"yAHMAQ7jASiBCocKSk4BCcgBzAEOMSiBCocKSk4BCcgBzAEONiiBCocKSk4BCcgB" +
+	"zAEOQSiBCocKSk4BCcgBzAEOMSjTCocKSk4BCcgBzAEONijTCocKSk4BCcgBzAEO" +
+	"QSjTCocKSk4BA8gBAbNfAQTqAQGZE40EAQPqAQGNBAED6gEBjAMBA+oBAfEDAQPq" +
+	"AQHQAQED6gEAFAED6gEA7QEBA+oBADoBA+oBAJICAQPqAQAwAQPqAQCqAgEE6gEA" +
+	"e2IBBOoBAHtvAgPqAQA4AQPqAQDMAwED6gEAigICA+oBADUBA+oBAKoDAQPqAQCi" +
+	"AgED6gEAWgED6gEA0QIBA+oBAMMBAQPqAQCvAwED6gEAoQIBA+oBAKsCAQPqAQDO" +
+	"AgED6gEApQIBA+oBAP8DAQPqAQDTAgED6gEAmgMBA+oBANADAQPqAQCbAwED6gEA" +
+	"2wMBA+oBAL8DAQPqAQC0AQED6gEA6gIBA+oBAL8BAQPqAQCpAwED6gEArQECA+oB" +
+	"AJcBAQPqAQCEAwED6gEA4AEBA+oBAMEDAQPqAQCoAQED6gEAswEBA+oBAJwBAQPq" +
+	"AQDQBQED6gEAqQEBA+oBAOAEAgPqAQCFAwED6gEAqAIBA+oBAOMDAQPqAQC4AQED" +
+	"6gEAggEDA+oBAYMFAQPqAQGVBgEE6gEQAe0BAQTqARABOgEE6gEQAZICAQTqARAB" +
+	"MAEE6gEQAaoCAQXqARABe2IBBeoBEAF74gMCBOoBEAE4AQTqARABzAMBBOoBEAGK" +
+	"AgIE6gEQATUBBOoBEAGqAwEE6gEQAaICAQPqAQHWAwgD6gG+BioBBOoBigK+BioH" +
+	"BOoBAP8aoxwBA+oBANMDAgTqAQB74gMBBOoBAHuHBAEF6gEQAXtvAQXqARABe4cE" +
+	"AwPqAQ7jAQED6gEOMQED6gEONgED6gEOQQED6gEOTgED6gEOVQED6gEOdQED6gEO" +
+	"gAEBA+oBDnQBA+oBDogBAgPqAQHsCgED6gEBswsOBZEBAR+MAyYBA5EBAY0EAQOR" +
+	"AQGMAwEDkQEB8QMBBJEBAK0DjAMBA5EBABQBA5EBAO0BAQORAQA6AQORAQCSAgED" +
+	"kQEAMAEDkQEAqgIBBJEBAHtiAQSRAQB7bwIDkQEAOAEDkQEAzAMBA5EBAIoCAgOR" +
+	"AQA1AQORAQCqAwEDkQEAogIBA5EBAFoBA5EBANECAQORAQDDAQEDkQEArwMBA5EB" +
+	"AKECAQORAQCrAgEDkQEAzgIBA5EBAKUCAQORAQD/AwEDkQEA0wIBA5EBAJoDAQOR" +
+	"AQDQAwEDkQEAmwMBA5EBANsDAQORAQC/AwEDkQEAtAEBA5EBAOoCAQORAQC/AQED" +
+	"kQEAqQMBA5EBAK0BAQORAQC0DAEDkQEAlwEBA5EBAIQDAQORAQDgAQEDkQEAwQMB" +
+	"A5EBAKgBAQORAQCzAQEDkQEAnAEBA5EBANAFAQORAQCpAQEDkQEA4AQBA5EBAPkK" +
+	"AQORAQCFAwEDkQEAqAIBA5EBAOMDAQORAQC4AQEDkQEAggEBA5EBAOceAQWRAQFT" +
+	"d9YDAQSRAQHWC9YDAQORAQGVBgEEkQEQAe0BAQSRARABOgEEkQEQAZICAQSRARAB" +
+	"MAEEkQEQAaoCAQWRARABe2IBBZEBEAF74gMCBJEBEAE4AQSRARABzAMBBJEBEAGK" +
+	"AgIEkQEQATUBBJEBEAGqAwEEkQEQAaICAQORAQHWAwEEkQEAO/lTAQORAQHxGwUE" +
+	"kQEAswiwAQEEkQEAswjiAQEEkQEAswiaRgEEkQGiAr4GKgEEkQHMATHWGwEEkQHM" +
+	"ATGKGAEEkQHMAUHbFwEEkQHMATHtHgEEkQHMATG+EwEEkQHMAUHuHgEEkQHMATHh" +
+	"EAEEkQEA2QOSAgEEkQEAe+IDAQSRAQB7hwQBBZEBEAF7bwEFkQEQAXuHBAMDkQEO" +
+	"4wEBA5EBDjEBA5EBDjYBA5EBDkEBA5EBDk4BA5EBDlUBA5EBDnUBA5EBDoABAQOR" +
+	"AQ50AQORAQ6IAQEDkQEdhgIBBJEBHTGGAQEEkQEdMasBAQSRAcwBMe4DAQSRAcwB" +
+	"MW4BBJEBzAFBwgYBBJEBzAExnwsBBJEBzAEx6QMBBJEBzAFBmA8BA5EBkBcqAQSR" +
+	"AQCzCLMMAQSRAQCzCJkBAQSRAQCzCOIDAQSRAQCzCG8BBJEBALMIhwQBBJEBALMI" +
+	"xwECA7IBAY0EAQOyAQHPJgEDsgEBlWICA7IBALcnAQOyAQD+JAEDsgEA8SUBA7IB" +
+	"AOolAQOyAQDxQQEDsgEArkEBA7IBANtgAQOyAQDYYAEDsgEA5UEBA7IBAORBAQOy" +
+	"AQC1QQEDsgEAs0EBA7IBAP85AQOyAQCkOQEDsgEAiyYBA7IBAPlPAQOyAQDdTwED" +
+	"sgEArycEBLIBAOwHphkBBLIBAI4IphkBBLIBAOwH8hABBLIBAI4I8hABBLIBAPNC" +
+	"/RoBBLIBAIYP8hABBLIBAOwHzhYBBLIBAI4IzhYBBLIBAOwHohEBBLIBAI4IohEB" +
+	"BLIBALcT/RoBBbIBALcT+VWSSwEEsgEAhg+iEQEEsgEA7AfjHgEEsgEAjgjjHgEE" +
+	"sgEA7Ae6EAEEsgEAjgi6EAEEsgEA+RGFGwEEsgEAhg+6EAEEsgEA7AedHgEEsgEA" +
+	"jgidHgEEsgEA7Ae3EAEEsgEAjgi3EAEEsgEAtRCFGwIEsgEAhg+3EAEEsgEA7Af3" +
+	"GwEEsgEAjgj3GwEEsgEA7Af4DwEEsgEAjgj4DwEDsgEA2kgBBLIBAKcm+A8BA7IB" +
+	"ALlkAQOyAQDVUwIEsgEAtRDmGQMDsgEAvWEBBLIBALcT8hIBBLIBAPkR8hIBBLIB" +
+	"ALUQ8hIBA7IBAJU+AQSyAQD5EeYZAQOyAQDhOgQDsgEBkCYFBLIBEAHfDwEFsgEQ" +
+	"AbIRxhUBBbIBEAHdCcYVAQWyARABshGKGQEFsgEQAd0JihkBBbIBEAGyEecbAgWy" +
+	"ARAB3QnnGwIEsgEQAZcYAQSyARABlQwBBbIBEAHdCZUMAQWyARABjES+NQEGsgEQ" +
+	"AZUMywffDwEHsgEQAZUMywfdCd8PAQayARABlQzLB/MQAQSyARAB8xAHBLIBjAgO" +
+	"4wEBBLIBjAgOMQEEsgGMCA42AQSyAYwIDkEBBLIBjAgOTgEEsgGMCA5VAQSyAYwI" +
+	"DnUBBLIBjAgOgAEBBLIBjAgOdAEEsgGMCA6IAQMFsgEQAd0JlxgBBbIBEAHdCfMQ" +
+	"AQOyAcQBt0QNBPcB2gGABe0KAQT3AdoBpQexGQEE9wHaAaUHwEMBBPcB2gGlB8ND" +
+	"AQT3AdoBpQe7QwEE9wHaAaUHyFMBBPcB2gGACqpMAQT3AdoBwwfWKwEE9wHaAcMH" +
+	"+SsBBPcB2gHDB9crAQT3AdoB+AT4BAEE9wHaAcMHhSwBBPcB2gGmBNdkAQT3AdoB" +
+	"xwXRKwEE9wHaAULVUAEE9wHaAcAFqgsBBPcB2gHABZhLAQT3AdoBwAWWUQEE9wHa" +
+	"AeADhhIBBPcB2gHHBb01AQT3AdoBQv5cAQT3AdoBwAXfXQEE9wHaAcAFvF0BBPcB" +
+	"2gHABbAeAQT3AdoB4AOiBAEE9wHaAZYHlikBBPcB2gH1BeEOAQT3AdoB2AeVUQEE" +
+	"9wHaAcoH0wMBBPcB2gHYB4lRAQT3AdoBygf4FwEE9wHaAdgH8FUBBPcB2gGuBagB" +
+	"AQT3AdoBpgSCFAEE9wHaAfQE81QBA/cB2gGcBQEE9wHaAcwF9hkBA/cB2gHyBAEE" +
+	"9wHaAZYF7GIBBPcB2gH4BOdVAQT3AdoB+ASGVQEE9wHaAfgErBMBBPcB2gHfBOAK" +
+	"AQT3AdoBzAWNLAEE9wHaATXuBgEE9wHaAd8EqU0BA/cB2gGYUAEE9wHaAeMGFAEE" +
+	"9wHaAawFgj4BBPcB2gHjBu0BAQT3AdoB4wbtBwEE9wHaAeMGOgEE9wHaAeMGkgIB" +
+	"BPcB2gHjBqAFAQT3AdoB4wacYAEE9wHaAeMGMAEE9wHaAeMGqgIBA/cB2gGPUQUE" +
+	"9wH3BwuVKQEE9wHaAeMGOAEE9wHaAeMGlQQBBPcB2gHjBjUBBfcB2gHjBooCp0gB" +
+	"BfcB2gHjBooCpkgBA/cB2gGwRQED9wHaAapIAQP3AdoBqUgBBPcB2gGsBc0KAQT3" +
+	"AdoBrAXABQEE9wHaAawFx14BBPcB2gGsBdsrAQP3AdoBwF0BA/cB2gHQTAED9wHa" +
+	"AaxkAQP3AdoBpTsBA/cBDuMBAQP3AQ4xAQP3AQ42AQP3AQ5BAQP3AQ5OAQP3AQ5V" +
+	"AQP3AQ51AQP3AQ6AAQED9wEOdAED9wEOiAEBA/cB2gG3JgED9wHaAbpDJgOHAgCA" +
+	"BQEEhwIApQelCwIEhwIApQeWCgIEhwIAggevAwEDhwIAgAoBA4cCAJgHAQSHAgCC" +
+	"B84CAQSHAgD4BJYKAgSHAgCCB/8DAQOHAgDRCAEEhwIAggfTAgEEhwIAggeaAwEE" +
+	"hwIAggfQAwEEhwIAggebAwEEhwIAggfbAwEEhwIAgge/AwEDhwIAxwUBA4cCAEIB" +
+	"BIcCAMAFpQsBBIcCAMAFlgoBBIcCAIIHqQMBA4cCAOADAQOHAgCWBwEDhwIA9QUB" +
+	"BIcCANgHpQsBBIcCAMoHlgoBBIcCANgHlgoBBIcCAMoHpQsBBIcCAIIHwQMBA4cC" +
+	"AK4FAQOHAgCmBAEEhwIAzAX2GQIEhwIAzAWAGgIDhwIAlgUBBIcCAIYdqAIBBIcC" +
+	"AIYd4wMBBIcCAPgEpQsBBIcCAN8EpQsBBIcCAIIH4AQBA4cCADUBBIcCAN8ElgoB" +
+	"AocC/wgBBIcCEAEUAQWHAhABrAXuCgEEhwIQAe0BAQSHAhAB7QcBBIcCEAE6AQSH" +
+	"AhABkgIBBIcCEAHiAQEEhwIQAY4UAQSHAhABMAEEhwIQAaoCAQSHAgGCB9YDAQWH" +
+	"AhABrAXCGQEEhwKTHQHMBQEEhwKTHQHRCAMEhwIQATgBBIcCEAG6CAEEhwIQATUB" +
+	"BIcCEAGRBwEEhwIQAYoCAgOHAoAFqQECBIcCiQGsBc0KAQSHAokBrAXABQEEhwKJ" +
+	"AawF4gUBBIcCiQGsBaArAQOHAqUNKgEChwLKTAMDhwIO4wEBA4cCDjEBA4cCDjYB" +
+	"A4cCDkEBA4cCDk4BA4cCDlUBA4cCDnUBA4cCDoABAQOHAg50AQOHAg6IAQMDhwLf" +
+	"BOADAQOHAt8ErgUBBIcCALcZ2gYBBIcCALcZ0QghA1QE/wYBB1Qq/wu9B78G8l4U" +
+	"AQlUKv8LvQe/BpcU7hyOFqgBAQlUKv8LvQe/BpcU/wvlB6gBAQdUKle9B78Gyxqo" +
+	"AQEHVCrXC70HvwaYHagBAQhUKvMHvQe/BoocpAaoAQEHVCq9B78G5QekBqgBAQRU" +
+	"KolWpAYBBVQqtyq9B78GAQZUKogqpB29B78GAQRUKthB5QcBBVQquw3lB7gqAQNU" +
+	"KqQGAQRUKssOpAYBBFQq5QekBgEFVCrLDuUHpAYBBlQqsFTnK9xapAYBBVQq3hy5" +
+	"GKQGAQhUKvMHlhSJB5AEqgHUBgEEVCr/C+UHAQVU/hkB0CuJBwEFVP4ZAehFiQcB" +
+	"B1SrCAGBWa489R+JBwEFVKsIAfYflwEBBVSrCAGZVoFfAQVUAZUI4QujGAEFVAGV" +
+	"COELtRgBBVQBlQjhC4ERAQVUAZUIqgyjGAEFVAGVCKoMtRgBBlQBlQjhC5UIqgwB" +
+	"A1QO4wEBA1QOMQEDVA42AQNUDkEBA1QOTgEDVA5VAQNUDnUBA1QOgAEBA1QOdAED" +
+	"VA6IAQEEVA5uMQEEVA5uNgEEVA5uQQEEVA5uTgEEVA5uVQEEVA5udQEEVA5ugAEB" +
+	"BFQObnQBBFQObogBAQRUDm7jAQEEVCq1KokHAQZUKpEbwxbhBugfAQhUKvMHlhSJ" +
+	"B4kQ3hrUBgEGVCqRG8MWgFmJBwEEVCqLBb8GAQRUKr4E9x8BBVQqwBiJB8kYAQVU" +
+	"KsAYiQfIGAEFVCruBrMZyRgBBVQq7gazGcgYAQRUAb4f3R4BBFQBwhrdHgEDVABa" +
+	"AQNUANECAQNUAMMBAQNUAK8DAQNUAKECAQNUAKsCAQNUAM4CAQNUAKUCAgNUANMC" +
+	"AQNUAJoDAQNUANADAQNUAJsDAQNUANsDAQNUAL8DAQNUALQBAQNUAOoCAQNUAL8B" +
+	"AQNUAKkDAQNUAK0BAQNUAJcBAQNUAIQDAQNUAOABAQNUAMEDAQNUAKgBAQNUAL4E" +
+	"AQNUAMsGAQNUAPUGAQNUAOYLAQNUAK8CAQNUALYFAQNUAJ8DAQNUAKcKAQNUALMB" +
+	"AQNUAJwBAQNUAKkBAQNUAKgCAQNUAOMDAQNUALgBAQNUAIIBAQNUABQBA1QAmQwB" +
+	"BFQA2w2cAQEDVAC3EQEDVADQBQUEVBAB7QEBBFQQAToBBFQQAZICAQRUEAEwAQRU" +
+	"EAGqAgEFVBABe2IBBVQQAXviAwEFVBABe28BBVQQAXuHBAEEVBABOAEEVBABzAMB" +
+	"BFQQATUBBFQQAaoDAQZUAblUsgWhAvQEAQRUAe4cjhYBBVQQAfgBOgEFVBAB+AGS" +
+	"AgEGVAHhBugf/wq/AQEEVAGgWshFAQNUKoMRAQNUKqBQAQRUAcVFiQcBBFQBvAeJ" +
+	"BwEFVAHnGb4E1AYBBFQBsA7UBgEFVAG+GNQG4BwBBVQBvhjwSOAcAQVUAc8CsA7U" +
+	"BgEGVIACAecZvgTUBgEFVIACAbAO1AYBBlSAAgHPArAO1AYBBFSAAgBaAQRUgAIA" +
+	"0QIBBFSAAgDDAQEEVIACAK8DAQRUgAIAoQIBBFSAAgCrAgEEVIACAM4CAQRUgAIA" +
+	"pQICBFSAAgDTAgEEVIACAJoDAQRUgAIA0AMBBFSAAgCbAwEEVIACANsDAQRUgAIA" +
+	"vwMBBFSAAgC0AQEEVIACAOoCAQRUgAIAvwEBBFSAAgCpAwEEVIACAK0BAQRUgAIA" +
+	"lwEBBFSAAgCEAwEEVIACAOABAQRUgAIAwQMBBFSAAgCoAQEEVIACAL4EAQRUgAIA" +
+	"ywYBBFSAAgD1BgEEVIACAOYLAQRUgAIArwIBBFSAAgC2BQEEVIACAJ8DAQRUgAIA" +
+	"pwoBBFSAAgCzAQEEVIACAJwBAQRUgAIAqQEBBFSAAgCoAgEEVIACAOMDAQRUgAIA" +
+	"uAEBBFSAAgCCAQEEVIACABQBBFSAAgCZDAEFVIACANsNrwIBBVSAAgDbDbMBAQVU" +
+	"gAIA2w2cAQIEVKoFnAXRAgEHVKoFnAXRAokQ3hrUBgEFVKYNAUuRFgEFVKYNAXqR" +
+	"FgEFVKYNAY0rmF0BBVSmDQGKVvQfAQRUC7o46gQBBFQLzxztHAEEVAuSUK88AQZU" +
+	"C88c7RzeHLkYAQRUC4oclwEBBFQL3wbqBAEGVAvfBuoEyw7dDwEGVAvfBuoEgRHd" +
+	"DwEGVAvfBuoEiRDdDwIGVAGVCKoMlQjhCwEFVAGVCKoMgREBB1QqtiqkHY4Fvwbf" +
+	"HAEHVCrsSb0HjgW/Bt8cAQRUKssO5QcBCVQqV7gW7xy9B78GyxqoAQEJVCrXC7gW" +
+	"7xy9B78GmB2oAQED/Q6qDwEBA6EOqg8BAQX9DqoPAQKLAQEFoQ6qDwECiwEBBVQq" +
+	"ykXKGokHAQVUKr1eyhqJByYDTQBaAQNNANECAQNNAMMBAQNNAK8DAQNNAKECAQNN" +
+	"AKsCAQNNAM4CAQNNAKUCAQNNAP8DAQNNANMCAQNNAKJNAQNNAJoDAQNNANADAQNN" +
+	"AJsDAQNNANsDAQNNAL8DAQNNALQBAQNNAOoCAQNNAL8BAQNNAKkDAQNNAK0BAQNN" +
+	"AJcBAQNNAIQDAQNNAOABAQNNAMEDAQNNAKgBAQNNALMBAQNNAJwBAQNNAKkBAQNN" +
+	"AK8CAQNNALgBAQNNAIIBAQNNAOAEAQNNABQBBE0AuQMUAQNNADoBA00AkgIBA00A" +
+	"MAEDTQCqAgEDTQA4AQRNAPwGOAEDTQA1AQNNAKICAQVNEAGnC+0BAQRNEAHtAQEE" +
+	"TRABOgEETRABkgIBBE0QATABBE0QAaoCAQRNEAE4AQRNEAGKAgEFTRAB/AaSAgEF" +
+	"TRAB/AY1AQVNEAE4JgEDTQGMAwEETQE7OQEDTQHxAwEDTQHWAwEDTQGFJwEFTfEB" +
+	"Ac0BswEBBU3xAQHNAZwBAQVN8QEBzQGvAgEFTfEBAc0BggEBBE0A3Aq4AQEDTQ7j" +
+	"AQEDTQ4xAQNNDjYBA00OQQEDTQ5OAQNNDlUBA00OdQEDTQ6AAQEDTQ50AQNNDogB" +
+	"AQRNAbkC8AMBA00B8AMBA00LoUYBA00LvS0BA00L9CUBA00LvDwBA00AqAIBA00A" +
+	"4wMBBE0Ae2IBBE0Ae+IDAQRNAHtvAQRNAHuHBAEFTRABe2IBBU0QAXviAwEFTRAB" +
+	"e28BBU0QAXuHBAEETQD8BqECAQRNAPwG/wMBBE0A/AbXCQEETQD8BssLAQZN8QEB" +
+	"/AbNAa0BAQZN8QEB/AbNAagBAQZN8QEB/AbNAakBAQVNAIwP8gWoAgEGTRABjA/y" +
+	"Bb4IAQZNiQEqjA/yBZA+AQdNiQEqjA/yBbwF2AcBBk0AqAjiBvIF6gIBBk0AqAji" +
+	"BvIFzgwBB00QAagI4gbyBb4IAQdNEAGoCOIG8gWgBQEGTQGoCOIG8gXxDAEGTQGo" +
+	"COIG8gWgCAEGTQGoCOIG8gXiCAEGTQGoCOIG8gWfCgEGTQGoCOIG8gWhCAEGTQDK" +
+	"DeIG8gW/AwEGTQDKDeIG8gWMFAEGTQDKDeIG8gX3PAEGTRABsTzyBToBBU0QAZYD" +
+	"xAMBBU0QAZYDMAEFTRABlgPMAwEETQC5A1oBBE0AuQPRAgEETQC5A8MBAQRNALkD" +
+	"qwIBBE0AuQOfAwEETQC5A9MCAQRNALkDvwEBBE0AuQOtAQEETQC5A4QDAQRNALkD" +
+	"0wMBBE0AuQPgAQEETQC5A+oCAQRNALkDggEBBk3xAQG5A80BrwIBBU0QAbkD7QEB" +
+	"BU0QAbkDOAEGTRABuQM4JgEGTRABuQMy4gEBBE0BuQOgCAEETQG5A+IIAQRNAbkD" +
+	"oQgBBE0BuQO0DwEFTQG5A6cQoAgBBU0BuQOnEOIIAQZNAbkDpxC8OYkBAQVNAPgc" +
+	"1A7TAwEFTQH4HNQOoQgBBE25Aw7jAQEETbkDDjEBBE25Aw42AQRNuQMOQQEETbkD" +
+	"Dk4BBE25Aw5VAQRNuQMOdQEETbkDDoABAQRNuQMOdAEETbkDDogBAQRNAf8E8QwB" +
+	"BE0B/wTiCAEFTRABqgoUAQVNEAGqCooCAQRNC7kDMQEETQu5A+wEAQRmBQDzAwEE" +
+	"ZgUAmg0BBGYFAOsJAQRmBQDEDQEEZgUA/AIBBGYFAMgPAQRmBQCJDQEEZgUArA8B" +
+	"BGYFAN8CAQRmBQDuCgEEZgUAnw4BBGYFAK0FAQRmBQCrDAEEZgUAzAIBBGYFANYO" +
+	"AQRmBQDaDwEEZgUAkgsBBGYFAJcLAQRmBQCXCgEEZgUA5AgBBGYFAN0OAQRmBQCM" +
+	"DgEEZgUA6A0BBGYFAM8MAQRmBQCYBAEEZgUArA0BBGYFANQGAQRmBQCMDAEEZgUA" +
+	"sA0BBGYFAKkNAQRmBQDPDwEEZgUAhQ4BBGYFAO8NAQRmBQDUAwEEZgUAgwwBBGYF" +
+	"AP0EAQRmBQDfCgEEZgUA9g0CBGYFAL4LBgRmBQCoCgMDZgDzAwEDZgCaDQEDZgDr" +
+	"CQEDZgDEDQEDZgD8AgEDZgDIDwEDZgCJDQEDZgCsDwEDZgDfAgEDZgDuCgEDZgCf" +
+	"DgEDZgCtBQEDZgCrDAEDZgDMAgEDZgDWDgEDZgDaDwEDZgCSCwEDZgCXCwEDZgCX" +
+	"CgEDZgDkCAEDZgDdDgEDZgCMDgEDZgDoDQEDZgDPDAEDZgCYBAEDZgCsDQEDZgDU" +
+	"BgEDZgCMDAEDZgCwDQEDZgCpDQEDZgDPDwEDZgCFDgEDZgDvDQEDZgDUAwEDZgCD" +
+	"DAEDZgD9BAEDZgDfCgEDZgD2DQEDZgDHBwEDZgC+CwEDZgDeFwEEZgCgAesJAQNm" +
+	"ALsDAQNmwAz1BAEEPQBmqwwBA2YAqAoBBGYAhwgBAQRmANUZAQEDHJUBkQkBAxyV" +
+	"AaMPAQMclQGhCQEDHJUBxwkBAxyVAaYPAQMclQGwCQEDHJUBnAkBAxyVAa0JAQMc" +
+	"lQGkDwEDHJUBtwkBAxyVAaUPAQMclQHICAEDHJUBtAgBAxyVAaIPAQMclQH1CAED" +
+	"HJUBkAkBAxyVAcMJAQMclQGrCQEDHJUBigkBAxyVAaEbAQMclQGdEwEDHJUBjxIB" +
+	"AxyVAcZMAQMclQG0HgEDHJUB5RwBAxyVAeEdAQMclQH4DgEDHJUBoxkBAxyVAeoR" +
+	"AQMclQGqEQEDHJUBkBwBAxyVAaNRAQMclQG/EgEDHJUB3w4BAxyVAZIcAQMclQG+" +
+	"EgEDHJUBp1EBAxyVAalRAQMclQGmUQEDHJUBvRIBAxyVAY4cAQMclQGTHAEDHJUB" +
+	"kRwBAxyVAawRAQMclQGkGQEDHJUBihMBAxyVAb0dAQMclQGMEwEDHJUBvh0BAxyV" +
+	"AbwdAQMclQGLEwEDHJUB+VkBAxyVAfpZAQMclQH0WQEDHJUBiRMBAxyVAbodAQMc" +
+	"lQH2WQEDHJUBvx0BAxyVAfhZAQMclQG7HQEDHJUBgCwBAxyVAYIsAQMclQHHKwED" +
+	"HJUBySsBAxyVAa4SAQMclQH8GAEDHJUBqUEBAxyVAaFBAQMclQGkQQEDHJUBpkEB" +
+	"AxyVAaJBAQMclQGcEwEDHJUBnkEBAxyVAZ1BAQMclQGoQQEDHJUBo0EBAxyVAYcU" +
+	"AQMclQGhLAEDHJUB/ysBAxyVAYEsAQMclQHGKwEDHJUByCsBAxyVAfQrAQMclQHz" +
+	"KwEDHJUB/isBAxyVAcUrAQMclQGHHAEDHJUBqxEBAxyVAeAdAQMclQGGFAEDHJUB" +
+	"70MBAxyVAY4SAQMclQHCDgEDHJUBww4BAxyVAbYeAQMclQG/CAEDHNwBvwgBAxzc" +
+	"ARQBAxzcAZUEAQMc3AGzAQEDHNwBgg0BAxzcAekLAQMc3AE4AQMc3AGFFAEDHNwB" +
+	"qwYBAxzcATUBAxzcAa8CAQMc3AHxEwEDHNwBxAMBAxzcAaYEAQMc3AEwAQMc3AH1" +
+	"EwEDHNwB/QQBAxzcAbQFAQMc3AHmAwEDHNwBvggBAxzcAQkBAxzcAToBAxzcAfwf" +
+	"AQMc3AH9HwEDHNwBlmQBAxzcAbgfAQMc3AHNOQEDHNwBzjkBAxzcAcw5AQMc3AHA" +
+	"ZAEDHNwBwWQBAxzcAeBOAQMc3AHfTgEDHNwB5k4BAxzcAeFOAQMc3AHjTgEDHNwB" +
+	"yR8BAxzcAcofAQMc3AHlZAEDHNwB5GQBAxzcAcgfAQMc3AHcXwEDHNwB3V8BAxzc" +
+	"Ad5fAQMc3AHhXwEDHNwB4F8BAxzcAfhkAQMc3AH7ZAEDHNwB+mQBAxzcAdAfAQMc" +
+	"3AHPHwEDHNwB/WQBAxzcAc4fAQMc3AHqOQEDHNwB6DkBAxzcAdBkAQMc3AHEQAED" +
+	"HNwBzEABAxzcAclAAQMc3AHLQAEDHNwBx0ABAxzcAcVAAQMc3AHnFQEDHNwB5SYB" +
+	"AxzcAecmAQMc3AHmJgEDHNwB5FoBAxzcAfsfAQMc3AGXZAEDHNwBwmQBAxzcAeRO" +
+	"AQMc3AHlTgEDHIoBkQkBAxyKAaMPAQMcigG2EQEDHIoBoQkBAxyKAcIOAQMcigHD" +
+	"DgEDHIoBxwkBAxyKAbAJAQMcigH5DgEDHIoB+g4BAxyKAfsOAQMcigH8DgEDHIoB" +
+	"5BIBAxyKAeMSAQMcigH4DgEDHIoBnAkBAxyKAa0JAQMcigHfDgEDHIoBtwkBAxyK" +
+	"AaUPAQMcigHICAEDHIoBtAgBAxyKAfUIAQMcigGQCQEDHIoBwwkBAxyKAasJAQMc" +
+	"igGKCQEDHIoB7UMBAxyKAe5DAQMcigGhGwEDHIoBjxIBAxyKAY4SAQMcigGiGwED" +
+	"HIoByEwBAxyKAbQeAQMcigG2HgEDHIoB5BwBAxyKAeUcAQMcigHlEgEDHIoBpVQB" +
+	"AxyKAeEdAQMcigGdVAEDHIoBnlQBAxyKAeccAQMcigGfVAEDHIoB4hwBAxyKAaNU" +
+	"AQMcigHmHAEDHIoB4xwBAxyKAekcAQMcigHcGgEDHIoBzkkBAxyKAeoRAQMcigHr" +
+	"EQEDHIoB0EkBAxyKAd0aAQMcigHJSQEDHIoBy0kBAxyKAaoRAQMcigGkUQEDHIoB" +
+	"kRwBAxyKAY8cAQMcigGsEQEDHIoBihMBAxyKAYwTAQMcigG+HQEDHIoBixMBAxyK" +
+	"Aa4SAQMcigH8GAEDHIoBp0EBAxyKAZwTAQMcigGgQQEDHIoBhxQBAxyKAcQfAQMc" +
+	"igHDHwEDHIoBhxwBAxyKAasRAQMcigHXPgEDHIoB2T4BAxyKAdY+AQMcigHYPgED" +
+	"HIoBhhQBAxyKAetDAQMcigHsQwEDHIoB6EMBAxyKAepDAQMcigHpQwEDHIoBnRMB" +
+	"AyIEggEBAyIE2wYBAyIE9gMBAyIEywcBAyIE9A0BAyIE1AMBAyIE3wQBAyIEwD8B" +
+	"AyIEqQEBAyIE8gQBAyIE1QIBAyIEmgwBAyIEoA4BAyIEowMBAyIEzAUBAyIE1BEB" +
+	"AyIExggBAyIEvz4BAyIEvT4BAyIE1xgBAyIEvD4BAyIEuz4BAyIEvj4BAyIE2BgB" +
+	"AyIEqAEBAyIE3gMBAyIEuQQBAyIE1hEBAyIE5BEBAyIEkAQBAyIErgUBAyIEuw4B" +
+	"AyIEqlwBAyIEsFwBAyIErlwBAyIEq1wBAyIErVwBAyIErFwBAyIEr1wBAyIEsVwB" +
+	"AyIEnAEBAyIEnAUBAyIEoAYBAyIE0gwBAyIE1AwBAyIE9gUBAyIE9AQBAyIE+RwB" +
+	"AyIEuAEBAyIEsgUBAyIEnQUBAyIEhQ8BAyIEiQoBAyIEsAUBAyIE+AQBAyIEsxMB" +
+	"AyIEqAIBAyIEyQYBAyIEmwsBAyIEmh0BAyIEjg8BAyIEugMBAyIE3gcBAyIEhhMB" +
+	"AyIEzwUBAyIE0QwBAyIE0AwBAyIE2BIBAyIEzVIBAyIE6w4BAyIE7A4BAyIE51IB" +
+	"AyIEg1MCAyIExBwBAyIEhFMBAyIEwxwBAyIEwhwDAyIE0FIBAyIE2FIBAyIE1VIB" +
+	"AyIE0VIBAyIE1FIBAyIE01IBAyIE1lICAyIE2VICAyIE3VIBAyIE2lIBAyIE3FIB" +
+	"AyIE21IDAyIE4AEBAyIE6gQBAyIE2AUBAyIElw0BAyIEsQoBAyIErgYBAyIElgcB" +
+	"AyIEvxYBAyIEhQMBAyIEowoBAyIE5ggBAyIEjR8BAyIEzQkBAyIEkwYBAyIEpggB" +
+	"AyIEtmIBAyIEtAEBAyIEiAUBAyIE4gUBAyIEkwoBAyIEqQsBAyIE+gMBAyIEQgED" +
+	"IgTjEwEDIgSrAgEDIgStEAEDIgSvDQEDIgSKEAEDIgTTFgEDIgSwCAEDIgSYBwED" +
+	"IgSxEAEDIgTOBgEDIgTyYwEDIgTPBgEDIgTZYwEDIgTdYwEDIgS7CwEDIgS0HwED" +
+	"IgTrYwEDIgT2YwIDIgT6YwEDIgT3YwEDIgT5YwEDIgT4YwMDIgStAQEDIgSiBAED" +
+	"IgSJBAEDIgT/CgEDIgSFEgEDIgSIBAEDIgTgAwEDIgTJDgEDIgTTAgEDIgSfEgED" +
+	"IgThBgEDIgTEGwEDIgTFGwEDIgSdEgEDIgTRCAEDIgS9TgEEIgSGBBQBBCIEhgQw" +
+	"AQQiBIYEOgEEIgSGBO0BAQQiBIYEzAMBBCIEhgQ4AQQiBIYENQEEIgSGBK8CAQMi" +
+	"BFoBAyIEqgUBAyIEkAUBAyIEiA4BAyIEsBEBAyIEvAUBAyIEgAUBAyIEg0QBAyIE" +
+	"vhECAyIEmw4BAyIEmQ4BAyIE0BkBAyIEmg4DAyIEvxEBAyIE1kQBAyIE1EQBAyIE" +
+	"0UQBAyIE00QBAyIE0kQBAyIE1UQCAyIE10QCAyIE20QBAyIE2EQBAyIE2kQBAyIE" +
+	"2UQDAyIErwIBAyIE6QcBAyIEtAUBAyIE/QwBAyIEyg8BAyIE/QQBAyIElgUBAyIE" +
+	"qmMBBCIEqgkUAQQiBKoJMAEEIgSqCToBBCIEqgntAQEEIgSqCcwDAQQiBKoJOAEE" +
+	"IgSqCTUCAyIEnwMBAyIEwAcBAyIE6wcBAyIE3R8BAyIEiA0BAyIEtQUBAyIEvwcB" +
+	"AyIEjWYBAyIEtgUBAyIEkxQBAyIEkBQBAyIE02UBAyIE2w8BAyIErQYBAyIEkhQB" +
+	"AyIE6mUBAyIEswEBAyIE5gMBAyIECQEDIgS8CwEDIgS9CwEDIgSrBgEDIgSmBAED" +
+	"IgTnZAEDIgS/AQEDIgTbBQEDIgTaBQEDIgSBFwEDIgTDCgEDIgSlBQEDIgTHBQED" +
+	"IgTbOAEDIgSbAwEDIgSZFwEDIgSYFwEDIgSTFwEDIgSWNQEDIgSVFwEDIgS7EAED" +
+	"IgSuNQEDIgSlAgEDIgTzCQEDIgSOCQEDIgSOGQEDIgTrCgEDIgSjBwEDIgTNBwED" +
+	"IgScGQEDIgTDAQEDIgSEBgEDIgSOBQEDIgT2CwEDIgT3CwEDIgSFCAEDIgTaBgED" +
+	"IgSUPQEDIgTEGAIDIgTHGAEDIgTLPQEDIgTGGAEDIgTFGAMDIgTZCgEDIgStGAED" +
+	"IgSqGAEDIgTFPAEDIgTJPAEDIgT5EAEDIgSrGAEDIgTdPAEDIgTqAgEDIgTHEwED" +
+	"IgSdCgEDIgTsDAEDIgTtDAEDIgTGAwEDIgTABQEDIgTJEwEDIgTOAgEDIgSuDQED" +
+	"IgTrBAEDIgTYFgEDIgSrDQEDIgSLBQEDIgTDBwEDIgSWLAEDIgSEAwEDIgSUUQED" +
+	"IgSEBQEDIgSGHAEDIgSKUQEDIgS7EgEDIgTYBwEDIgSXUQEDIgS+BAEDIgS8DwED" +
+	"IgShCgEDIgT5XgEDIgTbHgEDIgToBgEDIgS7DwEDIgSFXwEDIgTOXwEDIgTWXwED" +
+	"IgTSXwEDIgTPXwEDIgTRXwEDIgTQXwEDIgTUXwEDIgTVXwEDIgTTAwEDIgTCCAED" +
+	"IgTHBwEDIgTYDQEDIgTnCQEDIgSfBwEDIgTKBwEDIgSTGAEDIgSXAQEDIgSGBwED" +
+	"IgSTAwEDIgTTDgEDIgTSCAEDIgSxAwEDIgT1BQEDIgTODAEDIgSRVQEDIgT+CgED" +
+	"IgTMOwMHIh/4CxgQvgYqAQUiHxC+BioBBCIf+AsqAQMi8AMqAQIisWMBAyKSA6kC" +
+	"AQIi5wIBAiKyBwECIpsEAQMi+VGbBAEDIssEKgEDIsAM9QQBAyIOMQEDIg42AQMi" +
+	"DkEBAyIOTgEDIg5VAQMiDnUBAyIOgAEBAyIOdAEDIg6IAQEDIh2GAgEDIh2nAwED" +
+	"Ih29BAEDIh3cBAEDIh2FBAEDIh2OBgEDIh2TBAEDIh2zBgEDIh3tAwEDIh2GAQEE" +
+	"Ih2GAqsBBAQiBIkPuw4BAyIEvA4BAyIE9hoBAyIE+xEBBCIEiQ+/FgEDIgTBFgED" +
+	"IgTAFgEDIgTUKgEEIgSJD5MYAQMiBJYYAQMiBJUYAQMiBMo7AQQiBIkPzgwBAyIE" +
+	"6Q4BAyIEsxwBAyIE1xIBBCLnBiraZAEEIucGKs01AQQi5wYq6hwBBSLnBiq1Auoc" +
+	"AQQi5wYq8zUBBCLnBiqdQwEEIucGKvwrAQQi5wYq1D4BBCLnBirONQEEIucGKsFE" +
+	"BwNnABQBA2cAOAEDZwA6AQNnADUBA2cAMAEDZwDLAQEDZwDDAQEDZwBaAQNnAIUI" +
+	"AQNnAI4FAQNnANoGAQNnAIQGAQNnAMMYAQNnAIIBAQNnANQDAQNnAPYDAQNnAN8E" +
+	"AQNnANsGAQNnAPkNAQNnAKkBAQNnAKMDAQNnANUCAQNnAMwFAQNnAPIEAQNnAIUa" +
+	"AQNnAKgBAQNnAJAEAQNnALkEAQNnAK4FAQNnAN4DAQNnAK0BAQNnAIsRAQNnAP0R" +
+	"AQNnAIgEAQNnAIkEAQNnAOADAQNnAKIEAQNnAJsSAQNnALwcAQNnAL0cAQNnAL4c" +
+	"AQNnANwSAQNnAN0SAQNnAMEcAQNnALgBAQNnAGMBA2cAsAUBA2cAnQUBA2cA+AQB" +
+	"A2cAsgUBA2cAshMBA2cAvwEBA2cAtAEBA2cApQUBA2cA+gMBA2cA2gUBA2cA4gUB" +
+	"A2cAxwUBA2cA2wUBA2cAwxcBA2cAww0BA2cAzhMBA2cAzxMBA2cA0RMBA2cA0hMB" +
+	"A2cA0xMBA2cAwR4BA2cAvgQBA2cA6AYBA2cAoQoBA2cAuw8BA2cAvA8BA2cA4B4B" +
+	"A2cArwIBA2cA/QQBA2cAtAUBA2cAlgUBA2cA6QcBA2cAsx8BA2cAswEBA2cAqwYB" +
+	"A2cACQEDZwCmBAEDZwDmAwEDZwDWHwEDZwCoDAMEZwMAqwYBBGcDAAkBBGcDAKYE" +
+	"AQRnAwDmAwEEZwMA1h8BBGcDAKgMAwMSE6IHAQMSEzgBAxITvBUBAxITOgEDEhOS" +
+	"AgEDEhM1AQMSE6oDAQQSE7sHqgMBBBITX8wDAQQSE186AQMSExQBAxIT7QEBAxIT" +
+	"/QQBBBIT7AH9BAEDEhO0BQEEEhPsAbQFAQMSE6kfAQQSE+wBqR8BAxITlgUBBBIT" +
+	"7AGWBQEDEhOBDQEEEhPsAYENAQQSE8AGgQ0BAxITrwIBBBIT7AGvAgEDEhP9DAEE" +
+	"EhPsAf0MAQQSE8AG/QwBAxITigIBBBITuweOAgEEEhOGBKkCAQQSEzLfAQEEEhMy" +
+	"vgIBBhITMvQDbp8BAQYSEzKzA26fAQEGEhMyJW6fAQEEEhMynwEBBRITMjPfAQEH" +
+	"EhMyM7UCU6oTAQUSEzJMOwEGEhMytQKsAUgBBBITMmABBRITMnOMBAEDEhP8AgED" +
+	"EhPfAgEDEhPMAgEDEhPzAwEDEhOxAwEDEhOKUAEDEhOTAwEDEhPHDAEDEhP1BQED" +
+	"EhPMDAEEEhO7B8wMAQQSE1/0DQEEEhNf9gMBAxITlwEBAxIT0w4BAxIT1xIBBBIT" +
+	"7AHXEgEDEhPpDgEEEhPsAekOAQMSE7QcAQQSE+wBtBwBAxIT4gYBBBIT7AHiBgED" +
+	"EhO1HAEEEhPsAbUcAQMSE84MAQQSE+wBzgwBAxIT1hIBBBIT7AHWEgEEEhO7B9YS" +
+	"AQMSE/oBAQQSE+wB+gEBBBITX9QBAQMSE/oDAQMSE+hcAQMSE+IFAQMSE/ddAQMS" +
+	"E0IBAxITrQsBBBITuwetCwEEEhNfwwoBBBITX9oFAQMSE7QBAQMSE5MKAQMSE/gM" +
+	"AQQSE+wB+AwBAxIT8x4BBBIT7AHzHgEDEhP0HgEEEhPsAfQeAQMSEzYBBBIT7AE2" +
+	"AQMSE/YeAQQSE+wB9h4BAxIT4xMBBBIT7AHjEwEDEhPkEwEEEhPsAeQTAQQSE8AG" +
+	"5BMBAxITxQEBAxIT9AwBAxIT5h4BAxIT4RMBAxITmgMBAxITvAUBAxIT4kIBAxIT" +
+	"kAUBAxIT1kMBAxITgAUBAxITlgwBBBITuweWDAEDEhNaAQMSE4gOAQMSE5oOAQQS" +
+	"E+wBmg4BAxITmw4BBBIT7AGbDgEDEhPRGQEEEhPsAdEZAQMSE9IZAQQSE+wB0hkB" +
+	"AxIT0xkBBBIT7AHTGQEDEhO+EQEEEhPsAb4RAQMSE5kOAQQSE+wBmQ4BBBITwAaZ" +
+	"DgEDEhPHAQEDEhO9EQEEEhOdD7ERAQQSE50P1UMBBBITnQ+IRAEEEhOdD6EZAQMS" +
+	"E7AIAQMSE/kqAQMSE68NAQMSE6UsAQMSE5gHAQMSE6YQAQQSE7sHphABAxITqwIB" +
+	"AxITihABAxIT+RYBBBIT7AH5FgEDEhOzEAEEEhPsAbMQAQMSE/oWAQQSE+wB+hYB" +
+	"AxIT+xYBBBIT7AH7FgEDEhP8FgEEEhPsAfwWAQMSE7EQAQQSE+wBsRABAxITshAB" +
+	"BBIT7AGyEAEEEhPABrIQAQMSE/MBAQQSE40GwAkBAxITkAQBAxITkEgBAxITuQQB" +
+	"AxIT00kBAxITrgUBAxIT8hEBBBITuwfyEQEDEhOoAQEDEhPWEQEDEhP7EQEEEhPs" +
+	"AfsRAQMSE7wOAQQSE+wBvA4BAxIT9xoBBBIT7AH3GgEDEhP4GgEEEhPsAfgaAQMS" +
+	"E/kaAQQSE+wB+RoBAxITuw4BBBIT7AG7DgEDEhP6EQEEEhPsAfoRAQQSE8AG+hEB" +
+	"AxITsAEBBBIT7AGwAQEDEhO7SQEEEhPyFbABAQQSE40GsAEBAxITiAQBAxITkUsB" +
+	"AxITiQQBAxIToxsBAxIT4AMBAxITlRIBBBITuweVEgEDEhOtAQEDEhP/CgEDEhO+" +
+	"GwEEEhPsAb4bAQMSE8kOAQQSE+wByQ4BAxITnBIBBBIT7AGcEgEEEhPABpwSAQMS" +
+	"E5kBAQQSE1/OBQEDEhO1TAEDEhOjAwEDEhPzRAEDEhPVAgEDEhP8RQEDEhPMBQED" +
+	"EhP6CgEEEhO7B/oKAQMSE6kBAQMSE5oMAQMSE4caAQQSE+wBhxoBAxITiBoBBBIT" +
+	"7AGIGgEDEhOJGgEEEhPsAYkaAQMSE4oaAQQSE+wBihoBAxITixoBBBIT7AGLGgED" +
+	"EhPUEQEEEhPsAdQRAQMSE4YaAQQSE+wBhhoBAxITbwEEEhPsAW8BBBITzQFvAQMS" +
+	"E7AFAQMSE9hVAQMSE50FAQMSE+AMAQMSE/gEAQMSE+MMAQQSE7sH4wwBAxITuAEB" +
+	"AxIThQ8BAxIT+R0BBBIT7AH5HQEDEhP7HQEEEhPsAfsdAQMSE/wdAQQSE+wB/B0B" +
+	"AxIT/R0BBBIT7AH9HQEDEhP+HQEEEhPsAf4dAQMSE7MTAQQSE+wBsxMBAxITtBMB" +
+	"BBIT7AG0EwEEEhPABrQTAQMSE2MBBBIT8hVjAQMSE/cdAQQSE+YFYwEEEhOSSoha" +
+	"AQQSE8AGjloBBBITwAaYVQEEEhPABt1aAQQSE8AGw1sBBBITwAaPWgEEEhPABpdW" +
+	"AQMSE7oDAQMSE5sLAQMSE5APAQMSE94HAQMSE4MTAQMSE6gCAQMSE5odAQMSE44K" +
+	"AQQSE+wBjgoBAxITrR0BBBIT7AGtHQEDEhOuHQEEEhPsAa4dAQMSE68dAQQSE+wB" +
+	"rx0BAxITsB0BBBIT7AGwHQEDEhOGEwEEEhPsAYYTAQMSE6wdAQQSE+wBrB0BAxIT" +
+	"igoBAxITqwYBAxITmWQBAxITCQEDEhPWZAEDEhOmBAEDEhPUDwEEEhO7B9QPAQMS" +
+	"E7MBAQMSE7wLAQMSE9cfAQQSE+wB1x8BAxIT2B8BBBIT7AHYHwEDEhPZHwEEEhPs" +
+	"AdkfAQMSE9ofAQQSE+wB2h8BAxIT2x8BBBIT7AHbHwEDEhOMFAEEEhPsAYwUAQMS" +
+	"E40UAQQSE+wBjRQBBBITwAaNFAEDEhPiAQEEEhPsKeIBAQQSE+wB4gEBBBITjQYJ" +
+	"AQMSE/YFAQQSE8Uc9gUBBBIT7AGjAwEDEhO6UwEDEhOgBgEDEhOBDwEDEhP0BAED" +
+	"EhPzHAEEEhPsAcwFAQMSE5wBAQMSE9IMAQQSE+wBqQEBAxIT+hwBBBIT7AH6HAED" +
+	"EhNiAQQSE+wBYgEEEhPNAWIBAxITnwcBAxITxjoBAxITxwcBAxIT+joBAxITygcB" +
+	"AxIT6hABAxIT0wMBAxIT2A0BAxITlBgBBBIT7AGUGAEDEhOzAgEDEhPGAwEEEhP8" +
+	"EcYDAQMSE50KAQQSE/wRnQoBAxITwRMBBBIT/BHBEwEDEhPABQEDEhPEEwEDEhPq" +
+	"AgEDEhPsDAEDEhPKEwEEEhPsAcoTAQMSE8AJAQMSE+QeAQMSE+UeAQMSE70PAQMS" +
+	"E9ADAQMSE4lfAQMSE8ZfAQMSE8dfAQMSE8hfAQMSE8RfAQQSE6UJ1AMBBBITpQn2" +
+	"AwEEEhOlCdsYAQQSE6UJ3wQBBBITpQmEDAEEEhOlCYIBAQQSE6UJywcBBBITpQnU" +
+	"AQEEEhOVTtQBAQMSE+M+AQMSE8RSAQMSE9AMAQMSE7kcAQMSE+wOAQMSE9sSAQMS" +
+	"E88FAQMSE9gSAQMSE+4CAQMSE74eAQMSE78eAQMSE8AeAQMSE9ATAQQSE+wB9gUB" +
+	"BBIT7AGgBgEEEhPsAfQEAQQSE+wBnAEBAxIT9EsBAxITwQ4BAxITl0wBAxITgAoB" +
+	"AxITpkwBAxIToQIBAxITwA4BAxITzgUBAxITmU0BBBITjQa6AwEEEhONBpsLAQQS" +
+	"E40G3gcBBBITjQaoAgEEEhOOB8YDAQQSE44HnQoBBBITjgfABQEEEhOOB+oCAQQS" +
+	"E44HwAkBAxIT8hkBAxIT7EUBAxIT8xkBAxIT7UUBAxITkwkBAxIT8RkBAxITzBEB" +
+	"BBITwQnGAwEEEhPBCZ0KAQQSE8EJwRMBBBITwQnABQEEEhPBCcQTAQQSE8EJ6gIB" +
+	"BBITwQnsDAEEEhPBCcAJAQQSE4omPgEEEhPmBTgBBBIT5gU6AQQSE+YFNQEEEhPm" +
+	"BRQBBBIT5gX9BAEEEhPmBbQFAQQSE+YFlgUBBBIT5gWvAgEEEhPmBYgEAQQSE+YF" +
+	"iQQBBBIT5gXgAwEEEhPmBa0BAQQSE+YFvAUBBBIT5gWQBQEEEhPmBYAFAQQSE+YF" +
+	"WgEEEhONBtQDAQQSE40G9gMBBBITjQbfBAEEEhONBoIBAQQSE1/2PAEEEhNfshgB" +
+	"BBITX+0FAQQSE1/pPAEEEhNf8jwBBBITX68DAQQSE1+cBQEEEhNf9AQBBBITX/YF" +
+	"AQQSE1/UDAEEEhNfoAYBBBITX5wBAQQSE1/pBwEEEhNflgUBBBITX/0EAQQSE1/K" +
+	"DwEEEhNftAUBBBITX68CAQQSE1+eQAEEEhNfnUABBBITX5ARAQQSE1+bQAEEEhNf" +
+	"nEABBBITX5lAAQQSE1/HEwEEEhNfwAUBBBITX8YDAQQSE1/tDAEEEhNfnQoBBBIT" +
+	"X+oCAQQSE1+VXwEEEhNf4RMBBBITX/QMAQQSE1+IXwEEEhNf5h4BBBITX5oDAQQS" +
+	"E1+GBwEEEhNf9QUBBBITX7EDAQQSE1/SCAEEEhNfkwMBBBITX5cBAQQSE1/6AQEE" +
+	"EhNfhAYBBBITX9oGAQQSE1+FCAEEEhNf9wsBBBITX44FAQQSE1/DAQEEEhNfv0MB" +
+	"BBITX6UHAQQSE1+zEQEEEhNftkMBBBITX40OAQQSE1/RAgEEEhNf9EMBBBITX/ND" +
+	"AQQSE1/wQwEEEhNf8UMBBBITX/JDAQQSE1+3EQEEEhNfkA4BBBITX6IEAQQSE1/g" +
+	"AwEEEhNfiAQBBBITX4USAQQSE1+JBAEEEhNfrQEBBBITX94DAQQSE1+uBQEEEhNf" +
+	"kAQBBBITX+QRAQQSE1+5BAEEEhNfqAEBBBITX+YDAQQSE1+mBAEEEhNfqwYBBBIT" +
+	"X70LAQQSE18JAQQSE1+zAQEEEhNf8wkBBBITjQbzCQEEEhNfzQcBBBITX6MHAQQS" +
+	"E1/rCgEEEhNfjgkBBBITjQaOCQEEEhNfpQIBBBITX5cZAQQSE1+WGQEEEhNfk0IB" +
+	"BBITX5RCAQQSE1+UGQEEEhNfjQwBBBITX/IEAQQSE1/MBQEEEhNfowMBBBITX6AO" +
+	"AQQSE1/VAgEEEhNfqQEBBBITX6M2AQQSE1+iNgEEEhNfnTYBBBITX542AQQSE1+h" +
+	"NgEEEhNfww0BBBITX+5FAQQSE1/zGQEEEhNf6kUBBBITX+tFAQQSE1/yGQEEEhNf" +
+	"kwkBBBITX5BeAQQSE1/AHgEEEhNfvh4BBBITX45eAQQSE1+/HgEEEhNf0BMBBBIT" +
+	"X9MTAQQSE1/SEwEEEhNfzxMBBBITX41eAQQSE1/REwEEEhNfzhMBBBITX8AHAQQS" +
+	"E1+/BwEEEhNftQUBBBITX4gNAQQSE1/rBwEEEhNfnwMBBBITX94BAQUSE19X3gEB" +
+	"BBITX8oXAQQSE1/JFwEEEhNf/ggBBBITX8UXAQQSE1/HFwEEEhNf9QYBBBITX7IF" +
+	"AQQSE1/4BAEEEhNfsAUBBBITX4kKAQQSE1+dBQEEEhNfuAEBBBITX8kGAQQSE1/e" +
+	"BwEEEhNfugMBBBITX44PAQQSE1+bCwEEEhNfqAIBBBITX4oKAQQSE1+8DwEEEhNf" +
+	"uw8BBBITX+gGAQQSE1/bHgEEEhNfoQoBBBITX74EAQQSE1+uDQEEEhNfwwcBBBIT" +
+	"X4sFAQQSE1+rDQEEEhNf6wQBBBITX84CAQQSE1+TXwEEEhNfkl8BBBITX49fAQQS" +
+	"E1+QXwEEEhNfkV8BBBITX45fAQQSE+sEAQEEEhOSA6kCAQMSE7ccAQMSE/ZLAQMS" +
+	"E5xNAQMSE51NAQMSE55NAQMSE59NAQMSE5pNAQMSE5tNAQQSE44H410BBBITjgfk" +
+	"XQEEEhOOB+VdAQQSE44H5l0BBBITjgfnXQEEEhOOB8kTAQQSE44HyhMBBRITjgcy" +
+	"wAkBBBIT5gWOAgEDxwSxBSoBA8cEANkpAQPHBADCRgEDxwQA5DoBA8cEAN1VAQPH" +
+	"BADVTAEDxwQAlGABA8cEAP80AQPHBACDXgEDxwQAsC0BA8cEAL8rAQPHBACySgED" +
+	"xwQAoz0BA8cEAP1LAQPHBACnWwEDxwQA91QBA8cEAIYmAQPHBADYGwEDxwQA5QgB" +
+	"A8cEAJg5AQPHBADdQQEDxwQAlzkBA8cEAN0BAQPHBACjYAEDxwQArEEBA8cEAJk5" +
+	"AQPHBADrUAEDxwTZDSoBBMcE+AHZDSoEBu4BAOg66zqfB7MCAQPuAQDLAQEF7gEA" +
+	"y2DlCDABA+4BAM0fAQPuAQDiAQED7gEAjgIBBe4BAOBd4V3FCQED7gEAgQkBBO4B" +
+	"AMgmFAEE7gEA3xs1AQTuAQCKDRQBA+4BAMoVAQTuAQCsRjUBBO4BALNZNQED7gEA" +
+	"NQED7gEAxAMBA+4BAMwCAQbuAQDFU+8O7lNiAQPuAQCEQwED7gEAwysBBO4BAIND" +
+	"xwEBA+4BAMEBAQPuAQCdBwEF7gEAsjzTPcEBAQPuAQDBBAEF7gEAw2POD44CAQTu" +
+	"AQD0PdQBAQTuAQDwPdQBAQTuAQCqRtQBAQTuAQCwWdQBAQbuAQCfS6VOnkuZAQEE" +
+	"7gEAslmZAQED7gEAsjgBBu4BAOdB6ArrQToBA+4BADgBBO4BAIJCkwIBA+4BAL88" +
+	"AQTuAQCpRpUEAQTuAQCuWRQBBO4BAO9BzzkBBe4BAPlQ9FD6AQEE7gEAmSbQOQEE" +
+	"7gEAvFpjAQXuAQDrWa1GYwEE7gEAtFljAQPuAQDzAQED7gEA3gEBBu4BAIpengrN" +
+	"X8UBAQTuAQC1WcUBAQPuAQDSAQEG7gEA4CneKYcqPgEE7gEAr1k+AQPuAQCzOAED" +
+	"7gEA4E8BBe4BAKc5/Qc4AQXuAQC0SK0FsAEBBO4BAKtGsAEBBO4BALFZsAEBBu4B" +
+	"AMFFgkWkRm8BA+4BALE4AQPuAQDNQQED7gEAlxEBBe4BAPc08zTSAQEF7gEA7U/j" +
+	"OTUBA+4BAOIJAQPuAQDfQQED7gEAijQBA+4BAIQrAQPuAQC6KwED7gEAjlsBA+4B" +
+	"AK5GAQPuAQC2WQED7gEA6EABA+4BAO4CAQPuAQD8AQED7gGMAsQBAQPuAf0GxAEB" +
+	"A+4BkQPEAQED7gHxJgsBA+4Btl8LAQPuAdopCwED7gEAxwEBA+4BAIoKAQPuAQCq" +
+	"AwEF7gEA9AvSC98bAQXuAQD0C9IL6AoBBe4BAPQL0gv9BwEF7gEA9AvSC4oNAQXu" +
+	"AQD0C9ILyhUIA58FABQBA58FADoBA58FADABA58FAFoBA58FAMMBAQOfBQChAgED" +
+	"nwUAtAEBA58FAL8BAQOfBQCtAQEDnwUAlwEBA58FAOABAQOfBQCoAQEDnwUAswEB" +
+	"A58FAJwBAQOfBQCpAQEDnwUArwIBA58FALgBAQOfBQCCAQEEnwUQAToBBJ8FEAEw" +
+	"AQOfBQHWAwEDnwUB7hsKBJ8FANkDnAEBA7sFABQBA7sFADoBA7sFADABA7sFAFoB" +
+	"A7sFAMMBAQO7BQChAgEDuwUAtAEBA7sFAL8BAQO7BQCtAQEDuwUAlwEBA7sFAOAB" +
+	"AQO7BQCoAQEDuwUAswEBA7sFAJwBAQO7BQCpAQEDuwUArwIBA7sFALgBAQO7BQCC" +
+	"AQEEuwUQAToBBLsFEAEwAQO7BQHuGwEDiByMAsQBAQOIHDPEAQoDwwUAFAEDwwUA" +
+	"OgEDwwUAMAEDwwUAWgEDwwUAwwEBA8MFAKECAQPDBQC0AQEDwwUAvwEBA8MFAK0B" +
+	"AQPDBQCXAQEDwwUA4AEBA8MFAKgBAQPDBQCzAQEDwwUAnAEBA8MFAKkBAQPDBQCv" +
+	"AgEDwwUAuAEBA8MFAIIBAQTDBRABOgEEwwUQATANA/oFABQBA/oFADoBA/oFADAB" +
+	"A/oFAFoBA/oFAMMBAQP6BQChAgED+gUAtAEBA/oFAL8BAQP6BQCtAQED+gUAlwEB" +
+	"A/oFAOABAQP6BQCoAQED+gUAswECA/oFAKkBAQP6BQCvAgED+gUAuAECBPoFEAE6" +
+	"AQT6BRABMA0DfABaAQN8ANECAQN8AIAFAQN8AKUHAQN8AIAKAQN8AKsCAQN8AM4C" +
+	"AQN8AJgHAQN8AMMHAQN8ANEIAQN8AL8BAQN8ANADAQN8AMcFAQN8AL0PAQN8AKFN" +
+	"AQN8ALQBAQN8AOoCAQN8AEIBA3wAwAUBA3wA4AMBA3wA4AEBA3wAhAMBA3wA9QUB" +
+	"A3wA2AcBA3wArgUBA3wApgQBA3wA9AQBA3wAzAUBA3wApggBA3wAqAIBA3wAnxMB" +
+	"A3wAuAEBA3wAggEBA3wAqQEBA3wAzwUBBHyPBRDJUgEEfI8FENgSAQR8jwUQ0AwB" +
+	"BHyPBRC5HAEEfI8FENEMAQR8jwUQ+FIBBHyPBRDdEgEEfI8FEIFTAQR8jwUQ+xwB" +
+	"BHyPBRCXVQEEfI8FENURAQR8jwUQ2EYBBHyPBRDrDgEEfI8FELccAQZ8jwUQ2xLA" +
+	"DzEBBnyPBRDbEsAPNgEEfI8FEMtSAQR8EIMZxwsBBHwQgxntAQEEfBAB7QEBBHwQ" +
+	"AToBBHwQAZICAQR8EAHiAQEEfBABjhQBBHwQATABBHwQAaoCAQR8EAGxCwEEfBAB" +
+	"xAMBBHwQAbMBAQR8EAGIBgEEfBABOAEEfBABlQQBBHwQAYoCAQR8EAGqAwEEfBAB" +
+	"ogIBA3wBz0wBA3wB3FMBA3wBi2UBA3wBy0oBA3wBzF4BA3wBoCkBA3wBxVQBA3wB" +
+	"nF4BA3wB7UIBA3wBgiYBBHwB8lX3VQEDfAGUYgEDfAGtLQEDfAGsKQEDfAGzQwED" +
+	"fAGkKQEFfAGHK8cMxkQBBHwB0wetCwEDfAHiKQEEfAGQUfIaAQN8AY5EAQR89wcL" +
+	"mVQBA3wBsCcBA3wBoicDA3wO4wEBA3wOMQEDfA42AQN8DkEBA3wOTgEDfA5VAQN8" +
+	"DnUBA3wOgAEBA3wOdAEDfA6IAQcFfAvTB+8HsloBBXwL0wfvB/gRAQV8C9MH7wfH" +
+	"DAEFfAvTB+8H+w8BBXwL0wfvB4cQAQV8C9MH7wfKEgEFfAvTB+8HzRIBBXwL0wfv" +
+	"B84SAQV8C9MH7wfLEgEFfAvTB+8HzBIHAmnSBgECaf8IAQJp5wIBA2mSA6kCAQJp" +
+	"mwQBA2lOiwEBBGnNBOUGogcBBWmeBQSdKpoJAQNpvQXnAgEEab0FkgOpAgECaddM" +
+	"AQVp9QtD3AwxAQVp9QtD3Aw2AQVp9QtD3AxBAQNpEPUEAQVp9QtD3AxOAQNpDuMB" +
+	"AQNpDjEBA2kONgEDaQ5BAQNpDk4BA2kOVQEDaQ51AQNpDoABAQNpDnQBA2kOiAEH" +
+	"A2kAFAEDaQA4AQNpADoBA2kANQEDaQAwAQNpAMQDAQNpAKAFAQNpAMwDAQNpAK0B" +
+	"AQNpAO4GAQNpAOABAQNpAJcBAQNpAM8FAQNpAMMBAQNpAKgBAQNpAKkBAQNpALgB" +
+	"AQNpAKgCAQNpALQBAQNpAL8BAQNpAM4CAQNpAKUCAQNpALMBAQNpAJwBAQNpAK8C" +
+	"AQNpANMDAQNpAFoBA2kA0QIBA2kAvgQBA2kAnwMBA2kAywcBA2kAgmYBA2kAkwkB" +
+	"A2kAkBQBA2kA6wQBBmkAzQSqARABAQRpAM0EOAEEaQDNBDoBBGkAzQQ1AQRpAM0E" +
+	"MAEEaQDNBMQDAQRpAM0EoAUBBGkAzQTuBgEEaQDNBOABAQRpAM0ElwEBBGkAzQTP" +
+	"BQEEaQDNBMMBAQRpAM0EqAEBBGkAzQS0AQEEaQDNBL8BAQRpAM0EzgIBBGkAzQSl" +
+	"AgEEaQDNBL4EAQRpAM0EswEBBGkAzQSvAgEEaQDNBFoBBGkAzQT2CwEEaQDNBMsH" +
+	"AQRpAM0EikIBBGkAzQTCTAEEaQDNBPUGAQRpAJ4FOAEEaQCeBToBBGkAngWhEQEE" +
+	"aQCeBaAFAQRpAJ4FMAEEaQCeBe4GAQRpAJ4FWgEEaQCeBcMBAQRpAJ4FggEBBGkA" +
+	"ngWXAQEEaQCeBagCAQRpAJ4FtAEBBGkAngW/AQEEaQCeBaUCAQRpAJ4F0wMBBGkA" +
+	"ngX2CwEEaQCeBcsHAQRpAJ4FvgQBBGkAngWfAwEEaQCeBdIMAQRpAJ4FzgIBBGkA" +
+	"ngW2BQEEaQC9BToBBGkAvQVaAQRpAL0FnAEBBGkAvQXTAwEEaQC9BbYFAQZpAM4C" +
+	"AjaLAQgGaQDHA8gDjAMxAQZpAMcDyAPxAzEBBWkAxwPIA4M1AQVpAMcDyAP+HgEG" +
+	"aQDHA8gDzwL+HgEFaQDHA8gDhRYBBmkAxwPIA0GFFgEFaQDHA8gDFAEFaQDHA8gD" +
+	"OgEFaQDHA8gDWgEFaQDHA8gDoQIBBWkAxwPIA6sCAQVpAMcDyAOaAwEFaQDHA8gD" +
+	"0AMBBWkAxwPIA5sDAQVpAMcDyAO/AwEFaQDHA8gDtAEBBWkAxwPIA78BAQVpAMcD" +
+	"yAOXAQEFaQDHA8gDhAMBBWkAxwPIA+MDAQVpAMcDyAO2BQEFaQDHA8gDnwMBBWkA" +
+	"xwPIA5AHAQZpAM0ExwPIA7QBAQZpAM0ExwPIA7YFAQZpAL0FxwPIA68DAQZpAL0F" +
+	"xwPIA6ECAQZpAL0FxwPIA6sCAQZpAL0FxwPIA/8DAQZpAL0FxwPIA5oDAQZpAL0F" +
+	"xwPIA9sDAQZpAL0FxwPIA7QBAQZpAL0FxwPIA6kDAQZpAL0FxwPIA+MDAQZpAL0F" +
+	"xwPIA/4WAQZpAL0FxwPIA7YFAQZpAL0FxwPIA58DAQZpAMcDyANuMAEGaQDHA8gD" +
+	"brMBAQZpAL0FxwPIA8EDAQVpAMcDyAP2NAEGaQC9BccDyAOTCQYDEhOsEgEDEhOR" +
+	"BwEDEhO+FQEDEhP/DAEDEhPxUQEDEhPcUAEDEhO1UgEDEhOPXQEDEhOvEQEDEhPM" +
+	"RAEDEhPFGgEDEhO7TQEDEhOkSwEDEhPDRQEDEhO+WgEDEhOKHQEDEhOnHQEDEhOR" +
+	"WQEDEhPVWQEDEhPwZAEDEhPAHwEDEhPNHAEDEhO/GwEEEhOuB78bAQMSE8AbAQQS" +
+	"E64HwBsBAxITwRsBBBITrgfBGwEDEhPCGwEEEhOuB8IbAQMSE4xVAQMSE41VAQMS" +
+	"E45VAQMSE49VAQMSE5BVAQMSE/kcAQQSE64H+gEBBBITrgfFAQEEEhOuB8cBAQQS" +
+	"E64H8wEBBBITrgewAQEEEhOuB5kBAQQSE64HYwEEEhOuB4oKAQQSE8oNjgIBBBIT" +
+	"qAiOAgEFEhMyA58BAQUSEzKRBDsBBBITxRyLVQEEEhPsAfoKAQQSE+wBmgwBAxIT" +
+	"4l0BAxITyRMBAxITjV8BAxITi18BAxIT4BMBAxITkV4BAxITj14BBBITjQaOCgEE" +
+	"EhONBoMTAQQSE40GhAwBBBITX8w9AQUSE1++EPcLAQQSE1/2CwEEEhNfxBgBBBIT" +
+	"jQbBQgEEEhNfnBkBBRIT+Q++EG8BBRIT+Q++EGIBBRITX78QYwsDyAKrYgABA8gC" +
+	"AFoBA8gCANECAQPIAgDDAQEDyAIArwMBA8gCAKECAQPIAgCrAgEDyAIAzgIBA8gC" +
+	"AKUCAQPIAgD/AwEDyAIAux8BA8gCALQBAQPIAgDqAgEDyAIAvwEBA8gCAKkDAQPI" +
+	"AgCtAQEDyAIAlwEBA8gCAIQDAQPIAgDgAQEDyAIAwQMBA8gCAKgBAQPIAgCzAQED" +
+	"yAIAnAEBA8gCAKkBAQPIAgCvAgEDyAIAqAIBA8gCAOMDAQPIAgC4AQEDyAIAggEB" +
+	"A8gCANA9AQPIAgDXEwIEyAIQARQBBMgCEAE6AQTIAhABMAEEyAIQAcwDAQTIAhAB" +
+	"igIBBMgCEAGqAwEEyAIQAaICAQTIAhABOAEEyAIQATUBBMgCgAIAswEBBMgCgAIA" +
+	"nAEBBMgCgAIArwIFBMgCAwBaAQTIAgMAoQIBBMgCAwCMAwEEyAIDALQBAQTIAgMA" +
+	"rQEBBMgCAwCXAQEEyAIDAKgBAQTIAgMAnAEBBMgCAwCpAQEDyAIBtUoBA8gCAZxD" +
+	"AQPIAgHWVQUDyAIB+goEA8gC7AQqAQPIAssEKgEDyAIO4wEBA8gCDjEBA8gCDjYB" +
+	"A8gCDkEBA8gCDk4BA8gCDlUBA8gCDnUBA8gCDoABAQPIAg50AQPIAg6IAQEENKMD" +
+	"AFoBBDSjAwDOBgEENKMDAKECAQQ0owMAvgQBBDSjAwC4AQEENKMDALMBAQQ0owMA" +
+	"tAEBBDSjAwDqAgEENKMDAKkBAQQ0owMAlwEBBDSjAwCEAwEENKMDAKgBAQQ0owMA" +
+	"0wMBBDSjAwCFAwEENKMDAIIBAQQ0owMAzwUBBDSjAwDRAgEENKMDAMsGAQQ0owMA" +
+	"rQEBBDSjAwAUAQQ0owMAOgEENKMDAMwDAQQ0owMA/QcBBDSjAwAwAQQ0owMAqgMB" +
+	"BDSjAwA1AQQ0owMAoAUBBDSjAwA4AQQ0owMA9BUBBDSjAwCKAgMENKMDAKAIAQQ0" +
+	"owMA4ggBBDSjAwCfCgEENKMDAKEIAQQ0owMAtA8MBvUBNP4BAI4BzwUBBvUBNP4B" +
+	"AIcBzwUBBvUBNP4BAI4BWgEG9QE0/gEAjgHOBgEG9QE0/gEAjgGhAgEG9QE0/gEA" +
+	"hwFaAQb1ATT+AQCHAc4GAQb1ATT+AQCHAaECAQb1ATT+AQCOAb4EAQb1ATT+AQCO" +
+	"AbgBAQb1ATT+AQCOAbMBAQb1ATT+AQCHAb4EAQb1ATT+AQCHAbgBAQb1ATT+AQCH" +
+	"AbMBAQb1ATT+AQCOAbQBAQb1ATT+AQCOAeoCAQb1ATT+AQCOAa0BAQb1ATT+AQCH" +
+	"AbQBAQb1ATT+AQCHAeoCAQb1ATT+AQCHAa0BAQb1ATT+AQCOAZcBAQb1ATT+AQCO" +
+	"AYQDAQb1ATT+AQCOAagBAQb1ATT+AQCHAZcBAQb1ATT+AQCHAYQDAQb1ATT+AQCH" +
+	"AagBAQb1ATT+AQCOAdMDAQb1ATT+AQCOAYUDAQb1ATT+AQCOAakBAQb1ATT+AQCH" +
+	"AdMDAQb1ATT+AQCHAYUDAQb1ATT+AQCHAakBAQb1ATT+AQCOAYIBAQb1ATT+AQCO" +
+	"Ab8BAQb1ATT+AQCOAeABAQb1ATT+AQCHAYIBAQb1ATT+AQCHAb8BAQb1ATT+AQCH" +
+	"AeABAQb1ATT+AQCOAc8ZAQb1ATT+AQCOAbUfAQb1ATT+AQCHAc8ZAQb1ATT+AQCH" +
+	"AbUfAQb1ATT+AQCOAawTAQb1ATT+AQCHAawTBQf1ATT+ARABELhZAQb1ATT+ARAB" +
+	"7QEBBvUBNP4BEAGSAgEG9QE0/gEQATABBvUBNP4BEAGqAgEG9QE0/gEQATgBBvUB" +
+	"NP4BEAGVBAEG9QE0/gEQATUBBvUBNP4BEAG3DAEG9QE0/gEQAaAFAQb1ATT+ARAB" +
+	"kQcBBvUBNP4BEAG+FQEG9QE0/gEQAYsfAQb1ATT+ARABrBIBBvUBNP4BEAHLGwEG" +
+	"9QE0/gEQAaBgAQb1ATT+ARABoREBBvUBNP4BADLLAQEG9QE0/gEAMs4FAQb1ATT+" +
+	"AQAymQEBBvUBNP4BADKwAQEG9QE0/gEAMscBAQb1ATT+AQAy0gEBBvUBNP4BADI+" +
+	"AQX1ATT+AYkBoAwBBfUBNP4BiQGhDAcF9QE0/gEO4wEBBfUBNP4BDjEBBfUBNP4B" +
+	"DjYBBfUBNP4BDkEBBfUBNP4BDk4BBfUBNP4BDlUBBfUBNP4BDnUBBfUBNP4BDoAB" +
+	"AQX1ATT+AQ50AQX1ATT+AQ6IAQEG9QE0/gGEAQ4xBAX1ATT+AQHXGQEF9QE0/gEB" +
+	"gUUBA3wL11ABBHwL+BG7BgEEfAvHDLsGAQR8C/sPuwYBBHwLhxC7BgEEfAvKErsG" +
+	"AQR8C80SuwYBBHwLzhK7BgEEfAvLErsGAQR8C8wSuwYBBHwLthC7BgEEfAuIF7sG" +
+	"AQR8C4kXuwYBBHwLhhe7BgEEfAuHF7sGAQR8C4oXuwYBA3wLsV8BBHwL+BHEBgEE" +
+	"fAvHDMQGAQR8C/sPxAYBBHwLhxDEBgEEfAvKEsQGAQR8C80SxAYBBHwLzhLEBgEE" +
+	"fAvLEsQGAQR8C8wSxAYBBHwLthDEBgEEfAuIF8QGAQR8C4kXxAYBBHwLhhfEBgEE" +
+	"fAuHF8QGAQR8C4oXxAYBA7YEAFoBA7YEAMMBAQO2BAChAgEDtgQAmhsBA7YEAJcB" +
+	"AQO2BADgAQEDtgQAqAEBA7YEAPAaAQO2BAC0AQEDtgQAvwEBA7YEAK0BAQO2BACW" +
+	"EgEDtgQAqwIBA7YEAKUCAQO2BADTAgEDtgQApE4BA7YEALMBAQO2BACcAQEDtgQA" +
+	"qQEBA7YEAIUDAQO2BAC4AQEDtgQAFAEDtgQAggEBBLYEEAE6AQS2BBABMAEEtgQQ" +
+	"ATgBBLYEEAE1AQS2BBABlQQDArYEnlABBLYEqQRK8AMBBTSEAQCOAVoBBTSEAQCO" +
+	"AdECAQU0hAEAjgG/EQEFNIQBAIcBWgEFNIQBAIcBvxEBBTSEAQCHAdECAQQ0hAEA" +
+	"oQIBBTSEAQCOAasCAQU0hAEAjgHOAgEFNIQBAIcBqwIBBTSEAQCHAbgBAQU0hAEA" +
+	"hwHOAgEENIQBANMCAQQ0hAEA0FMBBTSEAQCOAeASAQQ0hAEAvwEBBTSEAQCHAeAS" +
+	"AQQ0hAEAzBwBBTSEAQCOAbQBAQU0hAEAjgHqAgEFNIQBAIcBtAEBBTSEAQCHAeoC" +
+	"AQQ0hAEArQEBBDSEAQDgAQEFNIQBAI4BlwEBBTSEAQCOAYQDAQU0hAEAjgHTAwEF" +
+	"NIQBAIcBlwEBBTSEAQCHAdMDAQU0hAEAhwGEAwEENIQBAKgBAQU0hAEAhwGzAQEF" +
+	"NIQBAI4BswEBBDSEAQCcAQEENIQBAPVUAQQ0hAEAqQEBBDSEAQD+AQEENIQBAK8C" +
+	"AQU0hAEAjgGoAgEFNIQBAI4B4wMBBTSEAQCOAbgBAQU0hAEAjgGCAQEENIQBAOAE" +
+	"AQQ0hAEAFAEFNIQBAIcBggEBBDSEAQA6AQQ0hAEAkgIBBDSEAQAwAQQ0hAEAqgIB" +
+	"BDSEAQDMAwEENIQBAKoDAQQ0hAEA1xkBBTSEAQDcCrgBAQY0hAHxAQHNAZwBAQY0" +
+	"hAHxAQHNAakBAQc0hAHxAQGpAfxcnQ4BBjSEAQGsBYkOnQ4BBjSEAfEBATKhAgEG" +
+	"NIQB8QEBhwGXAQEJNIQB8QEBjgHgEt0BhwGXAQEFNIQB8QEBqAEBBTSEAfEBAeAB" +
+	"AQU0hAHxAQG4AQIENIQBAeRVAQU0hAEQARQBBjSEARABrAXwEgEFNIQBEAHtAQEG" +
+	"NIQBEAGnC+0BAQU0hAEQAToBBTSEARABkgIBBTSEARABoAUBBTSEARAB6xMBBTSE" +
+	"ARABMAEFNIQBEAGqAgEFNIQBEAE1AQY0hAEQAbcMOQEFNIQBEAGsEgEFNIQBEAE4" +
+	"AQU0hAEQAZUEAQU0hAEQAaoDAQU0hAEQAYoCAQY0hAEQAYQBigIBBjSEARABtwwm" +
+	"AQU0hAEBrAWJDgEENIQBAfEMAQQ0hAEBoAgBBTSEAQG0EeIIAQU0hAEBtBGfCgEF" +
+	"NIQBAbQRoQgBBTSEAQGcAe09AQU0hAEBrAXvEgEFNIQBAcJD+EIDBTSEAR+4CDsB" +
+	"BTSEAYgIDuMBAQU0hAGICA4xAQU0hAGICA42AQU0hAGICA5BAQU0hAGICA5OAQU0" +
+	"hAGICA5VAQU0hAGICA51AQU0hAGICA6AAQEFNIQBiAgOdAEFNIQBiAgOiAEHBTSE" +
+	"AYQBDuMBAQU0hAGEAQ4xAQU0hAGEAQ42AQU0hAGEAQ5BAQU0hAGEAQ5OAQU0hAGE" +
+	"AQ5VAQU0hAGEAQ51AQU0hAGEAQ6AAQEFNIQBhAEOdAEFNIQBhAEOiAEHBDSEAQGX" +
+	"YwEENIQBAZhjAQQ0hAEBhVYBBDSEAQGeQwEENIQBAYFAAQQ0hAEBqjYBBjSEAQH4" +
+	"AdsCzBwBBTSEAQGsBa1kAQQ0hAEB40IBBDSEAQHkQgEENIQBAf5VAQQ0hAEB/1UB" +
+	"BDSEAQGHPgEENIQBAfoqAwQf5AuLAsQCAQIf6zUBAh/mCgEDH7YBGwEDH/ACOwED" +
+	"H8xjOQEEH7oLvwI5AQQfsQEqOQEFHzOxASo5AQUfeqgNSDkBBR/qHagNSDkBAx/X" +
+	"DiYBBB8z1w4mAQMf1w45AQMf1w6bBQEGHwcDAI4COQEHHwcDAKABjgI5AQUfJ7cD" +
+	"JicBBR8ltwMmJQEFHye3AzknAQUfJbcDOSUBBB8rpSomAQQfHQEmAQUfzwIz6BUm" +
+	"AQQfYAEmAQUfM2ABJgEFHzNgATkBBB/wAt8BxAIBBh8HAwDJBcEBAQYfBwMAyQVi" +
+	"AQYfBwMAyQXFATIElgEBxA+XVAEElgEBxA+wBgEDlgEBuysBA5YBAfYdAQOWAQH+" +
+	"KQEDlgEAzRUBBJYBAM0VtQcBA5YBAIAZAQSWAQCAGbUHAQOWAQCBHwEElgEAgR+1" +
+	"BwEElgEAnAGTCwEFlgEAnAGTC7UHAQSWAQCpAaIOAQWWAQCpAaIOtQcBA5YBAK05" +
+	"AQOWAQCFJgEDlgEA0BsBBJYBANAbtQcBA5YBAFoBBJYBAFqZCQEDlgEAwwEBBJYB" +
+	"AMMBnz0BA5YBAKECAQOWAQCrAgEElgEAqwL8RAEDlgEApQIBBJYBAKUCgUIBA5YB" +
+	"ANMCAQSWAQC0Ab1FAQWWAQC0AaoHmQkBBZYBAL8BqgehJgEFlgEAvwGqB5kJAQSW" +
+	"AQCtAclTAQOWAQC0AQEElgEAtAGMXQEDlgEAvwEBBJYBAL8BlUgBA5YBAK0BAQOW" +
+	"AQCXAQEElgEAlwH0QgEDlgEA4AEBBJYBAOABm0MBA5YBAKgBAQOWAQCzAQEDlgEA" +
+	"nAEBA5YBAKkBAQOWAQCvAgEElgEAuAHaVQEElgEAuAH6VQEDlgEAuAEBA5YBAIIB" +
+	"AQOWAQH8UwEElgEQAbUHAQSWARABxA8BBZYBEAHED4gdAQSWARABpw8BBZYBEAGn" +
+	"D7JBAQWWARABnAGTCwEGlgEQAZwBkwu1BwEFlgEQAakBog4BBpYBEAGpAaIOtQcB" +
+	"BJYBEAHpDAEFlgEQAekMkwsBBZYBEAHpDLUHAQaWARAB6QyTC7UHAQSWARABtxIB" +
+	"BZYBEAG3ErUHAQOWAcALwAsBBJYBAJ0DsgkBBJYBAL1DsgkBBJYBANNfsgkBBJYB" +
+	"APwHsgkBBJYBAJMGsgkBBJYBANgPsgkBBJYBAJUnsgkBBJYBANkDmhkEA5YBDuMB" +
+	"AQOWAQ4xAQOWAQ42AQOWAQ5BAQOWAQ5OAQOWAQ5VAQOWAQ51AQOWAQ6AAQEDlgEO" +
+	"dAEDlgEOiAEBApYB8BsBApYB7BsBApYBqx8BA5YBjhCmUAEDlgGOEOxZAQOWAY4Q" +
+	"xFABApYBolABBJYBIwu2FwEElgEjC8Y1AQSWASMLwhcBBJYBIwu3DQEFlgEjC7cN" +
+	"9h0BBJYBIwuCNgEElgEjC/Q0AQSWASML0jUBBJYBIwv+NAEFlgEjC7cNszwBBZYB" +
+	"Iwsfo10BBZYBIwsfwTkBBZYBIwsfqhkBBZYBIwsfqRkBBZYBIwsfoxEBB5YBIwsf" +
+	"qhkCoxEBB5YBIwsfqRkCoxEBBZYBIwsf3CkBBZYBIwsfmz0BBpYBIwv+DrEBjgQB" +
+	"BpYBIwv+DrEB2wQBBpYBIwv+DsID6R4BBpYBIwv+DsID81wBBpYBIwucDLEBrFAB" +
+	"BpYBIwucDLEBoFIBBpYBIwucDMIDv1EBBpYBIwucDMIDyVEBBpYBIwucDLEBwBIB" +
+	"A5YB8BvgGQEDlgHsG+AZAgO2AgG6UAEDtgIBsFABA7YCAbNQAQO2AgAUAQO2AgA6" +
+	"AQO2AgAwAQO2AgCVBAEDtgIANQEDtgIAOAEDtgIAvggBA7YCAFoBA7YCAM8FAQO2" +
+	"AgDDAQEDtgIAoQIBA7YCAKsCAQO2AgClAgEDtgIAnwMBA7YCANMCAQO2AgC0AQED" +
+	"tgIAvwEBA7YCAK0BAQO2AgCXAQEDtgIA0wMBA7YCAIUDAQO2AgDgAQEDtgIAqAEB" +
+	"A7YCALMBAQO2AgCcAQEDtgIAqQEBA7YCAK8CAQO2AgC4AQEDtgIAzgYBA7YCAIIB" +
+	"AQS2AvEBAaRQAQS2AvEBAbhQAQS2AvEBAbtQAQS2AhABrVABBLYCEAG8UAEEtgIQ" +
+	"AadQAQS2AhABtFABBLYCEAGjUAEEtgIQAatQAQO2AgGhUAEDtgIB1gMBBbYC8QEB" +
+	"9RuoAQEFtgLxAQH1G68CAQO2AgDRAgEDtgIA/lsBA7YCDuMBAQO2Ag4xAQO2Ag42" +
+	"AQO2Ag5BAQO2Ag5OAQO2Ag5VAQO2Ag51AQO2Ag6AAQEDtgIOdAEDtgIOiAEBArYC" +
+	"lQYBA7YCAIZUAQO2AgDvGQEDtgIAwQMBBLYCADLHAQEEtgIAMrABAQP7AgAUAQT7" +
+	"AgDfBxQBA/sCAIIBAQT7AgDfB4IBAQT7AgDaEYIBAQP7AgDgAQEE+wIArRHgAQED" +
+	"+wIAlwEBBPsCAN8HlwEBA/sCAK0BAQT7AgDaEa0BAQP7AgCvAgEE+wIA3wevAgEE" +
+	"+wIA6huvAgED+wIAwwEBBPsCAN8HwwEBA/sCAKUCAQP7AgC/AQED+wIAnAEBBPsC" +
+	"AN8HnAEBA/sCAKgBAQT7AgDfB6gBAQT7AgDUHbQBAQT7AgCvG7QBAQP7AgC4AQEE" +
+	"+wIA3we4AQEE+wIA2hG4AQED+wIAswEBBPsCAN8HswEBA/sCAKECAQP7AgCpAQEE" +
+	"+wIA3wepAQED+wIA0wIBA/sCAKsCAQP7AgCCCwED+wIAogwBA/sCADoBA/sCADAB" +
+	"A/sCAaJeAQT7AhABOAEF+wIQAeobOAEE+wIQAcwDAQT7AhABOgEF+wIQAa0ROgEE" +
+	"+wIQATUBBfsCEAGtETUBBPsCEAEwAQf7AhABMCjfB7gBAQT7AvEBAc4FAQT7AvEB" +
+	"AdQBAQL7Au8bAQL7ArVQCQX7AgvwBq0BiUkBBPsCC/AGr1EBBPsCC/AGskIBBPsC" +
+	"C/AG7xsBA6cCAFoBA6cCAPZDAQOnAgDRAgEDpwIAwwEBA6cCAIw9AQOnAgChAgED" +
+	"pwIAqwIBA6cCAM4CAQOnAgClAgEDpwIA0wIBA6cCALQBAQOnAgDqAgEDpwIAvwEB" +
+	"A6cCAK0BAQOnAgCXAQEDpwIA4Q4BA6cCAIQDAQOnAgDTAwEDpwIAjTsBA6cCAOAB" +
+	"AQOnAgCJKgEDpwIAqAEBA6cCAOIaAQOnAgC+BAEDpwIAywYBA6cCAPUGAQOnAgCz" +
+	"AQEDpwIAnAEBA6cCAKkBAQOnAgCCAQEDpwIA4RgBA6cCAIUDAQOnAgC4AQEDpwIA" +
+	"qAIBA6cCAK8CAQOnAgAUAQSnAoACALMBAQSnAoACAJwBAQSnAhAB7QEBBKcCEAE6" +
+	"AQSnAhABNQEEpwIQAaoDAQSnAhABMAEEpwIQAaoCAQSnAhABOAEEpwLxAQHHAQEE" +
+	"pwLxAQGwAQEEpwLxAQFvAQSnAvEBAZkBAQSnAvEBAfoBAQSnAvEBAWIBBKcC8QEB" +
+	"xQEBBKcC8QEBrE4BBKcC8QEBiQ4BA6cCAcpTAQOnAgGDBQQDpwLEAZUeAQWnAsQB" +
+	"nhLoXZUeAQOnAsQB1hYBBKcCxAHeHtYWAQOnAsQB3h4BA6cCDuMBAQOnAg4xAQOn" +
+	"Ag42AQOnAg5BAQOnAg5OAQOnAg5VAQOnAg51AQOnAg6AAQEDpwIOdAEDpwIOiAEE" +
+	"A6cCAJoDAQOnAgDQAwEDpwIAmwMBBKYDqAMO4wEBBKYDqAMOMQEEpgOoAw42AQSm" +
+	"A6gDDkEBBKYDqAMOTgEEpgOoAw5VAQSmA6gDDnUBBKYDqAMOgAEBBKYDqAMOdAEE" +
+	"pgOoAw6IAQEEpgOoAwCpAQEEpgOoAwDZBAEEpgOoAwCMDQEEpgOoAwDuBgEEpgOo" +
+	"AwD9BQEEpgOoAwCaDAEEpgOoAwD3JAEEpgOoAwD2JAEEpgOoAwD4JAEEpgOoAwD9" +
+	"JAEEpgOoAwDVAgEEpgOoAwDoCgEEpgOoAwCUEQEEpgOoAwDbQQEEpgOoAwCKDAEE" +
+	"pgOoAwDyBAEEpgOoAwDBDwEEpgOoAwDYBAEEpgOoAwCGHwEEpgOoAwCLHwEEpgOo" +
+	"AwCjAwEEpgOoAwDSDQEEpgOoAwCdOQEEpgOoAwD8AgEEpgOoAwDTDQEEpgOoAwDM" +
+	"BQEEpgOoAwDxTwEEpgOoAwC1TwEEpgOoAwDiGwEEpgOoAwC4DAEEpgOoA94Dll8B" +
+	"BKYDqAOSPOgeAQSmA6gDrUroHgEDpgOoA/BTAQOmA6gDhlEBA6YDqAP9JQEEpgOo" +
+	"A8QB8xoBBaYDqAPEATPzGgEFKQMAxwaTBgEFKQMAr0alBQEFKQMAgQs1AQUpAwC6" +
+	"B8YHAQUpAwCnC/oDAQUpAwDZXfoDAQYpAwCnC4cIAQEFKQMApwvoCAEFKQMAsGCy" +
+	"CwgFZrYDBQDzAwEFZrYDBQCaDQEFZrYDBQDrCQEFZrYDBQDEDQEFZrYDBQD8AgEF" +
+	"ZrYDBQDIDwEFZrYDBQCJDQEFZrYDBQCsDwEFZrYDBQDfAgEFZrYDBQDuCgEFZrYD" +
+	"BQCfDgEFZrYDBQCtBQEFZrYDBQCrDAEFZrYDBQDMAgEFZrYDBQDWDgEFZrYDBQDa" +
+	"DwEFZrYDBQCSCwEFZrYDBQCXCwEFZrYDBQCXCgEFZrYDBQDkCAEFZrYDBQDdDgEF" +
+	"ZrYDBQCMDgEFZrYDBQDoDQEFZrYDBQDPDAEFZrYDBQCYBAEFZrYDBQCsDQEFZrYD" +
+	"BQDUBgEFZrYDBQCMDAEFZrYDBQCwDQEFZrYDBQCpDQEFZrYDBQDPDwEFZrYDBQCF" +
+	"DgEFZrYDBQDvDQEFZrYDBQDUAwEFZrYDBQCDDAEFZrYDBQD9BAEFZrYDBQDfCgEF" +
+	"ZrYDBQD2DQEFZrYDBQDHBwEFZrYDBQC+CwEFZrYDBQDeFwEGZrYDBQCgAesJAQVm" +
+	"tgMFALsDAwVmtgMFAKgKAQZmtgMFAIcIAQEGZrYDBQDVGQEBBLYCxAHwBu1bAQS2" +
+	"AsQB8AaxUAEEtgLEAfAGqVIBBLYCxAHwBsUWAQW2AsQB8AbvGYcPAQW2AsQB8AZa" +
+	"hw8BBbYCxAHwBr8Bhw8BBbYCxAHwBuABhw8JA60DiQH9QgEDrQOJAYxZAQOtA4kB" +
+	"+lEBA60DAc1MAQWtAwGDDdoanAgBBq0DiQGDDfoljwWcCAEFrQOJAYMNjwWcCAEG" +
+	"rQOJAYMNrhGPBZwIAQStA4kBsAY5AQetA4kBgw2uEY8FnAiPVgEErQOJATOcCAEE" +
+	"rQOJAfACnAgBBK0DiQGuEcULAQStA4kBOzkBBa0DiQE2iwE5AQWtA4kBQYsBOQEG" +
+	"rQOJAa1UpRmPBZwIAQWtA4kBmCePBZwIAQStAwHxA5wIAQStAwHxA8IPAQWtAwH4" +
+	"AfEDwg8BBK0DAfEDxQsBBa0DAfgB8QPFCwEGrQMB8QPCDwLpAgEGrQMB8QPFCwLp" +
+	"AgEErQMBjAPcFQEErQMBjAOUKQEErQMBjAOOHwEGrQMBjAOOHwLpAgEDrQMBiF4B" +
+	"Ba0DAbk+qgGMAwEErQMBqgGMAwEFrQMB7FSqAYwDAQWtAwGMA5Vgs0oBA60DAekV" +
+	"AQStAwHbAukVAQStA4kBsAYmAQOtAwHsCgEDrQMBswsBA60DAZonAQStA4kBnwEm" +
+	"AQWtA4kBM58BJgEFrQMBM4wD3BUGBQcAAwUUAQUHAAMFlQQBBQcDAKABlQQBBgcA" +
+	"AwXwBz4BBQcAAwXzAQEFBwADBdIBAQUHAAMFgQkBBQcAAwU4AQYHAwCgAbEBOAEF" +
+	"BwMAoAE6AQUHAAMFkwIBBQcAAwXHAQEHBwADBW8CSAEFBwADBbABAQYHAAMF+AGZ" +
+	"AQEFBwADBTUBBgcAAwWxATUBBQcDAOEFNQEGBwMA4QWxATUBBwcDAOEFNQJIAQUH" +
+	"AwCgAcQDAQUHAAMFpwkBBgcDALMDbjUBBgcDAPQDbjUBBQcAAwX6AQEGBwADBfgB" +
+	"YgEGBwADBaABYgEFBwADBcUBAQUHAAMFMAEFBwMA4QUwAQYHAwDhBew1MAEGBwMA" +
+	"4QWgAbABAQUHAAMFywEBBQcAAwWOAgEFBwADBd4BAQUHAAMFngcBBQcApwi6Rc9a" +
+	"AQMHALsDAQUhAAMFpwUBBSEAAwXeBgEFIQADBZMDAQUhAAMF1QQBBSEAAwWMBgEF" +
+	"KQADBZkFAQQ9AAUUAQQ9AAWVBAEEPQAFPgEFPQAF8Ac+AQQ9AAXSAQEEPQAFOAEF" +
+	"PQAF+AE4AQQ9AAXBAQEEPQAF1AEBBD0ABToBBD0ABZMCAQQ9AAXHAQEEPQAFbwEE" +
+	"PQAFsAEBBD0ABZkBAQU9AAX4AZkBAQQ9AAU1AQQ9AAWnCQEEPQAF+gEBBD0ABWIB" +
+	"BD0ABcUBAQQ9AAUwAQQ9AAWOAgEEPQADFAEFPQADoAEUAQQ9AAOxAgEFPQADoAGV" +
+	"BAEEPQADPgEEPQAD0gEBBD0AAzgBBD0AA90HAQU9AAOxATgBBj0AA6ABsQE4AQQ9" +
+	"AAPBAQEFPQADoAE6AQQ9AAPHAQEEPQADsAEBBD0AA50HAQQ9AAM1AQU9AAOxATUB" +
+	"Bj0AA7MDbjUBBj0AA/QDbjUBBD0AA/oBAQQ9AAPFAQEEPQADMAEFPQAD4QUwAQU9" +
+	"AAOgAbABAQQ9AAPLAQEEPQADuwMBBD0AA9cFAQU9AAMhpwUBBD0AA8YFAQU9AAMh" +
+	"hAUBBD0AA+sEAQUH5AMDADoBBQfkAwMAYgEFB+QDAwAwAQUH5AMDAMsBAQUh5AMD" +
+	"ANcFAQUh5AMDAKcFAQUh5AMDANUEAQUh5AMDAIQFAQUh5AMDAOsEAQQHAwCgBQEH" +
+	"BwMAPgJMuQEBBwcDANIBAky5AQEHBwMAswICTLkBAQcHAwCwAQJMuQEBBwcDAJkB" +
+	"Aky5AQEHBwMA+gECTLkBAQcHAwBiAky5AQEJBwMAYgKECRhMuQEBBwcDAGMCTLkB" +
+	"AQcHAwDFAQJMuQEBBwcDAN4BAky5AQEFBwMAoAHBAQEEPQAp/AIBBQcDAMkFwQEB" +
+	"BgcDAMAJArNbAQcHAwUAOgJIAQYHAwCIAwJIAQYHAwD6AQJIAQcHAwUAMAJIAQYH" +
+	"AwCsAwJIAQcHAwA+AsgEUgEHBwMA0gECyARSAQcHAwCzAgLIBFIBBwcDAMEBAsgE" +
+	"UgEHBwMAxwECyARSAQcHAwBvAsgEUgEHBwMAsAECyARSAQcHAwCZAQLIBFIBBwcD" +
+	"APoBAsgEUgEHBwMAYgLIBFIBBwcDAGMCyARSAQcHAwDcBQLIBFIBBwcDAMsBAsgE" +
+	"UgEHBwMA/AECyARSAQcHAwDeAQLIBFIBBwcDABQCzwNSAQcHAwCxAgLPA1IBCAcD" +
+	"ANIBAlIY6QIBBwcDADgCzwNSAQgHAwCxATgCzwNSAQkHAwD4AbEBOALPA1IBBwcD" +
+	"AN0HAs8DUgEHBwMAOgLPA1IBCAcDALEBNQLPA1IBBwcDANwFAs8DUgEHBwMAMALP" +
+	"A1IBBwcDAJ4HAs8DUgEFPQADoAGxAgEEPQAD8wEBBj0AA/MBAqQFAQQ9AAOBCQEG" +
+	"PQAD+AGxATgBBD0AA7MCAQc9AAO6BZMCAkgBBT0AA50BwQEBBT0AA6AB1AEBBj0A" +
+	"AzoCSAEEPQADiAMBBT0AAwU6AQc9AAMFOgJIAQY9AAOTAgK3CAEHPQADbwLPA1IB" +
+	"Bz0AA28CyARSAQU9AAMFbwEGPQADsAECUgEIPQADoAGwAQKqAcsFAQc9AAOZAQIn" +
+	"UgEHPQADmQECzwNSAQU9AAMFmQEBBT0AA/AHNQEEPQADhAUBBj0AA2MCUgEEPQAD" +
+	"3AUBBz0AA8UBAsgEUgEFPQADMHcBBD0AA6wDAQU9AAMFMAEGPQADywECUgEFPQAD" +
+	"oAHLAQEEPQAD3gEBBz0AA94BAs8DUgEGPQAD3gECpAUBBD0AA54HAQQ9AAOHBQEE" +
+	"H8sDvgLEAgEEH8sD3wHEAgEDH5wPOQEDH7ETKgECH5JIAQIfrz0BAh+USAECH90l" +
+	"AQIfrj0BAh/cJQEGHwcDAGI5AQIfrCoBAh+TSAEEHzOLAiYBAx/BBiYBAx++BzkB" +
+	"Ax/oCjkBAx/lCCYBAx/GDyYBCB8HAwDxC7EBFCYBBR8HAwCVBAEFHwcDAJANAQUf" +
+	"BwMArgoBBh8HAwDzAb8EAQYfBwMAyQXSAQEFHwcDAIEJAQUfBwMAwQEBBh8HAAMF" +
+	"wQEBBR8HAwDHAQEFHwcDAG8BBh8HAAMFbwEGHwcAAwWwAQEFHwcDAJkBAQYfBwAD" +
+	"BZkBAQYfBwADBWIBBh8HAwBi2gwBBR8HAwBjAQYfBwMAqgFjAQUfBwMA3gEBBR8H" +
+	"AwCxAgEFHwcDAD4BBR8HAwDXBQEFHwcDAN0HAQUfBwMAswIBCR8HAwBvAjNMuQEB" +
+	"CR8HAwA1AnqoDUgBBR8HAwD6AQEFHwcDANwFAQkfBwMAMAJ6qA1IAQUfBwMAjgIB" +
+	"Bx8HAwAUAqACAQcfBwMANQKgAgEHHwcDADACoAIBBB9hjAQmAQQfywgmJQEEH8sI" +
+	"JicBBB87JicBBR+6B88Ctwo5AQQfOzknAQMfvzUqAQUfM88C9gI5AQUflAakAUI5" +
+	"AQQfJ58CJgEHHyWfAhhznwI5AQcHBQAUAp8BOQEHBwMAFAKfATkBBwcFAD4COyYB" +
+	"BwcDAD4COyYBBwcFAD4COzkBBwcDAD4COzkBBwcFAD4CvwI5AQcHAwA+Ar8COQEI" +
+	"BwUA8wECvwQY3wEBCAcDAPMBAr8EGN8BAQcHBQDSAQI7JgEHBwMA0gECOyYBBwcF" +
+	"ANIBAjs5AQcHAwDSAQI7OQEHBwUA0gECvwI5AQcHAwDSAQK/AjkBBgcFANIBAr8E" +
+	"AQYHAwDSAQK/BAEHBwUA0gECiwI5AQcHAwDSAQKLAjkBCAcFADgCyQIYvgIBCAcD" +
+	"ADgCyQIYvgIBCAcFADgCyQIY3wEBCAcDADgCyQIY3wEBBwcFADgCiwI5AQcHAwA4" +
+	"AosCOQEHBwUAOAK5ATkBBwcDADgCuQE5AQgHBQA4Ar8EGPYCAQgHAwA4Ar8EGPYC" +
+	"AQcHBQCzAgI7JgEHBwMAswICOyYBBgcFAMEBAskCAQYHAwDBAQLJAgEHBwUA1AEC" +
+	"OyYBBwcDANQBAjsmAQcHBQDUAQI7OQEHBwMA1AECOzkBBgcFANQBAqACAQYHAwDU" +
+	"AQKgAgEGBwUA1AECvwQBBgcDANQBAr8EAQcHBQDUAQL2AjkBBwcDANQBAvYCOQEH" +
+	"BwUAOgK5ATkBBwcDADoCuQE5AQgHBQA6AqACGN8BAQgHAwA6AqACGN8BAQYHBQDH" +
+	"AQLfAQEGBwMAxwEC3wEBBwcFAMcBAjs5AQcHAwDHAQI7OQEHBwUAxwECvwI5AQcH" +
+	"AwDHAQK/AjkBBwcFAG8COzkBBwcDAG8COzkBCQcFAG8COzkYyQIBCQcDAG8COzkY" +
+	"yQIBBwcFAG8CvwI5AQcHAwBvAr8COQEHBwUAbwKLAjkBBwcDAG8CiwI5AQYHBQCw" +
+	"AQLfAQEGBwMAsAEC3wEBBwcFALABAjsmAQcHAwCwAQI7JgEHBwUAsAECOzkBBwcD" +
+	"ALABAjs5AQcHBQCZAQI7JgEHBwMAmQECOyYBBwcFAJkBAjs5AQcHAwCZAQI7OQEH" +
+	"BwUAmQECvwI5AQcHAwCZAQK/AjkBBwcFAJkBAosCOQEHBwMAmQECiwI5AQgHBQA1" +
+	"ArkBGN8BAQgHAwA1ArkBGN8BAQgHBQA1ArkBGKACAQgHAwA1ArkBGKACAQgHBQA1" +
+	"AskCGL4CAQgHAwA1AskCGL4CAQgHBQA1AskCGN8BAQgHAwA1AskCGN8BAQYHBQD6" +
+	"AQLfAQEGBwMA+gEC3wEBBwcFAPoBAjsmAQcHAwD6AQI7JgEHBwUAYgI7JgEHBwMA" +
+	"YgI7JgEHBwUAYgI7OQEHBwMAYgI7OQEJBwUAYgI7ORjJAgEJBwMAYgI7ORjJAgEH" +
+	"BwUAYgK/AjkBBwcDAGICvwI5AQcHBQBjAjsmAQcHAwBjAjsmAQcHBQBjAjs5AQcH" +
+	"AwBjAjs5AQkHBQBjAt8BGDsmAQkHAwBjAt8BGDsmAQkHBQBjAr0DGDsmAQkHAwBj" +
+	"Ar0DGDsmAQoHBQBjAjs5GDsmAQoHAwBjAjs5GDsmAQcHBQDFAQI7JgEHBwMAxQEC" +
+	"OyYBBwcFAMUBAjs5AQcHAwDFAQI7OQEHBwUAxQECvwI5AQcHAwDFAQK/AjkBBwcF" +
+	"AMUBAosCOQEHBwMAxQECiwI5AQcHBQAwAqACOQEHBwMAMAKgAjkBBwcFADACuQE5" +
+	"AQcHAwAwArkBOQEHBwUAMAKLAjkBBwcDADACiwI5AQgHBQAwArkBGN8BAQgHAwAw" +
+	"ArkBGN8BAQgHBQAwAskCGKACAQgHAwAwAskCGKACAQYHBQDLAQK5AQEGBwMAywEC" +
+	"uQEBBwcFAMsBAjs5AQcHAwDLAQI7OQEGBwUAjgICvgIBBgcDAI4CAr4CAQYHBQCO" +
+	"AgLfAQEGBwMAjgIC3wEBBgcFAI4CAqACAQYHAwCOAgKgAgEHBwUAjgICOyYBBwcD" +
+	"AI4CAjsmAQcHBQCOAgI7OQEHBwMAjgICOzkBBwcFAPwBAjsmAQcHAwD8AQI7JgEG" +
+	"BwUA/AECoAIBBgcDAPwBAqACAQcHBQDiAQI7JgEHBwMA4gECOyYBBgcFAN4BAosC" +
+	"AQYHAwDeAQKLAgEHBwUA3gECOzkBBwcDAN4BAjs5AQcHBQDeAQK/AjkBBwcDAN4B" +
+	"Ar8COQEHBwMA1AECvwI5AQYHAwDFAQKgAgEHBwMAjgICnwEmAQcHAwDiAQKfASYB" +
+	"CAcDABQCJW6fAQEIBwMAqgFjAjsmAQgHAwCqAWMCuwFIAQgHAwCqAWMCjgFIAQUH" +
+	"BQCLCmMBBAcDAMYFAQcHBQAUAjs5AQcHAwAUAjs5AQcHBQAUAlImAQcHAwAUAlIm" +
+	"AQgHBQAUAosCGN8BAQgHAwAUAosCGN8BAQgHBQAUAosCGL4CAQgHAwAUAosCGL4C" +
+	"AQkHBQAUAosCGFImAQkHAwAUAosCGFImAQgHBQAUAosCGLkBAQgHAwAUAosCGLkB" +
+	"AQkHBQAUAosCGDs5AQkHAwAUAosCGDs5AQgHBQAUAvYCGN8BAQgHAwAUAvYCGN8B" +
+	"AQgHBQAUAvYCGL4CAQgHAwAUAvYCGL4CAQkHBQAUAvYCGFImAQkHAwAUAvYCGFIm" +
+	"AQgHBQAUAvYCGLkBAQgHAwAUAvYCGLkBAQkHBQAUAvYCGDs5AQkHAwAUAvYCGDs5" +
+	"AQcHBQA4Ajs5AQcHAwA4Ajs5AQcHBQA4AlImAQcHAwA4AlImAQYHBQA4ArkBAQYH" +
+	"AwA4ArkBAQgHBQA4AosCGN8BAQgHAwA4AosCGN8BAQgHBQA4AosCGL4CAQgHAwA4" +
+	"AosCGL4CAQkHBQA4AosCGFImAQkHAwA4AosCGFImAQgHBQA4AosCGLkBAQgHAwA4" +
+	"AosCGLkBAQkHBQA4AosCGDs5AQkHAwA4AosCGDs5AQcHBQA6AlImAQcHAwA6AlIm" +
+	"AQcHBQA6Ajs5AQcHAwA6Ajs5AQcHBQA1Ajs5AQcHAwA1Ajs5AQcHBQA1AlImAQcH" +
+	"AwA1AlImAQgHBQA1AosCGN8BAQgHAwA1AosCGN8BAQgHBQA1AosCGL4CAQgHAwA1" +
+	"AosCGL4CAQkHBQA1AosCGFImAQkHAwA1AosCGFImAQgHBQA1AosCGLkBAQgHAwA1" +
+	"AosCGLkBAQkHBQA1AosCGDs5AQkHAwA1AosCGDs5AQgHBQA1Ao8EGN8BAQgHAwA1" +
+	"Ao8EGN8BAQgHBQA1Ao8EGL4CAQgHAwA1Ao8EGL4CAQkHBQA1Ao8EGFImAQkHAwA1" +
+	"Ao8EGFImAQgHBQA1Ao8EGLkBAQgHAwA1Ao8EGLkBAQkHBQA1Ao8EGDs5AQkHAwA1" +
+	"Ao8EGDs5AQcHBQAwAjs5AQcHAwAwAjs5AQcHBQAwAlImAQcHAwAwAlImAQgHBQAw" +
+	"Ao8EGN8BAQgHAwAwAo8EGN8BAQgHBQAwAo8EGL4CAQgHAwAwAo8EGL4CAQkHBQAw" +
+	"Ao8EGFImAQkHAwAwAo8EGFImAQgHBQAwAo8EGLkBAQgHAwAwAo8EGLkBAQkHBQAw" +
+	"Ao8EGDs5AQkHAwAwAo8EGDs5AQYHBQDiAQK+AgEGBwMA4gECvgIBBwcFAOIBAjs5" +
+	"AQcHAwDiAQI7OQEHBwUA4gECUiYBBwcDAOIBAlImAQYHBQDiAQK5AQEGBwMA4gEC" +
+	"uQEBBQcFALQOhwQBBQcDALQOhwQBBQcFALQOywEBBQcDALQOywEBBgcFAOIBAuEE" +
+	"AQYHAwDiAQLhBAEGIQMAsQICmAIBBiEDALECAoMCAQghAwCxAgKYAhjmAgEIIQMA" +
+	"sQICgwIY5gIBCCEDALECApgCGOQCAQghAwCxAgKDAhjkAgEIIQMAsQICmAIYsgMB" +
+	"CCEDALECAoMCGLIDAQYhBQCxAgKYAgEGIQUAsQICgwIBCCEFALECApgCGOYCAQgh" +
+	"BQCxAgKDAhjmAgEIIQUAsQICmAIY5AIBCCEFALECAoMCGOQCAQghBQCxAgKYAhiy" +
+	"AwEIIQUAsQICgwIYsgMBBiEDAOoDApgCAQYhAwDqAwKDAgEIIQMA6gMCmAIY5gIB" +
+	"CCEDAOoDAoMCGOYCAQghAwDqAwKYAhjkAgEIIQMA6gMCgwIY5AIDBiEFAOoDApgC" +
+	"AQYhBQDqAwKDAgEIIQUA6gMCmAIY5gIBCCEFAOoDAoMCGOYCAQghBQDqAwKYAhjk" +
+	"AgEIIQUA6gMCgwIY5AIDBiEDAP0CApgCAQYhAwD9AgKDAgEIIQMA/QICmAIY5gIB" +
+	"CCEDAP0CAoMCGOYCAQghAwD9AgKYAhjkAgEIIQMA/QICgwIY5AIBCCEDAP0CApgC" +
+	"GLIDAQghAwD9AgKDAhiyAwEGIQUA/QICmAIBBiEFAP0CAoMCAQghBQD9AgKYAhjm" +
+	"AgEIIQUA/QICgwIY5gIBCCEFAP0CApgCGOQCAQghBQD9AgKDAhjkAgEIIQUA/QIC" +
+	"mAIYsgMBCCEFAP0CAoMCGLIDAQYhAwCIAwKYAgEGIQMAiAMCgwIBCCEDAIgDApgC" +
+	"GOYCAQghAwCIAwKDAhjmAgEIIQMAiAMCmAIY5AIBCCEDAIgDAoMCGOQCAQghAwCI" +
+	"AwKYAhiyAwEIIQMAiAMCgwIYsgMBBiEFAIgDApgCAQYhBQCIAwKDAgEIIQUAiAMC" +
+	"mAIY5gIBCCEFAIgDAoMCGOYCAQghBQCIAwKYAhjkAgEIIQUAiAMCgwIY5AIBCCEF" +
+	"AIgDApgCGLIDAQghBQCIAwKDAhiyAwEGIQMAugQCmAIBBiEDALoEAoMCAQghAwC6" +
+	"BAKYAhjmAgEIIQMAugQCgwIY5gIBCCEDALoEApgCGOQCAQghAwC6BAKDAhjkAgMG" +
+	"IQUAugQCmAIBBiEFALoEAoMCAQghBQC6BAKYAhjmAgEIIQUAugQCgwIY5gIBCCEF" +
+	"ALoEApgCGOQCAQghBQC6BAKDAhjkAgMGIQMArAMCmAIBBiEDAKwDAoMCAQghAwCs" +
+	"AwKYAhjmAgEIIQMArAMCgwIY5gIBCCEDAKwDApgCGOQCAQghAwCsAwKDAhjkAgEI" +
+	"IQMArAMCmAIYsgMBCCEDAKwDAoMCGLIDAgYhBQCsAwKDAgIIIQUArAMCgwIY5gIC" +
+	"CCEFAKwDAoMCGOQCAgghBQCsAwKDAhiyAwEGIQMArAICmAIBBiEDAKwCAoMCAQgh" +
+	"AwCsAgKYAhjmAgEIIQMArAICgwIY5gIBCCEDAKwCApgCGOQCAQghAwCsAgKDAhjk" +
+	"AgEIIQMArAICmAIYsgMBCCEDAKwCAoMCGLIDAQYhBQCsAgKYAgEGIQUArAICgwIB" +
+	"CCEFAKwCApgCGOYCAQghBQCsAgKDAhjmAgEIIQUArAICmAIY5AIBCCEFAKwCAoMC" +
+	"GOQCAQghBQCsAgKYAhiyAwEIIQUArAICgwIYsgMBBiEDALECAuYCAQYhAwCxAgLk" +
+	"AgEGIQMA6gMC5gIBBiEDAOoDAuQCAQYhAwD9AgLmAgEGIQMA/QIC5AIBBiEDAIgD" +
+	"AuYCAQYhAwCIAwLkAgEGIQMAugQC5gIBBiEDALoEAuQCAQYhAwCsAwLmAgEGIQMA" +
+	"rAMC5AIBBiEDAKwCAuYCAQYhAwCsAgLkAgMIIQMAsQICmAIY8gMBCCEDALECAoMC" +
+	"GPIDAQohAwCxAgKYAhjmAhjyAwEKIQMAsQICgwIY5gIY8gMBCiEDALECApgCGOQC" +
+	"GPIDAQohAwCxAgKDAhjkAhjyAwEKIQMAsQICmAIYsgMY8gMBCiEDALECAoMCGLID" +
+	"GPIDAQghBQCxAgKYAhjUBAEIIQUAsQICgwIY1AQBCiEFALECApgCGOYCGNQEAQoh" +
+	"BQCxAgKDAhjmAhjUBAEKIQUAsQICmAIY5AIY1AQBCiEFALECAoMCGOQCGNQEAQoh" +
+	"BQCxAgKYAhiyAxjUBAEKIQUAsQICgwIYsgMY1AQBCCEDAP0CApgCGPIDAQghAwD9" +
+	"AgKDAhjyAwEKIQMA/QICmAIY5gIY8gMBCiEDAP0CAoMCGOYCGPIDAQohAwD9AgKY" +
+	"AhjkAhjyAwEKIQMA/QICgwIY5AIY8gMBCiEDAP0CApgCGLIDGPIDAQohAwD9AgKD" +
+	"AhiyAxjyAwEIIQUA/QICmAIY1AQBCCEFAP0CAoMCGNQEAQohBQD9AgKYAhjmAhjU" +
+	"BAEKIQUA/QICgwIY5gIY1AQBCiEFAP0CApgCGOQCGNQEAQohBQD9AgKDAhjkAhjU" +
+	"BAEKIQUA/QICmAIYsgMY1AQBCiEFAP0CAoMCGLIDGNQEAQghAwCsAgKYAhjyAwEI" +
+	"IQMArAICgwIY8gMBCiEDAKwCApgCGOYCGPIDAQohAwCsAgKDAhjmAhjyAwEKIQMA" +
+	"rAICmAIY5AIY8gMBCiEDAKwCAoMCGOQCGPIDAQohAwCsAgKYAhiyAxjyAwEKIQMA" +
+	"rAICgwIYsgMY8gMBCCEFAKwCApgCGNQEAQghBQCsAgKDAhjUBAEKIQUArAICmAIY" +
+	"5gIY1AQBCiEFAKwCAoMCGOYCGNQEAQohBQCsAgKYAhjkAhjUBAEKIQUArAICgwIY" +
+	"5AIY1AQBCiEFAKwCApgCGLIDGNQEAQohBQCsAgKDAhiyAxjUBAEGIQMAsQICtwsB" +
+	"BiEDALECAskCAQghAwCxAgLmAhjyAwEGIQMAsQIC8gMBCCEDALECAuQCGPIDAgYh" +
+	"AwCxAgKyAwEIIQMAsQICsgMY8gMBBiEFALECArcLAQYhBQCxAgLJAgEGIQUAsQIC" +
+	"5gIBBiEFALECAuQCAQYhBQCxAgLUBAECIboRAQIh1AQBAiGYAgECIbIDAQQhgwYY" +
+	"sgMBCCEDAP0CAuYCGPIDAQYhAwD9AgLyAwEIIQMA/QIC5AIY8gMCBiEDAP0CArID" +
+	"AQghAwD9AgKyAxjyAwEGIQUA6gMC5gIBBiEFAOoDAuQCAQYhBQD9AgLmAgEGIQUA" +
+	"/QIC5AIBBiEFAP0CAtQEAQQhmAIY5gIBBCGYAhjkAgEEIZgCGLIDAQYhAwCIAwK3" +
+	"CwEGIQMAiAMCyQIBCCEDAIgDAoMGGOYCAQghAwCIAwKDBhjkAgMGIQMAiAMCsgMB" +
+	"CCEDAIgDAoMGGLIDAQYhBQCIAwK3CwEGIQUAiAMCyQIBBiEFAIgDAuYCAQYhBQCI" +
+	"AwLkAgIEIYMCGOYCAQQhgwIY5AIBBCGDAhiyAwEGIQMArAMCtwsBBiEDAKwDAskC" +
+	"AQghAwCsAwKDBhjmAgEIIQMArAMCgwYY5AIBBiEDANUEApgCAQYhAwDVBAKDAgEG" +
+	"IQMArAMCsgMBCCEDAKwDAoMGGLIDAQYhBQCsAwK3CwEGIQUArAMCyQIBBiEFAKwD" +
+	"AuYCAQYhBQCsAwLkAgEGIQUA1QQCgwIBBCGDBhjmAgEEIYMGGOQCAQIh5gIDCCED" +
+	"AKwCAuYCGPIDAQYhAwCsAgLyAwEIIQMArAIC5AIY8gMCBiEDAKwCArIDAQghAwCs" +
+	"AgKyAxjyAwEGIQUAugQC5gIBBiEFALoEAuQCAQYhBQCsAgLmAgEGIQUArAIC5AIB" +
+	"BiEFAKwCAtQEAQIh5AIBAiGDAgIC/AKFBQEC6AWFBQEC/AKxBQEC6AWxBQEC212x" +
+	"BQECszuxBQECglqxBQECgwmxBQECxAGxBQECrg+xBQEC7wmxBQED4wHnB7EFAQPj" +
+	"AecHrk0BA+MB5wfyCQECnQwqAQKADyoBAaIHAQKtTaIHAQKDCdIDAQL8AtIDAQLo" +
+	"BdIDAQKsAXcBAzNTvwIBAzOHAb8CAQQnjAK8BCoBBCWMArwEKgEEjAKBGrwEKgEE" +
+	"jALZGLwEKgEEJzO8BCoBBCUzvAQqAQQzgRq8BCoBBDPZGLwEKgEB2gkBAjPaCQEB" +
+	"0wYBArMF0wYBAzE7xhEBAzY7xhEBAqwB/wgBAvMY5wQBAr8C9QQBAsAM9QQBAp0M" +
+	"4BcBAoAP4BcBA8cSrRetOwECnQzkGwECgA/kGwEDgQuUErEFAQPFDOAaAQEExQyG" +
+	"AqsBAQEBsAcBAjOwBwEC8AKwBwEC+AGwBwED+AEzsAcBA/gB8AKwBwEB8wcBBYwC" +
+	"ygXcArwEKgEFjAKSBdwCvAQqAQLmUyoBAzPsBCoBAYkMAQGoCQEBgx8BAtoBrAsB" +
+	"A/MHmRHnBAEBkScBAqIH0wYBAswBpgYBBScrgQEC7Q4BBSUrgQEC7Q4BAzPLBCoB" +
+	"A8sE7AQqAQPsBMsEKgEDuR4B9wYBA/gBlBwBAQM3bdMGAQM3W9MGAQKHAbUDAQL4" +
+	"AbIHAQKYEGEBBDaQJ5smzgkBA9kLvgUBAQL7W9IDAQLPAoMfAQPzC8QBKgEDQTvE" +
+	"AQEC2QewBwEDTjvEAQEDVTvEAQEDNjvEAQEDTjsqAQLLA5EDAQHTHgEDU06LAQED" +
+	"twEMsQUBAqEF8gkBApAY2SYBApsRFgECmxH1BAECmxFgAgKdDIEOAQKAD4EOAQPA" +
+	"COodgQ4BA8cSrReBDgEDhBmTHvgdAQPCFZMe+B0BBIQZCA+dHQEEwhUID50dAQOE" +
+	"Gw6cHQEDqk0OnB0BAtcE4wEBBdcEBwMAOgMC1wROAQLXBFUBAtcEdQEC1wSAAQEC" +
+	"1wR0AQLXBIgBAQPXBGABAQLXBL4FAQPXBP0DAQED1wQntwMBA9cEJbcDAQXXBAcD" +
+	"AJkBAQLkA+MBAQLkAzEBAuQDNgEC5ANBAQLkA04BAuQDVQEC5AN1AQLkA4ABAQLk" +
+	"A3QBAuQDiAEBA+QDYAEBAuQDvgUBA+QD/QMBAQPkAye3AwED5AMltwMCBQfkAwMA" +
+	"FAEFB+QDAwA4AQUH5AMDADUBBQfkAwMA/AEBBQfkAwMA3QcBBQfkAwMA1AEBBQfk" +
+	"AwMAxwEBBQfkAwMAbwEFB+QDAwCwAQEFB+QDAwCZAQEFB+QDAwD6AQEFB+QDAwBj" +
+	"AQUH5AMDAMUBBALsOQEBApsEAQEC+TMBAQONGLk7AQEC+RkBAQLbSQEBApVLAQEC" +
+	"+1ABAQKxCQEBAvkTAQED9QGYWQEBArYXAQEC8hcBAQK5GQEBAqBfAQECyhABAQPA" +
+	"PPBQAQEChBwBAQK3PQEBAqsnAQEChEABAQK8KwEBA5RGtV4BAQLLWgEBAqxdAQED" +
+	"yEGxCQEBA6xf+RkBAQOzTSoBAQKwSAEBAvRUAQECuUUBAQKDKgEBAtEdARAEHyfZ" +
+	"AiYBBB8l2QImAQUfqgFTvwKbBQEFH7UCU78CmwUBBB+4BRsmAQQflwYbJgEEHycb" +
+	"JgEEHyUbJgEDH58BmwUBBB+XBp8BmwUBBB+4BZ8BmwUBBB9BiwEmAQQfTosBJgED" +
+	"H+MJeAEDH+MJKwEDH+MJrgMBBB/jCXjVCQEFHyclGyYBAx/jCdsMAQMf4wmuGQEF" +
+	"H+MJiB+bAq8BAQQfvwWUBZsFAQUfM1NImwUBAx/GJgsBAx/wArNgAQQfuge3CiYB" +
+	"BB9tG5sFAQUfqgEzlAWbBQEGH1vZAgK6AbYBAQYfbdkCAroBtgEBBB8nGzkBBB8l" +
+	"GzkBAx+1AyYQAsAVSgEE4SVCxgPTWwED0wEF8wEBArkNwSsBA9ACvwILAQKXK0oB" +
+	"Av8qrGABAus5ohABAZZWAQK5DdE6AQOdAQPBAQEDnQEF1AEBA84LBdQBAQPTAQXU" +
+	"AQECnRyiEAEFnRyiEJIBNpMDAQOdAQU6AQPOCwU6AQOdAQVvAQOdAQNvAQRvPncL" +
+	"AQPTAQWZAQEClE4BAQOPBuNT7xYBA50BBfoBAQPTAQX6AQED0wEF7gIBA50BBWIB" +
+	"A84LBWIBA9MBBWIBAvtR9FwBAdscAQK1WCoBArYHAQEDvF4qAQEBxmEBA9MBBd4B" +
+	"AQLhGwEBAs0OAQEDzwLNDgEBA84LBd4BAQWgASEDAIgDAQKaQwEBArkmAQEDnQEF" +
+	"PgEDzgsF8wEBAuA5CwEDnQEDOAEDnQEFOAEDnQEFswIBA6ABBbMCAQOdAQWwAQED" +
+	"nQEDNQECdgsBArMKCwECmQYLAQK2DQsBApYRmBMBA9sCBe4CAQLPOgEBA9MBA5MD" +
+	"AQPTAQOnBQED0wEFpwUBA9MBBZMDAQPTAYoG5wwBBKABLwXBAQEEoAEvBW8BBPgB" +
+	"LwVvAQSgAS8F4gEBBNMBLAXSAQEE0wEsA9IBAQTTASwDOAEE0wEsAzoBBNMBLAOT" +
+	"AgECjFK/AgECoAHtCAECxQwBAQGOJgEDoAEDswIBBAso5QKYEwEE5QXMATG8WAEE" +
+	"5QXMATHUTAEE5QXMATG+EwEE5QXMATGNBwEE5QXMATbhCAEE5QXMATHhEAEE5QXM" +
+	"ATbiEAEE5QXMAUHiEAEE5QXMAU7iEAEE5QXMATGNEwEE5QXMAVXAHQEE5QXMATHp" +
+	"AwEE5QXMAUGyBgEE5QXMAVWyBgEE5QXMAYABsgYBA8wBhgsxAQOUA5oCMQEDlAOa" +
+	"AjYBA5QDmgJBAQOUA5oCTgEDlAOaAlUBA5QDmgJ1AQOUA5oCgAEBA5QDmgJ0AQOU" +
+	"A5oCiAEBA5QDmgKGAgEDlAOaApwHAQOUA5oCzAYBA5QDmgKFBAEElAOaAjGGAQEE" +
+	"lAOaAlWGAQEElAOaAjGrAQEEA5QDmgIxAQQDlAOaAjYBBAOUA5oCQQEEA5QDmgJO" +
+	"AQQDlAOaAlUBBAOUA5oCdQEEA5QDmgKAAQEEA5QDmgJ0AQQDlAOaAogBAQQDlAOa" +
+	"AoYCAQQDlAOaApwHAQQDlAOaAswGAQQDlAOaAoUEAQUDlAOaAjGGAQEFA5QDmgJV" +
+	"hgEBBQOUA5oCMasBAQaUA5oCMasB8wHSAQEElAOaAlWrAQEElAOaAoYCqwEBBZQD" +
+	"mgL4ATGGAQEFBwMA+AHzAQEFlAOaAnW8RQ8BBZQDmgKFBJo5DwEElAOaAoUEqwEB" +
+	"BZQDmgIxhgGrAQEE5QXMAeMB4QgBA6ABDjYBA6ABDkEFAm0bAQK+ARsBAlsbAQK2" +
+	"ARsBAyclGwEDYXMbAQO8AYYDGwEDvAHdAhsBA/IB3QIbAQPyAYYDGwEEbRsCSAEE" +
+	"WxsCSAEDbZkEGwEDW5kEGwEEbTbyDRsBBL4BNvINGwEEWzbyDRsBBLYBNvINGwEE" +
+	"bRsC6QIBBFsbAukCAQRtG/4DdwEEvgEb/gN3AQRbG/4DdwEEtgEb/gN3AQVhcxsC" +
+	"nA0BBG0bAlIBBFsbAlIBBG0bAuEEAQRbGwLhBAEEJyWZBBsBBSclGwJIAQO2Ab4H" +
+	"GwEFvgEbAsEFbQEFvgEbAsEFWwEFtgEbAsEFbQEFtgEbAsEFWwEFWxsC9QO2AQEF" +
+	"tgEbAvUDbQEEuAWzA90MGwEElwazA90MGwEGvAGGAxtCqgF3AQltG0J3kgFbG0J3" +
+	"AQS4BbEBeBsBBJcGsQF4GwEFbdkCAroBvgEBBW3ZAgK6AbYBAQW+AdkCAroBWwEF" +
+	"vgHZAgK6AW0BBVvZAgK6Ab4BAQVb2QICugG2AQEFtgHZAgK6AVsBBbYB2QICugFt" +
+	"AQVbG5IBbRsBBr4BG21KtgEbAQVtG5IBWxsBA22pCf8FAQO+AakJ/wUBA1upCf8F" +
+	"AQO2AakJ/wUBBW3ZApIBW9kCAQVb2QKSAW3ZAgEFbTMbAkgBBiclMxsCSAEFWzMb" +
+	"AkgBA20zGwEDvgEzGwEDWzMbAQO2ATMbAQQnJTMbAQRhczMbAQS8AYYDMxsBBLwB" +
+	"3QIzGwEE8gHdAjMbAQTyAYYDMxsBA23wAhsBA1vwAhsBA22gDxsBA1ugDxsBBb4B" +
+	"GwIzSAEFtgEbAjNIAQNt8wYbAQO+AfMGGwEDW/MGGwEDtgHzBhsBBG0bQncBBFsb" +
+	"QncBA20/GwEDvgE/GwEDWz8bAQO2AT8bAQW+AT8b/gN3AQW+AT8bzALZDgEIvgE/" +
+	"G8wC2Q4CrAF3AQi+AT8bzALZDgJTdwEEvgE/MxsBBr4BPzMbzALZDgEFWz8b/gP8" +
+	"BQEFvAGGAxtC9QMBBfIB3QIbQvUDAQRhcz8bAQUlGwIDeAEGtgEbbUq+ARsBA0Fb" +
+	"/wUBBW0bAlNIAQVbGwJTSAEGJyUbAlNIAQZtGwIzU0gBBlsbAjNTSAEHJyUbAjNT" +
+	"SAEDbaUSGwEDW6USGwEEJyWlEhsBAijDCwEBvC0BAosLxAoBAq0e+DkBBK0emgfS" +
+	"Avc5AQK0BtsIAQHHQQEBgAsBAvYGSgEE0gLzA/YGSgEDA/YGSgEDtgjRBrIOAQWa" +
+	"B9ICpBDRBrIOAQQDtgjRBrIOAQOpBEqLUgECigaFBwECigbuFgECigbnDAECvgUB" +
+	"AQLiSQEBAjtgAQL7CKYGAQLbCL4FAQK1A7ADAQKfAbADAQLTBrADAQIr2QwBAq4Q" +
+	"2QwBAuIN2QwBAo1SQgEB5goBAiXcAgEB3AIBAqkH3AIBApoT3AIBAZY2AQOaB9IC" +
+	"xBABApMIQgED0gKTCEIBAsMEGAECwwTdAQEB8AUBAdEFAQH+BAECM/4EAQLwAv4E" +
+	"AQKlEP4EAQKwE/4EAQKkYv4EAQKXBv4EAQOXBqUQ/gQBA7gFpRD+BAEBw10BAdIp" +
+	"AQHRUwEBrhwBAju+BQEB8jkBAr48rhwBAecYAQK5AbADAQL4AbkBAQPPAsURYwEC" +
+	"71mZBAECu2OFBwEC0gK5AQECvgW5AQED8RWkAUIBBNIC8RWkAUIBA60KpAFCAQat" +
+	"CvIH0gLDFaQBQgEG0AitCt8GwxWkAUIBA5QGpAFCAQTSApQGpAFCAQWUBqQB3QGk" +
+	"AUIBAvACuQEBA8MLpAFCAQK1BkIBA6YYtQZCAQL0NekEAQPbJsYDgkYBA6YYpAFC" +
+	"AQetCqQBQt0BxgPJCEoBBskISt0BrQqkAUIBApsE/QMBAv0DmwQBBJ8B3wKkAUIB" +
+	"A58BpAFCAQLiM0IBAeE5AQLUOUIBAu8C/QMBA8YFpAFCAQSkAUKXB7s1AQKpB5cH" +
+	"AQPxUqQBQgED0gKkAUIBAvwNQgED0gL8DUIBA7FbtQZCAQTtAt0BpAFCAQTsAt0B" +
+	"pAFCAQTtApIBpAFCAQTsApIBpAFCAQXtAvIH0gKkAUIBBewC8gfSAqQBQgECpwzt" +
+	"AgECpwzsAgEB6QQBA9ICtQZCAQLSAu0CAQLSAuwCAQXQCO0C3wakAUIBBdAI7ALf" +
+	"BqQBQgEE7QLdAbUGQgEE7ALdAbUGQgEF0AjtAt8GtQZCAQXQCOwC3wa1BkIBA+0C" +
+	"3QHsAgED7ALdAe0CAQTQCO0C3wbsAgEE0AjsAt8G7QIBAYQHAQGMBwEEhAfdAaQB" +
+	"QgEEjAfdAaQBQgEEhAfdAbUGQgEEjAfdAbUGQgEDmgfSAqccAQOaB9IC7h0BAvkE" +
+	"SgEC+gRKAQTSAhT5BEoBBNICFPoESgEF+QRK3QGkAUIBBfoESt0BpAFCAQfQCBT5" +
+	"BErfBqQBQgEH0AgU+gRK3wakAUIBBvkESgLSAqQBQgEG+gRKAtICpAFCAQH3EQEC" +
+	"9xH+BgEC9xHRBQEDK8kISgEDK7sMSgEGK8kISt0BpAFCAQYruwxK3QGkAUIBAiun" +
+	"DQECK9wLAQItYAECLb4FAQItFgEDLfsIpgYBAy07sAMBAy2fAbADAQMttQOwAwEC" +
+	"Lf0DAQIt0gMBAmVgAQJlvgUBAmUWAQNlO7ADAQIljAQBAieMBAECc4wEAQJhjAQB" +
+	"AY4nAQH2SQEB3BMBAas7AQXwAlN3JcwJAQYzU3czJcwJAQOaB9ICkFIBAtIC3BMB" +
+	"A5oH0gKqOwEHixszU3czJcwJAQOEB6UI9g4BA4wHpQj2DgEDkAi7CUoBBLYI0QaQ" +
+	"CLsJAQaQCLsJSt0BpAFCAQe2CNEGkAi7Cd0BpAFCAQK7DEoBAskISgEB9hEBA7Y+" +
+	"yDPUSAEB1UEBAe5jAQGXSwEB3wYBBCXcAgLCBQECJa8BAQOKBsMEGAEDigbDBN0B" +
+	"AQKKBvAFAQKKBtEFAQKuA7ADAQI7sAMBAu8CsAMBAvsIFgEBog0BBSeQCNsQ3gyF" +
+	"BwEFJZAI2xDeDIUHAQMn3gyFBwEDJd4MhQcBA/gBuQH9AwED/APDBN0BAQP8A8ME" +
+	"GAECM/kEAQIz+gQBAjPwBQECM9EFAQGbHAEEpAEYkwhCAQPtAgI7AQPsAgI7AQOy" +
+	"BKcM7QIBA7IEpwzsAgEF7QKkAULdAewCAQXsAqQBQt0B7QIBBKQBQt0B7QIBBKQB" +
+	"Qt0B7AIBBKQBQt0BhAcBBKQBQt0BjAcBBZoH0gKnHN0BpAEBBZoH0gLuHd0BpAEB" +
+	"B9ICK8kISt0BpAFCAQfSAiu7DErdAaQBQgEHK8kISt0B0gKkAUIBByu7DErdAdIC" +
+	"pAFCAQXtAvIH0gK1BkIBBewC8gfSArUGQgEFhAfyB9ICtQZCAQWMB/IH0gK1BkIB" +
+	"BNICkAi7CUoBBpoH0gKkENEGkAi7CQEH0gKQCLsJSt0BpAFCAQiaB9ICpBDRBpAI" +
+	"uwndAaQBAQJT/wgBA9oarAH/CAEEYSW7Af8IAQRzJbsB/wgBBvYGSgKqAawBSAEK" +
+	"9gZKAlN32QSpBEqsAUgBCwP2BkoCU3fZBKkESqwBSAEF9gZKAjsmAQT2BkoCgAcB" +
+	"BQP2BkoCgAcBBPYGSgKJBQEG9gZKAjasAaoTAQW2CAKqAawBSAEJtggCU3fZBKkE" +
+	"SqwBSAEKA7YIAlN32QSpBEqsAUgBA7YIAoAHAQQDtggCgAcBBN4BlwKYDfxIAQLt" +
+	"NQEBAs4NGwEB5AoBAmGfAgECc58CAQGJUgEB+FABAuYHvwIBAieBBgECJYEGAQIn" +
+	"6gUBAiXqBQED9AMlsw0BA/QDJ7MNAQOzAyWzDQEDswMnsw0BA/gB0gIBAQIr+QYB" +
+	"AcIFAQH2EgEBolYBArYH4lMBAo8LlgQBAohiKwEEhQpK1kEBAQOgAdICAQEBoB8B" +
+	"AfcNAQOzAyf1AwEDswMl9QMBA/QDJ/UDAQP0AyX1AwEDswNu/gQBA/QDbv4EAQHk" +
+	"DQEB4gwBBmGfAukENqwBygsBAuRPzAgBBOkXQsYDJQEF/AHfAhTVCGQBAZQMAQPK" +
+	"BdwCgQEBA5IF3AKBAQEE6RdCxgMnAQKUFp8BAQGONAECwwv1JgEBmlwBAsYe/lQB" +
+	"Avs16E8BAsczgF0BAZpaAQHlMwEB5jMBBLwCvQILxkABBbwCvQIL4VqFBQEFvAK9" +
+	"AguFBaQBAQW8Ar0CC4UFxBABBbwCvQILhQWuAwEFvAK9AguFBY8JAQW8Ar0CC4UF" +
+	"eAEFvAK9Agt4ogsBBbwCvQILeI8JAQW8Ar0CC6YGdwEFvAK9AgvVCXcBBbwCvQIL" +
+	"hQWmBgEFvAK9AguFBdUJAQW8Ar0CC4UF7QIBBbwCvQILhQXsAgEFvAK9Agttxw8B" +
+	"BbwCvQILW8cPAQa8Ar0CC4UFbRsBBrwCvQILhQVbGwEFvAK9Agt41QkBBrwCvQIL" +
+	"c4wEiQUBBbwCvQILxgWiCwEGvAK9AguFBXPzBwEFvAK9AguFBcYFAQa8Ar0CC3OM" +
+	"BI8JAQW8Ar0CC74Bxw8BBrwCvQILhQW+ARsBBrwCvQILYYwEgAcBBbwCvQILug2i" +
+	"CwEGvAK9AguFBWHzBwEFvAK9AguFBboNAQa8Ar0CC2GMBI8JAQW8Ar0CC7YBxw8B" +
+	"BrwCvQILhQW2ARsBBbwCvQILwByJBQEFvAK9AgvGBYkFAQW8Ar0CC64DiQUBBbwC" +
+	"vQILjwmJBQEFvAK9Agt4iQUBBrwCvQILYY0KjwkBBbwCvQILwByFBQEFvAK9Agt4" +
+	"7wIBBbwCvQILhQWbBAEGvAK9AgthjASgAgEFvAK9Agu6DaACAQW8Ar0CC+8CoAIB" +
+	"BbwCvQILjwmgAgEFvAK9Agt4oAIBBrwCvQILc40KogsBBrwCvQILJ40KogsBBbwC" +
+	"vQILuQGgAgEFvAK9AgvsAqACAQW8Ar0CC+cCdwEFvAK9Agu6DbkBAQS8Ar0CC/Zl" +
+	"AQW8Ar0CC6ILuQEBBbwCvQILsgeJBQEGvAK9AguFBdICpAEBBbwCvQILhQXLBAEG" +
+	"vAK9Agtz8we5AQEGvAK9Agth8we5AQEEvAK9AguIAwEEvAK9AgvVBAEEvAK9Agus" +
+	"AgEFvAK9AguxAokFAQW8Ar0CC+oDiQUBBbwCvQILiAOJBQEFvAK9AgusAokFAQS8" +
+	"Ar0CC7ECAQPSAtUGKgEGJdwCArYBvgcbAQO7WbEBZAEC2AkLAQVTvwICTDsBApkR" +
+	"CwEDzzOCHwsBA4k2gh8LAQLiFwsBAp8QCwEGPysC0AJTvwIBAuYXCwED4g/MCAsB" +
+	"ArA+CwEFLawBdwKwGwEDLa8BcwEFuAp4ArVNGwECtGALAQKNSgsBArdACwED2hvX" +
+	"PQsBA9ob5EQLAQLQUAsBAtFQCwEFwxCvEAsPNgECsVoLAQS8Ar0CC4UFAQS8EPUE" +
+	"zAgLAQL/UZIIAQLyS5IIAQOqHNsMCwEDtSzbDAsBBCe3A3JSAQMntwOCCQEEJ7cD" +
+	"a1IBBCW3A3JSAQMltwOCCQEEJbcDa1IBBScrgQFy9QMBBCcrgQGCCQEFJyuBAWv1" +
+	"AwEFJSuBAXL1AwEEJSuBAYIJAQUlK4EBa/UDAQUn/AOBAXJSAQUn/AOBAUysCQEF" +
+	"J/wDgQFrUgED/AOBAYIJAQUl/AOBAXJSAQUl/AOBAUysCQEFJfwDgQFrUgEC/gSC" +
+	"CQEDrAG/AoIJAQhyJ90BayX8A4EB8AMBCHIl3QFrJ/wDgQHwAwEC5wyzAwEC5wz0" +
+	"AwEDswMrgQEBA/QDK4EBAQf0AyuBAZIBswMrgQEBAy4L9AMBBCdTZL8CAQQlU2S/" +
+	"AgEDrAGID4dGAQOsAYgPiEYBA6wBiA+JRgEDrAGID4pGAQexBgt6UxizAyUBB7EG" +
+	"C3pTGPQDJQEGsQYLelMCeAEIsQYLenMYrAECeAEIsQYLemEYrAECeAEGsQYLelMC" +
+	"rwEBCLEGC3pzGKwBAq8BAQixBgt6YRisAQKvAQEGsQYLelMYmQQBCLEGC3pzGKwB" +
+	"ApkEAQixBgt6YRisAQKZBAEGsQYLenMYrAEBBrEGC3phGKwBAQexBgt6UxizAycB" +
+	"B7EGC3pTGPQDJwECK98NAQLWDAsBAqs5CwEDU78CggkBAs4I9gIBBM4IqgGSAbUC" +
+	"AQTOCLUCkgGqAQEFzgiqAZIBNpQPAQXOCDaUD5IBqgEBBM4INpQPjgwBAs4I1R4B" +
+	"As4Ipx4BAs4I/BsBApgGvBgBAcc7AQKzA7cDAQL0A7cDAQOzA/wDgQEBA/QD/AOB" +
+	"AQEEswP7BKQEgQEBBPQD+wSkBIEBAQI/wl4BBJQWnwECeAEBqFsBAZY7AQKKDa8Q" +
+	"AQKxOfAFAQO8EPo5CwEEN5IFM68BAQQ3ygUzrwEBBDeSBjOvAQEEN/QGM68BAQc3" +
+	"kgUzrwECU3cBBzfKBTOvAQJTdwEHN5IFrwECM1N3AQKTJtoEAQGkWwEC/l3aBAEE" +
+	"9w0CnDv0VQEEN7cBygWvAQEEN7cBkgWvAQEEN7cBkgavAQEEN7cB9AavAQEDM1N3" +
+	"AQQ3KyipAgEEN3go0hwBApALCwEDkAvPTwsBA5ALzAILAQOQC5NaCwEDvU//BwsB" +
+	"AwsouxsBBQso5AxKoj4BBQso5AxK3wgBBQsoqQRK3wgBBQsoqQRKzB4BAwsoxjkB" +
+	"AwsowRUBAwso2AkBAwsoiCkBBAsorAGWHgEECyi/At4QAQQLKFOWHgEECygP3hAB" +
+	"BAsomivWDAEECyiiHZ4GAQQLKKId3wIBBQsojDX3Ge0XAQULKLwNvgoxAQULKLwN" +
+	"vgo2AQULKLwNvgpBAQULKLwNvgpOAQQLKM4BwRUBBAsonVyaQQEGCyipBErMHnEB" +
+	"AwsoyBYBBQsoqQRKtwEBAwso7R0BAwso7RcBBAso7wv1BAEECyi9GPUEAQQLKNIc" +
+	"9QQBBAsotwf1BAEDCyixBQEDCyifFwECoRYLAQKxAWQBAwsohxIBBQsonxcPNgEF" +
+	"CyjtHQ82GgLXB1IBAtcH2RYBAtcH4A0BA9cHzwLgDQED1wfBB7oqAQPXB7YKrAsB" +
+	"BNcHgRD1D/gYAQTXB6smStUGAQLXB9IDAQTXB4c0wBUdAQPXBzPVCRYDLQ4xAQMt" +
+	"DjYBAy0OQQEDLQ5OAQMtDlUBAy0OdQEDLQ6AAQEDLQ50AQMtDogBAQMtHYYCAQMt" +
+	"HZwHAQMtHcwGAQMtHcQJAQMtHYUJAQMtHcgHAQMtHZsIAQMtHbQJAQMtHbsIAQMt" +
+	"HaIJAQMtHacDAQODAQ4xAQODAQ42AQODAQ5BAQODAQ5OAQODAQ5VAQODAQ51AQOD" +
+	"AQ6AAQEDgwEOdAEDgwEOiAEBA4MBHYYCAQODAR2cBwEDgwEdzAYBA4MBHcQJAQOD" +
+	"AR2FCQEDgwEdyAcBA4MBHZsIAQODAR20CQEDgwEduwgBA4MBHaIJAQODAR2nAwEE" +
+	"DjGSA6kCAQQONpIDqQIBBA5BkgOpAgEEDk6SA6kCAQQOVZIDqQIBBA51kgOpAgEE" +
+	"DoABkgOpAgEEDnSSA6kCAQQOiAGSA6kCAQQdhgKSA6kCAQQdnAeSA6kCAQQdzAaS" +
+	"A6kCAQQdxAmSA6kCAQQdhQmSA6kCAQQdyAeSA6kCAQQdmwiSA6kCAQQdtAmSA6kC" +
+	"AQQduwiSA6kCAQQdogmSA6kCAQQdpwOSA6kCAQWDAQcDABQBBYMBBwMAPgEFgwEH" +
+	"AwDzAQEFgwEHAwDSAQEFgwEHAwA4AQWDAQcDALMCAQWDAQcDAMEBAQWDAQcDANQB" +
+	"AQWDAQcDADoBBYMBBwMAkwIBBYMBBwMAxwEBBYMBBwMAbwEFgwEHAwCwAQEFgwEH" +
+	"AwCZAQEFgwEHAwA1AQWDAQcDAPoBAQWDAQcDAO4CAQWDAQcDAGIBBYMBBwMAYwEF" +
+	"gwEHAwDFAQEFgwEHAwAwAQWDAQcDAMsBAQWDAQcDAI4CAQWDAQcDAPwBAQWDAQcD" +
+	"AOIBAQWDAQcDAN4BAQUtBwUAFAEFLQcFAD4BBS0HBQDzAQEFLQcFANIBAQUtBwUA" +
+	"OAEFLQcFALMCAQUtBwUAwQEBBS0HBQDUAQEFLQcFADoBBS0HBQCTAgEFLQcFAMcB" +
+	"AQUtBwUAbwEFLQcFALABAQUtBwUAmQEBBS0HBQA1AQUtBwUA+gEBBS0HBQDuAgEF" +
+	"LQcFAGIBBS0HBQBjAQUtBwUAxQEBBS0HBQAwAQUtBwUAywEBBS0HBQCOAgEFLQcF" +
+	"APwBAQUtBwUA4gEBBS0HBQDeAQEFLQcDABQBBS0HAwA+AQUtBwMA8wEBBS0HAwDS" +
+	"AQEFLQcDADgBBS0HAwCzAgEFLQcDAMEBAQUtBwMA1AEBBS0HAwA6AQUtBwMAkwIB" +
+	"BS0HAwDHAQEFLQcDAG8BBS0HAwCwAQEFLQcDAJkBAQUtBwMANQEFLQcDAPoBAQUt" +
+	"BwMA7gIBBS0HAwBiAQUtBwMAYwEFLQcDAMUBAQUtBwMAMAEFLQcDAMsBAQUtBwMA" +
+	"jgIBBS0HAwD8AQEFLQcDAOIBAQUtBwMA3gEBAy0O4wEBBM4BLR2cBwEEzgEtHcwG" +
+	"AQTOAS0dxAkBBM4BLR2FCQEEzgEtHcgHAQTOAS0dmwgBBM4BLR20CQEEzgEtHbsI" +
+	"AQTOAS0dogkBBM4BLR2nAwEEMy0OMQEEMy0ONgEEMy0OQQEEMy0OTgEEMy0OVQEE" +
+	"My0OdQEEMy0OgAEBBDMtDnQBBDMtDogBAQQzLR2GAgEEzgEtDuMBAQRkfnqsAQEE" +
+	"ZH5LrAEBBGR+elMBBGR+S1MBBmR+evAC0gOsAQEGZH5L8ALSA6wBAQZkfnrwAtID" +
+	"UwEGZH5L8ALSA1MBBmR+etkH0gOsAQEGZH5L2QfSA6wBAQZkfnrZB9IDUwEGZH5L" +
+	"2QfSA1MBBmR+enMYJQEHZH5zehglSwEHZH5zSxglegEGZH5LcxglAQZkfnpzGCcB" +
+	"B2R+c3oYJ0sBB2R+c0sYJ3oBBmR+S3MYJwEGZH56YRglAQdkfmF6GCVLAQdkfmFL" +
+	"GCV6AQZkfkthGCUBBmR+emEYJwEHZH5hehgnSwEHZH5hSxgnegEGZH5LYRgnAQZk" +
+	"fnpTGCUBB2R+U3oYJUsBCGR+YUsYJXN6AQhkfnNLGCVhegEHZH5TSxglegEIZH5z" +
+	"ehglYUsBCGR+YXoYJXNLAQZkfktTGCUBBmR+elMYJwEHZH5TehgnSwEIZH5hSxgn" +
+	"c3oBCGR+c0sYJ2F6AQdkflNLGCd6AQhkfnN6GCdhSwEIZH5hehgnc0sBBmR+S1MY" +
+	"JwEGZH56cxisAQEIZH4nSxglc3oBCGR+JUsYJ3N6AQdkfnN6GKwBSwEHZH5zSxis" +
+	"AXoBCGR+JXoYJ3NLAQhkfid6GCVzSwEGZH5LcxisAQEGZH56YRisAQEIZH4nSxgl" +
+	"YXoBCGR+JUsYJ2F6AQdkfmF6GKwBSwEHZH5hSxisAXoBCGR+JXoYJ2FLAQhkfid6" +
+	"GCVhSwEGZH5LYRisAQEGZH56UxisAQEIZH4nSxglU3oBCGR+JUsYJ1N6AQdkflN6" +
+	"GKwBSwEIZH5hSxhzrAF6AQhkfnNLGGGsAXoBB2R+U0sYrAF6AQlkfidhSxglc3oB" +
+	"CWR+JWFLGCdzegEJZH4nc0sYJWF6AQlkfiVzSxgnYXoBCGR+c3oYYawBSwEIZH5h" +
+	"ehhzrAFLAQhkfiV6GCdTSwEIZH4nehglU0sBBmR+S1MYrAEBBmR+ejPSA6wBAQZk" +
+	"fksz0gOsAQEGZH56M9IDUwEGZH5LM9IDUwEEZH4zrAEBBGR+M1MBB2R+c4wCGCUz" +
+	"AQdkfnMzGCWMAgEGZH4zcxglAQdkfnOMAhgnMwEHZH5zMxgnjAIBBmR+M3MYJwEH" +
+	"ZH5hjAIYJTMBB2R+YTMYJYwCAQZkfjNhGCUBB2R+YYwCGCczAQdkfmEzGCeMAgEG" +
+	"ZH4zYRgnAQdkflOMAhglMwEHZH5TMxgljAIBBmR+M1MYJQEHZH5TjAIYJzMBB2R+" +
+	"UzMYJ4wCAQZkfjNTGCcBB2R+c4wCGKwBMwEHZH5zMxisAYwCAQZkfjNzGKwBAQdk" +
+	"fmGMAhisATMBB2R+YTMYrAGMAgEGZH4zYRisAQEHZH5TjAIYrAEzAQdkflMzGKwB" +
+	"jAIBBmR+M1MYrAEBB2R+esIFcxglAQdkfnrCBXMYJwEHZH56wgVhGCcBB2R+esIF" +
+	"YRglAQlkfnq7AXIlQmsnAQlkfnq7AXInQmslAQVkfnq7AZEDAQRkfnonAQRkfnph" +
+	"AQRkfnolAQRkfnpzAQRkfksnAQRkfkthAQRkfkslAQRkfktzAQdkfnonGEslAQdk" +
+	"fnphGEtzAQdkfksnGHolAQdkfkthGHpzAQNybnEBBGsx6QNxAQRrMe4DcQEEa0Gy" +
+	"BnEBA2tucQEEa1WyBnEBBGtBwgZxAQRrgAGyBnEBApIDcQEEJ4ABsgZxAQQnQcIG" +
+	"cQEEJ1WyBnEBAyducQEEJ0GyBnEBBCcx7gNxAQQnMekDcQEDJW5xAQJ6yAYBArcB" +
+	"yAYBAo0XyAYBBHIx6QNxAQQlMekDcQEDkQVrJwEDkQVrJQEDkQVyJwEJkQVyJxhr" +
+	"JxhrJQEGkQVyJxhrJQEJkQVyJxhyJRhrJwEJkQVyJxhyJRhrJQEDkQVyJQEGkQVy" +
+	"JRhrJwEJkQVyJRhrJxhrJQECNysBAj8rAQU/KwLHBsAKAQY/K7UINwMrAQQrAqwB" +
+	"6QUBBCsCU+kFAQUrAutP9BbpBQEIKwJyJ0JrJekFAQgrAnIlQmsn6QUBBSsCuwH0" +
+	"FukFAQM3AysBAz8DKwECN9UIAQI/1QgBAzdT1QgBAz9T1QgBAjfyGwECP/IbAQM3" +
+	"kgavAQEDP5IGrwEBBDeSBgOvAQEEP5IGA68BAQM3kgWvAQEDP5IFrwEBBDeSBQOv" +
+	"AQEEP5IFA68BAQM3kgXJDAEDP5IFyQwBAzf0Bq8BAQM/9AavAQEEN/QGA68BAQQ/" +
+	"9AYDrwEBAzfKBa8BAQM/ygWvAQEEN8oFA68BAQQ/ygUDrwEBAzfKBckMAQM/ygXJ" +
+	"DAECN64DAQI/rgMBBj+uA7UINwOuAwEBhTsBAfkGAQI/eAECywN4AQR4AlPpBQEB" +
+	"wCoBAjd4AQV4AiduNwEFeAIlbjcBBXgCa243AQV4AnJuNwEGeAJyJZEFNwEIeALD" +
+	"C/IHcieRBTcBBCduN3gBBCVuN3gBAswH0wYBA8wHP3gBBXJuzAc/eAEFa27MBz94" +
+	"AQVyJ5EF1gvCBQEFciWRBdYLwgUBBWslkQXWC8IFAQVrJ5EF1gvCBQEDcm54AQNr" +
+	"bngBBDdrJa8BAQQ3ayevAQEEN3InrwEBBDdyJa8BAQI/0wYBBSsCJ243AQUrAiVu" +
+	"NwEHKwJyJ7sBbjcBBysCayW7AW43AQY/KwJT/ym/AgEFP5IGrwECOwEGkgavAQIn" +
+	"bjcBBpIGrwECJW43AQLhAXgBBj8rAnInkQUBBj8rAmsnkQUBBj8rAmslkQUBBj8r" +
+	"AnIlkQUBBj94AnInkQUBBj94AmsnkQUBBj94AmslkQUBBj94AnIlkQUBA3InrwEB" +
+	"A3IlrwEBA2snrwEBAz+3ASsBAze3ASsBBD+3AQMrAQQ3twEDKwEDayWvAQEEN6gG" +
+	"AtoHAQH2BwEB+wwBAZYTAQG3LQECN+8CAQI/7wIBAZ4MAQHeXQEBqAYBAu4VrhsB" +
+	"AsEQrhsBAckzAQGmEgECN7YHAQI/tgcBAu8GZAEE7wZkAtUGAQTvBmQC/AEBAdwH" +
+	"AQT7DAKICr44AQKFDJYWAQM/kg+sCQEDN5IPrAkBAYlZAQX4AdsC5hC4BNMGAQQ3" +
+	"J5sCagEENyWbAmoBBD8nmwJqAQQ/YZsCagEEPyWbAmoBBD9zmwJqAQOQExjzFgEC" +
+	"oysBAQK9UwEBAvopAQEBxBYBAcMmAQLqT5EDAQLrBNUEAQORA0qzRgEDkQNKg0IB" +
+	"A+8CGMEKAQLYBgsBAuMlhlkBA/ANGORZAQK1EgsBAukIvAcBA8kJKPMNAQPJCSjb" +
+	"GQEDyQkoyQcBA8kJKMgTAQPJCSjoBwEDyQko6QYBA8kJKJ0JAQPJCSiYBgEDyw9K" +
+	"3TUBAz/lDWgBAz+TBWgBAzeTBWgBBD+oBgLaBwEDwAjuA8YEAQPEEe4DxgQBAbMO" +
+	"AQLVCgEBAZgGAQKPCAEBAb1CAQGAVgEBiR8BAY0bAQHIDAEB7iYBAYpdAQG4PAEB" +
+	"iSsBAd1FAQGTYgEB8kUBAZJWAQHbVQEBkysBAd8mAQG5UQEDP9gB8wUBAz/YAZ8G" +
+	"AQM/2AGxBwEDP9gBlQcBAz/YAdMEAQM/2AGvBwEDN9gB8wUBAzfYAZ8GAQM32AGx" +
+	"BwEDN9gBlQcBAzfYAdMEAQM32AGvBwEDN9Ud3ggBAz+4BN4IAQM/rgPeCAEDN5sQ" +
+	"3ggBAz/VHd4IAQM3uATeCAEDN64D3ggBAz+bEN4IAQKFDNpaAQLuA+YEAQLpA+YE" +
+	"AQOdDekDtQwBA50Nnwu1DAEDug7pCQEBA7oOgBIBAQO6DosKAQEDhgPrAZEDAQPd" +
+	"AusBkQMBA4QflggLAQWWCAsoyV+GCgEFlggLKMtfhgoBBZYICyj4HoYKAQWWCAso" +
+	"+R6GCgEFlggLKPoehgoBBZYICyj7HoYKAQWWCAsozF+GCgEFlggLKLo800gBBDeE" +
+	"H5YICwED5VPVDgsBA8hQ1Q4LAQP2UNUOAQEC9xMLAQL6CMg6AQL6CMk6AQL6CMo6" +
+	"AQL6CMs6AQL6CMw6AQL6CM06AQU/eAI7JQEFP3gCNosBAQY3eAI/OyUBBjd4AjY/" +
+	"iwEBA88IKLwHAQPPCCjpCAEEuQgouhi8BwEEuQgo7hnpCAEEuQgo7hm8BwEEuQgo" +
+	"uhjpCAECP6AHAQI3oAcBA/ANGIwcAQHZFQEC8gb6WwEDuAlK8CUBAYtWAQHQFQEB" +
+	"8wsBAfYQAQO4CUq1PgECnicLAQGXOwEDhAo/7wIBBEHOEewWJQEEQc4R7BYnAQL2" +
+	"YgEBA44Bo2IBAQPkC9UKAQED5AuPCAEBBddB1QoYjwgBAQSPCBjVCgEBBI8IAkgB" +
+	"AQiPCAJIGI8IGNUKAQEFU48IAkgBAQWsAY8IAkgBAQO3AT94AQO3ATd4AQS3AQM/" +
+	"eAECxUgLAQKYNgsBA7xg9BsLAQGuLQECxDvJYAEB8EsBAc0rAQGdUAEBt0IBAflh" +
+	"AQH9KwEDN8YE/kUBAf1YAQGqWAEB9VIBAbZYAQKtWpQHAQGoKQECZcwIAQM/xg2t" +
+	"BQEDP8YN8wUBAzfGDa0FAQM3xg3zBQEDlhOADZQTAQOoBvoP9gcBAYgKAQI3lhMB" +
+	"BMgT9gcYiAoBBKABP5IPrAkBBKABN5IPrAkBBD+uA98CKwECnATfGQECiDb0CAEB" +
+	"4U8BAYwcAQL0CJhaAQT1DQI/kQMBAy2cBN8ZAQHSKwEC4APUEAEF2APUTyf/DPIM" +
+	"AQU39R4n/wzyDAEFP/UeJ/8M8gwBBDcn3hnRGgEEPyfeGdEaAQO/F8cdAQEESz/0" +
+	"Bq8BAQMnwgPUEAECZdwHAQjlCbsB3wI/eN8CNysBAjfbEwED3BwnyTkBA9wcJ8o5" +
+	"AQSUJwsoiR8BCEt4AkgYNosBJgEB2w4BA6lUhxnbDgEDzkWHGdsOAQLPAtsOAQQ3" +
+	"kQPMAoETAQKkWcFZAQHgFgEBjxABAuE+gFoBA/YQgA2LQAED9hAChT4BBJ8MCyj7" +
+	"RQEBnQkBA/sMzAK8GAEBixgBA6AH3wLkGAEB7ToBAd5VAQMrTsAKAQGKWgECjAnD" +
+	"HQEDrwUClAcBAb0TAQONCfUPCwED0BiwPQsBAsA7nlIBBNwLzAI3KwEHP6AHAqwB" +
+	"TDe0WwEDN+4SmQsBA3KgFpkLAQI3mQsBA2ugFpkLAQI/mQsBBD9L1QYqAQO2B5YJ" +
+	"AQECmh6/FwEB7AgBAb0IAQKRBOQQAQKRBKwEAQKRH6wEAQKwH6wEAQNrJdoOAQHa" +
+	"DgEDciXaDgECP6AbAQI3oBsBAtUGKgEDS9UGKgEC/gb8AQEDS/4G/AEBAu8G/AEB" +
+	"A0vvBvwBAQOECiGRAwEDSyGRAwEDsQHQApEDAQRLsQHQApEDAQIHkQMBBIoHPweR" +
+	"AwEDhAoHkQMBAq1IkQMBA+8CSo01AQNOmQq1AwEDTvMPtQMBBEtO8w+1AwEDTsMs" +
+	"tQMBBDdO+APvAgEEP074A+8CAQHDWgEE5QyECj/vAgEDLT/vAgEEsQHQAjfvAgEE" +
+	"N9ACP+8CAQOECjfvAgEES4QKN+8CAQLUCO8CAQOKBz/vAgECS7UDAQOxAdACtQMB" +
+	"A3T5BbUDAQR0+AM37wIBBHT4A9QI7wIBBHX4AzfvAgEFdPgD1Bw37wIBBkt0+APU" +
+	"HDfvAgEEzAb4AzfvAgEDmwj4A7UDAQKZCrUDAQSxAdACmQq1AwEDS5kKtQMBBnWF" +
+	"HDcYP90NAQI33Q0BAj/dDQEFdIUchAo33Q0BBi2xAdACdPgD7wIBBEuZCtQItQMB" +
+	"AZUTAQPLE8lelRMBA0vdFpUTAQHWHQECS9YdAQLzD7UDAQR0mQqtHLUDAQVLdJkK" +
+	"rRy1AwECkQMqAQOKBz94AQTOAWWRAyoBBWslwBc/KwEFciXAFz8rAQVrJYoHPysB" +
+	"BXIligc/KwEEN8sEKtcCAQQ/ywQq1wIBBD/sBCrXAgEFN64DvgU//AEBBEvsBCoL" +
+	"AQN6U3cBA7cBU3cBA0tTdwEHS4wCoAHnArwEKtcCAQZLjALnArwEKtcCAQdLM6AB" +
+	"5wK8BCrXAgEGSzPnArwEKtcCAQdLhwGMAucCvAQq1wIBB0uHATPnArwEKtcCAQXF" +
+	"BeIHwAwB1wIBBEvsBCrXAgEFS7gE7AQq1wIBA0s3uAQBBdsCSze4BNMGAQLmELgE" +
+	"AQTbAuYQuATTBgEEtwEntwPXAgEEtwEltwPXAgEFtwHxCye3A9cCAQW3AfELJbcD" +
+	"1wIBBbcBygXcAoEB1wIBBbcBkgXcAoEB1wIBBkvKBdwCvAQq1wIBBkuSBdwCvAQq" +
+	"1wIBBUvKBdwCgQHXAgEFS5IF3AKBAdcCAQZ6J/sEpASBAdcCAQZ6JfsEpASBAdcC" +
+	"AQW3ASf8A4EB1wIBBbcBJfwDgQHXAgEFnwTOAS0OMQEFnwTOAS0ONgEFnwTOAS0O" +
+	"QQEFnwTOAS0OTgEFnwTOAS0OVQEFnwTOAS0OdQEFnwTOAS0OgAEBBZ8EzgEtDnQB" +
+	"BZ8EzgEtDogBAQWfBM4BLR2GAgEFnwQtLw4xAQWfBC0vDjYBBZ8ELS8OQQEFnwQt" +
+	"Lw5OAQWfBC0vDlUBBZ8ELS8OdQEFnwQtLw6AAQEFnwQtLw50AQWfBC0vDogBAQWf" +
+	"BC0vHYYCAQafBM4BLS8OMQEGnwTOAS0vDjYBBp8EzgEtLw5BAQafBM4BLS8OTgEG" +
+	"nwTOAS0vDlUBBp8EzgEtLw51AQafBM4BLS8OgAEBBp8EzgEtLw50AQafBM4BLS8O" +
+	"iAEBBp8EzgEtLx2GAgEES9cDWxsBA0tgAQEDS74FAQEDS/sIAQEES/IB3QIbAQNL" +
+	"WxsBBEu8Ad0CGwEEuTjnBFsbAQRL0lRbGwEDngJbGwEES54CWxsBBPMGngJbGwEF" +
+	"S/MGngJbGwEDN1sbAQSrC9UTW58CAQSrC5sqW58CAQM3W58CAQdLN8UFtgEYWxsB" +
+	"B0s3xQW+ARhbGwEE3R03WxsBBUvBMzdbGwEE5hI/WxsBBMkRP1sbAQXwD4oHP1sb" +
+	"AQXwEIoHP1sbAQZLa/8OP1sbAQZLcv8OP1sbAQaRCGv/Dj9bGwEC/APhBAEGkQhy" +
+	"/w4/WxsBBS1LP1sbAQORY1sbAQS0CvIB3QIbAQO0ClsbAQS0CrwB3QIbAQVLtAry" +
+	"Ad0CGwEES7QKWxsBBUu0CrwB3QIbAQOeXVsbAQRLn11bGwEDph9bGwEES6YfWxsB" +
+	"A99PWxsBAzP8A+EEAQNB/DXcAgEGP68BtQgDP68BAQG5EgECsQH5BAECsQH6BAEE" +
+	"J/wcmA27DQEEJfwcmA27DQEE3QECO90FAQS/BZQFqBz5BAED+gSoHJQFAQVTdwKs" +
+	"AUgBAwzDBrsBAQKqAfsIAQMM5Qm7AQEDZcMEGAEDZcME3QEBBT+uAwKxCDsBAxgC" +
+	"OwEE9gZKggq+AQEFayX1AwI7AQVyJ/UDAjsBAyeoEoYOAQMlqBKGDgEDkgOoEoYO" +
+	"AQPhAWGMBAED4QFzjAQBBScYJTPMCQEEJxgljAQBAif2EQEDqgEljAQBA6oBJ4wE" +
+	"AQVhjAQCeCYBBfkGxRCXB6wB+lQBAz+hEK4DAQY/oRCuAwJtnwgBBj+hEK4DAluf" +
+	"CAEFPysCbZ8IAQU/KwJbnwgBBQwnPyuBAQEFDCU/K4EBAQQMJ9wCgQEBBAwl3AKB" +
+	"AQEFDCcz3AKBAQEFDCUz3AKBAQEGDCc/+wSkBIEBAQYMJT/7BKQEgQEBBAwn8Qu3" +
+	"AwEEDCXxC7cDAQO+AdkHGwEDtgHZBxsBBLgFmxh4GwEElwabGHgbAQUlGwItYAED" +
+	"qgFtGwEDqgFbGwEEqgEnJRsBBKoBbTMbAQSqAVszGwEFqgEnJTMbAQWqAW0b/gN3" +
+	"AQWqAVsb/gN3AQaqAW0zG/4DdwEGqgFbMxv+A3cBBKoBW6APGwEDRUShFgEDRUSy" +
+	"NgEDRUSyNwEDRUSzNgEDRUTyNwEDRUTzNgEDRUSzNwEDRUS0NgEDRUSSOAEDRUST" +
+	"NwEDRUTTNwEDRUTUNgEDRUTzNwEDRUT0NgEDRUS0NwEDRUS1NgEDRUSiOAEDRUSj" +
+	"NwEDRUTjNwEDRUTkNgEDRUSDOAEDRUSENwEDRUTENwEDRUTFNgEDRUSTOAEDRUSU" +
+	"NwEDRUTUNwEDRUTVNgEDRUT0NwEDRUT1NgEDRUS1NwEDRUS2NgEDRUSqOAEDRUSr" +
+	"NwEDRUTrNwEDRUTsNgEDRUSLOAEDRUSMNwEDRUTMNwEDRUTNNgEDRUSbOAEDRUSc" +
+	"NwEDRUTcNwEDRUTdNgEDRUT8NwEDRUT9NgEDRUS9NwEDRUS+NgEDRUSjOAEDRUSk" +
+	"NwEDRUTkNwEDRUTlNgEDRUSEOAEDRUSFNwEDRUTFNwEDRUTGNgEDRUSUOAEDRUSV" +
+	"NwEDRUTVNwEDRUTWNgEDRUT1NwEDRUT2NgEDRUS2NwEDRUS3NgEDRUSuOAEDRUSv" +
+	"NwEDRUTvNwEDRUTwNgEDRUSPOAEDRUSQNwEDRUTQNwEDRUTRNgEDRUSfOAEDRUSg" +
+	"NwEDRUTgNwEDRUThNgEDRUSAOAEDRUSBNwEDRUTBNwEDRUTCNgEDRUSnOAEDRUSo" +
+	"NwEDRUToNwEDRUTpNgEDRUSIOAEDRUSJNwEDRUTJNwEDRUTKNgEDRUSYOAEDRUSZ" +
+	"NwEDRUTZNwEDRUTaNgEDRUT5NwEDRUT6NgEDRUS6NwEDRUS7NgEDRUSrOAEDRUSs" +
+	"NwEDRUTsNwEDRUTtNgEDRUSMOAEDRUSNNwEDRUTNNwEDRUTONgEDRUScOAEDRUSd" +
+	"NwEDRUTdNwEDRUTeNgEDRUT9NwEDRUT+NgEDRUS+NwEDRUS/NgEDRUSkOAEDRUSl" +
+	"NwEDRUTlNwEDRUTmNgEDRUSFOAEDRUSGNwEDRUTGNwEDRUTHNgEDRUSVOAEDRUSW" +
+	"NwEDRUTWNwEDRUTXNgEDRUT2NwEDRUT3NgEDRUS3NwEDRUS4NgEDRUSwOAEDRUSx" +
+	"NwEDRUTxNwEDRUTyNgEDRUSROAEDRUSSNwEDRUTSNwEDRUTTNgEDRUShOAEDRUSi" +
+	"NwEDRUTiNwEDRUTjNgEDRUSCOAEDRUSDNwEDRUTDNwEDRUTENgEDRUSpOAEDRUSq" +
+	"NwEDRUTqNwEDRUTrNgEDRUSKOAEDRUSLNwEDRUTLNwEDRUTMNgEDRUSaOAEDRUSb" +
+	"NwEDRUTbNwEDRUTcNgEDRUT7NwEDRUT8NgEDRUS8NwEDRUS9NgEDRUStOAEDRUSu" +
+	"NwEDRUTuNwEDRUTvNgEDRUSOOAEDRUSPNwEDRUTPNwEDRUTQNgEDRUSeOAEDRUSf" +
+	"NwEDRUTfNwEDRUTgNgEDRUT/NwEDRUSANwEDRUTANwEDRUTBNgEDRUSmOAEDRUSn" +
+	"NwEDRUTnNwEDRUToNgEDRUSHOAEDRUSINwEDRUTINwEDRUTJNgEDRUSXOAEDRUSY" +
+	"NwEDRUTYNwEDRUTZNgEDRUT4NwEDRUT5NgEDRUS5NwEDRUS6NgEDRUSvOAEDRUSw" +
+	"NwEDRUTwNwEDRUTxNgEDRUSQOAEDRUSRNwEDRUTRNwEDRUTSNgEDRUSgOAEDRUSh" +
+	"NwEDRUThNwEDRUTiNgEDRUSBOAEDRUSCNwEDRUTCNwEDRUTDNgEDRUSoOAEDRUSp" +
+	"NwEDRUTpNwEDRUTqNgEDRUSJOAEDRUSKNwEDRUTKNwEDRUTLNgEDRUSZOAEDRUSa" +
+	"NwEDRUTaNwEDRUTbNgEDRUT6NwEDRUT7NgEDRUS7NwEDRUS8NgEDRUSsOAEDRUSt" +
+	"NwEDRUTtNwEDRUTuNgEDRUSNOAEDRUSONwEDRUTONwEDRUTPNgEDRUSdOAEDRUSe" +
+	"NwEDRUTeNwEDRUTfNgEDRUT+NwEDRUT/NgEDRUS/NwEDRUTANgEDRUSlOAEDRUSm" +
+	"NwEDRUTmNwEDRUTnNgEDRUSGOAEDRUSHNwEDRUTHNwEDRUTINgEDRUSWOAEDRUSX" +
+	"NwEDRUTXNwEDRUTYNgEDRUT3NwEDRUT4NgEDRUS4NwEDRUS5NgEGW+QFGwJTSAEH" +
+	"W+QFGwIzU0gBBm0zGwJTSAEGWzMbAlNIAQcnJTMbAlNIAQVb5AUb/gN3AQVtMxv+" +
+	"A3cBBVszG/4DdwEFtgEbAqwBSAEFvgEbAqwBSAEDvgHwAhsBA7YB8AIbAQRtM9ID" +
+	"GwEEWzPSAxsBBG3wAtIDGwEEW/AC0gMbAQVb5AXwAtIDGwEFWxsCywPiBwEEvgEb" +
+	"QncBBLYBG0J3AQdbGwLpAgJTSAEIWxsC6QICM1NIAQVb5AUbAukCAQhb5AUbAukC" +
+	"AlNIAQlb5AUbAukCAjNTSAECbZMNAQJbkw0BA20zkw0BA1szkw0BBW0bQjeuAwEF" +
+	"WxtCN64DAQdtG/4Dd0I3rgMBB1sb/gN3QjeuAwEGvAGGAxjyAd0CGwEGvAHdAhjy" +
+	"AYYDGwEFvAGGAxsCUgEFvAHdAhsCUgEF8gHdAhsCUgEF8gGGAxsCUgEHvAGGAxsY" +
+	"vAHdAhsBB7wB3QIbGPIB3QIbAQfyAd0CGxjyAYYDGwEH8gGGAxsYvAGGAxsBBcMG" +
+	"uwGcBOUJuwEBBeUJuwGcBMMGuwEBB/IB3QIbnAS8Ad0CGwEHvAHdAhucBPIB3QIb" +
+	"AQblCbsBnAS8Ad0CGwEGwwa7AZwE8gHdAhsBB7wB3QIbnAS8AYYDGwEHvAGGAxuc" +
+	"BLwB3QIbAQWZBBubAq4XJQEGG5sCW+AI3Qu+AQEGG5sCW+AI3Qu2AQEGG5sCtgHg" +
+	"CN0LbQEGG5sCtgHgCN0LWwEEq1TCBZcGGwEE0EXCBbgFGwEEswPCBbgFGwEE9APC" +
+	"BbgFGwEGswPCBZcGGwK+BQEGswPCBbgFGwJgAQVrJfoSlwYbAQVrJ/oSuAUbAQS4" +
+	"BcIDeBsBBJcGwgN4GwEGWxsmtQJtGwEGbRsmtQJbGwEGtQJbGyZtGwEFWxsCYDkB" +
+	"BW0bAmA5AQRbG8oG/AEBBiclG8oGA3gBBr4B5AUb/gMDeAEHJ7oBYSW6AXPZAgEH" +
+	"J7oBcyW6AWHZAgEHYboBJXO6ASfZAgEHYboBJ3O6ASXZAgEHJ7oBYSW6AWHZAgEH" +
+	"YboBJXO6ASXZAgEHJ7oBcyW6AXPZAgEHYboBJ3O6ASfZAgEHbdkCAroBYUJ3AQdb" +
+	"2QICugFhQncBB74B2QICugElQncBB7YB2QICugElQncBB23ZAgK6AXNCdwEHW9kC" +
+	"AroBc0J3AQe+AdkCAroBJ0J3AQe2AdkCAroBJ0J3AQdt2QICugFh/gN3AQdb2QIC" +
+	"ugFh/gN3AQe+AdkCAroBJf4DdwEHtgHZAgK6ASX+A3cBB23ZAgK6AXP+A3cBB1vZ" +
+	"AgK6AXP+A3cBB74B2QICugEn/gN3AQe2AdkCAroBJ/4DdwELbdkCAroBYSZt2QIC" +
+	"ugFzAQu+AdkCAroBJ8IHvgHZAgK6ASUBC1vZAgK6AWEmW9kCAroBcwELtgHZAgK6" +
+	"ASfCB7YB2QICugElAQtt2QICugFhJlvZAgK6AWEBC23ZAgK6AXMmW9kCAroBcwEL" +
+	"W9kCAroBYSZt2QICugFhAQtb2QICugFzJm3ZAgK6AXMBCG3ZAgK6AWEmqgHSAwEI" +
+	"bdkCAroBczmqAdIDAQhb2QICugFhJqoB0gMBCFvZAgK6AXM5qgHSAwELvgHZAgK6" +
+	"ASfCB7YB2QICugElAQu2AdkCAroBJ8IHvgHZAgK6ASUBBiUzGwLHBtIEAQX9AwEm" +
+	"WxsBBbkBsAMmWxsBBW0bJrkBsAMBBVsbJrkBsAMBBlsbJpQGpAFCAQTtAiZtGwEE" +
+	"bRvKBu0CAQTsAiZbGwEE+QQmWxsBBG0bygb5BAEE+gQmbRsBAyeBCOkCAQMlgQjp" +
+	"AgEDYYEI6QIBA3OBCOkCAQTwAlN3uw0BA94BlwLWWgEE3gGXAsAPmwQBBCc//AOB" +
+	"AQEEJT/8A4EBAQMnP7cDAQMlP7cDAQXeAZcCJ8kIgQEBBd4BlwIlyQiBAQEF3gGX" +
+	"AiecFoEBAQXeAZcCJZwWgQEBBScrgQECiQUBBSUrgQECiQUBCCcrgQECnwjfArMD" +
+	"9QMBCCUrgQECnwjfAvQD9QMBCCcrgQECnwjfAvQD9QMBCCUrgQECnwjfArMD9QMB" +
+	"BSfcAoEBAjsBBSXcAoEBAjsBBCfCBe0CgQEBBCXCBewCgQEBBTMnwgXsAoEBAQUz" +
+	"JcIF7QKBAQEFJzf7BKQEgQEBBSU3+wSkBIEBAQLLA+4LAQNTvge/AgEEqQfcAoIK" +
+	"JwEFJdwCpQQCKwEFqQcl3AICOwEE3AICY90FAQLfAdwCAQSaE9wCggonAQSaE9wC" +
+	"ggphAQKgAdwCAQL4AdwCAQPcAgKJBQEE+AHcAgKJBQEEiwbcAoIKYQEEiwbcAoIK" +
+	"cwEMqQfcAgKxAaIFgAnfAhubAmEYJQEMqQfcAgKxAaIFgAnfAhubAmEYJwEMqQfc" +
+	"AgKxAaIFgAnfAhubAnMYJQEMqQfcAgKxAaIFgAnfAhubAnMYJwEMqQfcAgKxAaIF" +
+	"gAnfAhubAiUYYQEMqQfcAgKxAaIFgAnfAhubAicYYQEMqQfcAgKxAaIFgAnfAhub" +
+	"AiUYcwEMqQfcAgKxAaIFgAnfAhubAicYcwED+AG0BtsIAQS0BtsIAoAHAQa0BtsI" +
+	"AgN4JgEGtAbbCAIlGyYBBrQG2wgCJxsmAQR4AqwBdwEDLVN3AQItkwgBAy2/BZQF" +
+	"AQItuRIBDHjFEJcHrAF3GLMDbsUQlwdTdwEEeALnW/wBAQQtyib7CAEBBGEbygZ4" +
+	"AQMtP9MGAQIt0wYBAi3tAgECLewCAQd4AgN4QsYDJQEIeAI2rAGqE0LGAyUBBGXD" +
+	"BrsBpgYBBGXlCbsBpgYBAmW1AwEDZQN4AQJlKwEDNo4Mnw8BBK8BAjsmAQOvAQKJ" +
+	"BQEDY98CrwEBBa8BAosP2QT0AwEFJa8BJievAQEFJ68BwgdTdwEFU3fCByWvAQEF" +
+	"og0CJ243AQWiDQIlbjcBAjeiDQEFFgInbjcBBRYCJW43AQI/9w0BAjf3DQEDJ7oL" +
+	"7gsBAyW6C+4LAQQnM7oL7gsBBCUzugvuCwECxUHmCgEDrAuSAeYKAQXmCosbAlN3" +
+	"AQK0OPYRAQQrAtAz9U8BAsZB0QYBAslZhQcBBf0DARjWBJMIAQj9AwEY1gSTCAK5" +
+	"ASYBBfwNQhjWBJMIAQKOPZJbAQHEXQEG9AavAQInbjcBBvQGrwECJW43AQU3rgMC" +
+	"cxsBAjf5BgEFP3gCcxsBBTd4AnMbAQPSCj8rAQPSCjcrAQPSCj+uAwED0go3rgMB" +
+	"A9IKP3gBA9IKN3gBAftUAQO/BZQFsAMBA5QFAoAHAQW/BZQFAqwBSAECrwaUBQED" +
+	"rwa/BZQFAQIzYAEC8AJgAQTKBcUF3AKBAQEEkgXFBdwCgQEBAe8MAQHjSQEEigYt" +
+	"O7ADAQSKBi1gsAMBBIoGLRawAwEFigbRBbADAjsBBYoG0QWwAwJgAQSKBivwBbAD" +
+	"AQSKBivRBbADAQQ2wwQYsAMBBDbDBN0BsAMBA4oGFrADAQOESjakCwED5wwC/gQB" +
+	"A9kH/gSwAwED/zqyEv4EAQT+BAIzSAEE/gSxJwKmBgECqCyQGAECuAX/DQEHvwL/" +
+	"DQLTHMIM6Q/KDAEHvwL/DQL6EsIM6Q/KDAEGvwL/DdICw0HGA8oMAQX+BOkPFOcE" +
+	"sAMBA+5S/gSwAwEG/gQCbRsCUgEE/gQCFgEBA/4EAvAFAQP+BALRBQED/gQCgAcB" +
+	"A/4EAokFAQGGDgEE4QEnrwGwAwEE3gGXAvMSnxABBN4BlwLzErVRAQTeAZcC8xKI" +
+	"UgEGYAECA3gmAQZgAQKLAsQCJgEFYAECuQEmAQVgAQI7OQEFYAECuQE5AQVgAQLk" +
+	"AzYBBWABAjevAQEFvgUBAucCJgEFvgUBAjs5AQW+BQEC5QmLAQEFvgUBAsMGiwEB" +
+	"BmAB3wInbngBBmAB3wIlbngBBL9h3QGRA4UHAQX+BgECOyYBBP4GAQKJBQEF3gyF" +
+	"BwL0A8IDAQLKHYUHAQb+BgHfAidueAEG/gYB3wIlbngBBi3+BgECiwLEAgEF/gYB" +
+	"3wIzeAEDLfsIAQEEYAHfAq8BAQS+BQHfAq8BAQT+BgHfAq8BAQKGGYUHAQOsVIYZ" +
+	"hQcBBN4BlwLxU58QAQOmJt0B7hYBA/AFAjsBBNEFAr4FAQED0QUCgAcBA/AFAoAH" +
+	"AQTwBQLDBBgBBNEFAsME3QEBA9EFJvAFAQPwBSbRBQEF0QUmdybwBQEF8AUmdybR" +
+	"BQEG0QXCBxiODALRBQEG8AXCBxiODALwBQEEwgPRBQKLDwEEwgPwBQKLDwEDMyvw" +
+	"BQEDMyvRBQEHwgPRBQKLDxjKHYUHAQXDBBgCOyYBBcME3QECOyYBAzPDBBgBAzPD" +
+	"BN0BAQQ2iRnDBBgBBDaJGcME3QEBA8Yd4QHdAQEDxh3hARgBBcME3QG9DMMEGAEF" +
+	"wwQYAkziBwEFwwTdAQJM4gcBBcMEGAKsAdIDAQXDBN0BAqwB0gMBBcMEGAIzgAcB" +
+	"BMMEGAKJBQEFwwQYAjOJBQEEA80JAokFAQXDBN0BAjOABwEFwwTdAQIziQUBBN4B" +
+	"lwKtNt8VAQTeAZcC0wzfFQEF/QMBAjs5AQT8DQI7JgEH8AKsAXcCM1NIAQfwAqwB" +
+	"dwLwAlNIAQW5AbADAjsmAQW5AbADAsMGiwEBA5oIvgWaCAEExjMCOyYBA/0DArUD" +
+	"AQaUBqQBQgKLAsQCAQWtCqQB3QGkAUIBBf0DASZgAQEFYAEm/QMBAQX9AwEmuQGw" +
+	"AwEDM5sEpAEBBDbrFv0DtB0BBEHrFv0DtB0BCv0DAQI2iwEmGDaLATkBBbUGAk6L" +
+	"ASYBBO0CAnjdBQEE7AICeN0FAQXtAgLLBComAQXsAgLLBComAQXtAt0B1gSkAUIB" +
+	"BewC3QHWBKQBQgEI7QLdAdYEpAFCAjvdBQEI7ALdAdYEpAFCAjvdBQEI7QLdAdYE" +
+	"pAFCAjsmAQjsAt0B1gSkAUICOyYBCe0C3QHWBKQBQgI7JiUBCewC3QHWBKQBQgI7" +
+	"JicBA+0C3QGRDQED7ALdAZENAQbtAhieC9ICpAFCAQbsAhieC9ICpAFCAQTtAhjS" +
+	"ApENAQTsAhjSApENAQbtAib5B6QBJuwCAQbsAib5B6QBJu0CAQXtAiaaCN0BpAEB" +
+	"BewCJpoI3QGkAQEF7QImmggm7AIBBewCJpoIJu0CAQbtAibsAib5B6QBAQbsAibt" +
+	"Aib5B6QBAQntAibWBKQBJuwCJtYEpAEBCewCJtYEpAEm7QIm1gSkAQEF1gSkAULd" +
+	"Ae0CAQXWBKQBQt0B7AIBCNYEpAFC3QHtAgI73QUBCNYEpAFC3QHsAgI73QUBBfkH" +
+	"pAFC3QHtAgEF+QekAULdAewCAQb5B9YEpAFC3QHtAgEG+QfWBKQBQt0B7AIBA5oI" +
+	"3QHtAgEDmgjdAewCAQaaCCbtAib9AwEBBpoIJuwCJv0DAQEDM68M7QIBAzOvDOwC" +
+	"AQUzrwztAgKJBQED7AK9DO0CAQPsAsIH7QIBBO0CwgOXB+cDAQTsAsIDlwfnAwEH" +
+	"7QLCA5cH5wMm1gSkAQEH7ALCA5cH5wMm1gSkAQECyR2qCwEC4RmqCwEFyR2qC90B" +
+	"pAFCAQXhGaoL3QGkAUIBBf0DAQLBKiYBBYQHJp4L/QMBAQWMByaeC/0DAQEGhAcm" +
+	"ngvSAqQBQgEGjAcmngvSAqQBQgEEhAcm/QMBAQSMByb9AwEBBYQHJtICpAFCAQWM" +
+	"BybSAqQBQgEFhAcmlAakAUIBBYwHJpQGpAFCAQaEBybSApQGpAFCAQaMBybSApQG" +
+	"pAFCAQIzhAcBAjOMBwED+QQCOwED+gQCOwEF+QQCYAE5AQX6BAJgATkBBfkEAv4G" +
+	"ATkBBfoEAv4GATkBCPkESt0BpAFCAjsmAQj6BErdAaQBQgI7JgEF+QRKJv0DAQEF" +
+	"+gRKJv0DAQEF+QRKJrkBsAMBBfoESia5AbADAQb5BEomlAakAUIBBvoESiaUBqQB" +
+	"QgEG+QRKJtICpAFCAQb6BEom0gKkAUIBBSsnsQFksAMBBSslsQFksAMBAsID+QQB" +
+	"AsID+gQBBcID+QTdAaQBQgEFwgP6BN0BpAFCAQP5BCb6BAED+gQm+QQBA/kEJvkE" +
+	"AQP6BCb6BAED+gTCB/kEAQj6BMIHGI4MlwfSAwL5BAEE9gZKggq2AQEEmxwCqQuz" +
+	"AwECwF7wBQEBrDsBAbBNAQO1AieMBAEDtQJzjAQBA7UCYYwEAQO5EgJjAQVTd/AC" +
+	"JcwJAQUzU3cnzAkBBVN3MyfMCQEGM1N3MyfMCQEIqgHSA/4DJ7IOSjNTAQW1AnOM" +
+	"BAKABwEFtQJhjAQCiQUBB7UCYYwEJrUCc4wEAQMzc4wEAQMzYYwEAQQzSNICAQEF" +
+	"+AEzSNICAQEHmgfSAsQQAvgB50umBgEFU78CAngmAQVTvwICeDkBBXOMBAJ4OQEE" +
+	"kwgCrAFIAQSTCAK5AbADAQXwAlN3mhb2DgEG8AJTdwKsAUgBA/ACmwSwAwED8AKv" +
+	"DO0CAQPwAq8M7AIBBvkH1gTtAt0BpAFCAQb5B9YE7ALdAaQBQgEE8AKUBZoW9g4B" +
+	"BeEB8AJTd7ADAQMzlAWwAwEDP1N3AQSKBj9TdwEEvAHdAj8bAQS8AYYDPxsBBPIB" +
+	"3QI/GwEE8gGGAz8bAQQnJT8bAQNtNxsBA74BNxsBA7YBNxsBBLwB3QI3GwEEvAGG" +
+	"AzcbAQTyAd0CNxsBBPIBhgM3GwEEJyU3GwEEYXM3GwEFWxsCwQW2AQEFWxsCwQW+" +
+	"AQEFbRsCwQW2AQEFbRsCwQW+AQEFKwKzA243AQUrAvQDbjcBBysCciW7AW43AQcr" +
+	"AmsnuwFuNwEFrgMCJ243AQWuAwIlbjcBBa4DArMDbjcBBa4DAvQDbjcBAssDKwED" +
+	"N+EBKwEDP+EBKwEEN7IEAysBBD+yBAMrAQI3xAwBAj/EDAECP4kRAQI3iREBA6wB" +
+	"N4kRAQM34QF4AQM3twGuAwEDP7cBrgMBAze3AfkGAQM/twH5BgEDNwOuAwEDNwP5" +
+	"BgEDPwP5BgEDN6wBzw0BAz+sAc8NAQM3U88NAQM/U88NAQUnGwIDeAEDQW3/BQEF" +
+	"JxsCLWABBKoBbaAPGwEGbeQFGwJTSAEHbeQFGwIzU0gBBW3kBRv+A3cBBW3kBfAC" +
+	"0gMbAQVtGwLLA+IHAQdtGwLpAgJTSAEIbRsC6QICM1NIAQVt5AUbAukCAQht5AUb" +
+	"AukCAlNIAQlt5AUbAukCAjNTSAEEbRvKBvwBAQWZBBubAq4XJwEF/QMBJm0bAQa/" +
+	"BbkBsAMmbRsBB20bJr8FlAakAUIBBFsbygbsAgEEWxvKBvoEAQNt2QcbAQNb2Qcb" +
+	"AQa/BbkBsAMmWxsBB1sbJr8FlAakAUIBBbkBsAMmbRsBBm0bJpQGpAFCAQZtGya/" +
+	"BbkBsAMBBlsbJr8FuQGwAwEEtgGeAr4HGwEEtQLWBLwBGwEEtQLxD/IBGwEDP7cB" +
+	"7wIBAzcD7wIBAz8D7wIBAzeSBcQMAQM/kgXEDAEDS+EBeAEFS7wMArwM3QUBBUt4" +
+	"AnjdBQECS3gBA0st3AcBBtYEvAEbAuIK0gQBBvEP8gEbAuIK6QIBBtYEvAEbAqwB" +
+	"6QIBBvEP8gEbAqwB6QIBB7UEG5sCtgHgCLwB3QIBCLUCtQQbmwK2AeAIvAHdAgED" +
+	"bZ4CGwEDvgGeAhsBA1ueAhsBA7YBngIbAQQnJZ4CGwEEYXOeAhsBBLwBhgOeAhsB" +
+	"BLwB3QKeAhsBBPIB3QKeAhsBBPIBhgOeAhsBBG2eAvMGGwEEvgGeAvMGGwEEW54C" +
+	"8wYbAQS2AZ4C8wYbAQWXBp4CsQF4GwEFuAWeArEBeBsBBW2eAhtCdwEFvgGeAhtC" +
+	"dwEFW54CG0J3AQW2AZ4CG0J3Awa8AYYDngIbQncBBrwB3QKeAhtCdwEG8gHdAp4C" +
+	"G0J3AQbyAYYDngIbQncBB22eAhsCM6wBSAEHvgGeAhsCM6wBSAEHW54CGwIzrAFI" +
+	"AQe2AZ4CGwIzrAFIAQOsAZ0IzAgBA1OdCMwIAQdtngIbkgFbngIbAQi+AZ4CG21K" +
+	"tgGeAhsBB1ueAhuSAW2eAhsBCLYBngIbbUq+AZ4CGwEEbZ4CqQn/BQEEvgGeAqkJ" +
+	"/wUBBFueAqkJ/wUBBLYBngKpCf8FAQVtNy0/GwEFvgE3LT8bAQVbNy0/GwEFtgE3" +
+	"LT8bAQW4BZ4CJfkMGwEFuAWeAvQD+QwbAQW4BZ4CJ/kMGwEFuAWeArMD+QwbAQLW" +
+	"DCcBAtYMJQEChxInAQKHEiUBBU71A/8F4ha4BQEDWzcbAgULKMAPFLI5AQWrC9UT" +
+	"bY0FnwIBBasLqlS+AY0FnwIBBasL1RNbjQWfAgEFqwvPRbYBjQWfAgEEN22NBZ8C" +
+	"AQQ3vgGNBZ8CAQQ3W40FnwIBBDe2AY0FnwIBB7YBngIbAqoBwQVtAQe2AZ4CGwKq" +
+	"AcEFWwEHvgGeAhsCqgHBBW0BB74BngIbAqoBwQVbAQdtngIbAqoBwQW+AQEHW54C" +
+	"GwKqAcEFvgEBB22eAhsCqgHBBbYBAQdbngIbAqoBwQW2AQEGN8UFtgEYbRsBBjfF" +
+	"BbYBGFsbAQY3xQW+ARhtGwEGN8UFvgEYWxsBBjfFBW0YvgEbAQY3xQVbGL4BGwEG" +
+	"N8UFbRi2ARsBBjfFBVsYtgEbAQTbBxtzJwEE2wcbcyUBBNsHG2EnAQTbBxthJQEE" +
+	"2wcbJ2EBBNsHGyVhAQTbBxsncwEE2wcbJXMBCL4BPxv+A3cCrAF3AQZhnwLfAhTV" +
+	"CGQBA70MP58PAQW9DD8YN58PAQO9DDefDwEF7wZkAnr8AQECLfwBAQMtGfwBAQM3" +
+	"K7EIAQM3rgOxCAEDoAE3xAwBA6wBN8wbAQI3zBsBBTe3AZIGrwGxCAEFN7cB9Aav" +
+	"AbEIAQU3twHKBa8BsQgBBTe3AZIFrwGxCAEDjRsPNgEEswNuN3gBBPQDbjd4AQV6" +
+	"TvgDN7UNAQbbAnpO+AM3tQ0BBD9O+AO1DQEF2wI/TvgDtQ0BAyuPC5YEAQKxYAEB" +
+	"Ar0YKgEDyAwPNgEDyAwPQQEDyAwPTgEDyAwPVQEBv14BAY5SAQGTJwEBtEABAZJR" +
+	"AQHtSwEDP8YEqFgBBDeuA8wCkQMBBNwTesYE/SYBAYA0AQHvPQEBz2UBAaVEAQHW" +
+	"JgEB5CUBAbBiAQHkUQEEJ2437wIBBCVuN+8CAQXvAgInbjcBBe8CAiVuNwEGbeQF" +
+	"GwKvAZQNAQa+AeQFGwKvAZQNAQZb5AUbAq8BlA0BBrYB5AUbAq8BlA0BA+sXDzEB" +
+	"A+sXDzYBAaNWAQSVC6sIC4xiAQSVC6sIC7hNAQSVC6sIC/dSAQSVC6sIC/kpAQSV" +
+	"C6sIC61YAQSVC6sIC8ReAQX9AwEC5go5AQK5YAsBArFYCwEC5AsLAQLNUAsBA/gB" +
+	"JdwCAQOvPtlQCwEEjAEFAO8PAQSMAQUAhhABBIwBBQDtEwEEjAEFAP4QAQSMAQUA" +
+	"yRABBIwBBQCIFAEEjAEFAJEUAQSMAQUAyQ0BBIwBBQCPFAEEjAEFAOkKAQWMAQUA" +
+	"V+kKAQSMAQUAOgEEjAEFAMcQAQSMAQUAqREBBIwBBQDQEQEEjAEFAKkMAQSMAQUA" +
+	"/xEBBIwBBQCjEgEEjAEFAMQSAQSMAQUA6BIBBIwBBQCSEwEEjAEFAOITAQSMAQUA" +
+	"6RMBBIwBBQDuEAEEjAEFAIgRAQSMAQUA4BsBBIwBBQCxAwEEjAEFAIUTAQSMAQUA" +
+	"oQoBBIwBBQDVCwEEjAEFAKgCAQSMAQUA6gcBBIwBBQDSDwEEjAEFAIMUAQWMAQUA" +
+	"2h2CAQEEjAEFAOYDAQWMAQUAA7QEAQeMAQUAA7QEAukCAQSMAQUApgQBBowBBQDn" +
+	"CgO0BAEFjAEFAK8GtAQBBowBBQDnCq8GtAQBBIwBBQDoCQEEjAEFAOoKAQSMAQUA" +
+	"qh0BBYwBBQDYHhQBBYwBBQDjGakMAQWMAQUAzBbVCwEEjAEDAO8PAQSMAQMAhhAB" +
+	"BIwBAwDtEwEEjAEDAP4QAQSMAQMAyRABBIwBAwCIFAEEjAEDAJEUAQSMAQMAyQ0B" +
+	"BIwBAwCPFAEEjAEDAOkKAQWMAQMAV+kKAQSMAQMAOgEEjAEDAMcQAQSMAQMAqREB" +
+	"BIwBAwDQEQEEjAEDAKkMAQSMAQMA/xEBBIwBAwCjEgEEjAEDAMQSAQSMAQMA6BIB" +
+	"BIwBAwCSEwEEjAEDAOITAQSMAQMA6RMBBIwBAwDuEAEEjAEDAIgRAQSMAQMA4BsB" +
+	"BIwBAwCxAwEEjAEDAIUTAQSMAQMAoQoBBIwBAwDVCwEEjAEDAKgCAQSMAQMA6gcB" +
+	"BIwBAwDSDwEEjAEDAIMUAQWMAQMA2h2CAQEEjAEDAOYDAQWMAQMAA7QEAQeMAQMA" +
+	"A7QEAukCAQSMAQMApgQBBowBAwDnCgO0BAEFjAEDAK8GtAQBBowBAwDnCq8GtAQB" +
+	"BIwBAwDoCQEEjAEDAOoKAQSMAQMAqh0BBYwBAwDYHhQBBYwBAwDjGakMAQWMAQMA" +
+	"zBbVCwEHBwUAbwIzdwEHBwMAbwIzdwEHBwUAbwJMuQEBBgcFAPoBAkgBBgcFAGIC" +
+	"6QIBBgcDABQCSAEHBwMAxQECuwFIAQYHBQDUAQLcAwEGBwMA1AEC3AMBBgcFAMcB" +
+	"AtwDAQYHAwDHAQLcAwEGBwUA3gEC3AMBBgcDAN4BAtwDAQQHBQCxAgEGBwUAsAEC" +
+	"UgEFBwUAoAEUAQUHBQCgAbECAQcHAwDLAQIlUgEGBwUAjgICUgEGBwMAjgICUgEG" +
+	"BwMAywECpAUBBQcFAG7UAQEFBwMAbtQBAQUHAwDxXIQFAQYHAwA4ArAbAQcHAwCg" +
+	"AWIC6QIBCAcDADUChwGfAd0FAQYHAAMFoAE4AQUH5AMDAJMCAQQ9AAXLAQEHBwUA" +
+	"YwKmC+kCAQcHBQDeAQKmC+kCAQRdBQDSFQEEXQMA0hUBBF0FAJIfAQRdAwCSHwEE" +
+	"XQUApwUBBF0DAKcFAQRdBQCEFwEEXQMAhBcBBF0FAMwNAQRdAwDMDQEEXQUA0x0B" +
+	"BF0DANMdAQRdBQDZDwEEXQMA2Q8BBF0FAM0YAQRdAwDNGAEEXQUArx4BBF0DAK8e" +
+	"AQRdBQD2GAEEXQMA9hgBBF0FAIoOAQRdAwCKDgEEXQUA5BkBBF0DAOQZAQRdBQC5" +
+	"BAEEXQMAuQQBBF0FAIkEAQRdAwCJBAEEXQUAlg4BBF0DAJYOAQRdBQA1AQRdAwA1" +
+	"AQRdBQCTAwEEXQMAkwMBBF0FAPQEAQRdAwD0BAEEXQUAiBMBBF0DAIgTAQRdBQD7" +
+	"BQEEXQMA+wUBBF0FALELAQRdAwCxCwEEXQUAxwcBBF0DAMcHAQRdBQCNDgEEXQMA" +
+	"jQ4BBF0FAIwGAQRdAwCMBgEEXQUA0A4BBF0DANAOAQVdBQD5CHYBBV0DAPkIdgEG" +
+	"XQUAJF27AwEGXQMAJF27AwEFXQUAuAjMDQEFXQMAuAjMDQEFXQUA+QiKDgEFXQMA" +
+	"+QiKDgEFXQUA+QiJBAEFXQMA+QiJBAEFXQUAuAiJBAEFXQMAuAiJBAEGXQUAJF3Q" +
+	"DgEGXQMAJF3QDgEEXQUAlgsBBF0DAJYLAQVdBQDyBrUJAQVdAwDyBrUJAQZdBQAk" +
+	"XbUJAQZdAwAkXbUJAQZdBQAkXdwFAQZdAwAkXdwFAQVdBQDOFfAKAQVdAwDOFfAK" +
+	"AQVdBQD5COMKAQVdAwD5COMKAQZdBQAkXeMKAQZdAwAkXeMKAQZdBQAkXYIBAQZd" +
+	"AwAkXYIBAQVdBQDUGYIBAQVdAwDUGYIBAQZdBQAkXdIYAQZdAwAkXdIYAQZdBQAk" +
+	"XfAJAQZdAwAkXfAJAQZdBQAkXdcKAQZdAwAkXdcKAQZdBQAkXbMXAQZdAwAkXbMX" +
+	"AQZdBQAkXYwKAQZdAwAkXYwKAQZdBQAkrAeMCgEGXQMAJKwHjAoBBl0FACSsB8EO" +
+	"AQZdAwAkrAfBDgEGXQUAJKwH4QYBBl0DACSsB+EGAQZdBQAkrAfyEwEGXQMAJKwH" +
+	"8hMBA10L7QoBBF0LuQT0BAEEXQuTA/QEAQNdC5hbAQRdC/sF9AQBBF0LjQ70BAEE" +
+	"XQuMCogTAQVdBQC4CLUJAQVdAwC4CLUJAQVdBQC4CNcKAQVdAwC4CNcKAQRdH4kE" +
+	"JgEEXR/bHYsnAQRdH9sd7BkBBV0FALAW8AoBBV0DALAW8AoGBV0krAeSA6kCAQZd" +
+	"JKwHwxDLBCoBBl0krAfKQcsEKgEFXSSsB+4TxgoBBF3MATFuAQNdkgOpAgEDXZRK" +
+	"xgoBBGYDAPMDAQRmAwCaDQEEZgMA6wkBBGYDAMQNAQRmAwD8AgEEZgMAyA8BBGYD" +
+	"AIkNAQRmAwCsDwEEZgMA3wIBBGYDAO4KAQRmAwCfDgEEZgMArQUBBGYDAKsMAQRm" +
+	"AwDMAgEEZgMA1g4BBGYDANoPAQRmAwCSCwEEZgMAlwsBBGYDAJcKAQRmAwDkCAEE" +
+	"ZgMA3Q4BBGYDAIwOAQRmAwDoDQEEZgMAzwwBBGYDAJgEAQRmAwCsDQEEZgMA1AYB" +
+	"BGYDAIwMAQRmAwCwDQEEZgMAqQ0BBGYDAM8PAQRmAwCFDgEEZgMA7w0BBGYDANQD" +
+	"AQRmAwCDDAEEZgMA/QQBBGYDAN8KAQRmAwD2DQIEZgMAvgsGBGYDAKgKAwPyAgCz" +
+	"AQED8gIAm2QBA/ICAJxkAQPyAgClZAED8gIApmQBBfICAJUWvxWBFAED8gIAgRQB" +
+	"A/ICAJ5kAQPyAgChZAED8gIAn2QBA/ICAKBkAQPyAgDOZAED8gIAo2QBA/ICAIIU" +
+	"AQTyAgDKCYIUAQPyAgCqZAED8gIA0Q8BBfICAJUWvxXRDwEE8gIAygnRDwED8gIA" +
+	"qGQBA/ICALwLAQPyAgC5HwEE8gIAygm5HwED8gIAvR8BBPICAMoJvR8BA/ICAAkB" +
+	"A/ICAIQUAQTyAgD+JYQUAQTyAgDKCYQUAQPyAgCrZAED8gIAuh8BA/ICALsfAQTy" +
+	"AgDKCadkAQTyAgDKCbwHAQPyAgC8HwED8gIA5gMBA/ICAL4fAQPyAgCvZAED8gIA" +
+	"gBQBBPICAMoJgBQBBPICALgngBQBA/ICALBkAQPyAgCyZAED8gIAsWQBA/ICAOgI" +
+	"AQPyAgCzZAED8gIAnWQBA/ICALRkAQPyAgC2ZAED8gIAt2QBA/ICAMAfAQPyAgDB" +
+	"HwEE8gIAjV3BHwED8gIAu2QBA/ICAKsGAQPyAgCmBAgF8gI9APpEKgED8gL1BCoP" +
+	"A/IC8QHyCQEDIgSdRgEDIgTzSQEDIgTCVAEDIgSsWgEDIgSoWQEDIgSPKgEDIgSb" +
+	"XgEDIgSnLQEDIgSoTQEDIgSwTgEEIgSGBLcMAQMiBP5lAQMiBKY2AQMiBKM1AQMi" +
+	"BKhCAQMiBNFdAQMiBIMsAQMiBJFRAQMiBNBRAQMiBNw8AQMiBOA8AQMiBN88AQMi" +
+	"BN48CgMiBOMDAQMiBKATAQMiBJ4TAQMiBONaAQMiBPFaAQMiBOIdAQMiBJ8TAgMi" +
+	"BKYrAQMiBLkrAQMiBLcrAQMiBKcrAQMiBKkrAQMiBKgrAQMiBLgrAgMiBNwPAQMi" +
+	"BPMfAQMiBPAfAQMiBJZmAQMiBJpmAQMiBO8fAQMiBPIfAgMiBKorAQMiBLYrAQMi" +
+	"BLQrAQMiBKsrAQMiBKwrAQMiBJAQAQMiBLUrAgMiBIZTAQMiBJBTAQMiBIpTAQMi" +
+	"BIdTAQMiBIlTAQMiBIhTAQMiBItTAgMiBMARAQMiBOJEAQMiBN9EAQMiBNxEAQMi" +
+	"BJwOAQMiBN5EAQMiBOFEAgMiBLYfAQMiBIZkAQMiBP5jAQMiBPxjAQMiBP1jAQMi" +
+	"BLcfAQMiBP9jAgMiBM49AQMiBNY9AQMiBNQ9AQMiBM89AQMiBNI9AQMiBNE9AQMi" +
+	"BNU9AgQfKQCuBgEEHykAkwYBBB8pAO0FAQQfKQClBQEEHykArQYBBB8pALUFAQQf" +
+	"KQBaAQQfKQCZBQEEHykA6AUBBB8pAPwCAQQfKQA1AQQfKQCxAwEEHykAtgYBBB8p" +
+	"AMYHAQQfKQD6AwEEHykAggEBBB8pAOgGAQQfKQCLBQEEHykAqAIBBB8pAP4SAQQf" +
+	"KQDoCQEEHykA2zkBBB8pABQBBB8pAIgGAQQfKQDGEAEFHykA8BGyCwEEHykA6AgB" +
+	"BB8pAOYDAQUfKQCaBhQBBR8pALkCtAQBBR8pAK8GtAQBBh8pAJoGrwa0BAEEJdwC" +
+	"owuaCQEFJdwCywOjC5oJAQMnowuBAQEDJaMLgQEBBCfLA6MLgQEBBCXLA6MLgQEB" +
+	"A5EEiBmaCQEEkQTLA4gZmgkBA8sD2ROaCQEDJ9kTgQEBAyXZE4EBAQKRBCsBBCeR" +
+	"BNUbgQEBBCWRBNUbgQEBAp853jMBAa8SAQKJGK8SAQP4AYkYrxIBAbZAAQLLA7ZP" +
+	"AQK2AdoVAQK+AdoVAQPLA5IF3AIBAzOLBqIHAQLPAokMAQKKC4EQAQOiBwKgAgEE" +
+	"uQECnwEmAQQnhwHzG4EBAQQlhwHzG4EBAQS5AQI7JgEEuQECOzkBBSdTdwLtDgEF" +
+	"JVN3Au0OAQSzAydugQEBBLMDJW6BAQEE9AMnboEBAQT0AyVugQEBBCfhBTCBAQEE" +
+	"JeEFMIEBAQMnM7cDAQMlM7cDAQY2iwGSATE7xAEBBjE7kgE2iwHEAQEEZU47xAEB" +
+	"A1U7KgED+AHLBCoBAlO5AQECnwHnBAEEoQX1BEw7AQKgAecCAQKRBDsBApEE5wIB" +
+	"AqABsgcBBNoJAie/GAEE2gkCJb8YAQKgAdoJAQSzA27wAwEBAr9f0gMBAtdd0gMB" +
+	"A5xbkgOpAgEDU3WLAQEDugtTvwIBAZArAQIzogcBAvgB5wIBBDO9RrwEKgEE0gMC" +
+	"J+oTAQMzsRMqAQPPAocBywgBBs8ChwHLCALLCCYBAocBywgBBIcBywgCOwEDM4tb" +
+	"5wIBAssDlAUBAvAC2gkBAuMR5wIBAsJQKgEDn1K0OSoBA90z7hPGCgEFkQP2GwIl" +
+	"8hYBBZED9hsCJ/IWAQS5HgEF9wYBA+MR7AQqAQPjEcsEKgEFJyuBAQJIAQUlK4EB" +
+	"AkgBBicrgQECM0gBBiUrgQECM0gBBLMDbie3AwEEswNuJbcDAQT0A24ntwMBBPQD" +
+	"biW3AwECiwaiByMDVi7XCAEDVi6XEAEEVi7aCDEBBFYu2gg2AQRWLtoIQQEDVi6v" +
+	"BQEDVi5kAQNWLrgTAQRWLvMKMQEEVi7zCjYBA1YusRcBA1Yu9RIBBFYuAzEBBFYu" +
+	"AzYBBFYumwwxAQRWLpsMNgEEVi6bDEEBBFYumwxOAQNWLpwPAQNWLsYTAQRWLpMT" +
+	"MQEEVi6TEzYBBFYuuAQxAQRWLrgENgEDVi6sBAEDVi7fEgIDVi6GLAEDVi6oBgED" +
+	"Vi7GBAEDVi6bFwEDVi7tGgEDVi6sLAEEVi7pBjEBBFYu6QY2AQNWLskHAQRWLrQS" +
+	"MQEEVi60EjYBBlYu4QxuowjaHgEDVi7aCwEDVi7HCgEDVi6PGQEFVi7/D0qZEAED" +
+	"Vi7/BwEEVi6eDzEBBFYung82AQNWLvQPAQNWLocTAQRWLqMFhxMBBFYugwsxAQRW" +
+	"LoMLNgEEVi6DC0EBBFYugwtOAQNWLtMaAQNWLv8SAQNWLvIOAQNWLvQXAQNWLiQB" +
+	"BFYuuQoxAQRWLrkKNgEDVi6xDgEDVi7sGgEEVi7rDTEBBFYu6w02AQRWLusNQQED" +
+	"Vi6wDwEDVi6aEAEEVi6GAzEBBFYuhgM2AQRWLqMFiQoBBFYu4QyPBAEDVi6PBAEE" +
+	"Vi6jBZAKAQRWLqMFpAQBA1Yu3w0BBFYuowXLFgEEVi7hDP4MAQRWLv4MMQEEVi7+" +
+	"DDYBA1Yu4xYBBFYuowXqDQEEVi6qATEBBFYuqgE2AQRWLqMFqgEBBFYuowWdGAEE" +
+	"Vi70ETEBBFYu9BE2AQNWLogKAQNWLvIIAQVWLqMFmR7HEQEEVi6jBasFAQRWLqMF" +
+	"6AcBBFYuowXnEAEEVi7LCjEBBFYuywo2AQRWLssKQQEEVi6jBcsKAQNWLtIEAQRW" +
+	"LqMFxwgBA1YuoA0BA1Yu+xABBFYuowWBCAEEVi6jBZ8NAQRWLqMFmAgBBFYu4Qz2" +
+	"EwEEVi7hDIQNAQRWLqMF7xABBFYugw7TCgEEVi6jBdMKAQRWLoMOtg8BBFYuowW2" +
+	"DwEEVi6DDv0IAQRWLqMF/QgBA1Yu9wwBBFYugw73DAEEVi6jBfcMDQNPLjEBA08u" +
+	"vwIBA08uOwEDTy6mBgEDTy7aCAEDTy5SAQNPLjYBA08u9BkBA08urQUBA08u0kUB" +
+	"A08u5hcBA08udAEETy5zZAEDTy6oEAEDTy6MCQEDTy64EwEETy6xAWQBA08u8woB" +
+	"A08ukAsBA08uuWMBA08umxMBBU8uJbEBZAEETy7VPtIQAQNPLoYCAQNPLrEXAQNP" +
+	"LvUSAQNPLpcQAQNPLoNSAQNPLvclAQNPLqUDAQNPLtIQAQNPLpgGAQNPLo5WAQNP" +
+	"LtoGAQRPLtoGnVoBA08u7jkBA08urwYBA08u0AkBA08ukxABA08uy1QBA08uwkEB" +
+	"A08uAwEDTy6bDAEDTy7gMwEDTy7bWgEDTy6dCQEDTy64VAEDTy77EwEDTy7VTwED" +
+	"Ty7sHgEDTy7LEAEETy61AsYTAQRPLssDlxABBE8uqgGyWwEETy42xQgBA08urFkB" +
+	"A08utgoBA08ukxMBA08usSoBA08uohMBA08uuAQBA08u+T0BA08uuBcBA08urAQB" +
+	"A08ugRABA08u3xIBA08unQEBA08uhTYBA08u7Q8BA08uKwEDTy7SAgEDTy6oBgED" +
+	"Ty6KHQEDTy7GBAEDTy6jCAEDTy79RAEDTy6pAgEDTy6bFwEDTy6DYwEETy7HBdIC" +
+	"AQNPLrotAQNPLpEYAQNPLsQFAQNPLucdAQNPLukGAQNPLskHAQNPLrQsAQNPLvkX" +
+	"AQRPLjP8AQEFTy5uowjaHgEDTy7FHQEDTy7aOgEDTy7aCwEDTy7HCgEDTy6FUgED" +
+	"Ty6PGQEDTy7PGgEDTy59AQNPLvodAQNPLvhFAQNPLsxgAQNPLvwXAQVPLv8PSpkQ" +
+	"AQNPLuVZAQRPLssDvRMBA08uPwEDTy6LWgEDTy6YBQEDTy7/BwEDTy7XHQEDTy4b" +
+	"AQNPLqUTAQNPLp4PAQNPLskeAQNPLqk9AQNPLqQrAQNPLo9bAQNPLvQPAQNPLpQL" +
+	"AQNPLocTAQNPLpAZAQNPLoMLAQNPLv8SAQNPLtkNAQNPLiQBA08uGAEDTy7HUQED" +
+	"Ty7iCQEDTy65CgEDTy6xDgEDTy7hSQEDTy6RHQEDTy75JgEDTy7sGgEDTy6RBgED" +
+	"Ty7iTwEDTy6uFgEDTy6jWwEDTy6yLQEDTy7rDQEDTy6wDwEDTy7UQQEDTy6qFgEE" +
+	"Ty7+DNIQAQNPLpoQAQNPLoYDAQNPLokKAQNPLo8EAQNPLpAKAQNPLq9hAQNPLs4p" +
+	"AQNPLuAOAQNPLoQWAQNPLqQEAQNPLuAFAQNPLvxUAQNPLt8NAQNPLq8WAQNPLssW" +
+	"AQNPLoUqAQNPLpNKAQNPLv4MAQNPLuMWAQNPLswPAQNPLpA2AQNPLo1iAQNPLuoN" +
+	"AQNPLqoBAQNPLp0YAQNPLvQRAQNPLpBaAQVPLrUCqQafDQEDTy6ICgEDTy7yCAED" +
+	"Ty69YwEDTy5oAQNPLscRAQRPLpkexxEBA08uzUUBA08ujwYBA08uqwUBA08u6AcB" +
+	"A08u5xABA08uywoBA08u0gQBA08utzsBA08uxwgBA08uoA0BA08upwsBA08u7wkB" +
+	"A08u9joBBE8u2VXMDwEDTy7NFgEDTy77EAEDTy6BCAEDTy6fDQEDTy6YCAEDTy69" +
+	"EAEDTy72EwEDTy6xPgEDTy6EDQEDTy7cSQEDTy43AQNPLrs5AQNPLu8QAQNPLtFe" +
+	"AQNPLsgKAQNPLvMOAQNPLtYHAQNPLtMKAQNPLrYPAQNPLv0IAQNPLvcMAQNPLoMY" +
+	"GwaeAZkH2gEnQiUBBp4BmQfaASZCOQEIngGZB9oBJ0JMGCUBCJ4BmQfaASZCTBg5" +
+	"AQWeAZkH2gGSA5IKAQaeAZkH2gGSCv4DJgEGngGZB9oBkgr+AzkBBp4BmQfaAZIK" +
+	"/gMnAQeeAZkH2gGSCv4DcicBB54BmQfaAZIK/gNyJQEHngGZB9oBkgr+A2snAQSe" +
+	"AZkH2gGpEgUCngGxBQECngHnAgEDngGSA6kCAQKTNioBBI0Jy0HlHQsBA54Byggq" +
+	"AQOeAdcLKgEDngEd4wEBAyfcAoEBAQMl3AKBAQEEJzPcAoEBAQQlM9wCgQEBAyf1" +
+	"A4EBAQMl9QOBAQEEJz/1A4EBAQQlP/UDgQEBBCc3kgmBAQEEJTeSCYEBAQLkDioB" +
+	"AsI8KgEEJ/sEpASBAQEEJfsEpASBAQEEJz+SCYEBAQQlP5IJgQEBBSc/+wSkBIEB" +
+	"AQUlP/sEpASBAQEEJz8rgQEBBCU/K4EBAQKZBNIDAQX4ATOwB7wEKgEEM7AHvAQq" +
+	"AQWHATOwB7wEKgED5A4qaAEDoQeaAjEBA6EHmgI2AQOhB5oCQQEDoQeaAk4BA6EH" +
+	"mgJVAQOhB5oCdQEDoQeaAoABAQOhB5oCdAEDoQeaAogBAQSeAfAZiQEqAQSeAcMG" +
+	"iQEqAQSeAcAQiQEqAQSeAcc5iQEqAQUcjAI7iQEqAQUcMzuJASoBAuYH0gMBBFOR" +
+	"DNcIKgEHU5EM1wgCpwiPBioBBlORDNcIKnJuAQlTkQzXCAKnCI8GKnJuAQZTkQzX" +
+	"CCprbgEDLeQOKgEGngHDAr8C3hD1BAsBA6EHmgKGAgEDoQeaAqcDAQOhB5oCvQQB" +
+	"BFOeAcoIKgEC0EgqAQOyEqMmKgEDngFDlgQBBJ4BbukFsQUCBNsBAAMUAQPbAQAU" +
+	"AQTbAQADOgED2wEAOgEE2wEAAzABA9sBADABBNsBAAM4AQPbAQA4AQTbAQADNQED" +
+	"2wEANQED2wEAWgED2wEAwwEBA9sBAJAFAQPbAQCOBQED2wEAqgUBA9sBAIQGAQPb" +
+	"AQC8BQED2wEAhQgBA9sBAIAFAQPbAQDaBgED2wEAuAEBA9sBAJ8DAQPbAQCdBQED" +
+	"2wEA6wcBA9sBALIFAQPbAQDABwED2wEAsAUBA9sBALUFAQPbAQD4BAED2wEAvwcB" +
+	"A9sBALQBAQPbAQC/AQED2wEA4gUBA9sBANoFAQTbAQADiAUBA9sBAIgFAQPbAQDb" +
+	"BQED2wEA+gMBA9sBAKUFAQPbAQBCAQPbAQDHBQED2wEArQEBA9sBAIkEAQPbAQCi" +
+	"BAED2wEAiAQBA9sBAOADAQPbAQCCAQED2wEA4AEBA9sBAJcBAQPbAQD2AwED2wEA" +
+	"2AUBA9sBAJMDAQPbAQDbBgED2wEA6gQBA9sBAIYHAQPbAQDUAwED2wEArgYBA9sB" +
+	"ALEDAQPbAQDfBAED2wEAlgcBA9sBAPUFAQPbAQCoAQED2wEAuQQBA9sBAN4DAQPb" +
+	"AQCQBAED2wEArgUBBNsBAAOzAQED2wEAswEBBNsBAAPmAwED2wEA5gMBBNsBAAOm" +
+	"BAED2wEApgQBA9sBAJwBAQPbAQCgBgED2wEAnAUBA9sBAPYFAQPbAQD0BAEE2wEA" +
+	"A68CAQPbAQCvAgED2wEAtAUBA9sBAP0EAQPbAQCWBQED2wEAmQEBA9sBAKMKAQTb" +
+	"AQADWgEE2wEAA7wFAwUf9AmnCI8GKgEFH/QJ+BKPBioBBPQJpwiPBioBBPQJ+BKP" +
+	"BioBA9sByggqAQTbAacIyggqAQPbAagE6mQBA/QJM6IHAQRHAAMUAQNHABQBBEcA" +
+	"AzoBA0cAOgEERwADMAEDRwAwAQRHAAM4AQNHADgBBEcAAzUBA0cANQEDRwBaAQNH" +
+	"AMMBAQNHAJAFAQNHAI4FAQNHAKoFAQNHAIQGAQNHALwFAQNHAIUIAQNHAIAFAQNH" +
+	"ANoGAQNHALgBAQNHAJ8DAQNHAJ0FAQNHAOsHAQNHALIFAQNHAMAHAQNHALAFAQNH" +
+	"ALUFAQNHAPgEAQNHAL8HAQNHALQBAQNHAL8BAQNHAOIFAQNHANoFAQRHAAOIBQED" +
+	"RwCIBQEDRwDbBQEDRwD6AwEDRwClBQEDRwBCAQNHAMcFAQNHAK0BAQNHAIkEAQNH" +
+	"AKIEAQNHAIgEAQNHAOADAQNHAIIBAQNHAOABAQNHAJcBAQNHAPYDAQNHANgFAQNH" +
+	"AJMDAQNHANsGAQNHAOoEAQNHAIYHAQNHANQDAQNHAK4GAQNHALEDAQNHAN8EAQNH" +
+	"AJYHAQNHAPUFAQNHAKgBAQNHALkEAQNHAN4DAQNHAJAEAQNHAK4FAQRHAAOzAQED" +
+	"RwCzAQEERwAD5gMBA0cA5gMBBEcAA6YEAQNHAKYEAQNHAJwBAQNHAKAGAQNHAJwF" +
+	"AQNHAPYFAQNHAPQEAQRHAAOvAgEDRwCvAgEDRwC0BQEDRwD9BAEDRwCWBQEDRwCZ" +
+	"AQEDRwCjCgEERwADWgEERwADvAUBA0cAhQMBA0cA5ggBA0cAkwYBA0cApggBA0dM" +
+	"OwEE9AmsHI8GKgEDR8oIKgEER6cIyggqAQNHqATFGQYDowIAPgEDowIA+gEBA6MC" +
+	"ALABAQOjAgCzAgEDowIA0gEBA6MCAMUBAQOjAgCZAQEDowIAbwEDowIAwQEBA6MC" +
+	"AMcBAQOjAgDUAQEDowIAkwIBA6MCAO4CAQOjAgD8AQEDowIA0mUBA6MCANcWAQOj" +
+	"AgCKCgEDowIAYgEDowIA3gEBA6MCAPMBAQOjAgBjAQOjAgAUAQOjAgA1AQOjAgA4" +
+	"AQOjAgD9BwEDowIAigIBA6MCALoIAQOjAgCiAgEDowIApwkBA6MCAPMDAQOjAgD8" +
+	"AgEDowIA7gYBA6MCAJ0HAQOjAgC2BgEDowIAOgEDowIAMAEDowIAiwwBA6MCAMsB" +
+	"AQOjAgDOBQEDowIAkj0BA6MCAJQRAQajAgA1AjsmAQOjAgCzDAIDHACRCQEDHACj" +
+	"DwEDHAC2EQEDHAChCQEDHADCDgEDHADDDgEDHADHCQEDHACmDwEDHACwCQEDHAD5" +
+	"DgEDHAD6DgEDHAD7DgEDHAD8DgEDHADkEgEDHADjEgEDHAD4DgEDHACcCQEDHACt" +
+	"CQEDHACkDwEDHADfDgEDHAC3CQEDHAClDwEDHADICAEDHAC0CAEDHACiDwEDHAD1" +
+	"CAEDHACQCQEDHADDCQEDHACrCQEDHACKCQEDHAAUAQMcAJUEAQMcALMBAQMcAIIN" +
+	"AQMcAOkLAQMcADgBAxwAhRQBAxwAqwYBAxwANQEDHACvAgEDHADxEwEDHADEAwED" +
+	"HACmBAEDHAAwAQMcAPUTAQMcAP0EAQMcALQFAQMcAOYDAQMcAL4IAQMcAAkBAxwA" +
+	"OgECHL8IAQMcAJ0TAQMcAI8SAQMcAI4SAQMcAKIbAQMcAOQcAQMcAOUSAQMcAOcc" +
+	"AQMcAOYcAQMcAOkcAQMcAOoRAQMcAOsRAQMcAN0aAQMcAKoRAQMcAJAcAQMcAL8S" +
+	"AQMcAJIcAQMcAL4SAQMcAL0SAQMcAJMcAQMcAKwRAQMcAKQZAQMcAIoTAQMcAL0d" +
+	"AQMcAIwTAQMcAIsTAQMcAIkTAQMcAK4SAQMcAJwTAQMcAIcUAQMcAMQfAQMcAMMf" +
+	"AQMcAKsRAQMcAOAdAQMcAIYUAQMcAMkfAQMcAMofAQMcAMgfAQMcANAfAQMcAM8f" +
+	"AQMcAM4fAQMcAOcVAQMcAOgmAgSeAdQFjUYqAQSeAdQFvwUqAQSeAdQFMSoBBJ4B" +
+	"1AU2KgEEngHUBUEqAQSeAdQFTioBBJ4B1AWzAyoBBJ4B1AVMKgEEngHUBfQDKgEE" +
+	"ngHUBcAIKgEEngHUBdoIKgEEngHUBY0HKgEEngHUBeINKgEEngHUBfMNKgEEngHU" +
+	"BZgGKgEEngHUBa0FKgEDowIA6gQBA6MCAOsHAQOjAgCOCQEDowIAhAYBA6MCAMwD" +
+	"AQOjAgDTEAEDowIAqgMBA6MCANgbAQOjAgCKDAEDowIAxCYBA6MCANFBAQOjAgCG" +
+	"HwEDowIAuQYBA6MCAIFMAQOjAgCHJgEDowIApicBA6MCAO0HAQOjAgD/BgEDowIA" +
+	"1xsBA6MCANJBAQSjAjIA+gEBBKMCMgDFAQEEowIyAMcBAQSjAjIA1AEBA6MCAOE8" +
+	"AQOjAgDMEQEDowIA7h8BBKMCMgDBAQEDowIAyj0BA6MCAL0RAQOjAgDEAwEDowIA" +
+	"kAcBA1ZIxQEBA1ZIjmMBA1ZI4GMBA1ZI1SoBA1ZI9x0BA1ZIvEABA1ZIuEABA1ZI" +
+	"+A0BA1ZIu0ABA1ZIslwBA1ZIuUABA1ZIvUABA1ZIg0ABA1ZIukABA1ZIvkABA1ZI" +
+	"mQEBA1ZI1AEBA1ZIYwEDVkj6AQEDVki/WgEDVkjSAQEDVkj0GAEDVkjWGAEDVkip" +
+	"XAEDVkj8WwEDVkihEwEDVkj/WAEDVkjfUAEDVki7UgEDVkiTXgEDVkizXAEDVkj1" +
+	"WwEDVkizQAEDVki/QAEDVkiEUQEDVkjuAg0ERwADqgUBBEcAA50FAQRHAAOyBQEE" +
+	"RwADQgEERwADogQBBEcAA4IBAQRHAAP2AwEERwAD2wYBBEcAA9QDAQRHAAPfBAEE" +
+	"RwAD3gMBBEcAA5wBAQRHAAOgBgEERwADnAUBBEcAA/YFAQRHAAP0BAEDgwEckQkB" +
+	"A4MBHKEJAQODARzHCQEDgwEcsAkBA4MBHJwJAQODARytCQEDgwEctwkBA4MBHMgI" +
+	"AQODARy0CAEDgwEc9QgBA4MBHJAJAQODARzDCQEDgwEcqwkBA4MBHIoJAQSDARyR" +
+	"CRQBBIMBHKEJFAEEgwEcxwkUAQSDARywCRQBBIMBHJwJFAEEgwEcrQkUAQSDARy3" +
+	"CRQBBIMBHMgIFAEEgwEctAgUAQSDARz1CBQBBIMBHJAJFAEEgwEcwwkUAQSDARyr" +
+	"CRQBBIMBHIoJFAEEgwEctAgwAQSDAZcM2gHMTwEFgwGXDNoBNdsGAgODAeUBMQED" +
+	"gwHlATYBA4MB5QFBAQODAeUBTgEDgwHlAVUBA4MB5QF1AQODAeUBgAEBA4MB5QF0" +
+	"AQODAeUBiAEBA4MB5QGGAgEDgwHlAcYEAQODAeUByQcBA4MB5QHpBgEDgwHlAfoT" +
+	"AQODAeUB1RoBA4MB5QGYBgEDgwHlAagGAQODAeUBpBMBA4MB5QHOGAEDgwHlAc0d" +
+	"AQODAeUBoAkBA4MB5QHYHQEDgwHlAf4XAQODAeUB6RYBA4MB5QHWGQEDgwHlAftT" +
+	"AQODAeUBjRABA4MB5QHrHQEDgwHlAfUdAQODAeUB5xcBA4MB5QHaHAEDgwHlAdYV" +
+	"AQODAeUB7joBA4MB5QH3BQEDgwHlAZEdAQODAeUB0BwBAy3lAcsEAQMt5QHaQwED" +
+	"LeUB9BIBAy3lAcUZAQYtHYYCzAI3KwEGLR2nA8wCNysBBi0dvQTMAjcrAQYtHdwE" +
+	"zAI3KwEGLR2FBMwCNysBBi0djgbMAjcrAQYtHZMEzAI3KwEGLR2zBswCNysBAvQb" +
+	"AQEELR2nAzEBBC0dpwM2AQQtHacDQQEELR2nA04BBC0dpwNVAQQtHacDdQEELR2n" +
+	"A4ABAQQtHacDdAEELR2nA4gBAQMtHb0EAQQtHb0EMQEELR29BDYBBC0dvQRBAQQt" +
+	"Hb0ETgEELR29BFUBAy0ckQkBAy0coQkBAy0cxwkBAy0csAkBAy0cnAkBAy0crQkB" +
+	"Ay0ctwkBAy0cyAgBAy0ctAgBAy0c9QgBAy0ckAkBAy0cwwkBAy0cqwkBAy0cigkB" +
+	"BC0ckQkUAQQtHKEJFAEELRzHCRQBBC0csAkUAQQtHJwJFAEELRytCRQBBC0ctwkU" +
+	"AQQtHMgIFAEELRy0CBQBBC0c9QgUAQQtHJAJFAEELRzDCRQBBC0cqwkUAQQtHIoJ" +
+	"FAEELZcM2gHVKwEELZcM2gGzQgEELRzICDABA5cM5R0LAQMt5QExAQMt5QE2AQMt" +
+	"5QFBAQMt5QFOAQMt5QFVAQMt5QF1AQMt5QGAAQEDLeUBdAEDLeUBiAEBAy3lAYYC" +
+	"AQMt5QHGBAEDLeUByQcBAy3lAekGAQMt5QH6EwEDLeUB1RoBAy3lAZgGAQMt5QGo" +
+	"BgEDLeUBpBMBAy3lAc4YAQMt5QHNHQEDLeUBoAkBAy3lAdgdAQMt5QH+FwEDLeUB" +
+	"6RYBAy3lAdYZAQMt5QGhVgEDLeUBjwgBAy3lAdUKAQMt5QHcWwEDLeUB8TkBAy3l" +
+	"AaocAQMt5QGhJwEDLeUB7EEBAy3lAfcFAQMt5QHZMwEDLeUB4TMBAy3lAY4BAQMt" +
+	"5QHQAgEDLeUBhwEBAy3lAScBAy3lASUBAy3lAfJIAQMt5QHzUwEDLeUB6x0BAy3l" +
+	"AfUdAQMt5QHnFwEDLeUB2hwBAy3lAdYVAQMt5QHEDgEELR29BHUBBC0dvQSAAQEE" +
+	"LR29BHQBBC0dvQSIAQEDLR3cBAEELR3cBDEBBC0d3AQ2AQQtHdwEQQEELR3cBE4B" +
+	"BC0d3ARVAQQtHdwEdQEELR3cBIABAQQtHdwEdAEELR3cBIgBAQMtHYUEAQWeAcMC" +
+	"Cyj6QQEFngHDAgso5ToBBZ4BwwILKMBIAQWeAcMCCyjcJgEFngHDAgsoxRoBBZ4B" +
+	"wwILKLZCAQWeAcMCCyi1QgEFngHDAgsopScBBZ4BwwILKLJYAQWeAcMCCyjDTwEF" +
+	"ngHDAgsot00BBZ4BwwILKLM1AQIr1hgBAivWOQECK9cQAQOERvBFAQEDLUcUAQMt" +
+	"RzoBAy1HMAEDLUc4AQMtRzUBAy1HWgEDLUeQBQEDLUeqBQEDLUe8BQEDLUeABQED" +
+	"LUe4AQEDLUedBQEDLUeyBQEDLUewBQEDLUf4BAEDLUe0AQEDLUfiBQEDLUeIBQED" +
+	"LUf6AwEDLUdCAQMtR60BAQMtR4kEAQMtR6IEAQMtR4gEAQMtR+ADAQMtR4IBAQMt" +
+	"R/YDAQMtR9sGAQMtR9QDAQMtR98EAQMtR6gBAQMtR7kEAQMtR94DAQMtR5AEAQMt" +
+	"R64FAQMtR7MBAQMtR+YDAQMtR6YEAQMtR5wBAQMtR6AGAQMtR5wFAQMtR/YFAQMt" +
+	"R/QEAQMtR68CAQMtR7QFAQMtR/0EAQMtR5YFAQQr6gugCe9TAQIr0iYBAiuBJwEC" +
+	"K8cmAQIr+yQBAivPQQECK9lBAQIrvmABAiviOQECK6I5AQIr2E8BAivbTwECK+tC" +
+	"AQIr+UIBAiv8QgECK6E8AQIrnDwBAiuBPQECK4M9AQIr40QBAiuIPQECK91DAQIr" +
+	"3kMBAivfQwECK+BDAQIrwj0BAivDPQECK8NEAQIrwEQBAiuXQwECK5REAQIrj0QB" +
+	"AivcVQECK/hVAQIr/FkBAiuuWAECK69YAQIr8jQBAivPNQECK7E2AQIro14BAiuZ" +
+	"SwECK7ZNAQIr+D0BAiuNUAECK45QAQIrhykBAiuaUQECK6xRAQIrq1EBAiv9KQEC" +
+	"K4pAAQIrjEABAivNKgECK5RAAQIrrD4BAiuEHAECK+9QAQIrtz4BAivyUAECK+pQ" +
+	"AQIr1SkBAivWUQECK5cqAQIrjREBAivdUQECK8k/AQIrxz8BAiulSAECK6hIAQIr" +
+	"o0gBAivISAECK7dIAQIr10kBAivmSQECK+dJAQIr5hEBAiv3SAECK/RIAQIrmGQB" +
+	"AiuaZAECK/9kAQIrtlQBAiu1VAECK4NVAQIriFUBAiv2UwECK/dTAQIr/WIBBp4B" +
+	"wwILKPAE4wEBBp4BwwILKPAEMQEGngHDAgso8AQ2AQaeAcMCCyjwBEEBBp4BwwIL" +
+	"KPAETgEGngHDAgso8ARVAQaeAcMCCyjwBHUBBp4BwwILKPAEgAEBBp4BwwILKPAE" +
+	"dAEGngHDAgso8ASIAQEGngHDAgso8ASGAgEGngHDAgso8AScBwEGngHDAgso8ATM" +
+	"BgEGngHDAgso8ATECQEGngHDAgso8ASFCQEGngHDAgso8ATIBwEGngHDAgso8ASb" +
+	"CAEGngHDAgso8AS0CQEGngHDAgso8AS7CAEGngHDAgso8ASiCQEGngHDAgso8ASn" +
+	"AwEGngHDAgso8ATxHgEGngHDAgso8ATlEwEGngHDAgso8ATyHgEGngHDAgso8ATw" +
+	"HgECK4JAAQIrvwEBAiuiAgECK3cBAiviGwECK95QAQIryBABAyvIEGUBAyvIENsL" +
+	"AQIriwwBBCvqC6AJqz4BBCvqC6AJoFwBBCvqC6AJ8lwBBCvqC6AJ+EgBAivfMwED" +
+	"K5cBrSYBAiutAQEDK94DFAECK6gBAQIrWgECK4tDAQIr3REBAiumPAECK4MrAQIr" +
+	"jEMBAiuDUQECK/NLAQMr3gOzAgEDK94DwQEBAiuZSQECK68ZAQIr9BgBAivEQwEC" +
+	"K7xJAQIr+DwBAivpXQEDK94DbwECK+tJAQIrnDYBAiv1QwECK547AQIrlk0BAyve" +
+	"A7ABAQIrtw4BAiucEAECK5IOAQMrtw5lAQMrnBBlAQMrsAFlAQMrkg5lAQMrtw7b" +
+	"CwEDK5wQ2wsBAyuwAdsLAQMrkg7bCwEEK7ABkgFjAQUrsAGSAWNlAQIrlwEBAiuY" +
+	"DAECK/AaAQIrpT0BAivvDgEEK+8OkgFjAQUr7w6SAWNlAQIrkVIBAivYTQEDK94D" +
+	"YwECK6hKAQIrs1IBAiubEgEDK94DywEBAiuoDAECK8pEAQMrqAzmEQECK7RSAQIr" +
+	"n04BAyveA44CAQIr9RoBAiu9EQEDK/Ua5hEBAyvHAc0OAQMrsAHNDgECK+0HAQIr" +
+	"oyoBAivQFgECK9EWAQQr8wGSAa8ZAQIrmAcBAiuRFwECK809AQIrggEBAiv4DQEC" +
+	"K98CAQIrkA4BAyuSDgUBAiunRAECK5tGAQIrnEYBAiujRgECK89GAQMr3REDAQIr" +
+	"2EkBAiuHSgECK4VRAQIrz1EBAivzUQECK/VRAQIr4loBAiuyEwECK/5iAQQrywGS" +
+	"AbABAQQrFJIBsAEBBp4BwwILKJ4EMQEGngHDAgsongQ2AQaeAcMCCyieBEEBBp4B" +
+	"wwILKJ4ETgEGngHDAgsongRVAQaeAcMCCyieBHUBBp4BwwILKJ4EgAEBBp4BwwIL" +
+	"KJ4EdAEGngHDAgsongSIAQEGngHDAgsongSGAgEGngHDAgsongScBwEGngHDAgso" +
+	"ngTMBgEGngHDAgsongTECQEGngHDAgsongSFCQEGngHDAgsongTIBwEGngHDAgso" +
+	"ngSbCAEGngHDAgsongS0CQEGngHDAgsongS7CAEGngHDAgsongSiCQEGngHDAgso" +
+	"ngSnAwEGngHDAgsongTxHgEGngHDAgsongTlEwEGngHDAgsongTyHgEGngHDAgso" +
+	"ngTwHgEGngHDAgsongS/DwEGngHDAgsongS8XwEGngHDAgsongS7XwEGngHDAgso" +
+	"ngS5XwEGngHDAgsongS6XwEGngHDAgsongS9BAEGngHDAgsongTOXQECK8MIwTMF" +
+	"2gIoxgPtM/MNAQXaAijGA+BTmAYBBtoCKPY12QTGA9gpAQTaAijsZKI7AQPaAiic" +
+	"HwED2gIowzMBBNoCKMYD9CYBBNoCKOEKsw8BBNoCKAOXHgED2gIow14BA9oCKLUS" +
+	"AQPaAiiRWwED2gIo6ToBBNoCKNwKpRwBA9oCKPlJAQPaAijIOQED2gIohRgBBtoC" +
+	"KPsTzALGA7I1AQPaAijaJgED2gIoyzMBBNoCKJ8WygYBA9oCKP8QAQTaAijRWtMm" +
+	"AQPaAijWDAED2gIo00EBBNoCKNwKlx4BBNoCKKUDwAoBBNoCKNwKqRwBBdoCKMYD" +
+	"1iXpBgEF2gIoxgO7LMkHAQPaAijMQQED2gIozjgBA9oCKIVUAQTaAijcCpALAQPa" +
+	"AiiGUgEG2gIojhdKxgN6AQTaAijGA/cXAQPaAiimEgED2gIovk8BA9oCKME1AQPa" +
+	"AiidFwED2gIolREBA9oCKKoqAQXaAii4LULOGgEE2gIo8RCzDwEE2gIorFKIHwED" +
+	"2gIo408BBNoCKMYDiGMBA9oCKIpUAQTaAijGA80WAQXaAijGA/cmyBMBBtoCKMYD" +
+	"qBmfW50JAQPaAijTNQEF2gIoxgPGSKRIAQPaAijVJQEE2gIoxgPzYgEF2gIoxgO9" +
+	"POgHAQXaAijGA65C2xkBA9oCKIs2AQPaAiiDRgEE2gIohRnzXgEE2gIoA6kcAQTa" +
+	"Aij2JZ4QAQTaAijWKZ4QgaQBAwkEoBEBAwkEjBkBAwkEOgEDCQTgQQEDCQScQQED" +
+	"CQSqQQEDCQSIBgEDCQSbQQEDCQTZBAEDCQT3FQEDCQQUAQMJBOAVAQMJBMBgAQMJ" +
+	"BIcfAQMJBL9gAQMJBNEOAQMJBNIOAQMJBDUBAwkEpBIBAwkE1A0BAwkEOAEDCQTp" +
+	"BwEDCQSeFgEDCQSGKgEDCQTYBQEDCQT7KQEDCQTzKQEDCQT0KQEDCQTxKQEDCQTy" +
+	"KQEDCQT2DwEDCQSuKQEDCQTgAQEDCQShKQEDCQTGKgEDCQTEKgEDCQTFKgEDCQSa" +
+	"KgEDCQRkAQMJBJYHAQMJBJMqAQMJBOEpAQMJBK4GAQMJBN8pAQMJBPIHAQMJBNMq" +
+	"AQMJBOoEAQMJBMgqAQMJBMsqAQMJBK8IAQMJBNkqAQMJBNoqAQMJBJcHAQMJBNYq" +
+	"AQMJBNgqAQMJBNcqAQMJBJocAQMJBLtRAQMJBJMDAQMJBJYcAQMJBKpRAQMJBI0c" +
+	"AQMJBJ9RAQMJBNRQAQMJBNtQAQMJBJcBAQMJBPQFAQMJBKRSAQMJBKJSAQMJBKNS" +
+	"AQMJBOUOAQMJBPBRAQMJBPUFAQMJBMcSAQMJBNUSAQMJBLFSAQMJBIYHAQMJBLIc" +
+	"AQMJBKtSAQMJBKZSAQMJBLYcAQMJBLpSAQMJBLZSAQMJBLdSAQMJBLlSAQMJBLhS" +
+	"AQMJBLwpAQMJBL0pAQMJBIoWAQMJBLspAQMJBLkpAQMJBLopAQMJBLcpAQMJBLgp" +
+	"AQMJBLIpAQMJBLMpAQMJBNcJAQMJBLEpAQMJBMMpAQMJBMEpAQMJBMIpAQMJBL8p" +
+	"AQMJBMApAQMJBIsWAQMJBL4pAQMJBLYpAQMJBMsLAQMJBLUpAQMJBI0WAQMJBMcp" +
+	"AQMJBIwWAQMJBMQpAQMJBMYpAQMJBMUpAQMJBMopAQMJBMspAQMJBMgpAQMJBMkp" +
+	"AQMJBK5LAQMJBK9LAQMJBKpLAQMJBK1LAQMJBKxLAQMJBIYbAQMJBKtLAQMJBKhL" +
+	"AQMJBKlLAQMJBKZLAQMJBKdLAQMJBLJLAQMJBLNLAQMJBLBLAQMJBLFLAQMJBLhL" +
+	"AQMJBLlLAQMJBLRLAQMJBLVLAQMJBLdLAQMJBLZLAQMJBL5LAQMJBL9LAQMJBLpL" +
+	"AQMJBLtLAQMJBL1LAQMJBLxLAQMJBI8/AQMJBJA/AQMJBIo/AQMJBI4/AQMJBI0/" +
+	"AQMJBIs/AQMJBIw/AQMJBIc/AQMJBIg/AQMJBIU/AQMJBIY/AQMJBJc/AQMJBJU/" +
+	"AQMJBJY/AQMJBJI/AQMJBJM/AQMJBOIYAQMJBJE/AQMJBJs/AQMJBJw/AQMJBJQ/" +
+	"AQMJBJg/AQMJBJo/AQMJBJk/AQMJBKE/AQMJBJ0/AQMJBJ4/AQMJBKA/AQMJBJ8/" +
+	"AQMJBO4RAQMJBOlJAQMJBLkEAQMJBORJAQMJBNJJAQMJBMdJAQMJBMhJAQMJBNFI" +
+	"AQMJBNdIAQMJBKgBAQMJBJ8MAQMJBL9KAQMJBMBKAQMJBLxKAQMJBL5KAQMJBJhK" +
+	"AQMJBKFKAQMJBK4FAQMJBOsaAQMJBJBJAQMJBJAEAQMJBMlKAQMJBM1KAQMJBN4D" +
+	"AQMJBMFKAQMJBMZKAQMJBMNKAQMJBNJKAQMJBNNKAQMJBPoaAQMJBNFKAQMJBIg7" +
+	"AQMJBIs7AQMJBMcHAQMJBIA7AQMJBN86AQMJBN0QAQMJBNMDAQMJBNs6AQMJBIwY" +
+	"AQMJBMoHAQMJBKk7AQMJBMg7AQMJBMk7AQMJBMIIAQMJBMU7AQMJBMY7AQMJBJEY" +
+	"AQMJBM47AQMJBM87AQMJBMs7AQMJBM07AQMJBJZiAQMJBJtiAQMJBOYIAQMJBJFi" +
+	"AQMJBK4CAQMJBItiAQMJBIZiAQMJBIdiAQMJBLlhAQMJBLxhAQMJBIUDAQMJBLFh" +
+	"AQMJBKliAQMJBKxiAQMJBKYIAQMJBKhiAQMJBP5hAQMJBJAfAQMJBLNiAQMJBLRi" +
+	"AQMJBKMKAQMJBLFiAQMJBLJiAQMJBJsfAQMJBLxiAQMJBL1iAQMJBPATAQMJBLli" +
+	"AQMJBLtiAQMJBLpiAQMJBJI2AQMJBJk2AQMJBNoFAQMJBIM2AQMJBPI1AQMJBPoI" +
+	"AQMJBPA1AQMJBI8XAQMJBJA1AQMJBL8BAQMJBLYQAQMJBMk4AQMJBMg4AQMJBDsB" +
+	"AwkEuDgBAwkExwUBAwkErzYBAwkE1DUBAwkEpQUBAwkEyDUBAwkE0jgBAwkE1DgB" +
+	"AwkE2wUBAwkEyjgBAwkEzzgBAwkEzDgBAwkEuh4BAwkEjF4BAwkE4gUBAwkEwQUB" +
+	"AwkE9l0BAwkErAsBAwkE9V0BAwkEmx4BAwkEjl0BAwkEtAEBAwkE/1wBAwkE6h4B" +
+	"AwkEqV8BAwkEp18BAwkEqF8BAwkExR4BAwkEuF4BAwkEQgEDCQSzAwEDCQS6XQED" +
+	"CQT6AwEDCQSuXQEDCQSwXwEDCQS0XwEDCQSIBQEDCQTrHgEDCQSvXwEDCQSwCwED" +
+	"CQShNQEDCQSiNQEDCQSYFwEDCQSgNQEDCQSfNQEDCQSdNQEDCQSeNQEDCQSUNQED" +
+	"CQSVNQEDCQSbAwEDCQSTNQEDCQSpNQEDCQSnNQEDCQSoNQEDCQSlNQEDCQSmNQED" +
+	"CQS7EAEDCQSkNQEDCQSYNQEDCQSVFwEDCQSXNQEDCQSsNQEDCQStNQEDCQSZFwED" +
+	"CQSqNQEDCQSrNQEDCQSaFwEDCQTRSwEDCQTSSwEDCQSDEgEDCQTPSwEDCQTOSwED" +
+	"CQTNSwEDCQTESwEDCQTFSwEDCQSCCwEDCQSBEgEDCQTWSwEDCQTXSwEDCQSEEgED" +
+	"CQTVSwEDCQTKSwEDCQS+DgEDCQTGSwEDCQTcSwEDCQTdSwEDCQS/DgEDCQTZSwED" +
+	"CQTbSwEDCQTaSwEDCQSvPwEDCQSwPwEDCQSpPwEDCQSuPwEDCQSsPwEDCQStPwED" +
+	"CQSqPwEDCQSrPwEDCQSjPwEDCQSlPwEDCQSLEQEDCQSiPwEDCQS2PwEDCQS1PwED" +
+	"CQSyPwEDCQSzPwEDCQSxPwEDCQSoPwEDCQSmPwEDCQSnPwEDCQS3PwEDCQTZTAED" +
+	"CQTbTAEDCQSJBAEDCQTWTAEDCQTJTAEDCQTDTAEDCQTETAEDCQSiSwEDCQStAQED" +
+	"CQScSwEDCQSYTgEDCQSWTgEDCQSXTgEDCQTSAgEDCQS6TQEDCQTgAwEDCQSyTQED" +
+	"CQTxSwEDCQSIBAEDCQTrSwEDCQS8GwEDCQSeTgEDCQSiBAEDCQSZTgEDCQSbTgED" +
+	"CQSaTgEDCQTwPgEDCQTxPgEDCQSKEQEDCQTvPgEDCQTuPgEDCQTsPgEDCQTtPgED" +
+	"CQTmPgEDCQToPgEDCQThGAEDCQTlPgEDCQT4PgEDCQT2PgEDCQT3PgEDCQT0PgED" +
+	"CQTyPgEDCQTzPgEDCQTrPgEDCQTpPgEDCQTqPgEDCQT8PgEDCQT9PgEDCQT1PgED" +
+	"CQT5PgEDCQT7PgEDCQT6PgEDCQSCPwEDCQSDPwEDCQT+PgEDCQT/PgEDCQSBPwED" +
+	"CQSAPwEDCQSSRgEDCQSVRgEDCQTVAgEDCQSlDgEDCQT2RQEDCQT3RQEDCQT1GQED" +
+	"CQT1RQEDCQS7RQEDCQTCRQEDCQSpAQEDCQTDEQEDCQTHRgEDCQTIRgEDCQTFRgED" +
+	"CQTGRgEDCQS3RgEDCQS+RgEDCQTMBQEDCQSyRgEDCQTnRQEDCQSjAwEDCQTfRQED" +
+	"CQTNRgEDCQTORgEDCQTyBAEDCQTJRgEDCQTLRgEDCQTKRgEDCQTWRgEDCQTXRgED" +
+	"CQTVEQEDCQTURgEDCQTVRgEDCQSMGgEDCQSKPQEDCQSLPQEDCQSOBQEDCQSEPQED" +
+	"CQT9PAEDCQT+PAEDCQT7PAEDCQT8PAEDCQSjPAEDCQSlPAEDCQTDAQEDCQTYCgED" +
+	"CQS/PQEDCQTAPQEDCQS9PQEDCQS+PQEDCQS4GAEDCQSkPQEDCQTaBgEDCQSePQED" +
+	"CQTBPAEDCQTDPAEDCQSFCAEDCQSnGAEDCQTHPQEDCQTIPQEDCQSEBgEDCQTBPQED" +
+	"CQTGPQEDCQTuDQEDCQS6GQEDCQTnQwEDCQSQBQEDCQS5GQEDCQTUQwEDCQTRQwED" +
+	"CQTTQwEDCQT+QgEDCQSKQwEDCQRaAQMJBKIZAQMJBLxEAQMJBLlEAQMJBLwRAQMJ" +
+	"BJVEAQMJBJlEAQMJBIAFAQMJBJFEAQMJBPYJAQMJBK9DAQMJBLwFAQMJBJ9DAQMJ" +
+	"BJgOAQMJBMhEAQMJBKoFAQMJBL1EAQMJBMREAQMJBN4FAQMJBNA8AQMJBNE8AQMJ" +
+	"BKoYAQMJBM88AQMJBM08AQMJBM48AQMJBMc8AQMJBMg8AQMJBNkKAQMJBMY8AQMJ" +
+	"BNU8AQMJBNY8AQMJBK4YAQMJBNQ8AQMJBNI8AQMJBNM8AQMJBKsYAQMJBKwYAQMJ" +
+	"BMs8AQMJBMw8AQMJBPkQAQMJBMo8AQMJBNo8AQMJBNs8AQMJBK0YAQMJBNc8AQMJ" +
+	"BNk8AQMJBNg8AQMJBK5JAQMJBK1JAQMJBJxJAQMJBJ1JAQMJBJpJAQMJBJtJAQMJ" +
+	"BLVJAQMJBLNJAQMJBLRJAQMJBLBJAQMJBLFJAQMJBL8GAQMJBK9JAQMJBKxJAQMJ" +
+	"BKpJAQMJBKtJAQMJBLlJAQMJBLpJAQMJBLJJAQMJBLZJAQMJBLhJAQMJBLdJAQMJ" +
+	"BO8YAQMJBKxAAQMJBKZAAQMJBKtAAQMJBKlAAQMJBKpAAQMJBKdAAQMJBKhAAQMJ" +
+	"BKFAAQMJBKJAAQMJBJ9AAQMJBKBAAQMJBLFAAQMJBLJAAQMJBPEYAQMJBLBAAQMJ" +
+	"BK5AAQMJBK9AAQMJBK1AAQMJBPAYAQMJBKVAAQMJBKNAAQMJBKRAAQMJBJZMAQMJ" +
+	"BJRMAQMJBJVMAQMJBPtLAQMJBPxLAQMJBKECAQMJBPlLAQMJBLFMAQMJBLJMAQMJ" +
+	"BLBMAQMJBKhMAQMJBKlMAQMJBIAKAQMJBJ4bAQMJBIBMAQMJBJIbAQMJBP5LAQMJ" +
+	"BN8YAQMJBNs+AQMJBIMMAQMJBPAJAQMJBJM+AQMJBIIBAQMJBIk+AQMJBJJAAQMJ" +
+	"BJNAAQMJBJBAAQMJBJFAAQMJBIUMAQMJBIBAAQMJBN8EAQMJBMs/AQMJBLg+AQMJ" +
+	"BNQDAQMJBLI+AQMJBPtiAQMJBKIfAQMJBK8CAQMJBJ4fAQMJBMVjAQMJBLIfAQMJ" +
+	"BMRjAQMJBLhjAQMJBJYFAQMJBLBjAQMJBI1jAQMJBP0EAQMJBIpjAQMJBPtlAQMJ" +
+	"BPxlAQMJBOsHAQMJBPhlAQMJBPRlAQMJBPJlAQMJBPNlAQMJBMdlAQMJBMllAQMJ" +
+	"BJ8DAQMJBMNlAQMJBIlmAQMJBIdmAQMJBIhmAQMJBOsfAQMJBIFmAQMJBL8HAQMJ" +
+	"BIBmAQMJBNFlAQMJBLUFAQMJBM5lAQMJBItmAQMJBIxmAQMJBMAHAQMJBOwfAQMJ" +
+	"BIpmAQMJBO0fAQMJBJRmAQMJBJVmAQMJBO4fAQMJBJFmAQMJBJNmAQMJBJJmAQMJ" +
+	"BKosAQMJBK0sAQMJBK8NAQMJBOEWAQMJBKAsAQMJBKQsAQMJBJ4sAQMJBJ8sAQMJ" +
+	"BPEGAQMJBKUrAQMJBKsCAQMJBKcNAQMJBP4zAQMJBP0zAQMJBPcWAQMJBOMzAQMJ" +
+	"BOkzAQMJBJgHAQMJBNczAQMJBM8rAQMJBLAIAQMJBMorAQMJBLAQAQMJBIk0AQMJ" +
+	"BK0QAQMJBNwLAQMJBIU0AQMJBIE0AQMJBIAXAQMJBJM0AQMJBP0WAQMJBP8WAQMJ" +
+	"BJI0AQMJBJA0AQMJBKFmAQMJBKJmAQMJBPAfAQMJBKBmAQMJBPEfAQMJBJ9mAQMJ" +
+	"BJ1mAQMJBJ5mAQMJBJhmAQMJBJlmAQMJBNwPAQMJBJdmAQMJBKRmAQMJBPIfAQMJ" +
+	"BKNmAQMJBJxmAQMJBO8fAQMJBJtmAQMJBKpmAQMJBPMfAQMJBKdmAQMJBKlmAQMJ" +
+	"BKhmAQMJBLBmAQMJBLFmAQMJBKtmAQMJBK1mAQMJBK9mAQMJBK5mAQMJBMpOAQMJ" +
+	"BMtOAQMJBMVOAQMJBMlOAQMJBMhOAQMJBMZOAQMJBMdOAQMJBMBOAQMJBMFOAQMJ" +
+	"BKASAQMJBL5OAQMJBNFOAQMJBNBOAQMJBM1OAQMJBMxOAQMJBMROAQMJBMJOAQMJ" +
+	"BNVOAQMJBM5OAQMJBMobAQMJBNROAQMJBNNOAQMJBNpOAQMJBNtOAQMJBNZOAQMJ" +
+	"BNdOAQMJBNlOAQMJBNhOAQMJBP9ZAQMJBHUBAwkEnQUBAwkE+1kBAwkE6lkBAwkE" +
+	"51kBAwkE6VkBAwkE8BIBAwkEhlYBAwkEuAEBAwkEhx0BAwkE41sBAwkE4VsBAwkE" +
+	"4lsBAwkElxMBAwkEvVoBAwkE+AQBAwkEtloBAwkEv1gBAwkEsAUBAwkEsFgBAwkE" +
+	"71sBAwkE8VsBAwkEsgUBAwkE5FsBAwkE7FsBAwkEqA8BAwkEp1wBAwkEqFwBAwkE" +
+	"/VsBAwkEoVwBAwkEplwBAwkEolwBAwkE+loBAwkE+1oBAwkEnhMBAwkE+VoBAwkE" +
+	"+FoBAwkE9loBAwkE91oBAwkE71oBAwkE8FoBAwkE4wMBAwkE7loBAwkE/VoBAwkE" +
+	"/loBAwkEnxMBAwkE/FoBAwkE81oBAwkE4h0BAwkE8loBAwkEgFsBAwkEgVsBAwkE" +
+	"oBMBAwkE/1oBAwkEhlsBAwkEh1sBAwkEglsBAwkEg1sBAwkEhVsBAwkEhFsBAwkE" +
+	"1mUBAwkE12UBAwkEtgUBAwkE1WUBAwkE5GUBAwkE4mUBAwkE42UBAwkE4GUBAwkE" +
+	"4WUBAwkEkhQBAwkE32UBAwkE2mUBAwkE22UBAwkErQYBAwkE2WUBAwkE6GUBAwkE" +
+	"6WUBAwkEkxQBAwkE5WUBAwkE52UBAwkE5mUBAwkE72UBAwkE8GUBAwkE62UBAwkE" +
+	"7GUBAwkE7mUBAwkE7WUBAwkE2hYBAwkE3isBAwkEzgIBAwkE2SsBAwkEkCwBAwkE" +
+	"kSwBAwkEjiwBAwkEjywBAwkEiSwBAwkEiiwBAwkEwwcBAwkEhywBAwkE7isBAwkE" +
+	"7ysBAwkEiwUBAwkE6CsBAwkElSwBAwkErg0BAwkEkiwBAwkElCwBAwkEkywBAwkE" +
+	"nCwBAwkEnSwBAwkEmCwBAwkEmSwBAwkEmywBAwkEmiwBAwkE1VQBAwkE0AUBAwkE" +
+	"31QBAwkE3lQBAwkE2lQBAwkE21QBAwkE9hwBAwkE2VQBAwkE11QBAwkE2FQBAwkE" +
+	"9RwBAwkE1lQBAwkE41QBAwkE5FQBAwkE3VQBAwkE4FQBAwkE4lQBAwkE4VQBAwkE" +
+	"6VQBAwkE6lQBAwkE5VQBAwkE5lQBAwkE6FQBAwkE51QBAwkEwU0BAwkEwk0BAwkE" +
+	"lhIBAwkEwE0BAwkEyk0BAwkEyE0BAwkEyU0BAwkExk0BAwkEx00BAwkEw00BAwkE" +
+	"xE0BAwkE0E0BAwkE0U0BAwkEy00BAwkEzU0BAwkEz00BAwkEzk0BAwkE1k0BAwkE" +
+	"100BAwkE0k0BAwkE000BAwkE1U0BAwkE1E0BAwkEnh0BAwkEkFkBAwkEqAIBAwkE" +
+	"ilkBAwkEzlkBAwkEzFkBAwkEzVkBAwkEuVkBAwkEvVkBAwkE3gcBAwkEpR0BAwkE" +
+	"oB0BAwkEoFkBAwkEugMBAwkEl1kBAwkE0VkBAwkE01kBAwkEyQYBAwkEz1kBAwkE" +
+	"0FkBAwkEqx0BAwkE21kBAwkE3FkBAwkEsR0BAwkE2FkBAwkE2lkBAwkE2VkBAwkE" +
+	"8w4BAwkE1FMBAwkEnAEBAwkE3xIBAwkEgVUBAwkE/1QBAwkEgFUBAwkE0VQBAwkE" +
+	"1FQBAwkE9AQBAwkEzlQBAwkEi1QBAwkE9gUBAwkE+FMBAwkEh1UBAwkEiVUBAwkE" +
+	"nAUBAwkEglUBAwkEhVUBAwkEhFUBAwkElVUBAwkEllUBAwkE+xwBAwkEklUBAwkE" +
+	"lFUBAwkEk1UBAwkEkUIBAwkEkkIBAwkEjgkBAwkEkEIBAwkEjUIBAwkEjkIBAwkE" +
+	"i0IBAwkEjEIBAwkEukIBAwkEu0IBAwkEuEIBAwkEuUIBAwkEjwkBAwkErUIBAwkE" +
+	"zQcBAwkEq0IBAwkEwEIBAwkEwkIBAwkE8wkBAwkEvEIBAwkEv0IBAwkEvkIBAwkE" +
+	"x0IBAwkEyEIBAwkEw0IBAwkExEIBAwkExkIBAwkExUIBAwkE5FIBAwkE5VIBAwkE" +
+	"0AwBAwkE41IBAwkE4FIBAwkE4VIBAwkE3lIBAwkE31IBAwkE+lIBAwkE+1IBAwkE" +
+	"3BIBAwkE+VIBAwkEuxwBAwkE6lIBAwkE7A4BAwkE6FIBAwkEgFMBAwkEglMBAwkE" +
+	"0QwBAwkE/FIBAwkE/lIBAwkE/VIBAwkEj1MBAwkEkVMBAwkEhVMBAwkEjFMBAwkE" +
+	"jlMBAwkEjVMBAwkEmUIBAwkEmkIBAwkElBkBAwkEmEIBAwkElkIBAwkEl0IBAwkE" +
+	"lRkBAwkElUIBAwkEoEIBAwkEnkIBAwkEn0IBAwkEnEIBAwkEnUIBAwkElhkBAwkE" +
+	"m0IBAwkEmBkBAwkEpEIBAwkElxkBAwkEoUIBAwkEo0IBAwkEokIBAwkEpkIBAwkE" +
+	"p0IBAwkEmRkBAwkEpUIBAwkE6UwBAwkE6kwBAwkEhQsBAwkE6EwBAwkE5kwBAwkE" +
+	"50wBAwkE40wBAwkE5UwBAwkE70wBAwkE7kwBAwkE7EwBAwkE7UwBAwkEqRsBAwkE" +
+	"60wBAwkE9EwBAwkEkxIBAwkE8EwBAwkE80wBAwkE8kwBAwkE+UwBAwkE+kwBAwkE" +
+	"9UwBAwkE9kwBAwkE+EwBAwkE90wBAwkEyBsBAwkErk4BAwkE4QYBAwkExxsBAwkE" +
+	"qU4BAwkEqk4BAwkEp04BAwkEqE4BAwkEuU4BAwkEt04BAwkEuE4BAwkEs04BAwkE" +
+	"tE4BAwkE0QgBAwkEyRsBAwkEu04BAwkEvE4BAwkEnxIBAwkEuk4BAwkE6WMBAwkE" +
+	"6mMBAwkEzwYBAwkE52MBAwkE5WMBAwkE5mMBAwkE42MBAwkE5GMBAwkE9GMBAwkE" +
+	"82MBAwkE72MBAwkE8GMBAwkEtB8BAwkE7GMBAwkEhWQBAwkEh2QBAwkE+2MBAwkE" +
+	"gmQBAwkEhGQBAwkEg2QBAwkEiRQBAwkE2WQBAwkECQEDCQTYZAEDCQTTZAEDCQTU" +
+	"ZAEDCQTHHwEDCQTSZAEDCQSGZQEDCQSHZQEDCQSEZQEDCQTUHwEDCQSHDQEDCQTv" +
+	"ZAEDCQSmBAEDCQTpZAEDCQTWDwEDCQSMZQEDCQTmAwEDCQSIZQEDCQSKZQEDCQSJ" +
+	"ZQEDCQSSZQEDCQSTZQEDCQSOFAEDCQSPZQEDCQSRZQEDCQSQZQQDCS67HAEDCS7V" +
+	"AgEDCS66GQEDCS7HGwEDCS7/FgEDCS6eEwEDCS6sGAEDCS6nGAEDCS65BAEDCS7v" +
+	"GAEDCS6MGgEDCS6NFgEDCS7rGgEDCS6mBAEDCS7VEgEDCS7xGAEDCS6bHgEDCS7D" +
+	"AQEDCS7sHwEDCS6AFwEDCS6aFwEDCS6vCAEDCS6uGAEDCS7JGwEDCS6IBQEDCS6k" +
+	"EgEDCS6YGQEDCS7rHwEDCS62HAEDCS7iGAEDCS6JFAEDCS6bHwEDCS6xHQEDCS6Q" +
+	"HwEDCS6fAwEDCS7NBwEDCS7KGwEDCS6ZGQEDCS64GAEDCS6VGQEDCS6WBQEDCS7b" +
+	"BQEDCS6rHQEDCS71GQEDCS79FgEDCS73FgEDCS7hFgEDCS7wGAEDCS6eHQEDCS7t" +
+	"HwEDCS6lHQEDCS6LBQEDCS7xHwEDCS6GGwEDCS68BQoDpAMA4AEBA6QDAJcBAQOk" +
+	"AwCEAwEDpAMAvwEBA6QDALQBAQOkAwDqAgEDpAMAwwEBA6QDAFoBA6QDANECAQOk" +
+	"AwClAgEDpAMAqwIBA6QDAM4CAQOkAwD1BgEDpAMAvgQBA6QDAMsGAQOkAwCoAQED" +
+	"pAMArQEBA6QDAKkBAQOkAwC4AQEDpAMAtgUBA6QDAJ8DAQOkAwChAgEDpAMAggEB" +
+	"A6QDAM4GAQOkAwDGCAEDpAMA0wMBA6QDAK8CAQOkAwCoAgEDpAMAswEBA6QDAK8D" +
+	"AQOkAwAUAQOkAwCVBAEDpAMAOAEDpAMAvggBA6QDADoBA6QDADUBA6QDADABA6QD" +
+	"AKAFAQOkAwDnEwEDpAMAxAMBBaQDAIkB/griBQEFpAMAiQGtAfUFAQWkAwCJAf4K" +
+	"/hYBBaQDAIkB/gqWBwEFpAMAiQH+Cq0BAQWkAwCJAf4KhEIBA6QDxAHnAgEEpAPE" +
+	"AZIDqQIBAzwEzAMBAzwEozkBAzwE9A0BAzwEyg8BAzwEh2MBAzwE0ggBAzwE5ykB" +
+	"AzwEsQoBAzwErw4BAzwEyBkBAzwEoUkBAzwEnxgBAzwE5wkBAzwEzQkBAzwEqQsB" +
+	"AzwE7QwBAzwE3jUBAzwE4TUBAzwEoA4BAzwE1AwBAzwEwwoBAzwEghIBAzwEiQoB" +
+	"AzwEjg8BAzwEiA0BAzwE2w8BAzwE0xYBAzwE6woBAzwEsgwBAzwEvQsBAzwEsBEB" +
+	"AzwElRsBAzwE9wsBAzwE5BEBAzwEhRIBAzwExRsBAzwEOgEDPATfAgEDPAT2AwED" +
+	"PATcGAEDPAS0BQEDPASdYwEDPASTAwEDPAToKQEDPATYBQEDPATeEQEDPATJGQED" +
+	"PASkSQEDPASgGAEDPATHBwEDPATmCAEDPATiBQEDPASdCgEDPATmNQEDPATiNQED" +
+	"PATVAgEDPASgBgEDPATaBQEDPASDEgEDPASdBQEDPASbCwEDPATrBwEDPASQFAED" +
+	"PASvDQEDPASOCQEDPASFCwEDPAQJAQM8BJAFAQM8BJcbAQM8BI4FAQM8BLkEAQM8" +
+	"BIkEAQM8BOEGAQM8BBQBAzwE8wMBAzwE90sBAzwEggEBAzwEyhgBAzwErwIBAzwE" +
+	"8WIBAzwElwEBAzwEwQMBAzwE4AEBAzwEogwBAzwEmAwBAzwEm0QBAzwE1xoBAzwE" +
+	"9BABAzwE0wMBAzwEhQMBAzwEtAEBAzwE6gIBAzwEqQMBAzwE3zUBAzwEqQEBAzwE" +
+	"nAEBAzwEvwEBAzwEggsBAzwEuAEBAzwEqAIBAzwEnwMBAzwEtgUBAzwEqwIBAzwE" +
+	"pQIBAzwEpRsBAzwEswEBAzwEWgEDPATuCgEDPASwDAEDPATDAQEDPASoAQEDPASt" +
+	"AQEDPATTAgEDPASqAwEDPATcTwEDPASEDAEDPASBDQEDPASvYwEDPATMDAEDPATp" +
+	"KQEDPASxFgEDPAThEQEDPATKGQEDPASoSQEDPASiGAEDPATqEAEDPASZHwEDPASt" +
+	"CwEDPATEEwEDPAToNQEDPATkNQEDPAT6CgEDPATzHAEDPAS3FwEDPASIGwEDPATj" +
+	"DAEDPASDEwEDPATqHwEDPATeZQEDPASmEAEDPASbGQEDPASqGwEDPATUDwEDPASW" +
+	"DAEDPASLEgEDPAScPQEDPATyEQEDPASVEgEDPASyTgEDPAQwAQM8BOQIAQM8BNsG" +
+	"AQM8BO0YAQM8BOkHAQM8BMJjAQM8BIYHAQM8BOopAQM8BOoEAQM8BOIRAQM8BMsZ" +
+	"AQM8BKlJAQM8BPUQAQM8BMIIAQM8BKMKAQM8BIgFAQM8BMcTAQM8BOo1AQM8BOU1" +
+	"AQM8BPIEAQM8BJwFAQM8BNsFAQM8BL8OAQM8BLIFAQM8BMkGAQM8BMAHAQM8BJMU" +
+	"AQM8BK0QAQM8BPMJAQM8BJMSAQM8BOYDAQM8BKoFAQM8BIwSAQM8BIQGAQM8BN4D" +
+	"AQM8BKIEAQM8BJ8SAQM8BDUBAzwEzAIBAzwEnRsBAzwE3wQBAzwEjREBAzwElgUB" +
+	"AzwE+RMBAzwE9QUBAzwEmBYBAzwElgcBAzwE4BEBAzwElA4BAzwEpkkBAzwEoRgB" +
+	"AzwErTwBAzwEygcBAzwEpggBAzwEQgEDPATABQEDPASoFwEDPATjNQEDPATMBQED" +
+	"PAT0BAEDPATHBQEDPASEEgEDPAT4BAEDPATeBwEDPAS/BwEDPASSFAEDPASYBwED" +
+	"PATNBwEDPASpGwEDPASmBAEDPASABQEDPASKEgEDPATaBgEDPASuBQEDPATgAwED" +
+	"PATRCAEDPAQ4AQM8BPwCAQM8BJMbAQM8BNQDAQM8BNMYAQM8BP0EAQM8BIljAQM8" +
+	"BLEDAQM8BJ4NAQM8BK4GAQM8BK4OAQM8BMcZAQM8BJ1EAQM8BKBJAQM8BOcNAQM8" +
+	"BKk8AQM8BJ8HAQM8BJMGAQM8BPoDAQM8BMYDAQM8BN8LAQM8BOA1AQM8BKMDAQM8" +
+	"BPYFAQM8BKUFAQM8BL4OAQM8BLAFAQM8BLoDAQM8BLUFAQM8BK0GAQM8BLAIAQM8" +
+	"BKMHAQM8BKQJAQM8BKsGAQM8BLwFAQM8BIgSAQM8BIkSAQM8BIUIAQM8BLk8AQM8" +
+	"BJAEAQM8BIgEAQM8BJ0SAQM8BM4FAQM8BKMOAQI85wIBAzySA6kCAQM8ywQqAQQ8" +
+	"BK0M0wMBBDwErQxaAQQ8BK0M4wwBAzwL5zoBAzwLlkMBAzwLgl4BAzwLoxsBAzwL" +
+	"nikBAzwL2A0BAzwLkwoBAzwLtw0BAzwLrjYBAzwLuEQBAzwLq14BAzwLpTYBAzwL" +
+	"qkIBAzwO4wEBAzwOMQEDPA42AQM8DkEBAzwOTgEDPA5VAQM8DnUBAzwOgAEBAzwO" +
+	"dAEDPA6IAQEEPAStDKgBAQQ8BK0MxwUVBCkFAOIfAQQpAwDiHwEEKQUAyQ0BBCkD" +
+	"AMkNAQUpBQD4Af4IAQUpAwD4Af4IAQQpBQCIAwEEKQMAiAMBBCkFAMYQAQQpAwDG" +
+	"EAEFKQUA8BGyCwEFKQMA8BGyCwEFKQUAvBasAgEFKQMAvBasAgEFKQUAzQOGDQEF" +
+	"KQMAzQOGDQEHKQUA6gcCkweGDQEHKQMA6gcCkweGDQEFKQUAmgboCAEFKQMAmgbo" +
+	"CAEFKQUA+AHmAwEFKQMA+AHmAwEFKQUAmgYUAQUpAwCaBhQBBikFAMIDuQK0BAEG" +
+	"KQMAwgO5ArQEAQUpBQCiFrQEAQUpAwCiFrQEAQcpBQCaBsIDuQK0BAEHKQMAmgbC" +
+	"A7kCtAQBBCkFAL4LAQQpAwC+CwEFKQUA+AHoBgEFKQMA+AHoBgEFKQUA5QalBQEF" +
+	"KQMA5QalBQEFKQUA5QaZBQEFKQMA5QaZBQEFKQUA5QboBQEFKQMA5QboBQEFKQUA" +
+	"uA41AQUpAwC4DjUBBSkFAJ0WNQEFKQMAnRY1AQYpBQAzuA41AQYpAwAzuA41AQQp" +
+	"ALdKNQEDHym/YgEFHymGAvwJAQEFHymGAfwJAQEFHymrAfwJAQECkVq1AwEFHykA" +
+	"6BOIBgEEHykAOgEEHykACQEEHykAMAEFHykAhwgBAQQfKQDqBwEFHykA5QYBAQQf" +
+	"KQCsAgEDHynLCAEDHyn4GwECKcsIAQIp+BsBBCkFAM4QAQQpAwDOEAEEKQUAyxcB" +
+	"BCkDAMsXAQQpBQDjHwEEKQMA4x8BBCkFAJAQAQQpAwCQEAEEKQUAzBcBBCkDAMwX" +
+	"AQcpBQD6AwJMUgEHKQMA+gMCTFIBBCkFAPgMAQQpAwD4DAEEKQUA4R4BBCkDAOEe" +
+	"AQQpBQDfHgEEKQMA3x4BBCkFAJ8eAQQpAwCfHgEEKQUAkBEBBCkDAJARAQQpBQCO" +
+	"CgEEKQMAjgoBBSkFADM1AQUpAwAzNQEFKQUA8gY1AQUpAwDyBjUBBT0AKYcIAQEF" +
+	"PQAp5QYBAQQfKQD8BwEFHykAmgY4AQMXABQBAxcAWgEDFwAwAQMXAKoFAQMXAMwD" +
+	"AQMXANQMAQMXALkTAQMXADUBAxcA4QYBAxcAOgEDFwCpAQEDFwCXAQEDFwCBDwED" +
+	"FwDhHAEDFwDpGQEDFwDlEQEDFwCTCgEDFwC9DgEDFwCSEgEDFwCwAQEDFwCpDwED" +
+	"FwDeAwEDFwCQDwEDFwCdBQEDFwChHQEDFwD8EgEDFwCcDgEDFwD2CQEDFwC5GwED" +
+	"FwCiBAEDFwCrGwEDFwDVDwEDFwDJBgEDFwCLFAEDFwCzAQEDFwCXEgEDFwCtGQED" +
+	"FwC6EgEDFwCyDAEDFwCZEgEDFwCxHAEDFwD9EwEDFwDSCAEDFwDnCQEDFwCcBQED" +
+	"FwDyBAEDFwC5BAEDFwCJBAEDFwDiEgEDFwCSCwEDFwDvCgEDFwCcGwEDFwCwDAED" +
+	"FwChAgEDFwDeBwEDFwDSEgEDFwDCCAEDFwDpEAEDFwCvAgEDFwCtAQEDFwDVAgED" +
+	"FwCTAwEDFwCpDgEDFwCABQEDFwDHGgEDFwD3DgEDFwDVBwEDFwCoAQEDFwDiBQED" +
+	"FwCQBQEDFwCuBQEDFwD8CgEDFwCcCgEDFwCYDAEDFwCGAgEDFwC3GwEDFwCEHQED" +
+	"FwDHOgEDFwCYRAEDFwCHRAEEFx8qkkQBBBcfKqFfAQIXphsBAxeSA6kCAQIXmwQB" +
+	"AhfnAgECF7IHAQMXywQqCQY9APQHiQHpCMASAQY9APQHiQG8B8ASAQY9APQHiQHp" +
+	"CJsdAQY9APQHiQG8B5sdAQY9APQHiQHpCNEMAQY9APQHiQG8B9EMAQY9APQHiQHp" +
+	"CJwFAQY9APQHiQG8B5wFAQY9ANUNywOJAXcBBj0AjgHLA4kBdwEGPQD7CcsDiQF3" +
+	"AQY9AIcBywOJAXcBBj0A1g3LA4kBdwEHPQDVDcsDiwiJAXcBBz0AjgHLA4sIiQF3" +
+	"AQc9APsJywOLCIkBdwEHPQCHAcsDiwiJAXcBBz0A1g3LA4sIiQF3AQY9ANUNiwiJ" +
+	"AXcBBj0AjgGLCIkBdwEGPQD7CYsIiQF3AQY9AIcBiwiJAXcBBj0A1g2LCIkBdwEF" +
+	"PQA7U3cBBD0AO6YGAQU9ADusAXcBBj0AayX1A9wCAQU9AJEEYRsBBT0AkQRzGwEF" +
+	"PQCRBOwEKgEGPQCRBM8C7AQqAQY9AIcBzwLsBCoBBj0A5QwYjgGJAQEGPQDlDBiH" +
+	"AYkBAQUHBQDLDXYBBQcDAMsNdgEFBwUAyw27AwEFBwMAyw27AwEEBwUAggwBBAcD" +
+	"AIIMAQQHBQD8HgEEBwMA/B4BBAcFANEeAQQHAwDRHgEEBwUAtA0BBAcDALQNAQYH" +
+	"BQC0DQLnAgEGBwMAtA0C5wIBBQcAAwWzAgEFBwADBWMBBAcFAO0BAQQHAwDtAQEE" +
+	"BwUAkA0BBAcDAJANAQQHBQCiAgEEBwMAogIBBAcFAK4KAQQHAwCuCgEHBwUArgoC" +
+	"rAF3AQcHAwCuCgKsAXcBBAcFAJEHAQQHAwCRBwEHBwUA+AHzAQI7AQcHAwD4AfMB" +
+	"AjsBBgcFAMcBAkgBBgcDAMcBAkgBBwcFAMcBArsBSAEHBwMAxwECuwFIAQkHBQDH" +
+	"AQJIGLsBSAEJBwMAxwECSBi7AUgBBQcFALgKbwEFBwMAuApvAQcHBQBvAo4BSAEH" +
+	"BwMAbwKOAUgBCAcFADUCqgFImwUBCAcDADUCqgFImwUBBgcFADUC4QQBBgcDADUC" +
+	"4QQBBAcFAKoDAQQHAwCqAwEIBwUA+gECSMoG3AMBCAcDAPoBAkjKBtwDAQYHBQD6" +
+	"AQLyCwEGBwMA+gEC8gsBBwcFAPoBAt4d6QIBBwcDAPoBAt4d6QIBCAcFAO4CAkjK" +
+	"BtwDAQgHAwDuAgJIygbcAwEHBwUA7gICuwFIAQcHAwDuAgK7AUgBBQcFAGLaDAEF" +
+	"BwMAYtoMAQUHBQCED9oMAQUHAwCED9oMAQcHBQDLAQK7AUgBBwcDAMsBArsBSAEE" +
+	"BwUA8BMBBAcDAPATAQUHBQCWH94BAQUHAwCWH94BAQYHBQDFCQJIAQYHAwDFCQJI" +
+	"AQgHBQDFCQJIygbcAwEIBwMAxQkCSMoG3AMBBAcFAI8fAQQHAwCPHwEEBwUA9wYB" +
+	"BAcDAPcGAQQHBQDoCgEEBwMA6AoBBAcFAOgWAQQHAwDoFgEDPQDGDwEEBwMAxTgB" +
+	"BAcDAKgHAQQHAwC4SgEEBwMAkk4BBAcDAIQPAQUHAAMFhA8BBAcDAPYMAQQHAwDj" +
+	"CAEFBwUAyQXSAQEFBwMAyQXSAQEFBwUAyQWzAgEFBwMAyQWzAgEFBwUAyQXBAQEG" +
+	"BwUAoAHJBcEBAQYHAwCgAckFwQEBBQcFAKABbwEFBwMAoAFvAQUHBQDJBWIBBQcD" +
+	"AMkFYgEFBwUAyQVjAQUHAwDJBWMBBQcFAMkFxQEBBQcDAMkFxQEBBT0AhwGLAsQC" +
+	"AQM9AJsEAQU9ALUC/QMBAQQHBQCDHQEEBwMAgx0BBQcFAKAB1AEBCQcDAG8CzwNS" +
+	"GMEHAQQHAPJZOwEGBwUAmQEC3AMBBgcDAJkBAtwDAQYHBQDzAQJ3AQYHAwDzAQJ3" +
+	"AQcHAwDzAQLIBFIBBwcDANQBAsgEUgEGBwUAPgLyCwEGBwMAPgLyCwEGBwUAswIC" +
+	"SAEGBwMAswICSAEFBwUAtguVBAEFBwMAtguVBAEFBwUAtgvEAwEFBwMAtgvEAwEF" +
+	"BwUAtgugBQEFBwMAtgugBQEHBwUAwQECiwZIAQcHAwDBAQKLBkgBBwcFAMcBAosG" +
+	"SAEHBwMAxwECiwZIAQcHBQCZAQKLBkgBBwcDAJkBAosGSAEHBwUAYgKLBkgBBwcD" +
+	"AGICiwZIAQcHBQBjAosGSAEHBwMAYwKLBkgBBgcFANQBAlIBBgcFAPgBsQE4AQUH" +
+	"BQCdAcEBAQYHBQBvAsEHAQYHBQADBToBBQcAAwXuAgEFBwUAoAHHAQEFBwUAoAHF" +
+	"AQEGBwUAkwICtwgBBAcFAOsEAQQHBQDXBQEEBwMA1wUBBAcFAKwCAQQHAwCsAgEG" +
+	"BwUAMAJIAQYHAwAwAkgBBQcFAIYEFAEFBwMAhgQUAQUHBQCGBDoBBQcDAIYEOgEF" +
+	"BwUAhgQwAQUHAwCGBDABBgcFACTFEjUBBgcDACTFEjUBBQcFANsVjgIBBQcDANsV" +
+	"jgIBBwcFAPMBAsgEUgEGBwUAYwJSAQcHBQDeAQLIBFIBCAcFANIBArUCSJsFAQgH" +
+	"AwDSAQK1AkibBQEIBwUAYwK1AkibBQEIBwMAYwK1AkibBQYGBwUAwgPJBcEBAQYH" +
+	"AwDCA8kFwQECBQcDADPFCQIFBwMAM84PAQYHBQBMjR1jAQYHAwBMjR1jAQUHBQCz" +
+	"HWMBBQcDALMdYxkEPQAF8wEBBD0ABbMCAQQ9AAXuAgEGBwUA+AFu1AEBBgcDAPgB" +
+	"btQBAQUHzwoA4QU6AQY9AAXUAQJIAQU9AAMgxAMBBgcAAwWgAbABAQUHzwoA+AGz" +
+	"AgEFB88KAPgB+gEBBQfPCgDPArABAQUHzwoAOqgOAQUHzwoA2QOwAQEEwAO+AwAU" +
+	"AQTAA74DADoBBMADvgMB1zgBBMADvgMAMAEEwAO+AwA4AQTAA74DADUBBMADvgMB" +
+	"zBgBBMADvgMAgAUBBMADvgMApQcBBMADvgMA2gYBBMADvgMAshgBBMADvgMBjAMB" +
+	"BMADvgMAmAcBBMADvgMAwwcBBMADvgMAzQcBBMADvgMAiEIBBMADvgMA4RMBBMAD" +
+	"vgMAvQ8BBMADvgMAuxABBMADvgMAlxcBBMADvgMAQgEEwAO+AwDABQEEwAO+AwDH" +
+	"BQEEwAO+AwCoFwEEwAO+AwDgAwEEwAO+AwD1BQEEwAO+AwDYBwEEwAO+AwCWBwEE" +
+	"wAO+AwCYFgEEwAO+AwCuBQEEwAO+AwD0BAEEwAO+AwDMBQEEwAO+AwD2HAEEwAO+" +
+	"AwD4BAEEwAO+AwDfBAEFwAO+AxABFAEFwAO+AxABOgEFwAO+AxABMAEFwAO+AxAB" +
+	"OAEFwAO+AxABqgMBBMADvgPiDqAMAQTAA74D4g6hDAEEwAO+A+IOwxoBBMADvgPi" +
+	"DsJIAQXAA74DAdgDzBgEBbwBmQLMATHuAwEFvAGZAswBMW4BBbwBmQLMAUHCBgEF" +
+	"vAGZAswBMZ8LAQW8AZkCzAEx6QMBBbwBmQLMAUGYDwEEvAGZAu4DKgEEvAGZAsIS" +
+	"KgEEvAGZArEJKgEEvAGZAu1SKgcDgAMAWgEDgAMA0QIBA4ADAMMBAQOAAwChAgED" +
+	"gAMAqwIBA4ADAM4CAQOAAwClAgEDgAMA0wIBA4ADALQBAQOAAwDqAgEDgAMAvwEB" +
+	"A4ADAK0BAQOAAwCXAQEDgAMAhAMBA4ADAOABAQOAAwCoAQEDgAMAvgQBA4ADAMsG" +
+	"AQOAAwD1BgEDgAMArwIBA4ADALYFAQOAAwCfAwEEgAMAAxQBA4ADALMBAQOAAwCc" +
+	"AQEDgAMAqQEBA4ADAKgCAQOAAwC4AQEDgAMAggEBA4ADABQBA4ADADoBA4ADADAB" +
+	"A4ADADgBA4ADADUBA4ADAM8FAQOAAwDOBgEDgAMA0wMBA4ADANkKAQOAAwDMAwEE" +
+	"gAOAAgCvAgEEgAOAAgCzAQEDgAMAmgMBA4ADANADAQOAAwCbAwEDgAMAvwMBBIAD" +
+	"ANgDswEBBIADAJ5iqAIBBIADAKcIggEBBIADAIwn0wMBBIADgAIAnAEBBIAD5VsA" +
+	"nAEBA4ADAI0EAQSAA4wC0gQqAQSAAzPSBCoBA4ADKqQGAQSAAyozpAYJA4gCAYwD" +
+	"AQOIAgHxAwEDiAIAFAEDiAIA7QEBA4gCADoBA4gCAJICAQOIAgAwAQOIAgCqAgEE" +
+	"iAIAe2IBBIgCAHviAwEEiAIAe28BBIgCAHuHBAEDiAIAOAEDiAIAzAMBA4gCAIoC" +
+	"AQOIAgA1AQOIAgCqAwEDiAIAogIBA4gCAFoBA4gCANECAQOIAgDDAQEDiAIArwMB" +
+	"A4gCAKECAQOIAgCrAgEDiAIAzgIBA4gCAKUCAQOIAgD/AwEDiAIA0wIBA4gCAJoD" +
+	"AQOIAgDQAwEDiAIAmwMBA4gCANsDAQOIAgC/AwEDiAIAtAEBA4gCAOoCAQOIAgC/" +
+	"AQEDiAIAqQMBA4gCAK0BAQOIAgCXAQEDiAIAhAMBA4gCAOABAQOIAgDBAwEDiAIA" +
+	"qAEBA4gCALMBAQOIAgCcAQEDiAIAqQEBA4gCAIUDAQOIAgCoAgEDiAIA4wMBA4gC" +
+	"ALgBAQOIAgCCAQEDiAIA4AQBBIgC8QEB7j0BBIgCEAHtAQEEiAIQAToBBIgCEAGS" +
+	"AgEEiAIQATABBIgCEAGqAgEFiAIQAXtiAQWIAhABe+IDAQWIAhABe28BBYgCEAF7" +
+	"hwQBBIgCEAE4AQSIAhABzAMBBIgCEAGKAgEEiAIQATUBBIgCEAGqAwEEiAIQAaIC" +
+	"AQOIAgHWAwEDiAIBjQQJAogCtwQBA4gCM7cEAQOIAg7jAQEDiAIOMQEDiAIONgED" +
+	"iAIOQQEDiAIOTgEDiAIOVQEDiAIOdQEDiAIOgAEBA4gCDnQBA4gCDogBBwQfbA7j" +
+	"AQEEH2wOMQEEH2wONgEEH2wOQQEEH2wOTgEEH2wOVQEEH2wOdQEEH2wOgAEBBB9s" +
+	"DnQBBB9sDogBAQQfbAAUAQQfbAAwAQQfbABaAQQfbACtAQEEH2wAlwEBBB9sAJwB" +
+	"AQQfbADmCAEEH2wBlQYBBGwBmRONBAEEbAGNBNYDAQVsATONBNYDAQRsAY0ENgEE" +
+	"bAGNBEEBBGwBjQSVBgEDbAGtUgEDbNgKvwgBAmzzBwECbIYRAQNsAdABAQNs90H/" +
+	"BgEDbACRBwEEbBABkQcBBJYD1QIO4wEBBJYD1QIOMQEElgPVAg42AQSWA9UCDkEB" +
+	"BJYD1QIOTgEElgPVAg5VAQSWA9UCDnUBBJYD1QIOgAEBBJYD1QIOdAEElgPVAg6I" +
+	"AQEElgPVAgBaAQSWA9UCANECAQSWA9UCAMMBAQSWA9UCAKECAQSWA9UCALgBAQSW" +
+	"A9UCAKgCAQSWA9UCAJ8DAQSWA9UCANMCAQSWA9UCALQBAQSWA9UCAOsYAQSWA9UC" +
+	"AK0BAQSWA9UCAJcBAQSWA9UCAIQDAQSWA9UCAKgBAQSWA9UCAL8BAQSWA9UCAOAB" +
+	"AQSWA9UCAJwBAQSWA9UCALMBAQSWA9UCAKkBAQSWA9UCAK8CAQSWA9UCAOoCAQSW" +
+	"A9UCAIIBAQSWA9UCAIUDAQSWA9UCAKsCAQSWA9UCABQBBJYD1QIAxAMBBJYD1QIA" +
+	"OgEElgPVAgCqAwEElgPVAhCgBQEElgPVAhA4AQSWA9UCEDABBJYD1QIQzAMBBJYD" +
+	"1QIQNQEElgPVAokBnxwBBJYD1QKJAcYWAQWWA9UCiQHGFp8cAQSWA9UCAbMQAQSW" +
+	"A9UCAdZZAQP5AwBaAQP5AwDDAQED+QMAoQIBA/kDALQBAQP5AwC/AQED+QMArQEB" +
+	"A/kDAJcBAQP5AwDgAQED+QMAqAEBA/kDAKsCAQP5AwClAgED+QMA0wIBA/kDALgB" +
+	"AQP5AwCcAQED+QMAqQEBA/kDALMBAQP5AwCvAgED+QMAggEBA/kDAKIMAQP5AwCw" +
+	"DAED+QMAggsBA/kDAK9OAQP5AwAUAQT5AxABOgEE+QMQATABBPkDEAE4AQT5AxAB" +
+	"igIBBPkDEAE1AQT5AxABogIBBPkDEAG+CAEE+QMQAc8QAQT5A/EBAc4FAQT5A/EB" +
+	"AZkBAQT5A/EBAWIBBPkD8QEB1AEBAvkD1gMMA/kD8AMqAQMclQH5XQEDHJUBtR4B" +
+	"AxyVAbceAQMclQGzHgEDHJUB+Q4BAxyVAegcAQMclQHlEgEDHJUBpFQBAxyVAfoO" +
+	"AQMclQH7DgEDHJUBolQBAxyVAeIcAQMclQH8DgEDHJUBmlQBAxyVAeMcAQMclQHc" +
+	"GgEDHJUB0UkBAxyVAesRAQMclQGoUQEDHJUBoVEBAxyVAY8cAQMclQHpWgEDHJUB" +
+	"pUEBAxyVAZ9BAQMclQHlWgEDHJUB61oBAxyVAYtRAQMclQHaPgEDHJUB7VoEA+YB" +
+	"AblQAQPmAQHSFgED5gEBxEUBA+YBAZtjAQPmAQAUAQTmAQA6iEMBA+YBADoBA+YB" +
+	"AJICAQPmAQAwAQTmAQCXAcsrAQTmAQChAusZAQXmAQChAusZz1MBA+YBADgBA+YB" +
+	"AIoCAQPmAQA1AQPmAQBaAQTmAQBasgkBBOYBAFqqBwED5gEAwwEBBOYBAMMBqgcB" +
+	"A+YBAKECAQPmAQCrAgEE5gEAqwKqBwED5gEApQIBBOYBANMCqgcBBOYBAKUCmQkB" +
+	"A+YBANMCAQPmAQCaAwEE5gEAmgOZCQED5gEAmwMBBOYBAJsDmQkBBOYBAK0BqgcB" +
+	"A+YBALQBAQTmAQC0AaoHAQPmAQC/AQEE5gEAvwGZCQED5gEArQEBA+YBAJcBAQTm" +
+	"AQCXAaoHAQPmAQDgAQEE5gEA4AGqBwED5gEAqAEBA+YBALMBAQPmAQCcAQEE5gEA" +
+	"nAH8JQED5gEAqQEBA+YBAK8CAQTmAQC4AaoHAQTmAQC4AZkJAQPmAQC4AQED5gEA" +
+	"ggEBBOYBAdIWqV0BBOYBEAGEXQEE5gEQAaBeAQTmARABsR8BBeYBEAGxH/lIAQTm" +
+	"ARABpw8BBeYBEAGnD/5IAQTmARAB6QwBBeYBEAGHNsRKAQTmARABtxIBBOYB8QEB" +
+	"oEMBBOYB8QEB7VABBOYB8QEBxRYBAuYBr1ABA+YBJ9kcAQPmASXZHAED5gGBB7Mm" +
+	"AQPmAYEHlkgBA+YBgQfBRgED5gGBB6sfAQPmAYEHrlABA+YBgQeLRgED5gGBB8RG" +
+	"AQPmAYEHwAsBBOYBgQfAC8ALAQPmAYEHmRwBBOYBoAGBB5kcAgLmAbJQAQPmAQ7j" +
+	"AQED5gEOMQED5gEONgED5gEOQQED5gEOTgED5gEOVQED5gEOdQED5gEOgAEBA+YB" +
+	"DnQBA+YBDogBBQTmAYEHh16lXwED5gGBB+hBAQRNALkDrwMBBE0AuQPOAgEETQC5" +
+	"A/8DAQRNALkDvwMBBE0AuQPBAwEETQG5A/ESAQVNPQC5A9UcAQVNADTxBNMCAQVN" +
+	"ADTxBNMDAQVNADTxBMMBAQVNADTxBK8DAQVNADTxBKUCAQVNADTxBP8DAQVNADTx" +
+	"BJsDAQVNADTxBNsDAQVNADTxBL8DAQVNNPEEDuMBAQVNNPEEDjEBBU008QQONgEF" +
+	"TTTxBA5BAQVNNPEEDk4BBU008QQOVQEFTTTxBA51AQVNNPEEDoABAQVNNPEEDnQB" +
+	"BU008QQOiAEBBU0ANPEE4AQBBU0ANPEEvwEBBU0ANPEEqQMBBU0ANPEE4AEBBU0A" +
+	"NPEEwQMCA4ICABQBA4ICADoBA4ICADABA4ICADgBA4ICAIoCAQOCAgA1AQOCAgBa" +
+	"AQOCAgDRAgEDggIAwwEBA4ICAK8DAQOCAgCuTAEDggIAoQIBA4ICAM4CAQOCAgDw" +
+	"KwEDggIApQIBA4ICAP8DAQOCAgC4TAEDggIAhAsBA4ICALdMAQOCAgC0AQEDggIA" +
+	"6gIBA4ICAL8BAQOCAgCpAwEDggIAkU4BA4ICAK0BAQOCAgCbAwEDggIAlwEBA4IC" +
+	"APJRAQOCAgCEAwEDggIA4AEBA4ICAMEDAQOCAgCvSgEDggIAqAEBA4ICANcJAQOC" +
+	"AgCzAQEDggIAnAEBA4ICAKkBAQOCAgCFAwEDggIA4wMBA4ICALgBAQOCAgCCAQEE" +
+	"ggIQAe0BAQSCAhABOgEEggIQAZICAQSCAhABuggBBIICEAEwAQSCAhABxAMBBIIC" +
+	"EAE1AQSCAhABigIBBIICEAGiAgEEggIQAaAFAQSCAvEBAbMBAQSCAvEBAZwBAQSC" +
+	"AvEBAakBAQSCAvEBAa8CCgSCAgAyxwEBBIICADLBAQEEggIAMs4FAQWCAvEBATLO" +
+	"BQEEggIAMtcWAQSCAgAyxQEBBIICADKZAQEEggIAMvoBAQSCAgAy4gEBBIICADJi" +
+	"AQSCAgAybwEEggIAMqEPAQWCAvEBATKwAQEFggLxAQEy1AEDA4ICDuMBAQOCAg4x" +
+	"AQOCAg42AQOCAg5BAQOCAg5OAQOCAg5VAQOCAg51AQOCAg6AAQEDggIOdAEDggIO" +
+	"iAEDA4ICxAGRCgEDggLEAbcEAQSCAsQBM7cEAQSCAsQB8AK3BAEETQD/BMMBAQRN" +
+	"AP8EqwIBBE0A/wTOAgEETQD/BKUCAQRNAP8E/wMBBE0A/wTTAgEETQD/BJoDAQRN" +
+	"AP8E0AMBBE0A/wSbAwEETQD/BNsDAQRNAP8EqQMBBE0A/wStAQEETQD/BLgBAQRN" +
+	"AP8EggEBBE0A/wTGCAEETQD/BNMDAQVNPQD/BNUcAQRNAP8EzgYBBE0A/wSfAwEE" +
+	"TQD/BJwBAQRNlwn/BMsbAQRNlwn/BOZSAQRNlwn/BIQ/AQRNC6oK7AQBBE0Lqgox" +
+	"AQRNC6oKNgEETQCqCpwBAQVNAb1Q8gWJAQEFTQE08QSgCAEFTQE08QShCAEFTQCO" +
+	"CtQOzgIBBU0AjgrUDqgCAQU0rgIAhwGABQEFNK4CAI4BgAUBBTSuAgCHAaUHAQU0" +
+	"rgIAjgGlBwEFNK4CAIcBthkBBTSuAgCOAbYZAQU0rgIAhwHaBgEFNK4CAI4B2gYB" +
+	"BTSuAgCHAYAKAQU0rgIAjgGACgEFNK4CAIcBmAcBBTSuAgCOAZgHAQU0rgIAhwHD" +
+	"BwEFNK4CAI4BwwcBBTSuAgCHAfgEAQU0rgIAjgH4BAEFNK4CAIcB0QgBBTSuAgCO" +
+	"AdEIAQU0rgIAhwHHBQEFNK4CAI4BxwUBBTSuAgCHAUIBBTSuAgCOAUIBBTSuAgCH" +
+	"AcAFAQU0rgIAjgHABQEFNK4CAIcB4AMBBTSuAgCOAeADAQU0rgIAhwGWBwEFNK4C" +
+	"AI4BlgcBBTSuAgCHAfUFAQU0rgIAjgH1BQEFNK4CAIcB2AcBBTSuAgCOAdgHAQU0" +
+	"rgIAhwHKBwEFNK4CAI4BygcBBTSuAgCHAa4FAQU0rgIAjgGuBQEFNK4CAIcBpgQB" +
+	"BTSuAgCOAaYEAQU0rgIAhwH0BAEFNK4CAI4B9AQBBTSuAgCHAcwFAQU0rgIAjgHM" +
+	"BQEFNK4CAIcBpggBBTSuAgCOAaYIAQU0rgIAhwHfBAEFNK4CAI4B3wQBBTSuAgCH" +
+	"ATUBBTSuAgCOATUBBDSuAqwFiQ4BBDSuAhDtAQEENK4CEDoBBDSuAhCgBQEENK4C" +
+	"EDABBDSuAhA4AQQ0rgIQNQEENK4CrAW4QwEENK4CEPsNAQQ0rgIQmmABBDSuAhCx" +
+	"CwEENK4CEPQVAQQ0rgIQkQcBBDSuAhDzAwEENK4CEO0HAQU0rgKJAawFzQoBBTSu" +
+	"AokBrAW6GwEFNK4CiQGsBcAFAQU0rgKJAawFs1oZBDSuAgvCGQEENK4CC7obAQQ0" +
+	"rgIL7xIBBTSuAgvfBMI/AQU0rgILuRG5EQEElQKUAgA4AQSVApQCADUBBJUClAIA" +
+	"zgIBBJUClAIA0wIBBJUClAIAmgMBBJUClAIA0AMBBJUClAIAmwMBBJUClAIA2wMB" +
+	"BJUClAIAvwMBBJUClAIAqAIBBJUClAIA4wMBBZUClAIQAZICAQWVApQCEAGqAgEF" +
+	"lQKUAhABvBUBBZUClAIQAaICAQWVApQCEAH8JAEDlQKUAuMrAQSVApQC/yXBQwED" +
+	"lQKUAuQPAQWVApQCBNUMKgEFlQKUAqEF1QwqAQWVApQCEAHxAwEDlQKUAtYDCwMi" +
+	"BIxfAQMiBOUeAQMiBOATAQMiBIpfAQMiBOQeAQMiBL0PAwMiBJw1AQMiBJs1AQMi" +
+	"BJYXAQMiBJo1AQMiBJk1AQMiBJcXAwMiBMoXAQMiBMcXAQMiBN44AQMiBMUXAQMi" +
+	"BP4IAQMiBMkXCgMiBK0rAQMiBLMrAQMiBLErAQMiBK4rAQMiBLArAQMiBK8rAQMi" +
+	"BLIrAgMiBNcJAQMiBIwWAQMiBIoWAQMiBLApAQMiBLQpAQMiBMsLAQMiBIsWAgUH" +
+	"AwDwB7ECAQUHAwAUh1QBBQcDAP4POAEFBwMA8Ac4AQYHAwA4AvILAQUHAwDsGbMC" +
+	"AQcHAwCdAcEBArcIAQgHAwBvAs8CxRFjAQgHAwBvAjNMuQEBBwcDAG8CTJ8BAQYH" +
+	"AwCwAQK3CAEGBwMAmQECtwgBBgcDAJ0HArcIAQUHAwD+DzUBBwcDAP4PNQJIAQcH" +
+	"AwCxATUCSAEFBwMAzwLEAwEHBwMAoAHEAwJIAQgHAwCgAcQDAqwBSAEGBwMAoAE1" +
+	"2xsBCAcDAKABNdsbAkgBBQcDAKFbYgEIBwADBWICJcsFAQYHAwBigA3LGAEFBwMA" +
+	"M2IBBgcDAGICtwgBBwcDADNiArcIAQUHAwCdAWIBBwcDAJ0BYgKfAQEFBwMAhhbc" +
+	"BQEIBwMAMAK1AiXLBQEJBwMAMHcCtQIlywUBBAcDAMMPAQUHAwCgAcMPAQcHAwAw" +
+	"AidSAQQHAwDrBAEIBwMA6wQChwElnwEBCAcDAOsEAocBJ5UdAQgHAwD8AQKHASWf" +
+	"AQEIBwMA/AECqgEnywUBDAcDAPwBAqoBJ8sFGIcBJZ8BAQoHAwD8AQKqASfLBQKV" +
+	"HQEIBwMA4gECtQIlywUBBT32AgLPAvYCAQQ9AAOCDAEIPQADbwLPAsURYwEHPQAD" +
+	"bwJMuQEBBz0AAzACJ1IBBQcDAOJV6AgBBQcDAJoGOAEFBwMAsQHEAwEEBwMAhx8B" +
+	"BQcDAM8CsQIBBSEAAwWsAgEIBwMA+weoBALPA1IBCAcDALoPqAQCzwNSAQgHAwCg" +
+	"AWICTLkBAQU9AAOgAY4CAQQ9ACeMBAEEPQAljAQFBGcDABQBBGcDADgBBGcDADoB" +
+	"BGcDADUBBGcDADABBGcDAMsBAQRnAwDDAQEEZwMAWgEEZwMAhQgBBGcDAI4FAQRn" +
+	"AwDaBgEEZwMAhAYBBGcDAMMYAQRnAwCCAQEEZwMA1AMBBGcDAPYDAQRnAwDfBAEE" +
+	"ZwMA2wYBBGcDAPkNAQRnAwCpAQEEZwMAowMBBGcDANUCAQRnAwDMBQEEZwMA8gQB" +
+	"BGcDAIUaAQRnAwCoAQEEZwMAkAQBBGcDALkEAQRnAwCuBQEEZwMA3gMBBGcDAK0B" +
+	"AQRnAwCLEQEEZwMA/REBBGcDAIgEAQRnAwCJBAEEZwMA4AMBBGcDAKIEAQRnAwCb" +
+	"EgEEZwMAvBwBBGcDAL0cAQRnAwC+HAEEZwMA3BIBBGcDAN0SAQRnAwDBHAEEZwMA" +
+	"uAEBBGcDAGMBBGcDALAFAQRnAwCdBQEEZwMA+AQBBGcDALIFAQRnAwCyEwEEZwMA" +
+	"vwEBBGcDALQBAQRnAwClBQEEZwMA+gMBBGcDANoFAQRnAwDiBQEEZwMAxwUBBGcD" +
+	"ANsFAQRnAwDDFwEEZwMAww0BBGcDAM4TAQRnAwDPEwEEZwMA0RMBBGcDANITAQRn" +
+	"AwDTEwEEZwMAwR4BBGcDAL4EAQRnAwDoBgEEZwMAoQoBBGcDALsPAQRnAwC8DwEE" +
+	"ZwMA4B4BBGcDAK8CAQRnAwD9BAEEZwMAtAUBBGcDAJYFAQRnAwDpBwEEZwMAsx8B" +
+	"BGcDALMBAQSVApQCAMEZAQSVApQCAO8SAQSVApQCAJ0OAQSVApQCAO4RAQSVApQC" +
+	"AJcBAQSVApQCAK0BAQSVApQCAPUrAQSVApQCAMwTAQSVApQCAL5DAQSVApQCAJ8b" +
+	"AQSVApQCANRdAQSVApQCAO5iAQSVApQCALwHAQSVApQCAI1AAQSVApQCAOQIAQSV" +
+	"ApQCADoBBJUClAIAiFEBBJUClAIAmycBBJUClAIAmT0BBJUClAIAhkIBBJUClAIA" +
+	"w1MBBJUClAIA4AEBBJUClAIAjAwBBJUClAIA+jUBBJUClAIA9TwBBJUClAIA6TUB" +
+	"BJUClAIA5SkBBZUClAIAwRmYCQEFlQKUAgCdDpgJAQWVApQCAO4RmAkBBZUClAIA" +
+	"lwGYCQEFlQKUAgCtAZgJAQWVApQCAMwTmAkBBZUClAIAnxuYCQEFlQKUAgA6mAkB" +
+	"BZUClAIQAdBPAQWVApQCEAHAQQEFlQKUAhABsCYBBZUClAIQAb9kAQWVApQCEAG6" +
+	"WgEFlQKUAhABrmABBZUClAIQAeUrAQWVApQCEAGaEgEDlQKUAuQrAQSVApQCqAeN" +
+	"GQEElQKUAt0mjRkDBJUClAIO4wEBBJUClAIOMQEElQKUAg42AQSVApQCDkEBBJUC" +
+	"lAIOTgEElQKUAg5VAQSVApQCDnUBBJUClAIOgAEBBJUClAIOdAEElQKUAg6IAbdX" +
+	"AxzcAedOAQMc3AHiTgEDHNwB4WQBAxzcAeJkAQMc3AHjZAEDHNwB4l8BAxzcAd9f" +
+	"AQMc3AH5ZAEDHNwB/GQBAxzcAeU5AQMc3AHnOQEDHNwB5jkBAxzcAek5AQMc3AHN" +
+	"QAEDHNwBzkABAxzcAdBAAQMc3AHPQAEDHNwBykABAxzcAdFAAQMc3AHSQAEDHNwB" +
+	"yEABAxzcAeMmAQMc3AHkJgUDHIoBx0wBAxyKAcVMAQMcigGmDwEDHIoB7FoBAxyK" +
+	"AbUeAQMcigG3HgEDHIoB+l0BAxyKAbMeAQMcigH4XQEDHIoB+10BAxyKAegcAQMc" +
+	"igGbVAEDHIoB51oBAxyKAZxUAQMcigGhVAEDHIoBoFQBAxyKAadUAQMcigGmVAED" +
+	"HIoBoxkBAxyKAcxJAQMcigHPSQEDHIoB5loBAxyKAc1JAQMcigHKSQEDHIoBvxIB" +
+	"AxyKAaVRAQMcigGiUQEDHIoBpA8BAxyKAb4SAQMcigG9EgEDHIoBjhwBAxyKAbwd" +
+	"AQMcigH1WQEDHIoB6FoBAxyKAepaAQMcigH3WQEDHIoBiRMBAxyKAbodAQMcigG/" +
+	"HQEDHIoBux0BAxyKAbdQAQMcigG2UAEDHIoBxWQBAxyKAcRkAQMcigGiLAEDHIoB" +
+	"oywBAxyKAaIPAQMcigGMUQEDHIoBjVGFRgQHAyDyOgEEBwMgxwcBBAcDIIw7AQQH" +
+	"AyDzOgEEBwMg9DoBBgcDIKoBY8UBAQQHAyChEw0F1gEDINUHxg4BBdYBAyDVB8wK" +
+	"AQXWAQMg1QeYEQEF1gEDIO8Txg4BBdYBAyDVB/4TBgWNAQCpCALeGAEEjQHnBLBC" +
+	"tWEBBo0BINMPqQipCNgOAQSNAQDiD5IHAQSNAQC6B3YBBI0BALoHtg0BBI0BALoH" +
+	"1AMBBI0BALoHnQMBBI0BALoHng4BBY0BALoHMt8FAQSNAQC6B4cHAQSNAQC6B7sT" +
+	"AQWNAQDiD2ABAQaNAQCYBAKYBDsBBo0BAJgEApcPOwEIjQEAmAQCjAUYmAQ7AQiN" +
+	"AQCYBAKMBRiXDzsBBY0BAHYC2A4BBY0BAHYC6g4BBY0BAHYC2xEBBY0BALMKAowF" +
+	"AQWNAQCZBgKMBQEFjQEAtg0CjAUBBY0BANQDAtsRAQWNAQC1CwKMBQEFjQEA7QYC" +
+	"jAUCBY0BAJwKAowFAQWNAQCpCAKMBQEGjQEAMp0DAowFAQWNAQCdAwKMBQEFjQEA" +
+	"ng4CjAUCBY0BAN8FAowFAgWNAQDxAgKMBQEFjQEAowYCjAUCBo0BADKxAwKMBQEF" +
+	"jQEAsQMCjAUCBY0BAN0TAowFAQWNAQDaEgKMBQEFjQEAhwcCjAUBBY0BAJgEAowF" +
+	"AQWNAQC7EwKMBQEFjQEAtQsCjBEBBY0BALMKAvAOAQWNAQCdAwLwDgEFjQEAsQMC" +
+	"8A4BBI0BIHaeDgEGCAB2yQ9JDwEGCAB2yQ8yDwEFCADMC0kPAQUIAMwLMg8BBQgA" +
+	"zAtXDwEFCADMC80BDwEFCADTCEkPAQUIANMIMg8BBQgA0whXDwEFCADTCM0BDwEF" +
+	"CADNC0kPAQUIAM0LMg8BBQgAzQtXDwEFCADNC80BDwEFCAD1DEkPAQUIAPUMMg8B" +
+	"BQgA9QxXDwEFCAD1DM0BDwEFCADrDEkPAQUIAOsMMg8BBQgA6wxXDwEFCADrDM0B" +
+	"DwEFCACvC0kPAQUIAK8LMg8BBQgArwtXDwEFCACvC80BDwEFCAD8DEkPAQUIAPwM" +
+	"Mg8BBQgA/AxXDwEFCAD8DM0BDwEFCADDDEkPAQUIAMMMMg8BBQgAwwxXDwEFCADD" +
+	"DM0BDwEFCADlC0kPAQUIAOULMg8BBQgA5QtXDwEFCADlC80BDwEFCAC2DEkPAQUI" +
+	"ALYMMg8BBQgAtgxXDwEFCAC2DM0BDwEFCACYCkkPAQUIAJgKMg8BBQgAmApXDwEF" +
+	"CACYCs0BDwEFCADqDEkPAQUIAOoMMg8BBQgA6gxXDwEFCADqDM0BDwEFCAC4EEkP" +
+	"AQUIALgQMg8BBQgAtBBJDwEFCAC0EDIPAQUIAMwQSQ8BBQgAzBAyDwEFCAC5EEkP" +
+	"AQUIALkQMg8BBQgApBFJDwEFCACkETIPAQUIAOwSSQ8BBQgA7BIyDwEFCACkB0kP" +
+	"AQUIAKQHMg8BBQgApAdXDwEFCACkB80BDwEFCACECEkPAQUIAIQIMg8BBQgAhAhX" +
+	"DwEFCACECM0BDwEFCACADEkPAQUIAIAMMg8BBQgAgAxXDwEFCACADM0BDwEFCACx" +
+	"DEkPAQUIALEMMg8BBQgAsQxXDwEFCACxDM0BDwEGCADLAvoLSQ8BBggAywL6CzIP" +
+	"AQUIANcMSQ8BBQgA1wwyDwEFCADXDFcPAQUIANcMzQEPAQgIAKIDAlwmSQ8BCAgA" +
+	"ogMCXCYyDwEGCACiA4YJSQ8BBggAogOGCTIPAQYIAKIDhglXDwEGCACiA4YJzQEP" +
+	"AQYIAKID4gtJDwEGCACiA+ILMg8BBggAogPiC1cPAQYIAKID4gvNAQ8BBggAXK0I" +
+	"SQ8BBggAXK0IMg8BCQgAXK0IApECJkkPAQkIAFytCAKRAiYyDwEECAs7JgEECAs7" +
+	"OQEFCAs2iwEmAQUICzaLATkBBQgLQYsBJgEFCAtBiwE5AQcIC0GLAZsCtgEmAQcI" +
+	"C0GLAZsCtgE5AQUIC06LASYBBQgLTosBOQEGCAszU3c5AQYICzaLAc4JJgEGCAs2" +
+	"iwHOCTkBAwgLnwEBBQgLA+UDJgEFCAsD5QM5AQQIC8kPJhEFCADOBUkPAQUIAM4F" +
+	"Mg8BBQgAzgVXDwEFCADOBc0BDwEFCAAwSQ8BBQgAMDIPAQUIAMQDSQ8BBQgAxAMy" +
+	"DwEFCADmA0kPAQUIAOYDMg8BCAgAMAKRAiZJDwEFCACTBkkPAQUIAJMGMg8BBggA" +
+	"zwfEA0kPAQYIAM8HxAMyDwEGCADPB+YDSQ8BBggAzwfmAzIPAQUIADhJDwEFCAA4" +
+	"Mg8BBQgAOFcPAQUIADjNAQ8BCQgA+gynGc8HdsICVw8BCQgA+gynGc8HdsICzQEP" +
+	"AQoIIFwCkQImAnZJDwEKCCBcApECJgJ2Mg8BCgggXAKRAiYClQRJDwEKCCBcApEC" +
+	"JgKVBDIPAQoIIFwCkQImAtEDSQ8BCgggXAKRAiYC0QMyDwEKCCBcApECJgIwSQ8B" +
+	"CgggXAKRAiYCMDIPAQoIIFwCkQImAsQDSQ8BCgggXAKRAiYCxAMyDwEKCCBcApEC" +
+	"JgLmA0kPAQoIIFwCkQImAuYDMg8BCgggXAKRAiYCOEkPAQoIIFwCkQImAjgyDwEK" +
+	"CCBcApECJgI4Vw8BDQgg+gzPB1wCkQImAnbCAkkPAQ0IIPoMzwdcApECJgJ2wgIy" +
+	"DwENCCD6DM8HXAKRAiYCdsICVw8BBggA2AZcSQ8BBggA2AZcMg8BBggA2AZcVw8B" +
+	"BggA2AZczQEPAQoIIFwCkQImAsIBSQ8BCgggXAKRAiYCpQFJDwEKCCBcApECJgJY" +
+	"SQ8BCwggXAKRAiYCdsICSQ8BCgggXAKRAiYCXEkPAQcIILwDAsIBSQ8BBwggvAMC" +
+	"pQFJDwEHCCC8AwLGAkkPAQcIILwDAlhJDwEICCC8AwJ2wgJJDwEHCCC8AwJcSQ8B" +
+	"BwggkAMCwgFJDwEHCCCQAwKlAUkPAQcIIJADAsYCSQ8BBwggkAMCWEkPAQgIIJAD" +
+	"AnbCAkkPAQcIIJADAlxJDwEHCCCVBQLCAUkPAQcIIJUFAlhJDwEICCCVBQJ2wgJJ" +
+	"DwEHCCCVBQJcSQ8BBwggwgECpQFJDwEHCCDCAQJYSQ8BBwggpQECwgFJDwEHCCCl" +
+	"AQJYSQ8BBwggxgICwgFJDwEHCCDGAgKlAUkPAQcIIMYCAlhJDwEHCCCZAwLCAUkP" +
+	"AQcIIJkDAqUBSQ8BBwggmQMCxgJJDwEHCCCZAwJYSQ8BBwggkgQCpQFJDwEHCCCS" +
+	"BAJYSQ8BBwggnQQCwgFJDwEHCCCdBAKlAUkPAQcIIJ0EAsYCSQ8BBwggnQQCWEkP" +
+	"AQcIIOUDAqUBSQ8BBwgg5QMCWEkPAQcIIOwGAlhJDwEHCCC7AwLCAUkPAQcIILsD" +
+	"AlhJDwEHCCDuBALCAUkPAQcIIO4EAlhJDwEHCCDrAwLCAUkPAQcIIOsDAqUBSQ8B" +
+	"Bwgg6wMCxgJJDwEHCCDrAwJYSQ8BCAgg6wMCdsICSQ8BBwgg6wMCXEkPAQcIIIEE" +
+	"AqUBSQ8BBwgggQQCWEkPAQgIIIEEAnbCAkkPAQcIIIEEAlxJDwEHCCCdAwJ2SQ8B" +
+	"BwggnQMCwgFJDwEHCCCdAwKlAUkPAQcIIJ0DAsYCSQ8BBwggnQMCpgJJDwEHCCCd" +
+	"AwJYSQ8BCAggnQMCdsICSQ8BBwggnQMCXEkPAQcIIKYCAsIBSQ8BBwggpgICpQFJ" +
+	"DwEHCCCmAgLGAkkPAQcIIKYCAlhJDwEICCCmAgJ2wgJJDwEHCCCmAgJcSQ8BBwgg" +
+	"WALCAUkPAQcIIFgCpQFJDwEHCCBYAsYCSQ8BBwggWAJYSQ8BCAggWAJ2wgJJDwEH" +
+	"CCBYAlxJDwEHCCDLAgLCAUkPAQcIIMsCAqUBSQ8BBwggywICxgJJDwEHCCDLAgJY" +
+	"SQ8BCAggywICdsICSQ8BBwggywICXEkPAQcIIKIDAsIBSQ8BBwggogMCWEkPAQgI" +
+	"IKIDAnbCAkkPAQcIIKIDAlxJDwEHCCBcAsIBSQ8BBwggXAKlAUkPAQcIIFwCxgJJ" +
+	"DwEHCCBcAlhJDwEICCBcAnbCAkkPAQcIIFwCXEkPAQgIIMIJAtcEdkkPAQgIIO8D" +
+	"AtcEdkkPAQkIIHbCAgLXBHZJDwEHCCDkBgLeC0kPAQcIIOQGApIMSQ8BBwgg5AYC" +
+	"2QZJDwEHCCDkBgLFB0kPAQcIIOQGAt0GSQ8BCAgg5AYC1wR2SQ8BCgggXAKRAiYC" +
+	"7wMyDwEKCCBcApECJgKsBjIPAQoIIFwCkQImAlgyDwEKCCBcApECJgLLAjIPAQsI" +
+	"IFwCkQImAnbCAjIPAQoIIFwCkQImAlwyDwEHCCC8AwLvAzIPAQcIILwDAqwGMg8B" +
+	"BwggvAMCWDIPAQcIILwDAssCMg8BCAggvAMCdsICMg8BBwggvAMCXDIPAQcIIJAD" +
+	"Au8DMg8BBwggkAMCrAYyDwEHCCCQAwJYMg8BBwggkAMCywIyDwEICCCQAwJ2wgIy" +
+	"DwEHCCCQAwJcMg8BBwgglQUC7wMyDwEHCCCVBQKsBjIPAQcIIJUFAlgyDwEHCCCV" +
+	"BQLLAjIPAQgIIJUFAnbCAjIPAQcIIJUFAlwyDwEICCDrAwJ2wgIyDwEHCCDrAwJc" +
+	"Mg8BCAgggQQCdsICMg8BBwgggQQCXDIPAQcIIJ0DAnYyDwEHCCCdAwKmAjIPAQcI" +
+	"IJ0DAlgyDwEICCCdAwJ2wgIyDwEHCCCdAwJcMg8BBwggpgICWDIPAQgIIKYCAnbC" +
+	"AjIPAQcIIKYCAlwyDwEHCCBYAnYyDwEHCCBYAlgyDwEHCCDLAgLvAzIPAQcIIMsC" +
+	"AqwGMg8BBwggywICWDIPAQcIIMsCAssCMg8BCAggywICdsICMg8BBwggywICXDIP" +
+	"AQkIIHbCAgLXBHYyDwEHCCBcAu8DMg8BBwggXAKsBjIPAQcIIFwCWDIPAQcIIFwC" +
+	"ywIyDwEICCBcAnbCAjIPAQcIIFwCXDIPAQoIIFwCkQImAsIBVw8BCgggXAKRAiYC" +
+	"pQFXDwEKCCBcApECJgLGAlcPAQoIIFwCkQImAlhXDwEKCCBcApECJgKiA1cPAQcI" +
+	"ILwDAsIBVw8BBwggvAMCpQFXDwEHCCC8AwLGAlcPAQcIILwDAlhXDwEHCCC8AwKi" +
+	"A1cPAQcIIJADAsIBVw8BBwggkAMCpQFXDwEHCCCQAwLGAlcPAQcIIJADAlhXDwEH" +
+	"CCCQAwKiA1cPAQcIIJUFAlhXDwEHCCDCAQKlAVcPAQcIIMIBAlhXDwEHCCClAQLC" +
+	"AVcPAQcIIKUBAlhXDwEHCCDGAgLCAVcPAQcIIMYCAlhXDwEHCCCZAwLCAVcPAQcI" +
+	"IJkDAqUBVw8BBwggmQMCxgJXDwEHCCCZAwJYVw8BBwggkgQCpQFXDwEHCCCSBALG" +
+	"AlcPAQcIIJIEAlhXDwEHCCCdBALCAVcPAQcIIJ0EAqUBVw8BBwggnQQCxgJXDwEH" +
+	"CCCdBAJYVw8BBwgg5QMCpQFXDwEHCCDsBgJYVw8BBwgguwMCwgFXDwEHCCC7AwJY" +
+	"Vw8BBwgg7gQCwgFXDwEHCCDuBAJYVw8BBwgg6wMCwgFXDwEHCCDrAwKlAVcPAQcI" +
+	"IOsDAsYCVw8BBwgg6wMCWFcPAQcIIIEEAqUBVw8BBwgggQQCWFcPAQcIIJ0DAsIB" +
+	"Vw8BBwggnQMCpQFXDwEHCCCdAwLGAlcPAQcIIJ0DAqYCVw8BBwggnQMCWFcPAQcI" +
+	"IKYCAsIBVw8BBwggpgICpQFXDwEHCCCmAgLGAlcPAQcIIKYCAlhXDwEHCCCmAgKi" +
+	"A1cPAQcIIFgCwgFXDwEHCCBYAqUBVw8BBwggWALGAlcPAQcIIFgCWFcPAQcIIMsC" +
+	"AsIBVw8BBwggywICpQFXDwEHCCDLAgLGAlcPAQcIIMsCAlhXDwEHCCDLAgKiA1cP" +
+	"AQcIIKIDAsIBVw8BBwggogMCWFcPAQgIIKIDAtcEdlcPAQcIIFwCwgFXDwEHCCBc" +
+	"AqUBVw8BBwggXALGAlcPAQcIIFwCWFcPAQcIIFwCogNXDwEKCCBcApECJgJYzQEP" +
+	"AQoIIFwCkQImAqIDzQEPAQcIILwDAljNAQ8BBwggvAMCogPNAQ8BBwggkAMCWM0B" +
+	"DwEHCCCQAwKiA80BDwEHCCCVBQJYzQEPAQcIIJUFAqIDzQEPAQcIIJkDAljNAQ8B" +
+	"BwggmQMCogPNAQ8BBwggxQMCWM0BDwEHCCDFAwKiA80BDwEHCCCdAwKmAs0BDwEH" +
+	"CCCdAwJYzQEPAQcIIKYCAljNAQ8BBwggywICWM0BDwEHCCDLAgKiA80BDwEHCCBc" +
+	"AljNAQ8BBwggXAKiA80BDwEHCCDkBgLZBs0BDwEHCCDkBgLFB80BDwEHCCDkBgLd" +
+	"Bs0BDwEICCDlAwJ2wgJJDwEHCCDlAwJcSQ8BCAgguwMCdsICSQ8BBwgguwMCXEkP" +
+	"AQgIIO4EAnbCAkkPAQcIIO4EAlxJDwEICCCZAwJ2wgJJDwEHCCCZAwJcSQ8BCAgg" +
+	"xQMCdsICSQ8BBwggxQMCXEkPAQgIIKUBAnbCAkkPAQcIIKUBAlxJDwEICCDCAQJ2" +
+	"wgJJDwEHCCDCAQJcSQ8BCAggxgICdsICSQ8BBwggxgICXEkPAQgIIJIEAnbCAkkP" +
+	"AQcIIJIEAlxJDwEICCCdBAJ2wgJJDwEHCCCdBAJcSQ8BBwggxQMCwgFJDwEHCCDF" +
+	"AwKlAUkPAQcIIMUDAsYCSQ8BBwggxQMCWEkPAQcIIMUDAu8DSQ8BBwggmQMC7wNJ" +
+	"DwEHCCCSBALvA0kPAQcIIJ0EAu8DSQ8BCAgg5QMCdsICMg8BBwgg5QMCXDIPAQgI" +
+	"ILsDAnbCAjIPAQcIILsDAlwyDwEICCDuBAJ2wgIyDwEHCCDuBAJcMg8BCAggmQMC" +
+	"dsICMg8BBwggmQMCXDIPAQgIIMUDAnbCAjIPAQcIIMUDAlwyDwEICCClAQJ2wgIy" +
+	"DwEHCCClAQJcMg8BCAggwgECdsICMg8BBwggwgECXDIPAQgIIMYCAnbCAjIPAQcI" +
+	"IMYCAlwyDwEICCCSBAJ2wgIyDwEHCCCSBAJcMg8BCAggnQQCdsICMg8BBwggnQQC" +
+	"XDIPAQcIIMUDAsIBMg8BBwggxQMCpQEyDwEHCCDFAwLGAjIPAQcIIMUDAlgyDwEH" +
+	"CCDFAwLvAzIPAQcIIJkDAu8DMg8BBwggkgQC7wMyDwEHCCCdBALvAzIPAQcIIMUD" +
+	"AsIBVw8BBwggxQMCpQFXDwEHCCDFAwLGAlcPAQcIIMUDAlhXDwEHCCCZAwKiA1cP" +
+	"AQcIIMUDAqIDVw8BBwgg5QMCWFcPAQcIIJkDAsIBzQEPAQcIIJkDAqUBzQEPAQcI" +
+	"IJkDAsYCzQEPAQcIIMUDAsIBzQEPAQcIIMUDAqUBzQEPAQcIIMUDAsYCzQEPAQcI" +
+	"IOUDAljNAQ8BBwgg7AYCWM0BDwEHCCB2AuYJMg8BBwggdgLmCUkPAQPeGye3AwED" +
+	"3hsltwMBBAggwFPUFQEFCCCRC8QLvCYBBQggkQvEC70mAQUIIJELxAu/JgEFCCCR" +
+	"C8QLwCYBBQggkQvEC8EmAQUIIOtVjQ3lYgEECCCNDZYNAQQIIJUmlg0BBAggliaW" +
+	"DQEFCCCNDYMn92IBBAgg8FL9WQEGCCDsVY0N6GLmYgEECCCUJpYNAQQIIOxc52IB" +
+	"BAggwVPUFQEJCCCQAwLCAQJYVw8BCQggkAMCpQECwgEyDwEJCCCQAwKlAQLCAVcP" +
+	"AQkIIJADAqUBAlhXDwEJCCCQAwLGAgJYVw8BCQggkAMCWALCAVcPAQkIIJADAlgC" +
+	"pQFXDwEJCCCQAwJYAsYCVw8BCQggwgECWAKlATIPAQkIIMIBAlgCpQFXDwEJCCCl" +
+	"AQJYAlwyDwEKCCClAQJYAnbCAjIPAQkIIJkDAqUBAsIBVw8BCQggmQMCwgECpQFX" +
+	"DwEKCCCZAwLCAQJ2wgIyDwEJCCCZAwJYAqUBMg8BCQggmQMCWAKlAVcPAQkIIJkD" +
+	"AlgCwgFXDwEJCCCZAwJYAlgyDwEJCCCZAwJYAlhXDwEJCCCSBAKlAQKlATIPAQkI" +
+	"IJIEAqUBAqUBVw8BCQggkgQCWAJYMg8BCQggxQMCpQECWDIPAQkIIMUDAqUBAlhX" +
+	"DwEJCCDFAwLCAQJcMg8BCQggxQMCWALGAjIPAQkIIMUDAlgCxgJXDwEJCCDFAwJY" +
+	"AlgyDwEJCCDFAwJYAlhXDwEKCCCdBAKlAQJ2wgIyDwEJCCCdBALGAgJYMg8BCQgg" +
+	"nQQCxgICWFcPAQkIIOUDAlgCpQEyDwEJCCDlAwJYAqUBVw8BCQgg5QMCWAJYVw8B" +
+	"CQgg5QMCWAJcMg8BCQgguwMCwgECWDIPAQkIILsDAlgCWDIPAQkIILsDAlgCWFcP" +
+	"AQoIILsDAlgCdsICMg8BCQgg7gQCWAJYMg8BCQgg7gQCWAJcMg8BCggg7gQCWAJ2" +
+	"wgIyDwEJCCDrAwLGAgJYMg8BCQgg6wMCxgICWFcPAQkIIIEEAlgCpQEyDwEJCCCB" +
+	"BAJYAlgyDwEJCCCmAgKlAQJYMg8BCQggpgICpQECXDIPAQoIIKYCAqUBAnbCAjIP" +
+	"AQkIIKYCAsIBAsIBVw8BCQggpgICwgECwgEyDwEJCCCmAgLGAgJYMg8BCQggpgIC" +
+	"xgICWFcPAQkIIKYCAlgCpQEyDwEJCCCmAgJYAqUBVw8BCQggWAKlAQLCAVcPAQkI" +
+	"IFgCpQECWFcPAQkIIFgCpQECXDIPAQkIIFgCwgECpQFXDwEJCCBYAsIBAlhXDwEJ" +
+	"CCBYAsYCAsIBVw8BCQggWALGAgJYVw8DCQggWALCAQLGAlcPAQkIIKIDAlgCwgFX" +
+	"DwEJCCCiAwJYAlhXDwEJCCDLAgKlAQJYVw8BCgggywICpQECdsICMg8BCQggywIC" +
+	"wgECWDIPAQkIIMsCAsIBAlhXDwEKCCDLAgLCAQJ2wgIyDwEJCCDLAgJYAlwyDwEK" +
+	"CCDLAgJYAnbCAjIPAQkIIFwCWAJYMg8BCQggXAJYAlhXDwEJCCC8AwLGAgJcMg8B" +
+	"CQggkAMCwgECXDIPAQoIIJADAsIBAnbCAjIPAQkIIJADAsYCAlwyDwEKCCCQAwLG" +
+	"AgJ2wgIyDwEJCCCQAwJYAlwyDwEKCCCQAwJYAnbCAjIPAQkIIMIBAlgCXDIPAQoI" +
+	"IMIBAqUBAnbCAjIPAQoIIMIBAlgCdsICMg8BCgggmQMCxgICdsICMg8BCQggkgQC" +
+	"pQECXDIPAQkIIMUDAqUBAlwyDwEJCCCdBAKlAQJcMg8BCQggpgICwgECXDIPAQkI" +
+	"IKYCAlgCXDIPAQkIIFwCpQECXDIPAQkIIFwCwgECXDIPAQkIIFwCWAJcMg8BCQgg" +
+	"WAJYAlwyDwEJCCCBBAJYAlwyDwEJCCDLAgKlAQJcMg8BCQgggQQCWAKlAVcPAQkI" +
+	"IKYCAqUBAlhXDwEJCCC7AwJYAlwyDwEJCCCdAwJYAlwyDwEJCCDLAgLCAQKlAVcP" +
+	"AQkIIFgCxgICXDIPAQkIIKYCAsIBAlhXDwEJCCCdAwJYAlgyDwEJCCCmAgLCAQJY" +
+	"Mg8BCQggywICwgECpQEyDwEJCCDCAQKlAQJcMg8BCQggpQECwgECXDIPAQkIIFgC" +
+	"wgECXDIPAQkIIOsDAlgCXDIPAQkIILwDAqUBAlwyDwEJCCCdAwJYAlhXDwEJCCC7" +
+	"AwLCAQJYVw8BCQggkgQCWAJYVw8BCQggmQMCxgICXDIPAQkIIMsCAsIBAlwyDwgE" +
+	"CCDoVZcmIQoIIIEdih/RBsQZqQIBSQ8BCgggx1KKH9EGxBmpAgFJDwEFCCCeJkkP" +
+	"AQUIIIwmSQ8BBQgghUpJDwEFCCDqVUkPAQUIIM5TSQ8BBQggwgtJDwEFCCCfH0kP" +
+	"AQUIIIEdSQ8BBQgggh3CC58fAQMIIPlBAQKWVAEBBQgggCrhJuAmAQUIINFbrwLm" +
+	"XAEFCCC6J68C+EEBAkOoVgECQ5dXAQJD21cBAkPmVwECQ/FXAQJD/FcBAkOHWAEC" +
+	"Q5JYAQJDnVgBAkOpVgECQ7RWAQJDv1YBAkPKVgECQ9VWAQJD4FYBAkPrVgEFlwQP" +
+	"KFPnAgEGlwQPKFOeAecCAQeXBA8oU54BkgOpAgEFlwQPKFObBAEFlwQPKFOyBwEG" +
+	"lwQPKFPsBCoBBpcEDyhTywQqAQiXBA8oUyc/kgmBAQEIlwQPKFMlP5IJpyoBBpcE" +
+	"DyhTrAH/CAcEHyAnbgEEHyAlbgEFHzO5ASduAQUfM7kBJW4BBB/JAiduAQQfyQIl" +
+	"bgEDH+oWyQIBBR8gJ245AQUfICVuOQEFH7kBJ245AQUfuQElbjkBBR/JAiduOQEF" +
+	"H8kCJW45AQQf6hbJAjkBBR8p8AwnbgEFHynwDCVuAQeXBA8oUzY7xhEBBpcEDyhT" +
+	"6AXSAwEGlwQPKFP8AtIDAQaXBA8oU4cBvwIBB5cEDyhT5geHAb8CAQaXBA8oUye3" +
+	"AwEGlwQPKFMltwMBB5cEDyhTJ/wDgQEBB5cEDyhTJfwDgQEBCJcEDyhTJ/sEpASB" +
+	"AQEIlwQPKFMl+wSkBIEBAQiXBA8oUyc3kgmBAQEIlwQPKFMlN5IJgQEBCJcEDyhT" +
+	"JzPcAoEBAQiXBA8oUyUz3AKBAQEHlwQPKFMn3AKBAQEHlwQPKFMl3AKBAQEHlwQP" +
+	"KFMn9QOBAQEHlwQPKFMl9QOBAQEIlwQPKFMnP/UDgQEBCJcEDyhTJT/1A4EBAQKW" +
+	"HTsBAz+WHTsBB5cEDyhTJyuBAQEHlwQPKFMlK4EBAQLzBqgJAQLVFqgJAQLmB6gJ" +
+	"AQMz5geoCQED8waHAb8CAQPVFocBvwIBA+YHhwG/AgECA+cCAQMDngHnAgEDA5ID" +
+	"qQICAgOyBwECA5sEAQMDywQqAQMD7AQqAQMD6AXSAwEDAye3AwEDAyW3AwEEAyf8" +
+	"A4EBAQQDJfwDgQEBBQMn+wSkBIEBAQUDJfsEpASBAQEDAx0BAQID7QgBAgO1AwED" +
+	"A2ABAQID+g0BAwPtAgEBAwPsAgEBAwP9AwECAwO/BZQFAQMD4AkBAQMDxgwBAQMD" +
+	"2QvZBAUECOYJSQ8BBQioCwLmCSYBBAjeC0kPAQMI6QK2OwEECJIMSQ8CBAjZBkkP" +
+	"AQQI2QbNAQ8BBAjFB0kPAQQIxQfNAQ8BBAjdBkkPAQQI3QbNAQ8BBAjkBkkPAQQI" +
+	"5AbNAQ8BBAi9CUkPAQQIvQnNAQ8BBQgAkQJJDwEICAB2AtQHJkkPAQgIAHYC1Acm" +
+	"Mg8BCAgAdgKRAiZJDwEICAB2ApECJjIPAQgIANEDApECJkkPAQgIANEDApECJjIP" +
+	"AQgIAHYCkQI5SQ8BCAgAdgKRAjkyDwEICABcApECJkkPAQgIAFwCkQImMg8BCAgA" +
+	"XAKRAiZXDwEICABcApECJs0BDwEFCAB2SQ8BBQgAdjIPAQUIALwDSQ8BBQgAvAMy" +
+	"DwEFCAC8A1cPAQUIALwDzQEPAQYIAJADrQ5JDwEGCACQA60OMg8BBQgAkANJDwEF" +
+	"CACQAzIPAQUIAJADVw8BBQgAkAPNAQ8BBQgAlQVJDwEFCACVBTIPAQUIAJUFVw8B" +
+	"BQgAlQXNAQ8BBQgAwgFJDwEFCADCATIPAQUIAMIBVw8BBQgAwgHNAQ8BBQgApQFJ" +
+	"DwEFCAClATIPAQUIAKUBVw8BBQgApQHNAQ8BBQgAxgJJDwEFCADGAjIPAQUIAMYC" +
+	"Vw8BBQgAxgLNAQ8BBQgA2QVJDwEFCADZBTIPAQUIAMIJSQ8BBQgAwgkyDwEFCADv" +
+	"A0kPAQUIAO8DMg8BBQgArAZJDwEFCACsBjIPAQUIAJkDSQ8BBQgAmQMyDwEFCACZ" +
+	"A1cPAQUIAJkDzQEPAQUIAMUDSQ8BBQgAxQMyDwEFCADFA1cPAQUIAMUDzQEPAQUI" +
+	"AJIESQ8BBQgAkgQyDwEFCACSBFcPAQUIAJIEzQEPAQUIAJ0ESQ8BBQgAnQQyDwEF" +
+	"CACdBFcPAQUIAJ0EzQEPAQUIAOUDSQ8BBQgA5QMyDwEFCADlA1cPAQUIAOUDzQEP" +
+	"AQUIAOwGSQ8BBQgA7AYyDwEFCADsBlcPAQUIAOwGzQEPAQUIALsDSQ8BBQgAuwMy" +
+	"DwEFCAC7A1cPAQUIALsDzQEPAQUIAO4ESQ8BBQgA7gQyDwEFCADuBFcPAQUIAO4E" +
+	"zQEPAQUIAOsDSQ8BBQgA6wMyDwEFCADrA1cPAQUIAOsDzQEPAQUIAIEESQ8BBQgA" +
+	"gQQyDwEFCACBBFcPAQUIAIEEzQEPAQUIAJ0DSQ8BBQgAnQMyDwEFCACdA1cPAQUI" +
+	"AJ0DzQEPAQUIAKYCSQ8BBQgApgIyDwEFCACmAlcPAQUIAKYCzQEPAQUIAFhJDwEF" +
+	"CABYMg8BBQgAWFcPAQUIAFjNAQ8BBQgAywJJDwEFCADLAjIPAQUIAMsCVw8BBQgA" +
+	"ywLNAQ8BBQgAogNJDwEFCACiAzIPAQUIAKIDVw8BBQgAogPNAQ8BBQgA0QNJDwEF" +
+	"CADRAzIPAQYIAHbCAkkPAQYIAHbCAjIPAQUIAFxJDwEFCABcMg8BBQgAXFcPAQUI" +
+	"AFzNAQ8BCgggpgICdgLUByZJDwEKCCCmAgJ2AtQHJjIPAQoIIKYCAnYCkQImSQ8B" +
+	"CgggpgICdgKRAiYyDwEKCCCmAgJ2ApECOUkPAQoIIKYCAnYCkQI5Mg8BBwggpgIC" +
+	"dkkPAQcIIKYCAnYyDwME4wHnB5QSsQUCA8AB7AQqAQPAAbwEKgEDwAEdAQEDwAHg" +
+	"CQEBA8ABxgwBAQLAAe0IAQLAAaoIAQPAASe3AwEDwAEltwMBAsABtQMBA8ABYAEB" +
+	"AsAB5wIBAsAB+g0BA8ABkgOpAgECwAGUBQEDwAEO4wEBA8ABDjEBA8ABDjYBA8AB" +
+	"DkEBA8ABDk4BA8ABDlUBA8ABDnUBA8ABDoABAQPAAQ50AQPAAQ6IAQECwAGbBAEC" +
+	"wAGyBwEDwAHtAgEBA8AB/QMBAQPAAewCAQEDwAHLBCoBA8AB2QvZBAEFwAEHBQAU" +
+	"AQXAAQcFAD4BBcABBwUA8wEBBcABBwUA0gEBBcABBwUAOAEFwAEHBQCzAgEFwAEH" +
+	"BQDBAQEFwAEHBQDUAQEFwAEHBQA6AQXAAQcFAJMCAQXAAQcFAMcBAQXAAQcFAG8B" +
+	"BcABBwUAsAEBBcABBwUAmQEBBcABBwUANQEFwAEHBQD6AQEFwAEHBQDuAgEFwAEH" +
+	"BQBiAQXAAQcFAGMBBcABBwUAxQEBBcABBwUAMAEFwAEHBQDLAQEFwAEHBQCOAgEF" +
+	"wAEHBQD8AQEFwAEHBQDiAQEFwAEHBQDeAQEEwAEnK4EBAQPAAb8FlAUBBMABJSuB" +
+	"AQEDwAGLAsQCAQPAAYcBvwIBA8ABvgLEAgEFwAEHAwAUAQXAAQcDAD4BBcABBwMA" +
+	"8wEBBcABBwMA0gEBBcABBwMAOAEFwAEHAwCzAgEFwAEHAwDBAQEFwAEHAwDUAQEF" +
+	"wAEHAwA6AQXAAQcDAJMCAQXAAQcDAMcBAQXAAQcDAG8BBcABBwMAsAEBBcABBwMA" +
+	"mQEBBcABBwMANQEFwAEHAwD6AQEFwAEHAwDuAgEFwAEHAwBiAQXAAQcDAGMBBcAB" +
+	"BwMAxQEBBcABBwMAMAEFwAEHAwDLAQEFwAEHAwCOAgEFwAEHAwD8AQEFwAEHAwDi" +
+	"AQEFwAEHAwDeAQEEwAEn/AOBAQEDwAFTvwIBBMABJfwDgQEBAsABuQEBBMABJz+3" +
+	"AwEEwAElP7cDAQSaAZ4BkgOpAgEEmgEn9QOBAQEEmgEl9QOBAQEDmgGeAecCAQSa" +
+	"AUdMOwEEmgFHAJYFAQWaAUcAAxQBBZoBRwADOgEFmgFHAAMwAQWaAUcAAzgBBZoB" +
+	"RwADNQEFmgFHAAOzAQEFmgFHAAPmAwEFmgFHAAOmBAEFmgFHAAOIBQEFmgH0Cawc" +
+	"jwYqAQSaAUcAFAEEmgFHADoBBJoBRwAwAQSaAUcAOAEEmgFHADUBBJoBRwBaAQSa" +
+	"AUcAkAUBBJoBRwCqBQEEmgFHALwFAQSaAUcAgAUBBJoBRwC4AQEEmgFHAJ0FAQSa" +
+	"AUcAsgUBBJoBRwCwBQEEmgFHAPgEAQSaAUcAtAEBBJoBRwDiBQEEmgFHAIgFAQSa" +
+	"AUcA+gMBBJoBRwBCAQSaAUcArQEBBJoBRwCJBAEEmgFHAKIEAQSaAUcAiAQBBJoB" +
+	"RwDgAwEEmgFHAIIBAQSaAUcA9gMBBJoBRwDbBgEEmgFHANQDAQSaAUcA3wQBBJoB" +
+	"RwCoAQEEmgFHALkEAQSaAUcA3gMBBJoBRwCQBAEEmgFHAK4FAQSaAUcAswEBBJoB" +
+	"RwDmAwEEmgFHAKYEAQSaAUcAnAEBBJoBRwCgBgEEmgFHAJwFAQSaAUcA9gUBBJoB" +
+	"RwD0BAEEmgFHAK8CAQSaAUcAmQEBBZoBR6cIjwYqAQWaAUf4Eo8GKgEDmgEcvwgB" +
+	"BJoBHACRCQEEmgEcAKMPAQSaARwAthEBBJoBHAChCQEEmgEcAMIOAQSaARwAww4B" +
+	"BJoBHADHCQEEmgEcAKYPAQSaARwAsAkBBJoBHAD5DgEEmgEcAPoOAQSaARwA+w4B" +
+	"BJoBHAD8DgEEmgEcAOQSAQSaARwA4xIBBJoBHAD4DgEEmgEcAJwJAQSaARwArQkB" +
+	"BJoBHACkDwEEmgEcAN8OAQSaARwAtwkBBJoBHAClDwEEmgEcAMgIAQSaARwAtAgB" +
+	"BJoBHACiDwEEmgEcAPUIAQSaARwAkAkBBJoBHADDCQEEmgEcAKsJAQSaARwAigkE" +
+	"BJoBHAAUAQSaARwAlQQBBJoBHACzAQEEmgEcAIINAQSaARwA6QsBBJoBHAA4AwSa" +
+	"ARwAhRQBBJoBHACrBgEEmgEcADUBBJoBHACvAgEEmgEcAPETAQSaARwAxAMDBJoB" +
+	"HACmBAEEmgEcADABBJoBHAD1EwEEmgEcAP0EAQSaARwAtAUBBJoBHADmAwMEmgEc" +
+	"AL4IAQSaARwACQEEmgEcADoEA8AB1BYBAQPAAeYOAQEDwAHSAgEBAsAByQIBA8AB" +
+	"uAp3AQPAAYUNAQEDwAH5EwECBJoBrjt6UwEDmgFtGwEDmgG+ARsBA5oBWxsBA5oB" +
+	"tgEbAQOaATcrAQOaAT94CwOaEdQF2RUBA5oR1AX1BAEDmhHUBa9dAQO4T9gc2gEB" +
+	"Atgc2gEDBR4+BIIWFAEFHj4E1yc4AQUePgTOJzoBBR4+BO4nNQEFHj4EvCcwAQUe" +
+	"PgT7Fb8BAQUePgTeJ6UFAQUePgSBFtoFAQUePgTAJ8cFAQUePgTkJ9sFAQUePgTq" +
+	"J6UCAQUePgTfJ6MHAgUePgTVJ80HAQUePgTyJ/MJAQUePgT+J1oBBR4+BN0nvAUB" +
+	"BR4+BPQnkAUBBR4+BPcngAUBBR4+BIIoqgUBBR4+BIEoqAEBBR4+BL8nkAQBBR4+" +
+	"BPonuQQBBR4+BMEnrgUBBR4+BMkn3gMBBR4+BIAWrQEBBR4+BMoniAQBBR4+BNAn" +
+	"iQQBBR4+BOUn4AMBBR4+BOgnogQBBR4+BP0VlwEBBR4+BPknsQMBBR4+BNgnkwMB" +
+	"BR4+BL0n9QUBBR4+BOMnhgcBBR4+BMInzwUBBR4+BP8n6w4BBR4+BMcn0AwBBR4+" +
+	"BNIn7A4CBR4+BO0nnAEBBR4+BM0n9gUBBR4+BOYnoAYBBR4+BPwV9AQBBR4+BMwn" +
+	"nAUBBR4+BNEnuAEBBR4+BIMWsAUBBR4+BNonnQUBBR4+BL4n+AQBBR4+BOsnsgUB" +
+	"BR4+BOwntAEBBR4+BP4V+gMBBR4+BNYn4gUBBR4+BP8VQgEFHj4E9ieIBQEFHj4E" +
+	"5yevAgEFHj4E/Cf9BAEFHj4E2Se0BQEFHj4E2yeWBQIFHj4EwyefAwEFHj4E+ye1" +
+	"BQIFHj4Exie/BwEFHj4EyyfeDwEFHj4E3CeXIgEFHj4EhSiiAgEFHj4E+CfOEAEF" +
+	"Hj4EiSjcOAEFHj4E4SfJDgEFHj4EzyeXUgEFHj4E7yeWUgEFHj4E/Se4UwEFHj4E" +
+	"0ye5UwEFHj4E9SfBVAEFHj4E8yflXAEFHj4Ehyj4DAEFHj4Eiig2AwQePgvEJwEE" +
+	"Hj4LxScBBB4+C8gnAQQePgvUJwEEHj4L4CcBBB4+C+InAQQePgvpJwEEHj4L8CcB" +
+	"BB4+C/EnAQQePguAKAEEHj4LgygBBB4+C4QoAQQePguGKAEEHj4LiCgjBR4+oQGL" +
+	"KK0FAQUePqEBjCjQCQEFHj6hAY0ovRABBR4+oQGOKNU5AQUePqEBjyjBSAEFHj6h" +
+	"AZAojVsBBR4+oQGRKPQXAQUePqEBkijyDgEFHj6hAZMok1kBBR4+oQGUKKA+AQUe" +
+	"PqEBlSi7WgEFHj6hAZYorRYBBR4+oQGXKNoLAQUePqEBmCi+KgEFHj6hAZko9hMB" +
+	"BR4+oQGaKKUpAQUePqEBmyjTGwEFHj6hAZwozVoBBR4+oQGdKI80AQUePs8Inij1" +
+	"QgEFHj7PCJ8o8UIBBR4+oQGgKM4OAQUePqEBoSjMDwEEHj6hAaIoAQUePs8Ioyjr" +
+	"JgEFHj7PCKQogkkBBR4+oQGlKLMqAQUePqEBpijqDQEEHj6hAacoAQUePqEBqCiu" +
+	"HwEEHj6hAakoAQQePqEBqigBBR4+oQGrKI8EAQQePqEBrCgBBB4+oQGtKAEEHj6h" +
+	"Aa4oAQUePs8IsCitXwEEHj6hAbEoAQQePqEBsigBBR4+oQGzKJkQAQQePqEBtCgB" +
+	"BB4+oQG1KAEFHj6hAbYooDwBBR4+oQG3KPImAQQePqEBuCgBBB4+oQG5KAEEHj6h" +
+	"AbooAQQePqEBuygBBB4+oQG8KAEEHj6hAb0oAQQePqEBvigBBB4+oQG/KAEEHj6h" +
+	"AcAoAQUePqEBwSjxEQEEHj6hAcIoAQUePqEBwyijCAEEHj6hAcQoAQQePqEBxSgB" +
+	"BB4+oQHGKAEEHj6hAccoAQQePqEByCgBBB4+oQHJKAEEHj6hAcooAQQePqEByygB" +
+	"BB4+oQHMKAEEHj6hAc0oAQQePqEBzigBBR4+oQHPKPUNAQUePqEB5CioOwEFHj6h" +
+	"AecoiBYBBR4+oQHsKNcdAQUePqEB7SgbAQQePqEB7igBBR4+oQHvKPlbAQQePqEB" +
+	"8CgBBB4+oQHxKAEGHj6hAfIokGPTCwEFHj6hAfMo0wsBBh4+oQH0KNML4w0BBR4+" +
+	"oQH1KMsPAQQePqEB9igBBB4+oQH3KAEFHj7PCPgohjYBBB4+oQH5KAEEHj6hAfoo" +
+	"AQQePqEB/CgBBB4+oQH9KAEEHj6hAf4oAQUePqEB/yiKNQEEHj6hAYApAQQePqEB" +
+	"gSkBBB4+oQGCKQEEHj6hAYMpAQQePqEBhCkBBR4+oQHOBK8oAQUePqEBzgTQKAEF" +
+	"Hj6hAc4E0SgBBR4+oQHOBNIoAQUePqEBzgTTKAEFHj6hAc4E1CgBBR4+oQHOBNUo" +
+	"AQUePqEBzgTWKAEFHj6hAc4E1ygBBR4+oQHOBNgoAQUePqEBzgTZKAEFHj6hAc4E" +
+	"2igBBR4+oQHOBNsoAQUePqEBzgTcKAEFHj6hAc4E3SgBBR4+oQHOBN4oAQUePqEB" +
+	"zgTfKAEFHj6hAc4E4CgBBR4+oQHOBOEoAQUePqEBzgTiKAEFHj6hAc4E4ygBBR4+" +
+	"oQHOBOUoAQUePqEBzgTmKAEFHj6hAc4E6CgBBR4+oQHOBOkoAQUePqEBzgTqKAEF" +
+	"Hj6hAc4E6ygBBR4+oQHOBPsoAQUePqEBzgSFKQYE9QKhBfUEvwIBBPUCoQX1BDsB" +
+	"A/UC1QYqBQP1Ah0xAQP1Ah02AQP1Ah1BAQP1Ah1OAQP1Ah1VAQP1Ah11AQP1Ah2A" +
+	"AQED9QIddAED9QIdiAEBA/UCHYYCAQP1Ah2nAwED9QIdvQQBA/UCHdwEAQP1Ah2F" +
+	"BAED9QIdjgYBA/UCHZMEAQP1Ah2zBgED9QId7QMBBPUCHTGGAQEE9QIdNoYBAQT1" +
+	"Ah1BhgEBBPUCHU6GAQEE9QIdVYYBAQT1Ah11hgEBBPUCHYABhgEBBPUCHXSGAQEE" +
+	"9QIdiAGGAQEE9QIdMasBAQT1Ah02qwEBBPUCHUGrAQEE9QIdTqsBAQT1Ah1VqwEB" +
+	"BPUCHXWrAQEE9QIdgAGrAQEE9QIddKsBAQT1Ah2IAasBAQT1Ah2GAqsBAQT1Ah2n" +
+	"A6sBAQT1Ah29BKsBAQT1Ah3cBKsBAQT1Ah2FBKsBAQT1Ah2OBqsBAQT1Ah2TBKsB" +
+	"AQT1Ah2zBqsBAQT1Ah3tA6sBBAT1ArkLnA23BwEE9QK5C8AIvAkBBPUCuQvaCLwJ" +
+	"AQT1ArkLjQe8CQEE9QK5C+INvAkBBfUCyxD6CcAIvAkBBfUC+Bn6CcAIvAkBBPUC" +
+	"+gnaCLwJAQT1AvoJjQe8CQEFIYoDigUx7gMBBSGKA4oFMW4BBSGKA4oFMcoQAQQh" +
+	"igOKBVUBBCGKA4oFhQQBBSGKA4oFVYYBAQUhigOKBVWrAQEFIYoDigWFBKsBAQUh" +
+	"igOKBVWUCgEFIYoDigWGApQKAQUhigOKBYUElAoBBiGKA4oFMYYBlAoBBiGKA4oF" +
+	"VYYBlAoBBiGKA4oFMasBlAoBBiGKA4oFVasBlAoBBSGKA4oFVbkJAQUhigOKBYYC" +
+	"uQkBBSGKA4oFhQS5CQEGIYoDigUxhgG5CQEGIYoDigVVhgG5CQEGIYoDigUxqwG5" +
+	"CQEGIYoDigWGAqsBuQkBBiGKA4oFhQSrAbkJAQUhigOKBYYC7xEBBSGKA7M+McVR" +
+	"AQQhigOeCDEBBCGKA4cRMQEEIYoD1RA2AQQhigOeCDYBBSGKA5E0NrsXAQUhigPV" +
+	"EDa7FwEEIYoDrgtVAQQhigOuC4YCAQYhigOuC4YC2AMPAQQhigOHEYYCAQQhigOG" +
+	"SYYCAQQhigOeCIYCAQQhigOeCL0EAQQhigOuC4UEAQYhigOuC4UE2AMPAQQhigOH" +
+	"EYUEAQQhigOeCIUEAQUhigOeCDGGAQEFIYoDnghBhgEBBSGKA9UQVYYBAQUhigOu" +
+	"C1WGAQEFIYoDnghVhgEBBSGKA58rVYYBAQUhigOjS1WGAQEFIYoDnggxqwEBBSGK" +
+	"A54IVasBAQUhigPDNVXvEQEFIYoDqluFBO8RAQQhMW4BAQYhMW4B2AMPAQQhNuEI" +
+	"AQEEIUHCBgEBAyHCHwEBAyH3XAEBAyHKEAEBAyG8TwEBBCE2zA4BAQQhQcwOAQEE" +
+	"IU7MDgEBBCFVzA4BAQMhi0kBAQQh3UScDQEBAyGTRgEBAyHzTwEBAyHeYwEBAyH+" +
+	"JgEBAyH2JgEBAyGqPQEBBCH4XpwNAQEDIeMBAQEEITHuAwEBAyHzWQEBAyHJQQEB" +
+	"AqtNAQIDlAPAWAEBA5QDsmABAQOUA6xYAQEDlAOXHQEBBJQD/TWXHQEBA5QD7VkB" +
+	"AQOUA8U1AQEDlAP0UgEBA5QDt1gBAQOUA8s4AQEDlAPRBgEBA5QDxCsBAQKHJwsE" +
+	"BCEL+wXVBDAEuAOhAwH5GwEFuAOhAwHLUdIEAQW4A6EDAYhd0gQBBLgDoQMBlCsB" +
+	"BLgDoQMBkxABBLgDoQMB0AkBBLgDoQMB9Q0BBLgDoQMBpDwBBLgDoQMB8l0BBLgD" +
+	"oQMBGwEEuAOhAwG2CgEEuAOhAwGBEwEEuAOhAwGbEAEEuAOhAwGvSAEEuAOhAwHV" +
+	"SAEEuAOhAwHxEgEEuAOhAwH0GQEEuAOhAwGzFgEFuAOhAwHKFp4cAQS4A6EDAas2" +
+	"AQS4A6EDAbQtAQS4A6EDAZlaAQS4A6EDAbMtAQS4A6EDAdQpAQS4A6EDAYITAQS4" +
+	"A6EDAY8EAQS4A6EDAdM+AQW4A6EDAb8qywUBBLgDoQMB8QYBBLgDoQMB8g4BBLgD" +
+	"oQMBzhcBBLgDoQMBuhcBBLgDoQMBpl8BBLgDoQMBsQoBBbgDoQMBnhyjCAEEuAOh" +
+	"AwHnCAEEuAOhAwHAUAEEuAOhAwHrEgEEuAOhAwH/RQEFuAOhAwHSDpMHAQS4A6ED" +
+	"AYMYAQS4A6EDAa09AQS4A6EDAalbAQW4A6EDAQPtDwEFuAOhAwHmB5wpAQa4A6ED" +
+	"AR+LBkiDAQPEBAAUAQPEBAA4AQPEBAA+AQPEBADjKQEDxAQAwQEBA8QEANIBAQPE" +
+	"BAA6AQPEBACOAgEDxAQA3gEBA8QEAMAJAQPEBACTAgEDxAQAxwEBA8QEAO4CAQPE" +
+	"BABvAQPEBACwAQEDxAQAmQEBA8QEALcOAQPEBACzDAEDxAQAMAEDxAQA+gEBA8QE" +
+	"AJAOAQPEBABiAQPEBABjAQPEBADFAQEDxAQA3xMBA8QEAPMDAQPEBAD8AgEDxAQA" +
+	"1AEBA8QEAPwBBAOgAwAUAQOgAwCJUAEDoAMA0gEBA6ADAG8BA6ADANRgAQOgAwBi" +
+	"AQOgAwDGRQEDoAMA3g8BA6ADAO4CAQOgAwA+AQOgAwCwAQEDoAMANQEDoAMA8DQB" +
+	"A6ADAMUBAQOgAwCKCgEDoAMAglkBA6ADAGMBA6ADANsqAQOgAwAwAQOgAwCzDAED" +
+	"oAMA/AEBA6ADAJkBAQOgAwCHXwEDoAMA+gEBA6ADAKEPAQOgAwA6AQOgAwA4AQOg" +
+	"AwDXYAEDoAMAxwEBA6ADANFCAQOgAwDCSwEDoAMAqgIBA6ADAMEBAQOgAwCQPAED" +
+	"oAMAoRMBA6ADAIhbAQOgAwDOBQEDoAMAkgIBA6ADANwqAQOgAwDfEwEDoAMA1WAB" +
+	"A6ADAOIDAQOgAwDdEQEDoAMA20gBA6ADANxIAQOgAwDdSAEDoAMAx0UBA6ADAJsH" +
+	"AQOgAwDWYBAEXdEExgkqAQRd0QQOMQEEXdEEDjYBBF3RBA5BAQRd0QQOTgEEXdEE" +
+	"DlUBBF3RBA51AQRd0QQOgAEBBF3RBA50AQRd0QQOiAEBBF3RBB2GAgEEXdEEHacD" +
+	"AQRd0QQdvQQBBF3RBB3cBAEEXdEEHYUEAQRd0QQdjgYBBF3RBB2TBAEEXdEEHbMG" +
+	"AQRd0QQd7QMBBV3RBB0xhgEBBV3RBB02hgEBBV3RBB1BhgEBBV3RBB1OhgEBBV3R" +
+	"BB1VhgEBBV3RBB11hgEBBV3RBB2AAYYBAQVd0QQddIYBAQVd0QQdiAGGAQUEJCwA" +
+	"FAEEJCwArgYBBCQsALwFAQQkLAClBQEEJCwAOAEEJCwAkwYBBCQsALUFAQQkLADU" +
+	"AwEEJCwAxgMBBCQsADoBBCQsAFoBBCQsAJkFAQQkLADoBQEEJCwA/AIBBCQsANwF" +
+	"AQQkLAA1AQQkLACxAwEEJCwAugMBBCQsAKoFAQQkLAC2BgEEJCwAxgcBBCQsAPoD" +
+	"AQQkLAAwAQQkLACuOQEEJCwAuxIBBCQsALMRAQQkLAD8BwEEJCwA1zkBBCQsAIsF" +
+	"AQQkLACSAgEEJCwAqgIBBCQsAN85AQQkLJoCMQEEJCyaAlUBBCQsmgKGAgEEJCya" +
+	"AoUECgQkLACrBgEFJCwArxvoBgEFJCwA1B3oBgED3QQAgSYBA90EAJcpAQPdBAD5" +
+	"PAED3QQA+TQBA90EAIQmAQPdBADGUgED3QQA7kEBA90EAPM9AQPdBADQXQED3QQA" +
+	"qjkBA90EAMVEAQPdBACDRQED3QQAs0gBA90EAKFLAQPdBACAQgED3QQAymABA90E" +
+	"AJdQAQPdBADtAwED3QQAxFMBA90EAIJWAQPdBACkXQED3QQAn2MBA90EANM6AQPd" +
+	"BACtQQED3QQA7hgBA90EAOxPAQTdBACIAYYBBgQkygMA8wMBBCTKAwCvCAEEJMoD" +
+	"AJU8AQQkygMAtBcBBCTKAwA4AQQkygMA3WUBBCTKAwDgOAEEJMoDANkPAQQkygMA" +
+	"4TgBBCTKAwA6AQQkygMAikQBBCTKAwDTRQEEJMoDAP9IAQQkygMAjBsBBCTKAwCn" +
+	"YgEEJMoDAOVQAQQkygMA7VMBBCTKAwDgDAEEJMoDADQBBCTKAwAwAQQkygMA7CsB" +
+	"BCTKAwCrWQEEJMoDAJJZAQQkygMA82QBBCTKAwDqBwEEJMoDADUBBCTKAwCqAwEE" +
+	"JMoDAPwHAQQkygMAggEBBCTKAwCCXwEEJMoDAMNhAQQkygMAhg0BBCTKAwDSDwEE" +
+	"JMoDAOgIAQQkygMAiAYBBCTKAwDmAwEEJMoDALMBAQQkygMA+w0BBR8kygMA8wMB" +
+	"BR8kygMAtBcBBR8kygMA2Q8BBR8kygMAjBsBBR8kygMA4AwGA7EEAKAmAQOxBADX" +
+	"BQEDsQQAmjwBA7EEANECAQOxBADGBQEDsQQA3wQBA7EEAJYFAQOxBACPBwEDsQQA" +
+	"/z8BA7EEAJwKAQOxBACpCAEDsQQAnQMBA7EEAJgEAQOxBADeBgEDsQQA3wUBA7EE" +
+	"ANk1AQOxBADxAgEDsQQAwAcBA7EEAO9VAQOxBAC7AwEDsQQAhgcBA7EEAP4cAQOx" +
+	"BADpUgEDsQQAzVMBA7EEAL9dAQOxBADuBAEDsQQAQgEDsQQAOgEDsQQAMAEDsQQA" +
+	"oBMCA7EEoQXGCgEEJI8DARQBBCSPAwE6AQQkjwMBMAEEJI8DAVoBBCSPAwGqBQEE" +
+	"JI8DAcMBAQQkjwMBhAYBBCSPAwHOBgEEJI8DAasCAQQkjwMBpQIBBCSPAwGOCQEE" +
+	"JI8DAbQBAQQkjwMBiAUBBCSPAwG/AQEEJI8DAdoFAQQkjwMB2wUBBCSPAwHqAgEE" +
+	"JI8DAZcBAQQkjwMB4AEBBCSPAwHTAwEEJI8DAa0BAQQkjwMBogQBBCSPAwGoAQEE" +
+	"JI8DAbkEAQQkjwMB3gMBBCSPAwGzAQEEJI8DAYUDAQQkjwMB5ggBBCSPAwGcAQEE" +
+	"JI8DAZwFAQQkjwMBqQEBBCSPAwG4AQEEJI8DAZ8DAQQkjwMBqAIBBCSPAwHjAwEE" +
+	"JI8DAYIBBQQkjwMBpycBBCSPAwGoJwEEJI8DAaknAQQkjwMB8WMBBCSPAwH7NAEE" +
+	"JI8DAfw0AQQkjwMBjikBBCSPAwHSKgEEJI8DoQXGCgEEJI8DHTEBBCSPAx02AQQk" +
+	"jwMdhgIBBCSPAx2nAwEEJI8DHYYBKwWPAgUAqgE6AQWPAgUAqgE4AQWPAgUAqgEU" +
+	"AQWPAgUAqgGQBwEFjwIFAKoBNQEFjwIFAKoBqgMBBY8CBQC1AjoBBY8CBQC1AjgB" +
+	"BY8CBQC1AhQBBY8CBQC1ApAHAQWPAgUAtQI1AQWPAgUAtQKqAwEEjwIFAJEHAQSP" +
+	"AgUAvgwBBI8CBQDpBwEEjwIFAL0LAQSPAgUA1AEBBI8CBQDSCAEEjwIFALEKAQSP" +
+	"AgUAqQsBBI8CBQDDCgEEjwIFAKsNAQSPAgUA6woBBI8CBQCvEQEEjwIFAJ4YAQSP" +
+	"AgUA/AcBBI8CBQDNCQEEjwIFAIEJAQSPAgUA7QwBBI8CBQDGBwEEjwIFAIgNAQSP" +
+	"AgUA3AUBBI8CBQDbDwEEjwIFALYGAQSPAgUAmQUBBI8CBQDoBQEEjwIFAPwCAQSP" +
+	"AgUAnQcBBI8CBQC5DAEEjwIFANgQAQWPAgMAqgE6AQWPAgMAqgE4AQWPAgMAqgEU" +
+	"AQWPAgMAqgGQBwEFjwIDAKoBNQEFjwIDAKoBqgMBBY8CAwC1AjoBBY8CAwC1AjgB" +
+	"BY8CAwC1AhQBBY8CAwC1ApAHAQWPAgMAtQI1AQWPAgMAtQKqAwEEjwIDAJEHAQSP" +
+	"AgMAvgwBBI8CAwDpBwEEjwIDAL0LAQSPAgMA1AEBBI8CAwDSCAEEjwIDALEKAQSP" +
+	"AgMAqQsBBI8CAwDDCgEEjwIDAKsNAQSPAgMA6woBBI8CAwCvEQEEjwIDAJ4YAQSP" +
+	"AgMA/AcBBI8CAwDNCQEEjwIDAIEJAQSPAgMA7QwBBI8CAwDGBwEEjwIDAIgNAQSP" +
+	"AgMA3AUBBI8CAwDbDwEEjwIDALYGAQSPAgMAmQUBBI8CAwDoBQEEjwIDAPwCAQSP" +
+	"AgMAnQcBBI8CAwC5DAEEjwIDANgQAQOrAwDoUAEDqwMAxR4BA6sDANBDAQOrAwDn" +
+	"CQEDqwMAyl0BA6sDAPgEAQOrAwDqWwEDqwMA4BYBA6sDALxkAQOrAwCPQAEDqwMA" +
+	"6ykBA6sDAK81AQOrAwCUPAEDqwMAqmIBA6sDAMddAQOrAwDqHwEDqwMA+gkBA6sD" +
+	"ALFCAQOrAwCrYwEDqwMA7D0BA6sDAKVGAQOrAwDdSQEDqwMAq0EBA6sDANQXAQOr" +
+	"AwD6AgEDqwMA5iUBA6sDAMwCAQOrAwCuHwEDqwMAngYBA6sDAJAHAQOrAwDDVAED" +
+	"qwMA8QIBA6sDAMsKAQOrAwD5JQEDqwMAjAkBA6sDAGEBA6sDALRPAQOrAwDeTwED" +
+	"qwMAzg4BA6sDALQnAQOrAwDqJgEDqwMA3QEBA6sDAKkKAQOrAwDTDQEDqwMA+CYB" +
+	"A6sDAOIJAQOrAwD1GAEDqwMAzWQBA+EDAHYBA+EDAOABAQPhAwC0AQED4QMApQIB" +
+	"A+EDAM4GAQPhAwDRAgED4QMAuDUBA+EDAJwBAQPhAwC4AQED4QMAoVkBA+EDAKkD" +
+	"AQPhAwDPFgED4QMAwwEBA+EDANMDAQPhAwDZEgED4QMAnRkBA+EDAPVEAQPhAwDU" +
+	"SQED4QMAnU4BA+EDANEDAQPhAwCCAQED4QMAswEBA+EDABQBA+EDADgBA+EDADoB" +
+	"A+EDADUBA+EDADABA+EDAO0BAQPhAwDMAwED4QMAqgMDA+EDDuMBAQPhAw4xAQPh" +
+	"Aw42AQPhAw5BAQPhAw5OAQPhAw5VAQPhAw51AQPhAw6AAQED4QMOdAED4QMOiAEH" +
+	"BLQCBQAUAQS0AgUAigIBBLQCBQC7AwEEtAIFAJAHAQS0AgUAtxYBBLQCBQDOAgEE" +
+	"tAIFANYXAQS0AgUAOAEEtAIFANwXAQS0AgUAggEBBLQCBQDyGAEEtAIFADoBBLQC" +
+	"BQBaAQS0AgUA1xcBBLQCBQDAEQEEtAIFAKkBAQS0AgUAqAEBBLQCBQCtAQEEtAIF" +
+	"ADUBBLQCBQDPGwEEtAIFAJcBAQS0AgUA2BcBBLQCBQC4AQEEtAIFAKgCAQS0AgUA" +
+	"tAEBBLQCBQDZFwEEtAIFAL4EAQS0AgUA2hcBBLQCBQDLBgEEtAIFAKkDAQS0AgUA" +
+	"MAEEtAIFAK8CAQS0AgUA0QIBBLQCBQCvAwEEtAIFAJ8DAQS0AgUAtgUFBLQCAwAU" +
+	"AQS0AgMAigIBBLQCAwC7AwEEtAIDAJAHAQS0AgMAtxYBBLQCAwDOAgEEtAIDANYX" +
+	"AQS0AgMAOAEEtAIDANwXAQS0AgMAggEBBLQCAwDyGAEEtAIDADoBBLQCAwBaAQS0" +
+	"AgMA1xcBBLQCAwDAEQEEtAIDAKkBAQS0AgMAqAEBBLQCAwCtAQEEtAIDADUBBLQC" +
+	"AwDPGwEEtAIDAJcBAQS0AgMA2BcBBLQCAwC4AQEEtAIDAKgCAQS0AgMAtAEBBLQC" +
+	"AwDZFwEEtAIDAL4EAQS0AgMA2hcBBLQCAwDLBgEEtAIDAKkDAQS0AgMAMAEEtAID" +
+	"AK8CAQS0AgMA0QIBBLQCAwCvAwEEtAIDAJ8DAQS0AgMAtgUFA90DABQBA90DAK4G" +
+	"AQPdAwCwCAED3QMAiwUBA90DAKUFAQPdAwC+DgED3QMA3wsBA90DALoIAQPdAwA4" +
+	"AQPdAwCfBwED3QMAhQgBA90DAP0QAQPdAwDUAwED3QMAOgED3QMAowcBA90DALwF" +
+	"AQPdAwCjAwED3QMAmEYBA90DAJAEAQPdAwCIBAED3QMArQEBA90DAKQJAQPdAwA1" +
+	"AQPdAwCxAwED3QMA6w4BA90DAPYFAQPdAwD1HAED3QMAsAUBA90DALoDAQPdAwD6" +
+	"AwED3QMAxgMBA90DADABA90DAJMGAQPdAwC7CwED3QMA4gEBA90DALUFAQPdAwCt" +
+	"BgED3QMA7QUBA90DAOU8AQPdAwCzEQkEjQOLAwCiJgEEjQOLAwCzCgEEjQOLAwD8" +
+	"EAEEjQOLAwCPFwEEjQOLAwDQEAEEjQOLAwDFZQEEjQOLAwD1FwEEjQOLAwDcZQEE" +
+	"jQOLAwCFXQEEjQOLAwDOAgEEjQOLAwDuZAEEjQOLAwC2BQEEjQOLAwDhQQEEjQOL" +
+	"AwCoAgEEjQOLAwDdGQEEjQOLAwDcQQEEjQOLAwDfYwEEjQOLAwDdOAEEjQOLAwD0" +
+	"CAEEjQOLAwCJQgEEjQOLAwD3QgEEjQOLAwDTRgEEjQOLAwC6PgEEjQOLAwDMUgEE" +
+	"jQOLAwDQJgEEjQOLAwCLLAEEjQOLAwDrBAEEjQOLAwCMNAEEjQOLAwDBGgEEjQOL" +
+	"AwDPDAEEjQOLAwC5TQEEjQOLAwDiOAEEjQOLAwCEWQEEjQOLAwD+QQEEjQOLAwDM" +
+	"AgEEjQOLAwDFXwEEjQOLAwDZOgEEjQOLAwDfOAEEjQOLAwDaFgEEjQOLAwCNCwEE" +
+	"jQOLAwDxPAEEjQOLAwDzDgEEjQOLAwD+WAEEjQOLAwD/YQEEjQOLAwCLXgEEjQOL" +
+	"AwCnHQEEjQOLAwDwQQEEjQOLAwCLNAEEjQOLAwDPFgEEjQOLAwC6ZAEEjQOLAwDB" +
+	"EgEEjQOLAwDlQwwEjQOLA6ssKgEEwAIFABQBBMACBQDLCwEEwAIFAK4GAQTAAgUA" +
+	"sAgBBMACBQCLBQEEwAIFAKUFAQTAAgUA3wsBBMACBQC6CAEEwAIFADgBBMACBQCf" +
+	"BwEEwAIFAMMBAgTAAgUAggEBBMACBQDGCAEEwAIFADoBBMACBQD/GAEEwAIFAKMH" +
+	"AQTAAgUAWgEEwAIFAKkBAQTAAgUA4AQBBMACBQCQBAEEwAIFAIgEAQTAAgUApAkB" +
+	"BMACBQA1AQTAAgUAsQMBBMACBQDPBQEEwAIFAPYFAgTAAgUAsAUBBMACBQC6AwEE" +
+	"wAIFAPoDAQTAAgUAxgMBBMACBQAwAQTAAgUAkwYBBMACBQC7CwIEwAIFAOIBAQTA" +
+	"AgUAtQUCBMACAwAUAQTAAgMAywsBBMACAwCuBgEEwAIDALAIAQTAAgMAiwUBBMAC" +
+	"AwClBQEEwAIDAN8LAQTAAgMAuggBBMACAwA4AQTAAgMAnwcBBMACAwDDAQIEwAID" +
+	"AIIBAQTAAgMAxggBBMACAwA6AQTAAgMA/xgBBMACAwCjBwEEwAIDAFoBBMACAwCp" +
+	"AQEEwAIDAOAEAQTAAgMAkAQBBMACAwCIBAEEwAIDAKQJAQTAAgMANQEEwAIDALED" +
+	"AQTAAgMAzwUBBMACAwD2BQIEwAIDALAFAQTAAgMAugMBBMACAwD6AwEEwAIDAMYD" +
+	"AQTAAgMAMAEEwAIDAJMGAQTAAgMAuwsCBMACAwDiAQEEwAIDALUFRAQeFAGBJQEE" +
+	"HhQBgiUBBB4UAYMlAQQeFAGEJQEEHhQBhSUBBB4UAYYlAQQeFAGHJQEEHhQBiCUB" +
+	"BB4UAYklAQQeFAGKJQEEHhQBiyUBBB4UAYwlAQQeFAGNJQEEHhQBjiUBBB4UAY8l" +
+	"AQQeFAGQJQEEHhQBkSUBBB4UAZIlAQQeFAGTJQEEHhQBlCUBBB4UAZUlAQQeFAGW" +
+	"JQEEHhQBlyUBBB4UAZglAQQeFAGZJQEEHhQBmiUBBB4UAZslAQQeFAGGIAEEHhQB" +
+	"nCUBBB4UAZ0lAQQeFAGeJQEEHhQBnyUBBB4UAaAlAQQeFAGhJQEEHhQBoiUBBB4U" +
+	"AaMlAQQeFAGkJQEEHhQBpSUBBB4UAaYlAQQeFAGnJQEEHhQBqCUBBB4UAaklAQQe" +
+	"FAGqJQEEHhQBqyUBBB4UAawlAQQeFAGtJQEEHhQBriUBBB4UAa8lAQQeFAGwJQEE" +
+	"HhQBsSUBBB4UAbIlAQQeFAGzJQEEHhQBtCUBBB4UAbUlAQQeFAG2JQEEHhQBtyUB" +
+	"BB4UAbglAQQeFAG5JQEEHhQBuiUBBB4UAbslAQQeFAG8JQEEHhQBvSUBBB4UAb4l" +
+	"AQQeFAG/JQEEHhQBwCUBBB4UAcElAQQeFAHCJQEEHhQBwyUBBB4UAcQlAQQeFAHF" +
+	"JQEEHhQBxiUBBB4UAbIgAQQeFAHHJQEEHhQByCUBBB4UAdUgAQQeFAHJJQEEHhQB" +
+	"yiUBBB4UAcslAQQeFAHMJQEEHhQB4iABBB4UAc0lAQQeFAHOJQEEHhQBzyUBBB4U" +
+	"AdAlAQQeFAHRJQEEHhQB3xQBBB4UAeAUAQQeFAHhFAEEHhQB4hQBBB4UAeMUAQQe" +
+	"FAHkFAEEHhQB5RQBBB4UAeYUAQQeFAHnFAEEHhQBmiIBBB4UAZsiAQQeFAHoFAEE" +
+	"HhQB6RQBBB4UAeoUAQQeFAGdIgEEHhQBniIBBB4UAZ8iAQQeFAHrFAEEHhQB7BQB" +
+	"BB4UAe0UAQQeFAHuFAEEHhQB7xQBBB4UAfAUAQQeFAHxFAEEHhQB8hQBBB4UAfMU" +
+	"AQQeFAH0FAEEHhQB9RQBBB4UAfYUAQQeFAH3FAEEHhQB+BQBBB4UAfkUAQQeFAH6" +
+	"FAEEHhQB+xQBBB4UAfwUAQQeFAGhIgEEHhQB/RQBBB4UAf4UAQQeFAH/FAEEHhQB" +
+	"gBUBBB4UAYEVAQQeFAGCFQEEHhQBgxUBBB4UAYQVAQQeFAGFFQEEHhQBhhUBBB4U" +
+	"AYcVAQQeFAGIFQEEHhQBiRUBBB4UAYoVAQQeFAGLFQEEHhQBjBUBBB4UAY0VAQQe" +
+	"FAGOFQEEHhQBjxUBBB4UAZAVAQQeFAGRFQEEHhQBkhUBBB4UAZMVAQQeFAGUFQEE" +
+	"HhQBlRUBBB4UAZYVAQQeFAGXFQEEHhQBmBUBBB4UAZkVAQQeFAGaFQEEHhQBmxUB" +
+	"BB4UAZwVAQQeFAGdFQEEHhQBnhUBBB4UAZ8VAQQeFAGgFQEEHhQBoRUBBB4UAaIV" +
+	"AQQeFAGjFQEEHhQBzCIBBB4UAc4iAQQeFAHQIgEEHhQB0iIBBB4UAdQiAQQeFAHW" +
+	"IgEEHhQB2CIBBB4UAdoiAQQeFAHcIgEEHhQB3iIBBB4UAeAiAQQeFAHjIgEEHhQB" +
+	"5SIBBB4UAeciAQQeFAHpIgEEHhQB6yIBBB4UAe0iAQQeFAHvIgEEHhQB8SIBBB4U" +
+	"AaQVAQQeFAGlFQEEHhQBphUBBB4UAacVAQQeFAGoFQEEHhQBqRUBBB4UAaoVAQQe" +
+	"FAGrFQEEHhQBrBUBBB4UAa0VAQQeFAGuFQEEHhQBrxUBBB4UAbAVAQQeFAGxFQEE" +
+	"HhQBshUBBB4UAbMVAQQeFAG0FQEEHhQBtRUBBB4UAbYVAQQeFAG3FQEEHhQBuBUB" +
+	"BB4UAbkVAQQeFAG6FQEEHhQBuxUBBB4UAckjAQQeFAHKIwEEHhQByyMBBB4UAcwj" +
+	"AQQeFAHNIwEEHhQBziMBBB4UAc8jAQQeFAHQIwEEHhQB0SMBBB4UAdIjAQQeFAHT" +
+	"IwEEHhQB1CMBBB4UAdUjAQQeFAHWIwEEHhQB1yMBBB4UAdgjAQQeFAHZIwEEHhQB" +
+	"2iMBBB4UAdsjAQQeFAHcIwEEHhQB3SMBBB4UAd4jAQQeFAHfIwEEHhQB4CMBBB4U" +
+	"AeEjAQQeFAHiIwEEHhQB4yMBBB4UAeQjAQQeFAHlIwEEHhQB5iMBBB4UAecjAQQe" +
+	"FAHoIwEEHhQB6SMBBB4UAeojAQQeFAHrIwEEHhQB7CMBBB4UAe0jAQQeFAHuIwEE" +
+	"HhQB7yMBBB4UAfAjAQQeFAHxIwEEHhQB8iMBBB4UAfMjAQQeFAH0IwEEHhQB9SMB" +
+	"BB4UAfYjAQQeFAH3IwEEHhQB+CMBBB4UAfkjAQQeFAH6IwEEHhQB+yMBBB4UAfwj" +
+	"AQQeFAH9IwEEHhQB/iMBBB4UAf8jAQQeFAGAJAEEHhQBgSQBBB4UAYIkAQQeFAGD" +
+	"JAEEHhQBhCQBBB4UAYUkAQQeFAGGJAEEHhQBhyQBBB4UAYgkAQQeFAGJJAEEHhQB" +
+	"iiQBBB4UAYskAQQeFAGMJAEEHhQBjSQBBB4UAY4kAQQeFAGPJAEEHhQBkCQBBB4U" +
+	"AZEkAQQeFAGSJAEEHhQBkyQBBB4UAZQkAQQeFAGVJAEEHhQBliQBBB4UAZckAQQe" +
+	"FAGYJAEEHhQBmSQBBB4UAZokAQQeFAGbJAEEHhQBnCQBBB4UAZ0kAQQeFAGeJAEE" +
+	"HhQBnyQBBB4UAaAkAQQeFAGhJAEEHhQBoiQBBB4UAaMkAQQeFAGkJAEEHhQBpSQB" +
+	"BB4UAaYkAQQeFAGnJAEEHhQBqCQBBB4UAakkAQQeFAGqJAEEHhQBqyQBBB4UAawk" +
+	"AQQeFAGtJAEEHhQBriQBBB4UAa8kAQQeFAGwJAEEHhQBsSQBBB4UAbIkAQQeFAGz" +
+	"JAEEHhQBtCQKBR4UAbUkFAEFHhQBtiQ+AQUeFAG3JNIBAQUeFAG4JDgBBR4UAbkk" +
+	"swIBBR4UAbok1AEBBR4UAbskkwIBBR4UAbwkxwEBBR4UAb0kbwEFHhQBviTvRAEF" +
+	"HhQBvyTwRAEFHhQBwCTxRAEFHhQBwSTyRAEFHhQBwiSOAgEFHhQBwyT8AQEFHhQB" +
+	"xCTiAQEFHhQBxSSsAgEFHhQBxiTUJQEFHhQBxySvKQEFHhQByCSSNQEFHhQBySSD" +
+	"OgEFHhQByiSjBwsEHhQByyQBBB4UAcwkAQQeFAHNJAEEHhQBziQBBB4UAc8kAQQe" +
+	"FAHQJAEEHhQB0SQBBB4UAdIkGQU9AAMF7QEBBT0A1wSzBZsEAQY9ANcEbrMFmwQB" +
+	"BD0AA5UEAQU9AAMFPgEGPQADPgJSAgU9AAP7B6gEAQg9AAP7B6gEAs8DUgEHPQAD" +
+	"+weoBAKkBQEFPQADvQ2oBAEGPQAD0gEC6QIBBj0AA9IBAlIBCD0AA9IBAlIY6QIB" +
+	"BT0AA/gBOAEHPQADwgP4AbEBOAEFPQAD3xCoBAEFPQADyxyPBAEFPQADBcEBAQY9" +
+	"AAPBAQJSAQc9AAMFwQECUgEGPQAD1AECSAEFPQADBdQBAQY9AAOCDAJSAQk9AAO6" +
+	"BZMCAkgYUgEFPQADgxqoBAEFPQADjRqoBAEGPQADbwLBBwEHPQADBW8CwQcBCT0A" +
+	"A28CzwNSGMEHAQQ9AAOkDgEHPQADpA4CzwNSAQU9AAOgAeIBAQc9AAOgAeIBAsEH" +
+	"AQY9AAM1AkgBBT0AAwXEAwEFPQADwgOsAgEEPQAD7gIBCD0AA6ABYgKqAcsFAQs9" +
+	"AAOgAWICqgHLBRjPA1IBBj0AA2IC6QIBBj0AA2IChAkBBT0AAwViAQc9AAOeHqgE" +
+	"AqQFAQU9AAO6D6gEAQg9AAO6D6gEAs8DUgEFPQADrQ+oBAEHPQADxQECzwNSAQc9" +
+	"AAPLAQIlUgIFPQADBeIBAQY9AIYEqQICSAEHPQD4AYYEqQICSAEEPQD9D8QHAQQ9" +
+	"AL8QxAcBBD0A4hnEBwEEPQDXFcQHAQc9AM8DxAcCzwNSAQY9AANjAqQFRgODAwQU" +
+	"AQODAwQ4AQODAwQ6AQODAwQ1AQODAwQwAQODAwSlAgMDgwMEzQcCA4MDBFoBA4MD" +
+	"BLwFAQODAwSQBQEDgwMEgAUBA4MDBKoFAQODAwSpAQEDgwMEowMBA4MDBNUCAQOD" +
+	"AwTMBQEDgwME8gQBA4MDBKgBAQODAwSQBAEDgwMEuQQBA4MDBK4FAQODAwTeAwED" +
+	"gwMErQEBA4MDBIgEAQODAwSJBAEDgwME4AMBA4MDBKIEAQODAwSXAQEDgwMEsQMB" +
+	"A4MDBJMDAQODAwT1BQEDgwMEhgcBA4MDBJwBAQODAwT2BQEDgwMEoAYBA4MDBPQE" +
+	"AQODAwScBQEDgwMEuAEBA4MDBLAFAQODAwSdBQEDgwME+AQBA4MDBLIFAQODAwS0" +
+	"AQEDgwME+gMBA4MDBOIFAQODAwRCAQODAwSIBQEDgwMErwIBA4MDBP0EAQODAwS0" +
+	"BQEDgwMElgUCA4MDBM4GAQODAwS7CwQDgwMEnwMDA4MDBL8HAQSuBKcEAP4FAQSu" +
+	"BKcEAIAGAQSuBKcEAJkGAQSuBKcEAPgHAQSuBKcEANQDAQSuBKcEANEDAQSuBKcE" +
+	"AO0GAQSuBKcEALgGAQSuBKcEAOQHAQSuBKcEANAGAQSuBKcEAJsGAQSuBKcEAL0G" +
+	"AQSuBKcEAN8FAQSuBKcEAPECAQSuBKcEAKMGAQSuBKcEAJIHAQSuBKcEALEDAQSu" +
+	"BKcEAKIGAQSuBKcEAK8JAQSuBKcEAIcHAQSuBKcEAJgEAQSuBKcEAJAGAgSuBKcE" +
+	"8AMBAQSuBKcEHTEBBK4EpwQdNgEErgSnBB1BAQSuBKcEHYYCAQSuBKcEHacDAQWu" +
+	"BKcEHTGGAQEFrgSnBB0xqwEBBa4EpwQdhgKrAQEDowQA/gUBA6MEAIAGAQOjBACZ" +
+	"BgEDowQA+AcBA6MEANQDAQOjBADRAwEDowQA7QYBA6MEALgGAQOjBADkBwEDowQA" +
+	"0AYBA6MEAJsGAQOjBAC9BgEDowQA3wUBBKMEADLxAgEDowQA8QIBA6MEAKMGAQOj" +
+	"BACSBwEDowQAsQMBA6MEAKIGAQOjBACvCQEDowQAhwcBA6MEAJgEAQOjBACQBgED" +
+	"owTKBeUQAQOjBJIF5RABA6MEHTEBA6MEHTYBA6MEHUEBA6MEHU4BA6MEHVUBA6ME" +
+	"HYYCAQOjBB2nAwEE3wMAMv4FAQPfAwD+BQEE3wMAMoAGAQPfAwCABgED3wMAmQYB" +
+	"A98DAPgHAQTfAwAy1AMBA98DANQDAQPfAwDRAwED3wMA7QYBA98DALgGAQPfAwDk" +
+	"BwEE3wMAMtAGAQPfAwDQBgEE3wMAMpsGAQPfAwCbBgEE3wMAMr0GAQPfAwC9BgEE" +
+	"3wMAMt8FAQPfAwDfBQEE3wMAMvECAQPfAwDxAgED3wMAowYBA98DAJIHAQPfAwCx" +
+	"AwED3wMAogYBA98DAK8JAQPfAwCHBwEE3wMAMpgEAQPfAwCYBAED3wMAkAYJA98D" +
+	"HTEBA98DHTYBA98DHUEBA98DHU4BBN8D9zMdTgED3wMdVQED3wMdhgIBA98DHacD" +
+	"AQTfAx0xhgExA+8EAP4FAQPvBACABgED7wQAmQYBA+8EAIE1AQPvBADUAwED7wQA" +
+	"0QMBA+8EAOEfAQPvBAC4BgED7wQA5AcBA+8EANAGAQPvBACbBgED7wQAvQYBA+8E" +
+	"AN8FAQPvBADxAgED7wQAowYBA+8EAPkVAQPvBACxAwED7wQAogYBA+8EAK8JAgPv" +
+	"BACYBAED7wQAkAYGA+8EHTEBA+8EHVUBA+8EHYYCAQPvBB2nAwEE7wQdMYYBAQPJ" +
+	"BACYJgEDyQQAswoBA8kEAJk8AQPJBADENQEDyQQA1AMBA8kEAPITAQPJBAC/ZQED" +
+	"yQQA1BgBA8kEAJwKAQPJBACpCAEDyQQAnQMBA8kEALNFAQPJBADfBQEDyQQA8QIB" +
+	"A8kEAKtYAQPJBAC7AwEDyQQAsQMBA8kEAP4cAQPJBADaEgEDyQQA0FQBA8kEAJgE" +
+	"AQPJBAD7BQEDyQQdMQEDyQQdhgIBA8kEHacDAQTJBB0xhgEBA8kEHTYBA8kEHUEE" +
+	"A8kEoQX1BAED4wQAFAED4wQAPgED4wQAwQEBA+MEANIBAQPjBAA4AQPjBADLAQED" +
+	"4wQAOgED4wQA4gEBA+MEAMcBAQPjBABvAQPjBACwAQED4wQAmQEBA+MEADUBA+ME" +
+	"AGIBA+MEAKEPAQPjBADFAQED4wQAMAED4wQAswIBA+MEAO4CAQPjBABjAQPjBADf" +
+	"EwED4wQA8wMBA+MEAPwCAQPjBADVEQED4wQAswwBA+MEAPMBBgPjBLMFKkEEmwGg" +
+	"BAAUAQSbAaAEADgBBJsBoAQAOgEEmwGgBAA1AQSbAaAEALMBAQSbAaAEAK8CAQSb" +
+	"AaAEAOABAQSbAaAEAIYpAQSbAaAEAJcBAQSbAaAEAKgBAQSbAaAEAK0BAQSbAaAE" +
+	"APsaAQSbAaAEAIgEAQSbAaAEAIkbAQSbAaAEAJwBAQSbAaAEAMYcAQSbAaAEAKkB" +
+	"AQSbAaAEANECAQSbAaAEAMYIAQSbAaAEALgBAQSbAaAEAP0cAQSbAaAEALAFAQSb" +
+	"AaAEAFoBBJsBoAQAzwUBBJsBoAQAtAEBBJsBoAQAlB4BBJsBoAQA+gMBBJsBoAQA" +
+	"oB4BBJsBoAQAQgEEmwGgBAC/AQEEmwGgBAuEYgEEmwGgBAuFYgEEmwHpAQAUAQSb" +
+	"AekBADgBBJsB6QEAOgEEmwHpAQA1AQSbAekBALMBAQSbAekBAK8CAQSbAekBAOAB" +
+	"AQSbAekBAJcBAQSbAekBAKgBAQSbAekBAK0BAQSbAekBAIgEAQSbAekBAJwBAQSb" +
+	"AekBAKkBAQSbAekBANECAQSbAekBAMYIAQSbAekBALgBAQWbAekBANkDuAEBBJsB" +
+	"6QEAsAUBBJsB6QEAWgEEmwHpAQDPBQEEmwHpAQC0AQEEmwHpAQD6AwEEmwHpAQBC" +
+	"AQSbAekBAL8BBQWbAekBzAGcB6QIAQWbAekBzAExbgEEmwHpAZcJulQBBJsB6QGX" +
+	"Cb1BAQSbAekBHTEBBJsB6QEdNgEEmwHpAR1BAQSbAekBHU4BBJsB6QEdVQEEmwHp" +
+	"AR11AQSbAekBHYABAQSbAekBHXQBBJsB6QEdiAEBBJsB6QEdhgIBBJsB6QEdpwMB" +
+	"BJsB6QEdvQQBBJsB6QEd3AQBBJsB6QEdhQQBBJsB6QEdjgYBBJsB6QEdkwQDBZsB" +
+	"6QEdMYYBAQWbAekBHTaGAQEFmwHpAR1BhgEBBZsB6QEdToYBAQWbAekBHVWGAQEF" +
+	"mwHpAR11hgEBBZsB6QEdgAGGAQEFmwHpAR10hgEBBZsB6QEdiAGGAQEFmwHpAR0x" +
+	"qwEBBZsB6QEdNqsBAQWbAekBHUGrAQEFmwHpAR1OqwEBBZsB6QEdVasBAQWbAekB" +
+	"HXWrAQEFmwHpAR2AAasBAQWbAekBHXSrAQEFmwHpAR2IAasBAQWbAekBHYYCqwEB" +
+	"BZsB6QEdpwOrAQEFmwHpAR29BKsBAQWbAekBHdwEqwEBBZsB6QEdhQSrAQEFmwHp" +
+	"AR2OBqsBAQWbAekBHZMEqwEBBZsB6QEdswarAQEFmwHpAR3tA6sBAQabAekBHTGG" +
+	"AasBAQabAekBHTaGAasBAQabAekBHUGGAasBAQabAekBHU6GAasBAQabAekBHVWG" +
+	"AasBAQabAekBHXWGAasBAQabAekBHYABhgGrAQEGmwHpAR10hgGrAQEGmwHpAR2I" +
+	"AYYBqwEBBZsB6QHMATG3XwEFmwHpAcwBNqQIAQWbAekBzAFBpAgBBZsB6QHMAU6k" +
+	"CAEFmwHpAcwBVaQIAQWbAekBzAF1pAgBBZsB6QHMAYABpAgBBZsB6QHMAXSkCAEF" +
+	"mwHpAcwBiAGkCAEFmwHpAcwBhgKkCAEDxwIAFAEExwIQAToBBMcCEAEwAQXHAhAB" +
+	"e2ICBMcCEAE4AQTHAhABNQYExwIQvgYqAQXHAgEznwE5AQPHAgGMAwEDxwIB8QMB" +
+	"A8cCAFoBA8cCANECAQPHAgDDAQEDxwIArwMCA8cCAKsCAQPHAgDOAgEDxwIApQIC" +
+	"A8cCANMCAQPHAgCaAwEDxwIA0AMBA8cCAJsDAQPHAgDbAwEDxwIAvwMBA8cCALQB" +
+	"AQPHAgDqAgEDxwIAvwEBA8cCAKkDAQPHAgCtAQEDxwIAlwEBA8cCAIQDAQPHAgDg" +
+	"AQEDxwIAwQMBA8cCAKgBAQPHAgCzAQEDxwIAnAEBA8cCAKkBAQPHAgCFAwEDxwIA" +
+	"qAIBA8cCAOMDAQPHAgC4AQEDxwIAnwMBA8cCAIIBAQPHAgC3EQEDxwIAlF8BA8cC" +
+	"AOceAQPHAgCBYgMExwIBdyYBA8cCAaIrAQTHAgE7OQUCxwLWAwEDxwIOMQEDxwIO" +
+	"NgEDxwIOQQEDxwIOTgEDxwIdhgIBA8cCHacDAQTHAh0xhgEBBMcCHTGrAQEExwLM" +
+	"ATFuCAPHAsQBOwEExwLEAQN4AQPHAsQBeAEExwLEAcEKdwEDxwLEAbFIAQPHAsQB" +
+	"qg4BA8cCxAG3BAEExwLEATO3BAEDxwLEAc4RCAUk8gHYAgDUAwEFJPIB2AIAvQYB" +
+	"BSTyAdgCALgGAQUk8gHYAgDfBQEFJPIB2AIArwkBBSTyAdgCANEDAQUk8gHYAgCY" +
+	"BAEFJPIB2AIAhwcBBSTyAdgCAIAGAQUk8gHYAgCQBgEFJPIB2AIA8BIBBSTyAdgC" +
+	"AJsGAQUk8gHYAgDxAgEFJPIB2AIAt0MBBSTyAdgCAKIGAQUk8gHYAgCjBgEFJPIB" +
+	"2AIAnwcBBSTyAdgCAHYBBSTyAdgCAPkVAQUk8gHYAgDYNQEFJPIB2AIAmQYBBSTy" +
+	"AdgCAPgHAQUk8gHYAgDoPAEFJPIB2AIA5AcBBSTyAdgCAOEfAQUk8gHYAgDbNQEF" +
+	"JPIB2AIA0AYBBSTyAdgCAMFdAQUk8gHYAgDGXQEFJPIB2AIdMQEFJPIB2AIdhQQB" +
+	"BSTyAdgCrgGWBAEFJLwB2AIAogMBBSS8AdgCAKYCAQUkvAHYAgClAQEFJLwB2AIA" +
+	"WAEFJLwB2AIAgQQBBSS8AdgCANEDAQUkvAHYAgDZOQEFJLwB2AIA7wMBBSS8AdgC" +
+	"ALwDAQUkvAHYAgCQAwEFJLwB2AIA2DkBBSS8AdgCAJ0DAQUkvAHYAgDLAgEFJLwB" +
+	"2AIAxgIBBSS8AdgCAJIEAQUkvAHYAgDaOQEFJLwB2AIA6wMBBSS8AdgCAHYBBSS8" +
+	"AdgCALsDAQUkvAHYAgCdBAEFJLwB2AIAtTwBBSS8AdgCANkFAQUkvAHYAgDuBAEF" +
+	"JLwB2AIA5QMBBSS8AdgCAKwGAQUkvAHYAgDCCQEFJLwB2AIAXAEFJLwB2AIAlQUB" +
+	"BSS8AdgCAOwGAQUkvAHYAh0xAQUkvAHYAh2GAgEFJLwB2AIdpwMhA5cDAP4FAQOX" +
+	"AwCABgEDlwMAlxYBA5cDAJkGAQOXAwDzPAEDlwMA+AcBA5cDANQDAQOXAwDRAwED" +
+	"lwMB2AQBA5cDAO0GAQOXAwDYZQEDlwMA/UEBA5cDAIdCAQOXAwC4BgEDlwMA5AcB" +
+	"A5cDANAGAQOXAwCbBgEDlwMA2mMBA5cDALQZAQOXAwC9BgEDlwMA3DUBA5cDAL5d" +
+	"AQOXAwDfBQEDlwMA8QIBA5cDAKMGAQOXAwCSBwEDlwMA/yQBA5cDALEDAQOXAwCf" +
+	"BwEDlwMAogYBA5cDAK8JAQOXAwDtYwEDlwMA11IBA5cDAIcHAQOXAwCYBAEDlwMA" +
+	"9VoBA5cDAJAGAQSXA9MFKiYBBJcD0wUqOQUDlwMdMQEDlwMdVQEDlwMdhgIBA5cD" +
+	"HacDAQSXAx0xhgEBA5cDxAHvAgEDlwPEAeUQAQaXA8QBMzukCjsBBZcDxAE7pAo7" +
+	"AQOXA8QBOwEElwPEATaLAQEElwPEAb8CvwgKA4IDABQBA4IDAO0BAQOCAwCQDQED" +
+	"ggMA+iQBA4IDAPMDAQOCAwC9FQEDggMAlQQBA4IDAMcVAQOCAwA4AQOCAwDMAwED" +
+	"ggMANQEDggMAqgMBA4IDADoBA4IDAJICAQOCAwAwAQOCAwCqAgEDggMAvAUBA4ID" +
+	"ALsLAQOCAwC3HwEDggMA9WMBA4IDAIUIAQOCAwD5EAEDggMA7QUBA4IDALAIAQOC" +
+	"AwCjBwEDggMA+gMBA4IDAMYDAQOCAwClBQEDggMA3wsBA4IDAPQMAQOCAwCxAwED" +
+	"ggMAnwcBA4IDAK4GAQOCAwCeDQEDggMAkhsBA4IDALRMAQOCAwCzTAEDggMAiAQB" +
+	"A4IDAJ0SAQOCAwCYTQEDggMAkAQBA4IDAIk/AQOCAwCOZQEDggMAqwYBA4IDAJMG" +
+	"AQOCAwD2BQEDggMAowMBA4IDALAFAQOCAwC1BQEDggMAugMBA4IDAK0GAQOCAwDX" +
+	"WQEDggMA9FoBA4IDANQDBAOCA9MFKgEH7ww2iwGSATE7xAEBBwM2iwGSATE7xAEB" +
+	"B+EBNosBkgExO8QBAQfhATE7kgE2iwHEAQEH4QE26xySATGfAcQBAQfhATGfAZIB" +
+	"NuscxAEBBPgCuwQA/gUBBPgCuwQAgAYBBPgCuwQAmQYBBPgCuwQA+AcBBPgCuwQA" +
+	"1AMBBPgCuwQA0QMBBPgCuwQA7QYBBPgCuwQAuAYBBPgCuwQA5AcBBPgCuwQA0AYB" +
+	"BPgCuwQAmwYBBPgCuwQAvQYBBPgCuwQA3wUBBPgCuwQA8QIBBPgCuwQAowYBBPgC" +
+	"uwQAkgcBBPgCuwQAsQMBBPgCuwQAogYBBPgCuwQArwkBBPgCuwQAhwcBBPgCuwQA" +
+	"mAQBBPgCuwQAkAYDBPgCuwQdMQEE+AK7BB02AQT4ArsEHUEBBPgCuwQdTgEE+AK7" +
+	"BB2GAgEE+AK7BB2nAwEF+AK7BB0xhgEBBfgCuwQdMasBAQT4Av8CAP4FAQT4Av8C" +
+	"AIAGAQT4Av8CAJkGAQT4Av8CAPgHAQT4Av8CANQDAQT4Av8CAKEfAQT4Av8CAO0G" +
+	"AQT4Av8CALgGAQT4Av8CAOQHAQT4Av8CANAGAQT4Av8CAJsGAQT4Av8CAL0GAQT4" +
+	"Av8CANAaAQT4Av8CAPECAQT4Av8CAKMGAQT4Av8CALEDAQT4Av8CAKIGAQT4Av8C" +
+	"AJgEAQT4Av8CAJAGBgT4Av8CHTEBBPgC/wIdNgEE+AL/Ah1BAQT4Av8CHU4BBPgC" +
+	"/wIdhgIBBPgC/wIdpwMBBfgC/wIdMYYBAQX4Av8CHTGrAQEEygT/AgD+BQEEygT/" +
+	"AgCABgEEygT/AgCZBgEEygT/AgD4BwEEygT/AgDUAwEEygT/AgChHwEEygT/AgDt" +
+	"BgEEygT/AgC4BgEEygT/AgDQBgEEygT/AgCbBgEEygT/AgC9BgEEygT/AgDQGgEE" +
+	"ygT/AgDxAgEEygT/AgCjBgEEygT/AgCxAwEEygT/AgCiBgEEygT/AgCYBAEEygT/" +
+	"AgCQBggEygT/AvADKgEFygT/AqAB8AMqAQbKBP8CTosBApEDAQbKBP8CTosBAjsN" +
+	"BMoE/wIdMQEEygT/Ah02AQTKBP8CHUEBBMoE/wIdTgEEygT/Ah2GAgEEygT/Ah2n" +
+	"AwEFygT/Ah0xhgFRBSStAgDOAxQBBSStAgCzBBQBBSStAgCzBJUEAQUkrQIAzgM6" +
+	"AQUkrQIAswQ6AQUkrQIAswQ4AQUkrQIAzgM1AQUkrQIAzgPEAwEFJK0CALMExAMB" +
+	"BSStAgDOA7cFAQUkrQIAswS3BQEFJK0CAM4DxRUBBSStAgCzBMUVAQUkrQIAzgOM" +
+	"DQEFJK0CALMEjA0BBSStAgDOA8gVAQUkrQIAswTIFQEFJK0CAM4D0gkBBSStAgCz" +
+	"BNIJAQUkrQIAzgPpJQEFJK0CAM4D7AsBBSStAgCzBOwLAQUkrQIAzgORBwEFJK0C" +
+	"ALMEkQcBBSStAgDOA8wVAQUkrQIAswTMFQEFJK0CAM4DyRUBBSStAgCzBMkVAQUk" +
+	"rQIAzgPOGwEFJK0CALMEzhsBBSStAgDOA/0FAQUkrQIAswT9BQEFJK0CAM4D6yUB" +
+	"BSStAgDOA7g5AQUkrQIAzgPoBQEFJK0CAM4D8wMBBSStAgDOA6gKAQUkrQIAswSo" +
+	"CgEFJK0CAM4D0Q0BBSStAgCzBNENAQUkrQIAzgPQDQEFJK0CALME0A0BBSStAgDO" +
+	"A84KAQUkrQIAswTOCgEFJK0CALME7gYBBSStAgDOA50HAQUkrQIAswTsJQEFJK0C" +
+	"AM4D0g0BBSStAgDOA6QSAQUkrQIAzgOSEQEFJK0CAM4D5wsBBSStAgCzBOcLAQUk" +
+	"rQIAzgPHCwEFJK0CALMExwsBBSStAgDOA50RAQUkrQIAswSdEQEFJK0CAM4DpxIB" +
+	"BSStAgCzBKcSAQUkrQIAzgPnDwEFJK0CALME5w8BBSStAgDOA+0lAQUkrQIAzgPR" +
+	"BgEFJK0CAM4D7yUBBSStAgDOA/oCAQUkrQIAswT6AgEFJK0CAM4D3AUBBSStAgCz" +
+	"BNwFAQUkrQIAzgPZBAEFJK0CALME2QQBBSStAgDOA8sVAQUkrQIAswTLFQEFJK0C" +
+	"AM4D0Q4BBSStAgDOA6kpOAUktQEFABQBBSS1AQUA7QEBBSS1AQUA0BABBSS1AQUA" +
+	"2BUBBSS1AQUA5wsBBSS1AQUA0A0BBSS1AQUA0RcBBSS1AQUA0hcBBSS1AQUAGAEF" +
+	"JLUBBQA4AQYktQEFAJgQOAEFJLUBBQDMAwEFJLUBBQD8BwEFJLUBBQDTFwEFJLUB" +
+	"BQDVFwEFJLUBBQD9BwEFJLUBBQA6AQUktQEFAJICAQUktQEFAN0XAQUktQEFAM0K" +
+	"AQUktQEFAKsKAQUktQEFAIUfAQUktQEFAJkFAQUktQEFAN8XAQUktQEFAOgFAQUk" +
+	"tQEFAPwCAQUktQEFAM4KAQUktQEFADUBBSS1AQUAqgMBBiS1AQUAxQ7EAwEGJLUB" +
+	"BQCDD8QDAQUktQEFAM0bAQUktQEFANINAQUktQEFAOEXAQUktQEFALYGAQYktQEF" +
+	"ALUCtgYBBSS1AQUAxgcBBSS1AQUA7xcBBSS1AQUA9wYBBSS1AQUA0Q0BBSS1AQUA" +
+	"8RcBBSS1AQUAzAoBBSS1AQUAMAEFJLUBBQCqAgEGJLUBBQDFDqAFAQYktQEFAIMP" +
+	"oAUBBSS1AQUA1xABBSS1AQUA7AsBBSS1AQUA9hcBBiS1AQUA5RcBAQUktQEFAMYP" +
+	"DgUktQEDABQBBSS1AQMA7QEBBSS1AQMA0BABBSS1AQMA2BUBBSS1AQMA5wsBBSS1" +
+	"AQMA0A0BBSS1AQMA0RcBBSS1AQMA0hcBBSS1AQMAGAEFJLUBAwA4AQYktQEDAJgQ" +
+	"OAEFJLUBAwDMAwEFJLUBAwD8BwEFJLUBAwDTFwEFJLUBAwDVFwEFJLUBAwD9BwEF" +
+	"JLUBAwA6AQUktQEDAJICAQUktQEDAN0XAQUktQEDAM0KAQUktQEDAKsKAQUktQED" +
+	"AIUfAQUktQEDAJkFAQUktQEDAN8XAQUktQEDAOgFAQUktQEDAPwCAQUktQEDAM4K" +
+	"AQUktQEDADUBBSS1AQMAqgMBBiS1AQMAxQ7EAwEGJLUBAwCDD8QDAQUktQEDAM0b" +
+	"AQUktQEDANINAQUktQEDAOEXAQUktQEDALYGAQYktQEDALUCtgYBBSS1AQMAxgcB" +
+	"BSS1AQMA7xcBBSS1AQMA9wYBBSS1AQMA0Q0BBSS1AQMA8RcBBSS1AQMAzAoBBSS1" +
+	"AQMAMAEFJLUBAwCqAgEGJLUBAwDFDqAFAQYktQEDAIMPoAUBBSS1AQMA1xABBSS1" +
+	"AQMA7AsBBSS1AQMA9hcBBiS1AQMA5RcBAQUktQEDAMYPCAQktQEdMQEEJLUBHVUB" +
+	"BCS1AR2GAgEEJLUBHYUEAQUktQEdMYYBAQUktQEdMasBAQScA5gDABQBBJwDmAMA" +
+	"4AEBBJwDmAMAlwEBBJwDmAMAtAEBBJwDmAMAmgMBBJwDmAMApQIBBJwDmAMAqwIB" +
+	"BJwDmAMAggEBBJwDmAMA0QIBBJwDmAMA0wMBBJwDmAMAvwEBBJwDmAMAmwMBBJwD" +
+	"mAMAnAEBBJwDmAMA0AUBBJwDmAMAnwMBBJwDmAMAuAEBBJwDmAMAqAIBBJwDmAMA" +
+	"WgEEnAOYAwDDAQEEnAOYAwCpAQEEnAOYAwCoAQEEnAOYAwCtAQEEnAOYAwCvAgEF" +
+	"nAOYAwC4Ga8CAQScA5gDALMBAQWcA5gDALgZswEBBJwDmAMAoQIBBJwDmAMA0wIB" +
+	"BJwDmAMAhQMBBJwDmAMQFAEEnAOYAxA6AQScA5gDEDABBJwDmAMQOAEEnAOYAxA1" +
+	"AQScA5gDKuNVAQWcA5gDKq0BvEMBBJwDmAMBiz4BBJwDmAMB8FwBBJwDmAMB+lwB" +
+	"BJwDmAMBh10JBJwDmAMO4wEBBJwDmAMOMQEEnAOYAw42AQScA5gDDkEBBJwDmAMO" +
+	"TgEEnAOYAw5VAQScA5gDDnUBBJwDmAMOgAEBBJwDmAMOdAEEnAOYAw6IAacCA68E" +
+	"DjEBA68EDjYBA68EDkEBA68EDk4BA68EDlUBA68EDnUBA68EDoABAQOvBA50AQOv" +
+	"BA6IAQEDrwQdhgIBA68EHacDAQOvBB29BAEDrwQd3AQBA68EHYUEAQOvBB2OBgED" +
+	"rwQdkwQBA68EHbMGAQOvBB3tAwEErwQdMYYBAQSvBB02hgEBBK8EHUGGAQEErwQd" +
+	"ToYBAQSvBB1VhgEBBK8EHXWGAQEErwQdgAGGAQEErwQddIYBAQSvBB2IAYYBAQSv" +
+	"BMwBMW4BBK8EzAEx7gMBBK8EzAExjQcBBK8EzAE24QgCA7QDALc5AQO0AwCuBgED" +
+	"tAMAsQMBA7QDALsSAQO0AwDGAwEDtAMAsAUBA7QDAJYGAQO0AwD3KwEDtAMA8SsB" +
+	"A7QDAMYIAQO0AwDOBgEDtAMA2QUBA7QDANgPAQO0AwCcAQEDtAMA2AgBA7QDAJ8D" +
+	"AQO0AwClAgEDtAMAlw8BA7QDAJgEAQO0AwCSBAEDtAMAnQQBA7QDALQBAQO0AwC1" +
+	"BQEDtAMA9RcBA7QDAOFjAQO0AwDTAwEDtAMAhQMBBbQDAIUD2AMPAQO0AwCBBAED" +
+	"tAMAnQMBA7QDALFDAQO0AwCECAEDtAMApgIBA7QDALUOAQO0AwDxAgEDtAMA4wgB" +
+	"A7QDANEDAQO0AwC+DAEDtAMA2BABA7QDAJQ+AQO0AwCHDQEDtAMA9wYCBLQDH5EC" +
+	"KgEEtAMf1AcqAQO0A/MYKgMGtAMApgICOyYBBrQDAIcNAosCJk8EJPsBAP4FAQUk" +
+	"+wEAMv4FAQQk+wEAgAYBBST7AQAygAYBBCT7AQCZBgEEJPsBANQDAQUk+wEAMtQD" +
+	"AQQk+wEA0QMBBCT7AQDtBgEEJPsBALgGAQQk+wEA0AYBBCT7AQCbBgEEJPsBAL0G" +
+	"AQQk+wEA3wUBBCT7AQDxAgEFJPsBADLxAgEIJPsBADLxAgJT6QIBBCT7AQCjBgEE" +
+	"JPsBAJIHAQUk+wEA2AOSBwEEJPsBALEDAQQk+wEAogYBBST7AQAyogYBCCT7AQAy" +
+	"ogYCU+kCAQQk+wEA/lMBBCT7AQCYBAEEJPsBAJAGAQUk+wEAMpAGAQgk+wEAMpAG" +
+	"AlPpAgEEJPsBHTEBBCT7AR02AQQk+wEdQQEEJPsBHU4BBCT7AR1VAQQk+wEdhgIB" +
+	"BCT7AR2nAwEEJPsBHb0EAQUk+wEdMYYBAQUk+wHMATFuAQQk+wEguScJA/sBAP4F" +
+	"AQP7AQCABgED+wEAmQYBA/sBANQDAQP7AQDRAwED+wEA7QYBA/sBALgGAQP7AQDQ" +
+	"BgED+wEAmwYBA/sBAL0GAQP7AQDfBQED+wEA8QIBA/sBAKMGAQP7AQCSBwED+wEA" +
+	"sQMBA/sBAKIGAQP7AQD9UwED+wEAmAQBA/sBAJAGAQP7AQDvOgED+wEA7RkBA/sB" +
+	"jwWYBAEE+wEfOzkBBfsBHzaLATkBBPsBHzsmAQX7AR82iwEmAQT7AR/nAyYBBPsB" +
+	"H+cDOQEE+wEfUiYBBPsBH1I5AQX7AR+qAVI5AQT7AR+HBzkBBPsBH0g5AQP7AR0x" +
+	"AQP7AR2GAgED+wEdpwMBBPsBHTGGAQEF+wHEATZTygsBB/sBxAE2U8oLAosBAQX7" +
+	"AcQBeAI7AQb7AcQBNoIGAosBAQb7AcQBbngCOxcEJPwEAP4FAQQk/AQAgAYBBCT8" +
+	"BACCPQEEJPwEANEDAQQk/AQA7QYBBST8BAAyuAYBBCT8BADQBgEEJPwEAJsGAQQk" +
+	"/AQAvQYBBCT8BADfBQEEJPwEAPECAQQk/AQAowYBBCT8BACxAwEEJPwEAKIGAQQk" +
+	"/AQAhwcBBCT8BACYBAEEJPwEAJAGAQQk/AQA7RkBBST8BB87JgEFJPwEHzs5AQYk" +
+	"/AQfNosBJgEGJPwEHzaLATkBBCT8BMQBdwEFJPwExAE2ygsBBST8BMQBNosBAQUk" +
+	"/ATEAU6LAScD0AQA/gUBBNAEAAP+BQED0AQAgAYBA9AEAJkGAQPQBAD4BwED0AQA" +
+	"1AMBA9AEANEDAQTQBACCNNEDAQPQBADtBgED0AQAuAYBA9AEANAGAQPQBACbBgED" +
+	"0AQAvQYBA9AEAN8FAQPQBADxAgED0AQAowYBA9AEAJIHAQPQBACxAwED0AQAhwcB" +
+	"A9AEAJgEAQPQBACQBgED0AQdMQED0AQdNgED0AQdQQED0AQdTgED0AQdhgIBA9AE" +
+	"HacDAQTQBB0xhgEVA5oFAP4FAQOaBQCABgEDmgUAmQYBA5oFAPgHAQOaBQDUAwED" +
+	"mgUA0QMBA5oFAO0GAQOaBQC4BgEDmgUA5AcBA5oFANAGAQOaBQCbBgEDmgUAvQYB" +
+	"A5oFAN8FAQOaBQDxAgEDmgUAowYBA5oFAJIHAQOaBQCxAwEDmgUAogYBA5oFAK8J" +
+	"AQOaBQCHBwEDmgUAmAQBA5oFAJAGAQOaBSDKZQoDpwEBjQQBA6cBAYwDAQOnAQHx" +
+	"AwEDpwEB7AoBA6cBAbMLAQOnAQAUAQOnAQDtAQEDpwEAOgEDpwEAkgIBA6cBADAB" +
+	"A6cBAKoCAQSnAQB7YgEEpwEAe+IDAQSnAQB7bwEEpwEAe4cEAQOnAQA4AQOnAQCK" +
+	"AgEDpwEANQEDpwEAogIBA6cBAFoBA6cBANECAQOnAQDDAQEDpwEArwMBA6cBAKEC" +
+	"AQOnAQCrAgEDpwEAzgIBA6cBAKUCAQOnAQD/AwEDpwEA0wIBA6cBAJoDAQOnAQDQ" +
+	"AwEDpwEAmwMBA6cBANsDAQOnAQC/AwEDpwEAtAEBA6cBAOoCAQOnAQC/AQEDpwEA" +
+	"qQMBA6cBAK0BAQOnAQCXAQEDpwEAhAMBA6cBAOABAQOnAQDBAwEDpwEAqAEBA6cB" +
+	"ALMBAQOnAQCcAQEDpwEAqQEBA6cBAIUDAQOnAQCoAgEDpwEA4wMBA6cBALgBAQOn" +
+	"AQCCAQEDpwEA4AQBBacBACSPAfkKAQWnAQAkjwHQBQEFpwEAJI8BtAwBBKcBEAHt" +
+	"AQEFpwEQAeYp7QEBBKcBEAE6AQSnARABkgIBBKcBEAEwAQSnARABqgIBBacBEAF7" +
+	"YgEFpwEQAXviAwEFpwEQAXtvAQWnARABe4cEAQSnARABOAEEpwEQAYoCAQSnARAB" +
+	"NQEEpwEQAaICAQKnAdYDAQKnAbcEAQOnATO3BAEDpwHEATsBBKcBxAEzOwEDpwHE" +
+	"Ab8CAQSnAcQBwQp3AQOnAcQBqg4FA6cBHTEBA6cBHTYBA6cBHUEBA6cBHU4BA6cB" +
+	"HVUBA6cBHXUBA6cBHYABAQOnAR10AQOnAR2IAQEDpwEdhgIBA6cBHacDAQOnAR29" +
+	"BAEDpwEd3AQBA6cBHYUEAQOnAR2OBgEDpwEdkwQBA6cBHbMGAQOnAR3tAwEEpwEd" +
+	"MYYBAQSnAR0xqwEBA6cBDuMBAQOnAQ4xAQOnAQ42AQOnAQ5BAQOnAQ5OAQOnAQ5V" +
+	"AQOnAQ51AQOnAQ6AAQEDpwEOdAEDpwEOiAEBBacBASSPAdYDAQanAQAkjwG1AjgB" +
+	"BqcBACSPAbUCNQEHpwEQASSPAbUCOAEHpwEQASSPAbUCNQEFpwEAJI8B4AQKA6cB" +
+	"HfIJAQPFAgGNBAEDxQIBjAMBA8UCAfEDAQPFAgAUAQPFAgDtAQEDxQIAOgEDxQIA" +
+	"kgIBA8UCADABA8UCAKoCAQPFAgA4AQPFAgCKAgEDxQIANQEDxQIAogIBA8UCAFoB" +
+	"A8UCANECAQPFAgDDAQEDxQIArwMBA8UCAKECAQPFAgCrAgEDxQIAzgIBA8UCAKUC" +
+	"AQPFAgD/AwEDxQIA0wIBA8UCAJoDAQPFAgDQAwEDxQIAmwMBA8UCAJQXAQPFAgDb" +
+	"AwEDxQIA2AgBA8UCAL8DAQPFAgC0AQEDxQIA6gIBA8UCAL8BAQPFAgCpAwEDxQIA" +
+	"rQEBA8UCAJcBAQPFAgCEAwEDxQIA4AEBA8UCAMEDAQPFAgCoAQEDxQIAswEBA8UC" +
+	"AJwBAQPFAgCpAQEDxQIAhQMBA8UCAKgCAQPFAgDjAwEDxQIAuAEBA8UCAIIBAQTF" +
+	"AhAB7QEBBMUCEAE6AQTFAhABkgIBBMUCEAEwAQTFAhABqgIBBMUCEAE4AQTFAhAB" +
+	"igIBBMUCEAE1AQTFAhABogIBA8UCAdYDAQPFAgGDBQEDxQLTBQEBA8UCyzkBAQPF" +
+	"Ah0BAQPFAvADKgEExQIz8AMqAQLFArcEAQPFAjO3BAEFxQIQAXtiCwTFAh0BJgME" +
+	"iwSKBACAHQEEiwSKBADlAwEEiwSKBACTKQEEiwSKBACBKwEEiwSKBAD6NAEEiwSK" +
+	"BACYGAEEiwSKBAC+GgEEiwSKBAD1SwEEiwSKBACERQEEiwSKBAD9EQEEiwSKBAD7" +
+	"AwEEiwSKBACVUAEEiwSKBADRDwEEiwSKBAC/UwEEiwSKBAClAQEEiwSKBAChGQEE" +
+	"iwSKBAD2QQEEiwSKBAChTgEEiwSKBACQBwEEiwSKBAChOQEEiwSKBACUEQEEiwSK" +
+	"BADnEwEEiwSKBAC4DAEEiwSKBAD9BwEEiwSKBAC7GggEiwSKBA7jAQEEiwSKBA4x" +
+	"AQSLBIoEDjYBBIsEigQOQQEEiwSKBA5OAQSLBIoEDlUBBIsEigQOdQEEiwSKBA6A" +
+	"AQEEiwSKBA50AQSLBIoEDogBBwO4AgGNBAEDuAIBjAMBA7gCAfEDAQO4AgDtAQED" +
+	"uAIAOgEDuAIAMAEDuAIAOAEDuAIAiA4BA7gCALAZAQO4AgD2CwEDuAIArxgBA7gC" +
+	"AMAOAQO4AgCKEAEDuAIA2BYBA7gCAI4ZAQO4AgCFQgEDuAIAxBsBA7gCAOIeAQO4" +
+	"AgDgEwEDuAIAkxcBA7gCAJYXAQO4AgCXTQEDuAIAkwoBA7gCAOwMAQO4AgCBFwED" +
+	"uAIA1jUBA7gCAP8KAQO4AgDTDgEDuAIAhhwBA7gCAJcNAQO4AgDkKQEDuAIA1hEB" +
+	"A7gCAI1lAQO4AgC8CwEDuAIA0gwBA7gCAJoMAQO4AgD9DAEDuAIAhQ8BA7gCAMsH" +
+	"AQS4AhABFAEEuAIQAToBBLgCEAGSAgEEuAIQATABBLgCEAGqAgEEuAIQATgBBLgC" +
+	"EAGKAgEEuAIQATUBBLgCEAGiAgEEuAIQAbkMAQO4AjUqAQO4AqICKgECuALWAwEC" +
+	"uAKRSAIDuAIO4wEBA7gCDjEBA7gCDjYBA7gCDkEBA7gCDk4BA7gCDlUBA7gCDnUB" +
+	"A7gCDoABAQO4Ag50AQO4Ag6IAQEDuALwAyoBArgCtwQBA7gCM7cEAQO4AssEKgED" +
+	"uAIA8RkBBLgCEAHtAQEEuAIQAboIAQO4AgCNHwkD6AMAFAED6AMAOgED6AMAMAED" +
+	"6AMAOAED6AMANQED6AMAWgED6AMA0QIBA+gDAMMBAQPoAwCvAwED6AMAqwIBA+gD" +
+	"AM4CAQPoAwClAgED6AMA/wMBA+gDANMCAQPoAwCaAwED6AMA0AMBA+gDAJsDAQPo" +
+	"AwDbAwED6AMAvwMBA+gDALQBAQPoAwDqAgED6AMAvwEBA+gDAKkDAQPoAwCtAQED" +
+	"6AMAlwEBA+gDAIQDAQPoAwDgAQED6AMAwQMBA+gDAKgBAQPoAwCcAQED6AMAqQEB" +
+	"A+gDAIUDAQPoAwC4AQED6AMAggEBA+gDANAFAQPoAwGDBQED6APTBQEBA+gD8AMq" +
+	"AQPoAyCoHQoD2QEBjQQBA9kBAYwDAQPZAQHxAwED2QEAFAED2QEA7QEBA9kBADoB" +
+	"A9kBAJICAQPZAQAwAQPZAQCqAgEE2QEAe2IBBNkBAHviAwEE2QEAe28BBNkBAHuH" +
+	"BAED2QEAOAED2QEAigIBA9kBADUBA9kBAKICAQPZAQBaAQPZAQDRAgED2QEAwwEB" +
+	"A9kBAK8DAQPZAQChAgED2QEAqwIBA9kBAM4CAQPZAQClAgED2QEA/wMBA9kBANMC" +
+	"AQPZAQCaAwED2QEA0AMBA9kBAJsDAQPZAQDbAwED2QEAvwMBA9kBALQBAQPZAQDq" +
+	"AgED2QEAvwEBA9kBAKkDAQPZAQCtAQED2QEAlwEBA9kBAIQDAQPZAQDgAQED2QEA" +
+	"wQMBA9kBAKgBAQPZAQCzAQED2QEAnAEBA9kBAKkBAQPZAQDgBAED2QEAhQMBA9kB" +
+	"AKgCAQPZAQDjAwED2QEAuAEBA9kBAIIBAQTZARAB7QEBBNkBEAE6AQTZARABkgIB" +
+	"BNkBEAEwAQTZARABqgIBBdkBEAF7YgEF2QEQAXviAwEF2QEQAXtvAQXZARABe4cE" +
+	"AQTZARABOAEE2QEQAYoCAQTZARABNQEE2QEQAaICAQPZAQHWAwED2QEBlQYBA9kB" +
+	"AewKAQPZAQGzCwEC2QH/BgEC2QG3BAED2QEztwQBA9kB0wUBAQLZAfUEAQPZAdkI" +
+	"KgED2QEBgwUBBNkBED0qAQXZAf05tQIQKgED2QHwWyoBBdkBEAHQEjgBBNkBAc8C" +
+	"jQQBA9kBDuMBAQPZAQ4xAQPZAQ42AQPZAQ5BAQPZAQ5OAQPZAQ5VAQPZAQ51AQPZ" +
+	"AQ6AAQED2QEOdAED2QEOiAEBAtkBrDkBA9kBAdABAQLZAYYRAQPZAc0zAQED2QHw" +
+	"A6AMAQPZAfADoQwCBLIB2QMOMQEEsgHZAw42AQSyAdkDDkEBBLIB2QMOTgEEsgHZ" +
+	"Aw5VAQSyAdkDDnUBBLIB2QMOgAEBBLIB2QMOdAEEsgHZAw6IAQEEsgHZAx2GAgEE" +
+	"sgHZAx2nAwEEsgHZAx29BAEEsgHZAx3cBAEEsgHZAx2FBAEEsgHZAx2OBgEEsgHZ" +
+	"Ax2TBAEEsgHZAx2zBgEEsgHZAx3tAwEFsgHZAx0xhgEBBbIB2QMdMasBDAPhAgAU" +
+	"AQPhAgDtAQED4QIAOgED4QIAMAED4QIAOAED4QIAigIBA+ECADUBA+ECAKICAQPh" +
+	"AgBaAQPhAgDRAgED4QIAwwEBA+ECANkKAQPhAgCvAwED4QIAoQIBA+ECAKsCAQPh" +
+	"AgDOAgED4QIApQIBA+ECAI0MAgPhAgDTAgED4QIAmgMBA+ECANADAQPhAgCbAwED" +
+	"4QIA2wMBA+ECAL8DAQPhAgC0AQED4QIA6gIBA+ECAL8BAQPhAgC4DQED4QIAqQMB" +
+	"A+ECAK0BAQPhAgCXAQED4QIAhAMBA+ECAOABAQPhAgDXCQED4QIAwQMBA+ECAKgB" +
+	"AQPhAgCzAQED4QIAnAEBA+ECAKkBAQPhAgCFAwED4QIAuAEBA+ECAIIBAQPhAgDg" +
+	"BAEE4QIQAe0BAQThAhABOgEE4QIQAZICAQThAhABMAEE4QIQATgBBOECEAGKAgEE" +
+	"4QIQATUBBOECEAGiAgED4QIBjAMBA+ECAdYDAQPhAgGDBQED4QIB5AYBAuECtwQB" +
+	"A+ECM7cEAQPhAqEF9QQBA+EC8AMqAQThAjPwAyoBA+EC0wUBAQPhAgG9CUID7AMA" +
+	"FAED7AMAOgED7AMAMAED7AMAOAED7AMAWgED7AMA0QIBA+wDAMMBAgPsAwCvAwID" +
+	"7AMAqwIBA+wDAM4CAQPsAwClAgED7AMAjQwCA+wDANMCAQPsAwCaAwED7AMA0AMB" +
+	"A+wDAJsDAQPsAwC4DQED7AMA2wMBA+wDAL8DAQPsAwC0AQED7AMA6gIBA+wDAL8B" +
+	"AQPsAwCpAwED7AMArQEBA+wDAJcBAQPsAwCEAwED7AMA4AECA+wDAMEDAQPsAwCo" +
+	"AQED7AMAswEBA+wDAJwBAQPsAwCpAQED7AMAhQMBA+wDALgBAQPsAwCCAQED7AMA" +
+	"0AUBA+wDANgIAQPsA/ADKgcDwQIAFAEDwQIA7QEBA8ECADoBA8ECAJICAQPBAgAw" +
+	"AQPBAgCqAgEDwQIAOAEDwQIAigIBA8ECADUBA8ECAKICAQPBAgBaAQPBAgDRAgED" +
+	"wQIAwwEBA8ECANkKAQPBAgCvAwEDwQIAoQIBA8ECAKsCAQPBAgDOAgEDwQIApQIB" +
+	"A8ECAI0MAQPBAgD/AwEDwQIA0wIBA8ECAJoDAQPBAgDQAwEDwQIAmwMBA8ECALgN" +
+	"AQPBAgDQBQEDwQIA2wMBA8ECAL8DAQPBAgC0AQEDwQIA6gIBA8ECAL8BAQPBAgCp" +
+	"AwEDwQIArQEBA8ECAJcBAQPBAgCEAwEDwQIA4AEBA8ECANcJAQPBAgDBAwEDwQIA" +
+	"qAEBA8ECALMBAQPBAgCcAQEDwQIAqQEBA8ECAIUDAQPBAgCoAgEDwQIAuAEBA8EC" +
+	"AIIBAQPBAgGMAwEEwQIQAe0BAQTBAhABOgEEwQIQAZICAQTBAhABMAEEwQIQAaoC" +
+	"AQTBAhABOAEEwQIQAYoCAQTBAhABNQEEwQIQAaICAQPBAgGDBQEDwQIB1gMGA8EC" +
+	"DuMBAQPBAg4xAQPBAg42AQPBAg5BAQPBAg5OAQPBAg5VAQPBAg51AQPBAg6AAQED" +
+	"wQIOdAEDwQIOiAEHBfkBAR+MAyYBA/kBAY0EAQP5AQGMAwED+QEB8QMCA/kBABQB" +
+	"A/kBAO0BAQP5AQA6AQP5AQCSAgED+QEAMAED+QEAqgIBBPkBAHtiAQT5AQB7bwMD" +
+	"+QEAzAMBA/kBAIoCAwP5AQCqAwED+QEAogIBA/kBAFoBA/kBANECAQP5AQDDAQED" +
+	"+QEArwMBA/kBAKECAQP5AQCrAgED+QEAzgIBA/kBAKUCAQP5AQD/AwED+QEA0wIB" +
+	"A/kBAJoDAQP5AQDQAwED+QEAmwMBA/kBANsDAQP5AQC/AwED+QEAtAEBA/kBAOoC" +
+	"AQP5AQC/AQED+QEAqQMBA/kBAK0BAgP5AQCXAQED+QEAhAMBA/kBAOABAQP5AQDB" +
+	"AwED+QEAqAEBA/kBALMBAQP5AQCcAQID+QEAqQEBA/kBAOAEAgP5AQCFAwED+QEA" +
+	"qAIBA/kBAOMDAQP5AQC4AQED+QEAggECAx/wBjkBA/kBAYMFAQP5AQGVBgEE+QEQ" +
+	"Ae0BAQT5ARABOgEE+QEQAZICAQT5ARABMAEE+QEQAaoCAQX5ARABe2IBBfkBEAF7" +
+	"4gMDBPkBEAHMAwEE+QEQAYoCAwT5ARABqgMBBPkBEAGiAgED+QEB1gMDAvkB/wYH" +
+	"BPkBogK+BioGA/kBAaAcAQT5AQCtA4wDAQX5AQCtAzOMAwEE+QEAe+IDAQT5AQB7" +
+	"hwQBBfkBEAF7bwEF+QEQAXuHBAMEH/kBDuMBAQQf+QEOMQEEH/kBDjYBBB/5AQ5B" +
+	"AQQf+QEOTgEEH/kBDlUBBB/5AQ51BAQf+QEAFAEEH/kBAFoBBB/5AQCtAQEEH/kB" +
+	"AOYIAQQf+QEAlwGMAQPVAQAUAQPVAQDtAQED1QEAOgED1QEAkgIBA9UBADABA9UB" +
+	"AKoCAQTVAQB7YgEE1QEAe+IDAQTVAQB7bwEE1QEAe4cEAQPVAQA4AQPVAQCKAgED" +
+	"1QEANQED1QEAogIBA9UBAFoBA9UBANECAQPVAQDDAQED1QEArwMBA9UBAKECAQPV" +
+	"AQCZGwED1QEAqwIBA9UBAM4CAQPVAQClAgED1QEA/wMBA9UBANMCAQPVAQDGGwED" +
+	"1QEAmgMBA9UBANADAQPVAQCbAwED1QEA2wMBA9UBAL8DAQPVAQC0AQED1QEA6gIB" +
+	"A9UBAL8BAQPVAQCpAwED1QEArQEBA9UBAIQLAQPVAQCXAQED1QEAhAMBA9UBAOAB" +
+	"AQPVAQDBAwED1QEAqAEBA9UBANgaAQPVAQCzAQED1QEAnAEBA9UBANgIAQPVAQCp" +
+	"AQED1QEAkwkBA9UBAK8CAQPVAQCoAgED1QEA4wMBA9UBALgBAQPVAQCCAQEE1QEQ" +
+	"Ae0BAQTVARABOgEE1QEQAZICAQTVARABMAEE1QEQAaoCAQXVARABe2IBBdUBEAF7" +
+	"4gMBBdUBEAF7bwEF1QEQAXuHBAEE1QEQATgBBNUBEAGKAgEE1QEQATUBBNUBEAGi" +
+	"AgED1QEB1gMBA9UBAY0EAQPVAQGMAwED1QEB8QMBA9UBAYMFAQPVAQGVBgEE1QEB" +
+	"MowDAQLVAf8GAQLVAeZZAQLVAbcEAQPVATO3BAEC1QHnAgED1QHYCr8IAQPVAdMF" +
+	"AQED1QEO4wEBA9UBDjEBA9UBDjYBA9UBDkEBA9UBDk4BA9UBDlUBA9UBDnUBA9UB" +
+	"DoABAQPVAQ50AQPVAQ6IAQED1QEz5wIBA9UBwhIqAgPVAZkRAQED1QHZCCoBBNUB" +
+	"AK0DjAMBA9UBAewKAQPVAQGzCx8CiQLkDwEDiQIAFAEDiQIA7QEBA4kCADoBA4kC" +
+	"AJICAQOJAgAwAQOJAgCqAgEEiQIAe2IBBIkCAHviAwEEiQIAe28BBIkCAHuHBAED" +
+	"iQIAOAEDiQIAigIBA4kCADUBA4kCAKICAQOJAgBaAQOJAgDRAgEDiQIAwwEBA4kC" +
+	"AK8DAQOJAgChAgEDiQIAqwIBA4kCAM4CAQOJAgClAgEDiQIA/wMBA4kCANMCAQOJ" +
+	"AgCaAwEDiQIA0AMBA4kCAJsDAQOJAgDbAwEDiQIAvwMBA4kCALQBAQOJAgDqAgED" +
+	"iQIAvwEBA4kCAKkDAQOJAgCtAQEDiQIAlwEBA4kCAIQDAQOJAgDgAQEDiQIAwQMB" +
+	"A4kCAKgBAQOJAgCzAQEDiQIAnAEBA4kCAKkBAQOJAgCFAwEDiQIAqAIBA4kCAOMD" +
+	"AQOJAgC4AQEDiQIAggEBBIkCEAHtAQEEiQIQAToBBIkCEAGSAgEEiQIQATABBIkC" +
+	"EAGqAgEFiQIQAXtiAQWJAhABe+IDAQWJAhABe28BBYkCEAF7hwQBBIkCEAE4AQWJ" +
+	"AhABtQI4AQSJAhABigIBBIkCEAE1AQWJAhABtQI1AQSJAhABogIBA4kCAY0EAQOJ" +
+	"AgGMAwEDiQIB8QMBA4kCAdYDAQOJAgGDBQEDiQIBlQYBAokCyT0BA4kC0wUBAQKJ" +
+	"Av8GCQOJAg7jAQEDiQIOMQEDiQIONgEDiQIOQQEDiQIOTgEDiQIOVQEDiQIOdQED" +
+	"iQIOgAEBA4kCDnQBA4kCDogBpwED0AEAFAED0AEA7QEBA9ABADoBA9ABAJICAQPQ" +
+	"AQAwAQPQAQCqAgEE0AEAe2IBBNABAHviAwEE0AEAe28BBNABAHuHBAED0AEAOAED" +
+	"0AEAigIBA9ABADUBA9ABAKICAQPQAQBaAQPQAQDRAgED0AEAwwEBA9ABAK8DAQPQ" +
+	"AQChAgED0AEAqwIBA9ABAM4CAQPQAQClAgED0AEA/wMBA9ABANMCAQPQAQCaAwED" +
+	"0AEA0AMBA9ABAJsDAQPQAQDbAwED0AEAvwMBA9ABALQBAQPQAQDqAgED0AEAvwEB" +
+	"A9ABAKkDAQPQAQCtAQED0AEAlwEBA9ABAIQDAQPQAQDgAQED0AEAwQMBA9ABAKgB" +
+	"AQPQAQCzAQED0AEAnAEBA9ABAKkBAQPQAQCFAwED0AEAqAIBA9ABAOMDAQPQAQC4" +
+	"AQED0AEAggEBBNABEAHtAQEE0AEQAToBBNABEAGSAgEE0AEQATABBNABEAGqAgEF" +
+	"0AEQAXtiAQXQARABe+IDAwTQARABOAEE0AEQAYoCAQTQARABNQEE0AEQAaICAQPQ" +
+	"AQGNBAED0AEBjAMBA9ABAfEDAQPQAQHWAwED0AEBgwUBA9ABAdABAQLQAbcEAQPQ" +
+	"ATO3BAED0AH1BDsBA9AB9QR3AQPQAdUMoAwBA9AB1QyhDAED0AHVDMMaAQXQAakE" +
+	"St8IKgEI0AHwAyoC8wwY+QzpTwEI0AHwAyoC8wwYywP3CAEI0AHwAyoC2gcYywP3" +
+	"CAEJ0AHwAyoC2gcYywMz9wgBCdAB8AMqAtoHGMsD8AL3CAEF0AHwAyoznwEBB9AB" +
+	"8AMqM58BAtoHAQbQAfADKgIz9wgBBtAB8AMqAvAC9wgBBtAB8AMqAtkH9wgBBtAB" +
+	"8AMqArNY9wgBB9AB8AMqAoIGGNoHAQjQAfADKgKCBhg24xcBCNAB8AMqAoIGGE7j" +
+	"FwEF0AEA1V3YAzoBBdABAOYT2AM6AQXQAQDmE9gDkgIBBNABANgDMAEF0AEQAdgD" +
+	"MAEF0AEQAdgDqgIjA5YCABQBA5YCAO0BAQOWAgA6AQOWAgCSAgEDlgIAMAEDlgIA" +
+	"qgIBBJYCAHtiAQSWAgB74gMBBJYCAHtvAQSWAgB7hwQBA5YCADgBA5YCAIoCAQOW" +
+	"AgA1AQOWAgCiAgEDlgIAWgEDlgIA0QIBA5YCAMMBAQOWAgCvAwEDlgIAoQIBA5YC" +
+	"AKsCAQOWAgDOAgEDlgIApQIBA5YCAP8DAQOWAgDTAgEDlgIAmgMBA5YCANADAQOW" +
+	"AgCbAwEDlgIA2wMBA5YCAL8DAQOWAgC0AQEDlgIA6gIBA5YCAL8BAQOWAgCpAwED" +
+	"lgIArQEBA5YCAJcBAQOWAgCEAwEDlgIA4AEBA5YCAMEDAQOWAgCoAQEDlgIAswEB" +
+	"A5YCAJwBAQOWAgCpAQEDlgIAhQMBA5YCAKgCAQOWAgDjAwEDlgIAuAEBA5YCAIIB" +
+	"AQOWAgDgBAEElgIQAe0BAQSWAhABOgEElgIQAZICAQSWAhABMAEElgIQAaoCAQWW" +
+	"AhABe2IBBZYCEAF74gMBBZYCEAF7bwEFlgIQAXuHBAEElgIQATgBBJYCEAGKAgEE" +
+	"lgIQATUBBJYCEAGiAgEDlgIBjAMBA5YCAfEDAQOWAgHWAwEDlgIB6SYBApYCtwQB" +
+	"A5YCM7cEAQOWAtMFAQEDlgIBmEAMA5YCDuMBAQOWAg4xAQOWAg42AQOWAg5BAQOW" +
+	"Ag5OAQOWAg5VAQOWAg51AQOWAg6AAQEDlgIOdAEDlgIOiAEHBGnSBgLXAgEDadsC" +
+	"0gYBBWkz0gYC1wIBBWnwAtIGAtcCAQVp0gYCM9cCAQVp2wLSBgLXAgEGadsC0gYC" +
+	"M9cCAQNpzwLSBgEGac8C0gYCM9cCAQNp6AzSBgEFaegM0gYC1wIBBmnoDNIGAjPX" +
+	"AgEHaaAB6AzSBgIz1wIUA80CABQBA80CAO0BAQPNAgA6AQPNAgCSAgEDzQIAMAED" +
+	"zQIAqgIBA80CADgBA80CAIoCAQPNAgA1AQPNAgCiAgEDzQIAWgEDzQIA0QIBA80C" +
+	"AMMBAQPNAgCvAwEDzQIAoQIBA80CAKsCAQPNAgDOAgEDzQIApQIBA80CAP8DAQPN" +
+	"AgDTAgEDzQIAmgMBA80CANADAQPNAgCbAwEDzQIA2wMBA80CAL8DAQPNAgC0AQED" +
+	"zQIA6gIBA80CAL8BAQPNAgCpAwEDzQIArQEBA80CAJcBAQPNAgCEAwEDzQIA4AEB" +
+	"A80CAMEDAQPNAgCoAQEDzQIAswEBA80CAJwBAQPNAgCpAQEDzQIAhQMBA80CAKgC" +
+	"AQPNAgC4AQEDzQIAggEBA80CANAFAQPNAgGMAwEDzQIB8QMBBM0CEAHtAQEEzQIQ" +
+	"AToBBM0CEAGSAgEEzQIQATABBM0CEAGqAgEEzQIQATgBBM0CEAGKAgEEzQIQATUB" +
+	"BM0CEAGiAgEDzQIB1gMBA80CAYMFAQTNAgDZA9ECAQPNAtMFAQcDzQIO4wEBA80C" +
+	"DjEBA80CDjYBA80CDkEBA80CDk4BA80CDlUBA80CDnUBA80CDoABAQPNAg50AQPN" +
+	"Ag6IATcD1AIAWgED1AIA0QIBA9QCAKECAQPUAgCtAQED1AIAtAEBBNQCANgDtAEB" +
+	"A9QCAJcBAQPUAgCEAwED1AIA4AEBA9QCAKgBAQPUAgClAgED1AIAzgIBA9QCAOoC" +
+	"AQPUAgCcAQED1AIAqQEBA9QCALgBAQPUAgDTAgED1AIAggEBA9QCABQBA9QCAL8B" +
+	"AQPUAgCpAwED1AIAwwEBBNQCANgDwwEBA9QCAK8DAQPUAgDBAwED1AIA/wMBBNQC" +
+	"ANgD4AEDBdQC8QEBzQGpAQEF1ALxAQHNAZwBAQbUAvEBAc0B+kWcAQEE1AIQARQB" +
+	"BNQCEAHtAQEE1AIQAToBBNQCEAGSAgEE1AIQATABBNQCEAGqAgEE1AIQATgBBNQC" +
+	"EAHsDwEE1AIQATUBBNQCEAGKAgEE1AIQAe0HAQPUAgHYQwUD1AIO4wEBA9QCDjEB" +
+	"A9QCDjYBA9QCDkEBA9QCDk4BA9QCDlUBA9QCDnUBA9QCDoABAQPUAg50AQPUAg6I" +
+	"AQED1AIdhgIBA9QCHacDAQTUAgED8AMBA9QCAfADAQPUAgH5VAED1AIL5ggBA9QC" +
+	"AKsCAQPUAgCaAwED1AIA0AMBA9QCAJsDAQPUAgDbAwED1AIAvwMBA9QCAOAEugED" +
+	"6wIAFAED6wIA7QEBA+sCADoBA+sCAJICAQPrAgAwAQPrAgCqAgED6wIAOAED6wIA" +
+	"igIBA+sCADUBA+sCAKICAQPrAgBaAQPrAgDRAgED6wIAwwEBA+sCAK8DAQPrAgCh" +
+	"AgED6wIAqwIBA+sCAM4CAQPrAgClAgED6wIA/wMBA+sCANMCAQPrAgCaAwED6wIA" +
+	"0AMBA+sCAJsDAQPrAgDbAwED6wIAvwMBA+sCALQBAQPrAgDqAgED6wIAvwEBA+sC" +
+	"AKkDAQPrAgCtAQED6wIAlwEBA+sCAIQDAQPrAgDgAQED6wIAwQMBA+sCAKgBAQPr" +
+	"AgCzAQED6wIAnAEBA+sCAKkBAQPrAgCFAwED6wIAqAIBA+sCAOMDAQPrAgC4AQED" +
+	"6wIAggEBA+sCANAFAQTrAhAB7QEBBOsCEAE6AQTrAhABkgIBBOsCEAEwAQTrAhAB" +
+	"qgIBBesCEAF7YgEF6wIQAXviAwEE6wIQATgBBOsCEAGKAgEE6wIQATUBBOsCEAGi" +
+	"AgED6wIBjAMBA+sCAfEDAQPrAgHWAwED6wIBgwUBA+sC0wUBZQWBAv0BBQDADgEF" +
+	"gQL9AQUAFAEFgQL9AQUAtAUBBYEC/QEFAOYDAQWBAv0BBQCzAQEFgQL9AQUApgQB" +
+	"BYEC/QEFAJICAQWBAv0BBQCqAgEFgQL9AQUAOAEFgQL9AQUANQEFgQL9AQUA7gYB" +
+	"BYEC/QEFAMMBAQWBAv0BBQCABQEFgQL9AQUAzgoBBYEC/QEFANIfAQWBAv0BBQDB" +
+	"DwEFgQL9AQUA0xABBYEC/QEFAIEKAQWBAv0BBQD0DAEFgQL9AQUAmhIBBYEC/QEF" +
+	"AL8BAQWBAv0BBQDZBAEFgQL9AQUA7QcBBYEC/QEFAOoEAQWBAv0BBQCGBwEFgQL9" +
+	"AQUA4BgBBYEC/QEFAOYYAQWBAv0BBQDpGAEFgQL9AQUA3woBBYEC/QEFAOMdAQWB" +
+	"Av0BBQDgDAEFgQL9AQUAlx8BBYEC/QEDAMAOAQWBAv0BAwAUAQWBAv0BAwC0BQEF" +
+	"gQL9AQMA5gMBBYEC/QEDALMBAQWBAv0BAwCmBAEFgQL9AQMAkgIBBYEC/QEDAKoC" +
+	"AQWBAv0BAwA4AQWBAv0BAwA1AQWBAv0BAwDuBgEFgQL9AQMAwwEBBYEC/QEDAIAF" +
+	"AQWBAv0BAwDOCgEFgQL9AQMA0h8BBYEC/QEDAMEPAQWBAv0BAwDTEAEFgQL9AQMA" +
+	"gQoBBYEC/QEDAPQMAQWBAv0BAwCaEgEFgQL9AQMAvwEBBYEC/QEDANkEAQWBAv0B" +
+	"AwDtBwEFgQL9AQMA6gQBBYEC/QEDAIYHAQWBAv0BAwDgGAEFgQL9AQMA5hgBBYEC" +
+	"/QEDAOkYAQWBAv0BAwDfCgEFgQL9AQMA4x0BBYEC/QEDAOAMAQWBAv0BAwCXHwEE" +
+	"gQL9AQ7jAQEEgQL9AQ4xAQSBAv0BDjYBBIEC/QEOQQEEgQL9AQ5OAQSBAv0BDlUB" +
+	"BIEC/QEOdQEEgQL9AQ6AAQEEgQL9AQ50AQSBAv0BDogBAQSBAv0BHYYCAQSBAv0B" +
+	"HacDAQSBAv0BHb0EAQSBAv0BHdwEAQSBAv0BHYUEAQSBAv0BHY4GAQSBAv0BHZME" +
+	"AQSBAv0BHbMGAQSBAv0BHe0DDQOBAv0B/wYBBLICsAIAFAEEsgKwAgDtAQEEsgKw" +
+	"AgA6AQSyArACAJICAQSyArACADABBLICsAIAqgIBBLICsAIAOAMEsgKwAgA1AwSy" +
+	"ArACAFoBBLICsAIA0QIBBLICsAIAwwEBBLICsAIArwMBBLICsAIAoQIBBLICsAIA" +
+	"qwIBBLICsAIAzgIBBLICsAIApQICBLICsAIA0wIBBLICsAIAmgMCBLICsAIAmwMB" +
+	"BLICsAIA2wMBBLICsAIAvwMBBLICsAIAtAEBBLICsAIA6gIBBLICsAIAvwEBBLIC" +
+	"sAIAqQMBBLICsAIArQEBBLICsAIAlwEBBLICsAIAhAMBBLICsAIA4AEBBLICsAIA" +
+	"wQMBBLICsAIAqAEBBLICsAIAswEBBLICsAIA1w8BBLICsAIAnAEBBLICsAIAqQEB" +
+	"BLICsAIAhQMBBLICsAIAqAIBBLICsAIA4wMBBLICsAIAuAEBBLICsAIAggEBBLIC" +
+	"sAIA4AQBBLICsAIAnwMBBbICsAIQAe0BAQWyArACEAE6AQWyArACEAGSAgEFsgKw" +
+	"AhABMAEFsgKwAhABqgIBBbICsAIQATgCBbICsAIQAYoCAQWyArACEAE1AwSyArAC" +
+	"AYwDAQSyArACAY0EAQSyArACAYMRAQOyArAC1gMBBbICsAKUCKwMAQEEsgKwAs0B" +
+	"swEBBLICsAJXnAEBBLICsALNAZwBAQSyArACAYMFAQSyArACM7cEAQSyArAC2Aq/" +
+	"CAEGsgKwAqkESt8IKgoEsgKwAg7jAQEEsgKwAg4xAQSyArACDjYBBLICsAIOQQEE" +
+	"sgKwAg5OAQSyArACDlUBBLICsAIOdQEEsgKwAg6AAQEEsgKwAg50AQSyArACDogB" +
+	"RwPWAgAUAQPWAgDtAQED1gIAOgED1gIAkgIBA9YCADABA9YCAKoCAQTWAgB7YgEE" +
+	"1gIAe+IDAwPWAgA4AQPWAgCKAgED1gIANQED1gIAogIBA9YCAFoBA9YCANECAQPW" +
+	"AgDDAQED1gIArwMBA9YCAKECAQPWAgCrAgED1gIAzgIBA9YCAKUCAQPWAgD/AwED" +
+	"1gIA0wIBA9YCAJoDAQPWAgDQAwED1gIAmwMBA9YCANsDAQPWAgC/AwED1gIAtAEB" +
+	"A9YCAOoCAQPWAgC/AQED1gIAqQMBA9YCAK0BAQPWAgCXAQED1gIAhAMBA9YCAOAB" +
+	"AQPWAgDBAwED1gIAqAEBA9YCALMBAQPWAgCcAQED1gIAqQEBA9YCAIUDAQPWAgCo" +
+	"AgED1gIA4wMBA9YCALgBAQPWAgCCAQED1gIA4AQBA9YCANAFAQTWAhAB7QEBBNYC" +
+	"EAE6AQTWAhABkgIBBNYCEAEwAQTWAhABqgIBBdYCEAF7YgEF1gIQAXviAwME1gIQ" +
+	"ATgBBNYCEAGKAgEE1gIQATUBBNYCEAGiAgED1gIBjAMBA9YCAfEDAQPWAgHWAwED" +
+	"1gIBlQYBA9YCAdABAQLWAoYRAQXWAhAB0BI4HAS3AisAFAEFtwIrEAE6AQW3AisQ" +
+	"AaAFAQW3AisQATABBbcCKxABOAEFtwIrEAHEAwEFtwIrEAE1AQW3AisQAYoCAQW3" +
+	"AisQAaICAQa3AisQAfgBOgEFtwIrEL4GKgEEtwIrAFoBBLcCKwDRAgEEtwIrAMMB" +
+	"AQS3AisArwMBBLcCKwChAgEEtwIrAKsCAQS3AisAzgIBBLcCKwClAgEEtwIrANMC" +
+	"AQS3AisAmgMBBLcCKwDQAwEEtwIrAJsDAQS3AisA2wMBBLcCKwC/AwEEtwIrALQB" +
+	"AQS3AisA6gIBBLcCKwC/AQEEtwIrAKkDAQS3AisArQEBBLcCKwCXAQEEtwIrAIQD" +
+	"AQS3AisA4AEBBLcCKwDBAwEEtwIrAKgBAQS3AisAvgQBBLcCKwDLBgEEtwIrAPUG" +
+	"AQS3AisA5gsBBLcCKwC2BQEEtwIrAJ8DAQS3AisApwoBBLcCKwCzAQEEtwIrAJwB" +
+	"AQS3AisAqQEBBLcCKwCFAwEEtwIrAKgCAQS3AisA4wMBBLcCKwC4AQEEtwIrAIIB" +
+	"AQS3AisAmQwBBbcCKzLxASoBBLcCKwHWAwEEtwIrAY0EAQa3AisBjQQC1wIBBrcC" +
+	"KwGwBgLXAgEEtwIrAYwDAQS3AisB8QMBBbcCK9gLAJwBAQW3AiuxDQCzAQEFtwIr" +
+	"sQ0AnAEBBbcCK7ENAKkBAQW3AiuxDQCFAwEFtwIrV9IEKgEFtwIr1wvSBCoBBLcC" +
+	"KyrlBwEEtwIrKqQGAQW3AisqM6QGAQW3AisqqgHlBwEGtwIrV7kX0gQqAQa3AivX" +
+	"C7kX0gQqAQO3AivsHQkDhQIAFAEEhQIQAToBBIUCEAGgBQEEhQIQATABBIUCEAE4" +
+	"AQSFAhABNQEEhQIQAcQDAQSFAhABigIBBIUCEAGiAgEFhQIQAXtiAQWFAhABe28B" +
+	"BIUCEL4GKgEDhQIAWgEDhQIA0QIBA4UCAMMBAQOFAgCvAwEDhQIAoQIBA4UCAKsC" +
+	"AQOFAgDOAgEDhQIApQIBA4UCAP8DAQOFAgDTAgEDhQIAmgMBA4UCANADAQOFAgCb" +
+	"AwEDhQIA2wMBA4UCAL8DAQOFAgC0AQEDhQIA6gIBA4UCAL8BAQOFAgCpAwEDhQIA" +
+	"rQEBA4UCAJcBAQOFAgCEAwEDhQIA4AEBA4UCAMEDAQOFAgCoAQEDhQIAvgQBA4UC" +
+	"AMsGAQOFAgD1BgEDhQIAtgUBA4UCAJ8DAQOFAgCnCgEDhQIAswEBA4UCAJwBAQOF" +
+	"AgCpAQEDhQIAhQMBA4UCAKgCAQOFAgDjAwEDhQIAuAEBA4UCAIIBAQOFAgCZDAED" +
+	"hQIB7AoBA4UCAbMLAQSFAtgLAJwBAQSFAtgLAKkBAQSFAtgLAKgCAQSFAtgLALgB" +
+	"AQWFAjLxAQHBAQEFhQIy8QEBxwEBBYUCMvEBAc4FAQWFAjLxAQHSAQEFhQIy8QEB" +
+	"mQEBBYUCMvEBAT4BBYUCMvEBAbABAQWFAjLxAQFiAQWFAjLxAQFvAQWFAjLxAQGK" +
+	"CgEFhQIy8QEBYwEFhQIy8QEBpwoBA4UCAYwDAQOFAgHxAwEDhQL4CyoBAoUC7B0B" +
+	"A4UCKuUHAQOFAiqkBgEEhQIqM6QGAQOFAiqgHAEKhQLSBCoCxgQYqAYY8AL/FwEJ" +
+	"hQLSBCoCxgQYqAYY/xcBB4UC0gQqAsYEGKgGAQOFAqUeoAwBA4UCpR6hDA4EEhOr" +
+	"B/YDAQQSE6sH2xgBBBITqwffBAEEEhOrB4QMAQQSE6sHggEBBBITqwfLBwEEEhOr" +
+	"B6gdAQQSE6sHwFkBBBITqwfCWQEEEhOrB8NZAQQSE6sHvlkBBBITqwe/WQEDEhPG" +
+	"WgEDEhPMWgEDEhPSWgEDEhPAWgEF+QL3AvQCAJcBAQX5AvcC9AIAWgEF+QL3AvQC" +
+	"AKkBAQX5AvcC9AIAqAEBBfkC9wL0AgC/AQEF+QL3AvQCAJ8DAQX5AvcC9AIAhQMB" +
+	"BfkC9wL0AgChAgEF+QL3AvQCAIIBAQX5AvcC9AIAwwEBBfkC9wL0AgDRAgEF+QL3" +
+	"AvQCALgBAQX5AvcC9AIA4AEBBfkC9wL0AgCrAgEF+QL3AvQCALQBAQX5AvcC9AIA" +
+	"6gIBBfkC9wL0AgCtAQEF+QL3AvQCAIQDAQX5AvcC9AIAnAEBBfkC9wL0AgDTAwEF" +
+	"+QL3AvQCAM4CAQX5AvcC9AIAFAEF+QL3AvQCADgBBfkC9wL0AgA6AQX5AvcC9AIA" +
+	"NQEF+QL3AvQCADABBfkC9wL0AgCxCwEF+QL3AvQCAPsNAQb5AvcC9AIAMvoBAQb5" +
+	"AvcC9AIAMscBAQb5AvcC9AIAMsUBAQb5AvcC9AIAMrABAQb5AvcC9AIAMpkBAQb5" +
+	"AvcC9AIAMm8BBvkC9wL0AgAyjgIBBvkC9wL0AgAyzgUBBvkC9wL0AgAy4gEBBvkC" +
+	"9wL0AsMGiQGqAQEF+QL3AvQCwwaJAQEG+QL3AvQC2QiGBKkCAQf5AvcC9ALDBokB" +
+	"qgEyAQb5AvcC9ALDBokBMgEH+QL3AvQC2QiGBKkCMgEG+QL3AvQC2QiJAaoBAQX5" +
+	"AvcC9ALZCIkBAQf5AvcC9ALZCIkBqgEyAQb5AvcC9ALZCIkBMgEF+QL3AvQC6RGJ" +
+	"AQEG+QL3AvQChgSpAqUEAQf5AvcC9ALpEYkBqgEyAQb5AvcC9ALpEYkBMgEG+QL3" +
+	"AvQCqw6JAaoBAQX5AvcC9AKrDokBAQX5AvcC9AKGBKkCAQf5AvcC9AKrDokBqgEy" +
+	"AQb5AvcC9AKrDokBMgEG+QL3AvQChgSpAjKIAgPRAQAUAQPRAQDtAQED0QEAOgED" +
+	"0QEAkgIBA9EBADABA9EBAKoCAQTRAQB7YgEE0QEAe+IDAQTRAQB7bwID0QEAOAED" +
+	"0QEAigIBA9EBADUBA9EBAKICAQPRAQBaAQPRAQDRAgED0QEAwwEBA9EBAK8DAQPR" +
+	"AQChAgED0QEAqwIBA9EBAM4CAQPRAQClAgED0QEA/wMBA9EBANMCAQPRAQCaAwED" +
+	"0QEA0AMBA9EBAJsDAQPRAQDbAwED0QEAvwMBA9EBALQBAQPRAQDqAgED0QEAvwEB" +
+	"A9EBAKkDAQPRAQCtAQED0QEAlwEBA9EBAIQDAQPRAQDgAQED0QEAwQMBA9EBAKgB" +
+	"AQPRAQCzAQED0QEAnAEBA9EBAKkBAQPRAQCFAwED0QEAqAIBA9EBAOMDAQPRAQC4" +
+	"AQED0QEAggEBBNEBEAHtAQEE0QEQAToBBNEBEAGSAgEE0QEQATABBNEBEAGqAgEF" +
+	"0QEQAXtiAQXRARABe+IDAQXRARABe28CBNEBEAE4AQTRARABigIBBNEBEAE1AQTR" +
+	"ARABogIBA9EBAY0EAQPRAQGMAwED0QEB8QMBA9EBAdYDAQPRAQGVBgEC0QG3BAED" +
+	"0QEztwQBA9EBoQX1BAED0QHYCvw6AQPRAdgK/ToLA9EBDuMBAQPRAQ4xAQPRAQ42" +
+	"AQPRAQ5BAQPRAQ5OAQPRAQ5VAQPRAQ51AQPRAQ6AAQED0QEOdAED0QEOiAEBA9EB" +
+	"HTEBA9EBHTYBA9EBHUEBA9EBHU4BA9EBHVUBA9EBHXUBA9EBHYABAQPRAR10AQPR" +
+	"AR2IAQED0QEdhgIBA9EBHacDAQPRAR29BAED0QEd3AQBA9EBHYUEAQPRAR2OBgED" +
+	"0QEdkwQBA9EBHbMGAQPRAR3tAwEE0QGPEbcHKgQDygLSBCoBA8oCKqQGAQPKAgBa" +
+	"AQPKAgDRAgEDygIAwwEBA8oCAKECAQPKAgCrAgEDygIAzgIBA8oCAKUCAQPKAgDT" +
+	"AgEDygIAtAEBA8oCAOoCAQPKAgC/AQEDygIArQEBA8oCAJcBAQPKAgCEAwEDygIA" +
+	"4AEBA8oCAKgBAQPKAgC+BAEDygIAywYBA8oCAPUGAQPKAgCvAgEDygIAtgUBA8oC" +
+	"AJ8DAQPKAgCnCgEDygIAswEBA8oCAJwBAQPKAgCpAQEDygIAqAIBA8oCALgBAQPK" +
+	"AgCCAQEDygIAFAMEygKAAgBaAQTKAoACANECAQTKAoACAMMBAQTKAoACAKECAQTK" +
+	"AoACAKsCAQTKAoACAM4CAQTKAoACAKUCAQTKAoACANMCAQTKAoACALQBAQTKAoAC" +
+	"AOoCAQTKAoACAL8BAQTKAoACAK0BAQTKAoACAJcBAQTKAoACAIQDAQTKAoACAOAB" +
+	"AQTKAoACAKgBAQTKAoACAL4EAQTKAoACAMsGAQTKAoACAPUGAQTKAoACAK8CAQTK" +
+	"AoACALYFAQTKAoACAJ8DAgTKAoACALMBAQTKAoACAJwBAQTKAoACAKkBAQTKAoAC" +
+	"AKgCAQTKAoACALgBAQTKAoACAIIBAQTKAoACABQBBMoCEAHtAQEEygIQAToBBMoC" +
+	"EAEwAQTKAhABOAEEygIQATUBA8oCAYwDAQPKAgGNBEoEpAKFAQAUAQSkAoUBAO0B" +
+	"AQSkAoUBADoBBKQChQEAkgIBBKQChQEAMAEEpAKFAQCqAgEEpAKFAQA4AgSkAoUB" +
+	"AIoCAQSkAoUBADUCBKQChQEAogIBBKQChQEAWgEEpAKFAQDRAgEEpAKFAQDDAQEE" +
+	"pAKFAQCvAwEEpAKFAQChAgEEpAKFAQCrAgEEpAKFAQDOAgEEpAKFAQClAgEEpAKF" +
+	"AQD/AwEEpAKFAQDTAgEEpAKFAQCaAwEEpAKFAQDQAwEEpAKFAQCbAwEEpAKFAQDb" +
+	"AwEEpAKFAQC/AwEEpAKFAQC0AQEEpAKFAQDqAgEEpAKFAQC/AQEEpAKFAQCpAwEE" +
+	"pAKFAQCtAQEEpAKFAQCXAQEEpAKFAQCEAwEEpAKFAQDgAQEEpAKFAQDBAwEEpAKF" +
+	"AQCoAQEEpAKFAQCzAQEEpAKFAQCcAQEEpAKFAQCpAQEEpAKFAQCFAwEEpAKFAQCo" +
+	"AgEEpAKFAQDjAwEEpAKFAQC4AQEEpAKFAQCCAQEEpAKFAQDgBAEEpAKFAQCZDAEE" +
+	"pAKFAQCaGQEEpAKFAQDXEwEFpAKFARAB7QEBBaQChQEQAToBBaQChQEQAZICAQWk" +
+	"AoUBEAEwAQWkAoUBEAGqAgEGpAKFARABe2IEBaQChQEQATgCBaQChQEQAYoCAQWk" +
+	"AoUBEAE1AgWkAoUBEAGiAgEEpAKFAQGMAwEEpAKFAQHxAwEEpAKFAQGDBQEEpAKF" +
+	"AQGwBgEEpAKFAQGDEQEDpAKFAdYDAQOkAoUB+lMBA6QChQG3UwkEpAKFAQ7jAQEE" +
+	"pAKFAQ4xAQSkAoUBDjYBBKQChQEOQQEEpAKFAQ5OAQSkAoUBDlUBBKQChQEOdQEE" +
+	"pAKFAQ6AAQEEpAKFAQ50AQSkAoUBDogBBwTeAoUBABQBBN4ChQEA7QEBBN4ChQEA" +
+	"OgEE3gKFAQCSAgEE3gKFAQAwAQTeAoUBAKoCAgTeAoUBAMwDAQTeAoUBAIoCAgTe" +
+	"AoUBAKoDAQTeAoUBAKICAQTeAoUBALMBAQTeAoUBAIUDAQTeAoUBAOABAQTeAoUB" +
+	"AMEDAQTeAoUBAKgBAQTeAoUBAFoBBN4ChQEA0QIBBN4ChQEAtAEBBN4ChQEA6gIB" +
+	"BN4ChQEAqQEBBN4ChQEAwwEBBN4ChQEArwMBBN4ChQEAvwEBBN4ChQEAqQMBBN4C" +
+	"hQEArQEBBN4ChQEAqwIBBN4ChQEAzgIBBN4ChQEAmgMBBN4ChQEA0AMBBN4ChQEA" +
+	"4AQBBN4ChQEApQIBBN4ChQEA/wMBBN4ChQEAmwMBBN4ChQEA2wMBBN4ChQEAoQIB" +
+	"BN4ChQEAlwEBBN4ChQEAhAMBBN4ChQEAggEBBN4ChQEAnAEBBN4ChQEAuAEBBd4C" +
+	"hQEQAe0BAQXeAoUBEAE6AQXeAoUBEAGSAgEF3gKFARABMAEF3gKFARABqgICBd4C" +
+	"hQEQAcwDAQXeAoUBEAGKAgIF3gKFARABqgMBBd4ChQEQAaICAQTeAoUBAYwDAQTe" +
+	"AoUBAfEDAQPeAoUB1gMBA94ChQH/BggE3gKFAQ7jAQEE3gKFAQ4xAQTeAoUBDjYB" +
+	"BN4ChQEOQQEE3gKFAQ5OAQTeAoUBDlUBBN4ChQEOdQEE3gKFAQ6AAQEE3gKFAQ50" +
+	"AQTeAoUBDogBtwIDgQUAWgEDgQUAwwEBA4EFAKECAQOBBQCXAQEDgQUA4AEBA4EF" +
+	"AKgBAQOBBQC0AQEDgQUAvwEBA4EFAK0BAQOBBQCrAgEDgQUApQIBA4EFANMCAQOB" +
+	"BQCzAQEDgQUAnAEBA4EFAKkBAQOBBQCFAwEDgQUAuAEBA4EFABQBAoEFtiYBBIEF" +
+	"EAE6AQSBBRABMAEEgQUQATgBBIEFEAE1AQKBBc9QAQSBBakESvADuAEDpAMAz2QQ" +
+	"BI8BzAEx2F0BBI8BzAEx1hsBBI8BzAExqTkBBI8BzAExjhMBBI8BzAExihgBBI8B" +
+	"zAExwxMBBI8BzAFB2xcBBI8BzAFBh1oBBI8BzAEx7R4BBI8BzAExhVoBBI8BzAEx" +
+	"hloBBI8BzAExvhMBBI8BzAEx6QMBBI8BzAFB7h4BBI8BzAFBmA8BBI8BzAEx4RAB" +
+	"BI8BzAEx7gMBBI8BzAEx+j0BBI8BzAEx+z0BBI8BzAFBwgYBBY8BzAG3ONsQ10MB" +
+	"A48BAehLAQOPAQHOKwEDjwEBgCUBA48BAd1gAQOPAQHMSgEDjwEBwkQBA48BAdZQ" +
+	"AQOPAQG0SgEDjwEB4EIBA48BAahQAQOPAQHLDAEDjwEBs2EBA48BAYxQAQOPAQHJ" +
+	"RAEDjwEBwmEBBI8Bi2P2FgEBBI8ByxD2FgEBA48BtEUBAQSPAZgIrRIBAQSPAcoe" +
+	"qhABAQSPAcoeHQEBA48BrxABAQSPARiBCgEBA48ByloBAQOPAcYeAQEEjwHfAqUc" +
+	"AQEEjwGVW/4DAQEDjwEBykoBA48BAa5hDgWPAcQBqQRK3wgBAwoBFAEFCgEUFhQB" +
+	"BQoBFBbWBQEGCgEUFqgF1QMBBQoBFBaCAQEFCgEUFqEEAQYKARQWvAb+AgEFCgEU" +
+	"FokGAQUKARQWzAQBAwoB3g8BAwoBtwUBBQoBtwUW7gcBBgoBtwUW4Qn+AgEFCgG3" +
+	"BRbDCAEGCgG3BRaoBdUDAQUKAbcFFoIBAQYKAbcFFqEE/gIBBQoBtwUW/Q0BBQoB" +
+	"twUW4AIBBQoBtwUWgBMBCQoBtwUWMGAwYDABBAoBtwX+AgEDCgHSBQEFCgHSBRaZ" +
+	"DQEGCgHSBRaoBdUDAQcKAdIFFpAEYPwCAQUKAdIFFrMHAQUKAdIFFqoGAQMKAdIJ" +
+	"AQMKAasKAQUKAasKFtEKAQcKAasKFpEPYKkFAQMKAf0FAQUKAf0FFv0FAQUKAf0F" +
+	"FsIQAQUKAf0FFqkFAQUKAf0FFoIBAQUKAf0FFo8MAQUKAf0FFpAFAQUKAf0FFroD" +
+	"AQUKAf0FFrkHAQMKAZImAQMKAf4FAQMKAY8NAQUKAY8NFroDAQMKAfMDAQUKAfMD" +
+	"kgHzAwEFCgHzA0EWAQkKAfMDYJ8JpgnzA2CfCQEGCgHzA2CfCWUBAwoB5Q8BAwoB" +
+	"1CYBAwoB5hUBBQoB5hUW3gUBAwoB8CYBAwoBhCcBAwoB+gIBBQoB+gLlH9UDAQUK" +
+	"AfoCnxnVAwEMCgH6ApIB+gLLCZIBywnLCZIBywn0BQEHCgH6ApIB+gKSAfoCAQ0K" +
+	"AfoCkgH6ApIB+gKcBPoCkgH6ApIB+gIBAwoB7gcBAwoB6g8BAwoB4AEBAwoB1gUB" +
+	"AwoBjSkBAwoByAsBAwoByQsBBQoByQuSAckLAQMKAZkNAQMKAXcBAwoBoikBAwoB" +
+	"2AUBBQoB2AUWFAEFCgHYBRbBBAEGCgHYBRahBP4CAQMKAeoEAQYKAeoEkgHqBLcF" +
+	"AQYKAeoEkgHqBOQIAQUKAeoEnATqBAEDCgGjDQEFCgGjDZIBow0BAwoBrwgBAwoB" +
+	"ySoBAwoBvwEBAwoB2wQBCAoB2wTCBBYUYPkJAQYKAdsEwgQWjw0BBgoB2wTCBBaZ" +
+	"DQEGCgHbBMIEFtgFAQYKAdsEwgQWwwEBCAoB2wTCBBbDAWD5CQEGCgHbBMIEFo4F" +
+	"AQYKAdsEwgQW7QkBBgoB2wTCBBaFBgEGCgHbBMIEFoIBAQYKAdsEwgQWigwBCAoB" +
+	"2wTCBBaKDGDyBAEGCgHbBMIEFokIAQYKAdsEwgQWqQEBBgoB2wTCBBbyBAEICgHb" +
+	"BMIEFvIEYMQaAQYKAdsEwgQWqAcBBgoB2wTCBBaIBAEICgHbBMIEFvQFYPQFAQYK" +
+	"AdsEwgQWnQUBBgoB2wTCBBaqBgEICgHbBMIEFs0GYO0JAQYKAdsEwgQWuQcBAwoB" +
+	"gjUBAwoBixcBAwoBjBcBAwoBiDUBAwoB2gUBAwoBqRcBAwoB3gkBBQoB3gkWugMB" +
+	"AwoBwhABAwoBwA0BBwoBwA26BjD+ApgFAQMKAZgFAQMKAdsFAQUKAdsFkgHbBQEE" +
+	"CgHbBf4CAQQKAdsFpQYBAwoByQoBBQoByQoW/gcBAwoBwDgBAwoBjgQBAwoBwzgB" +
+	"AwoBxDgBAwoBzRABAwoB4QkBBAoB4Qn+AgEFCgHhCf4C/gIBAwoBxzgBAwoBzTgB" +
+	"AwoBOAEFCgE4FvQFAQgKATiSATjxApIB8QIBAwoBmwcBCQoBmwcWFGCCAWC/AQEF" +
+	"CgGbBxbBBAEFCgGbBxa5BAEFCgGbBxaXCAEFCgGbBxa6AwEFCgGbBxYwAQMKAZ45" +
+	"AQMKAaY5AQMKAZkFAQMKAfwCAQUKAfwCFqgFAQYKAfwCFqgF1QMBBQoB/AIWkAQB" +
+	"BQoB/AKcBPwCAQUKAfwCpgn8AgEECgH8AmUBAwoB6hcBAwoB0QoBAwoB/gcBAwoB" +
+	"qgQBBQoBqgQWFAEHCgGqBBYUYKcHAQkKAaoEFhRgpwcWpwcBBQoBqgQW8wMBBQoB" +
+	"qgQW1gUBBgoBqgQW4Qn+AgEHCgGqBBbhCf4C/gIBBQoBqgQWggEBBgoBqgQWggH+" +
+	"AgEGCgGqBBahBP4CAQUKAaoEFroGAQYKAaoEFroGZQEFCgGqBBb1CgEFCgGqBBap" +
+	"AQEHCgGqBBanBxanBwEFCgGqBBbVAgEFCgGqBBbyBAEFCgGqBBbNBgEFCgGqBBbY" +
+	"BAEDCgHDAQEECgHDAf4CAQMKAZwCAQkKAZwCFhRgvwFgggEBBwoBnAIWFGCCAQEH" +
+	"CgGcAhYUYKEEAQgKAZwCFtIF1QNgnQgBBQoBnAIW8wMBBQoBnAIW+gIBBwoBnAIW" +
+	"7gdgwwgBBQoBnAIW1gUBBwoBnAIWd2CcAQEFCgGcAhavCAEHCgGcAhavCGCcAQEF" +
+	"CgGcAha/AQEFCgGcAhbaBQEHCgGcAhbeCRa6AwEFCgGcAhbJCgEFCgGcAhaZBQEH" +
+	"CgGcAhaZBWCpAQEFCgGcAhb8AgEICgGcAhb8AhaoBdUDAQYKAZwCFqgF1QMBBQoB" +
+	"nAIWwQQBBQoBnAIWjgUBBQoBnAIW7AkBBwoBnAIW7AlgFAEHCgGcAhbtCWCyBQEJ" +
+	"CgGcAhaCAWDyBGD+BwEFCgGcAhbeCgEHCgGcAhbeCmCpAQEHCgGcAhb2A2DVAgEF" +
+	"CgGcAhaLCQEGCgGcAhahBP4CAQkKAZwCFp4RYNsGYPoCAQUKAZwCFokIAQUKAZwC" +
+	"FroGAQUKAZwCFo4OAQcKAZwCFo4OYKcHAQcKAZwCFvUKYPMDAQUKAZwCFqkBAQcK" +
+	"AZwCFpAEYPwCAQUKAZwCFrkEAQUKAZwCFvECAQcKAZwCFvECkgHxAgEFCgGcAhaX" +
+	"AQEFCgGcAhaXCAEFCgGcAhaYCwEFCgGcAha6AwEHCgGcAha6A2CwCwEFCgGcAhac" +
+	"CwEFCgGcAhakCwEFCgGcAhaqBgEFCgGcAhYwAQUKAZwCFtgEAQcKAZwCFtgEYNsF" +
+	"AQUKAZwCkgGcAgEDCgHmDQEFCgHmDZwE5g0BAwoBgwgBCAoBgwiSAYMIwQSSAcEE" +
+	"AQMKAcMIAQkKAcMIgwiSAYMIwQSSAcEEAQMKAZY8AQMKAZc8AQMKAesJAQMKAagF" +
+	"AQQKAagF1QMBBQoBqAWSAagFAQUKAagFnASoBQEDCgHBBAEDCgGdPAEDCgGiPAED" +
+	"CgH4EAEFCgH4EBbeBQEDCgGOBQEFCgGOBRY4AQUKAY4FFjABBQoBjgWcBI4FAQMK" +
+	"AewJAQUKAewJkgHsCQEFCgHsCZwE7AkBAwoB+jwBAwoB7QkBBAoB7Qn+AgEDCgHa" +
+	"CgEHCgHaChYUYKEEAQYKAdoKFqgF1QMBBQoB2goWoQQBBwoB2goW8gRgoQQBBQoB" +
+	"2goWlwEBAwoBiT0BAwoBqQUBBQoBqQWcBKkFAQUKAakFFtYFAQUKAakFFqoGAQQK" +
+	"AakF1QMBAwoBhAYBBQoBhAacBIQGAQMKAe4JAQUKAe4JFokIAQgKAe4JFokIFqEE" +
+	"/gIBBQoB7gkW8QIBBwoB7gkWlwhgywkBBAoB7gn+AgEDCgGFBgEHCgGFBhYUYN4F" +
+	"AQUKAYUGFt4FAQYKAYUGkgGFBvcJAQMKAcEYAQMKAYIRAQUKAYIRFroDAQMKAe4N" +
+	"AQMKAcIYAQMKAcQ9AQMKAcU9AQMKAYIBAQQKAYIB1QMBBAoBggH+AgEDCgHeCgED" +
+	"CgH2AwEFCgH2Axb6AgEFCgH2AxbuBwEFCgH2AxbWBQEFCgH2AxaYBQEFCgH2AxaD" +
+	"CAEFCgH2Axa1EQEFCgH2AxbxAgEFCgH2Axa6AwEFCgH2AxYwAQMKAdsGAQMKAYsJ" +
+	"AQUKAYsJFvMDAQUKAYsJFt4KAQUKAYsJFroGAQUKAYsJFs8RAQUKAYsJFtgEAQMK" +
+	"AY5AAQMKAToBBAoBOhQBAwoBkREBAwoBhgwBBgoBhgySAYYMrwgBBgoBhgySAYYM" +
+	"ZQEDCgH9GAEDCgGhBAEECgGhBKkXAQQKAaEEoAYBCwoBoQSSAaEEnQuSAZ0L2ASS" +
+	"AdgEAQQKAaEE/gIBAwoBgRkBBgoBgRkWqAXVAwEDCgGvQQEDCgG5BgEFCgG5Bhaq" +
+	"BgEFCgG5BpwEuQYBBQoBuQamCbkGAQQKAbkGZQEDCgH9DQEDCgHfAgEDCgGKDAED" +
+	"CgGeEQEDCgFaAQUKAVoWFAEFCgFaFtIJAQcKAVoW0glg9QoBBQoBWhbuBwEFCgFa" +
+	"FtYFAQUKAVoWmQ0BBQoBWhZ3AQUKAVoW2AUBBQoBWhbRCgEFCgFaFv4HAQUKAVoW" +
+	"wwEBBQoBWhbDCAEGCgFaFqgF1QMBBQoBWhbBBAEJCgFaFsEEYLMHYBQBBQoBWhaO" +
+	"BQEFCgFaFu0JAQcKAVoWqQVgmAsBBwoBWhapBZwEqQUBBQoBWhaEBgEFCgFaFsIY" +
+	"AQUKAVoWoQQBBQoBWha5BgEFCgFaFokIAQUKAVoWkAUBBQoBWhaODgEFCgFaFtUC" +
+	"AQUKAVoW8gQBBQoBWhaQBAEHCgFaFpAEYNsFAQcKAVoWkARgjgUBBwoBWhaQBGD6" +
+	"AwEFCgFaFrkEAQcKAVoWuQRg4AYBBQoBWhaIBAEFCgFaFvECAQUKAVoWkwMBBQoB" +
+	"WhacBQEFCgFaFrgBAQUKAVoWmAsBBQoBWhaoAgEFCgFaFroDAQUKAVoWnAsBBQoB" +
+	"WhbJBgEFCgFaFrIdAQUKAVoW8R0BBQoBWhaXCgEFCgFaFjABBQoBWhbNBgEFCgFa" +
+	"FtgEAQcKAVoWxQ8WlwEBBQoBWha5BwEFCgFaFusHAQMKAacRAQUKAacRnASnEQED" +
+	"CgGeGQEDCgGgGQEDCgGPDAEDCgHoQgEDCgGoEQEFCgGoEZIBqBEBAwoBiQgBBgoB" +
+	"iQgWoQT+AgEDCgGQDAEFCgGQDBbWBQEFCgGQDJwEkAwBAwoB70IBAwoB8EIBAwoB" +
+	"ugYBCgoBugbgAhYwkgHgAhYwAQwKAboGkgG6BuACFjCSAeACFjABAwoBqxkBAwoB" +
+	"kAUBBQoBkAUW1gUBBQoBkAUWMAEFCgGQBRbYBAEDCgGODgEDCgG1EQEDCgHhQwED" +
+	"CgHiQwEDCgHCBAEFCgHCBJIBwgQBAwoBqgUBDAoBqgWSAfYDFu4HqgWSAfYDFu4H" +
+	"AQMKAfUKAQMKAc0ZAQUKAc0ZpQQPAQMKAa9EAQMKAZcOAQQKAZcO/gIBAwoBzhkB" +
+	"AwoB3gUBBQoB3gWmCd4FAQMKAfYKAQMKAc9EAQMKAakBAQMKAeACAQUKAeACFhQB" +
+	"CQoB4AIWFGC/AWCCAQEHCgHgAhYUYMEEAQcKAeACFhRgpwcBBQoB4AIW/QUBBQoB" +
+	"4AIW8wMBBwoB4AIW+gLlH9UDAQUKAeACFtYFAQUKAeACFtgFAQUKAeACFosXAQUK" +
+	"AeACFvwCAQUKAeACFsMBAQUKAeACFsEEAQUKAeACFoUGAQcKAeACFoUGYIUGAQUK" +
+	"AeACFoIBAQUKAeACFt4KAQcKAeACFvYDFvECAQYKAeACFqEE/gIBBQoB4AIWuQYB" +
+	"BwoB4AIWuQZgggEBBwoB4AIWuQZg8gQBBQoB4AIWkAUBBQoB4AIWtREBBQoB4AIW" +
+	"9QoBBQoB4AIWlw4BCQoB4AIWlw5g9gNgFAEFCgHgAhbgAgEFCgHgAhbPEQEFCgHg" +
+	"AhbyBAEFCgHgAhbTEQEFCgHgAhaQBAEHCgHgAhaQBGD8AgEFCgHgAhaJBgEFCgHg" +
+	"AhaIBAEHCgHgAha6A2CkCwEJCgHgAhaRD2CpBWDRCgEICgHgAhaRD2CpBdUDAQUK" +
+	"AeACFtwIAQcKAeACFtwIYNwIAQUKAeACFqQLAQUKAeACFr0BAQUKAeACFqoGAQsK" +
+	"AeACFvoDYBRgsgVgrQEBBQoB4AIWMAEHCgHgAhYwYBQBCQoB4AIWMGAwYDABBwoB" +
+	"4AIWzQZg+gIBBQoB4AIW2AQBBQoB4AIWuQcBBAoB4AJlAQMKAbwGAQUKAbwGFroD" +
+	"AQcKAbwGFroDYKQLAQQKAbwG/gIBCAoBvAb+ApIBvAb+AroDAQMKAYVFAQMKAacH" +
+	"AQUKAacHFqcHAQMKAaYCAQUKAaYCFt4FAQcKAaYCFt4FYJwFAQMKAdUCAQMKAf1F" +
+	"AQMKAYVGAQMKAc8RAQMKAfIEAQUKAfIEFtYFAQMKAYAEAQUKAYAEFv0FAQUKAYAE" +
+	"FtYFAQUKAYAEFv4HAQYKAYAEFv4H1QMBBgoBgAQWqAXVAwEHCgGABBb2AxbWBQEF" +
+	"CgGABBa5BgEFCgGABBagGQEFCgGABBaPDAEHCgGABBaPDGD6AgEFCgGABBaQBQEH" +
+	"CgGABBapAWD6AgEFCgGABBbgAgEHCgGABBaQBGD8AgEFCgGABBaIBAEFCgGABBai" +
+	"BAEHCgGABBadBWD6AgEHCgGABBa1HWDqBAEFCgGABBbLCQEECgGABNUDAQUKAYAE" +
+	"nASABAEFCgGABKYJgAQBBAoBgARlAQQKAYAEpQYBAwoB+woBAwoB9wkBBQoB9wmS" +
+	"AfcJAQUKAfcJpgn3CQEECgH3CaUGAQMKAdIRAQMKAdMRAQMKAagHAQUKAagHkgGo" +
+	"BwEICgGoB5IBqAfBBJIBwQQBAwoBqAEBBQoBqAEWqgYBBAoBqAH+AgEDCgGPSAED" +
+	"CgG+GgEDCgHCGgEDCgH5CQEDCgHEGgEDCgGQBAEDCgHnEQEDCgG5BAEDCgG2DgED" +
+	"CgHeAwEFCgHeA5IB3gMBAwoBuQ4BBAoBuQ7+AgEDCgG7SgEDCgHFSgEDCgGJBgEF" +
+	"CgGJBhYUAQUKAYkGFt4FAQUKAYkGFp8DAQUKAYkGkgGJBgEJCgGJBpIBiQYWFGCt" +
+	"AQEFCgGJBpwEiQYBAwoB/QoBBQoB/QoWFAEHCgH9ChYUYNoFAQUKAf0KFtoFAQQK" +
+	"Af0K/gIBAwoBrQEBAwoB/BoBAwoBnwkBBAoBnwnPAgEGCgGfCRbJBtUDAQUKAZ8J" +
+	"pgmfCQEDCgGTSwEECgGAG70bAQMKAYAbAQMKAYEbAQMKAYgEAQUKAYgEFhQBBQoB" +
+	"iAQW2AQBBAoBiASlBgEDCgGJBAEFCgGJBBY4AQMKAcFMAQMKAZASAQYKAZASFqgF" +
+	"1QMBCAoBkBIWwQRgqAXVAwEDCgHlBAEFCgHlBBbzAwEFCgHlBBb6AgEHCgHlBBb6" +
+	"AmD6AgEFCgHlBBaFBgEICgHlBBaQBGCoBdUDAQUKAeUEFogEAQUKAeUEFvECAQUK" +
+	"AeUEFroDAQgKAeUEFroDYBTzAwEHCgHlBBa6A2D6AgEJCgHlBBa6A2D6AmD6AgEH" +
+	"CgHlBBbNBmD6AgEFCgHlBBa5BwEDCgHYTAEDCgGiBAEDCgG4GwEDCgHxAgEGCgHx" +
+	"ArwGFsEEAQYKAfECvAYW+QkBBgoB8QK8BhaXCAELCgHxArwGFpcIkgHxArwGFpcI" +
+	"AQYKAfECvAYWuQcBBAoB8QLVAwEFCgHxApIB8QIBBQoB8QKcBPECAQgKAfECnATx" +
+	"ArwGkgG8BgEDCgHgBgEGCgHgBtIFFuoPAQYKAeAG0gUW2AUBBgoB4AbSBRaOBAEG" +
+	"CgHgBtIFFoUGAQcKAeAG0gUWoQT+AgEGCgHgBtIFFo8MAQYKAeAG0gUWqQEBBgoB" +
+	"4AbSBRaIBAEGCgHgBtIFFrYdAQYKAeAG0gUWzQYBBgoB4AbCBBbYBQEHCgHgBsIE" +
+	"FtgFMAEDCgGXAQEDCgG/DAEDCgGtEgEDCgH0BQEDCgGDHAEDCgGTAwEFCgGTAxYU" +
+	"AQUKAZMDFrcFAQUKAZMDFtgFAQUKAZMDFuoEAQUKAZMDFjgBBQoBkwMWOgEFCgGT" +
+	"AxaREQEFCgGTAxYwAQUKAZMDFs0GAQUKAZMDnASTAwEDCgGOCwEFCgGOCxaQDAEF" +
+	"CgGOCxbYBAEFCgGOCxafAwEFCgGOC6YJjgsBAwoBnAEBAwoBu1MBAwoBoAYBAwoB" +
+	"nAUBAwoBuAEBBAoBzAS9GwEDCgHMBAEFCgHMBBYUAQUKAcwEFtsFAQUKAcwEFskK" +
+	"AQUKAcwEFoIBAQUKAcwEFokIAQUKAcwEFt4FAQUKAcwEFqgHAQUKAcwEFrkEAQUK" +
+	"AcwEFvECAQUKAcwEFpcIAQUKAcwEFpwLAQUKAcwEFp0IAQUKAcwEFs0GAQUKAcwE" +
+	"Fv0eAQUKAcwEFuMIAQUKAcwEFuUIAQUKAcwEFrkHAQUKAcwEkgHMBAEECgHMBP4C" +
+	"AQMKAZcIAQYKAZcI4AIW7gcBAwoB9lUBAwoBmAsBAwoBqAIBAwoBswcBBQoBswcW" +
+	"FAEFCgGzBxbWBQEFCgGzBxapBQEFCgGzBxaIBAEFCgGzBxbcCAEFCgGzBxawCwEF" +
+	"CgGzBxYwAQcKAbMHFjBgFAEDCgGZHQEDCgGDWQEDCgG0BwEDCgG6AwEECgG6A9sG" +
+	"AQsKAboDkgG6A4MIkgGDCMEEkgHBBAELCgG6A5IBugOdCJIBnQjBBJIBwQQBAwoB" +
+	"lVkBAwoBllkBAwoBgBMBAwoBmVkBAwoBmlkBAwoBnAsBBQoBnAsWFAEFCgGcCxa5" +
+	"BgEDCgGLBwEFCgGLBxYUAQUKAYsHFskLAQUKAYsHFqMNAQUKAYsHFsANAQUKAYsH" +
+	"FsEEAQUKAYsHFqEEAQYKAYsHFqEE/gIBBQoBiwcW9goBBQoBiwcW0xEBBQoBiwcW" +
+	"uQ4BBQoBiwcWlwgBAwoBo1kBAwoBnQsBBAoBnQvVAwEICgGdC5IBnQuvCJIBrwgB" +
+	"AwoBkQ8BAwoByQYBBgoByQaSAc8CyQYBAwoB3AgBAwoBxlkBAwoBnQUBBAoBnQX+" +
+	"AgEDCgGyHQEDCgGVDwEGCgGVD5IBlQ/cCAEDCgG1HQEDCgG2HQEDCgGyBQEFCgGy" +
+	"BZIBsgUBAwoB2lsBAwoB21sBAwoB8R0BAwoBpAsBAwoB3VsBAwoBqA8BAwoB6VsB" +
+	"AwoBtAEBBAoBtAG1AwEFCgG0ARb2AwEFCgG0ARa5BAEECgG0Af4CAQMKAZ0IAQsK" +
+	"AZ0IkgGdCIkEkgGJBJgFkgGYBQEECgGdCGUBAwoBvQEBBQoBvQEW2AUBBQoBvQEW" +
+	"hQYBBQoBvQEWugMBBQoBvQEWyQYBBQoBvQEWywkBBQoBvQEW2AQBAwoBqgYBAwoB" +
+	"lwoBAwoB+gMBBAoB+gP+AgEDCgHiBQEECgHiBdUDAQMKAcwTAQMKAZ4KAQUKAZ4K" +
+	"FqoGAQUKAZ4KkgGeCgELCgGeCpIBngqDCJIBgwjBBJIBwQQBAwoBiAUBAwoBywkB" +
+	"AwoB6R4BAwoB9gwBAwoBsAsBCAoBsAuSAbALnwOSAZ8DAQMKATABBAoBMIUGAQUK" +
+	"ATAwMAELCgEwkgEwlwGSAZcBwQSSAcEEAQgKATCSATCoD5IBqA8BCgoBMJIBMDD4" +
+	"AZIBMPgBAQMKAc0GAQMKAf0eAQMKAdgEAQQKAdgE9goBBQoB2AQW1gUBBQoB2AQW" +
+	"uQQBCQoB2AQWMGAwYDABCgoB2AQWMGAwYDD+AgEECgHYBP4CAQQKAdgEpQYBBgoB" +
+	"2ASlBhbWBQEDCgGZYAEDCgHjCAEFCgHjCBbgAgEHCgHjCBaQBGC/AQEFCgHjCBaz" +
+	"BwEFCgHjCBYwAQMKAatgAQMKAcUPAQUKAcUPFroGAQUKAcUPFpcBAQMKAeQIAQQK" +
+	"AeQI/gIBAwoB5QgBBQoB5QicBOUIAQQKAeUIpQYBAwoBuAcBBwoBuAcWFGCCAQEH" +
+	"CgG4BxYUYK0BAQUKAbgHFv0FAQUKAbgHFoIBAQUKAbgHFvECAQUKAbgHFs0GAQcK" +
+	"AbgHFs0GYPoCAQcKAbgHFs0GYNgFAQMKAcRgAQMKAcZgAQMKAcdgAQMKAegEAQUK" +
+	"AegEFhQBBQoB6AQW6g8BBQoB6AQWdwEFCgHoBBbNEAEFCgHoBBbDAQEFCgHoBBbD" +
+	"CAEGCgHoBBaoBdUDAQUKAegEFsEEAQUKAegEFoQGAQUKAegEFoIBAQUKAegEFqEE" +
+	"AQUKAegEFrkGAQUKAegEFp4RAQUKAegEFpAFAQUKAegEFqgHAQUKAegEFrYOAQUK" +
+	"AegEFpcBAQUKAegEFroDAQUKAegEFpUPAQUKAegEFogFAQcKAegEFjBghQYBBQoB" +
+	"6AQW2AQBBQoB6AQWtAsBAwoBtAsBBQoBtAsWMAEDCgG5BwEFCgG5BxYUAQUKAbkH" +
+	"FqoFAQUKAbkHFt4FAQUKAbkHFqoGAQMKAdBgAQMKAc1gAQMKAc9gAQMKAdNgAQMK" +
+	"AYwfAQUKAYwfFroGAQMKAd5gAQMKAZ8DAQQKAZ8D1QMBBgoBnwNlFt4FAQMKAb5l" +
+	"AQMKAcFlAQMKActlAQMKAesHAQUKAesHkgHrBwEDCgHxZQEDCgHkHwEFCgHkH58Z" +
+	"1QMBAwoB9WUBAwoB5h8BAwoBwAcBAwoBlRQBBQoBlRQWFAEDCgGFZgEDCgGGZgEE" +
+	"CgGiGegLAQUKAbcFFvECAQUKAdIFFhQBBQoBjw0WtkQBCAoB2wTCBBbNBmD5CQED" +
+	"CgH1NAEFCgGYBWDJBgEFCgHJCha6AwEFCgGqBBaFBgEFCgGqBBa6AwEJCgGcAhbz" +
+	"A2CJCGAUAQUKAZwCFu4HAQMKAbA8AQMKAYA9AQMKAZVAAQUKAVoW5Q8BBQoBWhaI" +
+	"JwEFCgFaFqkFAQUKAVoWhQYBBwoBWhb2AxbuBwEFCgFaFqgHAQUKAVoWlwEBBQoB" +
+	"WhbKWQEFCgFaFogFAQUKAVoWuAcBBQoB4AIWjgUBBgoBgASlBhbWBQEHCgGABBb+" +
+	"B2CnBwEFCgGABBbJBgEDCgHTGgEFCgH9ChafAwEDCgGQSwEDCgGREgEDCgHRTAEF" +
+	"CgHlBBbJCwEFCgHlBBaOBQEGCgG4G9sC7QOOAwEECgGDHLUDAQMKAbZRAQYKAcwE" +
+	"FqEE/gIBAwoB8V0BBQoB4wgWkAQBBAoBMDBnBQquAQE2+gIBBQquAQFB+gIBBQqu" +
+	"AQFO+gIBBQquAQFV+gIBBQquAQF1+gIBBQquAQGAAfoCAQUKrgEBdPoCAQUKrgEB" +
+	"iAH6AgEFCq4BAUGYBQEFCq4BAU6YBQEFCq4BAVWYBQEFCq4BAXWYBQEFCq4BAYAB" +
+	"mAUBBQquAQF0mAUBBQquAQGIAZgFAQUKrgEBTjABBQquAQFVMAEFCq4BAXUwAQUK" +
+	"rgEBgAEwAQUKrgEBdDABBQquAQGIATABBQquAQExxAgBBQquAQE2xAgBBQquAQFB" +
+	"xAgBBQquAQFOxAgBBQquAQFVxAgBBQquAQF1xAgBBQquAQGAAcQIAQUKrgEBdMQI" +
+	"AQUKrgEBiAHECAEFCq4BATH5CwEFCq4BATb5CwEFCq4BAUH5CwEFCq4BAU75CwEF" +
+	"Cq4BAVX5CwEFCq4BATa0BwEFCq4BAUG0BwEHCq4BAUG0B6UEDwEFCq4BAU60BwEF" +
+	"Cq4BAVW0BwEFCq4BAXW0BwEFCq4BAYABtAcBBQquAQF0tAcBBQquAQGIAbQHAQUK" +
+	"rgEBMZoLAQUKrgEBNpoLAQUKrgEBQZoLAQcKrgEBQZoLpQQPAQUKrgEBTpoLAQUK" +
+	"rgEBVZoLAQgKrgEBtAcWwwhgmAUBCAquAQG0BxbDCGC2DgEFCq4BATG6CgEFCq4B" +
+	"ATa6CgEFCq4BAUG6CgEHCq4BAUG6CqUEDwEFCq4BAU66CgEFCq4BAVW6CgEHCq4B" +
+	"AUGlBA/cOQEHCq4BAUGlBA/dOQEHCq4BAU6lBA/NEQEHCq4BAU6lBA+GRgEICq4B" +
+	"AU6lBA/NERQBCAquAQFOpQQPzRE+AQcKrgEBdaUED4knAQcKrgEBgAGlBA+7QQEI" +
+	"Cq4BAYABpQQP/Q0UAQgKrgEBgAGlBA/9DT4BBwquAQF0pQQP0WABBwquAQF0pQQP" +
+	"0mABBwquAQGIAaUED4IZAQcKrgEBiAGlBA+wQQEHCq4BAYgBpQQPsUEBCAquAQGI" +
+	"AaUED4IZFAEGCq4BATb6AtUDAQYKrgEBQfoC1QMBBgquAQFO+gLVAwEGCq4BAVX6" +
+	"AtUDAQYKrgEBdfoC1QMBBQquAQEx1gkBBQquAQE21gkBBQquAQFB1gkBBQquAQFO" +
+	"1gkBBwquAQFO1gmlBA8BBQquAQFV1gkBBwquAQFV1gmlBA8BBAquAQHMTAEECq4B" +
+	"ActMAQUKrgEBMe4XAQUKrgEBNu4XAQYKrgEBMY0HmAUBBgquAQE24QiYBQEGCq4B" +
+	"AVXAHZgFAQgKrgEBMY0HpQQPFAEICq4BATbhCKUEDxQBBgquAQEx6QP6AgEGCq4B" +
+	"ATHuA/oCAQcKrgEBJOsPMY0TAQcKrgEBJOsPMe4DAQYKrgEBMe4D7g0BBgquAQEx" +
+	"bu4NAQYKrgEB6AsxjQcBBgquAQHoCzbhCAEFCq4BAegL3AQBBQquAQHoC4UEAQcK" +
+	"rgEBTjClBA8BBwquAQFVMKUEDwEHCq4BAXUwpQQPAQcKrgEBgAEwpQQPAQcKrgEB" +
+	"dDClBA8BBwquAQGIATClBA8CBwrEAQEk6w+hBcYKAQUKxAEBU5sEAQUKxAEBuwGb" +
+	"BAEFCsQBAbsB0x4BBQrEAQG7AexSDAYKAbcFFvEC1QMBBQoBtwUW3AgBBQoB0gkW" +
+	"/gcBBgoB1gUWmAXVAwEFCgHICxbSBQEFCgHICxaJBAEFCgHICxafAwEHCgHqBJIB" +
+	"6gQW/BoBBQoBvwEWqgYBBQoB2wQW3gUBBQoB3gkWoQQBBwoB3gkWMDAwAQUKAcIQ" +
+	"FtgEAQUKAY4EFuUPAQUKAY4EFvoCAQcKAY4EFvoC2QQnAQUKAY4EFsANAQUKAY4E" +
+	"Fs0QAQUKAY4EFtEKAQUKAY4EFsMBAQUKAY4EFo4FAQYKAY4EFu0J/gIBBQoBjgQW" +
+	"qQUBBQoBjgQWggEBBQoBjgQW9gMBBgoBjgQWoQT+AgEFCgGOBBa6BgEFCgGOBBbe" +
+	"BQEFCgGOBBb2CgEHCgGOBBb2CmC6BgEFCgGOBBaIRQEFCgGOBBamAgEHCgGOBBam" +
+	"AhbeBQEHCgGOBBbSEWCpBQEFCgGOBBb5CQEFCgGOBBbnEQEFCgGOBBa5BAEFCgGO" +
+	"BBaJBAEFCgGOBBaTAwEFCgGOBBa6AwEGCgGOBBadBf4CAQUKAZsHFt4FAQUKAZsH" +
+	"FvQFAQQKAdEK/AEBBQoB/gecBP4HAQYKAaoEpQYW+gIBBgoBqgSlBhb2AwEHCgGq" +
+	"BKUGFqEE/gIBBgoBqgSlBhapAQEGCgGqBKUGFqcHAQYKAaoEpQYWkAQBBgoBqgSl" +
+	"BhbnEQEGCgGqBKUGFrIFAQUKAaoEFrIFAQUKAZwCFsgLAQYKAZwCFt4J/gIBCAoB" +
+	"nAIWjgQWoQT+AgEHCgGcAhaOBBa6BgEFCgGcAhbqFwEFCgGcAhbDAQEHCgGcAhbB" +
+	"BGDaBQEHCgGcAhbBBGCIBAEHCgGcAhaCAWAUAQcKAZwCFvYKYLoGAQUKAZwCFqYC" +
+	"AQcKAZwCFqYCFt4FAQUKAZwCFtIRAQUKAZwCFokGAQUKAZwCFogEAQcKAZwCFogE" +
+	"YJsHAQcKAZwCFogEYI4FAQUKAZwCFosHAQUKAZwCFuYfAQYKAeYN2wLtA44DAQUK" +
+	"AfgQFjABBwoBqQUWqQWcBKkFAQYKAe4JFqEE/gIBBwoBhQZgqQUWqgYBBQoBggHV" +
+	"A/4CAQkKAfYDFvoCkgH2Axb6AgEFCgFaFuoEAQUKAVoWWgEHCgFaFjAwMAEFCgFa" +
+	"FuUIAQcKAeACFsAHkgHABwEDCgGHRQEDCgGJRQEDCgGKRQEDCgGLRQEDCgHYGQED" +
+	"CgGMRQEDCgGNRQEGCgHZGZIB2Rn+AgEDCgGORQEFCgHaGZIB2hkBAwoBj0UBAwoB" +
+	"kEUBAwoBkUUBAwoBkkUBAwoBk0UBAwoBlEUBAwoBlUUBAwoBlkUBAwoBl0UBAwoB" +
+	"mEUBAwoBmUUBAwoBmkUBAwoBm0UBAwoBnEUBAwoBnUUBAwoBnkUBAwoBn0UBAwoB" +
+	"oEUBAwoB9woBBQoB9woWhAYBBQoB9woWoQQBBwoB9woW9AVg+woBCQoB9woW9AVg" +
+	"9AVg+woBBwoB9woWzQZg4AEBAwoBoUUBAwoBokUBAwoBo0UBAwoBpEUBAwoBpUUB" +
+	"AwoBpkUBAwoBp0UBAwoBqEUBAwoBqUUBAwoBqkUBAwoB0gcBBQoB0gcW+gIBBQoB" +
+	"0gcW1gUBBwoB0gcW4Qn+Av4CAQUKAdIHFvUKAQUKAdIHFqkBAQUKAdIHFpcKAQUK" +
+	"AdIHFvoDAQUKAdIHFs0GAQUKAdIHFtgEAQUKAdIHFrQLAQMKAatFAQMKAYoIAQUK" +
+	"AYoIFskKAQUKAYoIFsMBAQUKAYoIFqEEAQYKAYoIFqEE/gIBBQoBiggWiQQBCQoB" +
+	"iggW9AVg9AVg+woBBwoBiggWgBNgkAUBBQoBiggW2AQBBQoBiggWtAsBAwoBrUUB" +
+	"AwoBrkUBBgoBgAT+Ahb6AgEFCgGABBaYBQEFCgGABBbeCgEFCgGABBb0BQEJCgGA" +
+	"BBb0BWD0BWD7CgEFCgGABBaqBgEFCgG5BGDcHwEHCgGJBpIBiQYWwwEBBwoBiQaS" +
+	"AYkGFokIAQYKAeUEFt4J/gIBBQoB5QQWqQUBBQoB5QQWwRgBBQoB5QQW9gMBBQoB" +
+	"5QQWqxkBBQoB5QQW2BkBBQoB5QQW+QkBBwoB5QQW9AVg9AUBBQoB5QQWMAEHCgHl" +
+	"BBYwYDABBQoB5QQWtAsBBgoBzAT+AhaCAQEFCgHMBBb8AgEHCgHMBBa6A9kEJwEF" +
+	"CgHMBBaqBgEECgGZHdUDAQUKAboDkgG6AwEFCgG6A2CLCQEFCgG6A2CBGwEFCgG6" +
+	"A2CYCwEHCgHcCGCOBBaJBAEHCgHcCGCbBxbzAwEFCgGdBRaqBgEFCgGqBmDMBAEG" +
+	"CgH2DBaoBdUDAQYKAfYMFkGYBQEECgG4B88CAQUKAbgHFtgEAQUKAegEFowXAQUK" +
+	"AegEFqxFAQUKAegEFvsKAQMKAdwfAQcKAcAHkgHAB2CYCwEHCgGVFBZBmAXVA80U" +
+	"A8kBAcQsAQPJAQHFLAEDyQEBxiwBA8kBAccsAQPJAQHILAEDyQEBySwBA8kBAcos" +
+	"AQPJAQHLLAEDyQEBzCwBA8kBAc0sAQPJAQHOLAEDyQEBzywBA8kBAdAsAQPJAQHR" +
+	"LAEDyQEB0iwBA8kBAdMsAQPJAQHULAEDyQEB1SwBA8kBAdYsAQPJAQHXLAEDyQEB" +
+	"2CwBA8kBAdksAQPJAQHaLAEDyQEB2ywBA8kBAdwsAQPJAQHdLAEDyQEB3iwBA8kB" +
+	"Ad8sAQPJAQHgLAEDyQEB4SwBA8kBAeIsAQPJAQHjLAEDyQEB5CwBA8kBAeUsAQPJ" +
+	"AQHmLAEDyQEB5ywBA8kBAegsAQPJAQHpLAEDyQEB6iwBA8kBAessAQPJAQHsLAED" +
+	"yQEB7SwBA8kBAe4sAQPJAQHvLAEDyQEB8CwBA8kBAfEsAQPJAQHyLAEDyQEB8ywB" +
+	"A8kBAfQsAQPJAQH1LAEDyQEB9iwBA8kBAfcsAQPJAQH4LAEDyQEB+SwBA8kBAfos" +
+	"AQPJAQH7LAEDyQEB/CwBA8kBAf0sAQPJAQH+LAEDyQEB/ywBA8kBAYAtAQPJAQGB" +
+	"LQEDyQEBgi0BA8kBAYMtAQPJAQGELQEDyQEBhS0BA8kBAYYtAQPJAQGHLQEDyQEB" +
+	"iC0BA8kBAYktAQPJAQGKLQEDyQEBiy0BA8kBAYwtAQPJAQGNLQEDyQEBji0BA8kB" +
+	"AY8tAQPJAQGQLQEDyQEBkS0BA8kBAZItAQPJAQGTLQEDyQEBlC0BA8kBAZUtAQPJ" +
+	"AQGWLQEDyQEBly0BA8kBAZgtAQPJAQGZLQEDyQEBmi0BA8kBAZstAQPJAQGcLQED" +
+	"yQEBnS0BA8kBAZ4tAQPJAQGfLQEDyQEBoC0BA8kBAaEtAQPJAQGiLQEDyQEBoy0B" +
+	"A8kBAaQtAQPJAQGlLQEDyQEBpi0OAw0GmBQBAw0GmRQBAw0GmhQBAw0GmxQBAw0G" +
+	"nBQBAw0G/x8BAw0GnRQBAw0GgCABAw0GgSABAw0GnhQBAw0GnxQBAw0GoBQBAw0G" +
+	"oRQBAw0GohQBAw0GoxQBAw0GpBQBAw0GpRQBAw0GgyABAw0GphQBAw0GpxQBAw0G" +
+	"qBQBAw0GhCABAw0GqRQBAw0GqhQBAw0GqxQBAw0GrBQBAw0GrRQBAw0GrhQBAw0G" +
+	"rxQBAw0GsBQBAw0GsRQBAw0GshQBAw0GsxQBAw0GtBQBAw0GtRQBAw0GthQBAw0G" +
+	"txQBAw0GhyABAw0GuBQBAw0GuRQBAw0GuhQBAw0GuxQBAw0GvBQBAw0GvRQBAw0G" +
+	"vhQBAw0GvxQBAw0GiSABAw0GwBQBAw0GwRQBAw0GiyABAw0GwhQBAw0GjCABAw0G" +
+	"wxQBAw0GxBQBAw0GxRQBAw0GxhQBAw0GxxQBAw0GyBQBAw0GyRQBAw0GyhQBAw0G" +
+	"yxQBAw0GzBQBAw0GzRQBAw0GzhQBAw0GzxQBAw0G0BQBAw0G0RQBAw0G0hQBAw0G" +
+	"0xQBAw0G1BQBAw0G1RQBAw0G1hQBAw0G1xQBAw0G2BQBAw0G2RQBAw0G2hQBAw0G" +
+	"2xQBAw0G3BQBAw0G3RQBAw0G3hQBAw0G+xUBAw0G/BUBAw0G/RUBAw0G/hUBAw0G" +
+	"/xUBAw0GuycBAw0GgBYBAw0GgRYBAw0GghYBAw0GgxYBAw0G3SoBAw0G3ioBAw0G" +
+	"3yoBAw0G4CoBAw0G4SoBAw0G4ioBAw0G4yoBAw0G5CoBAw0G5SoBAw0G5ioBAw0G" +
+	"5yoBAw0G6CoBAw0G6SoBAw0G6ioBAw0G6yoBAw0G7CoBAw0G7SoBAw0G7ioBAw0G" +
+	"7yoBAw0G8CoBAw0G8SoBAw0G8ioBAw0G8yoBAw0G9CoBAw0G9SoBAw0G9ioBAw0G" +
+	"9yoBAw0G+CoBAw0GlDQBAw0GlTQBAw0GljQBAw0GlzQBAw0GmDQBAw0GmTQBAw0G" +
+	"mjQBAw0GmzQBAw0GnDQBAw0GnTQBAw0GnjQBAw0GnzQBAw0GoDQBAw0GoTQBAw0G" +
+	"ojQBAw0GozQBAw0GpDQBAw0GpTQBAw0GpjQBAw0GpzQBAw0GqDQBAw0GqTQBAw0G" +
+	"qjQBAw0GqzQBAw0GrDQBAw0GrTQBAw0GrjQBAw0GrzQBAw0GsDQBAw0GsTQBAw0G" +
+	"sjQBAw0GszQBAw0GtDQBAw0GtTQBAw0GtjQBAw0GtzQBAw0GuDQBAw0GuTQBAw0G" +
+	"ujQBAw0GuzQBAw0GvDQBAw0GvTQBAw0GvjQBAw0GvzQBAw0GwDQBAw0GwTQBAw0G" +
+	"wjQBAw0GwzQBAw0GxDQBAw0GxTQBAw0GxjQBAw0GxzQBAw0GyDQBAw0GyTQBAw0G" +
+	"yjQBAw0GyzQBAw0GzDQBAw0GzTQBAw0GzjQBAw0GzzQBAw0G0DQBAw0G0TQBAw0G" +
+	"0jQBAw0G0zQBAw0G1DQBAw0G1TQBAw0G1jQBAw0G1zQBAw0G2DQBAw0G2TQBAw0G" +
+	"2jQBAw0G2zQBAw0G3DQBAw0G3TQBAw0G3jQBAw0G3zQBAw0G4DQBAw0G4TQBAw0G" +
+	"4jQBAw0G4zQBAw0G5DQBAw0G5TQBAw0G5jQBAw0G5zQBAw0G6DQBAw0G6TQBAw0G" +
+	"6jQBAw0G6zQBAw0G7DQBAw0G7TQBAw0G7jQBAw0G7zQBAw0G6zgBAw0G7DgBAw0G" +
+	"7TgBAw0G7jgBAw0G7zgBAw0G8DgBAw0G8TgBAw0G8jgBAw0G8zgBAw0G9DgBAw0G" +
+	"9TgBAw0G9jgBAw0G9zgBAw0G+DgBAw0G+TgBAw0G+jgBAw0G+zgBAw0G/DgBAw0G" +
+	"/TgBAw0G/jgBAw0G/zgBAw0GgDkBAw0GgTkBAw0GgjkBAw0GgzkBAw0GhDkBAw0G" +
+	"hTkBAw0GhjkBAw0GhzkBAw0GiDkBAw0GiTkBAw0GijkBAw0GizkBAw0GjDkBAw0G" +
+	"jTkBAw0GjjkBAw0GjzkBAw0GkDkBAw0GkTkBAw0GkjkBAw0GkzkBAw0GlDkBAw0G" +
+	"lTkBAw0GljkBAw0GhDoBAw0GhToBAw0GhjoBAw0GhzoBAw0GiDoBAw0GiToBAw0G" +
+	"ijoBAw0GizoBAw0GjDoBAw0GjToBAw0GjjoBAw0GjzoBAw0GkDoBAw0GkToBAw0G" +
+	"kjoBAw0GkzoBAw0GlDoBAw0GlToBAw0GljoBAw0GlzoBAw0GmDoBAw0GmToBAw0G" +
+	"mjoBAw0GmzoBAw0GnDoBAw0GnToBAw0GnjoBAw0GnzoBAw0GoDoBAw0GoToBAw0G" +
+	"ojoBAw0GozoBAw0GpDoBAw0GpToBAw0GpjoBAw0GpzoBAw0GqDoBAw0GqToBAw0G" +
+	"qjoBAw0GqzoBAw0GrDoBAw0GrToBAw0GrjoBAw0GrzoBAw0GsDoBAw0GsToBAw0G" +
+	"sjoBAw0GszoBAw0GtDoBAw0GtToBAw0GtjoBAw0GtzoBAw0GuDoBAw0GuToBAw0G" +
+	"ujoBAw0GuzoBAw0GvDoBAw0GvToBAw0GvjoBAw0GvzoBAw0GwDoBAw0GwToBAw0G" +
+	"wjoBAw0GwzoBAw0GxDoBAw0G0DsBAw0G0TsBAw0G0jsBAw0G0zsBAw0G1DsBAw0G" +
+	"1TsBAw0G1jsBAw0G1zsBAw0G2DsBAw0G2TsBAw0G2jsBAw0G2zsBAw0G3DsBAw0G" +
+	"3TsBAw0G3jsBAw0G3zsBAw0G4DsBAw0G4TsBAw0G4jsBAw0G4zsBAw0G5DsBAw0G" +
+	"5TsBAw0G5jsBAw0G5zsBAw0G6DsBAw0G6TsBAw0G6jsBAw0G6zsBAw0G7DsBAw0G" +
+	"7TsBAw0G7jsBAw0G7zsBAw0G8DsBAw0G8TsBAw0G8jsBAw0G8zsBAw0G9DsBAw0G" +
+	"9TsBAw0G9jsBAw0G9zsBAw0G+DsBAw0G+TsBAw0G+jsBAw0G+zsBAw0G/DsBAw0G" +
+	"/TsBAw0G/jsBAw0G/zsBAw0GgDwBAw0GgTwBAw0GgjwBAw0GgzwBAw0GhDwBAw0G" +
+	"hTwBAw0GhjwBAw0GhzwBAw0GiDwBAw0GiTwBAw0GijwBAw0GizwBAw0GjDwBAw0G" +
+	"jTwBAw0GjjwBAw0GjzwBAw0G2D0BAw0G2T0BAw0G2j0BAw0G2z0BAw0G3D0BAw0G" +
+	"3T0BAw0G3j0BAw0G3z0BAw0G4D0BAw0G00ABAw0G1EABAw0G1UABAw0G1kABAw0G" +
+	"10ABAw0G2EABAw0G2UABAw0G2kABAw0G20ABAw0G3EABAw0G3UABAw0G3kABAw0G" +
+	"30ABAw0G4EABAw0G4UABAw0G4kABAw0G40ABAw0G5EABAw0G5UABAw0GyUIBAw0G" +
+	"ykIBAw0Gy0IBAw0GzEIBAw0GzUIBAw0GzkIBAw0Gz0IBAw0G0EIBAw0G5UQBAw0G" +
+	"5kQBAw0G50QBAw0G6EQBAw0G6UQBAw0G6kQBAw0G60QBAw0G7EQBAw0G7UQBAw0G" +
+	"7kQBAw0GjhoBAw0G2UYBAw0G2kYBAw0GjxoBAw0GkBoBAw0G20YBAw0GkRoBAw0G" +
+	"khoBAw0GkxoBAw0GlBoBAw0GlRoBAw0GlhoBAw0GlxoBAw0G3EYBAw0GmBoBAw0G" +
+	"mRoBAw0G3UYBAw0G3kYBAw0G30YBAw0G4EYBAw0G4UYBAw0G4kYBAw0G40YBAw0G" +
+	"5EYBAw0GmhoBAw0GmxoBAw0GnBoBAw0G5UYBAw0GnRoBAw0G5kYBAw0GnhoBAw0G" +
+	"50YBAw0GnxoBAw0GoBoBAw0GoRoBAw0GohoBAw0GoxoBAw0G6EYBAw0GpBoBAw0G" +
+	"pRoBAw0G6UYBAw0GphoBAw0GpxoBAw0GqBoBAw0GqRoBAw0G6kYBAw0GqhoBAw0G" +
+	"qxoBAw0GrBoBAw0G60YBAw0GrRoBAw0GrhoBAw0G7EYBAw0G7UYBAw0GrxoBAw0G" +
+	"sBoBAw0GsRoBAw0GshoBAw0GsxoBAw0GtBoBAw0GtRoBAw0G7kYBAw0GthoBAw0G" +
+	"txoBAw0GuBoBAw0GuRoBAw0G10oBAw0G2EoBAw0G2UoBAw0G2koBAw0G20oBAw0G" +
+	"3EoBAw0G3UoBAw0G3koBAw0G30oBAw0G4EoBAw0G4UoBAw0G4koBAw0G40oBAw0G" +
+	"5EoBAw0G5UoBAw0G5koBAw0G50oBAw0G6EoBAw0G6UoBAw0G6koBAw0G60oBAw0G" +
+	"7EoBAw0G7UoBAw0G7koBAw0G70oBAw0G8EoBAw0G8UoBAw0G8koBAw0G80oBAw0G" +
+	"9EoBAw0G9UoBAw0G9koBAw0G90oBAw0G+EoBAw0G+UoBAw0G+koBAw0G+0oBAw0G" +
+	"/EoBAw0G/UoBAw0G/koBAw0G/0oBAw0GgEsBAw0GgUsBAw0GgksBAw0Gg0sBAw0G" +
+	"hEsBAw0GhUsBAw0GhksBAw0Gh0sBAw0G/0wBAw0GgE0BAw0GgU0BAw0Ggk0BAw0G" +
+	"g00BAw0GhE0BAw0GhU0BAw0Ghk0BAw0Gh00BAw0GiE0BAw0GiU0BAw0Gik0BAw0G" +
+	"i00BAw0GjE0BAw0GjU0BAw0Gjk0BAw0Gj00BAw0GkE0BAw0GkU0BAw0Gkk0BAw0G" +
+	"k00BAw0GlE0BAw0G900BAw0G+E0BAw0G+U0BAw0G+k0BAw0G+00BAw0G/E0BAw0G" +
+	"/U0BAw0G/k0BAw0G/00BAw0GgE4BAw0GgU4BAw0Ggk4BAw0Gg04BAw0GhE4BAw0G" +
+	"hU4BAw0Ghk4BAw0Gh04BAw0GiE4BAw0GiU4BAw0Gik4BAw0Gi04BAw0GjE4BAw0G" +
+	"jU4BAw0Gjk4BAw0Gj04BAw0GkE4BAw0G6E4BAw0G6U4BAw0G6k4BAw0G604BAw0G" +
+	"7E4BAw0G7U4BAw0G7k4BAw0G704BAw0G8E4BAw0G8U4BAw0G8k4BAw0G804BAw0G" +
+	"9E4BAw0G9U4BAw0G9k4BAw0G904BAw0G+E4BAw0G+U4BAw0G+k4BAw0G+04BAw0G" +
+	"/E4BAw0G/U4BAw0G/k4BAw0G/04BAw0GgE8BAw0GgU8BAw0Ggk8BAw0Gg08BAw0G" +
+	"hE8BAw0GhU8BAw0Ghk8BAw0Gh08BAw0GiE8BAw0GiU8BAw0Gik8BAw0Gi08BAw0G" +
+	"jE8BAw0GjU8BAw0Gjk8BAw0Gj08BAw0GkE8BAw0GkU8BAw0Gkk8BAw0Gk08BAw0G" +
+	"lE8BAw0GlU8BAw0Glk8BAw0Gl08BAw0GmE8BAw0GmU8BAw0Gmk8BAw0Gm08BAw0G" +
+	"nE8BAw0GnU8BAw0Gnk8BAw0Gn08BAw0GoE8BAw0GoU8BAw0Gok8BAw0Go08BAw0G" +
+	"pE8BAw0GpU8BAw0Gpk8BAw0Gp08BAw0GqE8BAw0GqU8BAw0Gqk8BAw0Gq08BAw0G" +
+	"rE8BAw0GrU8BAw0Grk8BAw0Gr08BAw0GsE8BAw0GsU8BAw0Gsk8BAw0G/E8BAw0G" +
+	"/U8BAw0G/k8BAw0G/08BAw0GgFABAw0GgVABAw0GglABAw0Gg1ABAw0GhFABAw0G" +
+	"hVABAw0GhlABAw0Gh1ABAw0GiFABAw0GvFIBAw0GvVIBAw0GvlIBAw0Gv1IBAw0G" +
+	"wFIBAw0GwVIBAw0GwlIBAw0GklMBAw0Gk1MBAw0GlFMBAw0GlVMBAw0GllMBAw0G" +
+	"l1MBAw0GmFMBAw0GmVMBAw0GmlMBAw0Gm1MBAw0GnFMBAw0GnVMBAw0GnlMBAw0G" +
+	"n1MBAw0GoFMBAw0GoVMBAw0GolMBAw0Go1MBAw0GpFMBAw0GpVMBAw0GplMBAw0G" +
+	"p1MBAw0GqFMBAw0GqVMBAw0GqlMBAw0Gq1MBAw0GrFMBAw0GrVMBAw0GrlMBAw0G" +
+	"r1MBAw0GsFMBAw0GsVMBAw0GslMBAw0Gs1MBAw0GmVUBAw0GmlUBAw0Gm1UBAw0G" +
+	"nFUBAw0GnVUBAw0GnlUBAw0Gn1UBAw0GoFUBAw0GoVUBAw0GolUBAw0Go1UBAw0G" +
+	"pFUBAw0GpVUBAw0GplUBAw0Gp1UBAw0GqFUBAw0GqVUBAw0GqlUBAw0Gq1UBAw0G" +
+	"rFUBAw0GrVUBAw0GrlUBAw0Gr1UBAw0GsFUBAw0GsVUBAw0GslUBAw0Gs1UBAw0G" +
+	"tFUBAw0GtVUBAw0GtlUBAw0Gt1UBAw0GuFUBAw0GuVUBAw0GulUBAw0Gu1UBAw0G" +
+	"vFUBAw0GvVUBAw0GvlUBAw0Gv1UBAw0GwFUBAw0GwVUBAw0GwlUBAw0Gw1UBAw0G" +
+	"xFUBAw0GxVUBAw0GxlUBAw0Gx1UBAw0GyFUBAw0GyVUBAw0GylUBAw0Gy1UBAw0G" +
+	"zFUBAw0GzVUBAw0GzlUBAw0GtlwBAw0Gt1wBAw0GuFwBAw0GuVwBAw0GulwBAw0G" +
+	"u1wBAw0GvFwBAw0GvVwBAw0GvlwBAw0Gv1wBAw0GwFwBAw0GwVwBAw0GwlwBAw0G" +
+	"w1wBAw0GxFwBAw0GxVwBAw0GxlwBAw0Gx1wBAw0GyFwBAw0GyVwBAw0GylwBAw0G" +
+	"y1wBAw0GzFwBAw0GzVwBAw0GzlwBAw0Gz1wBAw0G0FwBAw0G0VwBAw0G0lwBAw0G" +
+	"01wBAw0G1FwBAw0G1VwBAw0G1lwBAw0G11wBAw0G2FwBAw0G2VwBAw0G2lwBAw0G" +
+	"21wBAw0G3FwBAw0G3VwBAw0G3lwBAw0G31wBAw0G4FwBAw0G4VwBAw0G418BAw0G" +
+	"5F8BAw0G5V8BAw0G5l8BAw0G518BAw0G6F8BAw0G6V8BAw0G6l8BAw0G618BAw0G" +
+	"7F8BAw0G7V8BAw0G7l8BAw0G718BAw0G8F8BAw0G8V8BAw0G8l8BAw0G818BAw0G" +
+	"9F8BAw0G9V8BAw0G9l8BAw0G918BAw0G+F8BAw0G+V8BAw0G+l8BAw0G+18BAw0G" +
+	"/F8BAw0G/V8BAw0G/l8BAw0G/18BAw0GgGABAw0GgWABAw0GgmABAw0Gg2ABAw0G" +
+	"hGABAw0GhWABAw0GhmABAw0Gh2ABAw0GiGABAw0GiWABAw0GimABAw0Gi2ABAw0G" +
+	"jGABAw0GjWABAw0GjmABAw0Gj2ABAw0GkGABAw0GkWABAw0G32ABAw0G4GABAw0G" +
+	"4WABAw0G4mABAw0G42ABAw0G5GABAw0G5WABAw0G5mABAw0G52ABAw0G6GABAw0G" +
+	"6WABAw0G6mABAw0G62ABAw0G7GABAw0G7WABAw0G7mABAw0G72ABAw0G8GABAw0G" +
+	"8WABAw0G8mABAw0G82ABAw0G9GABAw0G9WABAw0G9mABAw0G92ABAw0G+GABAw0G" +
+	"+WABAw0G+mABAw0G+2ABAw0G/GABAw0G/WABAw0G/mABAw0G/2ABAw0GgGEBAw0G" +
+	"gWEBAw0GgmEBAw0Gg2EBAw0GhGEBAw0GhWEBAw0GhmEBAw0Gh2EBAw0GiGEBAw0G" +
+	"iWEBAw0GimEBAw0Gi2EBAw0GjGEBAw0GjWEBAw0GjmEBAw0Gj2EBAw0GkGEBAw0G" +
+	"kWEBAw0GkmEBAw0Gk2EBAw0GlGEBAw0GlWEBAw0GlmEBAw0Gl2EBAw0GmGEBAw0G" +
+	"mWEBAw0GmmEBAw0Gm2EBAw0GnGEBAw0GnWEBAw0GnmEBAw0Gn2EBAw0GoGEBAw0G" +
+	"oWEBAw0GomEBAw0Go2EBAw0GpGEBAw0GpWEBAw0GpmEBAw0Gp2EBAw0GqGEBAw0G" +
+	"qWEBAw0GqmEBAw0Gq2EBAw0GwGIBAw0GwWIBAw0GwmIBAw0Gw2IBAw0GxGIBAw0G" +
+	"xWIBAw0GxmIBAw0Gx2IBAw0GyGIBAw0GyWIBAw0GymIBAw0Gy2IBAw0GzGIBAw0G" +
+	"zWIBAw0GzmIBAw0Gz2IBAw0G0GIBAw0G0WIBAw0G0mIBAw0G02IBAw0G1GIBAw0G" +
+	"1WIBAw0G1mIBAw0G12IBAw0G2GIBAw0G2WIBAw0G2mIBAw0G22IBAw0G3GIBAw0G" +
+	"3WIBAw0G3mIBAw0G32IBAw0GzWMBAw0GzmMBAw0Gz2MBAw0G0GMBAw0G0WMBAw0G" +
+	"0mMBAw0G02MBAw0G1GMBAw0G1WMBAw0G1mMBAw0G12MBAw0G2GMBAw0GiGQBAw0G" +
+	"iWQBAw0GimQBAw0Gi2QBAw0GjGQBAw0GjWQBAw0GjmQBAw0Gj2QBAw0GkGQBAw0G" +
+	"lGUBAw0GlWUBAw0GlmUBAw0Gl2UBAw0GmGUBAw0GmWUBAw0GmmUBAw0Gm2UBAw0G" +
+	"nGUBAw0GnWUBAw0GnmUBAw0Gn2UBAw0GoGUBAw0GoWUBAw0GomUBAw0Go2UBAw0G" +
+	"pGUBAw0GpWUBAw0GpmUBAw0Gp2UBAw0GqGUBAw0GqWUBAw0GqmUBAw0Gq2UBAw0G" +
+	"rGUBAw0GrWUBAw0GrmUBAw0Gr2UBAw0GsGUBAw0GsWUBAw0GsmUBAw0Gs2UBAw0G" +
+	"tGUBAw0GtWUBAw0GtmUBAw0Gt2UBAw0GuGUBAw0GuWUBAw0GumUBAw0Gu2UBAw0G" +
+	"vGUBAw0G0yQBAw0G1CQBAw0G1SQBAw0G1iQBAw0G1yQBAw0G2CQBAw0G2SQBAw0G" +
+	"2iQBAw0G2yQBAw0G3CQBAw0G3SQBAw0G3iQBAw0G3yQBAw0G4CQBAw0G4SQBAw0G" +
+	"4iQBAw0G4yQBAw0G5CQBAw0G5SQBAw0G5iQBAw0G5yQBAw0G6CQBAw0G6SQBAw0G" +
+	"6iQBAw0G6yQBAw0G7CQBAw0G7SQBAw0G7iQBAw0G7yQBAw0G8CQBAw0G8SQBAw0G" +
+	"8iQBAw0G8yQBAw0G9CQCBA0GU/IJAQQNBqwB8gkBBg0G/g3ZBLMD5AwBBg0G/g3Z" +
+	"BPQD5AwBBg0G/g3ZBLMDqQQBBg0G/g3ZBPQDqQQBBA0GmwVMAQQNBvEI9hIBBA0G" +
+	"qQT2EsgfAxoGmBQBAxoGmRQBAxoGmhQBAxoGmxQBAxoGnBQBAxoGnRQBAxoGnhQB" +
+	"AxoGnxQBAxoGoBQBAxoGoRQBAxoGgiABAxoGohQBAxoGoxQBAxoGpBQBAxoGpRQB" +
+	"AxoGphQBAxoGpxQBAxoGqBQBAxoGqRQBAxoGqhQBAxoGqxQBAxoGrBQBAxoGrRQB" +
+	"AxoGrhQBAxoGrxQBAxoGsBQBAxoGsRQBAxoGhSABAxoGshQBAxoGsxQBAxoGtBQB" +
+	"AxoGtRQBAxoGthQBAxoGtxQBAxoGuBQBAxoGuRQBAxoGuhQBAxoGuxQBAxoGvBQB" +
+	"AxoGvRQBAxoGvhQBAxoGiCABAxoGvxQBAxoGwBQBAxoGiiABAxoGwRQBAxoGwhQB" +
+	"AxoGwxQBAxoGxBQBAxoGxRQBAxoGxhQBAxoGjSABAxoGjiABAxoGxxQBAxoGyBQB" +
+	"AxoGyRQBAxoGyhQBAxoGyxQBAxoGzBQBAxoGzRQBAxoGzhQBAxoGzxQBAxoG0BQB" +
+	"AxoG0RQBAxoG0hQBAxoG0xQBAxoG1BQBAxoG1RQBAxoG1hQBAxoG1xQBAxoG2BQB" +
+	"AxoG2RQBAxoG2hQBAxoGjyABAxoGkCABAxoGkSABAxoG2xQBAxoG3BQBAxoG3RQB" +
+	"AxoG3hQBAxoGkiABAxoGkyABAxoGlCABAxoGlSABAxoGliABAxoGlyABAxoGmCAB" +
+	"AxoGmSABAxoGmiABAxoGmyABAxoGnCABAxoGnSABAxoGniABAxoGnyABAxoGoCAB" +
+	"AxoGoSABAxoGoiABAxoGoyABAxoGpCABAxoGpSABAxoGpiABAxoGpyABAxoGqCAB" +
+	"AxoGqSABAxoGqiABAxoGqyABAxoGrCABAxoGrSABAxoGriABAxoGryABAxoGsCAB" +
+	"AxoGsSABAxoGsyABAxoGtCABAxoGtSABAxoGtiABAxoGtyABAxoGuCABAxoGuSAB" +
+	"AxoGuiABAxoGuyABAxoGvCABAxoGvSABAxoGviABAxoGvyABAxoGwCABAxoGwSAB" +
+	"AxoGwiABAxoGwyABAxoGxCABAxoGxSABAxoGxiABAxoGxyABAxoGyCABAxoGySAB" +
+	"AxoGyiABAxoGyyABAxoGzCABAxoGzSABAxoGziABAxoGzyABAxoG0CABAxoG0SAB" +
+	"AxoG0iABAxoG0yABAxoG1CABAxoG1iABAxoG1yABAxoG2CABAxoG2SABAxoG2iAB" +
+	"AxoG2yABAxoG3CABAxoG3SABAxoG3iABAxoG3yABAxoG4CABAxoG4SABAxoG4yAB" +
+	"AxoG5CABAxoG5SABAxoG5iABAxoG5yABAxoG6CABAxoG6SABAxoG6iABAxoG6yAB" +
+	"AxoG7CABAxoG7SABAxoG7iABAxoG7yABAxoG8CABAxoG8SABAxoG8iABAxoG8yAB" +
+	"AxoG9CABAxoG9SABAxoG9iABAxoG9yABAxoG+CABAxoG+SABAxoG+iABAxoG+yAB" +
+	"AxoG/CABAxoG/SABAxoG/iABAxoG/yABAxoGgCEBAxoGgSEBAxoGgiEBAxoGgyEB" +
+	"AxoGhCEBAxoGhSEBAxoGhiEBAxoGhyEBAxoGiCEBAxoGiSEBAxoGiiEBAxoGiyEB" +
+	"AxoGjCEBAxoGjSEBAxoGjiEBAxoGjyEBAxoGkCEBAxoGkSEBAxoGkiEBAxoGkyEB" +
+	"AxoGlCEBAxoGlSEBAxoGliEBAxoGlyEBAxoGmCEBAxoGmSEBAxoGmiEBAxoGmyEB" +
+	"AxoGnCEBAxoGnSEBAxoGniEBAxoGnyEBAxoGoCEBAxoGoSEBAxoGoiEBAxoGoyEB" +
+	"AxoGpCEBAxoGpSEBAxoGpiEBAxoGpyEBAxoGqCEBAxoGqSEBAxoGqiEBAxoGqyEB" +
+	"AxoGrCEBAxoGrSEBAxoGriEBAxoGryEBAxoGsCEBAxoGsSEBAxoGsiEBAxoGsyEB" +
+	"AxoGtCEBAxoGtSEBAxoGtiEBAxoGtyEBAxoGuCEBAxoGuSEBAxoGuiEBAxoGuyEB" +
+	"AxoGvCEBAxoGvSEBAxoGviEBAxoGvyEBAxoGwCEBAxoGwSEBAxoGwiEBAxoGwyEB" +
+	"AxoGxCEBAxoGxSEBAxoGxiEBAxoGxyEBAxoGyCEBAxoGySEBAxoGyiEBAxoGyyEB" +
+	"AxoGzCEBAxoGzSEBAxoGziEBAxoGzyEBAxoG0CEBAxoG0SEBAxoG0iEBAxoG0yEB" +
+	"AxoG1CEBAxoG1SEBAxoG1iEBAxoG1yEBAxoG2CEBAxoG2SEBAxoG2iEBAxoG2yEB" +
+	"AxoG3CEBAxoG3SEBAxoG3iEBAxoG3yEBAxoG4CEBAxoG4SEBAxoG4iEBAxoG4yEB" +
+	"AxoG5CEBAxoG5SEBAxoG5iEBAxoG5yEBAxoG6CEBAxoG6SEBAxoG6iEBAxoG6yEB" +
+	"AxoG7CEBAxoG7SEBAxoG7iEBAxoG7yEBAxoG8CEBAxoG8SEBAxoG8iEBAxoG8yEB" +
+	"AxoG9CEBAxoG9SEBAxoG9iEBAxoG9yEBAxoG+CEBAxoG+SEBAxoG+iEBAxoG+yEB" +
+	"AxoG/CEBAxoG/SEBAxoG/iEBAxoG/yEBAxoGgCIBAxoGgSIBAxoGgiIBAxoGgyIB" +
+	"AxoGhCIBAxoGhSIBAxoGhiIBAxoGhyIBAxoGiCIBAxoGiSIBAxoGiiIBAxoGiyIB" +
+	"AxoGjCIBAxoGjSIBAxoGjiIBAxoGjyIBAxoGkCIBAxoGkSIBAxoGkiIBAxoGkyIB" +
+	"AxoGlCIBAxoGlSIBAxoGliIBAxoGmCIBAxoG3xQBAxoG4BQBAxoG4RQBAxoG4hQB" +
+	"AxoG4xQBAxoG5BQBAxoG5RQBAxoG5hQBAxoGmSIBAxoG5xQBAxoG6BQBAxoG6RQB" +
+	"AxoG6hQBAxoGnCIBAxoG6xQBAxoG7BQBAxoG7RQBAxoG7hQBAxoG7xQBAxoG8BQB" +
+	"AxoG8RQBAxoG8hQBAxoG8xQBAxoG9BQBAxoG9RQBAxoG9hQBAxoG9xQBAxoG+BQB" +
+	"AxoG+RQBAxoG+hQBAxoGoCIBAxoG+xQBAxoG/BQBAxoGoiIBAxoGoyIBAxoGpCIB" +
+	"AxoG/RQBAxoG/hQBAxoG/xQBAxoGgBUBAxoGpSIBAxoGpiIBAxoGpyIBAxoGgRUB" +
+	"AxoGghUBAxoGgxUBAxoGhBUBAxoGhRUBAxoGhhUBAxoGhxUBAxoGiBUBAxoGiRUB" +
+	"AxoGihUBAxoGixUBAxoGjBUBAxoGjRUBAxoGjhUBAxoGjxUBAxoGkBUBAxoGkRUB" +
+	"AxoGkhUBAxoGkxUBAxoGlBUBAxoGlRUBAxoGlhUBAxoGlxUBAxoGqCIBAxoGmBUB" +
+	"AxoGmRUBAxoGmhUBAxoGmxUBAxoGnBUBAxoGqSIBAxoGnRUBAxoGnhUBAxoGnxUB" +
+	"AxoGoBUBAxoGqiIBAxoGoRUBAxoGohUBAxoGoxUBAxoGqyIBAxoGrCIBAxoGrSIB" +
+	"AxoGriIBAxoGryIBAxoGsCIBAxoGsSIBAxoGsiIBAxoGsyIBAxoGtCIBAxoGtSIB" +
+	"AxoGtiIBAxoGtyIBBhoGuCKcAd0BoAYBAxoGuSIBAxoGuiIBAxoGuyIBAxoGvCIB" +
+	"AxoGvSIBAxoGviIBAxoGvyIBAxoGwCIBAxoGwSIBAxoGwiIBAxoGwyIBBBoGxCJ0" +
+	"AQMaBsUiAQMaBsYiAQMaBsciAQMaBsgiAQMaBskiAQMaBsoiAQMaBssiAQMaBs0i" +
+	"AQMaBs8iAQMaBtEiAQMaBtMiAQMaBtUiAQMaBtciAQMaBtkiAQMaBtsiAQMaBt0i" +
+	"AQYaBt8i8QiXCSoBBhoG4SKpBJcJKgEDGgbiIgEDGgbkIgEDGgbmIgEDGgboIgED" +
+	"GgbqIgEDGgbsIgEDGgbuIgEDGgbwIgEDGgbyIgEDGgbzIgEDGgb0IgEDGgb1IgED" +
+	"Ggb2IgEDGgb3IgEDGgb4IgEDGgb5IgEDGgb6IgEDGgb7IgEDGgb8IgEDGgb9IgED" +
+	"Ggb+IgEDGgb/IgEDGgaAIwEDGgaBIwEDGgaCIwEDGgaDIwEDGgaEIwEDGgaFIwED" +
+	"GgaGIwEDGgaHIwEDGgaIIwEDGgaJIwEDGgaKIwEDGgaLIwEDGgaMIwEDGgaNIwED" +
+	"GgaOIwEDGgaPIwEDGgaQIwEDGgaRIwEDGgaSIwEDGgaTIwEDGgaUIwEDGgaVIwED" +
+	"GgaWIwEDGgaXIwEDGgaYIwEDGgaZIwEDGgaaIwEDGgabIwEDGgacIwEDGgadIwED" +
+	"GgaeIwEDGgafIwEDGgagIwEDGgahIwEDGgaiIwEDGgajIwEDGgakIwEDGgalIwED" +
+	"GgamIwEDGganIwEDGgaoIwEDGgapIwEDGgaqIwEDGgarIwEDGgasIwEDGgatIwED" +
+	"GgauIwEDGgavIwEDGgawIwEDGgaxIwEDGgayIwEDGgazIwEDGga0IwEDGga1IwED" +
+	"Gga2IwEDGga3IwEDGga4IwEDGga5IwEDGga6IwEDGga7IwEDGga8IwEDGga9IwED" +
+	"Gga+IwEDGga/IwEDGgbAIwEDGgbBIwEDGgbCIwEDGgakFQEDGgalFQEDGgamFQED" +
+	"GganFQEDGgaoFQEDGgapFQEDGgbDIwEDGgaqFQEDGgarFQEDGgasFQEDGgatFQED" +
+	"GgauFQEDGgavFQEDGgbEIwEDGgawFQEDGgaxFQEDGgbFIwEDGgbGIwEDGgbHIwED" +
+	"GgayFQEDGgazFQEDGgbIIwEDGga0FQEDGga1FQEDGga2FQEDGga3FQEDGga4FQED" +
+	"Gga5FQEDGga6FQEDGga7FbpDBRcA9gGbG5sJAQUXAPYBrDyjOwEGFwD2AcsMmwmY" +
+	"HAEGFwD2AcsMmwmXHAEFFwD2Af8KmwkBBBcA9gHHWQEFFwD2AbsemwkBBRcA9gGg" +
+	"EpsJAQYXAPYBolmXAYULAQcXAPYBywyXAYULmBwBBxcA9gHLDJcBhQuXHAEEFwD2" +
+	"AZlIAQUXAPYBiAXZEQEEFwD2AfhLAQQXAPYBnEgBBBcA9gG2SAEEFwD2Ac5KAQQX" +
+	"APYBulgBBBcA9gHsTQEEFwD2AadDAQQXAPYB/UwBBBcA9gGpDwEEFwD2AaFMAQQX" +
+	"APYBt0UBBRcA9gHKEZgOAQUXAPYBxw6SSQEEFwD2AZdIAQQXAPYBy1ABBBcA9gHs" +
+	"PAEEFwD2AaVQAQUXAPYB/AaWGwEFFwD2Ac9O6BEBBhcA9gEwixT+GgEEFwD2Aes8" +
+	"AQUXAPYBxw61GwEEFwD2Af5ZAQQXAPYBnkkBBBcA9gGOSQEEFwD2AZFKAQQXAPYB" +
+	"6UIBBRcA9gGXAcgRAQUXAPYBggvIEQEEFwD2AfxQAQQXAPYBm0gBBBcA9gHOTAEE" +
+	"FwD2AbIcAQQXAPYBnV8BBBcA9gG/RgEEFwD2AbRaAQQXAPYBt10BBBcA9gGeSAEE" +
+	"FwD2AfYJAQQXAPYBw0sBBBcA9gG7RAEEFwD2AZBKAQQXAPYBy1kBBBcA9gGoRgEE" +
+	"FwD2AYQ7AQQXAPYBylQBBBcA9gGfRAEEFwD2AbdaAQUXAPYBnwyeUQEEFwD2AaVZ" +
+	"AQQXAPYBxw4BBhcA9gGEE5gS0x8BBRcA9gHIG4lKAQQXAPYBi1ABBBcA9gGtGwEE" +
+	"FwD2AbtgAQQXAPYBiRIBBBcA9gG8GgEEFwD2AacbAQQXAPYBxhoBBBcA9gGeEgEE" +
+	"FwD2AakeAQQXAPYBlxMBBBcA9gHoGwEEFwD2AbMbAQQXAPYB2BEBBBcA9gHhBgEE" +
+	"FwD2AfZCAQQXAPYBlxIBBBcA9gHNCQEEFwD2AfIEAQQXAPYBhhIBBBcA9gGDGwEE" +
+	"FwD2AeBIAQQXAIED300BBRcAgQOIBZpIAQQXAIED6FkBBRcAgQPbCIgFAQUXAIED" +
+	"/xnIDgEFFwCBA6IMmEgBBBcAgQPSQwEEFwCBA8tkAQQXAIED30gBBBcAgQPuQgEE" +
+	"FwCBA+lQAQUXAIEDpGTIEQEGFwCBA6YCmBLKDgEFFwCBA+tNnlkBBRcAgQO/DtxM" +
+	"AQQXAIED7jwBBBcAgQOaHAEFFwCBA4gFtBsBBRcAgQOgHd5MAQQXAIEDnVkBBRcA" +
+	"gQObCaoeAQUXAIED3xHeSAEFFwCBA+EGyA4BBBcAgQOqQwEEFwCBA+08AQQXAIED" +
+	"rUMBBBcAgQP2RAEEFwCBA8lQAQQXAIED/GEBBRcAgQOgTPQaAQUXAIEDh0ziEQEE" +
+	"FwCBA8BjAQQXAIED4VUBBBcAgQPpXAEEFwCBA49JAQQXAIEDmBsBBRcAgQOYG+EB" +
+	"AQQXAIEDl0kBBBcAgQPwHQEEFwCBA+NIAQQXAIEDj0oBBBcAgQOgTgEEFwCBA6FS" +
+	"AQUXAIED6BGGTAEEFwCBA/E6AQQXAIED6kgBBBcAgQPnCQEEFwCBA6NDAQUXAIED" +
+	"qAHhTAEFFwCBA6gB8UwBBBcAgQPKEQEEFwCBA5QbAQQXAIED2k0BBBcAgQOoAQEE" +
+	"FwCBA9xDAQQXAIEDjRIBBRcAzwGbG9kRAQQXAM8BoBIBBBcAzwHTHwEEFwDPAfRi" +
+	"AQQXAM8BiRIBBBcAzwHHSwEEFwDPAZpMAQQXAM8B5zwBBBcAzwHhSAEEFwDPAag8" +
+	"AQYXAM8BrU7qSdYaAQUXAM8B703fEQEEFwDPAcgaAQQXAM8BuFEBBBcAzwHTSwEF" +
+	"FwDPAfwK+yoBBBcAzwGsQwEEFwDPAeY8AQQXAM8BpkMBBRcAzwHmA71KAQQXAM8B" +
+	"w04BBBcAzwHoSAEEFwDPAdtNAQQXAM8B3xEBBBcAzwHKZAEEFwDPAZxEAQQXAM8B" +
+	"iA4BBBcAzwH8EgEEFwDPAcxLAQQXAM8B61wBBBcAzwGsGwEFFwDPAbsegGUBBBcA" +
+	"zwHPWwEFFwDPAYtMyg4BBBcAzwH9YQEEFwDPAZpLAQUXAM8BqAGkQwEEFwDPAbUb" +
+	"AQQXAM8BkEwBBBcAzwHDEQEEFwDPAeVIAQQXAM8Bn0kBBBcAzwHpTQEEFwDPAbhd" +
+	"AQQXAM8BjkwBBBcAzwGPGAEEFwDPAchLAQQXAM8B2U0BBBcAzwHcTQEEFwDPAcIq" +
+	"AQQXAM8B+2EBBBcAzwHiSAEEFwDPAZwFAQQXAM8BkhIBBBcAzwGmAgEEFwDPAYle" +
+	"AQUXAM8B5k2NEgEEFwDPAd9MAQQXAM8B9gkBBBcAzwGMEgEEFwDPAbwaAQQXAM8B" +
+	"50gBBBcAzwHyBAEEFwDPAZgOAQQXAM8BpxsBBBcAzwGNEgEEFwDPAcZjAQQXAM8B" +
+	"jUwBBBcAzwHjTQEEFwDPAehNAQUXAM8BvBGtGwEEFwDPAeVNAQQXAM8BhxsBBRcA" +
+	"zwGoAbQbAQQXAM8BvAsBBBcAzwGBEgEEFwDPAchZAQQXAM8BuFgBBBcAzwHeEQEE" +
+	"FwDPAdkRAQQXAM8BxhoBBBcAzwGrQwEEFwDPAeRIAQQXAM8BrBkBBRcAzwH8CptR" +
+	"AQQXAM8B1R8BBBcAzwGoGwEEFwDPAdsaAQQXAM8B8RoBBBcAzwGjHQEFFwDPAe8K" +
+	"5RkBBRcAzwHvCuA6AQQXAM8B+ksBBBcAzwGDGwEEFwDPAZBGAQQXAM8BlRwBBBcA" +
+	"zwGNCwEEFwDPAZwKAQQXAKIB6UgBBBcAogGeHwEEFwCiAYULAQQXAKIBmwkBBBcA" +
+	"ogHkTAEEFwCiAfRFAQQXAKIB4kwBBBcAogHdTQEEFwCiAYNMAQQXAKIByg4BBBcA" +
+	"ogG/YwEEFwCiAaNMAQQXAKIBjg8BBBcAogGZTAEEFwCiAaVDAQQXAKIBtl0BBBcA" +
+	"ogGbWQEEFwCiAd1MAQQXAKIB8B0BBBcAogH2CQEEFwCiAaJkAQQXAKIBukQBBBcA" +
+	"ogGHHQEEFwCiAZVJAQQXAKIByUsBBBcAogGsSAEEFwCiAegRAQQXAKIBuVgBBBcA" +
+	"ogGFDQEEFwCiAeBMAQUXAKIBqEPmSAEEFwCiAZ1MAQQXAKIBiAUBBBcAogGvGAEE" +
+	"FwCiAaVMAQQXAKIB8DoBBBcAogGCEgEEFwCiAadJAQQXAKIB4kUBBBcAogHUSwEE" +
+	"FwCiAYpKAQQXAKIBo0kBBBcAogGwUgEEFwCiAaVJAQQXAKIBmEkBBBcAogGoBwEE" +
+	"FwCiAeFNAQQXAKIB/AoBBBcAogHLRAEEFwCiAZ4SAQQXAKIBqR4BBBcAogGXEwEE" +
+	"FwCiAdUfAQQXAKIBrBkBBBcAogG+UwEEFwCiAaFdAQQXAKIBnEwBBBcAogGTSQEE" +
+	"FwCiAeJNAQQXAKIBqUMBBBcAogGWHAEEFwCiAYJRAQQXAKIBtU4BBBcAogHKEQEE" +
+	"FwCiAZQbAQQXAKIBlkkBBBcAogGTTAEEFwCiAYVlAQQXAKIB9AUBBBcAogHUHwEE" +
+	"FwCiAYcbAQQXAKIByA4BBBcAogHNWwEEFwCiAc4ZAQQXAKIBiUwBBBcAogGfTAEE" +
+	"FwCiAeoeAQQXAKIB1hoBBBcAogG/RAEEFwCiAeRNAQQXAKIBtV0BBBcAogGdSAEE" +
+	"FwCiAYRMAQQXAKIB40UBBBcAogGPTAEEFwCiAZgSAQQXAKIBrBsBBBcAogGxGAEE" +
+	"FwCiAaoFAQUXAKIB9w4kAQQXAKIBuRMBBBcAogHCHgEEFwCiAeEGAQQXAKIBgQ8B" +
+	"BBcAogHpGQEEFwCiAeURAQQXAKIBsAEBBBcAogGpDwEEFwCiAd4DAQQXAKIBkA8B" +
+	"BBcAogGhHQEEFwCiAZwOAQQXAKIBogQBBBcAogHJBgEEFwCiAZkSAQQXAKIB0ggB" +
+	"BBcAogGJBAEEFwCiAYQTAQQXAKIBpVIBBBcAogH0GgEEFwCiAakOAQUXAKIB9w6n" +
+	"DAEEFwCiAeIFAQQXAKIBtxsBBRcAogH8CoABAQQXAKIB+1UBBBcAogHYDQEEFwBw" +
+	"gRIBBBcAcK1eAQQXAHDIGgEEFwBwwxEBBBcAcKViAQQXAHCxRgEEFwBw0w4BBBcA" +
+	"cNEdAQQXAHDMUwEEFwBw4E0BBBcAcNhLAQQXAHDSEgEEFwBwlgoBBBcAcJobAQQX" +
+	"AHCeRAEEFwBwsh8BBBcAcIkKAQQXAHCWGwEEFwBw6BsBBBcAcK0LAQQXAHC8EQEE" +
+	"FwBw/xkBBBcAcLMbAQQXAHCeGwEEFwBw2BEBBBcAcJ5MAQQXAHCnTAEEFwBw3k0B" +
+	"BBcAcK9UAQQXAHCoGwEEFwBw51ABBBcAcIUPAQQXAHCSTAEEFwBw1xoBBBcAcPA8" +
+	"AQQXAHCbTAEEFwBwphsBBBcAcJ8MAQQXAHCAGgEEFwBwhUwBBBcAcNBLAQUXAHDv" +
+	"XMgOAQQXAHDbCAEEFwBwnVIBBRcAcL0OsFoBBRcAcIxMyg4BBBcAcNFkAQQXAHDv" +
+	"PAEEFwBwnF8BBBcAcMdkAQQXAHD1BQEEFwBwo18BBBcAcKJDAQQXAHDOWwEEFwBw" +
+	"qh4BBBcAcPphAQQXAHCMYwEEFwBwwREBBBcAcIYHAQQXAHDqXAEEFwBw4jwBBBcA" +
+	"cP9LAQQXAHCcWQEEFwBwokkBBBcAcK8OAQQXAHC/TgEEFwBw/kwBBBcAcKo8AQQX" +
+	"AHD2DAEEFwBwtEQBBBcAcLwfAQUXAHDhBrYsAQQXAHCJFAEEFwBwlEkBBBcAcMtL" +
+	"AQQXAHCdUQEEFwBwgWUBBBcAcOFFAQQXAHCPGAEEFwBwqzwBBBcAcKRMAQQXAHD2" +
+	"CQEEFwBwuxoBBBcAcJhMAQQXAHDqPAEEFwBw0wMBBBcAcPFNAQQXAHCBUQEEFwBw" +
+	"yWQBBBcAcIhMAQUXAHDhBukEAQQXAHDSTgEEFwBw31EBBBcAcNsaAQQXAHDBOwEE" +
+	"FwBw/hoBBBcAcPEaAQQXAHCxGAEFFwBwwgg6AQQXAHDPSgEEFwBwmFIBBBcAcKJM" +
+	"AQQXAHCYDgEEFwBwoFEBBBcAcMcOAQQXAHDIZAEEFwBwkUwBBRcAcJcB/RsBBRcA" +
+	"cMIIjRABBBcAcOkQAQQXAHCyDAEEFwBwFAEEFwBwwh4BBBcAcDUBBBcAcDoBBBcA" +
+	"cLhFAQUXAHCXAc1RAQQXAHCTCgEEFwBwgV0BBhcAcL0O+hrkCgEEFwBwox0BBBcA" +
+	"cMxkAQQXAHCrTAEEFwBw/mQBBRcAcNUP91sBBRcAcNUPqBABBBcAcIsUAQQXAHCD" +
+	"ZQEEFwBwrRkBBBcAcLoSAQUXAHCyDNI5AQQXAHCxHAEEFwBw/RMBBBcAcOcJAQQX" +
+	"AHDNCQEEFwBw8gQBBBcAcLkEAQQXAHDiEgEEFwBwkgsBBBcAcKxMAQQXAHChAgEE" +
+	"FwBw3gcBBBcAcIQTAQUXAHDCCPRTAQQXAHCtAQEEFwBwkwMBBBcAcKkOAQQXAHCA" +
+	"BQEEFwBw1QcBBBcAcKgBAQQXAHDBGgEEFwBwtF0BBBcAcJAFAQQXAHD8BgEEFwBw" +
+	"hgIBBBcAcNw6AQQXAHD0PAEEFwCJA1oBBBcAiQMwAQQXAIkDqgUBBBcAiQPMAwEE" +
+	"FwCJA9QMAQQXAIkDuRMBBBcAiQPhBgEEFwCJA6kBAQQXAIkDgQ8BBBcAiQPhHAEE" +
+	"FwCJA+URAQQXAIkDkwoBBBcAiQO9DgEEFwCJA5ISAQQXAIkDsAEBBBcAiQOpDwEE" +
+	"FwCJA5APAQQXAIkDnQUBBBcAiQP8EgEEFwCJA5wOAQQXAIkD9gkBBBcAiQO5GwEE" +
+	"FwCJA6IEAQQXAIkDqxsBBBcAiQPVDwEEFwCJA8kGAQQXAIkDswEBBBcAiQOXEgEE" +
+	"FwCJA7oSAQQXAIkDmRIBBBcAiQP9EwEEFwCJA9IIAQQXAIkDnAUBBBcAiQOJBAEE" +
+	"FwCJA+ISAQQXAIkD7woBBBcAiQOcGwEEFwCJA7AMAQQXAIkD3gcBBBcAiQPSEgEE" +
+	"FwCJA+kQAQQXAIkDrwIBBBcAiQPVAgEEFwCJA6kOAQQXAIkDgAUBBBcAiQPHGgEE" +
+	"FwCJA/cOAQQXAIkDqAEBBBcAiQOuBQEEFwCJA/wKAQQXAIkDnAoBBBcAiQOYDAEE" +
+	"FwCJA4QdAQQXAIkDr2IIA8kDALQBAQPJAwDBDgEDyQMApgQBA8kDALUOAQPJAwDg" +
+	"AQEDyQMAvwEBA8kDABQBA8kDAIQFAQPJAwCxGQEDyQMAiD4BA8kDAP00AQPJAwCu" +
+	"DQEDyQMAk0MBA8kDAKYDAQPJAwDYEQEDyQMAkRIBA8kDAJcBAQPJAwCqAwEDyQMA" +
+	"NQEDyQMA9AQBA8kDAJsLAQPJAwDCXQEDyQMAzxABA8kDAK8CAQPJAwA4AQPJAwCA" +
+	"BQEDyQMA3RkBA8kDAKkBAQPJAwD1PQEDyQMAoAYBA8kDAKVdAgPJAw7jAQEDyQMO" +
+	"MQEDyQMONgEDyQMOQQEDyQMOTgEDyQMOVQEDyQMOdQEDyQMOgAEBA8kDDnQBA8kD" +
+	"DogBBQLJA7cEAQPJAzO3BAED7wEA+08BA+8BAL9PAQPvAQCnEgED7wEA0g4BA+8B" +
+	"APoVAQPvAQCKDQED7wEAxwsBA+8BAPcVAQPvAQC+YgED7wEAvmEBA+8BAK1iAQPv" +
+	"AQC4YgED7wEA7AsBA+8BAOcLAQPvAQDTOQED7wEA1A0BA+8BAPJBAQPvAQCSEQED" +
+	"7wEAnREBA+8BAIwZAQPvAQDcYAED7wEAwQ8BA+8BAMNgAQPvAQDaYAED7wEAticB" +
+	"A+8BALMnAQPvAQC1JwED7wEA9hUBA+8BAKpgAQPvAQCiYAED7wEApGABA+8BAKlg" +
+	"AQTvAQAyzgUBBO8BAKoB/x4BBO8BALUCgB8BBO8BALUC9hUBA+8BAJtgAQPvAQCA" +
+	"HwED7wEAn2ABA+8BAP8eAQPvAQCoYAED7wEApWABA+8BAKZgAQPvAQCnYAED7wEA" +
+	"1EoBA+8BAMkaAQPvAQCjSgED7wEA0EoBA+8BAFoBA+8BANECAQPvAQDDAQED7wEA" +
+	"oQIBA+8BALgBAQPvAQCzAQED7wEArwIBA+8BAJcBAQPvAQDTAgED7wEAhAMBA+8B" +
+	"AOABAQPvAQCoAQED7wEArQEBA+8BAIIBAQPvAQCpAQED7wEA6xgBA+8BALQBAQPv" +
+	"AQC/AQED7wEAnAEBA+8BAIQLAQPvAQCoAgED7wEAqwIBA+8BAL4EAQPvAQCvAwED" +
+	"7wEAhUABA+8BAOoCAQPvAQDOBgED7wEA0wMBA+8BAKkDAQPvAQDOAgED7wEAnwMC" +
+	"A+8BDuMBAQPvAQ4xAQPvAQ42AQPvAQ5BAQPvAQ5OAQPvAQ5VAQPvAQ51AQPvAQ6A" +
+	"AQED7wEOdAED7wEOiAEHBNoD+wMAxDkBBNoD+wMAWgEE2gP7AwCwBQEE2gP7AwDT" +
+	"AwEE2gP7AwCuDgEE2gP7AwDHHwEE2gP7AwCYGAEE2gP7AwDnNQEE2gP7AwCaRAEE" +
+	"2gP7AwDNBwEE2gP7AwCaQAEE2gP7AwCvAgEE2gP7AwC/BwEE2gP7AwD1EAEE2gP7" +
+	"AwDHBQEE2gP7AwCwCAEE2gP7AwDZYAEE2gP7AwBCAQTaA/sDAOABAQTaA/sDAKMK" +
+	"AQTaA/sDAL5kAQTaA/sDAJcBAQTaA/sDAOtiAQTaA/sDABQBBNoD+wMANQEE2gP7" +
+	"AwCqAwEE2gP7AwAwAQTaA/sDAMwDAQTaA/sDADgBBNoD+wMAOgMF2gP7Ax+OAYkB" +
+	"AQXaA/sDH4cBiQEBBdoD+wMf+wmJAQEF2gP7Ax+8RokBAQXaA/sDH90+iQEBBNoD" +
+	"+wOSA6kCCwSUAVkQlUMBBJQBWRCYQwEElAFZEM9DAQSUAVkQ5EMBBJQBWRCCQwEE" +
+	"lAFZEIVDAQSUAVkQs0QBBJQBWRDHRAEElAFZEJRDAQSUAVkQrkMBBJQBWRDqQgEE" +
+	"lAFZEOxCAQSUAVkQjUQBBJQBWRCQRAEElAFZEIdDAQSUAVkQiUMBBJQBWRCxRAEE" +
+	"lAFZELJEAQSUAVkQhUQBBJQBWRCXRAEElAFZEM1DAQSUAVkQzkMBBJQBWRCeGQEE" +
+	"lAFZEIZDAQSUAVkQzUQBBJQBWRDQRAEElAFZEN5CAQSUAVkQ5UIBBJQBWfEBu2EB" +
+	"BJQBWfEB7k0BBJQBWfEBvkUBBJQBWfEB9AIBBJQBWfEBlU0BBJQBWfEB0lMBBJQB" +
+	"WfEB/EwBBJQBWfEB0lIBBJQBWfEBtWQBBJQBWfEB5z4BBJQBWfEB1kgBBJQBWfEB" +
+	"3CsBBJQBWfEBwUsBBJQBWfEBpD8BBJQBWfEBxlEBBJQBWfEB6k0BBJQBWfEBnUsB" +
+	"BJQBWfEBogIBBJQBWfEB22MBBJQBWfEBoSsBBZQBWSqWBp5fAQWUAVkqlgb4BAEF" +
+	"lAFZKpYGoUMBBZQBWSqWBrVDAQWUAVkqlgbQWwEFlAFZKpYGxD8BBZQBWSqWBold" +
+	"AQWUAVkBogrTXQEGlAFZAaIK/l69KwEFlAFZAZYG4CsBBZQBWQGiCshdAQWUAVkB" +
+	"ogrmOgEFlAFZAdAP800BBZQBWQHQD9UEAQWUAVkB0A+2XgEFlAFZAdAP0joBBZQB" +
+	"WQGiCqdWAQWUAVkBzRrMWwEFlAFZAaIKzE0BBZQBWQGREbofAQSUAVkB3GMBBpQB" +
+	"WQGWBoNf6hILBJQBWQ7jAQEElAFZDjEBBJQBWQ42AQSUAVkOQQEElAFZDk4BBJQB" +
+	"WQ5VAQSUAVkOdQEElAFZDoABAQSUAVkOdAEElAFZDogBAgSUAVkd4wcBBJQBWR2P" +
+	"EQEFlAFZHYYCxgkBBJQBWR38CQEFlAFZHYYB/AkBBZQBWR2GAvgpAQSUAVkdzl4C" +
+	"BZQBWQGiCsBGAQSUAVkBgGQBBJQBWQGKEQEFlAFZAc1dihEBBZQBWQGPZr1dAQSU" +
+	"AVkBtD8BBJQBWQG/TQEElAFZAeJjAQSUAVkB8E0BBJQBWQGuCgEFlAFZAcNfvisB" +
+	"BZQBWQHNGvxeAQSUAVkB+wUBBJQBWQG1RgEElAFZAcdKAQiUAVkBlgb3PcxGthu2" +
+	"GwEGlAFZAZYG+zOAXwEFlAFZAZYG9x4BBpQBWQGWBvcelywBBpQBWQGWBplSjzUB" +
+	"BpQBWQGWBsVNs14GBZQBWcQFAf9eAQWUAVnEBQG9ZAEFlAFZxAUBkUYBBZQBWcQF" +
+	"AcBFAQWUAVnEBQGBZAEFlAFZxAUBlgwBBZQBWcQFAZI+AQWUAVnEBQGuSgEFlAFZ" +
+	"xAUB0l0BBZQBWcQFAfteAQWUAVnEBQGHUQEFlAFZxAUBsEMBBZQBWcQFAf49AQWU" +
+	"AVnEBQGtYQEFlAFZxAUB1joBBZQBWcQFAYEUAQWUAVnEBQGGXwEFlAFZxAUBzkQB" +
+	"BZQBWcQFAbdisQUE5wEFALABAQTnAQUAYwEE5wEFAMsBAQTnAQUAjgIBBOcBBQDz" +
+	"FQEE5wEFAN4BAQTnAQUAxhkBBOcBBQD6AQEE5wEFAMUBAQTnAQUAwQEBBOcBBQCz" +
+	"AgEE5wEFADoBBOcBBQDHAQEE5wEFABQBBOcBBQCTAgEE5wEFADgBBOcBBQA+AQTn" +
+	"AQUA8wEBBOcBBQAwAQTnAQUA5gMBBOcBBQBvAQTnAQUA7gIBBOcBBQD4DQEE5wEF" +
+	"AMMbAQTnAQUA/AEBBOcBBQDSAQEE5wEFAMQDAQTnAQUAmQEBBOcBBQBiAQTnAQUA" +
+	"NQEE5wEFAIoCAQTnAQUA4gEBBOcBAwCwAQEE5wEDAGMBBOcBAwDLAQEE5wEDAI4C" +
+	"AQTnAQMA8xUBBOcBAwDeAQEE5wEDAMYZAQTnAQMA+gEBBOcBAwDFAQEE5wEDAMEB" +
+	"AQTnAQMAswIBBOcBAwA6AQTnAQMAxwEBBOcBAwAUAQTnAQMAkwIBBOcBAwA4AQTn" +
+	"AQMAPgEE5wEDAPMBAQTnAQMAMAEE5wEDAOYDAQTnAQMAbwEE5wEDAO4CAQTnAQMA" +
+	"+A0BBOcBAwDDGwEE5wEDAPwBAQTnAQMA0gEBBOcBAwDEAwEE5wEDAJkBAQTnAQMA" +
+	"YgEE5wEDADUBBOcBAwCKAgEE5wEDAOIBAQPnAQ7jAQED5wEOMQED5wEONgED5wEO" +
+	"QQED5wEOTgED5wEOVQED5wEOdQED5wEOgAEBA+cBDnQBA+cBDogBAQPnAR2GAgED" +
+	"5wEdnAcBA+cBHcwGAQPnAR3ECQED5wEdhQkBA+cBHcgHAQPnAR2bCAED5wEdtAkB" +
+	"A+cBHbsIAQPnAR2iCQEF5wEOMdgDDwEF5wEONtgDDwEF5wEOQdgDDwEC5wHnAgED" +
+	"5wGSA6kCAQPnAQuJJgED5wHsBLgMZgN5AJcBAQN5AOABAQR5AAmXAQEDeQDhDgED" +
+	"eQCoAQEDeQDYGgEEeQDZA6gBAQN5ANMDAQN5AIUDAQN5AIBiAQN5ALQBAQN5AL8B" +
+	"AQR5AAmaAwEEeQAJtAEBA3kAmgMBA3kAmwMBA3kArQEBA3kAhAsBBHkACb8DAQR5" +
+	"ANkDrQEBA3kAvwMBA3kAoE0BA3kAqQEBA3kA0EYBA3kAkwkBA3kA70UBA3kA0BMB" +
+	"A3kAnzYBA3kAkl4BA3kAoDYBA3kAWgEDeQDDAQEEeQAJWgEDeQDPBQEDeQDPUgED" +
+	"eQChAgEDeQCZGwEEeQDZA6ECAQN5AIIBAQN5AM4GAQN5AK8DAQN5APoQAQN5AIRf" +
+	"AQN5AOQ4AQN5ANMCAQN5AMYbAQN5AMsGAQN5AOYLAQR5AAnLBgEEeQAJ5gsBBHkA" +
+	"1gjLBgEDeQCoAgEDeQDjAwEDeQC2BQEDeQCEZgEDeQC+BAEDeQD1BgEEeQAJvgQB" +
+	"A3kAuAEBA3kAnwMBA3kAg2YBA3kA3A8BA3kApWYBBHkA2QPcDwEDeQCsZgEDeQCm" +
+	"ZgEDeQCvAgEDeQCQBwEDeQDGCAEDeQCtKgEDeQD/WwEDeQDjOAEDeQD6AwEDeQDo" +
+	"BgEDeQDrVAUFeQHxAT13AQN5AP4RAQN5AfAVAQR5AdYImB8BBHkB1gjwFQEEeRAB" +
+	"FAEEeRAB7QEBBHkQAYAmAQR5EAHzAwEEeRAB7gYBBHkQATUBBHkQAaoDAQR5EAGW" +
+	"BQEEeRABjgIBBHkQATgBBHkQAfwCAQR5EAGdBwEEeRABx08BBHkQAToBBHkQAfsN" +
+	"AQR5EAH1GAEEeRAB5kABBHkQAZwRAQR5EAGIBgEEeRABkgIBBHkQAYsMAQR5EAGX" +
+	"EQEEeRABMAEEeRABsQsBBHkQAZJgAQR5EAGTYAEEeRABqgIBBHkQAZ1gAQR5EAG2" +
+	"YAEEeRAB4gEBBHkQAQkBBHkQAZUEAQR5EAHHFQEEeRAB0w0BBXkQAccG0w0BBHkQ" +
+	"AbYGAQV5EAHHBrYGAQR5EAGKAgEEeRABuggBBHkQAaICAQR5EAGnCQEEeRABmQEB" +
+	"BHkQAc4FAQR5EAG9YAEEeRABgmUBBHkQAcpPAQR5EAHGTwEEeRABtWIBBHkQAf0Y" +
+	"AQR5EAHPEAEEeRAB3kEBBHkQAcMPCAN5iQElAQR5iQGzAyUBA3mJASYBA3mJATkB" +
+	"A3kAoAgBA3kA4ggBA3kAnwoBA3kAoQgBA3kAtA8BA3kA1BMBA3kAtQ8BBHkA1gjx" +
+	"DAEEeQDWCKAIAQR5ANYInwoBBHkA1gihCAEEeQDWCLQPAQR5ANYItQ9BAxHKCCoB" +
+	"A5xOyggqAQQk9AdSKgEEJPQHyggqAQS5QwOdAb8IDAWUH9gD0RwqqwIBBZQf2APR" +
+	"HCq2TI8wAhG/LQECEcAtAQIRwS0BAhHCLQECEcMtAQIRxC0BAhHFLQECEcYtAQIR" +
+	"xy0BAhHILQECEcktAQIRyi0BAhHLLQECEcwtAQIRzS0BAhHOLQECEc8tAQIR0C0B" +
+	"AhHRLQECEdItAQIR0y0BAhHULQECEdUtAQIR1i0BAhHXLQECEdgtAQIR2S0BAhHa" +
+	"LQECEdstAQIR3C0BAhHdLQECEd4tAQIR3y0BAhHgLQECEeEtAQIR4i0BAhHjLQEC" +
+	"EeQtAQIR5S0BAhHmLQECEectAQIR6C0BAhHpLQECEeotAQIR6y0BAhHsLQECEe0t" +
+	"AQIR7i0BAhHvLQECEfAtAQIR8S0BAhHyLQECEfMtAQIR9C0BAhH1LQECEfYtAQIR" +
+	"9y0BAhH4LQECEfktAQIR+i0BAhH7LQECEfwtAQIR/S0BAhH+LQECEf8tAQIRgC4B" +
+	"AhGBLgECEYIuAQIRgy4BAhGELgECEYUuAQIRhi4BAhGHLgECEYguAQIRiS4BAhGK" +
+	"LgECEYsuAQIRjC4BAhGNLgECEY4uAQIRjy4BAhGQLgECEZEuAQIRki4BAhGTLgEC" +
+	"EZQuAQIRlS4BAhGWLgECEZcuAQIRmC4BAhGZLgECEZouAQIRmy4BAhGcLgECEZ0u" +
+	"AQIRni4BAhGfLgECEaAuAQIRoS4BAhGiLgECEaMuAQIRpC4BAhGlLgECEaYuAQIR" +
+	"py4BAhGoLgECEakuAQIRqi4BAhGrLgECEawuAQIRrS4BAhGuLgECEa8uAQIRsC4B" +
+	"AhGxLgECEbIuAQIRsy4BAhG0LgECEbUuAQIRti4BAhG3LgECEbguAQIRuS4BAhG6" +
+	"LgECEbsuAQIRvC4BAhG9LgECEb4uAQIRvy4BAhHALgECEcEuAQIRwi4BAhHDLgEC" +
+	"EcQuAQIRxS4BAhHGLgECEccuAQIRyC4BAhHJLgECEcouAQIRyy4BAhHMLgECEc0u" +
+	"AQIRzi4BAhHPLgECEdAuAQIR0S4BAhHSLgECEdMuAQIR1C4BAhHVLgECEdYuAQIR" +
+	"1y4BAhHYLgECEdkuAQIR2i4BAhHbLgECEdwuAQIR3S4BAhHeLgECEd8uAQIR4C4B" +
+	"AhHhLgECEeIuAQIR4y4BAhHkLgECEeUuAQIR5i4BAhHnLgECEeguAQIR6S4BAhHq" +
+	"LgECEesuAQIR7C4BAhHtLgECEe4uAQIR7y4BAhHwLgECEfEuAQIR8i4BAhHzLgEC" +
+	"EfQuAQIR9S4BAhH2LgECEfcuAQIR+C4BAhH5LgECEfouAQIR+y4BAhH8LgECEf0u" +
+	"AQIR/i4BAhH/LgECEYAvAQIRgS8BAhGCLwECEYMvAQIRhC8BAhGFLwECEYYvAQIR" +
+	"hy8BAhGILwECEYkvAQIRii8BAhGLLwECEYwvAQIRjS8BAhGOLwECEY8vAQIRkC8B" +
+	"AhGRLwECEZIvAQIRky8BAhGULwECEZUvAQIRli8BAhGXLwECEZgvAQIRmS8BAhGa" +
+	"LwECEZsvAQIRnC8BAhGdLwECEZ4vAQIRny8BAhGgLwECEaEvAQIRoi8BAhGjLwEC" +
+	"EaQvAQIRpS8BAhGmLwECEacvAQIRqC8BAhGpLwECEaovAQIRqy8BAhGsLwECEa0v" +
+	"AQIRri8BAhGvLwECEbAvAQIRsS8BAhGyLwECEbMvAQIRtC8BAhG1LwECEbYvAQIR" +
+	"ty8BAhG4LwECEbkvAQIRui8BAhG7LwECEbwvAQIRvS8BAhG+LwECEb8vAQIRwC8B" +
+	"AhHBLwECEcIvAQIRwy8BAhHELwECEcUvAQIRxi8BAhHHLwECEcgvAQIRyS8BAhHK" +
+	"LwECEcsvAQIRzC8BAhHNLwECEc4vAQIRzy8BAhHQLwECEdEvAQIR0i8BAhHTLwEC" +
+	"EdQvAQIR1S8BAhHWLwECEdcvAQIR2C8BAhHZLwECEdovAQIR2y8BAhHcLwECEd0v" +
+	"AQIR3i8BAhHfLwECEeAvAQIR4S8BAhHiLwECEeMvAQIR5C8BAhHlLwECEeYvAQIR" +
+	"5y8BAhHoLwECEekvAQIR6i8BAhHrLwECEewvAQIR7S8BAhHuLwECEe8vAQIR8C8B" +
+	"AhHxLwECEfIvAQIR8y8BAhH0LwECEfUvAQIR9i8BAhH3LwECEfgvAQIR+S8BAhH6" +
+	"LwECEfsvAQIR/C8BAhH9LwECEf4vAQIR/y8BAhGAMAECEYEwAQIRgjABAhGDMAEC" +
+	"EYQwAQIRhTABAhGGMAECEYcwAQIRiDABAhGJMAECEYowAQIRizABAhGMMAECEY0w" +
+	"AQIRjjABAhGPMAECEZAwAQIRkTABAhGSMAECEZMwAQIRlDABAhGVMAECEZYwAQIR" +
+	"lzABAhGYMAECEZkwAQIRmjABAhGbMAECEZwwAQIRnTABAhGeMAECEZ8wAQIRoDAB" +
+	"AhGhMAECEaIwAQIRozABAhGkMAECEaUwAQIRpjABAhGnMAECEagwAQIRqTABAhGq" +
+	"MAECEaswAQIRrDABAhGtMAECEa4wAQIRrzABAhGwMAECEbEwAQIRsjABAhGzMAEC" +
+	"EbQwAQIRtTABAhG2MAECEbcwAQIRuDABAhG5MAECEbowAQIRuzABAhG8MAECEb0w" +
+	"AQIRvjABAhG/MAECEcAwAQIRwTABAhHCMAECEcMwAQIRxDABAhHFMAECEcYwAQIR" +
+	"xzABAhHIMAECEckwAQIRyjABAhHLMAECEcwwAQIRzTABAhHOMAECEc8wAQIR0DAB" +
+	"AhHRMAECEdIwAQIR0zABAhHUMAECEdUwAQIR1jABAhHXMAECEdgwAQIR2TABAhHa" +
+	"MAECEdswAQIR3DABAhHdMAECEd4wAQIR3zABAhHgMAECEeEwAQIR4jABAhHjMAEC" +
+	"EeQwAQIR5TABAhHmMAECEecwAQIR6DABAhHpMAECEeowAQIR6zABAhHsMAECEe0w" +
+	"AQIR7jABAhHvMAECEfAwAQIR8TABAhHyMAECEfMwAQIR9DABAhH1MAECEfYwAQIR" +
+	"9zABAhH4MAECEfkwAQIR+jABAhH7MAECEfwwAQIR/TABAhH+MAECEf8wAQIRgDEB" +
+	"AhGBMQECEYIxAQIRgzEBAhGEMQECEYUxAQIRhjEBAhGHMQECEYgxAQIRiTEBAhGK" +
+	"MQECEYsxAQIRjDEBAhGNMQECEY4xAQIRjzEBAhGQMQECEZExAQIRkjEBAhGTMQEC" +
+	"EZQxAQIRlTEBAhGWMQECEZcxAQIRmDEBAhGZMQECEZoxAQIRmzEBAhGcMQECEZ0x" +
+	"AQIRnjEBAhGfMQECEaAxAQIRoTEBAhGiMQECEaMxAQIRpDEBAhGlMQECEaYxAQIR" +
+	"pzEBAhGoMQECEakxAQIRqjEBAhGrMQECEawxAQIRrTEBAhGuMQECEa8xAQIRsDEB" +
+	"AhGxMQECEbIxAQIRszEBAhG0MQECEbUxAQIRtjEBAhG3MQECEbgxAQIRuTEBAhG6" +
+	"MQECEbsxAQIRvDEBAhG9MQECEb4xAQIRvzEBAhHAMQECEcExAQIRwjEBAhHDMQEC" +
+	"EcQxAQIRxTEBAhHGMQECEccxAQIRyDEBAhHJMQECEcoxAQIRyzEBAhHMMQECEc0x" +
+	"AQIRzjEBAhHPMQECEdAxAQIR0TEBAhHSMQECEdMxAQIR1DEBAhHVMQECEdYxAQIR" +
+	"1zEBAhHYMQECEdkxAQIR2jEBAhHbMQECEdwxAQIR3TEBAhHeMQECEd8xAQIR4DEB" +
+	"AhHhMQECEeIxAQIR4zEBAhHkMQECEeUxAQIR5jEBAhHnMQECEegxAQIR6TEBAhHq" +
+	"MQECEesxAQIR7DEBAhHtMQECEe4xAQIR7zEBAhHwMQECEfExAQIR8jEBAhHzMQEC" +
+	"EfQxAQIR9TEBAhH2MQECEfcxAQIR+DEBAhH5MQECEfoxAQIR+zEBAhH8MQECEf0x" +
+	"AQIR/jEBAhH/MQECEYAyAQIRgTIBAhGCMgECEYMyAQIRhDIBAhGFMgECEYYyAQIR" +
+	"hzIBAhGIMgECEYkyAQIRijIBAhGLMgECEYwyAQIRjTIBAhGOMgECEY8yAQIRkDIB" +
+	"AhGRMgECEZIyAQIRkzIBAhGUMgECEZUyAQIRljIBAhGXMgECEZgyAQIRmTIBAhGa" +
+	"MgECEZsyAQIRnDIBAhGdMgECEZ4yAQIRnzIBAhGgMgECEaEyAQIRojIBAhGjMgEC" +
+	"EaQyAQIRpTIBAhGmMgECEacyAQIRqDIBAhGpMgECEaoyAQIRqzIBAhGsMgECEa0y" +
+	"AQIRrjIBAhGvMgECEbAyAQIRsTIBAhGyMgECEbMyAQIRtDIBAhG1MgECEbYyAQIR" +
+	"tzIBAhG4MgECEbkyAQIRujIBAhG7MgECEbwyAQIRvTIBAhG+MgECEb8yAQIRwDIB" +
+	"AhHBMgECEcIyAQIRwzIBAhHEMgECEcUyAQIRxjIBAhHHMgECEcgyAQIRyTIBAhHK" +
+	"MgECEcsyAQIRzDIBAhHNMgECEc4yAQIRzzIBAhHQMgECEdEyAQIR0jIBAhHTMgEC" +
+	"EdQyAQIR1TIBAhHWMgECEdcyAQIR2DIBAhHZMgECEdoyAQIR2zIBAhHcMgECEd0y" +
+	"AQIR3jIBAhHfMgECEeAyAQIR4TIBAhHiMgECEeMyAQIR5DIBAhHlMgECEeYyAQIR" +
+	"5zIBAhHoMgECEekyAQIR6jIBAhHrMgECEewyAQIR7TIBAhHuMgECEe8yAQIR8DIB" +
+	"AhHxMgECEfIyAQIR8zIBAhH0MgECEfUyAQIR9jIBAhH3MgECEfgyAQIR+TIBAhH6" +
+	"MgECEfsyAQIR/DIBAhH9MgECEf4yAQIR/zIBAhGAMwECEYEzAQIRgjMBAhGDMwEC" +
+	"EYQzAQIRhTMBAhGGMwECEYczAQIRiDMBAhGJMwECEYozAQIRizMBAhGMMwECEY0z" +
+	"AQIRjjMBAhGPMwECEZAzAQIRkTMBAhGSMwECEZMzAQIRlDMBAhGVMwECEZYzAQIR" +
+	"lzMBAhGYMwECEZkzAQIRmjMBAhGbMwECEZwzAQIRnTMBAhGeMwECEZ8zAQIRoDMB" +
+	"AhGhMwECEaIzAQIRozMBAhGkMwECEaUzAQIRpjMBAhGnMwECEagzAQIRqTMBAhGq" +
+	"MwECEaszAQIRrDMBAhGtMwECEa4zAQIRrzMBAhGwMwECEbEzAQIRsjMBAhGzMwEC" +
+	"EbQzAQIRtTMBAhG2MwECEbczAQIRuDMBAhG5MwECEbozAQIRuzMBAhG8MwECEb0z" +
+	"AQIRvjPxSQRHAPsGoAgBBEcA+wbiCAEERwD7Bp8KAQRHAPsGoQgCBEcA+wbUEwEE" +
+	"RwD7BrUPAQVHAPsG/wnxDAEFRwD7Bv8JoAgBBUcA+wb/CeIIAQVHAPsG/wmfCgEF" +
+	"RwD7Bv8JoQgCBUcA+wb/CdQTAQVHAPsG/wm1DwIERwDZAzgBBNsBANkDqwYBA0AA" +
+	"+B8BA0AA+R8BA0AA+h8BA0AA/h8BA0AAwEABA0AAwUABA0AAwkABA0AAw0ABA0AA" +
+	"118BA0AA2F8BA0AA2V8BA0AA2l8BA0AA218BA0AA5TgBA0AA5jgBA0AA5zgBA0AA" +
+	"6DgBA0AA6TgBA0AA3E4BA0AA3U4BA0AA3k4BA0AA0kIBA0AA1UIBA0AA1kIBA0AA" +
+	"10IBA0AA2EIBA0AA2UIBA0AA2kIBA0AA20IBA0AA3EIBA0AA00IBA0AA1EIBA0AA" +
+	"3UIBA0AAxUMBA0AAxkMBA0AAx0MBA0AAyEMBA0AAyUMBA0AAykMBA0AAy0MBA0AA" +
+	"zEMBA0AAqEQBA0AAqUQBA0AAqkQBA0AAq0QBA0AArEQBA0AArUQBA0AArkQBA0AA" +
+	"jUMBA0AAjkMBA0AAj0MBA0AAkEMBA0AAkUMBA0AAkkMBA0AA/0MBA0AAgEQBA0AA" +
+	"gUQBA0AAgkQBA0AAz1UBA0AA/RwBA0AA0FUBA0AA0VUBA0AA0lUBA0AA01UBA0AA" +
+	"1FUBA0AA1VUBA0AA3VkBA0AA3lkBA0AA31kBA0AA4FkBA0AA4VkBA0AA4lkBA0AA" +
+	"xFsBA0AAxVsBA0AAxlsBA0AAx1sBA0AAyFsBA0AAyVsBA0AAylsBA0AAy1sBA0AA" +
+	"mlYBA0AAm1YBA0AAnFYBA0AAnVYBA0AAnlYBA0AApVoBA0AAploBA0AAp1oBA0AA" +
+	"qFoBA0AAqVoBA0AAqloBA0AAq1oBA0AA4lwBA0AAlB4BA0AA41wBA0AA5FwBA0AA" +
+	"6l0BA0AA610BA0AA7F0BA0AA7V0BA0AA7l0BA0AA710BA0AA8F0BA0AAl18BA0AA" +
+	"mF8BA0AAmV8BA0AAml8BA0AAm18BA0AAkF0BA0AAoB4BA0AAkV0BA0AAkl0BA0AA" +
+	"k10BA0AAlF0BA0AAlV0BA0AAll0BA0AAl10BA0AAlF4BA0AAlV4BA0AAll4BA0AA" +
+	"l14BA0AAmF4BA0AAmV4BA0AAml4BA0AAiEsBA0AA+xoBA0AAiUsBA0AAiksBA0AA" +
+	"i0sBA0AAjEsBA0AAjUsBA0AAjksBA0AAj0sBA0AAuUwBA0AAukwBA0AAu0wBA0AA" +
+	"vEwBA0AAvUwBA0AAvkwBA0AAv0wBA0AAwEwBA0AA9E0BA0AA9U0BA0AA9k0BA0AA" +
+	"3ksBA0AAiRsBA0AA30sBA0AA4EsBA0AA4UsBA0AA4ksBA0AA40sBA0AAo00BA0AA" +
+	"pE0BA0AApU0BA0AApk0BA0AAp00BA0AA4T0BA0AA5D0BA0AA5T0BA0AA5j0BA0AA" +
+	"5z0BA0AA6D0BA0AA6T0BA0AA6j0BA0AA6z0BA0AA4j0BA0AA4z0BA0AAyj4BA0AA" +
+	"yz4BA0AAzD4BA0AAzT4BA0AAzj4BA0AAzz4BA0AA0D4BA0AAhkABA0AAh0ABA0AA" +
+	"iEABA0AAmT4BA0AAmj4BA0AAmz4BA0AAnD4BA0AAnT4BA0AAnj4BA0AAnz4BA0AA" +
+	"uD8BA0AAuT8BA0AAuj8BA0AAuz8BA0AAvD8BA0AAvT8BA0AAvj8BA0AAvz8BA0AA" +
+	"iEgBA0AAiUgBA0AAikgBA0AAi0gBA0AAjEgBA0AAjUgBA0AAjkgBA0AAvUkBA0AA" +
+	"vkkBA0AAv0kBA0AAwEkBA0AAwUkBA0AAwkkBA0AAw0kBA0AAqUoBA0AAqkoBA0AA" +
+	"q0oBA0AArEoBA0AA7UgBA0AA7kgBA0AA70gBA0AA7UkBA0AA7kkBA0AA70kBA0AA" +
+	"8EkBA0AA8UkBA0AA8kkBA0AAkWQBA0AAkmQBA0AAk2QBA0AAlGQBA0AAlWQBA0AA" +
+	"uB8BA0AA9GQBA0AA9WQBA0AA9mQBA0AA92QBA0AA22QBA0AA3GQBA0AA3WQBA0AA" +
+	"3mQBA0AA32QBA0AA4GQBA0AAtFMBA0AAxhwBA0AAtVMBA0AAtlMBA0AAj1QBA0AA" +
+	"kFQBA0AAkVQBA0AAklQBA0AAk1QBA0AAlFQBA0AAlVQBA0AA7VQBA0AA7lQBA0AA" +
+	"71QBA0AA8FQBA0AA8VQBA0AA8lQBA0AA2FMBA0AA2VMBA0AA2lMBA0AA21MBA0AA" +
+	"u1QBA0AAvFQBA0AAvVQBA0AAvlQBA0AAv1QBA0AAwFQBA0AA4GIBA0AA4WIBA0AA" +
+	"4mIBA0AA42IBA0AA5GIBA0AAkmMBA0AAk2MBA0AAlGMBA0AAlWMBA0AAlmMBA0AA" +
+	"/2IBA0AAgGMBA0AAgWMBA0AAgmMBA0AAo2MBA0AApGMBA0AApWMBA0AApmMBA0AA" +
+	"p2MBA0AAqGMBA0AAqWMBA0AA1UoBA0AA1koBBNsBANkD6QcBBEcA2QMJAQRHANkD" +
+	"qwYBBEcA2QPpBy4E2wEAA7QFAQTbAQAD/QQBBNsBAAOWBRIERwADtAUBBEcAA/0E" +
+	"AQRHAAOWBQEERwADmQGZFQN/ANQBAQN/APwBAQN/APoBAQN/AMUBAQN/ALMCAQN/" +
+	"AMcBAQN/AG8BA38APgEDfwDSAQEDfwDLAQEDfwDBAQEDfwBiAQR/APoBmQEBBH8A" +
+	"0gFjAQR/ALMCmQEBBH8AxwGwAQEEfwBiYwEDfwDACQEEfwDdCKcXAQN/AKcXAQN/" +
+	"AJAOAQR/AN0IkwIBA38A5D4BA38AzBEBA38AjFQBA38AsAEBA38AmQEBA38AkwIB" +
+	"A38AYwEEfwCwAZkBAQR/AJkBsAEBBH8AkwKwAQEEfwBjkwIBBX8AsAECOwEFfwCZ" +
+	"AQI7AQV/AJMCAjsBCH8AkwICiwHdBRgmAQV/AGMCOwEGfwBjAjs5AQR/ALABYwEE" +
+	"fwCZAWMBBH8AkwJjAQR/AGNjAQV/ALABmQFjAQV/AJkBsAFjAQV/AJMCsAFjAQV/" +
+	"AGOTAmMBBn8AkwJjAjsBBH8AkwKZAQEFfwCTApkBYwEEfwBjxQEBBX8AY8UBYgEE" +
+	"fwBj+gEBBX8AY/oBYgEEfwDFAWMBBX8AxQFiYwEDfwCOAgEDfwCPYwEEfwCOAmIB" +
+	"BH8AY5kBAQR/AGOwAQEFfwDHAWJjAQV/AMEBYmMBBH8AY8cBAQV/AGPHAWIBA38A" +
+	"FAEEfwDdCL4MAQN/ALcMAQN/ADUBA38A0SYBA38AOgEDfwA4AQN/AIgGAQR/ALUC" +
+	"OgEDfwDDDwEDfwDMAwEEfwDdCP0HAQR/APIcOgEEfwDdCMwDAQR/AKoBOgEDfwCr" +
+	"BgEDfwAwAQN/AL4IAQN/AP8TAQR/ADCZAQEEfwCqATABBH8A8hwwAQN/AOcTAQR/" +
+	"AN0IMAEDfwDaTwEDfwC+DAEDfwCnCQEDfwCvAgEDfwCWBQEDfwC0BQEDfwCnHwED" +
+	"fwC3YwEEfwCsDDABBH8ArAw1AQR/AKwMOgEEfwCsDBQBBH8AgRzzAwEEfwCBHO0H" +
+	"AQR/AN0I/AIBBH8A3QjzAwEEfwDdCMwCAQR/AHuwAQYFf5oEJ6wBswkBBX+aBPsJ" +
+	"rAGzCQEFf5oEJawBswkBBX+aBIcBU7MJAQV/mgT7CVOzCQEFf5oEjgFTswkBBH+a" +
+	"BLkFswkBBX+aBLkFnQyzCQEEf5oEuQWYHgEEf5oEuQXpAgEFf5oEuQU4UgEFf5oE" +
+	"uQU6UgEFf5oEuQWYHlIEBH+aBI4B3wEBBX+aBI4ByxPfAQEEf5oEjgG+AgEFf5oE" +
+	"jgGqAb4CAQR/mgSOATsBBH+aBI4BeAEEf5oEjgG/AgEEf5oEjgGZBAEEf5oEjgFT" +
+	"CAR/mgSHAd8BAQV/mgSHAcsT3wEBBH+aBIcBvgIBBX+aBIcBqgG+AgEEf5oEhwE7" +
+	"AQR/mgSHAXgBBH+aBIcBvwIBBH+aBIcBmQQBBH+aBIcBUwEEf5oEhwEbAwV/ATUC" +
+	"kQMBBH/JXQDcDAEDfzMqAQV/xAH6K5IDqQIBBJMP4Q0AqhIBBJMP4Q3OM6oSAQST" +
+	"D+ENc6ITAQSTD+ENYaIT3SQJXh8q/AujCWOmB8wCJwEIXh8qowljpgfMAicBBl4f" +
+	"Kt4TzAInAQdeHyr8C6MJzAInAQZeHyqjCcwCJwEGXh8q3x3MAicBB14fKvgJgwfM" +
+	"AicBBl4fKoMHzAInAQZeHyq4C8wCJwEJXh8q+AmDB2PxCswCJwEIXh8qgwdj8QrM" +
+	"AicBCF4fKrgLY/EKzAInAQleHyr8C6MJY6YHzAIlAQheHyqjCWOmB8wCJQEGXh8q" +
+	"3hPMAiUBB14fKvwLownMAiUBBl4fKqMJzAIlAQZeHyrfHcwCJQEHXh8q+AmDB8wC" +
+	"JQEGXh8qgwfMAiUBBl4fKrgLzAIlAQleHyr4CYMHY/EKzAIlAQheHyqDB2PxCswC" +
+	"JQEIXh8quAtj8QrMAiUBBl4fKt4TY6YHAQdeHyr4CYMHY6YHAQZeHyqnE/gJgwcB" +
+	"Bl4fKoMHY6YHAQVeHyqDB6cTAQZeHyq4C2OmBwEGXh8q+AmDB6cTAQVeHyr8C7gL" +
+	"AQReHyrNZQEEXh8qzAIBBF4fKtNTAQReHyqxDwEEXh8qmCoBBF4fKphgAQReHyrU" +
+	"UQEEXh8qp0YBBF4fKr5EAQReHyrmQgEEXh8q0GUBBF4fKoxaAQReHyrXUwEGXh8q" +
+	"lQ7MAicDBl4f5wbTDCqlSgEGXh/nBtMMKvJbAQZeH+cG0wwqxV4BBF4fKt4fAQVe" +
+	"HyqgF94fAQReHyrwTwEEXh8qwxIBB14fKsMSAlNIAQReHyqREAEFXh8qkRDaUQEE" +
+	"Xh8quU8BBV4fKtIdshsBBF4fKp1eAQReHyrZOAEFXh+gJ1PUGwEFXh8qxQXUGwEE" +
+	"Xh8qlQ4BBl4fa+cG0wyWBAEEXs0MPeRFAQRezQw95UUBBV7NDD3BDYIYAQRezQw9" +
+	"lQ4BBF7NDD3qEgoDXqYBzBkBBF6mAcwZuB4BA16mAcNQAQVepgHVOMsB5isBA16m" +
+	"AbwZAQNepgHCZQEDXqYBphMBBV6mAaYTY8FPAQNepgGAHAEEXqYBgByuDAEGXqYB" +
+	"phMC0h2yGwEDXqYB3BYBA16mAZxQAQNepgHRCQEEXqYB+wuZKgEEXqYB+wu4HgEE" +
+	"XqYB+wunSgEEXqYB+wvzWwEEXqYB+wvGXgEEXqYBzwnoDgEEXqYBzwn1EQEEXqYB" +
+	"zwm+CQEEXqYBzwm4DwEFXqYBzwnQB+gOAQVepgHPCdAH9REBBV6mAc8J0Ae+CQEF" +
+	"XqYBzwnQB7gPAQRepgEz0QkBBF6mAfgB3BYBA16mAcs1AQNepgGyQwEDXqYBkRAB" +
+	"A16mAcMSAQRepgHgB/URAQRepgHgB74JAQRepgHgB7gPAQRepgHgB7EPAQRepgGg" +
+	"F7wZAQVepgHgB9AHvgkBBF6mAeAHvRkBBV6mAeAH0AexDwEFXqYB4AfKCugOAQVe" +
+	"pgHgB8oKvgkBBV6mAeAHygquDAEFXqYB4AfKCtAHAQNepgGbDwEFXqYBmw9jvwsB" +
+	"BF6mAZsPwGUBBV6mAZsPY6YHAQNepgGVDgEDXqYB6hIBA16mAegJAQNepgGGRAED" +
+	"XqYB/WUBA16mAeEHAQVepgHhB2O/CwEFXqYB4QdjpgcBB16mAeEHY78LOqYHAQde" +
+	"pgHhB2OmBzq/CwEEXqYB4QffHwEGXqYB4QffH2O/CwEFXqYB4Qdjx1QBBl6mAeEH" +
+	"Y9o4xGUBB16mAeEHY78LOtNRAQNepgHZUQEEXqYB4QeuDAEEXqYBlAToDgEEXqYB" +
+	"lASmSgEEXqYBlASmRAEEXqYBlATcUQEEXqYBlATnDgEEXqYBlASuDAEEXqYBlAT5" +
+	"QwEEXqYBlAS9GQEFXqYBlASxD65SAQRepgGUBMoKAQRepgGUBNg4AQRepgGUBMQX" +
+	"AQVepgGUBMoK0AcBBV6mAZQExBfQBwEHXqYBlATsDQKMAtEJAQdepgGUBN0KAowC" +
+	"0QkBBF6mAZQE7A0BBF6mAZQE3QoBB16mAZQE3QoCM9EJAQRepgGUBO0NAQVepgGU" +
+	"BO0N5w4BA16mAaMMAQRepgGjDO1RAQRepgGjDPtDAQRepgGjDPpDAQRepgGjDPhD" +
+	"AQRepgGUBPVeAQRepgGUBPZeAQRepgGUBPdeAQNepgGICwEEXqYBiAu+CQEEXqYB" +
+	"iAu4DwEFXqYBiAvRB74JAQVepgGIC9AHvgkBBV6mAYgL0AeuDAEEXqYBlATRBwEF" +
+	"XqYBlATRB+cOAQhepgGUBNEH7A0CjALRCQEIXqYBlATRB90KAowC0QkBBV6mAZQE" +
+	"0QfsDQEFXqYBlATRB90KAQhepgGUBNEH3QoCM9EJAQVepgGUBNEH7Q0BBl6mAZQE" +
+	"0QftDecOAQVepgGUBNEH9F4BA16mAbVEAQNepgG6TwEDXqYBwjgBA16mAelLAQNe" +
+	"pgHYUD0ERiMLmAIBBEYjC4s1AQRGIwuyAwEFRiMLqxLNDQEFRiMLqxLfCQEFRiML" +
+	"7BPNDQEFRiML7BPfCQEERiMLgUMBBEYjC6VcAQRGIwuxEgEERiMLzB8BBUYjC8wf" +
+	"3wkBBEYjC6REAQVGIwvhFc0NAQVGIwvZEM0NAQRGIwuhHgEERiMLkwwBBEYjC+YP" +
+	"AQVGIwvmD98JAQRGIwufXAEERiMLz10BBUYjC9Ib1QUBBUYjC/0L1QUBBEYjC5VS" +
+	"AQRGIwvUKwEERiMLumEBBUYjC58R1QUBBUYjC4sO1QUBBUYjC5MM1QUBBEYjC4dW" +
+	"AQRGIwvFUAEFRiML5h3VJgEFRiML+E/VBQEFRiMLtGHVBQEFRiML4xXVBQEERiML" +
+	"1yYBBEYjC5EOAQRGIwuJVAEFRiMLixzVBQEERiMLgF4BBEYjC7ImAQRGIwuQHQEF" +
+	"RiMLthPVBQEGRiMLthOHSZlbAQVGIwvmG9UFAQRGIwurHgEERiML1EUBBEYjC8gN" +
+	"AQRGIwvIXgEERiMLvxMBBEYjC6FEAQZGIwvhFdkQ5AQBBUYjC+wF1QUBBEYjC7lB" +
+	"AQVGIwu5D9UFAQVGIwv/QrkPAQRGIwv6GwEERiMLsBwBBEYjC8MZAQVGIwveFtUF" +
+	"AQRGIwvLUwEFRiMLsBLVBQEFRiMLsRLVBQEERiML6UABBEYjC4IbAQRGIwv9UAEF" +
+	"RiMLwxnhDwEFRiMLuQ/hDwEERiMLplsBBEYjC7M9AQVGIwufEeQEAQVGIwvSG+QE" +
+	"AQVGIwurEuQEAQRGIwv+UAEERiMLlkQBBEYjC/9QAQRGIwuiRAEFRiML+hvkBAEG" +
+	"RiMLkwzkBO4IAQZGIwuLDuQE7ggBBEYjC/JkAQVGIwvmD+QEAQZGIwvkFZ5c5AQB" +
+	"BEYjC/FkAQRGIwujRAEERiMLsDkBBEYjC9MrAQVGIwvWSZ8RAQVGIwvsE+QEAQVG" +
+	"IwuLHOQEAQVGIwuwHOQEAQRGIwvOTwEERiMLzSYBBEYjC9FGAQVGIwuxEuQEAQVG" +
+	"IwuwEuQEAQVGIwvwF7ASAQRGIwvgRAEERiMLzCYBBUYjC7kP5AQBBEYjC685AQVG" +
+	"Iwu2E+QEAQRGIwukXAEFRiML3hbkBAEERiML0TkBBUYjC5Ad5AQBBUYjC+hjkQ4B" +
+	"BEYjC9leAQRGIwuSUgEERiML114BBEYjC9heAQRGIwuTUgEERiML2l4BBEYjC5RS" +
+	"AQRGIwugPQEERiML6xUBBUYjC/AX6xUBBUYjC+Yb5AQBBUYjC6we3jkBBUYjC6we" +
+	"2RABBUYjC6se4hUBBkYjC8Vd7QrYJgEERiMLgEMBBEYjC8I5AQVGIwvFH/UJAQVG" +
+	"IwvFH+4IAQRGIwvmHQEFRiMLkQ7uCAEFRiML3wnVBQEFRiMLuxHVBQEFRiMLuxHh" +
+	"DwEFRiMLuxHkBAEFRiML4xXkBAEERiMLxgsBBEYjC98JAQRGIwvQXgEERiMLs10B" +
+	"BEYjC7oRAQZGIwv4CsU53xYBBkYjC/gKyA2WEAEGRiML+ArPXpYQAQZGIwv4CrBd" +
+	"lhABBkYjC/gKvEHfFgEGRiML/QvkBO4IAQZGIwv9C8EM6A8BBkYjC/0LwQylFwEE" +
+	"RiMLvw0BB0YjC78NwQzoD/UJAQdGIwu/DcEM6A/uCAEGRiMLvw3BDKUXAQRGIwvL" +
+	"XgEERiML7CYBBEYjC7dBAQRGIwvuNQEGRiML6wiiHOoVAQVGIwvrCO0mAQVGIwvr" +
+	"COoVAQVGIwvrCIxJAQVGIwvrCIVJAQVGIwvrCLYYAQVGIwvrCKE9AQZGIwvrCKIc" +
+	"thgBBkYjC/oGrxz4BgEHRiML+gbVFa8c+AYBBkYjC/oGphf4BgEHRiML+gbVFaYX" +
+	"+AYBBkYjC/oG0l74BgEFRiML+gbKXgEGRiML+gbAE/gGAQdGIwv6BsAT6hn4BgEG" +
+	"RiML+gbqGfgGAQZGIwv6Bpwc+AYBBkYjC+ZBox73GAEGRiML5BWjHvcYAQVGIwvc" +
+	"ELJdAQVGIwvcEOkaAQVGIwvcEKoXAQZGIwv6BrZh+AYBBkYjC/oGj1L4BgEHRiML" +
+	"+gacHMAT+AYBBkYjC7cYmQHiFQEGRiMLtxiZAYQ2AQdGIwu+Oe0K7AWaHwEERiML" +
+	"uEEBBEYjC7pBAQdGIwvsBdUF1TXqQAEGRiML7AXbCZcBAQZGIwvsBdsJghsBBkYj" +
+	"C+wFm0v4BgEGRiML7AXbCdoFAQdGIwvsBY8T7zXaBQEGRiML7AXbCbwFAQZGIwvs" +
+	"BdsJvwcBB0YjC+wF2wmJBPUJAQdGIwvsBdsJiQTuCAEHRiML7AW/Gq0NqhcBB0Yj" +
+	"C+wFvxqtDekaAQdGIwv1Oo8TrQ23YQEHRiML7AWPE60Nm1wBBUYjC+wF6ksBBUYj" +
+	"C5UQkGYBBUYjC5UQ90MBBUYjC5UQxVoBB0YjC+wFOqYKph4BBkYjC+wFvTnLJgEF" +
+	"RiMLpgrXHgEFRiML3AnXHgEFRiML3AmmHgEHRiML3AnGC8gN/AgBB0YjC9wJ6hq/" +
+	"E/wIAQdGIwvcCasX1A38CAEHRiML3AnUHtEb/AgBB0YjC6YKxgvIDfwIAQdGIwum" +
+	"CuoavxP8CAEHRiMLpgqrF9QN/AgBB0YjC6YK1B7RG/wIAQVGIwulGNwJAQVGIwul" +
+	"GKYKAQZGIwu+DcYL1UkBBkYjC74Nxgv1SAEFRiMLvg3fCQEFRiMLvg3uDAEFRiML" +
+	"tgnuDAEGRiMLtgnuDLAXAQZGIwu2Ce4M1h4BBkYjC7YJ7gyoHgEFRiMLtgmVDQEG" +
+	"RiMLtgmVDbAXAQZGIwu2CZUN1h4BBkYjC7YJlQ2oHgEFRiML+TXEPAEFRiML9zWn" +
+	"CQEERiMLoxMBBUYjC9QJlwEBBUYjC9QJmh8BBUYjC9QJwwEBBUYjC9QJ2gUBBUYj" +
+	"C9QJvAUBBUYjC9QJvwcBBUYjC9QJiQQBBkYjC5MM5ATUGgEGRiMLiw7kBNQaAQZG" +
+	"IwuTDOQE9QkBBkYjC4sO5AT1CQEFRiMLkQ71CQEGRiML/QvkBPUJCwQjC4wCsAoB" +
+	"BCMLM7AKAQQjCzKwCgEFIwu/BTKwCgEEIwvzBrAKAQQjC7UCsAoBBSMLJ9cIAQEF" +
+	"Iwsl1wgBAQQjC9cIiwEBBCML2QWOHQEEIwu/AZErAQMjC44dAQMjC6wtAQQjC+ES" +
+	"9zoBBCML4RL4OgEEIwvhEvk6AQMjC+AQAQQjC+AQOQEEIwuCECoBAyMLgCsBAyML" +
+	"pCoBAyMLgQEBBCML0U+4CQEEIwvAX7gJAQQjC9pduAkBBCMLsju4CQEEIwuJO7gJ" +
+	"AQQjC4FauAkBBCMLg1qOGAEEIwu0O44YAQQjC8EB9QcBBiMLwQH1B4kLjg0BBiML" +
+	"wQH1B4kL2gMBBCML8wH1BwEEIwuzAvUHAQYjC7MC9QeJC44NAQYjC7MC9QeJC9oD" +
+	"AQQjC8gKtywBBCMLyAq4LAMFIwv9BvoJ9wUBBCMLM4sKAQQjCzPpCQEEIwvpCWEB" +
+	"BCML6QlzAQQjC4ASYQEEIwuAEnMBBCMLiwphAQQjC4sKcwEFIwvuA4kBiwoBBSML" +
+	"7gOJAekJAQQjC7ktzRMBBCMLsBDNEwEEIwuJC44NAQQjC4kL2gMBBCMLvxyODQEE" +
+	"Iwu/HNoDAQQjC7ZK9wUBBCML+BP3BQEEIwtu9wUBBCML7gP3BQEEIwvpA/cFAQQj" +
+	"C58L9wUBBCMLwxP3BQEEIwuOE/cFAQYjCzGGAe8e9wUBBCML/AGCBQEEIwtgggUB" +
+	"BSMLePwBggUBBSMLK4IFPwEFIwsrggU3AQYjC68BggVhPwEGIwuvAYIFYTcBBiML" +
+	"rwGCBSc/AQYjC68BggUnNwEGIwuvAYIFJT8BBiMLrwGCBSU3AQYjC68BggVzPwEG" +
+	"IwuvAYIFczcBByMLrwGCBWElPwEHIwuvAYIFYSU3AQUjC8YEggU/AQUjC8YEggU3" +
+	"AQYjC9IeggVzPwEGIwvSHoIFczcBBCMLtwOCBQEEIwufYoIFAQQjC4IFNwEEIwu7" +
+	"G4IFAQUjC+YWggU/AQUjC+YWggU3AQMjC/YCAQQjC/gT5gQBBCMLbuYEAQQjC+4D" +
+	"5gQBBCML6QPmBAEEIwufC+YEAQQjC8MT5gQBBCMLjhPmBAEGIwsxhgHvHuYEAQQj" +
+	"Cx/iBwEFIwsf2FriBwEEIwsfzh4BBCMLH88eAQQjCx/QHgEEIwvjEM4eAQQjC+MQ" +
+	"zx4BBCML4xDQHgEFIwsfpCc7AQQjCx+OOwEEIwsfjzsBBCMLH5A7AQQjCx+ROwEE" +
+	"IwsfkjsBBCML8QiPFgEEIwupBI8WAQQjC/EIrAsBBCMLqQSsCwEEIwvxCMgdAQQj" +
+	"C6kEyB0BBCML8QiJHAEEIwupBIkcAQQjCx/EAgEEIwsfilsBBCMLH61dAQQjCx+J" +
+	"WwEEIwsfvkgBBCMLH79IAQQjCx/XJQEEIwsfu0YBBCML7BVhAQQjC+wVcwEEIwsf" +
+	"qTYBBCMLH7RUAQQjCx+CGAEEIwsfnloBBCMLH7IKAQUjCx8zkQYBBSMLH/ACkQYB" +
+	"AyMLsVQBAyML0lsBAyML3gEBAyMLmFEBAyMLkUkBAyMLrzsBAyML7jMBAyMLtzUB" +
+	"BSML/xDmBKYGAQYjC/8Q5gTgA6YGAQMjC7leAQMjC74PAQQjC88Cvg8BBCMLvg+m" +
+	"BgEEIwu+D2EBBCML1wK1WwEEIwvXArhbAQQjC9cCuVsBBCML1wK6WwEEIwvXArtb" +
+	"AQQjC9cCvFsBBCML1wK9WwEEIwvXAr5bAQQjC9cCv1sBBCML1wK2WwEEIwvXArdb" +
+	"AQMjC5E+AQMjC+RLAQUjC6kESqBbAQQjC7kNpgYBBSMLH3O2CgEFIwsfYbYKAQQj" +
+	"Cx+NPgEFIwsfolq9UQEEIwu2EioBBSMLthJhKgEFIwtuthIqAQQjC7QYYQEEIwu0" +
+	"GHMBBCMLAv46AQMjC4UXAQQjC4UXwwsBAyML2EgBAyMLqA4BAyMLuhYBBCML+RI/" +
+	"AQQjC/kSNwEDIwvtEQEEIwvtETcBBCML+xI/AQQjC/sSNwEEIwuSGD8BBCMLkhg3" +
+	"AQUjC6gO3A73BQEFIwuoDuUK9wUBBCMLuhb3BQEEIwv5EvcFAQQjC+0R9wUBBCML" +
+	"+xL3BQEHIwu/CbgS+AiuCdwOAQcjC78JuBL4CK4J5QoBCCMLvwm4EvgIrgncDqwX" +
+	"AQcjC78Jhwz4CK4J3A4BByMLvwmHDPgIrgnlCgEIIwu/CYcM+AiuCeUKrBcBCCML" +
+	"vwmHDPgIrgnlCv81AQgjC78Jhwz4CK4J5QqANgEDIwvxMwEFIwu7GPMB9QcBBSML" +
+	"uxizAvUHAQQjCys+AQMjC5JiAQMjC9JRAQMjC70sAQMjC4sdAQMjC7ksAQMjC8Me" +
+	"AQMjC6QcAQQjC6QcgRgBBCMLix2BGAEEIwvDHoNUAQQjC/pQ11sBBSMLzgfzAfUH" +
+	"AQYjC84HqQRKrAkBBSMLzgcy5gQBBSMLzgfhUyoBBSMLzgf4E+YEAQUjC84HbuYE" +
+	"AQcjC84H7gPmBOIHcwEHIwvOB+4D5gTiB2EBByMLzgfpA+YE4gdzAQcjC84H6QPm" +
+	"BOIHYQEFIwvOB+kJAQEDIwu4WgEDIwuTRBYEIc8ElwL/HQEEIc8ElwKHHgEEIc8E" +
+	"lwKKXAEEIc8ElwKKHgEEIc8ElwKLHgEEIc8ElwKYXAEEIc8ElwKRHgEEIc8ElwKS" +
+	"HgEEIc8ElwKZXAEEIc8ElwKAXAEEIc8ElwKAHgEEIc8ElwKBHgEEIc8ElwKCHgEE" +
+	"Ic8ElwKDHgEEIc8ElwKBXAEEIc8ElwKCXAEEIc8ElwKEHgEEIc8ElwKFHgEEIc8E" +
+	"lwKGHgEEIc8ElwKDXAEEIc8ElwKEXAEEIc8ElwKFXAEEIc8ElwKIHgEEIc8ElwKJ" +
+	"HgEEIc8ElwKMHgEEIc8ElwKNHgEEIc8ElwKOHgEEIc8ElwKPHgEEIc8ElwKQHgEE" +
+	"IfcDlwL/HQEEIfcDlwKHHgEEIfcDlwKKHgEEIfcDlwKLHgEEIfcDlwKRHgEEIfcD" +
+	"lwKSHgEEIfcDlwKAHgEEIfcDlwKBHgEEIfcDlwKCHgEEIfcDlwKDHgEEIfcDlwKE" +
+	"HgEEIfcDlwKFHgEEIfcDlwKGHgEEIfcDlwKIHgEEIfcDlwKJHgEEIfcDlwKGXAEE" +
+	"IfcDlwKHXAEEIfcDlwKIXAEEIfcDlwKJXAEEIfcDlwKLXAEEIfcDlwKMXAEEIfcD" +
+	"lwKNXAEEIfcDlwKOXAEEIfcDlwKPXAEEIfcDlwKQXAEEIfcDlwKRXAEEIfcDlwKS" +
+	"XAEEIfcDlwKTXAEEIfcDlwKUXAEEIfcDlwKVXAEEIfcDlwKWXAEEIfcDlwKXXAEE" +
+	"IfcDlwKMHgEEIfcDlwKNHgEEIfcDlwKOHgEEIfcDlwKPHgEEIfcDlwKQHgEEHyEj" +
+	"1R4BBB8hI6ceAQQfISP8GwEDISP4CpsBA80FmgLjAQEDzQWaAjEBA80FmgI2AQPN" +
+	"BZoCQQEDzQWaAk4BA80FmgJVAQPNBZoCdQEDzQWaAoABAQPNBZoCdAEDzQWaAogB" +
+	"AQPNBZoChgIBA80FmgKcBwEDzQWaAswGAQPNBZoCxAkBA80FmgKFCQEDzQWaAsgH" +
+	"AQPNBZoCmwgBA80FmgK0CQEDzQWaArsIAQPNBZoCogkNA88IKJgGAQS5CCinPpgG" +
+	"AQS5CCiOEZgGAQS5CCjQF/MNAQS5CCjQF44RAQO5CCiYBgEDjQIo0AIBBI0CKJID" +
+	"eAEDjQIo5UkBA40CKKcpAQSNAiioGQMBA40CKNIzAQONAiiGJwEDjQIophIBBI0C" +
+	"KKkqngYBBY0CKLo13QGRNgEDjQIolDYBA40CKO1kAQONAiiVEQEDjQIo7FABA40C" +
+	"KNAcAQONAii9CgEEjQIo4QqTBwEDjQIonB8BA40CKIUYAQONAijnJQEDjQIo8lMB" +
+	"A40CKIBUAQONAiibOQEDjQIophEBA40CKMwzAQONAijAOQEDjQIo0zgBA40CKNgr" +
+	"AQONAii1NQEEjQIoGYFUAQONAiiQUAEDjQIo0UUBA40CKL4sAQONAijbQwEDjQIo" +
+	"8RABA40CKK9bAQONAiioUgEDjQIowzsBA40CKP9TAQWNAijlGd0B9UkBA40CKNsc" +
+	"AQWNAiiYPULOGgEDjQIovzkBA40CKKVbAQONAiixPQEDjQIowzkBA40CKEQBA40C" +
+	"KLdUAQONAiiZOwEFjQIouGHdAfpiAQONAijKMwEDjQIo+gkBA40CKNYQAQONAii6" +
+	"YAEDjQIo/jUBBI0CKMIDpQMBA40CKLg9AQSNAijxEN8CAQONAijPSAEDjQIo2yUB" +
+	"A40CKLk5AQONAii1OAEDjQIooB8BA40CKPFZAQONAiiFGQEDjQIoohcBA40CKI4X" +
+	"AQONAiiBNgEDjQIo9jkBA40CKL1YAQONAiiiWwEDjQIojD4BA40CKJ4QAQONAijA" +
+	"LAEDjQIo1DoBA40CKPslAQONAii+LQEFjQIozALGA8VhAQONAij1NQEDjQIo+0QB" +
+	"A40CKLE7CgXnBfgFtwcOMQEF5wX4BbcHDjYBBecF+AW3Bw5BAQXnBfgFtwcOTgEF" +
+	"5wX4BbcHDlUBBecF+AW3Bw51AQXnBfgFtwcOgAEBBecF+AW3Bw50AQXnBfgFtwcO" +
+	"iAEBBecF+AXjBw4xAQXnBfgF4wcONgEF5wX4BeMHDkEBBecF+AXjBw5OAQXnBfgF" +
+	"4wcOVQEF5wX4BeMHDnUBBecF+AXjBw6AAQEF5wX4BeMHDnQBBecF+AXjBw6IAQEE" +
+	"ngGVCioxAQSeAZUKKjYBBJ4BlQoqQQEEngGVCipOAQSeAZUKKlUBA5UKKjEBA5UK" +
+	"KlWIAQQMGQUUAQQMGQU+AQQMGQXzAQEEDBkF0gEBBAwZBTgBBAwZBbMCAQQMGQXB" +
+	"AQEEDBkF1AEBBAwZBToBBAwZBZMCAQQMGQXHAQEEDBkFbwEEDBkFsAEBBAwZBZkB" +
+	"AQQMGQU1AQQMGQX6AQEEDBkF7gIBBAwZBWIBBAwZBWMBBAwZBcUBAQQMGQUwAQQM" +
+	"GQXLAQEEDBkFjgIBBAwZBfwBAQQMGQXiAQEEDBkF3gEBBAwZAxQBBAwZAz4BBAwZ" +
+	"A/MBAQQMGQPSAQEEDBkDOAEEDBkDswIBBAwZA8EBAQQMGQPUAQEEDBkDOgEEDBkD" +
+	"kwIBBAwZA8cBAQQMGQNvAQQMGQOwAQEEDBkDmQEBBAwZAzUBBAwZA/oBAQQMGQPu" +
+	"AgEEDBkDYgEEDBkDYwEEDBkDxQEBBAwZAzABBAwZA8sBAQQMGQOOAgEEDBkD/AEB" +
+	"BAwZA+IBAQQMGQPeAQEEDCwFFAEEDCwFPgEEDCwF8wEBBAwsBdIBAQQMLAU4AQQM" +
+	"LAWzAgEEDCwFwQEBBAwsBdQBAQQMLAU6AQQMLAWTAgEEDCwFxwEBBAwsBW8BBAws" +
+	"BbABAQQMLAWZAQEEDCwFNQEEDCwF+gEBBAwsBe4CAQQMLAViAQQMLAVjAQQMLAXF" +
+	"AQEEDCwFMAEEDCwFywEBBAwsBY4CAQQMLAX8AQEEDCwF4gEBBAwsBd4BAQQMLAMU" +
+	"AQQMLAM+AQQMLAPzAQEEDCwD0gEBBAwsAzgBBAwsA7MCAQQMLAPBAQIEDCwDOgEE" +
+	"DCwDkwIBBAwsA8cBAQQMLANvAQQMLAOwAQEEDCwDmQEBBAwsAzUBBAwsA/oBAQQM" +
+	"LAPuAgEEDCwDYgEEDCwDYwEEDCwDxQEBBAwsAzABBAwsA8sBAQQMLAOOAgEEDCwD" +
+	"/AEBBAwsA+IBAQQMLAPeAQEFDBksBRQBBQwZLAU+AQUMGSwF8wEBBQwZLAXSAQEF" +
+	"DBksBTgBBQwZLAWzAgEFDBksBcEBAQUMGSwF1AEBBQwZLAU6AQUMGSwFkwIBBQwZ" +
+	"LAXHAQEFDBksBW8BBQwZLAWwAQEFDBksBZkBAQUMGSwFNQEFDBksBfoBAQUMGSwF" +
+	"7gIBBQwZLAViAQUMGSwFYwEFDBksBcUBAQUMGSwFMAEFDBksBcsBAQUMGSwFjgIB" +
+	"BQwZLAX8AQEFDBksBeIBAQUMGSwF3gEBBQwZLAMUAQUMGSwDPgEFDBksA/MBAQUM" +
+	"GSwD0gEBBQwZLAM4AQUMGSwDswIBBQwZLAPBAQEFDBksA9QBAQUMGSwDOgEFDBks" +
+	"A5MCAQUMGSwDxwEBBQwZLANvAQUMGSwDsAEBBQwZLAOZAQEFDBksAzUBBQwZLAP6" +
+	"AQEFDBksA+4CAQUMGSwDYgEFDBksA2MBBQwZLAPFAQEFDBksAzABBQwZLAPLAQEF" +
+	"DBksA44CAQUMGSwD/AEBBQwZLAPiAQEFDBksA94BAQQMnQEFFAIEDJ0BBfMBAQQM" +
+	"nQEF0gEDBAydAQXBAQMEDJ0BBZMCAQQMnQEFxwEDBAydAQWZAQEEDJ0BBTUBBAyd" +
+	"AQX6AQEEDJ0BBe4CAgQMnQEFYwEEDJ0BBcUBAQQMnQEFMAEEDJ0BBcsBAQQMnQEF" +
+	"jgIBBAydAQX8AQEEDJ0BBeIBAQQMnQEF3gEBBAydAQMUAQQMnQEDPgEEDJ0BA/MB" +
+	"AQQMnQED0gECBAydAQOzAgIEDJ0BA9QBAQQMnQEDOgEEDJ0BA5MCAQQMnQEDxwEB" +
+	"BAydAQNvAQQMnQEDsAEBBAydAQOZAQIEDJ0BA/oBAQQMnQED7gIBBAydAQNiAQQM" +
+	"nQEDYwEEDJ0BA8UBAQQMnQEDMAEEDJ0BA8sBAQQMnQEDjgIBBAydAQP8AQEEDJ0B" +
+	"A+IBAQQMnQED3gEBBQwZnQEFFAEFDBmdAQU+AQUMGZ0BBfMBAQUMGZ0BBdIBAQUM" +
+	"GZ0BBTgBBQwZnQEFswIBBQwZnQEFwQEBBQwZnQEF1AEBBQwZnQEFOgEFDBmdAQWT" +
+	"AgEFDBmdAQXHAQEFDBmdAQVvAQUMGZ0BBbABAQUMGZ0BBZkBAQUMGZ0BBTUBBQwZ" +
+	"nQEF+gEBBQwZnQEF7gIBBQwZnQEFYgEFDBmdAQVjAQUMGZ0BBcUBAQUMGZ0BBTAB" +
+	"BQwZnQEFywEBBQwZnQEFjgIBBQwZnQEF/AEBBQwZnQEF4gEBBQwZnQEF3gEBBQwZ" +
+	"nQEDFAEFDBmdAQM+AQUMGZ0BA/MBAQUMGZ0BA9IBAQUMGZ0BAzgBBQwZnQEDswIB" +
+	"BQwZnQEDwQEBBQwZnQED1AEBBQwZnQEDOgEFDBmdAQOTAgEFDBmdAQPHAQEFDBmd" +
+	"AQNvAQUMGZ0BA7ABAQUMGZ0BA5kBAQUMGZ0BAzUBBQwZnQED+gEBBQwZnQED7gIB" +
+	"BQwZnQEDYgEFDBmdAQNjAQUMGZ0BA8UBAQUMGZ0BAzABBQwZnQEDywEBBQwZnQED" +
+	"jgIBBQwZnQED/AEBBQwZnQED4gEBBQwZnQED3gEBBAzKAQUUAQQMygEFPgIEDMoB" +
+	"BdIBAQQMygEFOAEEDMoBBbMCAQQMygEFwQEDBAzKAQWTAgEEDMoBBccBAQQMygEF" +
+	"bwEEDMoBBbABAQQMygEFmQEBBAzKAQU1AQQMygEF+gEBBAzKAQXuAgIEDMoBBWMB" +
+	"BAzKAQXFAQEEDMoBBTABBAzKAQXLAQEEDMoBBY4CAQQMygEF/AEBBAzKAQXiAQIE" +
+	"DMoBAxQBBAzKAQM+AQQMygED8wEBBAzKAQPSAQEEDMoBAzgBBAzKAQOzAgEEDMoB" +
+	"A8EBAQQMygED1AEBBAzKAQM6AQQMygEDkwIBBAzKAQPHAQEEDMoBA28BBAzKAQOw" +
+	"AQEEDMoBA5kBAQQMygEDNQEEDMoBA/oBAQQMygED7gIBBAzKAQNiAQQMygEDYwEE" +
+	"DMoBA8UBAQQMygEDMAEEDMoBA8sBAQQMygEDjgIBBAzKAQP8AQEEDMoBA+IBAQQM" +
+	"ygED3gEBBAzTAQUUAQQM0wEFPgIEDNMBBdIBAQQM0wEFOAEEDNMBBbMCAQQM0wEF" +
+	"wQECBAzTAQU6AQQM0wEFkwIBBAzTAQXHAQEEDNMBBW8BBAzTAQWwAQIEDNMBBTUE" +
+	"BAzTAQVjAQQM0wEFxQEBBAzTAQUwAQQM0wEFywEBBAzTAQWOAgEEDNMBBfwBAQQM" +
+	"0wEF4gECBAzTAQMUAQQM0wEDPgEEDNMBA/MBAQQM0wED0gEBBAzTAQM4AQQM0wED" +
+	"swIBBAzTAQPBAQEEDNMBA9QBAQQM0wEDOgEEDNMBA5MCAQQM0wEDxwEBBAzTAQNv" +
+	"AQQM0wEDsAEBBAzTAQOZAQEEDNMBAzUBBAzTAQP6AQEEDNMBA+4CAQQM0wEDYgEE" +
+	"DNMBA2MBBAzTAQPFAQEEDNMBAzABBAzTAQPLAQEEDNMBA44CAQQM0wED/AEBBAzT" +
+	"AQPiAQEEDNMBA94BAQUMGcoBBRQBBQwZygEFPgEFDBnKAQXzAQEFDBnKAQXSAQEF" +
+	"DBnKAQU4AQUMGcoBBbMCAQUMGcoBBcEBAQUMGcoBBdQBAQUMGcoBBToBBQwZygEF" +
+	"kwIBBQwZygEFxwEBBQwZygEFbwEFDBnKAQWwAQEFDBnKAQWZAQEFDBnKAQU1AQUM" +
+	"GcoBBfoBAQUMGcoBBe4CAQUMGcoBBWIBBQwZygEFYwEFDBnKAQXFAQEFDBnKAQUw" +
+	"AQUMGcoBBcsBAQUMGcoBBY4CAQUMGcoBBfwBAQUMGcoBBeIBAQUMGcoBBd4BAQUM" +
+	"GcoBAxQBBQwZygEDPgEFDBnKAQPzAQEFDBnKAQPSAQEFDBnKAQM4AQUMGcoBA7MC" +
+	"AQUMGcoBA8EBAQUMGcoBA9QBAQUMGcoBAzoBBQwZygEDkwIBBQwZygEDxwEBBQwZ" +
+	"ygEDbwEFDBnKAQOwAQEFDBnKAQOZAQEFDBnKAQM1AQUMGcoBA/oBAQUMGcoBA+4C" +
+	"AQUMGcoBA2IBBQwZygEDYwEFDBnKAQPFAQEFDBnKAQMwAQUMGcoBA8sBAQUMGcoB" +
+	"A44CAQUMGcoBA/wBAQUMGcoBA+IBAQUMGcoBA94BAQQMLwUUAQQMLwU+AQQMLwXz" +
+	"AQEEDC8F0gEBBAwvBTgBBAwvBbMCAQQMLwXBAQEEDC8F1AEBBAwvBToBBAwvBZMC" +
+	"AQQMLwXHAQEEDC8FbwEEDC8FsAEBBAwvBZkBAQQMLwU1AQQMLwX6AQEEDC8F7gIB" +
+	"BAwvBWIBBAwvBWMBBAwvBcUBAQQMLwUwAQQMLwXLAQEEDC8FjgIBBAwvBfwBAQQM" +
+	"LwXiAQEEDC8F3gEBBAwvAxQBBAwvAz4BBAwvA/MBAQQMLwPSAQEEDC8DOAEEDC8D" +
+	"swIBBAwvA8EBAQQMLwPUAQEEDC8DOgEEDC8DkwIBBAwvA8cBAQQMLwNvAQQMLwOw" +
+	"AQEEDC8DmQEBBAwvAzUBBAwvA/oBAQQMLwPuAgEEDC8DYgEEDC8DYwEEDC8DxQEB" +
+	"BAwvAzABBAwvA8sBAQQMLwOOAgEEDC8D/AEBBAwvA+IBAQQMLwPeAQEFDC8ZBRQB" +
+	"BQwvGQU+AQUMLxkF8wEBBQwvGQXSAQEFDC8ZBTgBBQwvGQWzAgEFDC8ZBcEBAQUM" +
+	"LxkF1AEBBQwvGQU6AQUMLxkFkwIBBQwvGQXHAQEFDC8ZBW8BBQwvGQWwAQEFDC8Z" +
+	"BZkBAQUMLxkFNQEFDC8ZBfoBAQUMLxkF7gIBBQwvGQViAQUMLxkFYwEFDC8ZBcUB" +
+	"AQUMLxkFMAEFDC8ZBcsBAQUMLxkFjgIBBQwvGQX8AQEFDC8ZBeIBAQUMLxkF3gEB" +
+	"BQwvGQMUAQUMLxkDPgEFDC8ZA/MBAQUMLxkD0gEBBQwvGQM4AQUMLxkDswIBBQwv" +
+	"GQPBAQEFDC8ZA9QBAQUMLxkDOgEFDC8ZA5MCAQUMLxkDxwEBBQwvGQNvAQUMLxkD" +
+	"sAEBBQwvGQOZAQEFDC8ZAzUBBQwvGQP6AQEFDC8ZA+4CAQUMLxkDYgEFDC8ZA2MB" +
+	"BQwvGQPFAQEFDC8ZAzABBQwvGQPLAQEFDC8ZA44CAQUMLxkD/AEBBQwvGQPiAQEF" +
+	"DC8ZA94BAQUMLywFFAEFDC8sBT4BBQwvLAXzAQEFDC8sBdIBAQUMLywFOAEFDC8s" +
+	"BbMCAQUMLywFwQEBBQwvLAXUAQEFDC8sBToBBQwvLAWTAgEFDC8sBccBAQUMLywF" +
+	"bwEFDC8sBbABAQUMLywFmQEBBQwvLAU1AQUMLywF+gEBBQwvLAXuAgEFDC8sBWIB" +
+	"BQwvLAVjAQUMLywFxQEBBQwvLAUwAQUMLywFywEBBQwvLAWOAgEFDC8sBfwBAQUM" +
+	"LywF4gEBBQwvLAXeAQEFDC8sAxQBBQwvLAM+AQUMLywD8wEBBQwvLAPSAQEFDC8s" +
+	"AzgBBQwvLAOzAgEFDC8sA8EBAQUMLywD1AEBBQwvLAM6AQUMLywDkwIBBQwvLAPH" +
+	"AQEFDC8sA28BBQwvLAOwAQEFDC8sA5kBAQUMLywDNQEFDC8sA/oBAQUMLywD7gIB" +
+	"BQwvLANiAQUMLywDYwEFDC8sA8UBAQUMLywDMAEFDC8sA8sBAQUMLywDjgIBBQwv" +
+	"LAP8AQEFDC8sA+IBAQUMLywD3gEBBgwvGSwFFAEGDC8ZLAU+AQYMLxksBfMBAQYM" +
+	"LxksBdIBAQYMLxksBTgBBgwvGSwFswIBBgwvGSwFwQEBBgwvGSwF1AEBBgwvGSwF" +
+	"OgEGDC8ZLAWTAgEGDC8ZLAXHAQEGDC8ZLAVvAQYMLxksBbABAQYMLxksBZkBAQYM" +
+	"LxksBTUBBgwvGSwF+gEBBgwvGSwF7gIBBgwvGSwFYgEGDC8ZLAVjAQYMLxksBcUB" +
+	"AQYMLxksBTABBgwvGSwFywEBBgwvGSwFjgIBBgwvGSwF/AEBBgwvGSwF4gEBBgwv" +
+	"GSwF3gEBBgwvGSwDFAEGDC8ZLAM+AQYMLxksA/MBAQYMLxksA9IBAQYMLxksAzgB" +
+	"BgwvGSwDswIBBgwvGSwDwQEBBgwvGSwD1AEBBgwvGSwDOgEGDC8ZLAOTAgEGDC8Z" +
+	"LAPHAQEGDC8ZLANvAQYMLxksA7ABAQYMLxksA5kBAQYMLxksAzUBBgwvGSwD+gEB" +
+	"BgwvGSwD7gIBBgwvGSwDYgEGDC8ZLANjAQYMLxksA8UBAQYMLxksAzABBgwvGSwD" +
+	"ywEBBgwvGSwDjgIBBgwvGSwD/AEBBgwvGSwD4gEBBgwvGSwD3gEBBAziAgUUAQQM" +
+	"4gIFPgEEDOICBfMBAQQM4gIF0gEBBAziAgU4AQQM4gIFswIBBAziAgXBAQEEDOIC" +
+	"BdQBAQQM4gIFOgEEDOICBZMCAQQM4gIFxwEBBAziAgVvAQQM4gIFsAEBBAziAgWZ" +
+	"AQEEDOICBTUBBAziAgX6AQEEDOICBe4CAQQM4gIFYgEEDOICBWMBBAziAgXFAQEE" +
+	"DOICBTABBAziAgXLAQEEDOICBY4CAQQM4gIF/AEBBAziAgXiAQEEDOICBd4BAQQM" +
+	"4gIDFAEEDOICAz4BBAziAgPzAQEEDOICA9IBAQQM4gIDOAEEDOICA7MCAQQM4gID" +
+	"wQEBBAziAgPUAQEEDOICAzoBBAziAgOTAgEEDOICA8cBAQQM4gIDbwEEDOICA7AB" +
+	"AQQM4gIDmQEBBAziAgM1AQQM4gID+gEBBAziAgPuAgEEDOICA2IBBAziAgNjAQQM" +
+	"4gIDxQEBBAziAgMwAQQM4gIDywEBBAziAgOOAgEEDOICA/wBAQQM4gID4gEBBAzi" +
+	"AgPeAQEFDCwDugU6AQUMLAO6BZMCAwQMGQWxAgEEDBkF1wUBBAwZBacFAQQMGQXG" +
+	"BQEEDBkF6gMBBAwZBY8HAQQMGQX9AgEEDBkFhwUBBAwZBYgDAQQMGQXxBQEEDBkF" +
+	"3gYBBAwZBd4DAQQMGQWiBAEEDBkFzwYBBAwZBboEAQQMGQWTAwEEDBkF1QQBBQwZ" +
+	"BYcFCwEEDBkF9wQBBAwZBfsFAQQMGQWsAwEEDBkFhAUBBAwZBesEAQQMGQWMBgEE" +
+	"DBkFrAIBAwwZgAsBBAwZA7ECAQQMGQPXBQEEDBkDpwUBBAwZA8YFAQQMGQPqAwEE" +
+	"DBkDjwcBBAwZA/0CAQQMGQOHBQEEDBkDiAMBBAwZA/EFAQQMGQPeBgEEDBkD3gMB" +
+	"BAwZA6IEAQQMGQPPBgEEDBkDugQBBAwZA5MDAQQMGQPVBAEFDBkDMvcEAQQMGQP3" +
+	"BAEEDBkD+wUBBAwZA6wDAQQMGQOEBQEEDBkD6wQBBAwZA4wGAQQMGQOsAgEEDBmL" +
+	"C8QKAQQMGeoDCwEEDBmHBQsBBAwZ8QULAQQMGYQFCwEEDBnVBAsBBAwZkwMLAQQM" +
+	"LAWxAgEEDCwF1wUBBAwsBacFAQQMLAXGBQEEDCwF6gMBBAwsBY8HAQQMLAX9AgEE" +
+	"DCwFhwUBBAwsBYgDAQQMLAXxBQEEDCwF3gYBBAwsBd4DAQQMLAWiBAEEDCwFzwYB" +
+	"BAwsBboEAQQMLAWTAwEEDCwF1QQBBQwsBYcFCwEEDCwF9wQBBAwsBfsFAQQMLAWs" +
+	"AwEEDCwFhAUBBAwsBesEAQQMLAWMBgEEDCwFrAIBAwwsgAsBBAwsA7ECAQQMLAPX" +
+	"BQEEDCwDpwUBBAwsA8YFAQQMLAPqAwEEDCwDjwcBBAwsA/0CAQQMLAOHBQEEDCwD" +
+	"iAMBBAwsA/EFAQQMLAPeBgEEDCwD3gMBBAwsA6IEAQQMLAPPBgEEDCwDugQBBAws" +
+	"A5MDAQQMLAPVBAEFDCwDMvcEAQQMLAP3BAEEDCwD+wUBBAwsA6wDAQQMLAOEBQEE" +
+	"DCwD6wQBBAwsA4wGAQQMLAOsAgEEDCyLC8QKAQQMLOoDCwEEDCyHBQsBBAws8QUL" +
+	"AQQMLIQFCwEEDCzVBAsBBAwskwMLAQUMGSwFsQIBBQwZLAXXBQEFDBksBacFAQUM" +
+	"GSwFxgUBBQwZLAXqAwEFDBksBY8HAQUMGSwF/QIBBQwZLAWHBQEFDBksBYgDAQUM" +
+	"GSwF8QUBBQwZLAXeBgEFDBksBd4DAQUMGSwFogQBBQwZLAXPBgEFDBksBboEAQUM" +
+	"GSwFkwMBBQwZLAXVBAEGDBksBYcFCwEFDBksBfcEAQUMGSwF+wUBBQwZLAWsAwEF" +
+	"DBksBYQFAQUMGSwF6wQBBQwZLAWMBgEFDBksBawCAQQMGSyACwEFDBksA7ECAQUM" +
+	"GSwD1wUBBQwZLAOnBQEFDBksA8YFAQUMGSwD6gMBBQwZLAOPBwEFDBksA/0CAQUM" +
+	"GSwDhwUBBQwZLAOIAwEFDBksA/EFAQUMGSwD3gYBBQwZLAPeAwEFDBksA6IEAQUM" +
+	"GSwDzwYBBQwZLAO6BAEFDBksA5MDAQUMGSwD1QQBBgwZLAMy9wQBBQwZLAP3BAEF" +
+	"DBksA/sFAQUMGSwDrAMBBQwZLAOEBQEFDBksA+sEAQUMGSwDjAYBBQwZLAOsAgEF" +
+	"DBksiwvECgEFDBks6gMLAQUMGSyHBQsBBQwZLPEFCwEFDBkshAULAQUMGSzVBAsB" +
+	"BQwZLJMDCwEFDC8ZBbECAQUMLxkF1wUBBQwvGQWnBQEFDC8ZBcYFAQUMLxkF6gMB" +
+	"BQwvGQWPBwEFDC8ZBf0CAQUMLxkFhwUBBQwvGQWIAwEFDC8ZBfEFAQUMLxkF3gYB" +
+	"BQwvGQXeAwEFDC8ZBaIEAQUMLxkFzwYBBQwvGQW6BAEFDC8ZBZMDAQUMLxkF1QQB" +
+	"BgwvGQWHBQsBBQwvGQX3BAEFDC8ZBfsFAQUMLxkFrAMBBQwvGQWEBQEFDC8ZBesE" +
+	"AQUMLxkFjAYBBQwvGQWsAgEEDC8ZgAsBBQwvGQOxAgEFDC8ZA9cFAQUMLxkDpwUB" +
+	"BQwvGQPGBQEFDC8ZA+oDAQUMLxkDjwcBBQwvGQP9AgEFDC8ZA4cFAQUMLxkDiAMB" +
+	"BQwvGQPxBQEFDC8ZA94GAQUMLxkD3gMBBQwvGQOiBAEFDC8ZA88GAQUMLxkDugQB" +
+	"BQwvGQOTAwEFDC8ZA9UEAQYMLxkDMvcEAQUMLxkD9wQBBQwvGQP7BQEFDC8ZA6wD" +
+	"AQUMLxkDhAUBBQwvGQPrBAEFDC8ZA4wGAQUMLxkDrAIBBQwvGYsLxAoBBQwvGeoD" +
+	"CwEFDC8ZhwULAQUMLxnxBQsBBQwvGYQFCwEFDC8Z1QQLAQUMLxmTAwsBBgwvGSwF" +
+	"sQIBBgwvGSwF1wUBBgwvGSwFpwUBBgwvGSwFxgUBBgwvGSwF6gMBBgwvGSwFjwcB" +
+	"BgwvGSwF/QIBBgwvGSwFhwUBBgwvGSwFiAMBBgwvGSwF8QUBBgwvGSwF3gYBBgwv" +
+	"GSwF3gMBBgwvGSwFogQBBgwvGSwFzwYBBgwvGSwFugQBBgwvGSwFkwMBBgwvGSwF" +
+	"1QQBBwwvGSwFhwULAQYMLxksBfcEAQYMLxksBfsFAQYMLxksBawDAQYMLxksBYQF" +
+	"AQYMLxksBesEAQYMLxksBYwGAQYMLxksBawCAQUMLxksgAsBBgwvGSwDsQIBBgwv" +
+	"GSwD1wUBBgwvGSwDpwUBBgwvGSwDxgUBBgwvGSwD6gMBBgwvGSwDjwcBBgwvGSwD" +
+	"/QIBBgwvGSwDhwUBBgwvGSwDiAMBBgwvGSwD8QUBBgwvGSwD3gYBBgwvGSwD3gMB" +
+	"BgwvGSwDogQBBgwvGSwDzwYBBgwvGSwDugQBBgwvGSwDkwMBBgwvGSwD1QQBBwwv" +
+	"GSwDMvcEAQYMLxksA/cEAQYMLxksA/sFAQYMLxksA6wDAQYMLxksA4QFAQYMLxks" +
+	"A+sEAQYMLxksA4wGAQYMLxksA6wCAQYMLxksiwvECgEGDC8ZLOoDCwEGDC8ZLIcF" +
+	"CwEGDC8ZLPEFCwEGDC8ZLIQFCwEGDC8ZLNUECwEGDC8ZLJMDCwEEDBkFxQoBBAwZ" +
+	"A8UKAwQMGQ7jAQEEDBkOMQEEDBkONgEEDBkOQQEEDBkOTgEEDBkOVQEEDBkOdQEE" +
+	"DBkOgAEBBAwZDnQBBAwZDogBAQQM0wEO4wEBBAzTAQ4xAQQM0wEONgEEDNMBDkEB" +
+	"BAzTAQ5OAQQM0wEOVQEEDNMBDnUBBAzTAQ6AAQEEDNMBDnQBBAzTAQ6IAQEEDC8O" +
+	"4wEBBAwvDjEBBAwvDjYBBAwvDkEBBAwvDk4BBAwvDlUBBAwvDnUBBAwvDoABAQQM" +
+	"Lw50AQQMLw6IAQEFDC8ZDuMBAQUMLxkOMQEFDC8ZDjYBBQwvGQ5BAQUMLxkOTgEF" +
+	"DC8ZDlUBBQwvGQ51AQUMLxkOgAEBBQwvGQ50AQUMLxkOiAEBBAziAg7jAQEEDOIC" +
+	"DjEBBAziAg42AQQM4gIOQQEEDOICDk4BBAziAg5VAQQM4gIOdQEEDOICDoABAQQM" +
+	"4gIOdAEEDOICDogBAQMVmAFqAQMVhgZqAQMVtwZqAQMViQlqAQMVrQRqAQMV7wVq" +
+	"AQQVmAFqtQQBBBWGBmq1BAEGFZgBkAGlCGq1BAEFFZgBapEEkw4BBBWYAWrCCgEE" +
+	"FZgBasgFAQUVmAFqyAWHAQEEFYYGat0YAQQVmAFqTAEEFYYGakwBBRWYAWpMtQQB" +
+	"BhWYAWpMkQS4EQEFFZgBakzIBQEGFZgBamFMyAUBBhWYAWrIBUxhAQUVmAFqTMAE" +
+	"AQcVmAFqTMAEarUEAQcVmAFqTMAETLUEAQYVmAFqTMAEwgoBBhWYAWpMwATIBQEF" +
+	"FZgBakzyBgEFFYYGakzyBgEGFZgBTLUEkgFqAQYVmAFqtQSSAUwBBRWYAWpMkAEB" +
+	"BRWGBmpMkAEBBxWYAWpMgwSQAbUEAQcVmAFqTLUEkAGDBAEGFZgBakyQAbUEAQgV" +
+	"mAFqTMgFpwaQAYIEAQgVmAFqYUzIBZABggQBCBWYAWphTMgFkAHABAEIFZgBasgF" +
+	"TGGQAYIEAQgVmAFqTGGnBpAB6wUBBhWYAWpMkAHCCgEGFZgBakyQAS0BBhWYAWpM" +
+	"kAHiCgEGFZgBakyQAcgFAQcVmAGQAekEakyDBAEHFZgBakzABJABggQBCBWYAWpM" +
+	"wASQAYIEwAQBCBWYAWpMwASQAYIEtQQBBxWYAUyQAeIKamEBBxWYAWqQAeIKTGEB" +
+	"CBWYAWpMwATIBZABggQBBxWYAWpM8gaQAYIEAQcVmAFqTMAEkAHrBQEIFZgBakzA" +
+	"BMIKkAHrBQEHFZgBTJABwgpqYQEHFZgBapABwgpMYQEHFZgBTJABLWphAQcVmAFM" +
+	"kAEtasgFAQgVmAFqkAHTCZ4GTGEBCBWYAWqQAdMJ3wJMYQEHFZgBapABLUxhAQcV" +
+	"mAFqTJABwATIBQEHFZgBakyQAdMJngYBBhWYAWpMkAHTCQEIFZgBTJAB0wmeBmph" +
+	"AQgVmAFMkAHTCZ4GavIGAQcVmAFMkAHTCWphAQcVmAFqkAHiCkzIBQEEFd4ETqsE" +
+	"AQUV3gROqwS1BAEFFd4ETqsEyAUBBRXeBE6rBMAEAQYV3gROqwTABKALAQUViAlO" +
+	"qwTABAEGFZgBTqsEwAS1BAEFFa0ETqsEwAQBBRXeBFWrBKcGAQYV3gTxCVWrBKcG" +
+	"AQcV3gRVqwSnBk61BAEIFd4E8QlVqwSnBk61BAEGFd4EVasEpwa1BAEHFd4E8QlV" +
+	"qwSnBrUEAQcV3gRVqwSnBpAB6wUBBRW3BlWrBKcGAQYVtwZVqwSnBrEBAQYVrQRV" +
+	"qwSnBrEBAQUViQlVqwSnBgEGFd4EVasEpwbIBQEIFd4EVasEpwbIBZABggQBCBXe" +
+	"BFWrBKcGyAXgA5ABAQIV3gQBBRXeBOkEigvOOgEDFd4E8QkBBBXeBJABggQBBRXe" +
+	"BPEJkAGCBAEEFd4EkAG1BAEEFd4EkAHrBQEGFd4EoAtqkAGCBAEEFd4EoAvQAgEG" +
+	"Fd4EoAvQApABggQBBxXeBKAL0AKQAYIEtQQBBBXeBKALuQIBAhWICQEEFYgJkAGC" +
+	"BAEEFYgJ4AOQAQEEFYgJkAHrBQEDFYYI+BYBAhWGCAEDFbcGsQEBAhW3BgEFFbcG" +
+	"sQGQAYIEAQQVtwaQAYIEAQUVtwaxAeADkAEBBBW3BuADkAEBBRW3BrEBkAHrBQEE" +
+	"FbcGkAHrBQEDFfENsQEBAhXxDQECFYYGAQIViQkBBBWJCZABggQBBBWJCeADkAEB" +
+	"BBWJCZAB6wUBAxWtBLEBAQUVrQSxAZAB6wUBAhWtBAEDFa0EAwEFFa0EsQGQAYIE" +
+	"AQQVrQSQAYIEAQUVrQSxAeADkAEBBBWtBOADkAEBBhWtBJABggTHHmoBBhWtBJAB" +
+	"6QRMnwEBAhXvBQEFFZgBakyfAQEFFYYGakyfAQEFFa0EakyfAQEFFe8FakyfAQED" +
+	"Fa0EuQIBBhWYAWpMnwG1BAEGFZgBakyfAcAEAQYVrQRqTJ8BwAQBBBWYAbkCcwEG" +
+	"FZgBuQJz7ByDBAEGFZgBuQJz7BzFBQEGFZgBuQJz708tAQQVmAG5AmEBBhWYAZAB" +
+	"pQi5AmEBBBWGBrkCYQEEFYkJuQJhAQQV7wW5AmEBBRWYAbkCkQSTDgEEFZgBuQK1" +
+	"BAEFFZgBuQK0XpABAQQVmAG5ApABAQQVrQS5ApABAQUVmAG5AmqQAQEFFa0EuQJq" +
+	"kAEBCBXvBbkCapABapABngYBBxXvBbkCapABapABAQQVmAG5AmoBBBWGBrkCagEE" +
+	"Fa0EuQJqAQQV7wW5AmoBBRWYAWpMuQIBBRWGBmpMuQIBBRWtBGpMuQIBAxWtBJ8B" +
+	"AQUV7wVqTLkCAQYVmAFqTJEDuQIBBhWGBmpMkQO5AgEEFZgBnwFzAQgVrQSfAXNq" +
+	"kAFSTAEIFe8FnwFzTJABapEDAQQVmAGfAWEBBRWYAZ8BkQSTDgEEFZgBnwG5AgEE" +
+	"FYYGnwG5AgEEFYkJnwG5AgEEFe8FnwG5AgEEFZgBnwFMAQUVmAGfAUzABAEGFZgB" +
+	"nwFMkQS4EQEEFZgBnwFqAQQVmAGfAZABAQQVhgifAZABAQUVmAFqnwG5AgEFFYYG" +
+	"ap8BuQIBBhXxDWqfAbkCzAIBBhWGCGqfAbkCngYBBhWGCGqfAbkC3wIBBhWGCGqf" +
+	"AbkCpQgBBRW3BmqfAbkCAQUVrQRqnwG5AgEGFe8Fap8BuQKeBgEFFe8Fap8BuQIB" +
+	"BBWYAUxzAQMVrQRMAQQVmAFMYQEEFYYGTGEBBRWYAUyRBJMOAQYVmAFMYZABggQB" +
+	"BBWGCEyQAQEFFZgBTJABuQIBBBWYAUy5AgEFFZgBTJ8BuQIBBRWGBkyfAbkCAQYV" +
+	"8Q1MnwG5AswCAQUVtwZMnwG5AgEFFa0ETJ8BuQIBBhXvBUyfAbkCngYBBhXvBUyf" +
+	"AbkC3wIBBRXvBUyfAbkCAQYVhgZMnwG5ArUEAQYViAlMnwG5AsAEAQcViAlMnwG5" +
+	"AsAEggQBBxWGCEyfAbkCwASeBgEHFYYITJ8BuQLABN8CAQYVhghMnwG5AsAEAQQV" +
+	"rQRqyAUBBRWYAWqQAYIEAQUVrQRqkAGCBAEHFZgBapABggSQAbsBAQcVmAFqkAGC" +
+	"BJABwAQBBxWYAWqQAYIEkAG1BAEHFZgBapABggRqtQQBBxWYAWqQAYIEtRa1BAEH" +
+	"FZgBapABggRq3RgBBxWYAWqQAesFaoMEAQcVmAFqkAHrBWq1BAEFFZgBapABUgEF" +
+	"FZgBapAB+BYBBxWYAWqQAecDkAHdBQEHFYgJapAB5wOQAd0FAQcVmAFqkAHnA5AB" +
+	"pQgBBRWYAWqQAXgBBBW3BmqQAQEFFbcGapABsQEBBRWtBGqQAbEBAQUVrQRqkAHh" +
+	"AQEEFa0EapABAQUVrQRqkAEDAQUV7wVqkAGeBgEFFe8FapAB3wIBBBXvBWqQAQED" +
+	"FZgBkAEBBBWYAZAB8QkBBRWYAZABggS7AQEFFZgBkAGCBMAEAQUVmAGQAYIEtQQB" +
+	"BBWYAZAB6wUBBhWYAZAB6QRqTAEGFZgBkAHpBEyfAQEGFZgBkAHpBJ8BuQIBBhWY" +
+	"AZABpQg2qwQBBhWYAZABkgE2qwQBBhWYAZABpQhBqwQBBhWYAZABpQhOqwQBBxWY" +
+	"AZABkgFOkQS4EQECFZgBAQMVmAHxCQEDFdYTjAIBAxXWE/0GAQMV1hPpBAEDFYAR" +
+	"jAIBAxWAEf0GAQMVgBHpBAEDFakTjAIBAxWpE/0GAQMVqRPpBAEDFbkKjAIBAxW5" +
+	"Cv0GAQMVuQrpBAEDFYIPjAIBAxWCD/0GAQMVgg/pBAEDFbATtRMBAxWwE+kEAQQV" +
+	"oQvhAYwCAQQVoQsDjAIBBBWhC+EB/QYBBBWhCwP9BgEDFaELig8BBBXWCuEBjAIB" +
+	"BBXWCgOMAgEEFdYK4QH9BgEEFdYKA/0GAQMV1gqKDwEEFaEL1gqXBQEFFf4JYXPh" +
+	"AQEFFf4JYXMDAQQV/glhig8BBBX+CXOKDwEGFf4JYXOXBeEBAQYV/glhc5cFAwEG" +
+	"Ff4JggRCggSZCwEEFf8BgAi9CgEEFZ0CgAi9CgEFFf8BjAKDBAMBBRX/AYwCgwS3" +
+	"AQEFFf8BjAKDBOEBAQUV/wGMAoMEzQgBBRX/AYwC6gaCCAEEFf8BM4MEAQUV/wEz" +
+	"6gaCCAEEFf8BM5cFAQYV/wEzlwXqBoIIAQMV/wGRAwEFFf8B8AKDBJ4JAQUV/wHw" +
+	"AuoGgggBBBX/AfAClwUBBhX/AfAClwXqBoIIAQQV/wGyCgMBBBX/AbIKtwEBBBX/" +
+	"AbIK4QEBBBX/AfUDAwEEFf8B9QO3AQEEFf8B9QPhAQEEFf8B9QOhBgEEFf8B1QYD" +
+	"AQQV/wHVBrcBAQQV/wHVBuEBAQQV/wFkAwEEFf8BZLcBAQQV/wFk4QEBBBX/Ab4H" +
+	"AwEEFf8Bvge3AQEEFf8BvgfhAQEEFf8BjAsDAQQV/wGMC7cBAQQV/wGMC+EBAQQV" +
+	"ogjGBowCAQQVogjGBjMBBBWiCMYGlwUBBBWiCMUGjAIBBBWiCMUGMwEEFaIIxQaX" +
+	"BQEDFaIIjQ8BBRWiCKIFkQqMAgEFFaIIogWRCjMBBRWiCKIFkQrwAgEEFZ0G7wgD" +
+	"AQQVnQbvCLcBAQQVnQbvCOEBAQQVnQbvCM0IAQQVnQbICQMBBBWdBsgJtwEBBBWd" +
+	"BsgJ4QEBBBWdBsgJzQgBBRWdBukE7wgDAQUVnQbpBO8ItwEBBRWdBukE7wjhAQEF" +
+	"FZ0G6QTvCM0IAQUVnQbpBMgJAwEFFZ0G6QTICbcBAQUVnQbpBMgJ4QEBBRWdBukE" +
+	"yAnNCAEFFZ0CjAKDBAMBBRWdAowCgwS3AQEFFZ0CjAKDBOEBAQUVnQKMAoMEzQgB" +
+	"BRWdAowC6gaCCAEEFZ0CM4MEAQUVnQIz6gaCCAEEFZ0CM5cFAQYVnQIzlwXqBoII" +
+	"AQMVnQKRAwEFFZ0C8AKDBJ4JAQUVnQLwAuoGgggBBRWdAvAClwWeCQEGFZ0C8AKX" +
+	"BeoGgggBAxWdArIKAQQVnQL1AwMBBBWdAvUDtwEBBBWdAvUD4QEBAxWdAtUGAQQV" +
+	"nQJkAwEEFZ0CZLcBAQQVnQJk4QEBBBWdAr4HAwEEFZ0Cvge3AQEEFZ0CvgfhAQEE" +
+	"FZ0CjAsDAQQVnQKMC7cBAQQVnQKMC+EBAQQVoArFBowCAQQVoArFBjMBBBWgCsUG" +
+	"lwUBBBWgCsYGjAIBBBWgCsYGMwEEFaAKxgaXBQEDFaAKjQ8BBRX/AecD7gMDAQUV" +
+	"/wHnA+4DtwEBBRX/AecD7gPhAQEFFf8B5wPuA80IAQUV/wHnA4EMAwEFFf8B5wOB" +
+	"DLcBAQUV/wHnA4EM4QEBBRX/AecDgQzNCAEGFf8B5wOxHngDAQYV/wHnA7EeeLcB" +
+	"AQQV/wHcBgMBBBX/AdwGtwEBBBX/AdwG4QEBBBX/AeEEAwEEFf8B4QS3AQEEFf8B" +
+	"4QThAQEFFf8B4QQDMwEGFf8BmQTnAzMDAQYV/wGZBOcDM7cBAQYV/wGZBOcDM+EB" +
+	"AQYV/wGZBOcD8AIDAQYV/wGZBOcD8AK3AQEGFf8BmQTnA/AC4QEBBRX/AecD4AiD" +
+	"BAEFFf8BxQWRAwMBBRX/AcUFkQO3AQEDFcYGjAIBAxXGBjMBAxXGBtgDAQMV/wGN" +
+	"DwEGFf8B5wOHA8EI/AUBBhX/AdwGhwPBCPwFAQYV/wHhBIcDwQj8BQEGFf8BmQSH" +
+	"A8EI/AUBBhXGBowChwPBCPwFAQYVxgYzhwPBCPwFAQYVxgaXBYcDwQj8BQEFFf8B" +
+	"5wOHA9kJAQUV/wHcBocD2QkBBRX/AeEEhwPZCQEFFf8BmQSHA9kJAQUVxgaMAocD" +
+	"2QkBBRXGBjOHA9kJAQUVxgaXBYcD2QkBBhX/AZkEuwHCDAMBBhX/AZkEuwHCDLcB" +
+	"AQYV/wGZBLsBwgzhAQEGFZ0C5wOHA4EGAwEGFZ0C5wOHA4EG4QEBBxWdAtwGhwOB" +
+	"BgMzAQcVnQLcBocDgQbhATMBBxWdAtwGhwOBBgPwAgEHFZ0C3AaHA4EG4QHwAgEH" +
+	"FZ0C4QSHA4EGA4wCAQcVnQLhBIcDgQbhAYwCAQcVnQLhBIcDgQYDMwEHFZ0C4QSH" +
+	"A4EG4QEzAQYVnQKZBIcDgQYDAQYVnQKZBIcDgQbhAQEFFcUGjAKHA4EGAQUVxQYz" +
+	"hwOBBgEFFcUGlwWHA4EGAQYVnQLnA4cD6gUDAQYVnQLnA4cD6gXhAQEHFZ0C3AaH" +
+	"A+oFAzMBBxWdAtwGhwPqBeEBMwEIFZ0C3AaHA+oF8AID8AIBCBWdAtwGhwPqBfAC" +
+	"4QHwAgEHFZ0C4QSHA+oFA4wCAQcVnQLhBIcD6gXhAYwCAQcVnQLhBIcD6gUDMwEH" +
+	"FZ0C4QSHA+oF4QEzAQYVnQKZBIcD6gUDAQYVnQKZBIcD6gXhAQEFFcUGjAKHA+oF" +
+	"AQUVxQYzhwPqBQEFFcUGlwWHA+oFAQQVnQLnAwMBBBWdAucDtwEBBBWdAucD4QEB" +
+	"BBWdAucDzQgBBBWdAucDti0BBBWdAtwGAwEEFZ0C4QQDAQQVnQKZBJwPAQQVnQKZ" +
+	"BAMBBBWdApkE4QEBAxXFBowCAQMVxQYzAQMVxQaXBQEEFZ0CjQ+TCAEGFf8BogV4" +
+	"A4wCAQYV/wGiBXi3AYwCAQYV/wGiBXgDMwEGFf8BogV4twEzAQgVnQKiBXiHA/wF" +
+	"A4wCAQgVnQKiBXiHA/wFtwGMAgEIFZ0CogV4hwP8BeEBjAIBCBWdAqIFeIcD/AUD" +
+	"MwEIFZ0CogV4hwP8BbcBMwEIFZ0CogV4hwP8BeEBMwEGFf8B6gZ4wQiMAgEGFf8B" +
+	"6gZ4wQgzAQcVnQLqBniHA/wFjAIBBxWdAuoGeIcD/AUzAQUV/wGACIIGjAIBBRX/" +
+	"AYAIggYzAQcVnQKACIIGhwP8BYwCAQcVnQKACIIGhwP8BTMBBBX6B58CAwEEFfoH" +
+	"nwLhAQEDFfoH3joBAxX6B8cdAQMV+gebCgEDFfoH1hwBAxX6B7cdAQQV+ge3HZcF" +
+	"AQUV+gfwOe5PzRMBAxX6B6Y9AQIV0gQBAxXSBK5UAQQV0gT/AYMEAQQV0gT/Af1d" +
+	"AQQV0gSdAoMEAQQV0gT/AecDAQQV0gSdAucDAQQV0gSeCXgBBxVowQ2PC9YH6wWy" +
+	"DwEIFWjBDY8L1gdh3QFzAQkVaMENjwvWB2HdAXOyDwEEFeQJgwRhAQQV5AmDBM0D" +
+	"AQQV5AmDBHMBBRXHDeQJzQNzAQUVxw3kCXPNAwEFFccN5AlhzQMBBRXHDeQJzQNh" +
+	"AQMV6xDNAwEDFesQvQoBAxXrEKUKAQMV7QSxAQEDFe0E31oBAxXtBMIDAQQV/wej" +
+	"FowCAQQV/wejFv0GAQQV7QRusQEBBBXtBLoHsQEBBBXtBG7CAwEEFe0EmWOeCQED" +
+	"Ff8HoGMBAxXaEGEBAxXaEHMBAxXaEIQYAQMV6wuDBAEEFesLgwQzAQQV6wuDBJcF" +
+	"AQMV1w2DBAEEFdcNgwQzAQQV1w2DBJcFAQMV6wvFBQEDFdcNxQUBAxXrC+IWAQMV" +
+	"vAqcUgEDFbwKzQMBAxW8Cu8dAQQVmwq8Co4BAQQVmwq8CkwBBBWbCrwKhwEBAhXP" +
+	"FwEDFdYHzQMBAxXWB70KAQMV1gf8EwEDFdYHmmMBBBWpCqwWngYBBBWpCtlb3wIB" +
+	"BRWpCowqA/QcAQUVqQrYWwP0HAEDFYIQzkEBAxWCEPU5AQQVpQPCA80DAQQVpQPC" +
+	"A+sFAQQVpQPCA70KAQMVpQPiDAEEFaUD4gylCgEEFaUD4gyxAQEDFaUD5A0BBBWl" +
+	"A+QNpQoBBBWlA+QNsQEBBBWlA7EBeAEEFaUDsQHrBQEEFaUDsQGlCgEEFaUDsQG8" +
+	"DAEFFaUDsQG8DKUKAQUVpQOxAbwMvx8BBBWlA7EB1QgBBRWlA7EB1QilCgEFFaUD" +
+	"sQHVCL8fAQMVpQPyCgEEFaUD8grrBQEEFaUD8gqlCgEDFaUDmwoBBBWlA5sK6wUB" +
+	"BBWlA5sK7x0BBBWVCf1Rsw8BBRWlDmuSAXIBBRWlDnKSAWsBAxWlA8AKAQQVpQP8" +
+	"E4wCAQQVpQP8EzMBBRWRBugdngbdOgEEFZEG80WVCQEFFZEGwQXpBJUJAQYVkQbB" +
+	"Bcce3QWlAwEFFZEG3QWlA9YcAQUVkQafSvgl3ysBBRWRBtAC6B2eBgEFFZEG0ALd" +
+	"BaUDAQIVmgoBAxWaCp4JAQQVmgrMApEGAQUVmgrMApEGngkBBBWaCswClQkBBRWa" +
+	"CswClQmeCQEEFZoKhCqVCQEDFf8BkRkBAxWdApEZAQIVihsBAhXvCQECFfQ5AQQV" +
+	"3wzgCs5aAQQV3wzgCuVRAQUV8GLfDOAK7xoBBRWaO98M4ArvGgEFFd8Msg/+A+9i" +
+	"AQQVxB6DBLBbAQQVxB7FBbIKAQMVsl6+XwEHFXKvFrIP/gPgCqlCAQMVlAm1LQED" +
+	"FZQJ1kUBAxWUCddFAQMVlAnYRQEDFZQJ2UUBAxWUCdpFAQMVlAnbRQEDFZQJ3EUB" +
+	"AhWACAEDFaBGsQUBAxWfRrEFAQMVlgmqPgEDFZYJ5wcBAxWWCco1AQQVlgnSBIob" +
+	"AQMVlgmxXgEEFZYJgEb4NQECFecCAQMVkgOpAgECFbIHAQIVmwQBAhW3AxADFekF" +
+	"4xoBAxXpBeQaAQMV6QXlGgEDFekF5hoBAxXpBecaAgMVoQbjGgEDFaEG5BoBAxWh" +
+	"BuUaAQMVoQbmGgEDFaEG5xoBAxWhBoFKAQMVoQaCSgEDFaEGg0oBAxWhBvpJAQMV" +
+	"oQb7SQEDFaEG/EkBAxWhBv1JAQMVoQb+SQEDFaEG/0kBAxWhBoBK0QgHBwMA3xCo" +
+	"BALNXgEGBwMA+AGdAcEBAQYHAAMFoAHBAQEFBwMA+AHHAQEHBwADBW8CwQcBBwcD" +
+	"AKQOAs8DUgEHBwMAoAHiAQLBBwEFBwMA+AGdBwELBwMAoAFiAqoBywUYzwNSAQkH" +
+	"AwDFAQJSGM8DUgEHBwDPA8QHAs8DUgEHBwMA3AUCM3cBCQcDANwFAjN3GKQFAQcH" +
+	"AwCgAcUBAqQFAQcHAM8ChgSpAgKkBQEGBwCGBfMBAqQFAQYHAAMFoAHHAQEGBwMA" +
+	"bwKECQEIBwMAvQ2oBALIBFIBCQcDAG8CwQcYyARSAQcHAwCdBwLIBFIBCAcDAKAB" +
+	"YgLIBFIBCQcDAGIChAkYyARSAQgHAwCtD6gEAsgEUgEHBwMAngcCyARSAQgHAwC9" +
+	"DagEAs8DUgEJBwMAOgJIGM8DUgEHBwMANQLPA1IBCAcDAK0PqAQCzwNSAQcHAwDz" +
+	"AQLPA1IBBgcDAGMCpAXiAQQfjAEA7w8BBB+MAQCGEAEEH4wBAO0TAQQfjAEA/hAB" +
+	"BB+MAQDJEAEEH4wBAIgUAQQfjAEAkRQCBB+MAQCPFAEEH4wBAOkKAQUfjAEAV+kK" +
+	"AQQfjAEAOgEEH4wBAMcQAQQfjAEAqREBBB+MAQDQEQEEH4wBAKkMAQQfjAEA/xEB" +
+	"BB+MAQCjEgEEH4wBAMQSAQQfjAEA6BIBBB+MAQCSEwEEH4wBAOITAQQfjAEA6RMB" +
+	"BB+MAQDuEAEEH4wBAIgRAwQfjAEAhRMBBB+MAQChCgEEH4wBANULAQQfjAEAqAIB" +
+	"BB+MAQDqBwEEH4wBANIPAQQfjAEAgxQCBB+MAQDmAwEFH4wBAAO0BAIEH4wBAKYE" +
+	"AQYfjAEA5woDtAQBBR+MAQCvBrQEAQYfjAEA5wqvBrQEAQQfjAEA6AnWAQW6ArsC" +
+	"WQCoAQEFugK7AlkAvgQBBboCuwJZAOdNAQW6ArsCWQC0AQEFugK7AlkAggEBBboC" +
+	"uwJZAK0BAQW6ArsCWQDOBgEFugK7AlkA+0wBBboCuwJZAKsCAQW6ArsCWQCpAQEF" +
+	"ugK7AlkAuAEBBboCuwJZAJ8DAQW6ArsCWQDASwEFugK7AlkA7U0BBboCuwJZAFoB" +
+	"BboCuwJZAL8BAQW6ArsCWQDTAgEFugK7AlkAlhIBBboCuwJZAIUDAQW6ArsCWQDy" +
+	"TQEFugK7AlkAwl8BBboCuwJZANMDAQW6ArsCWQCcAQEFugK7AlkAzwUBBboCuwJZ" +
+	"ALMBAQW6ArsCWQC+TQEFugK7AlkAlwEBBboCuwJZALYfAQW6ArsCWQC8TQEFugK7" +
+	"AlkAww0BBboCuwJZAL1NAQW6ArsCWQClAQEFugK7AlkA4hoBBboCuwJZAOEOAQW6" +
+	"ArsCWQDDAQEFugK7AlkA0AUBBboCuwJZABQBBboCuwJZAO0BAQW6ArsCWQA6AQW6" +
+	"ArsCWQAwAQW6ArsCWQA1AQW6ArsCWQCqAwEFugK7AlkAOAEFugK7AlkAzAMBBboC" +
+	"uwJZAI4CBAS6ArsCWaReAQS6ArsCWaheAQS6ArsCWadeAQS6ArsCWapeAQS6ArsC" +
+	"WaleAQS6ArsCWaZeAQS6ArsCWaVeAQa6ArsCWQEorwUBBroCuwJZASjLXQEGugK7" +
+	"AlkBKJYJAQa6ArsCWQEowiYBBroCuwJZASjaQQEGugK7AlkB/xP/EwEFugK7AlkE" +
+	"ow4DBboCuwJZDuMBAQW6ArsCWQ4xAQW6ArsCWQ42AQW6ArsCWQ5BAQW6ArsCWQ5O" +
+	"AQW6ArsCWQ5VAQW6ArsCWQ51AQW6ArsCWQ6AAQEFugK7AlkOdAEFugK7AlkOiAEF" +
+	"BboCuwJZlwmiTgEFugK7AlktqwLBAgOwBACXAQEDsAQA4AEBA7AEALQBAQOwBAC/" +
+	"AQEDsAQAWgEDsAQAwwEBA7AEAKgBAQOwBACtAQEDsAQAoQIBA7AEALgBAQOwBADO" +
+	"AgEDsAQAswEBA7AEAK8CAQOwBAClAgEDsAQAggEBA7AEAJwBAQOwBACpAQEDsAQA" +
+	"OgEEsAQA0As6AQOwBACLDAEEsAQA0AuLDAEDsAQAMAEDsAQAOAEEsAQA0As4AQOw" +
+	"BADpCwEEsAQA0AvpCwEDsAQANQEDsAQAlQQBBLAEANALlQQBA7AEABQBBLAEAcMG" +
+	"iQESA/MCAO0BAQPzAgAUAQPzAgDgAQED8wIAqwIBA/MCAL8BAQPzAgDDAQED8wIA" +
+	"swEBA/MCAIQDAQPzAgCpAQED8wIArQEBA/MCAJcBAQPzAgC0AQED8wIA6gIBA/MC" +
+	"ANMDAQPzAgC4AQED8wIAqAIBA/MCAKUCAQPzAgCfAwED8wIArwIBA/MCAIUDAQPz" +
+	"AgBaAQPzAgA1AQPzAgCiAgED8wIAnAEBA/MCAKgBAQPzAgDRAgED8wIAggEBA/MC" +
+	"ADgBA/MCADoBA/MCAKECAQPzAgAwAQPzAgCZRgED8wIAvgQBA/MCANcTAQPzAgDX" +
+	"GwED8wIA+SQBA/MCAO4GAQPzAgCXEQED8wIAzAIBA/MCAPwCAQPzAgC9FQED8wIA" +
+	"0wIBA/MCAJ5gAQPzAgDVZAED8wKJAeseAQPzAokBql8BA/MCiQG5EQED8wKJAYlE" +
+	"AQPzAg7jAQED8wIOMQED8wIONgED8wIOQQED8wIOTgED8wIOVQED8wIOdQED8wIO" +
+	"gAEBA/MCDnQBA/MCDogBBgPzAq9MAeEJAyIEwz4BAyIEyT4BAyIExz4BAyIExD4B" +
+	"AyIExj4BAyIExT4BAyIEyD4CBCIE7gXYGAEDIgTCPgEDIgTBPgEDIgTAPgIEIgTu" +
+	"BbwOAQQiBO4F9hoCBCIE7gXEHAEEIgTuBcMcAQQiBO4FwhwBBCIE7gXBFgEEIgTu" +
+	"BcAWAQQiBO4Fmw4BBCIE7gXQGQEEIgTuBZoOAQQiBO4FxxgBBCIE7gXGGAEEIgTu" +
+	"BcUYAQQiBO4FlhgBBCIE7gWVGAEEIgTuBekOAQQiBO4FsxwCBVFQBI4akAUBBVFQ" +
+	"BI8aWgEFUVAEkBqqBQEFUVAEg0ewEQEFUVAEoUe8BQEFUVAEjkeWDAEFUVAE8kaA" +
+	"BQEFUVAE9UewRAEFUVAEkRq0BQEFUVAEkhqvAgEFUVAEkxrpBwEFUVAEwEfKDwEF" +
+	"UVAEuEf9BAEFUVAEtEeBDQEFUVAE70aWBQEFUVAEhEjBYwEFUVAE0UenHwEFUVAE" +
+	"/0bJYwEFUVAE80bHYwEFUVAEzUfIYwEFUVAElBq2DgEFUVAElRqtBQEFUVAElhq5" +
+	"SgEFUVAE/UbVBwEFUVAEoEf8BgEFUVAE3EfyGgEFUVAE/0ewSgEFUVAElxrYBQEF" +
+	"UVAEmBrgAQEFUVAEmRrqBAEFUVAE2EexCgEFUVAEo0euBgEFUVAEqUexFgEFUVAE" +
+	"zEeWBwEFUVAEmho6AQVRUASbGhQBBVFQBJwaMAEFUVAE5UfMAwEFUVAEpkc4AQVR" +
+	"UATnR6oDAQVRUATVRzUBBVFQBMtHuggBBVFQBMVH3wIBBVFQBMlH3wIBBVFQBIVI" +
+	"8wMBBVFQBPRH/AIBBVFQBKAanQUBBVFQBKEauAEBBVFQBKIasgUBBVFQBORHiQoB" +
+	"BVFQBLZHsAUBBVFQBMpH4wwBBVFQBJFH+AQBBVFQBIZI41kBBVFQBKYa1QIBBVFQ" +
+	"BKcaqQEBBVFQBKga8gQBBVFQBJZHoA4BBVFQBItHowMBBVFQBPhG+goBBVFQBNtH" +
+	"zAUBBlFQBLBHqgGjAwEFUVAEnRraBQEFUVAEnhq/AQEFUVAEnxrbBQEFUVAEm0fD" +
+	"CgEFUVAE9ke3FwEFUVAE90fHBQEFUVAEoxriBQEFUVAEpBq0AQEFUVAEpRqIBQEF" +
+	"UVAEnUepCwEFUVAE+Ub6AwEFUVAEqketCwEFUVAEh0dCAQVRUASpGo4JAQVRUASq" +
+	"GqUCAQVRUASrGvMJAQVRUATfR+sKAQVRUASzR6MHAQVRUATiR5sZAQVRUASBR80H" +
+	"AQZRUATxR6oBzQcBBVFQBKwaCQEFUVAErRqzAQEFUVAErhrmAwEFUVAEr0e9CwEF" +
+	"UVAEkkerBgEFUVAEz0fUDwEFUVAEu0emBAEFUVAErxrHBwEFUVAEsBrTAwEFUVAE" +
+	"sRrCCAEFUVAEkEfnCQEFUVAEjUefBwEFUVAEx0fqEAEFUVAEmkfKBwEFUVAEh0i/" +
+	"OwEFUVAEp0f4FwEFUVAEshqREgEFUVAEsxqWSwEFUVAEtBrxAgEFUVAEt0eGEgEF" +
+	"UVAE60esTQEFUVAE8kf2AwEFUVAEthqCAQEFUVAE/EfbBgEFUVAEtRr0DQEFUVAE" +
+	"okfUAwEFUVAEtxqEDAEFUVAEzkffBAEFUVAElUepPgEFUVAEwkfKPwEFUVAE90bc" +
+	"GAEFUVAExEfKGAEFUVAEmUftGAEFUVAE9kbTGAEFUVAEg0iNEQEFUVAE8EaJQAEF" +
+	"UVAEnEeXGwEFUVAEuBqwDAEFUVAElEeMEgEFUVAEtUeVGwEFUVAE1EeIEgEFUVAE" +
+	"3keLEgEFUVAEukeKEgEFUVAE4UeCTAEFUVAEwUeKTAEGUVAEmEeqAYgSAQZRUASs" +
+	"R6oBixIBBlFQBPlHqgGKEgEFUVAE3UeOBQEFUVAEsUfDAQEFUVAE6keEBgEFUVAE" +
+	"gEj3CwEFUVAE6Ee6PQEFUVAE6Ue2PQEFUVAE+keTGwEFUVAE+0adGwEFUVAE80et" +
+	"TAEFUVAEhkeTAwEFUVAEpUeXAQEFUVAE9EaGBwEFUVAEk0fSCAEFUVAE9UaxAwEF" +
+	"UVAEqEfMDAEFUVAEhEf1BQEFUVAE00feEQEFUVAEgEeiDAEFUVAEvEfiEQEFUVAE" +
+	"8UavDgEFUVAE/kevDgEFUVAEikeuDgEFUVAE7kfhEQEFUVAE8EfgEQEFUVAE/Ufr" +
+	"SAEGUVAE40eqAa4OAQZRUASrR6oB4REBBlFQBNBHqgHgEQEFUVAExkfJGQEFUVAE" +
+	"nkeYDAEFUVAEjEfLGQEFUVAEuRrIGQEFUVAErkfHGQEFUVAEskfKGQEFUVAE4EeU" +
+	"DgEFUVAEvkegGAEFUVAE+kb0EAEFUVAE1kf1EAEFUVAEn0efGAEFUVAErUfnDQEF" +
+	"UVAEiUeiGAEFUVAEiEehGAEFUVAE7UecAQEFUVAEvUeDEgEFUVAEw0eCCwEFUVAE" +
+	"v0e/DgEFUVAEgUiCEgEFUVAEuUe+DgEFUVAEhUeIGwEFUVAEgkeEEgEFUVAE2kel" +
+	"GwEFUVAEgkiTEgEFUVAE10eyDAEFUVAEyEeqGwEFUVAE+EfmCAEFUVAE+0eFAwEF" +
+	"UVAE2UejCgEFUVAE70fNCQEFUVAEl0eTBgEFUVAE0keZHwEFUVAEj0emCAEFUVAE" +
+	"5kerTgEFUVAE/EajTgEFUVAE7Ee2TgEFUVAEpEemTgEFUVAE/kaxTgMEUVAOMQEE" +
+	"UVAONgEEUVAOQQEEUVAOTgEEUVAOVQEEUVAOdQEEUVAOgAEBBFFQDnQBBFFQDogB" +
+	"AQVRUB8dol0BBVFQHx3jBwEFUVAfHY8RAQVRUB8dxgkBBlFQHx2GAsYJAQZRUB8d" +
+	"hgHGCQEFUVAfHfwJKgTwAQUA4A8BBPABBQCCFwEE8AEFAMERAQTwAQUA3xoBBPAB" +
+	"BQDgAQEE8AEFALkdAQTwAQUAsQMBBPABBQCeDQEE8AEFAJwBAQTwAQUAOAEE8AEF" +
+	"ANMDAQTwAQUAOgEE8AEFADUBBPABBQCpAwEE8AEFAMYfAQTwAQUA0QMBBPABBQDx" +
+	"AgEE8AEFAJ0DAQTwAQUAswEBBPABBQAwAQTwAQUAkxkBBPABBQDrBAEE8AEFAIIB" +
+	"AQTwAQUA2RIBBPABBQDDAQEE8AEFANMCAQTwAQUAiAUBBPABBQCECwEE8AEFAIUD" +
+	"AQTwAQUA0QIBBPABBQDnDQEE8AEFANgPAQTwAQUAlA4BBPABBQCoAgEE8AEDAOAP" +
+	"AQTwAQMAghcBBPABAwDBEQEE8AEDAN8aAQTwAQMA4AEBBPABAwC5HQEE8AEDALED" +
+	"AQTwAQMAng0BBPABAwCcAQEE8AEDADgBBPABAwDTAwEE8AEDADoBBPABAwA1AQTw" +
+	"AQMAqQMBBPABAwDGHwEE8AEDANEDAQTwAQMA8QIBBPABAwCdAwEE8AEDALMBAQTw" +
+	"AQMAMAEE8AEDAJMZAQTwAQMA6wQBBPABAwCCAQEE8AEDANkSAQTwAQMAwwEBBPAB" +
+	"AwDTAgEE8AEDAIgFAQTwAQMAhAsBBPABAwCFAwEE8AEDANECAQTwAQMA5w0BBPAB" +
+	"AwDYDwEE8AEDAJQOAQTwAQMAqAIBA/AB4A+jDgED8AEQow4BA/AB+AsqAQLwAZEC" +
+	"AQPwAfEBPQEE8AG3PPEBPQEC8AGDBQED8AH+ESoFA/ABDuMBAQPwAQ4xAQPwAQ42" +
+	"AQPwAQ5BAQPwAQ5OAQPwAQ5VAQPwAQ51AQPwAQ6AAQED8AEOdAED8AEOiAEFBPAB" +
+	"V+wEKgEE8AFXywQqkgYEmQKTAR0xAQSZApMBHTYBBJkCkwEdQQEEmQKTAR1OAQSZ" +
+	"ApMBHVUBBJkCkwEddQEEmQKTAR2AAQEEmQKTAR10AQSZApMBHYgBAQSZApMBHYYC" +
+	"AQSZApMBHacDAQSZApMBHb0EAQSZApMBHdwEAQSZApMBHYUEAQSZApMBHY4GAQSZ" +
+	"ApMBHZMEAQSZApMBHbMGAQSZApMBHe0DAQWZApMBHTGGAQEFmQKTAR02hgEBBZkC" +
+	"kwEdQYYBAQWZApMBHU6GAQEFmQKTAR1VhgEBBZkCkwEddYYBAQWZApMBHYABhgEB" +
+	"BZkCkwEddIYBAQWZApMBHYgBhgEBBZkCkwEdMasBAQWZApMBHTarAQEFmQKTAR1B" +
+	"qwEBBZkCkwEdTqsBAQWZApMBHVWrAQEFmQKTAR11qwEBBZkCkwEdgAGrAQEFmQKT" +
+	"AR10qwEBBZkCkwEdiAGrAQEFmQKTAR2GAqsBAQWZApMBHacDqwEBBZkCkwEdvQSr" +
+	"AQEFmQKTAR3cBKsBAQWZApMBHYUEqwEBBZkCkwEdjgarAQEFmQKTAR2TBKsBAQWZ" +
+	"ApMBHbMGqwEBBZkCkwEd7QOrAQEEmQKTAR3CEQEEmQKTAR2vRQEEmQKTAcIRKgEE" +
+	"mQKTAR36QgEEmQKTAR37QgEFmQKTAR2UCDEBBZkCkwEdlAg2AQWZApMBHZQIQQEF" +
+	"mQKTAR2UCE4BBZkCkwEdlAhVAQWZApMBHZQIdQEFmQKTAR2UCIABAQWZApMBHZQI" +
+	"dAEFmQKTAR2UCIgBAQOZApMBwhIBBZkCkwHMATHuAwEFmQKTAcwBMW4BBZkCkwHM" +
+	"AUHCBgEEmQKTAbEJKgEFmQKTAR3YAzEBBZkCkwEd2AM2AQaZApMBHdgDhgKrAQEF" +
+	"mQKTAdgDwhEqTQToApMBHTEBBOgCkwEdNgEE6AKTAR1BAQToApMBHU4BBOgCkwEd" +
+	"VQEE6AKTAR11AQToApMBHYABAQToApMBHXQBBOgCkwEdiAEBBOgCkwEdhgIBBOgC" +
+	"kwEdpwMBBOgCkwEdvQQBBOgCkwEd3AQBBOgCkwEdhQQBBOgCkwEdjgYBBOgCkwEd" +
+	"kwQBBOgCkwEdswYBBOgCkwEd7QMBBegCkwEdMYYBAQXoApMBHTaGAQEF6AKTAR1B" +
+	"hgEBBegCkwEdToYBAQXoApMBHVWGAQEF6AKTAR11hgEBBegCkwEdgAGGAQEF6AKT" +
+	"AR10hgEBBegCkwEdiAGGAQEF6AKTAR0xqwEBBegCkwEdNqsBAQXoApMBHUGrAQEF" +
+	"6AKTAR1OqwEBBegCkwEdVasBAQXoApMBHXWrAQEF6AKTAR2AAasBAQXoApMBHXSr" +
+	"AQEF6AKTAR2IAasBAQXoApMBHYYCqwEBBegCkwEdpwOrAQEF6AKTAR29BKsBAQXo" +
+	"ApMBHdwEqwEBBegCkwEdhQSrAQEF6AKTAR2OBqsBAQXoApMBHZMEqwEBBegCkwEd" +
+	"swarAQEF6AKTAR3tA6sBAQPoApMBxEgBBegCkwHYAx02AQXoApMB2AMdQQEF6AKT" +
+	"AdgDHU4BBegCkwHYAx1VAQXoApMB2AMddQEF6AKTAdgDHYABAQXoApMB2AMddAEF" +
+	"6AKTAdgDHYgBAQXoApMB2AMdhgIBBugCkwHYAx1OhgEBBugCkwHYAx11hgEBBugC" +
+	"kwHYAx02qwEBBugCkwHYAx2GAqsBAQXoApMBzAExbgEF6AKTAcwBMY0TwwEDCAx2" +
+	"AQMIDLwDAQMIDMIBAQMIDNkFAgMIDNEDAQMIDKwGAQMIDKUBAQMIDOUDAQMIDFwB" +
+	"AwgMnQMBAwgMpgIBAwgMWAEDCAzLAgEDCAyZAwEDCAy7AwEDCAzrAwEDCAySBAED" +
+	"CAyBBAEDCAzvAwEDCAzFAwEDCAyQAwEDCAyVBQEDCAzGAgEDCAzCCQEDCAydBAED" +
+	"CAzsBgEDCAzuBAEECAy6BbwDAQQIDLoFywIBBAgMugXrAwEECAy6BYEEAgQIDFe8" +
+	"AwEECAxXwgECBAgMV6IDAwQIDFelAQIECAxXXAEECAxXnQMBBAgMV6YCAQQIDFdY" +
+	"AQQIDFfLAgEECAxXmQMBBAgMV7sDAQQIDFfrAwEECAxXkgQBBAgMV4EEAgQIDFfF" +
+	"AwEECAxXkAMBBAgMV5UFAQQIDFfGAgIECAxXnQQCBAgMV+4EBwQIDKkGwgEFBAgM" +
+	"qQalAQIECAypBlwCBAgMqQamAgIECAypBssCAQQIDKkGmQMBBAgMqQa7AwIECAyp" +
+	"BpIEAQQIDKkGgQQCBAgMqQbFAwMECAypBsYCAgQIDKkGnQQCBAgMqQbuBAIFCAyp" +
+	"BroFywICBQgMqQa6BYEEAgQIDIYFvAMBBAgMhgXCAQIECAyGBaIDAwQIDIYFpQEB" +
+	"BAgMhgXlAwEECAyGBVwBBAgMhgWdAwIECAyGBVgBBAgMhgXLAgEECAyGBZkDAQQI" +
+	"DIYFuwMBBAgMhgXrAwEECAyGBZIEAQQIDIYFgQQCBAgMhgXFAwEECAyGBZADAQQI" +
+	"DIYFlQUBBAgMhgXGAgIECAyGBZ0EAQQIDIYF7AYBBAgMhgXuBAEFCAyGBboFvAMC" +
+	"BQgMhgW6BesDAgQIDOIEdgEECAziBLwDAQQIDOIEwgEBBAgM4gTZBQEECAziBKID" +
+	"AQQIDOIE0QMBBAgM4gSsBgEECAziBKUBAQQIDOIE5QMBBAgM4gRcAgQIDOIEpgIB" +
+	"BAgM4gRYAQQIDOIEywIBBAgM4gSZAwEECAziBLsDAQQIDOIE6wMBBAgM4gSSBAEE" +
+	"CAziBIEEAQQIDOIE7wMBBAgM4gTFAwEECAziBJADAQQIDOIElQUBBAgM4gTGAgEE" +
+	"CAziBMIJAQQIDOIEnQQBBAgM4gTsBgEECAziBO4EBgQIDNMBvAMBBAgM0wHCAQEE" +
+	"CAzTAdkFAgQIDNMB0QMBBAgM0wGsBgEECAzTAaUBAQQIDNMB5QMBBAgM0wFcAgQI" +
+	"DNMBpgIBBAgM0wFYAQQIDNMBywIBBAgM0wGZAwEECAzTAbsDAQQIDNMB6wMBBAgM" +
+	"0wGSBAEECAzTAYEEAQQIDNMB7wMBBAgM0wHFAwEECAzTAZADAQQIDNMBlQUBBAgM" +
+	"0wHGAgEECAzTAcIJAQQIDNMBnQQBBAgM0wHsBgEECAzTAe4ENQgIDLADWAKlAQKo" +
+	"CwEGCAywA6UBAtkFjwIEwwN93QLoBwEEwwN98gHoBwEEwwN9hgPoBwEEwwN9vAHo" +
+	"BwEEwwN94AX9CAEEwwN9hwn9CAEEwwN9P/0IAQXDA30xSrIIAQXDA302SrIIAQXD" +
+	"A31BSrIIAQXDA31OSrIIAQXDA31VSrIIAQXDA311SrIIAQXDA32AAUqyCAEFwwN9" +
+	"dEqyCAEFwwN9iAFKsggBBcMDfTFKrAgBBcMDfTZKrAgBBcMDfUFKrAgBBcMDfU5K" +
+	"rAgBBcMDfVVKrAgBBcMDfXVKrAgBBcMDfYABSqwIAQXDA310SqwIAQXDA32IAUqs" +
+	"CAEFwwN9MUqCBgEFwwN9NkqCBgEFwwN9QUqCBgEFwwN9TkqCBgEFwwN9VUqCBgEF" +
+	"wwN9dUqCBgEFwwN9gAFKggYBBcMDfXRKggYBBcMDfYgBSoIGAQPDA33KUQEDwwN9" +
+	"5k8BA8MDffQPAQPDA32MLAEDwwN92VoBA8MDfd5bAQPDA32uJwEDwwN9oWMBA8MD" +
+	"fYcOAQPDA32TBwUExgF9rAGTBwEDxgF9zD8BA8YBfc0/AQPGAX3OPwEDxgF9zz8B" +
+	"A8YBfdA/AQPGAX3RPwEDxgF90j8BA8YBfdM/AQPGAX3UPwEDxgF91T8BA8YBfdY/" +
+	"AQPGAX3XPwEDxgF92D8BA8YBfdk/AQPGAX3aPwEDxgF92z8BA8YBfdw/AQPGAX3d" +
+	"PwEDxgF93j8BA8YBfd8/AQPGAX3gPwEDxgF94T8BA8YBfeI/AQPGAX3jPwEDxgF9" +
+	"5D8BA8YBfeU/AQPGAX3mPwEDxgF95z8BA8YBfeg/AQPGAX3pPwEDxgF96j8BA8YB" +
+	"fes/AQPGAX3sPwEDxgF97T8BA8YBfe4/AQPGAX3vPwEDxgF98D8BA8YBffE/AQPG" +
+	"AX3yPwEDxgF98z8BA8YBffQ/AQPGAX31PwEDxgF99j8BA8YBffc/AQPGAX34PwED" +
+	"xgF9+T8BA8YBffo/AQPGAX37PwEDxgF9/D8BBMYBfVOTBwEDxgF9x2EBA8YBfchh" +
+	"AQPGAX3JYQEDxgF9ymEBA8YBfcthAQPGAX3MYQEDxgF9zWEBA8YBfc5hAQPGAX3P" +
+	"YQEDxgF90GEBA8YBfdFhAQPGAX3SYQEDxgF902EBA8YBfdRhAQPGAX3VYQEDxgF9" +
+	"1mEBA8YBfddhAQPGAX3YYQEDxgF92WEBA8YBfdphAQPGAX3bYQEDxgF93GEBA8YB" +
+	"fd1hAQPGAX3eYQEDxgF932EBA8YBfeBhAQPGAX3hYQEDxgF94mEBA8YBfeNhAQPG" +
+	"AX3kYQEDxgF95WEBA8YBfeZhAQPGAX3nYQEDxgF96GEBA8YBfelhAQPGAX3qYQED" +
+	"xgF962EBA8YBfexhAQPGAX3tYQEDxgF97mEBA8YBfe9hAQPGAX3wYQEDxgF98WEB" +
+	"A8YBffJhAQPGAX3zYQEDxgF99GEBA8YBffVhAQPGAX32YQEDxgF992ENA4QC9AGT" +
+	"BwEFhAL0AYsNSuYGAQWEAvQBNkrmBgEFhAL0AUFK5gYBBYQC9AFOSuYGAQWEAvQB" +
+	"VUrmBgEFhAL0AXVK5gYBBYQC9AGAAUrmBgEFhAL0AXRK5gYBBYQC9AGIAUrmBgEF" +
+	"hAL0AYYCSuYGAQWEAvQBhA5K5gYBBYQC9AHTBErmBgEFhAL0AZ8GSuYGAQWEAvQB" +
+	"8wVK5gYDBYQC9AGLDUqHBgEFhAL0ATZKhwYBBYQC9AFBSocGAQWEAvQBTkqHBgEF" +
+	"hAL0AVVKhwYBBYQC9AF1SocGAQWEAvQBgAFKhwYBBYQC9AF0SocGAQWEAvQBiAFK" +
+	"hwYBBYQC9AGGAkqHBgEFhAL0AYQOSocGAQWEAvQB0wRKhwYBBYQC9AGfBkqHBgEF" +
+	"hAL0AfMFSocGAQSEAvQB4AWHDgIFhAL0AYsNStcGAQWEAvQBNkrXBgEFhAL0AUFK" +
+	"1wYBBYQC9AFOStcGAQWEAvQBVUrXBgEFhAL0AXVK1wYBBYQC9AGAAUrXBgEFhAL0" +
+	"AXRK1wYBBYQC9AGIAUrXBgEFhAL0AYYCStcGAQWEAvQBhA5K1wYBBYQC9AHTBErX" +
+	"BgEFhAL0AZ8GStcGAQWEAvQB8wVK1wYBBIQC9AE3hw4CBYQC9AGLDUrWBgEFhAL0" +
+	"ATZK1gYBBYQC9AFBStYGAQWEAvQBTkrWBgEFhAL0AVVK1gYBBYQC9AF1StYGAQWE" +
+	"AvQBgAFK1gYBBYQC9AF0StYGAQWEAvQBiAFK1gYBBYQC9AGGAkrWBgEFhAL0AYQO" +
+	"StYGAQWEAvQB0wRK1gYBBYQC9AGfBkrWBgEFhAL0AfMFStYGAQSEAvQBP4cOAQOE" +
+	"AvQBpjsBA4QC9AHcXgEDhAL0AedeAQOEAvQB6l4BA4QC9AHrXgEDhAL0AexeAQOE" +
+	"AvQB7V4BA4QC9AHuXgEDhAL0Ae9eAQOEAvQB8F4BA4QC9AHdXgEDhAL0Ad5eAQOE" +
+	"AvQB314BA4QC9AHgXgEDhAL0AeFeAQOEAvQB4l4BA4QC9AHjXgEDhAL0AeReAQOE" +
+	"AvQB5V4BA4QC9AHmXgEDhAL0AeheAQOEAvQB6V4LBA7jAZIDqQIBAw7jAecCAQMO" +
+	"MecCAQMONucCAQMOQecCAQMOTucCAQMOVecCAQMOdecCAQMOgAHnAgEDDnTnAgED" +
+	"DogB5wIBBZ8ELS8O4wEBBp8EzgEtLw7jAQEELeMBAqYGAQMtuAUbAQYt4AkBAqkS" +
+	"1QkBBYMBBwUAFAEFgwEHBQA+AQWDAQcFAPMBAQWDAQcFANIBAQWDAQcFADgBBYMB" +
+	"BwUAswIBBYMBBwUAwQEBBYMBBwUA1AEBBYMBBwUAOgEFgwEHBQCTAgEFgwEHBQDH" +
+	"AQEFgwEHBQBvAQWDAQcFALABAQWDAQcFAJkBAQWDAQcFADUBBYMBBwUA+gEBBYMB" +
+	"BwUA7gIBBYMBBwUAYgEFgwEHBQBjAQWDAQcFAMUBAQWDAQcFADABBYMBBwUAywEB" +
+	"BYMBBwUAjgIBBYMBBwUA/AEBBYMBBwUA4gEBBYMBBwUA3gEBB/sEpATxBwcFAGMB" +
+	"Bi0sBwUA8wEBBi0sBwUAYgECLdEWAQItymMBAtozCwEFZQcFABQBBWUHBQA+AQVl" +
+	"BwUA8wEBBWUHBQDSAQEFZQcFADgBBWUHBQCzAgEFZQcFAMEBAQVlBwUA1AEBBWUH" +
+	"BQA6AQVlBwUAkwIBBWUHBQDHAQEFZQcFAG8BBWUHBQCwAQEFZQcFAJkBAQVlBwUA" +
+	"NQEFZQcFAPoBAQVlBwUA7gIBBWUHBQBiAQVlBwUAYwEFZQcFAMUBAQVlBwUAMAEF" +
+	"ZQcFAMsBAQVlBwUAjgIBBWUHBQD8AQEFZQcFAOIBAQVlBwUA3gEBAmX5DQECZagM" +
+	"AQJlmFYBAmWhDwECZfRRAQJlpB8BBs4BLQcFABQBBs4BLQcFAD4BBs4BLQcFAPMB" +
+	"AQbOAS0HBQDSAQEGzgEtBwUAOAEGzgEtBwUAswIBBs4BLQcFAMEBAQbOAS0HBQDU" +
+	"AQEGzgEtBwUAOgEGzgEtBwUAkwIBBs4BLQcFAMcBAQbOAS0HBQBvAQbOAS0HBQCw" +
+	"AQEGzgEtBwUAmQEBBs4BLQcFADUBBs4BLQcFAPoBAQbOAS0HBQDuAgEGzgEtBwUA" +
+	"YgEGzgEtBwUAYwEGzgEtBwUAxQEBBs4BLQcFADABBs4BLQcFAMsBAQbOAS0HBQCO" +
+	"AgEGzgEtBwUA/AEBBs4BLQcFAOIBAQbOAS0HBQDeAQEDkQTJGgEBA5EE7EgBAQOR" +
+	"BKRKAQECLdAWAQUt8wECqRLVCQEDLY4RgwkBBs4BZQcFABQBBs4BZQcFAD4BBs4B" +
+	"ZQcFAPMBAQbOAWUHBQDSAQEGzgFlBwUAOAEGzgFlBwUAswIBBs4BZQcFAMEBAQbO" +
+	"AWUHBQDUAQEGzgFlBwUAOgEGzgFlBwUAkwIBBs4BZQcFAMcBAQbOAWUHBQBvAQbO" +
+	"AWUHBQCwAQEGzgFlBwUAmQEBBs4BZQcFADUBBs4BZQcFAPoBAQbOAWUHBQDuAgEG" +
+	"zgFlBwUAYgEGzgFlBwUAYwEGzgFlBwUAxQEBBs4BZQcFADABBs4BZQcFAMsBAQbO" +
+	"AWUHBQCOAgEGzgFlBwUA/AEBBs4BZQcFAOIBAQbOAWUHBQDeAQEH8gbOAWUHBQD6" +
+	"AQEDzgFlkhEBA84BZZcBAQPOAWW4AQEDzgFltwUBA84BZaQfAQIrmzYBAmWuLAEC" +
+	"ZdYzAQJl9QsBAmXrQAECZfUBAQJlzgUBAmXPDgECZblaAQVlYQLsBCoBAmWuYgED" +
+	"ZUHSAQEDZdoI2wwBA2U2xwEBA2VOxwEBA2V0xwEBBGVV5wQxAQRlgAHnBDEBBGXl" +
+	"E+cENgEDZY4G+gEBBWUxhgGnA/oBAQVlBwMA0gEBAmWXPgECZZg+AQJl0T4BAmW2" +
+	"RgECZdRZAQJloWABAmWdYgED3BH7Ews5BfMElgQLABQBBfMElgQLAD4BBfMElgQL" +
+	"APMBAQXzBJYECwDSAQEF8wSWBAsAOAEF8wSWBAsAswIBBfMElgQLAMEBAQXzBJYE" +
+	"CwDUAQEF8wSWBAsAOgEF8wSWBAsAkwIBBfMElgQLAMcBAQXzBJYECwBvAQXzBJYE" +
+	"CwCwAQEF8wSWBAsAmQEBBfMElgQLADUBBfMElgQLAPoBAQXzBJYECwDuAgEF8wSW" +
+	"BAsAYgEF8wSWBAsAYwEF8wSWBAsAxQEBBfMElgQLADABBfMElgQLAMsBAQXzBJYE" +
+	"CwCOAgEF8wSWBAsA/AEBBfMElgQLAOIBAQXzBJYECwDeAQEDK9sBwz8BA2VHi0QB" +
+	"A2VHuAEOBGVWlQOAQQEEZVaVA/xAAQRlVpUD9EABA2VHpQUBBGVWlQP6GAEEZVaV" +
+	"A/pAAQRlVpUDlUEBBGVWlQP7QAEEZVaVA+5AAQRlVpUDh0EBBGVWlQOOQQEEZVaV" +
+	"A4VBAQRlVpUD8UABBGVWlQP/QAEEZVaVA+9AAQRlVpUDhkEBBGVWlQPwQAEEZVaV" +
+	"A5RBAQRlVpUDj0EBBGVWlQOWQQEEZVaVA/lAAQRlVpUD90ABBGVWlQOMQQEEZVaV" +
+	"A4FBAQRlVpUDg0EBBGVWlQPsQAEEZVaVA/kYAQRlVpUDmEEBBGVWlQP+QAEEZVaV" +
+	"A+1AAQRlVpUD9UABBGVWlQOCQQEEZVaVA5dBAQRlVpUD+xgBBGVWlQOSQQEEZVaV" +
+	"A5NBAQRlVpUD9kABBGVWlQOLQQEEZVaVA4lBAQRlVpUDiEEBBGVWlQOQQQEEZVaV" +
+	"A/JAAQRlVpUD+EABBGVWlQOZQQUG+wSkBPEHVpUDikEBBvsEpATxB1aVA/kYAQb7" +
+	"BKQE8QdWlQP6GAEG+wSkBPEHVpUD/UABBvsEpATxB1aVA41BAQb7BKQE8QdWlQP7" +
+	"GAEG+wSkBPEHVpUDkUEBBvsEpATxB1aVA/NAAQb7BKQE8QdWlQOEQQgDLeUB6CUB" +
+	"Ay3lAdglDwTHBgsowggBBMcGCyjyBAEExwYLKLpZAQTHBgsozwYBBMcGCyjFWQEE" +
+	"xwYLKIIrmwEBjTQBAaA7AQLCA/sMAQPEDgKUWwED8x2SAZ1KAQHzHQED5BbZBNE4" +
+	"AQPgW5IBvRYBAcdTAQO3CtkExA4BAukGmQQBAaFiAQLaSf8MAQOYBukN7TkBA5gG" +
+	"6Q2qJgEDmAbpDYonAQPpDQKDSQED9QHGBAsBBKMfwQrGBAsBBMAI7gPGBAsBBKMf" +
+	"sxjGBAsBA5IDxgQLAQSdH7MYxgQLAQTEEe4DxgQLAQSdH8EKxgQLAQLBCsYEAQT1" +
+	"AcYEAmgBBcAI7gPGBAJoAQXEEe4DxgQCaAEEkgPGBAJoAQOoBgJoAQKRPe8CAQKt" +
+	"We8CAQGuHgECN8EXAQI/qAYBBT+oBgID9gcBBD+oBvoP9gcBBj+oBvoP9gcCiAoB" +
+	"A/YHAogKAQP2BwKUEwED9gcCngwBA/YHArBeAQGfOwED6AesFmgBAoUMxwoBAe5c" +
+	"AQHKKgEB7SsBAaVWAQLvOaMIAQK0NaMIAQKKC6MIAQH+KgEChQz+GwEBol8BAusr" +
+	"qxYBAc9UAQHSPgEB31sBAasWAQPiCUqrSAED4glKlAsBAbQ+AQNOqwXBLAECu0ir" +
+	"BQEC2DqrBQEEqwWEGN8C6AcBAchKAQGhXgEBoycBAas9AQHPGgEB/GIBAf1cAQHV" +
+	"RQEBmykBAbRRAQLgBeUVAQKHCeUVAQHjUAEB4FABAeorAQGtWwEB/z0BA8UdSrxR" +
+	"AQOxDswCoA0BAutRywUBApQL6zMBApQLlAcBAtQzlAsBA4Q0GJQLAQKaW4AQAQHB" +
+	"WgEBuRYBAo0YvTsBA8RU+h2mHAEBhjUBAcVPAQHuWwECvDupHQEFgQiLEALoDNA1" +
+	"AQPlBowJ8BYBAo5ZjAkBAowJ8BYBAbY4AQHtFgEChCx3AQGLKwEBpkYBAYY0AQLF" +
+	"P+UOAQG3WQEC3SlkAQPlDkreDQEB1TMBA+ANGPMKAQOaXYANyxgBBOBVoQ0Y3AsB" +
+	"AswP2woBAqot2woBAtkevhcBApIWuQ4BA+UWkhaxSgECrwqhDQEF4A0Y8woCwlEB" +
+	"BKENAuJR3DMBAeBRAQHbBwECumP8UQEC/CmLEAEB9EEBApQQowgBAvkXlBABAYM7" +
+	"AQKCO8JaAQHyDwECsxLhUQECwjOUBwEC+1yjCAEC8gaTOwECs1GcFwECjQm1FwEC" +
+	"mSuuWwEC6Af4KwEDxgSKYswrAQL0Ev1VAQKnPacNAQa4BALBBcwCxgMnAQO2Fkqb" +
+	"OwEC7BGkDAEC9VPbBwEEI5QMAvVBAQLAW9kaAQLwGZdaAQK+Cv1DAQSdDe4VI7UM" +
+	"AQSdDcEQI7UMAQL9F7g7AQLlJfRdAQKYK8cIAQLsOssPAQLwHKgtAQSGO8oMGIEI" +
+	"AQHZGgECoEqkDQEBpiwBAaM+AQKAJ5tQAQKzA/AJAQKpLL0TAQHzXQECsSzPCwEC" +
+	"9VDtFQECkx+aGAECwxDfGAECm1rXEQEB9ykBApoY+ggBAZ8qAQPzC4QClisBAiPm" +
+	"BAED/QYjtQwBAYhWAQG8PQECI5QMAQHxXgEBkGIBAiOQVgEE7RKmWQL8VQEEpB7I" +
+	"HBiUBwEExB0YxB20FgEDqykYyD8BAukroAcBAaRaAQH9VAEB61sBAtVapAwBAdte" +
+	"AQLHCN4SAQKpJocYAQL2VIcYAQH2WwECuQv5RQEBmj0BAt4SmUoBAt4S9AgBBPEW" +
+	"9g8YlAcBAaJiAQX8F+MYugkYlAcBBYwJ4xi6CRibUgEFuBOkHpFQGJQHAQOUE5Ir" +
+	"nQkBAYgrAQPMKQL7DAEC0QujEAEC5Aq9FgEB5BYBA8w15ArRCwECsyzRCwEBoxcB" +
+	"AqMX6kEBAoQbxlABAYxbAQLkCtELAQPkCgKePAECoRLRCwEDjQnIEqESAQPzF8gS" +
+	"oRIBAf4/AQH1DwEDrSeoXdcRAQHqGAEC0RHqGAEC0zPpHQEB9BIBAsk16R0BAdA6" +
+	"AQLzQbZFAQKNCY8QAQLzF48QAQI/+xsBAjf7GwED9BM/oAcBA/QTN6AHAQHrEgEC" +
+	"N+sSAQH5RAEEjCnIHBjSWQEDtgoYGwEBrCYBBLwIPfALyl8BBLwIPfAL+B4BBLwI" +
+	"PfAL+R4BBLwIPfAL+h4BBLwIPfAL+x4BAfMOAQH9CQEB0g4BAukGuyoBAdoLAQGw" +
+	"DwEB3kUBAcccAQHxBgEB/QgBAe8zAQGoHwEBoVoBAZwPAQHHCAEB8g4BAZU9AQH/" +
+	"EgEBpgwBAc1UAQHyKwEBxwoBAeAOAQGtFgEB0RABAcRPAQKRCqQEAQG8KgEBySYB" +
+	"AcY/AQKARZMWAQGBCAEC2R6BCAEBjSoBAfcMAQKPPpIQAQKvCpIQAQO+O68KkhAB" +
+	"AZ8NAQHuUAEBhEQBAd5RAQK7OMcWAQKKKccWAQGsNgEC/QloAQLaC2gBArAPaAEC" +
+	"xxxoAQLxBmgBAv0IaAEC11qoHwECxwhoAQKmDGgBAscKaAEC4A5oAQLvEGgBAoE+" +
+	"aAECrGNoAQKQFmgBAqpQaAEC4A7WBwECtBKCUgEB+ysBAe0EAQH/BwEB4gkBAdYH" +
+	"AQGlAwEBkQYBBT9hmwLwCGoBBT9zmwLwCGoBBT8nmwLwCGoBBT8lmwLwCGoBA4c7" +
+	"rAQBAQP0E6wEAQEDzw6sBAEBA68PYQEBA68PcwEBA7IsxQgBAQOxAcUIAQEB9RYB" +
+	"As0P8AkBAYI6AQHlSwEBtVwBAf9BAQG9FwEB2UMBAfUpAQLND5oQAQGqUgEBgz4B" +
+	"AepRAQK1SI0KAQKZJ40KAQLcPo0KAQLND4UdAQLND5IqAQGnOwEDzirfApYPAQPP" +
+	"Kt8Clg8BAaEqAQGGPQEBrQUBAdAJAQH3FwEFrQUY0AnhCsUIAQQ21QfhCsUIAQQ2" +
+	"rWPhCsUIAQLjDslPAQTQCQLDKs8XAQOuKgLBYQEErwUCiyrvCQEFrQUCtT2TA7pI" +
+	"AQOtBQLsHgECohKtBQECohLQCQEBrwoBAqMQsmMBAYFSAQKNCctPAQKNCZY9AQH7" +
+	"EAECrwq1JgEC/jnTFQEC0xWOSgEBvkEBAZATAQOWEdE1rwUBAbk9AQGENQEBj0YB" +
+	"ApRLxRIBAmjOSAEB9j0BAqMpygwBAaNcAQGuUQEC8goqAQLREQABAZ8BAQK2PKUT" +
+	"AQHyCgEBthYBA+czArgEAQGFYwEC0Sm4BAECuAq4BAECNocGAQLEWrgEAQK0PbgE" +
+	"AQO4BAIbAQLyCLgEAQKHCbgEAQKEDbgEAQLTErgEAQO4BALbBwEC3RyHBgECuASc" +
+	"FwEGrgOLWQIUO90FAQPODXq9KgEC4w8LAQGQKgECkRMLAQKxLQsBA9Baqw8LAQHB" +
+	"FwEC0gMLAQOtUUrMDAECmDvtKQECshcLAQKQCvIPAQLFE/IPAQI/8wsBA4YB11EL" +
+	"AQLoGpgNAQL3B/M5AQNL4AkBAQKqEPQBAQSbDQKFDQEBBJsNAuAJAQEEmw0C8hcB" +
+	"AQSbDQLmDgEBA+gaAp5jAQfUCwK+AdoTGIUNAQEBoFYBAoIcoBABAa8qAQHfSQEC" +
+	"3A3gCwEC3BuhAwEB1jgBAu8L6gkBA7EB7wvqCQEDkggCpAUBA5II7QthAQGMEAEC" +
+	"nV2MEAEC9AFqAQTUCwK+AdoTAQTUCwK2AdoTAQJ31AsBAbwsAQHUEgECiAfUEgEB" +
+	"vlABAoME9xwBArMF9xwBArIW7VwBAcxFAQGxGwEEsRsCtjWoEAECwgO1CgECsQG1" +
+	"CgEChwm1CgEC8gi1CgEChwu1CgEBkSoBAqAJiykBAZVWAQH9SAECtgf0DgEBk1AB" +
+	"At0Q1xEBAokd3RUBA5pS4CW6RgEC4Sv2SAEC9E/NHgECwUHNHgEBj1ABAuo4CwEC" +
+	"xEG9CAEFvQgCtgEbJgEFwgOsDgKCGqAHAQXCA6wOApEEoAcBBbEBrA4CkQSgBwEF" +
+	"sQGsDgKCGqAHAQHmUQEC5A6PBAEBkBsBAqUM3g4BB6UM3g4CWxvZBCcBAoJi9EkB" +
+	"A6UM3g7ITwED4AOlDJNRAQPdFQLKCwEBpA0BA6QNApU7AQKTH6QNAQGnXQEByRwB" +
+	"AYNiAQL9F4pSAQLjUZ1bAQL2Uc0pAQO9X1v/BQEHlwZbGG2xAXj/BQELlwZbGG2x" +
+	"AXj/BQItMZsFAQeXBrYBGL4BsQF4/wUBB7gFtgEYvgGxAXj/BQEDhwG7FgsBA44B" +
+	"uxYLAQSPCgKlDUgBAY8KAQWPCgIxjwaZBAEFjwoCQY8G8xMBAYkWAQLODchRAQPK" +
+	"Bb0a2woBA5IFvRrbCgEEpw4C0EGNCwEEwgOnDgLMCAEBzAgBAacOAQKxAacOAQHY" +
+	"CQEE2AkCpQ1IAQGyFgEC9xkLAQLJHLsKAQWTBwJtGyYBBakEAm0bJgEJzAIC7AQq" +
+	"AiclGyYBBbVaAlsbJgEFswMCvgEbJgEF4AMxpQi7CAsBAq4ZhgIBBogMCygHBcsR" +
+	"AQaIDAsoBwPLEQEEiAwLKJNOAQSIDAsotRMBBYgMCygHyxEBAckHAQLODa9eAQGv" +
+	"HwEB8A0BA7wbGP8PAQHBPwEBulEBAcZJAQGmXQEC+jOUBwEGdfgD7wICTDsBBI0J" +
+	"CyjXKQEC8wy6OQEDNyu7CgEDPyu7CgED4QHgBXgBA+EB8gh4AQPhAYcLrgMBA+EB" +
+	"8giuAwEDA4cLrgMBAwPyCK4DAQOSBuAFrwEBA/QG4AWvAQEEkgYD4AWvAQEE9AYD" +
+	"4AWvAQEFayWKBz94AQVyJYoHP3gBAy2RA8YSAQWRA8YSAoEMOQECkQPGEgEGkQgn" +
+	"3QwCQYsBAQaRCCXdDAJBiwEBBAsow0jaKwEDPweRAwEDSweRAwECwiuRAwEC/wYL" +
+	"AQO6F0q1EgEB30IBAZZKAQGcXAEEgEkCiAGoKgEDgBBKtUABBNoEaDGtBwEE2gRo" +
+	"Nq0HAQTaBGhBrQcBBNoEaE6tBwEE2gRoVa0HAQTaBGh1rQcBBNoEaIABrQcBBNoE" +
+	"aHStBwEE2gRoiAGtBwEE2gRohgKtBwEE2gRonAetBwEE2gRozAatBwED2gRo008B" +
+	"A9oEaMFfAQPaBGjcXQED2gRotTsBA9oEaIo7AQPaBGiEWgED2gRou1gBA9oEaKg5" +
+	"AQPaBGjSTAED2gRoq10BA9oEaLU5AQPaBGi4XwECJY8KAQYljwoCMY8GmQQBBiWP" +
+	"CgJBjwbzEwEBvhYBBL4WAo8G8xMBArNU2AkBAbUKAQGKKwECuEjaBAEEN5ATGPMW" +
+	"AQLgA7dRAQHkGAEFrQXfAswq3gjmRQEDlVrdAd5aAQKNF/IdAQHZHQEC2R2EYwEB" +
+	"r0IBAq0FhTUBBCesBLYH9A4BBLYH9A4CkggBBCWsBLYH9A4BAz/IHrYHAQM3yB62" +
+	"BwEFtgfMArMDSvdJAQOwLKUM3g4BA5MHSr0IAQLkHb0IAQO9CAKeDAEC6BC9CAEE" +
+	"jQuSAeQdvQgBAoxGv1ABAjfUEgEDayfaDgEEayeaKY0LAQRrJ4sYjQsBA2snllAB" +
+	"A2sn7DMBAyewH6wEAQSgAc8OrAQBAQWRBKwEAqsE3B0BBvgBkQSsBAKrBNwdAQT4" +
+	"Aa8PYQEBBPgBrw9zAQED+AGRH6wEAQb4AawEAkyACKYFAQmRBKwEArIS6QRMGJ8B" +
+	"qwQBBj9zmwInrARqAQXhBT8nmwJqAQXhBT8lmwJqAQXhBTcnmwJqAQXhBTclmwJq" +
+	"AQU3J5sC8AhqAQU3JZsC8AhqAQXhBT9hmwJqAQXhBT9zmwJqAQXhBTdhmwJqAQXh" +
+	"BTdzmwJqAQU3YZsC8AhqAQU3c5sC8AhqAQI3uAQBAqQXoBABA5QMGP0JAQNB70vA" +
+	"MwEBqxwBAtFRhSsBBTeHCKQE3A3gCwEFP4cIpATcDeALAQTlBqQE3A3gCwECmh6d" +
+	"KwEComOUDAEDMbsK/QkBAza7Cv0JAQNBuwr9CQEBul4BAySCHKAQAQKHCOALAQHb" +
+	"DAECqxyTEQEC3RCTEQED3BuhA5MRAQPjCwLfCAEF4wsC3wgYvBIBA+MLArwSAQPj" +
+	"DQK8EgED4w0C/F0BBOMNAvMD/AEBAjfqCQEB6gkBArEB6gkBA/QBapU2AQP0Ae8L" +
+	"ZAEC7wv8KgECtAbmBAEDtAbmBJIIAQO0BuYEvwwBAeYEAQLmBJIIAQLmBL8MAQK0" +
+	"BuMLAQK0BpIIAQK0BukbAQHjCwEBkggBAekbAQH5YgEDkQrmBL8MAQORCowQvwwB" +
+	"AqQXqh8BAeBJAQHZSAEBqhIBBpcGJRgn3Qz/BQECpQ38AQEElRGGGMEdCwEEnReG" +
+	"GMEdCwEBvzMBAiTMCAECyVSQGwEEkggCLd8IAQKkE9QLAQLaCfMKAQGVCQEEyFrS" +
+	"BN8Clg8BA0HaByYBA0HaBzkBA0HaBycBA0HaByUBAyeQCq4IAQMlkAquCAEDNpAK" +
+	"hRABA0GQCoUQAQMnxROuCAEDJcUTrggBAyfjD64IAQMl4w+uCAEC8xGuCAEDngzz" +
+	"Ea4IAQKeDPMRAQTvBmQC7wYBA+8GnQH8AQEF7wZkAp0B/AEBBO8GGZ0B/AEBBu8G" +
+	"ZAIZnQH8AQEDetUGKgEF7wZkAhnVBgECs2OfDAECnErCOwECn163XgEDl1tK8UUB" +
+	"A5YPSvtBAQGiSgEC/gtoAQX+C2gCkwXtBAEFaAK8E0qmEQEFkwVoArEBpQMBCJMF" +
+	"aAKxAaUDGJMF7QQBCJMFaAKxAaUDGJ0Qqw8BCJMFaAKxAaUDGLIe7QQBBJMFaAL8" +
+	"PQEEkwVoAugYAQKsH2gBBZMFaAKTBe0EAQRohFbANd4NAQLXHGgBBZMFaALRGO0E" +
+	"AQSTBWgC8h0BAp9aaAECzQNoAQL7OWgBAq1gaAEEaAKdEKsPAQLxUGgBAsUzaAEC" +
+	"xDNoAQKPDmgBBGjdXRTyCgEFjw5oApMF7QQBBY8OaALCA+0EAQRoAqsTkQYBB2gC" +
+	"qxORBhisH/8HAQdoAqsTkQYYsh7tBAECrxdoAQK1Y2gBArgmaAECyRJoAQKsEGgB" +
+	"AvdQaAEFaAKwRkrTXgEErxfyB9ccaAEF5Q1oArEBpQMBAromaAEC4zpoAQKlH2gB" +
+	"ApRaaAEChl5oAQKyPWgBA7lGrBBoAQRoArEBpQMBApZAaAEHaAKxAaUDGJ0Qqw8B" +
+	"BGiTVt8C4joBApInaAECnTtoAQKRE2gBArIXaAEDaIANpQMBBGgC8UjcEQEG/gvx" +
+	"BmgCkwXtBAEG8QZoArwTSqYRAQaTBfEGaAKxAaUDAQaTBfEGaALRGO0EAQXxBmgC" +
+	"vmPiDAEGjw7xBmgCwgPtBAEDyRLxBmgBA6wQ8QZoAQOlH/EGaAEDmg/lDWgBA5oP" +
+	"kwVoAQLCYGgBBGgC8RztBAEFaALgA509qRgBBGgCzw6pGAEDrwWeKrk1AQKkVqYM" +
+	"AQKlPqYMAQLHWqYMAQWKPq8FyhwxrAQBBq8Fyhy1FsUI3wLAKwECrwXlDQEErwUC" +
+	"yRJoAQSvBQKhO8UIAQS8AYYDmwKrBQEE8gGGA5sCqwUBBLwB3QKbAqsFAQTyAd0C" +
+	"mwKrBQEFoAG8AYYDmwKrBQEFoAHyAYYDmwKrBQEFoAG8Ad0CmwKrBQEFoAHyAd0C" +
+	"mwKrBQEFvAGGA5sC5wirBQEF8gGGA5sC5wirBQEFvAHdApsC5wirBQEF8gHdApsC" +
+	"5wirBQEGS7wBhgObAucIqwUBBkvyAYYDmwLnCKsFAQZLvAHdApsC5wirBQEGS/IB" +
+	"3QKbAucIqwUBBLwBhgObAvMIAQTyAYYDmwLzCAEEvAHdApsC8wgBBPIB3QKbAvMI" +
+	"AQVLvAGGA5sC8wgBBUvyAYYDmwLzCAEFS7wB3QKbAvMIAQVL8gHdApsC8wgBBOUY" +
+	"7g4r1wIBB+UY7g4r1wLfAjcrAQTQHe4OK9cCAQfQHe4OK9cC3wI3KwECbdgMAQK+" +
+	"AdgMAQJb2AwBArYB2AwBBJ0BIPcG1wIBBUudASD3BtcCAQQgsQH3BtcCAQVLILEB" +
+	"9wbXAgEDS+0I1wIBA6YL7QjXAgEIL0szoAHnArwEKtcCAQcvSzPnArwEKtcCAQgv" +
+	"S4cBM+cCvAQq1wIBA0uJDNcCAQMviQzXAgEESy+JDNcCAQOyBEuUBQEEsgRLvwWU" +
+	"BQECqg3PCwEDvwWqDc8LAQHYDAEBrT4BAucd/RkBAvEO9AgBAtoY2BMBBdoY2BMC" +
+	"0wbWBwEB2BMBAY1JAQJ6xlMBAZZbAQHLHgECyx70CAEBiBABAroMiBABAdZeAQKI" +
+	"EKkCAQHeSQEBqCYBAskH5BcBAuMO9AgBA7oM4w70CAEBnB4BAroMnB4BAfUVAQK6" +
+	"DPUVAQLkU8BhAQLCNdsTAQL/JrRGAQG7XgEBjEoBAp0J8Q4BArET8Q4BAp0J/SoB" +
+	"Au4lvl4BAYITAQHTVAEByVoBA6wB8gx6AQNT8gx6AQKjEAEBBOMOnCvdHHoBBLMF" +
+	"oAfMAsgSAQG4FwED4APUEAEBAssdCwED4APLHQsBBtUS+hnfAu1BhQoLAQTHBdIC" +
+	"+hkLAQPoUekGCwEDr03pBgsBAe4pAQLgA+8pAQGZFgECnQmZFgEB+RsBAuAD5FAB" +
+	"AvYrnAQBAoFJCwECrmMLAQGCVAECrwoLAQGeXgEC6Qa/LAEBvFkBAfcPAQGIFgEC" +
+	"0lC+CgEBiDQBApAprywBAieEGgEErwECxwbACgECh1IBAQMtlhGYEwECoioLAQKH" +
+	"PQsBA+QzGNwZAQKRE9klAQKmHZEpAQLbKdgJAQHTKQEDhQpKtmMBAsJPAQECph3V" +
+	"XgEBwlsBAZRQAQLfPqpdAQGXQAEBszkGAsNRlloBAcsPAQKfAccqAQPwDRivHwEB" +
+	"gRMBAs4OyAoBAZtKAQLxDskeAQLuGq4WAQOSBuwR7AgBApIG7AgBA5IGA+wIAQID" +
+	"7AgBArRN7AgBAuwIohcBAuwI+iYEAYkdAQO6DMkH5BcBAvE1/RkBAs5QghMBAYwd" +
+	"AQLuGowdAQGPKwEBkloBAugQgVYBAYlaAQKsJ5hUAQKcUdsTAQLwHMMdBASjAQso" +
+	"9lIBBKMBCyipCgEEowELKMkHAQSjAQsomAYBBKMBCyjpBgEEowELKN4mAQWjAQso" +
+	"kg3nUwEFowELKJIN6FMBBaMBCyiSDZdiAQWjAQsokg2YYgEEowELKJUfAQSjAQso" +
+	"jmIBBKMBCyiPYgEEowELKK4TAQWjAQsojlGuEwEFowELKDeuEwEFowELKLMO5gwB" +
+	"BaMBCyizDtRbAQWjAQsosw7VWwEEowELKKcsAQSjAQsomAgBBKMBCyjaTAEEowEL" +
+	"KJliAQSjAQsommIBBaMBCyjpEpgIAQWjAQso6RLtVQEEowELKOoNAQSjAQso7lkB" +
+	"BaMBCyiADoMKAQWjAQsogA7nTwEGowELKKsQSoAOAQajAQso9Q5KgA4BBaMBCyi/" +
+	"CoMKAQWjAQso40GDCgEGowELKOYMSr8KAQajAQsoqxBKvwoBBqMBCyirEErYMwEF" +
+	"owELKL8K3hUBB6MBCyiYCEq/Ct4VAQijAQso5gxKmAhKvwoBBKMBCyjEYQEFowEL" +
+	"KP9dgwoBBaMBCyjJRYMKAQWjAQsorAqDCgEGowELKOYMSqwKAQajAQsomAhKrAoB" +
+	"CKMBCyjmDEqYCEqsCgEGowELKJUfSqwKAQajAQso9Q5KrAoBBqMBCyj1DkrOJgEE" +
+	"owELKPUOAQSjAQso6lMBBKMBCyjrUwEEowELKOxTAQSjAQsonCYBBKMBCyidJgEE" +
+	"owELKL1IAQSjAQso5lUBBKMBCyj8JgEEowELKN1TAQSjAQso3lMBBKMBCyiqJwEF" +
+	"owELKIEqgwoBBKMBCyiCXQEEowELKINdAQWjAQso81KBRgEEowELKJQqAQSjAQso" +
+	"lSoBBKMBCyiWKgEEowELKKQmAQSjAQsozg4BBKMBCyieDwEEowELKIFeAQSjAQso" +
+	"ghEBBKMBCyiiHwEEowELKO5RAQSjAQsohisBBKMBCyiyXwEFowELKJUrlUoBBqMB" +
+	"CyiNVkqsQgEEowELKMQWAQSjAQso8wwBBaMBCyiTW/MMAQSjAQsookYBBKMBCyjM" +
+	"HQEEowELKMhgAQWjAQsoxwjCFwEEowELKO8VAQWjAQso5Q7vFQEEowELKIMQAQWj" +
+	"AQso71GDEAEEowELKKUmAQajAQsoqBP0HagTAQajAQsoqBP0HatbAQSjAQso1lsB" +
+	"BKMBCyj4UQEEowELKI82AQSjAQsojTYBBKMBCyiONgEEowELKKdSAQSjAQsor1IB" +
+	"BKMBCyjyMwEEowELKPMzAQSjAQso9DMBBKMBCyj1MwEEowELKPYzAQSjAQso0BUB" +
+	"BqMBCyj3D0rKSAEGowELKPcPSrJhAQSjAQsohFQBBKMBCyjwBAEEowELKMQOAQSj" +
+	"AQsokTUBBKMBCyjxEQEFowELKG68FwEFowELKG7hGw0FN8oFgg4lrwEBBTeSBoIO" +
+	"Ja8BAQU3kgWCDiWvAQEFN/QGgg4lrwEBBDeaDwN4AQS3ARk/eAEDGT94AQNLP3gB" +
+	"BLIESz94AQTUCks/eAEGP3i1CDcDeAECiAe6EwEDN+8MKwEEN5oPAysBA3o/KwED" +
+	"twE/KwEDGT8rAQNLPysBBLIESz8rAQTUCks/KwEHPyu1CDeyBAMrAQY/K7UIN7cB" +
+	"KwECK7oTAQM37wyuAwEEN7IEA64DAQQ3twEDrgMBBz+uA7UIN7IEA64DAQY/rgO1" +
+	"CDe3Aa4DAQKuA7oTAQM37wz5BgEEN7IEA/kGAQQ3twED+QYBBj/5BrUINwP5BgED" +
+	"rg8hkQMBA3ohkQMBA7cBIZEDAQMZIZEDAQSyBBkhkQMBBLIESyGRAwEE1ApLIZED" +
+	"AQKuD9wHAQJ63AcBArcB3AcBAhncBwECS9wHAQOyBEvcBwED1ApL3AcBBHpV+QW1" +
+	"AwEEtwFV+QW1AwEEGVX5BbUDAQRLVfkFtQMBBbIES1X5BbUDAQXUCktV+QW1AwEE" +
+	"enX5BbUDAQS3AXX5BbUDAQQZdfkFtQMBBEt1+QW1AwEFsgRLdfkFtQMBBdQKS3X5" +
+	"BbUDAQR6dPkFtQMBBLcBdPkFtQMBBBl0+QW1AwEES3T5BbUDAQWyBEt0+QW1AwEF" +
+	"ekH4AzfvAgEFtwFB+AM37wIBBEH4AzfvAgEFtwFB+APUCO8CAQV6TvgDN+8CAQW3" +
+	"AU74AzfvAgEETvgDN+8CAQW3AU74A9QI7wIBBr8Fek74A9QI7wIBBXpV+AM37wIB" +
+	"BUtV+AM37wIBBbcBdfgDN+8CAQVLdfgDN+8CAQR1+APUCO8CAQW3AXT4AzfvAgEF" +
+	"S3T4AzfvAgEGsgRLdPgDN+8CAQVLdPgD1AjvAgEFeswG+AM37wIBBUvMBvgDN+8C" +
+	"AQVLzAb4A9QI7wIBAi2vAQEDzgEtrwEBAi0rAQPOAS0rCAPhAYcLeAED4QGEDXgB" +
+	"A+EBhwl4AQPhAdMSeAED4QGEEHgBA+EB4AUrAQPhAfIIKwED4QGHCysBA+EBhA0r" +
+	"AQPhAYcJKwED4QHTEisBA+EBhBArBQNL/QMBEAZtGwIDrwGfAgEGvgEbAgOvAZ8C" +
+	"AQZbGwIDrwGfAgEGtgEbAgOvAZ8CAQZtGwK3Aa8BnwIBBr4BGwK3Aa8BnwIBBlsb" +
+	"ArcBrwGfAgEGtgEbArcBrwGfAgEGbRsC4QGvAZ8CAQa+ARsC4QGvAZ8CAQZbGwLh" +
+	"Aa8BnwIBBrYBGwLhAa8BnwIFBm0bAgONBZ8CAQa+ARsCA40FnwIBBlsbAgONBZ8C" +
+	"AQa2ARsCA40FnwIBBW0bAo0FnwIBBb4BGwKNBZ8CAQVbGwKNBZ8CAQW2ARsCjQWf" +
+	"AgEGS20bAo0FnwIBBku+ARsCjQWfAgEGS1sbAo0FnwIBBku2ARsCjQWfAgEHS20b" +
+	"AuEBjQWfAgEHS74BGwLhAY0FnwIBB0tbGwLhAY0FnwIBB0u2ARsC4QGNBZ8CAQZt" +
+	"ngIbAoEL9gQBBr4BngIbAoEL9gQBBlueAhsCgQv2BAEGtgGeAhsCgQv2BAEGbZ4C" +
+	"GwK3AfYEAQa+AZ4CGwK3AfYEAQZbngIbArcB9gQBBrYBngIbArcB9gQBBm2eAhsC" +
+	"GfYEAQa+AZ4CGwIZ9gQBBlueAhsCGfYEAQa2AZ4CGwIZ9gQBBm2eAhsCS/YEAQa+" +
+	"AZ4CGwJL9gQBBlueAhsCS/YEAQa2AZ4CGwJL9gQBB22eAhsCsgRL9gQBB74BngIb" +
+	"ArIES/YEAQdbngIbArIES/YEAQe2AZ4CGwKyBEv2BAEDbdoNGwEDvgHaDRsBA1va" +
+	"DRsBA7YB2g0bAQNtZRsBA74BZRsBA1tlGwEDtgFlGwEDbfYIGwEDvgH2CBsBA1v2" +
+	"CBsBA7YB9ggbAQRtS/YIGwEEvgFL9ggbAQRbS/YIGwEEtgFL9ggbAQNtSxsBA74B" +
+	"SxsBA1tLGwEDtgFLGwkDbS8bAQO+AS8bAQNbLxsBA7YBLxsBBLwBhgMvGwEEvAHd" +
+	"Ai8bAQTyAd0CLxsBBPIBhgMvGwEEJyUvGwEEYXMvGwcF1wNteroBGwEF1wO+AXq6" +
+	"ARsBBdcDW3q6ARsBBdcDtgF6ugEbAQbXA7wBhgN6ugEbAQbXA7wB3QJ6ugEbAQbX" +
+	"A/IB3QJ6ugEbAQbXA/IBhgN6ugEbAQTXA226ARsBBNcDvgG6ARsBBNcDW7oBGwEE" +
+	"1wO2AboBGwEF1wO8AYYDugEbAQXXA7wB3QK6ARsBBdcD8gHdAroBGwEF1wPyAYYD" +
+	"ugEbAQXXA223AboBGwEF1wO+AbcBugEbAQXXA1u3AboBGwEF1wO2AbcBugEbAQbX" +
+	"A7wBhgO3AboBGwEG1wO8Ad0CtwG6ARsBBtcD8gHdArcBugEbAQbXA/IBhgO3AboB" +
+	"GwEF1wNtS7oBGwEF1wO+AUu6ARsBBdcDW0u6ARsBBdcDtgFLugEbAQbXA7wBhgNL" +
+	"ugEbAQbXA7wB3QJLugEbAQbXA/IB3QJLugEbAQbXA/IBhgNLugEbAQbXA22yBEu6" +
+	"ARsBBtcDvgGyBEu6ARsBBtcDW7IES7oBGwEG1wO2AbIES7oBGwEH1wO8AYYDsgRL" +
+	"ugEbAQfXA7wB3QKyBEu6ARsBB9cD8gHdArIES7oBGwEH1wPyAYYDsgRLugEbCQNt" +
+	"rwGfAgEDvgGvAZ8CAQNbrwGfAgEDtgGvAZ8CAQZtPxukCq8BnwIBBr4BPxukCq8B" +
+	"nwIBBls/G6QKrwGfAgEGtgE/G6QKrwGfAgEFbRsCkQjpAgEFvgEbApEI6QIBBVsb" +
+	"ApEI6QIBBbYBGwKRCOkCAQVLG/YE5wcxAQZLG/YE5wc24QgBBksb9gTnBzFuAQZL" +
+	"G/YE5wcxjQcBBG2cKj8bAQVb9AP9Ej8bAQVtswP9Ej8bAQVbswP9Ej8bAQRtyRE/" +
+	"GwEEW+YSPxsBBG3mEj8bAQRbyRE/GwEFbfAPigc/GwEFW/APigc/GwEFbfAQigc/" +
+	"GwEFW/AQigc/GwEFPxv2BOcHMQEGPxv2BOcHNuEIAwYbmwK+AeAIvAGGAwEHG5sC" +
+	"W+AI3QvyAYYDTwYtkQPsEAJOiwEBBi2RA+wQAjaLAQEDLZED7BABBidueAJOiwEB" +
+	"BidueAJBiwEBBidueAI2iwEBBSdueAI7AQMnbngBA8UN7QtSAQTFDe0LkQhSAQXF" +
+	"De0LUgI7AQbFDe0LkQhSAjsBArFRqwQBAj+4BAEChBC4BAECslGsBAEC+WVoAQKI" +
+	"SmgBA2gCrh4BAuxLaAECzF1oAQNoAqE+AQLGVGgBAuwYaAEEAUrGA+gYAQONEJAE" +
+	"rAQBBJEEkwdKrAQBAqEO5BABAv0O5BABAYY+AQesBAJqGEyrBPIGAQU60RHrZKwE" +
+	"AQEEaALoM/AJAQLCLGgBAqBLaAEF8RzMAsYD6gW/RQECvDhoAQLSRmgBAmiKCwEC" +
+	"o1poAQVoAjGBOpEEAQX+C2gC7wLtBAEJ/gtoAjHhARgxA/8HAQZoAoAIqRDCA5UJ" +
+	"AQa0WGgCtROpEKUDAQmTBWgCkwXtBBisBKkQpQMBBWgCsQGlA6ZiAQWpWWgC+TnS" +
+	"BAECzxLQCQEBqyoBA59QYbMPAQGpWAEBgFIBA60F3wK1XwEC7RqUEAEBxFkBA68F" +
+	"qDaeKwEBtEIBAeo6AQL4SfNQAQG8YwEC6QbbUQEBhD4BApc23BEBApxj8wsBA8gK" +
+	"Ar84AQLlFo09AQKkX9sKAQGbEwEChgmDCwEBqFQBA8AIhQqkDAED2giFCqQMAQON" +
+	"B4UKpAwBAqAqjz0BA8dI7RW4YAECgzSlEwEE/kS6CRiUBwEBr1oBAugQoQMBAfAz" +
+	"AQGyJwEB/DMBAYkpAQGmHAEBmysBApIpuRYBAocJ6VUBBIdZrRJK3g0BAsFbgBgB" +
+	"AdQXAQPbCkrZSQEB4lABAeZDAQGpUAEBxjgBArA77RYBAvVcZAEBiCwBA4AQApsT" +
+	"AQPcCwKsWwEBqy0BAbIqAQGNHAEB/lEBA7AQSrEOAQH1VQECjiveDQECy0WHCQEB" +
+	"skgBAsYEixABAY8pAQiTBWgCkwXtBBhBhwYBArhkaAEEkwVoApxdAQdoArMSjwQY" +
+	"sxLwCQEHaAK1YO0EGOYHpQMBAvZPaAECujtoAQHTTAECijZoAQRo4QqTB7wTAQRo" +
+	"AsRR7QQBAYgdAQL3ROcWAQGXPQEC3j60FgEC6QmNCgEB6jMBAo5GaAEBkVYBAatf" +
+	"AQK3YGgBAc4XAQHBOAEB9g8BAY1ZAQH3TwECjBhoAQHRKgEBvRABAaI9AQGWRgEB" +
+	"jVQBAakdAQHgWgEChT1oAQLMZWgBAag+AQGDVgEBtFwBAfEWAQHyQgEBl0YBAeFQ" +
+	"AQHgPgEBx1ABAbxTAQGeRgEBl0oBAcVJAQGEFgEB9FsBAa5IAQGnNgEBnFoBAfJP" +
+	"AQHlTwEBjVoBAZQ7AQH6TwEB+Q8BAYIqAQH1EgECuz3HCgEChFKMKwEEvAiyDeAF" +
+	"7wkBBLwIsg38A+8JAQO8CLINmCkBBLwIsg0/7wkBAaANAQHLBQEB3w0BAbYPAQHm" +
+	"WwEB6FsBAu4S+GEBBOIJAqY+gyYBAppK9xMBArlI9xMBAswaogUBAswaywUBAuIr" +
+	"hmMBAf8zAQKYCIVZAQKWFmQBAZ88AQHWTwEB1zoBAe1iAQHQKgEC0ki+FwECjAmu" +
+	"EAECrgiZXQEB1F4BApBbrwUBAvxDrwUBArA1rwUBA2gCi0oBAcQVAQGTEAECohLE" +
+	"FQEC0CmvBQEDrwUCpD4BBK8F3wKbW8xUAQKvBbosAQSvBd8Cqg6PCwEBn0gBAdU6" +
+	"AQGwYQEBhEkBAbY5AQG7PAEB/2UBAaYqAQKHC7gEAQL2KacNAQGMVgEBkD0BAecW" +
+	"AQGuWgED4AX/PL0IAQGBOwEDj0KyUqwJAQKxXZ9fAQKAUZgFAQOkNjOuPgEBuy0B" +
+	"AdIlAQLJB/w5AQGsXgEBgxABAaFIAQGEGgECuEahDQED1FpKxhMBA5QHSq5kAQLu" +
+	"EpUcAQKgXZAWAQG0KgEBqikBA8hUStUOAQN3SswdAQHTWgEB31MBAqVLriYBA80D" +
+	"2AHzBQEDzQPYAZ8GAQPNA9gBsQcBA80D2AGVBwEDzQPYAdMEAQPNA9gBrwcBBj/Y" +
+	"AdME2wLtEI4DAQY32AHTBNsC7RCOAwEGzQPYAdME2wLtEI4DAQY/2AHzBdsC7QOO" +
+	"AwEGP9gBnwbbAu0DjgMBBj/YAbEH2wLtA44DAQY/2AGVB9sC7QOOAwEGP9gB0wTb" +
+	"Au0DjgMBBj/YAa8H2wLtA44DAQY32AHzBdsC7QOOAwEGN9gBnwbbAu0DjgMBBjfY" +
+	"AbEH2wLtA44DAQY32AGVB9sC7QOOAwEGN9gB0wTbAu0DjgMBBjfYAa8H2wLtA44D" +
+	"AQbNA9gB8wXbAu0DjgMBBs0D2AGfBtsC7QOOAwEGzQPYAbEH2wLtA44DAQbNA9gB" +
+	"lQfbAu0DjgMBBs0D2AHTBNsC7QOOAwEGzQPYAa8H2wLtA44DAQg/2AHTBNsCMYYB" +
+	"whOOAwEIN9gB0wTbAjGGAcITjgMBCM0D2AHTBNsCMYYBwhOOAwEEP9gBoAHzBQEE" +
+	"P9gBoAGfBgEEP9gBoAGxBwEEP9gBoAGVBwEEP9gBoAHTBAEEP9gBoAGvBwEEN9gB" +
+	"oAHzBQEEN9gBoAGfBgEEN9gBoAGxBwEEN9gBoAGVBwEEN9gBoAHTBAEEN9gBoAGv" +
+	"BwEEzQPYAaAB8wUBBM0D2AGgAZ8GAQTNA9gBoAGxBwEEzQPYAaABlQcBBM0D2AGg" +
+	"AdMEAQTNA9gBoAGvBwEIP9gB0wTbAjaGAb8PjgMBCDfYAdME2wI2hgG/D44DAQjN" +
+	"A9gB0wTbAjaGAb8PjgMBCD/YAfMF2wI2hgGTBI4DAQg/2AGfBtsCNoYBkwSOAwEI" +
+	"P9gBsQfbAjaGAZMEjgMBCD/YAZUH2wI2hgGTBI4DAQg/2AHTBNsCNoYBkwSOAwEI" +
+	"P9gBrwfbAjaGAZMEjgMBCDfYAfMF2wI2hgGTBI4DAQg32AGfBtsCNoYBkwSOAwEI" +
+	"N9gBsQfbAjaGAZMEjgMBCDfYAZUH2wI2hgGTBI4DAQg32AHTBNsCNoYBkwSOAwEI" +
+	"N9gBrwfbAjaGAZMEjgMBCM0D2AHzBdsCNoYBkwSOAwEIzQPYAZ8G2wI2hgGTBI4D" +
+	"AQjNA9gBsQfbAjaGAZMEjgMBCM0D2AGVB9sCNoYBkwSOAwEIzQPYAdME2wI2hgGT" +
+	"BI4DAQjNA9gBrwfbAjaGAZMEjgMBCD/YAdME2wJBhgHIB44DAQg32AHTBNsCQYYB" +
+	"yAeOAwEIzQPYAdME2wJBhgHIB44DAQM/2AHQCgEDN9gB0AoBA80D2AHQCgEGP9gB" +
+	"0ArbAu0DjgMBBjfYAdAK2wLtA44DAQbNA9gB0ArbAu0DjgMBAz/YAb8ZAQM/2AHA" +
+	"GQEDP9gBvhkBAzfYAb8ZAQM32AHAGQEDN9gBvhkNA+sG4AWkGAED6wbgBcAaAQPr" +
+	"BuAF0RABA+sG4AXHCAED6wbgBdMLAQPrBuAFyRYBA+sG4AXPHQED6wY3pBgBA+sG" +
+	"N8AaAQPrBjfREAED6wY3xwgBA+sGN9MLAQPrBjfJFgED6wY3zx0DApkpqlkBAtJP" +
+	"+FsBAbAqAQGUDwECsB6FHQQDvThKqhYBAuIlnSkBAZ5bAQHLYwEB+DMEAeZkAQHj" +
+	"QwEBwVABAbMWAQKgSPJiAQGwUQEC7ku1FwoCslTAUQEB2RYBAdZTAQHtDwECmjbc" +
+	"GQEBnykBAuwR9Q0BAdolAQKqAcgKAQGvLQECyhbxEgEBlFYBAf9EAQFSAQHhGgEB" +
+	"qh8BAcxRAQK+WOZLAQH+QwEBuSoBAv0JwV4BAa5eAQHQGAEBvlEBAekSAQLhGpQH" +
+	"AQL4GPQBAQKHAYkWAQGAPgQB5xABAbRjAQGTFgEBqS0BAulRwVEBAfoTAQHZDQEB" +
+	"qg4BAdszAQK0Bo8bAQOPGwKlOQYCsSa4BAEBw0YBAv0b7BgBAs8SrQUBAs8SrwUB" +
+	"A68FAvUWCwGOKgEC2An+GwEB0xsBAYAYAQH4XAEBpDsBAZtdAQLsUfgZAQHPKQEB" +
+	"kBkHAvtIaAEC7lVoAQhoArEB7QQYrASSAaUDAQRoAuZQ/wcBBGgCuwGlAwEDywO/" +
+	"AmgBAp8WpQ4BAYUQCQesBAJqgAgYkAHyBgECW6wEAQJtrAQBA4oLc6wEAQOKC2Gs" +
+	"BAEFapsC2QTGA4liAQK4BMUICgJxwVgBAnHfWAECccJYAQJx7lgBAnHRWAECceBY" +
+	"AQJxw1gBAnH2WAECcdhYAQJx6FgBAnHKWAECce9YAQJx0lgBAnHhWAECccRYAQJx" +
+	"+lgBAnHcWAECcetYAQJxzlgBAnHzWAECceVYAQJxx1gBAnH3WAECcdlYAQJx6VgB" +
+	"AnHLWAECcfBYAQJx01gBAnHiWAECccVYAQJx/FgBAnHeWAECce1YAQJx0FgBAnH1" +
+	"WAECcddYAQJx51gBAnHJWAECcflYAQJx21gBAnHNWAECcfJYAQJx1VgBAnHkWAEC" +
+	"ccZYAQJx+1gBAnHdWAECcexYAQJxz1gBAnH0WAECcdZYAQJx5lgBAnHIWAECcfhY" +
+	"AQJx2lgBAnHqWAECccxYAQJx8VgBAnHUWAECceNYAQprJ3G7AWtMJ0Jr0AIBCmsn" +
+	"cbsBa0wnQmslAQprJ3G7AXJMJ0Jr0AIBCmsncbsBckwnQmslAQlrJ3G7AXInQmvQ" +
+	"AgEKayVxuwFyTCdCctACAQprJXG7AXJMJ0JyJQEKayVxuwFrTCdCctACAQprJXG7" +
+	"AWtMJ0JyJQEJayVxuwFrJ0Jy0AIBC2slcbsBa0wnQnJMJQEKayVxuwFr0AJCa0wl" +
+	"AQprJXG7AWsnQmtMJQEKayVxuwFr0AJCckwlAQprJXG7AWsnQnJMJQEJayVxuwFr" +
+	"0AJCciUBCmsncbsBctACQnJMJQEKaydxuwFyJ0JyTCUBCmsncbsBctACQmtMJQEK" +
+	"aydxuwFyJ0JrTCUBCWsncbsBctACQmslAQtrJ3G7AXJMJ0JrTCUBCnIlcbsBa0wn" +
+	"QmvQAgEKciVxuwFrTCdCayUBCnIlcbsBckwnQmvQAgEKciVxuwFyTCdCayUBCXIl" +
+	"cbsBcidCa9ACAQpyJ3G7AXJMJ0Jy0AIBCnIncbsBckwnQnIlAQpyJ3G7AWtMJ0Jy" +
+	"0AIBCnIncbsBa0wnQnIlAQlyJ3G7AWsnQnLQAgELcidxuwFrTCdCckwlAQpyJ3G7" +
+	"AWvQAkJrTCUBCnIncbsBaydCa0wlAQpyJ3G7AWvQAkJyTCUBCnIncbsBaydCckwl" +
+	"AQlyJ3G7AWvQAkJyJQEKciVxuwFy0AJCckwlAQpyJXG7AXInQnJMJQEKciVxuwFy" +
+	"0AJCa0wlAQpyJXG7AXInQmtMJQEJciVxuwFy0AJCayUBC3IlcbsBckwnQmtMJQEJ" +
+	"chglGGuzBUHCBnEBCScYaxglswVBwgZxAQlyGCcYa7MFQcIGcQEJJxhyGCWzBUHC" +
+	"BnEBBSezBTHuA3EBBXKzBTHuA3EBBSWzBTHuA3EBBWuzBTHuA3EBBFMx6QOkFgEE" +
+	"UzHpA6UWAQRTMekDphYBBFMx6QOnFgEEUzHpA6gWAQRTMekDqRYBBKwBMekDpBYB" +
+	"BKwBMekDpRYBBKwBMekDphYBBKwBMekDpxYBBKwBMekDqBYBBKwBMekDqRYBBicY" +
+	"azHpA3EBBicYcjHpA3EBBiUYcjHpA3EBBiUYazHpA3EBBnIYazHpA3EBBKwBMekD" +
+	"iioBBHIx7gNxAQRyQbIGcQEEclWyBnEBBHJBwgZxAQRygAGyBnEBBCUx7gNxAQQl" +
+	"QbIGcQEEJVWyBnEBBCVBwgZxAQQlgAGyBnEBBCdutwHIBgEEJW63AcgGAQRybrcB" +
+	"yAYBBGtutwHIBgEDzAe3AcgGAQlybnEYa27MB7cByAYBCXJuzAe3AcgGGGtucQIJ" +
+	"J27MB7cByAYYJW5xAQOqDc8L6QUBBMwHqg3PC+kFAQNLrAHpBQEGcidCayXpBQEG" +
+	"ciVCayfpBQEGchhrswVucQEGJxglswVucQEFciezBbcByAYBBXIlswW3AcgGAQVr" +
+	"JbMFtwHIBgEFayezBbcByAYBCWR+ersBctACQkwnAQlkfnq7AXLQAkJMJQEJZH56" +
+	"uwFMJ0Jr0AIBCWR+ersBTCVCa9ACAQxkfnq7AXLQAkJMJ0Jr0AIBDGR+ersBctAC" +
+	"QkwlQmvQAgEMZH56uwFMJ0Jr0AJCTCUBDGR+ersBTCdCctACQkwlAQ9kfnq7AXLQ" +
+	"AkJMJxhMJUJr0AIBD2R+ersBctACQkwlGEwnQmvQAgEPZH56uwFy0AJCTCVCa9AC" +
+	"QkwnAQ9kfnq7AXLQAkJMJ0Jr0AJCTCUBD2R+ersBTCdCctACQkwlQmvQAgEPZH56" +
+	"uwFMJUJy0AJCTCdCa9ACAQVkfnq7Aa4DAQdkfnqsAQJTSAEC+ybJDAEDzAfVBioB" +
+	"BCdu7RKtBQEEJW7tEq0FAQbMB7YBGwLBBW0BCW0bGHIYazHpA3EBCVsbGHIYazHp" +
+	"A3EBB7YBGxglMekDcQEHvgEbGCUx6QNxAQMnbuoJAQMlbuoJAQOgYiGRAwEEJbEB" +
+	"ZTsBA84BuwGRAwEHzgG7AUwlQmvQAgEDzgG7Aa4DAQY/S9wHAscGwAoBBieNBz8l" +
+	"mwJqAQZMjQc/JZsCagEGJY0HPyWbAmoBBM4BZcsEKgECugmDCQEFugmDCQLzJpEE" +
+	"AQS6CYMJ6BknAQS6CYMJ6BklAQS6CYMJAr0XAQM/kgbdFiYDmQgO4wEBA5kIDjEB" +
+	"A5kIDjYBA5kIDkEBA5kIDk4BA5kIDlUBA5kIDnUBA5kIDoABAQOZCA50AQOZCA6I" +
+	"AYiIMAK1Rb0BHwK9AbEFAQO9AewEKgEDvQG8BCoBA70BHQEBA70B4AkBAQO9AcYM" +
+	"AQECvQHtCAECvQGqCAEDvQEntwMBA70BJbcDAQK9AbUDAQO9AWABAQK9AecCAQK9" +
+	"AfoNAQO9AZIDqQIBAr0BlAUBA70BDuMBAQO9AQ4xAQO9AQ42AQO9AQ5BAQO9AQ5O" +
+	"AQO9AQ5VAQO9AQ51AQO9AQ6AAQEDvQEOdAEDvQEOiAEBAr0BmwQBAr0BsgcBA70B" +
+	"7QIBAQO9Af0DAQEDvQHsAgEBA70BywQqAQO9AdkL2QQBBb0BBwUAFAEFvQEHBQA+" +
+	"AQW9AQcFAPMBAQW9AQcFANIBAQW9AQcFADgBBb0BBwUAswIBBb0BBwUAwQEBBb0B" +
+	"BwUA1AEBBb0BBwUAOgEFvQEHBQCTAgEFvQEHBQDHAQEFvQEHBQBvAQW9AQcFALAB" +
+	"AQW9AQcFAJkBAQW9AQcFADUBBb0BBwUA+gEBBb0BBwUA7gIBBb0BBwUAYgEFvQEH" +
+	"BQBjAQW9AQcFAMUBAQW9AQcFADABBb0BBwUAywEBBb0BBwUAjgIBBb0BBwUA/AEB" +
+	"Bb0BBwUA4gEBBb0BBwUA3gEBBL0BJyuBAQEDvQG/BZQFAQS9ASUrgQEBA70BiwLE" +
+	"AgEDvQGHAb8CAQO9Ab4CxAIBBb0BBwMAFAEFvQEHAwA+AQW9AQcDAPMBAQW9AQcD" +
+	"ANIBAQW9AQcDADgBBb0BBwMAswIBBb0BBwMAwQEBBb0BBwMA1AEBBb0BBwMAOgEF" +
+	"vQEHAwCTAgEFvQEHAwDHAQEFvQEHAwBvAQW9AQcDALABAQW9AQcDAJkBAQW9AQcD" +
+	"ADUBBb0BBwMA+gEBBb0BBwMA7gIBBb0BBwMAYgEFvQEHAwBjAQW9AQcDAMUBAQW9" +
+	"AQcDADABBb0BBwMAywEBBb0BBwMAjgIBBb0BBwMA/AEBBb0BBwMA4gEBBb0BBwMA" +
+	"3gEBBL0BJ/wDgQEBA70BU78CAQS9ASX8A4EBAQK9AbkBAQLIFr0BgQECQ/ZWAQJD" +
+	"gVcBAkOMVwECQ5hXAQJDo1cBAkOuVwECQ7lXAQJDxFcBAkPPVwECQ9dXAQJD2FcB" +
+	"AkPZVwECQ9pXAQJD3FcBAkPdVwECQ95XAQJD31cBAkPgVwECQ+FXAQJD4lcBAkPj" +
+	"VwECQ+RXAQJD5VcBAkPnVwECQ+hXAQJD6VcBAkPqVwECQ+tXAQJD7FcBAkPtVwEC" +
+	"Q+5XAQJD71cBAkPwVwECQ/JXAQJD81cBAkP0VwECQ/VXAQJD9lcBAkP3VwECQ/hX" +
+	"AQJD+VcBAkP6VwECQ/tXAQJD/VcBAkP+VwECQ/9XAQJDgFgBAkOBWAECQ4JYAQJD" +
+	"g1gBAkOEWAECQ4VYAQJDhlgBAkOIWAECQ4lYAQJDilgBAkOLWAECQ4xYAQJDjVgB" +
+	"AkOOWAECQ49YAQJDkFgBAkORWAECQ5NYAQJDlFgBAkOVWAECQ5ZYAQJDl1gBAkOY" +
+	"WAECQ5lYAQJDmlgBAkObWAECQ5xYAQJDnlgBAkOfWAECQ6BYAQJDoVgBAkOiWAEC" +
+	"Q6NYAQJDpFgBAkOlWAECQ6ZYAQJDp1gBAkOqVgECQ6tWAQJDrFYBAkOtVgECQ65W" +
+	"AQJDr1YBAkOwVgECQ7FWAQJDslYBAkOzVgECQ7VWAQJDtlYBAkO3VgECQ7hWAQJD" +
+	"uVYBAkO6VgECQ7tWAQJDvFYBAkO9VgECQ75WAQJDwFYBAkPBVgECQ8JWAQJDw1YB" +
+	"AkPEVgECQ8VWAQJDxlYBAkPHVgECQ8hWAQJDyVYBAkPLVgECQ8xWAQJDzVYBAkPO" +
+	"VgECQ89WAQJD0FYBAkPRVgECQ9JWAQJD01YBAkPUVgECQ9ZWAQJD11YBAkPYVgEC" +
+	"Q9lWAQJD2lYBAkPbVgECQ9xWAQJD3VYBAkPeVgECQ99WAQJD4VYBAkPiVgECQ+NW" +
+	"AQJD5FYBAkPlVgECQ+ZWAQJD51YBAkPoVgECQ+lWAQJD6lYBAkPsVgECQ+1WAQJD" +
+	"7lYBAkPvVgECQ/BWAQJD8VYBAkPyVgECQ/NWAQJD9FYBAkP1VgECQ/dWAQJD+FYB" +
+	"AkP5VgECQ/pWAQJD+1YBAkP8VgECQ/1WAQJD/lYBAkP/VgECQ4BXAQJDglcBAkOD" +
+	"VwECQ4RXAQJDhVcBAkOGVwECQ4dXAQJDiFcBAkOJVwECQ4pXAQJDi1cBAkONVwEC" +
+	"Q45XAQJDj1cBAkOQVwECQ5FXAQJDklcBAkOTVwECQ5RXAQJDlVcBAkOWVwECQ5lX" +
+	"AQJDmlcBAkObVwECQ5xXAQJDnVcBAkOeVwECQ59XAQJDoFcBAkOhVwECQ6JXAQJD" +
+	"pFcBAkOlVwECQ6ZXAQJDp1cBAkOoVwECQ6lXAQJDqlcBAkOrVwECQ6xXAQJDrVcB" +
+	"AkOvVwECQ7BXAQJDsVcBAkOyVwECQ7NXAQJDtFcBAkO1VwECQ7ZXAQJDt1cBAkO4" +
+	"VwECQ7pXAQJDu1cBAkO8VwECQ71XAQJDvlcBAkO/VwECQ8BXAQJDwVcBAkPCVwEC" +
+	"Q8NXAQJDxVcBAkPGVwECQ8dXAQJDyFcBAkPJVwECQ8pXAQJDy1cBAkPMVwECQ81X" +
+	"AQJDzlcBAkPQVwECQ9FXAQJD0lcBAkPTVwECQ9RXAQJD1VcBAkPWVw=="
@@ -0,0 +1,269 @@
+package syntax
+
+import (
+	"math"
+)
+
+// NFA is a Thompson-style automaton for patterns that can be matched without
+// backtracking: no backreferences, lookarounds, atomic groups, conditionals,
+// balancing groups or right-to-left nodes.  It answers "is there a match"
+// questions only; greediness is irrelevant to it and captures are ignored,
+// except in the one compileCaptureNFA builds.
+type NFA struct {
+	Insts []NFAInst
+	Start int
+}
+
+type NFAOp uint8
+
+const (
+	NFAMatch   NFAOp = iota // match found
+	NFAChar                 // consume Ch
+	NFANotChar              // consume anything but Ch
+	NFASet                  // consume a rune in Set
+	NFASplit                // continue at both Out and Out1
+	NFAAssert               // zero-width Assert (Bol, Eol, Boundary, ...), then Out
+	NFANop                  // just go to Out
+	NFAFail                 // dead end
+	NFACapture              // note the position in Cap, then Out (capture NFAs only)
+)
+
+type NFAInst struct {
+	Op         NFAOp
+	Out, Out1  int
+	Ch         rune
+	Set        *CharSet
+	Assert     InstOp // one of the zero-width opcodes, for NFAAssert
+	IgnoreCase bool   // the input rune is case folded before comparing
+	Cap        int    // for NFACapture, slot*2 where the group starts, slot*2+1 where it ends
+}
+
+// maxNFASize bounds the expansion of counted repetitions like (abc){1000}
+const maxNFASize = 10000
+
+type nfaCompiler struct {
+	insts []NFAInst
+	ok    bool
+
+	// emit NFACapture for groups, with their numbers mapped to slots by
+	// caps, and keep to the order the backtracker tries things in
+	captures bool
+	caps     map[int]int
+}
+
+// compileNFA returns the NFA for tree, or nil if the pattern uses
+// constructs that need the backtracker.  Only RE2 mode patterns get one,
+// everything else keeps the backtracker's behavior, timeouts included.
+func compileNFA(tree *RegexTree) *NFA {
+	if tree.options&RE2 == 0 {
+		return nil
+	}
+	return tree.NFA()
+}
+
+// NFA returns the automaton for the tree whatever its options, or nil if
+// the pattern needs the backtracker, or is right to left or culture
+// sensitive, which the NFA has no way to follow.
+func (t *RegexTree) NFA() *NFA {
+	if t.options&RightToLeft != 0 || t.culture != nil {
+		return nil
+	}
+	c := nfaCompiler{ok: true}
+	match := c.emit(NFAInst{Op: NFAMatch})
+	start := c.compile(t.root, match)
+	if !c.ok {
+		return nil
+	}
+	return &NFA{Insts: c.insts, Start: start}
+}
+
+// compileCaptureNFA returns the NFA for tree with its captures, where
+// every split's Out is the way the backtracker tries first, so following
+// the splits in order finds the match the backtracker would, or nil if
+// the pattern needs the backtracker.  caps maps the group numbers to
+// slots, as the writer does.  Loops whose body can match the empty string
+// are left to the backtracker too: it stops them after one empty turn,
+// which leaves different captures than going round again would.
+func compileCaptureNFA(tree *RegexTree, caps map[int]int) *NFA {
+	if tree.options&RightToLeft != 0 {
+		return nil
+	}
+	c := nfaCompiler{ok: true, captures: true, caps: caps}
+	match := c.emit(NFAInst{Op: NFAMatch})
+	start := c.compile(tree.root, match)
+	if !c.ok {
+		return nil
+	}
+	return &NFA{Insts: c.insts, Start: start}
+}
+
+func (c *nfaCompiler) emit(inst NFAInst) int {
+	if len(c.insts) >= maxNFASize {
+		c.ok = false
+		return 0
+	}
+	c.insts = append(c.insts, inst)
+	return len(c.insts) - 1
+}
+
+// compile emits the instructions for node, continuing at next, and
+// returns the entry point.  The code is built back to front so that
+// every instruction knows its continuation when it is emitted.
+func (c *nfaCompiler) compile(node *regexNode, next int) int {
+	if !c.ok {
+		return 0
+	}
+	if node.options&RightToLeft != 0 {
+		c.ok = false
+		return 0
+	}
+	ci := node.options&IgnoreCase != 0
+
+	switch node.t {
+	case ntOne:
+		return c.emit(NFAInst{Op: NFAChar, Ch: node.ch, Out: next, IgnoreCase: ci})
+
+	case ntNotone:
+		return c.emit(NFAInst{Op: NFANotChar, Ch: node.ch, Out: next, IgnoreCase: ci})
+
+	case ntSet:
+		return c.emit(NFAInst{Op: NFASet, Set: node.set, Out: next, IgnoreCase: ci})
+
+	case ntMulti:
+		for i := len(node.str) - 1; i >= 0; i-- {
+			next = c.emit(NFAInst{Op: NFAChar, Ch: node.str[i], Out: next, IgnoreCase: ci})
+		}
+		return next
+
+	case ntOneloop, ntOnelazy, ntNotoneloop, ntNotonelazy, ntSetloop, ntSetlazy:
+		leaf := *node
+		switch node.t {
+		case ntOneloop, ntOnelazy:
+			leaf.t = ntOne
+		case ntNotoneloop, ntNotonelazy:
+			leaf.t = ntNotone
+		default:
+			leaf.t = ntSet
+		}
+		lazy := node.t == ntOnelazy || node.t == ntNotonelazy || node.t == ntSetlazy
+		return c.repeat(&leaf, node.m, node.n, lazy, next)
+
+	case ntLoop, ntLazyloop:
+		return c.repeat(node.children[0], node.m, node.n, node.t == ntLazyloop, next)
+
+	case ntConcatenate:
+		for i := len(node.children) - 1; i >= 0; i-- {
+			next = c.compile(node.children[i], next)
+		}
+		return next
+
+	case ntAlternate:
+		// a chain of splits, one per alternative
+		start := c.compile(node.children[len(node.children)-1], next)
+		for i := len(node.children) - 2; i >= 0; i-- {
+			alt := c.compile(node.children[i], next)
+			start = c.emit(NFAInst{Op: NFASplit, Out: alt, Out1: start})
+		}
+		return start
+
+	case ntCapture:
+		if node.n != -1 {
+			// balancing group
+			c.ok = false
+			return 0
+		}
+		if !c.captures {
+			return c.compile(node.children[0], next)
+		}
+		slot := node.m
+		if c.caps != nil {
+			slot = c.caps[slot]
+		}
+		end := c.emit(NFAInst{Op: NFACapture, Cap: slot*2 + 1, Out: next})
+		body := c.compile(node.children[0], end)
+		return c.emit(NFAInst{Op: NFACapture, Cap: slot * 2, Out: body})
+
+	case ntGroup:
+		return c.compile(node.children[0], next)
+
+	case ntEmpty:
+		return next
+
+	case ntNothing:
+		return c.emit(NFAInst{Op: NFAFail})
+
+	case ntBol, ntEol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary,
+		ntBeginning, ntStart, ntEndZ, ntEnd:
+		return c.emit(NFAInst{Op: NFAAssert, Assert: InstOp(node.t), Out: next})
+	}
+
+	c.ok = false
+	return 0
+}
+
+// repeat emits min copies of node followed by max-min optional ones,
+// or a loop when max is unbounded.
+func (c *nfaCompiler) repeat(node *regexNode, min, max int, lazy bool, next int) int {
+	cur := next
+	if max == math.MaxInt32 {
+		split := c.emit(NFAInst{Op: NFASplit})
+		if !c.ok {
+			return 0
+		}
+		body := c.compile(node, split)
+		c.insts[split].Out, c.insts[split].Out1 = c.optional(body, split, next, lazy)
+		cur = split
+	} else {
+		for i := 0; i < max-min && c.ok; i++ {
+			body := c.compile(node, cur)
+			out, out1 := c.optional(body, cur, next, lazy)
+			cur = c.emit(NFAInst{Op: NFASplit, Out: out, Out1: out1})
+		}
+	}
+	for i := 0; i < min && c.ok; i++ {
+		cur = c.compile(node, cur)
+	}
+	return cur
+}
+
+// optional gives the ways out of the split before a loop body that goes
+// on to cont, in the order they're tried
+func (c *nfaCompiler) optional(body, cont, next int, lazy bool) (out, out1 int) {
+	if !c.captures {
+		return body, next
+	}
+	if c.ok && c.empty(body, cont) {
+		c.ok = false
+	}
+	if lazy {
+		return next, body
+	}
+	return body, next
+}
+
+// empty says if the code at pc can get to the instruction to without
+// reading anything
+func (c *nfaCompiler) empty(pc, to int) bool {
+	seen := make(map[int]bool)
+	stack := []int{pc}
+	for len(stack) > 0 {
+		pc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if pc == to {
+			return true
+		}
+		if seen[pc] {
+			continue
+		}
+		seen[pc] = true
+
+		inst := &c.insts[pc]
+		switch inst.Op {
+		case NFASplit:
+			stack = append(stack, inst.Out, inst.Out1)
+		case NFANop, NFACapture, NFAAssert:
+			stack = append(stack, inst.Out)
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package syntax
+
+// OnePass is the capture NFA of a pattern anchored at the start of the
+// text, such as ^\d{4}-\d{2}-\d{2}$, that can be run without backtracking:
+// at each split, the next rune says which way to go, so one pass over the
+// text finds the match and its groups.  Where the next rune allows both
+// ways, the backtracker has to decide.
+type OnePass struct {
+	NFA
+
+	// First holds, for each NFASplit, the instructions that may come first
+	// along Out and along Out1: the ones that read a rune, NFAMatch, and the
+	// assertions that look for the end of the text or line
+	First [][2][]int
+}
+
+// maxOnePassSize keeps the first sets, which may each hold most of the
+// instructions, small
+const maxOnePassSize = 1000
+
+// compileOnePass returns the OnePass for a pattern with the capture NFA
+// nfa, or nil if it isn't anchored at the beginning.
+func compileOnePass(nfa *NFA, anchors AnchorLoc) *OnePass {
+	if nfa == nil || anchors&AnchorBeginning == 0 || len(nfa.Insts) > maxOnePassSize {
+		return nil
+	}
+	return newOnePass(*nfa)
+}
+
+func newOnePass(nfa NFA) *OnePass {
+	o := &OnePass{NFA: nfa, First: make([][2][]int, len(nfa.Insts))}
+	seen := make([]int, len(nfa.Insts))
+	gen := 0
+	for pc, inst := range nfa.Insts {
+		if inst.Op == NFASplit {
+			gen++
+			out := o.first(inst.Out, seen, gen)
+			gen++
+			o.First[pc] = [2][]int{out, o.first(inst.Out1, seen, gen)}
+		}
+	}
+	return o
+}
+
+// first follows the empty moves from pc, taking every other assertion to
+// hold, so a rune none of the instructions it returns accepts rules out
+// that way
+func (o *OnePass) first(pc int, seen []int, gen int) []int {
+	var out []int
+	stack := []int{pc}
+	for len(stack) > 0 {
+		pc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[pc] == gen {
+			continue
+		}
+		seen[pc] = gen
+
+		inst := &o.Insts[pc]
+		switch inst.Op {
+		case NFASplit:
+			stack = append(stack, inst.Out1, inst.Out)
+		case NFANop, NFACapture:
+			stack = append(stack, inst.Out)
+		case NFAAssert:
+			switch inst.Assert {
+			case Eol, EndZ, End:
+				out = append(out, pc)
+			default:
+				stack = append(stack, inst.Out)
+			}
+		case NFAFail:
+		default:
+			out = append(out, pc)
+		}
+	}
+	return out
+}
@@ -0,0 +1,261 @@
+package syntax
+
+// Optimization is a set of the rewrites the parser and writer can make to
+// a pattern so that it matches faster, which never change what it matches.
+// Parse makes DefaultOptimizations; ParseOptimized can turn them off one
+// by one, to see what they did, or turn on the ones that aren't default.
+type Optimization uint
+
+const (
+	// OptMergeSets joins alternatives that match one rune each into a
+	// class: a|b|[cd]|ef becomes [a-d]|ef.
+	OptMergeSets Optimization = 1 << iota
+
+	// OptJoinStrings joins adjacent runes into one string, matched at
+	// once: a(?:bc)d is the string abcd rather than a, bc and d.
+	OptJoinStrings
+
+	// OptCoalesceLoops multiplies out loops of loops where that doesn't
+	// change what they match: (?:a+)* becomes a*, and (?:a{2,}){3}
+	// becomes a{6,}.
+	OptCoalesceLoops
+
+	// OptLiteralTries matches alternations of many literals, like keyword
+	// lists, with a LiteralTrie instead of trying each in turn.
+	OptLiteralTries
+
+	// OptAutoAtomic makes greedy loops of single runes atomic when what
+	// comes next can't match the runes they would give back, so a
+	// failing match doesn't try each way to do so: \d+x becomes
+	// (?>\d+)x, as does a trailing \d+ at the end of the pattern.  It's
+	// not made by default, since it makes programs a little bigger.
+	OptAutoAtomic
+
+	// OptHoistSuffixes moves a literal that all the alternatives of an
+	// alternation end with out of it, so it's matched once: cat|bat|rat
+	// becomes [cbr]at, and Monday|Friday becomes (?:Mon|Fri)day.  It's
+	// not made by default, as it can keep alternations of literals from
+	// being matched with a LiteralTrie.
+	OptHoistSuffixes
+
+	// DefaultOptimizations are the optimizations Parse makes.
+	DefaultOptimizations = OptMergeSets | OptJoinStrings | OptCoalesceLoops | OptLiteralTries
+
+	// AllOptimizations are all of them.
+	AllOptimizations = DefaultOptimizations | OptAutoAtomic | OptHoistSuffixes
+)
+
+var optimizationNames = []string{"MergeSets", "JoinStrings", "CoalesceLoops", "LiteralTries", "AutoAtomic", "HoistSuffixes"}
+
+func (o Optimization) String() string {
+	if o == 0 {
+		return "0"
+	}
+	s := ""
+	for i, name := range optimizationNames {
+		if o&(1<<uint(i)) != 0 {
+			if s != "" {
+				s += "|"
+			}
+			s += "Opt" + name
+		}
+	}
+	return s
+}
+
+// unoptimized returns the options that turn off the optimizations the
+// parser makes as it goes and that aren't in o
+func (o Optimization) unoptimized() RegexOptions {
+	var opts RegexOptions
+	if o&OptMergeSets == 0 {
+		opts |= noMergeSets
+	}
+	if o&OptJoinStrings == 0 {
+		opts |= noJoinStrings
+	}
+	if o&OptCoalesceLoops == 0 {
+		opts |= noCoalesceLoops
+	}
+	return opts
+}
+
+// optimize makes the optimizations of the tree that need the whole of it
+func (t *RegexTree) optimize() {
+	if t.optimizations&OptHoistSuffixes != 0 {
+		t.root = t.root.rewrite(hoistSuffix)
+	}
+	if t.optimizations&OptAutoAtomic != 0 {
+		t.root = t.root.rewrite(autoAtomic)
+		// a loop that ends the pattern never has to give anything back,
+		// unless the pattern is called as a subroutine and something
+		// follows the call
+		if len(calledGroups(t.root, nil)) == 0 {
+			body := t.root.children[0]
+			if body.t == ntConcatenate && body.options&RightToLeft == 0 {
+				last := len(body.children) - 1
+				body.children[last] = atomicLoop(body.children[last], body)
+			} else if body.options&RightToLeft == 0 {
+				t.root.children[0] = atomicLoop(body, t.root)
+			}
+		}
+	}
+}
+
+// rewrite replaces the nodes of the tree from n, children first, with
+// what f returns for them, and keeps the links to parents up to date
+func (n *regexNode) rewrite(f func(*regexNode) *regexNode) *regexNode {
+	for i, child := range n.children {
+		n.children[i] = child.rewrite(f)
+		n.children[i].next = n
+	}
+	return f(n)
+}
+
+// autoAtomic makes the loops in a concatenation atomic where the node
+// after them can't start with what they match
+func autoAtomic(n *regexNode) *regexNode {
+	if n.t != ntConcatenate || n.options&RightToLeft != 0 {
+		return n
+	}
+	for i := 0; i < len(n.children)-1; i++ {
+		if loop := n.children[i]; loopLeaf(loop) && disjointStart(loop, n.children[i+1]) {
+			n.children[i] = atomicLoop(loop, n)
+		}
+	}
+	return n
+}
+
+// loopLeaf says whether n is a greedy loop of single runes that can give
+// some back
+func loopLeaf(n *regexNode) bool {
+	return n.t >= ntOneloop && n.t <= ntSetloop && n.m != n.n
+}
+
+// atomicLoop wraps n in an atomic group if it's a loop that can give
+// runes back
+func atomicLoop(n, parent *regexNode) *regexNode {
+	if !loopLeaf(n) {
+		return n
+	}
+	atomic := newRegexNode(ntGreedy, n.options)
+	atomic.pos, atomic.end = n.pos, n.end
+	atomic.children = []*regexNode{n}
+	atomic.next = parent
+	n.next = atomic
+	return atomic
+}
+
+// disjointStart says whether next has to start with a rune that loop,
+// a loopLeaf, can't match
+func disjointStart(loop, next *regexNode) bool {
+	if (loop.options^next.options)&(IgnoreCase|RightToLeft) != 0 {
+		return false
+	}
+	t, ch, set := ntOne+next.t%3, next.ch, next.set
+	switch {
+	case next.t == ntMulti:
+		t, ch = ntOne, next.str[0]
+	case next.t >= ntOne && next.t <= ntSet:
+		t = next.t
+	case next.t <= ntSetlazy && next.m > 0:
+	default:
+		return false
+	}
+
+	lt := ntOne + loop.t%3
+	switch {
+	case lt == ntOne && t == ntOne:
+		return loop.ch != ch
+	case lt == ntOne && t == ntSet:
+		return !set.CharIn(loop.ch)
+	case lt == ntSet && t == ntOne:
+		return !loop.set.CharIn(ch)
+	case lt == ntOne && t == ntNotone, lt == ntNotone && t == ntOne:
+		return loop.ch == ch
+	}
+	return false
+}
+
+// hoistSuffix moves the literal all the branches of an alternation end
+// with out after it
+func hoistSuffix(n *regexNode) *regexNode {
+	if n.t != ntAlternate || n.options&RightToLeft != 0 {
+		return n
+	}
+	var suffix *regexNode
+	k := 0
+	for _, branch := range n.children {
+		s := trailingString(branch)
+		if s == nil {
+			return n
+		}
+		if suffix == nil {
+			suffix, k = s, len(s.str)
+			continue
+		}
+		if (s.options^suffix.options)&IgnoreCase != 0 {
+			return n
+		}
+		common := 0
+		for common < k && common < len(s.str) && s.str[len(s.str)-1-common] == suffix.str[len(suffix.str)-1-common] {
+			common++
+		}
+		k = common
+	}
+	if k == 0 {
+		return n
+	}
+
+	str := append([]rune(nil), suffix.str[len(suffix.str)-k:]...)
+	alt := newRegexNode(ntAlternate, n.options)
+	for _, branch := range n.children {
+		alt.addChild(trimString(branch, k))
+	}
+	concat := newRegexNode(ntConcatenate, n.options)
+	concat.pos, concat.end = n.pos, n.end
+	concat.addChild(alt)
+	if len(str) == 1 {
+		concat.addChild(newRegexNodeCh(ntOne, suffix.options, str[0]))
+	} else {
+		concat.addChild(newRegexNodeStr(ntMulti, suffix.options, str))
+	}
+	return concat.reduce()
+}
+
+// trailingString returns the string a branch ends with as an ntMulti, if
+// it's one that trimString can take runes from
+func trailingString(n *regexNode) *regexNode {
+	if n.t == ntConcatenate && len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	switch n.t {
+	case ntOne:
+		return newRegexNodeStr(ntMulti, n.options, []rune{n.ch})
+	case ntMulti:
+		return n
+	}
+	return nil
+}
+
+// trimString returns the branch n without the last k runes it ends with
+func trimString(n *regexNode, k int) *regexNode {
+	if n.t == ntConcatenate {
+		last := len(n.children) - 1
+		n.children[last] = trimString(n.children[last], k)
+		if n.children[last].t == ntEmpty {
+			n.children = n.children[:last]
+		}
+		return n.stripEnation(ntEmpty)
+	}
+	if n.t == ntOne {
+		return newRegexNode(ntEmpty, n.options)
+	}
+	switch str := n.str[:len(n.str)-k]; len(str) {
+	case 0:
+		return newRegexNode(ntEmpty, n.options)
+	case 1:
+		return newRegexNodeCh(ntOne, n.options, str[0])
+	default:
+		return newRegexNodeStr(ntMulti, n.options, str)
+	}
+}
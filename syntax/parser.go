@@ -6,22 +6,43 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"unicode"
+	"unicode/utf16"
 )
 
 type RegexOptions int32
 
 const (
-	IgnoreCase              RegexOptions = 0x0001 // "i"
-	Multiline                            = 0x0002 // "m"
-	ExplicitCapture                      = 0x0004 // "n"
-	Compiled                             = 0x0008 // "c"
-	Singleline                           = 0x0010 // "s"
-	IgnorePatternWhitespace              = 0x0020 // "x"
-	RightToLeft                          = 0x0040 // "r"
-	Debug                                = 0x0080 // "d"
-	ECMAScript                           = 0x0100 // "e"
-	RE2                                  = 0x0200 // RE2 compat mode
+	IgnoreCase              RegexOptions = 0x0001  // "i"
+	Multiline                            = 0x0002  // "m"
+	ExplicitCapture                      = 0x0004  // "n"
+	Compiled                             = 0x0008  // "c"
+	Singleline                           = 0x0010  // "s"
+	IgnorePatternWhitespace              = 0x0020  // "x"
+	RightToLeft                          = 0x0040  // "r"
+	Debug                                = 0x0080  // "d"
+	ECMAScript                           = 0x0100  // "e"
+	RE2                                  = 0x0200  // RE2 compat mode
+	Memoize                              = 0x0400  // remember failed loop iterations
+	PCRE2                                = 0x0800  // PCRE2 compat mode
+	Python                               = 0x1000  // Python re compat mode
+	Java                                 = 0x2000  // Java java.util.regex compat mode
+	UnicodeSets                          = 0x4000  // ECMAScript v flag class syntax
+	CultureInvariant                     = 0x8000  // ignore the culture's casing rules
+	CaseConversion                       = 0x10000 // \U, \L, \u, \l and \E in replacements
+	DupNames                             = 0x20000 // "J": groups with the same name are numbered apart
+
+	// Python's inline (?a) flag; it can't be passed to Parse
+	asciiOnly RegexOptions = 0x40000000
+	// Java's inline (?U) flag, Unicode \d, \s, \w and \b
+	unicodeClasses RegexOptions = 0x20000000
+
+	// the parse time optimizations turned off, see unoptimized
+	noMergeSets     RegexOptions = 0x10000000
+	noJoinStrings   RegexOptions = 0x08000000
+	noCoalesceLoops RegexOptions = 0x04000000
+	noOptimizations              = noMergeSets | noJoinStrings | noCoalesceLoops
 )
 
 func optionFromCode(ch rune) RegexOptions {
@@ -49,11 +70,21 @@ func optionFromCode(ch rune) RegexOptions {
 }
 
 // An Error describes a failure to parse a regular expression
-// and gives the offending expression.
+// and gives the offending expression, and where in it things went wrong,
+// so that an editor can underline it.  Code is the same whatever Args
+// are, so it can key a translation of the message.
 type Error struct {
 	Code ErrorCode
 	Expr string
 	Args []interface{}
+
+	Offset     int    // where in Expr the offending token starts, in bytes
+	RuneOffset int    // the same, in runes
+	Token      string // the offending token, like `(?<1x>` or `[z-a`
+
+	// Hint suggests a fix for common mistakes, like "did you mean
+	// (?<name>...)?", or is "".  Error doesn't give it.
+	Hint string
 }
 
 func (e *Error) Error() string {
@@ -95,6 +126,7 @@ const (
 	ErrMalformedSlashP            = "malformed \\p{X} character escape"
 	ErrIncompleteSlashP           = "incomplete \\p{X} character escape"
 	ErrUnknownSlashP              = "unknown unicode category, script, or property '%v'"
+	ErrUnsupportedStringProp      = "unsupported property of strings '%v': there are no emoji tables to build it from"
 	ErrUnrecognizedEscape         = "unrecognized escape sequence \\%v"
 	ErrMissingControl             = "missing control character"
 	ErrUnrecognizedControl        = "unrecognized control character"
@@ -105,6 +137,20 @@ const (
 	ErrUnterminatedBracket        = "unterminated [] set"
 	ErrSubtractionMustBeLast      = "a subtraction must be the last element in a character class"
 	ErrReversedCharRange          = "[x-y] range in reverse order"
+	ErrMalformedCall              = "malformed subroutine call (?%v"
+	ErrUndefinedCall              = "subroutine call to undefined group %v"
+	ErrMalformedVerb              = "malformed backtracking verb (*%v"
+	ErrUnknownVerb                = "unknown backtracking verb (*%v)"
+	ErrMalformedGRef              = "malformed \\g back reference or subroutine call"
+	ErrTooFewOctal                = "insufficient octal digits"
+	ErrGlobalFlagsNotAtStart      = "global flags not at the start of the expression"
+	ErrClassSetOperation          = "invalid set operation in character class"
+	ErrClassSetSyntaxChar         = "%v must be escaped in a character class"
+	ErrNegatedClassStrings        = "negated character class may contain strings"
+	ErrMalformedClassString       = "malformed \\q{...} class string"
+	ErrMalformedCharName          = "malformed \\N{...} character name"
+	ErrUnknownPOSIXClass          = "unknown POSIX class name [:%v:]"
+	ErrUnknownCharName            = "unknown Unicode character name %v"
 )
 
 func (e ErrorCode) String() string {
@@ -122,7 +168,7 @@ type parser struct {
 	pattern    []rune
 
 	currentPos  int
-	specialCase *unicode.SpecialCase
+	specialCase unicode.SpecialCase // casing rules for IgnoreCase, nil for invariant
 
 	autocap  int
 	capcount int
@@ -132,12 +178,31 @@ type parser struct {
 	caps     map[int]int
 	capnames map[string]int
 
+	// how many groups of each name have been seen so far in this pass,
+	// and the groups of each name DupNames has given more than one
+	namecount map[string]int
+	dupnames  map[string][]int
+
 	capnumlist  []int
 	capnamelist []string
 
 	options         RegexOptions
 	optionsStack    []RegexOptions
 	ignoreNextParen bool
+
+	branchResets []branchReset
+
+	depth, maxDepth int // how deep the groups open now and at most nest
+
+	tokenPos int // where the construct being scanned starts, for errors
+}
+
+// branchReset tracks an open (?|...) group, whose alternatives all
+// number their captures starting from the same point
+type branchReset struct {
+	depth int // len(optionsStack) inside the group
+	start int // first capture number of each alternative
+	max   int // next capture number after the widest alternative so far
 }
 
 const (
@@ -147,9 +212,25 @@ const (
 
 // Parse converts a regex string into a parse tree
 func Parse(re string, op RegexOptions) (*RegexTree, error) {
+	return ParseCulture(re, op, nil)
+}
+
+// ParseCulture is like Parse, but IgnoreCase follows the casing rules of
+// culture unless op has CultureInvariant
+func ParseCulture(re string, op RegexOptions, culture unicode.SpecialCase) (*RegexTree, error) {
+	return ParseOptimized(re, op, culture, DefaultOptimizations)
+}
+
+// ParseOptimized is like ParseCulture, but makes just the optimizations
+// in opt, both to the tree and, when it's written, to its program
+func ParseOptimized(re string, op RegexOptions, culture unicode.SpecialCase, opt Optimization) (*RegexTree, error) {
+	if op&CultureInvariant != 0 {
+		culture = nil
+	}
 	p := parser{
-		options: op,
-		caps:    make(map[int]int),
+		options:     op,
+		caps:        make(map[int]int),
+		specialCase: culture,
 	}
 	p.setPattern(re)
 
@@ -157,21 +238,27 @@ func Parse(re string, op RegexOptions) (*RegexTree, error) {
 		return nil, err
 	}
 
-	p.reset(op)
+	p.reset(op | opt.unoptimized())
 	root, err := p.scanRegex()
 
 	if err != nil {
 		return nil, err
 	}
+	p.foldDupNames()
+	root = root.rewrite(fullFold)
 	tree := &RegexTree{
-		root:       root,
-		caps:       p.caps,
-		capnumlist: p.capnumlist,
-		captop:     p.captop,
-		Capnames:   p.capnames,
-		Caplist:    p.capnamelist,
-		options:    op,
-	}
+		root:          root,
+		caps:          p.caps,
+		capnumlist:    p.capnumlist,
+		captop:        p.captop,
+		Capnames:      p.capnames,
+		Caplist:       p.capnamelist,
+		options:       op,
+		culture:       culture,
+		optimizations: opt,
+		MaxDepth:      p.maxDepth,
+	}
+	tree.optimize()
 
 	if tree.options&Debug > 0 {
 		os.Stdout.WriteString(tree.Dump())
@@ -189,8 +276,34 @@ func (p *parser) setPattern(pattern string) {
 		p.pattern = append(p.pattern, r)
 	}
 }
+
+// getErr returns an error for the token that starts at p.tokenPos
 func (p *parser) getErr(code ErrorCode, args ...interface{}) error {
-	return &Error{Code: code, Expr: p.patternRaw, Args: args}
+	return p.getErrAt(p.tokenPos, code, args...)
+}
+
+// getErrAt returns an error for the token from pos to where the parser
+// is, or the rune at pos if it hasn't got past it
+func (p *parser) getErrAt(pos int, code ErrorCode, args ...interface{}) error {
+	if pos > len(p.pattern) {
+		pos = len(p.pattern)
+	}
+	end := p.currentPos
+	if end <= pos {
+		end = pos + 1
+	}
+	if end > len(p.pattern) {
+		end = len(p.pattern)
+	}
+	return &Error{
+		Code:       code,
+		Expr:       p.patternRaw,
+		Args:       args,
+		Offset:     len(string(p.pattern[:pos])),
+		RuneOffset: pos,
+		Token:      string(p.pattern[pos:end]),
+		Hint:       p.hint(code, pos, end),
+	}
 }
 
 func (p *parser) noteCaptureSlot(i, pos int) {
@@ -213,6 +326,7 @@ func (p *parser) noteCaptureName(name string, pos int) {
 	if p.capnames == nil {
 		p.capnames = make(map[string]int)
 	}
+	name = p.groupName(name)
 
 	if _, ok := p.capnames[name]; !ok {
 		p.capnames[name] = pos
@@ -220,6 +334,51 @@ func (p *parser) noteCaptureName(name string, pos int) {
 	}
 }
 
+// groupName is the name a group called name is kept under: name itself,
+// or with DupNames, a name of its own for each group after the first,
+// which foldDupNames gives back the name it was called
+func (p *parser) groupName(name string) string {
+	if p.namecount == nil {
+		p.namecount = make(map[string]int)
+	}
+	n := p.namecount[name]
+	p.namecount[name]++
+	if n == 0 || !p.useDupNames() {
+		return name
+	}
+	return name + "\x00" + strconv.Itoa(n)
+}
+
+// noteDupNames finds the groups of each name DupNames has numbered apart
+func (p *parser) noteDupNames() {
+	for _, name := range p.capnamelist {
+		base, _, ok := strings.Cut(name, "\x00")
+		if !ok {
+			continue
+		}
+		if p.dupnames == nil {
+			p.dupnames = make(map[string][]int)
+		}
+		if p.dupnames[base] == nil {
+			p.dupnames[base] = []int{p.capnames[base]}
+		}
+		p.dupnames[base] = append(p.dupnames[base], p.capnames[name])
+	}
+}
+
+// foldDupNames gives the groups noteDupNames found back their name
+func (p *parser) foldDupNames() {
+	if p.dupnames == nil {
+		return
+	}
+	for i, name := range p.capnamelist {
+		if base, _, ok := strings.Cut(name, "\x00"); ok {
+			p.capnamelist[i] = base
+			delete(p.capnames, name)
+		}
+	}
+}
+
 func (p *parser) assignNameSlots() {
 	if p.capnames != nil {
 		for _, name := range p.capnamelist {
@@ -307,10 +466,14 @@ func (p *parser) countCaptures() error {
 
 	for p.charsRight() > 0 {
 		pos := p.textpos()
+		p.tokenPos = pos
 		ch = p.moveRightGetChar()
 		switch ch {
 		case '\\':
-			if p.charsRight() > 0 {
+			if p.isQuoteStart() {
+				p.moveRight(1)
+				p.scanQuoted()
+			} else if p.charsRight() > 0 {
 				p.scanBackslash(true)
 			}
 
@@ -321,13 +484,21 @@ func (p *parser) countCaptures() error {
 			}
 
 		case '[':
-			p.scanCharSet(false, true)
+			if p.useUnicodeSets() {
+				p.scanClassSet(false, true)
+			} else {
+				p.scanCharSet(false, true)
+			}
 
 		case ')':
+			p.endBranchReset()
 			if !p.emptyOptionsStack() {
 				p.popOptions()
 			}
 
+		case '|':
+			p.nextBranchReset()
+
 		case '(':
 			if p.charsRight() >= 2 && p.rightChar(1) == '#' && p.rightChar(0) == '?' {
 				p.moveLeft()
@@ -355,7 +526,7 @@ func (p *parser) countCaptures() error {
 								p.noteCaptureName(p.scanCapname(), pos)
 							}
 						}
-					} else if p.useRE2() && p.charsRight() > 2 && (p.rightChar(0) == 'P' && p.rightChar(1) == '<') {
+					} else if p.usePNames() && p.charsRight() > 2 && (p.rightChar(0) == 'P' && p.rightChar(1) == '<') {
 						// RE2-compat (?P<)
 						p.moveRight(2)
 						ch = p.rightChar(0)
@@ -363,6 +534,8 @@ func (p *parser) countCaptures() error {
 							p.noteCaptureName(p.scanCapname(), pos)
 						}
 
+					} else if p.charsRight() > 0 && p.rightChar(0) == '|' {
+						p.startBranchReset()
 					} else {
 						// (?...
 
@@ -384,6 +557,8 @@ func (p *parser) countCaptures() error {
 							}
 						}
 					}
+				} else if p.charsRight() > 0 && p.rightChar(0) == '*' {
+					// backtracking verb, not a group
 				} else {
 					if !p.useOptionN() && !p.ignoreNextParen {
 						p.noteCaptureSlot(p.consumeAutocap(), pos)
@@ -397,6 +572,7 @@ func (p *parser) countCaptures() error {
 	}
 
 	p.assignNameSlots()
+	p.noteDupNames()
 	return nil
 }
 
@@ -404,13 +580,17 @@ func (p *parser) reset(topopts RegexOptions) {
 	p.currentPos = 0
 	p.autocap = 1
 	p.ignoreNextParen = false
+	p.branchResets = p.branchResets[:0]
 
 	if len(p.optionsStack) > 0 {
 		p.optionsStack = p.optionsStack[:0]
 	}
 
 	p.options = topopts
+	p.namecount = nil
 	p.stack = nil
+	p.depth, p.maxDepth = 0, 0
+	p.tokenPos = 0
 }
 
 func (p *parser) scanRegex() (*regexNode, error) {
@@ -451,6 +631,7 @@ func (p *parser) scanRegex() (*regexNode, error) {
 		}
 
 		endpos := p.textpos()
+		unitpos := endpos - 1 // where what a quantifier applies to starts
 
 		p.scanBlank()
 
@@ -458,6 +639,10 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			ch = '!' // nonspecial, means at end
 		} else if ch = p.rightChar(0); isSpecial(ch) {
 			isQuant = isQuantifier(ch)
+			if !isQuant {
+				unitpos = p.textpos()
+			}
+			p.tokenPos = p.textpos()
 			p.moveRight(1)
 		} else {
 			ch = ' ' // nonspecial, means at ordinary char
@@ -487,6 +672,14 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			goto ContinueOuterScan
 
 		case '[':
+			if p.useUnicodeSets() {
+				n, err := p.scanClassSet(p.useOptionI(), false)
+				if err != nil {
+					return nil, err
+				}
+				p.addUnitNode(n)
+				break
+			}
 			cc, err := p.scanCharSet(p.useOptionI(), false)
 			if err != nil {
 				return nil, err
@@ -494,6 +687,19 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			p.addUnitSet(cc)
 
 		case '(':
+			if call, err := p.scanCall(); err != nil {
+				return nil, err
+			} else if call != nil {
+				p.addUnitNode(call)
+				break
+			}
+			if verb, err := p.scanVerb(); err != nil {
+				return nil, err
+			} else if verb != nil {
+				p.addUnitNode(verb)
+				break
+			}
+
 			p.pushOptions()
 
 			if grouper, err := p.scanGroupOpen(); err != nil {
@@ -501,6 +707,7 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			} else if grouper == nil {
 				p.popKeepOptions()
 			} else {
+				grouper.pos = unitpos
 				p.pushGroup()
 				p.startGroup(grouper)
 			}
@@ -509,12 +716,14 @@ func (p *parser) scanRegex() (*regexNode, error) {
 
 		case '|':
 			p.addAlternate()
+			p.nextBranchReset()
 			goto ContinueOuterScan
 
 		case ')':
 			if p.emptyStack() {
 				return nil, p.getErr(ErrUnexpectedParen)
 			}
+			p.endBranchReset()
 
 			if err := p.addGroup(); err != nil {
 				return nil, err
@@ -527,8 +736,27 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			if p.unit == nil {
 				goto ContinueOuterScan
 			}
+			unitpos = p.unit.pos
 
 		case '\\':
+			if p.isQuoteStart() {
+				// \Q...\E: the quoted text is added like a run of ordinary
+				// chars, so a quantifier after it applies to the last one
+				p.moveRight(1)
+				start, end := p.scanQuoted()
+				if start == end {
+					goto ContinueOuterScan
+				}
+				p.addToConcatenate(start, end-start-1, false)
+				p.addUnitOne(p.charAt(end - 1))
+				unitpos = end - 1
+				break
+			}
+			if !p.useOptionE() && p.charsRight() > 0 && p.rightChar(0) == 'E' {
+				// a \E without a \Q is ignored
+				p.moveRight(1)
+				goto ContinueOuterScan
+			}
 			n, err := p.scanBackslash(false)
 			if err != nil {
 				return nil, err
@@ -550,7 +778,7 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			}
 
 		case '.':
-			if p.useOptionE() {
+			if p.useOptionE() && !p.useOptionS() {
 				p.addUnitSet(ECMAAnyClass())
 			} else if p.useOptionS() {
 				p.addUnitSet(AnyClass())
@@ -585,6 +813,7 @@ func (p *parser) scanRegex() (*regexNode, error) {
 			goto ContinueOuterScan
 		}
 
+		p.tokenPos = p.textpos()
 		ch = p.moveRightGetChar()
 
 		// Handle quantifiers
@@ -613,7 +842,7 @@ func (p *parser) scanRegex() (*regexNode, error) {
 						return nil, err
 					}
 					max = min
-					if startpos < p.textpos() {
+					if startpos < p.textpos() || (p.usePython() && p.charsRight() > 0 && p.rightChar(0) == ',') {
 						if p.charsRight() > 0 && p.rightChar(0) == ',' {
 							p.moveRight(1)
 							if p.charsRight() == 0 || p.rightChar(0) == '}' {
@@ -648,11 +877,22 @@ func (p *parser) scanRegex() (*regexNode, error) {
 				lazy = true
 			}
 
+			// possessive quantifiers (a*+) as in PCRE and Java
+			possessive := false
+			if !lazy && p.charsRight() > 0 && p.rightChar(0) == '+' {
+				p.moveRight(1)
+				possessive = true
+			}
+
 			if min > max {
 				return nil, p.getErr(ErrInvalidRepeatSize)
 			}
 
-			p.addConcatenate3(lazy, min, max)
+			if possessive {
+				p.addConcatenatePossessive(min, max, unitpos)
+			} else {
+				p.addConcatenate3(lazy, min, max, unitpos)
+			}
 		}
 
 	ContinueOuterScan:
@@ -662,7 +902,9 @@ BreakOuterScan:
 	;
 
 	if !p.emptyStack() {
-		return nil, p.getErr(ErrMissingParen)
+		// the innermost group left open
+		p.textto(p.group.pos + 1)
+		return nil, p.getErrAt(p.group.pos, ErrMissingParen)
 	}
 
 	if err := p.addGroup(); err != nil {
@@ -689,7 +931,7 @@ func (p *parser) scanReplacement() (*regexNode, error) {
 
 		startpos = p.textpos()
 
-		for c > 0 && p.rightChar(0) != '$' {
+		for c > 0 && p.rightChar(0) != '$' && !(p.rightChar(0) == '\\' && p.useOptionCase()) {
 			p.moveRight(1)
 			c--
 		}
@@ -703,6 +945,8 @@ func (p *parser) scanReplacement() (*regexNode, error) {
 					return nil, err
 				}
 				p.addUnitNode(n)
+			} else {
+				p.addUnitNode(p.scanCaseChange())
 			}
 			p.addConcatenate()
 		}
@@ -711,6 +955,22 @@ func (p *parser) scanReplacement() (*regexNode, error) {
 	return p.concatenation, nil
 }
 
+// Scans the \U, \L, \u, \l and \E case conversions in replacement
+// patterns, after the backslash.  \\ is a backslash, and a backslash
+// before anything else is kept as it is.
+func (p *parser) scanCaseChange() *regexNode {
+	if p.charsRight() > 0 {
+		switch ch := p.rightChar(0); ch {
+		case 'U', 'L', 'u', 'l', 'E':
+			p.moveRight(1)
+			return newRegexNodeCh(ntCaseChange, p.options, ch)
+		case '\\':
+			p.moveRight(1)
+		}
+	}
+	return newRegexNodeCh(ntOne, p.options, '\\')
+}
+
 /*
  * Scans $ patterns recognized within replacement patterns
  */
@@ -782,7 +1042,7 @@ func (p *parser) scanDollar() (*regexNode, error) {
 
 		if p.charsRight() > 0 && p.moveRightGetChar() == '}' {
 			if p.isCaptureName(capname) {
-				return newRegexNodeM(ntRef, p.options, p.captureSlotFromName(capname)), nil
+				return p.refNode(capname), nil
 			}
 		}
 	} else if !angled {
@@ -860,6 +1120,11 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 		case '>':
 			nt = ntGreedy
 
+		case '|':
+			// branch reset (?|...)
+			nt = ntGroup
+			p.startBranchReset()
+
 		case '\'':
 			close = '\''
 			fallthrough
@@ -914,7 +1179,7 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 					capname := p.scanCapname()
 
 					if p.isCaptureName(capname) {
-						capnum = p.captureSlotFromName(capname)
+						capnum = p.captureSlotFromName(p.groupName(capname))
 					}
 
 					// check if we have bogus character after the name
@@ -928,6 +1193,11 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 					return nil, p.getErr(ErrInvalidGroupName)
 				}
 
+				// balancing groups are .NET only
+				if !p.useDotNetClasses() && (proceed || (p.charsRight() > 0 && p.rightChar(0) == '-')) {
+					return nil, p.getErr(ErrInvalidGroupName)
+				}
+
 				// grab part after - if any
 
 				if (capnum != -1 || proceed == true) && p.charsRight() > 0 && p.rightChar(0) == '-' {
@@ -1032,7 +1302,7 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 			}
 
 		case 'P':
-			if p.useRE2() {
+			if p.usePNames() {
 				// support for P<name> syntax
 				if p.charsRight() < 3 {
 					goto BreakRecognize
@@ -1051,7 +1321,7 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 					capname := p.scanCapname()
 
 					if p.isCaptureName(capname) {
-						capnum = p.captureSlotFromName(capname)
+						capnum = p.captureSlotFromName(p.groupName(capname))
 					}
 
 					// check if we have bogus character after the name
@@ -1088,6 +1358,10 @@ func (p *parser) scanGroupOpen() (*regexNode, error) {
 			}
 
 			if ch = p.moveRightGetChar(); ch == ')' {
+				// Python only has global flags, so they must come first
+				if p.usePython() && !p.atPatternStart() {
+					return nil, p.getErr(ErrGlobalFlagsNotAtStart)
+				}
 				return nil, nil
 			}
 
@@ -1107,6 +1381,234 @@ BreakRecognize:
 	return nil, p.getErr(ErrUnrecognizedGrouping, string(p.pattern[start:p.textpos()]))
 }
 
+// Scans a PCRE-style subroutine call: (?R), (?n), (?+n), (?-n), (?&name)
+// or (?P>name), or a Python-style (?P=name) back reference where those
+// are allowed.  Returns nil without consuming anything if the text after
+// the ( is not a call.
+func (p *parser) scanCall() (*regexNode, error) {
+	if p.charsRight() < 3 || p.rightChar(0) != '?' {
+		return nil, nil
+	}
+	startpos := p.textpos()
+	p.moveRight(1)
+
+	switch ch := p.moveRightGetChar(); {
+	case ch == 'R':
+		if p.rightChar(0) == ')' {
+			p.moveRight(1)
+			return newRegexNodeM(ntCall, p.options, 0), nil
+		}
+
+	case ch == '+' || ch == '-' || (ch >= '0' && ch <= '9'):
+		if ch >= '0' && ch <= '9' {
+			p.moveLeft()
+		} else if p.charsRight() == 0 || p.rightChar(0) < '0' || p.rightChar(0) > '9' {
+			// (?-i) and friends
+			break
+		}
+		capnum, err := p.scanDecimal()
+		if err != nil {
+			return nil, err
+		}
+		if p.charsRight() == 0 || p.moveRightGetChar() != ')' {
+			return nil, p.getErr(ErrMalformedCall, string(p.pattern[startpos+1:p.textpos()]))
+		}
+		// relative calls count the unnamed groups opened so far
+		if ch == '+' {
+			capnum = p.autocap + capnum - 1
+		} else if ch == '-' {
+			capnum = p.autocap - capnum
+		}
+		if !p.isCaptureSlot(capnum) {
+			return nil, p.getErr(ErrUndefinedCall, capnum)
+		}
+		return newRegexNodeM(ntCall, p.options, capnum), nil
+
+	case ch == '&' || (ch == 'P' && p.rightChar(0) == '>'):
+		if ch == 'P' {
+			p.moveRight(1)
+		}
+		if p.charsRight() == 0 || !IsWordChar(p.rightChar(0)) {
+			return nil, p.getErr(ErrMalformedCall, string(p.pattern[startpos+1:p.textpos()]))
+		}
+		capname := p.scanCapname()
+		if p.charsRight() == 0 || p.moveRightGetChar() != ')' {
+			return nil, p.getErr(ErrMalformedCall, string(p.pattern[startpos+1:p.textpos()]))
+		}
+		if !p.isCaptureName(capname) {
+			return nil, p.getErr(ErrUndefinedCall, capname)
+		}
+		return newRegexNodeM(ntCall, p.options, p.captureSlotFromName(capname)), nil
+
+	case ch == 'P' && p.rightChar(0) == '=' && p.usePNames():
+		p.moveRight(1)
+		capname := p.scanCapname()
+		if capname == "" || p.charsRight() == 0 || p.moveRightGetChar() != ')' {
+			return nil, p.getErr(ErrMalformedNameRef)
+		}
+		if !p.isCaptureName(capname) {
+			return nil, p.getErr(ErrUndefinedNameRef, capname)
+		}
+		return p.refNode(capname), nil
+	}
+
+	p.textto(startpos)
+	return nil, nil
+}
+
+// Scans a backtracking control verb: (*FAIL) or (*F), (*COMMIT), (*PRUNE),
+// (*SKIP), (*MARK:NAME) or (*:NAME).  (*COMMIT:NAME) and (*PRUNE:NAME)
+// also set a mark, while (*SKIP:NAME) skips to the named mark.  Returns nil
+// without consuming anything if the ( is not followed by *.
+func (p *parser) scanVerb() (*regexNode, error) {
+	if p.charsRight() == 0 || p.rightChar(0) != '*' {
+		return nil, nil
+	}
+	p.moveRight(1)
+	startpos := p.textpos()
+
+	for p.charsRight() > 0 && p.rightChar(0) != ':' && p.rightChar(0) != ')' {
+		p.moveRight(1)
+	}
+	verb := string(p.pattern[startpos:p.textpos()])
+
+	var name []rune
+	if p.charsRight() > 0 && p.rightChar(0) == ':' {
+		p.moveRight(1)
+		namepos := p.textpos()
+		for p.charsRight() > 0 && p.rightChar(0) != ')' {
+			p.moveRight(1)
+		}
+		name = p.pattern[namepos:p.textpos()]
+	}
+	if p.charsRight() == 0 || (name != nil && len(name) == 0) {
+		return nil, p.getErr(ErrMalformedVerb, string(p.pattern[startpos:p.textpos()]))
+	}
+	p.moveRight(1)
+
+	var kind int
+	switch verb {
+	case "FAIL", "F":
+		if name == nil {
+			return newRegexNode(ntNothing, p.options), nil
+		}
+	case "COMMIT":
+		kind = VerbCommit
+	case "PRUNE":
+		kind = VerbPrune
+	case "SKIP":
+		kind = VerbSkip
+	case "MARK", "":
+		if name != nil {
+			kind = VerbMark
+		}
+	}
+	if kind == 0 {
+		return nil, p.getErr(ErrUnknownVerb, string(p.pattern[startpos:p.textpos()-1]))
+	}
+
+	n := newRegexNodeM(ntVerb, p.options, kind)
+	n.str = name
+	return n, nil
+}
+
+// Scans PCRE's \g escapes: the back references \g1, \g{1}, \g-1, \g{-1}
+// and \g{name}, and the Oniguruma-style subroutine calls \g<name>, \g'1',
+// \g<-1> and \g<+1>.  Relative numbers count the groups opened so far.
+func (p *parser) scanGReference(scanOnly bool) (*regexNode, error) {
+	p.moveRight(1)
+
+	close := '\x00'
+	if p.charsRight() > 0 {
+		switch p.rightChar(0) {
+		case '{':
+			close = '}'
+		case '<':
+			close = '>'
+		case '\'':
+			close = '\''
+		}
+		if close != 0 {
+			p.moveRight(1)
+		}
+	}
+	call := close == '>' || close == '\''
+
+	sign := '\x00'
+	if p.charsRight() > 0 && (p.rightChar(0) == '-' || (call && p.rightChar(0) == '+')) {
+		sign = p.moveRightGetChar()
+	}
+
+	capnum := -1
+	var capname string
+	if p.charsRight() > 0 && p.rightChar(0) >= '0' && p.rightChar(0) <= '9' {
+		n, err := p.scanDecimal()
+		if err != nil {
+			return nil, err
+		}
+		switch sign {
+		case '-':
+			capnum = p.autocap - n
+		case '+':
+			capnum = p.autocap + n - 1
+		default:
+			capnum = n
+		}
+	} else if close != 0 && sign == 0 && p.charsRight() > 0 && IsWordChar(p.rightChar(0)) {
+		capname = p.scanCapname()
+	} else {
+		return nil, p.getErr(ErrMalformedGRef)
+	}
+	if close != 0 && (p.charsRight() == 0 || p.moveRightGetChar() != close) {
+		return nil, p.getErr(ErrMalformedGRef)
+	}
+
+	if scanOnly {
+		return nil, nil
+	}
+	if capname != "" {
+		if !p.isCaptureName(capname) {
+			if call {
+				return nil, p.getErr(ErrUndefinedCall, capname)
+			}
+			return nil, p.getErr(ErrUndefinedNameRef, capname)
+		}
+		capnum = p.captureSlotFromName(capname)
+	} else if !p.isCaptureSlot(capnum) {
+		if call {
+			return nil, p.getErr(ErrUndefinedCall, capnum)
+		}
+		return nil, p.getErr(ErrUndefinedBackRef, capnum)
+	}
+
+	if call {
+		return newRegexNodeM(ntCall, p.options, capnum), nil
+	}
+	return newRegexNodeM(ntRef, p.options, capnum), nil
+}
+
+// Tells whether the \ just consumed starts a \Q...\E quote.  ECMAScript
+// treats \Q as an escaped Q instead.
+func (p *parser) isQuoteStart() bool {
+	return !p.useOptionE() && p.charsRight() > 0 && p.rightChar(0) == 'Q'
+}
+
+// Scans the text of a \Q...\E quote, the \Q having been consumed, and
+// returns where the quoted text starts and ends.  A quote without \E runs
+// to the end of the pattern.
+func (p *parser) scanQuoted() (start, end int) {
+	start = p.textpos()
+	for p.charsRight() > 0 {
+		if p.charsRight() > 1 && p.rightChar(0) == '\\' && p.rightChar(1) == 'E' {
+			end = p.textpos()
+			p.moveRight(2)
+			return start, end
+		}
+		p.moveRight(1)
+	}
+	return start, p.textpos()
+}
+
 // scans backslash specials and basics
 func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 
@@ -1117,24 +1619,71 @@ func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 	switch ch := p.rightChar(0); ch {
 	case 'b', 'B', 'A', 'G', 'Z', 'z':
 		p.moveRight(1)
+		if t, ok := p.scanBoundaryKind(ch); ok {
+			return newRegexNode(t, p.options), nil
+		}
 		return newRegexNode(p.typeFromCode(ch), p.options), nil
 
-	case 'w':
+	case 'X':
+		if p.useOptionE() {
+			return p.scanBasicBackslash(scanOnly)
+		}
 		p.moveRight(1)
+		return newRegexNode(ntGrapheme, p.options), nil
+
+	case 'R':
 		if p.useOptionE() {
+			return p.scanBasicBackslash(scanOnly)
+		}
+		p.moveRight(1)
+		return p.newlineSequenceNode(), nil
+
+	case 'N':
+		// \N{NAME} is a char, a bare \N (or one with a quantifier) is [^\n]
+		if p.useOptionE() {
+			return p.scanBasicBackslash(scanOnly)
+		}
+		p.moveRight(1)
+		if p.charsRight() > 0 && p.rightChar(0) == '{' && !p.isTrueQuantifier() {
+			p.moveLeft()
+			return p.scanBasicBackslash(scanOnly)
+		}
+		return newRegexNodeCh(ntNotone, p.options, '\n'), nil
+
+	case 'h', 'H', 'v', 'V':
+		if !p.isSpaceEscape(ch) {
+			return p.scanBasicBackslash(scanOnly)
+		}
+		p.moveRight(1)
+		switch ch {
+		case 'h':
+			return newRegexNodeSet(ntSet, p.options, HorizSpaceClass()), nil
+		case 'H':
+			return newRegexNodeSet(ntSet, p.options, NotHorizSpaceClass()), nil
+		case 'v':
+			return newRegexNodeSet(ntSet, p.options, VertSpaceClass()), nil
+		}
+		return newRegexNodeSet(ntSet, p.options, NotVertSpaceClass()), nil
+
+	case 'w':
+		p.moveRight(1)
+		if p.useASCIIClasses() {
 			return newRegexNodeSet(ntSet, p.options, ECMAWordClass()), nil
 		}
 		return newRegexNodeSet(ntSet, p.options, WordClass()), nil
 
 	case 'W':
 		p.moveRight(1)
-		if p.useOptionE() {
+		if p.useASCIIClasses() {
 			return newRegexNodeSet(ntSet, p.options, NotECMAWordClass()), nil
 		}
 		return newRegexNodeSet(ntSet, p.options, NotWordClass()), nil
 
 	case 's':
 		p.moveRight(1)
+		if p.useASCIISpace() {
+			return newRegexNodeSet(ntSet, p.options, PCRESpaceClass()), nil
+		}
 		if p.useOptionE() {
 			return newRegexNodeSet(ntSet, p.options, ECMASpaceClass()), nil
 		}
@@ -1142,6 +1691,9 @@ func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 
 	case 'S':
 		p.moveRight(1)
+		if p.useASCIISpace() {
+			return newRegexNodeSet(ntSet, p.options, NotPCRESpaceClass()), nil
+		}
 		if p.useOptionE() {
 			return newRegexNodeSet(ntSet, p.options, NotECMASpaceClass()), nil
 		}
@@ -1149,14 +1701,14 @@ func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 
 	case 'd':
 		p.moveRight(1)
-		if p.useOptionE() {
+		if p.useASCIIClasses() {
 			return newRegexNodeSet(ntSet, p.options, ECMADigitClass()), nil
 		}
 		return newRegexNodeSet(ntSet, p.options, DigitClass()), nil
 
 	case 'D':
 		p.moveRight(1)
-		if p.useOptionE() {
+		if p.useASCIIClasses() {
 			return newRegexNodeSet(ntSet, p.options, NotECMADigitClass()), nil
 		}
 		return newRegexNodeSet(ntSet, p.options, NotDigitClass()), nil
@@ -1170,7 +1722,7 @@ func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 		cc := &CharSet{}
 		cc.addCategory(prop, (ch != 'p'), p.useOptionI(), p.patternRaw)
 		if p.useOptionI() {
-			cc.addLowercase()
+			cc.addLowercase(p.specialCase, p.useOptionInvariant())
 		}
 
 		return newRegexNodeSet(ntSet, p.options, cc), nil
@@ -1180,6 +1732,17 @@ func (p *parser) scanBackslash(scanOnly bool) (*regexNode, error) {
 	}
 }
 
+// newlineSequenceNode builds \R, which is (?>\r\n|[\n\v\f\r\x85\u2028\u2029]);
+// the group is atomic so a \r\n never gets split up on backtracking
+func (p *parser) newlineSequenceNode() *regexNode {
+	alt := newRegexNode(ntAlternate, p.options)
+	alt.addChild(newRegexNodeStr(ntMulti, p.options, []rune("\r\n")))
+	alt.addChild(newRegexNodeSet(ntSet, p.options, VertSpaceClass()))
+	atomic := newRegexNode(ntGreedy, p.options)
+	atomic.addChild(alt)
+	return atomic
+}
+
 // Scans \-style backreferences and character escapes
 func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 	if p.charsRight() == 0 {
@@ -1191,6 +1754,10 @@ func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 	backpos := p.textpos()
 	ch := p.rightChar(0)
 
+	if ch == 'g' && p.usePCRE2() {
+		return p.scanGReference(scanOnly)
+	}
+
 	// allow \k<foo> instead of \<foo>, which is now deprecated
 
 	if ch == 'k' {
@@ -1205,6 +1772,10 @@ func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 				} else {
 					close = '>'
 				}
+			} else if ch == '{' && p.usePCRE2() {
+				// PCRE's \k{name}
+				angled = true
+				close = '}'
 			}
 		}
 
@@ -1262,7 +1833,7 @@ func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 
 		if p.charsRight() > 0 && p.moveRightGetChar() == close {
 			if p.isCaptureName(capname) {
-				return newRegexNodeM(ntRef, p.options, p.captureSlotFromName(capname)), nil
+				return p.refNode(capname), nil
 			}
 			return nil, p.getErr(ErrUndefinedNameRef, capname)
 		}
@@ -1277,7 +1848,7 @@ func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 	}
 
 	if p.useOptionI() {
-		ch = unicode.ToLower(ch)
+		ch = p.fold(ch)
 	}
 
 	return newRegexNodeCh(ntOne, p.options, ch), nil
@@ -1285,6 +1856,14 @@ func (p *parser) scanBasicBackslash(scanOnly bool) (*regexNode, error) {
 
 // Scans X for \p{X} or \P{X}
 func (p *parser) parseProperty() (string, error) {
+	if p.useJava() && p.charsRight() > 0 && p.rightChar(0) != '{' {
+		// Java's one letter category names, \pL
+		ch := p.moveRightGetChar()
+		if _, ok := unicode.Categories[string(ch)]; !ok {
+			return "", p.getErr(ErrMalformedSlashP)
+		}
+		return string(ch), nil
+	}
 	if p.charsRight() < 3 {
 		return "", p.getErr(ErrIncompleteSlashP)
 	}
@@ -1296,7 +1875,7 @@ func (p *parser) parseProperty() (string, error) {
 	startpos := p.textpos()
 	for p.charsRight() > 0 {
 		ch = p.moveRightGetChar()
-		if !(IsWordChar(ch) || ch == '-') {
+		if !(IsWordChar(ch) || ch == '-' || ch == '=' || (ch == ':' && p.usePCRE2())) {
 			p.moveLeft()
 			break
 		}
@@ -1307,23 +1886,51 @@ func (p *parser) parseProperty() (string, error) {
 		return "", p.getErr(ErrIncompleteSlashP)
 	}
 
-	if !isValidUnicodeCat(capname) {
+	name, ok := capname, false
+	switch {
+	case strings.ContainsAny(capname, "=:") && !p.useOptionE():
+		name, ok = scriptPropertyName(capname)
+	case p.useOptionE():
+		name, ok = ecmaPropertyName(capname)
+	case p.useJava():
+		name, ok = javaPropertyName(capname)
+	default:
+		ok = isValidUnicodeCat(capname) && javaProperties[capname] == nil && !ecmaOnlyCategories[capname]
+	}
+	if !ok {
+		if p.useUnicodeSets() && unsupportedStringProperties[capname] {
+			return "", p.getErr(ErrUnsupportedStringProp, capname)
+		}
 		return "", p.getErr(ErrUnknownSlashP, capname)
 	}
 
-	return capname, nil
+	return name, nil
+}
+
+// scriptPropertyName resolves a \p{sc=Name} or \p{scx=Name}, also spelled
+// Script=Name and Script_Extensions=Name, or with a : in PCRE2
+func scriptPropertyName(name string) (string, bool) {
+	i := strings.IndexAny(name, "=:")
+	prop, value := name[:i], name[i+1:]
+	switch prop {
+	case "sc", "Script":
+		return scriptName(value)
+	case "scx", "Script_Extensions":
+		return scriptExtensionsName(value)
+	}
+	return "", false
 }
 
 // Returns ReNode type for zero-length assertions with a \ code.
 func (p *parser) typeFromCode(ch rune) nodeType {
 	switch ch {
 	case 'b':
-		if p.useOptionE() {
+		if p.useASCIIClasses() {
 			return ntECMABoundary
 		}
 		return ntBoundary
 	case 'B':
-		if p.useOptionE() {
+		if p.useASCIIClasses() {
 			return ntNonECMABoundary
 		}
 		return ntNonboundary
@@ -1332,6 +1939,10 @@ func (p *parser) typeFromCode(ch rune) nodeType {
 	case 'G':
 		return ntStart
 	case 'Z':
+		if p.usePython() {
+			// Python's \Z is only the very end
+			return ntEnd
+		}
 		return ntEndZ
 	case 'z':
 		return ntEnd
@@ -1340,6 +1951,29 @@ func (p *parser) typeFromCode(ch rune) nodeType {
 	}
 }
 
+// Scans the {wb} or {g} of a \b{wb} word or \b{g} grapheme cluster
+// boundary, returning the node type for it.
+func (p *parser) scanBoundaryKind(ch rune) (nodeType, bool) {
+	if (ch != 'b' && ch != 'B') || p.useOptionE() || p.charsRight() < 3 || p.rightChar(0) != '{' {
+		return 0, false
+	}
+	switch {
+	case p.charsRight() >= 4 && p.rightChar(1) == 'w' && p.rightChar(2) == 'b' && p.rightChar(3) == '}':
+		p.moveRight(4)
+		if ch == 'B' {
+			return ntNonWordSegBoundary, true
+		}
+		return ntWordSegBoundary, true
+	case p.rightChar(1) == 'g' && p.rightChar(2) == '}':
+		p.moveRight(3)
+		if ch == 'B' {
+			return ntNonGraphemeBoundary, true
+		}
+		return ntGraphemeBoundary, true
+	}
+	return 0, false
+}
+
 // Scans whitespace or x-mode comments.
 func (p *parser) scanBlank() error {
 	if p.useOptionX() {
@@ -1358,6 +1992,7 @@ func (p *parser) scanBlank() error {
 				}
 			} else if p.charsRight() >= 3 && p.rightChar(2) == '#' &&
 				p.rightChar(1) == '?' && p.rightChar(0) == '(' {
+				p.tokenPos = p.textpos()
 				for p.charsRight() > 0 && p.rightChar(0) != ')' {
 					p.moveRight(1)
 				}
@@ -1375,6 +2010,7 @@ func (p *parser) scanBlank() error {
 				p.rightChar(1) != '?' || p.rightChar(0) != '(' {
 				return nil
 			}
+			p.tokenPos = p.textpos()
 
 			for p.charsRight() > 0 && p.rightChar(0) != ')' {
 				p.moveRight(1)
@@ -1403,11 +2039,13 @@ func (p *parser) scanCapname() string {
 
 //Scans contents of [] (not including []'s), and converts to a set.
 func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
+	start := p.textpos() - 1 // the [
 	ch := '\x00'
 	chPrev := '\x00'
 	inRange := false
 	firstChar := true
 	closed := false
+	quoted := false
 
 	var cc *CharSet
 	if !scanOnly {
@@ -1423,8 +2061,24 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 
 	for ; p.charsRight() > 0; firstChar = false {
 		fTranslatedChar := false
+		if !inRange {
+			p.tokenPos = p.textpos()
+		}
 		ch = p.moveRightGetChar()
-		if ch == ']' {
+		if quoted {
+			if ch == '\\' && p.charsRight() > 0 && p.rightChar(0) == 'E' {
+				p.moveRight(1)
+				quoted = false
+				continue
+			}
+			// inside \Q...\E everything is a literal member
+			fTranslatedChar = true
+			if p.charsRight() >= 4 && p.rightChar(0) == '\\' && p.rightChar(1) == 'E' && p.rightChar(2) == '-' && p.rightChar(3) != ']' {
+				// the last quoted char starts a range: [\Qa\E-z]
+				p.moveRight(2)
+				quoted = false
+			}
+		} else if ch == ']' {
 			if !firstChar {
 				closed = true
 				break
@@ -1437,13 +2091,36 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 			}
 
 		} else if ch == '\\' && p.charsRight() > 0 {
+			if p.isQuoteStart() {
+				p.moveRight(1)
+				quoted = true
+				continue
+			}
+			if !p.useOptionE() && p.rightChar(0) == 'E' {
+				p.moveRight(1)
+				continue
+			}
+			if p.isSpaceEscape(p.rightChar(0)) {
+				ch = p.moveRightGetChar()
+				if !scanOnly {
+					if inRange {
+						return nil, p.getErr(ErrBadClassInCharRange, ch)
+					}
+					if ch == 'h' || ch == 'H' {
+						cc.addHorizSpace(ch == 'H')
+					} else {
+						cc.addVertSpace(ch == 'V')
+					}
+				}
+				continue
+			}
 			switch ch = p.moveRightGetChar(); ch {
 			case 'D', 'd':
 				if !scanOnly {
 					if inRange {
 						return nil, p.getErr(ErrBadClassInCharRange, ch)
 					}
-					cc.addDigit(p.useOptionE(), ch == 'D', p.patternRaw)
+					cc.addDigit(p.useASCIIClasses(), ch == 'D', p.patternRaw)
 				}
 				continue
 
@@ -1452,7 +2129,11 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 					if inRange {
 						return nil, p.getErr(ErrBadClassInCharRange, ch)
 					}
-					cc.addSpace(p.useOptionE(), ch == 'S')
+					if p.useASCIISpace() {
+						cc.addPCRESpace(ch == 'S')
+					} else {
+						cc.addSpace(p.useOptionE(), ch == 'S')
+					}
 				}
 				continue
 
@@ -1462,7 +2143,7 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 						return nil, p.getErr(ErrBadClassInCharRange, ch)
 					}
 
-					cc.addWord(p.useOptionE(), ch == 'W')
+					cc.addWord(p.useASCIIClasses(), ch == 'W')
 				}
 				continue
 
@@ -1498,9 +2179,48 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 				fTranslatedChar = true
 				break // this break will only break out of the switch
 			}
-		} else if ch == '[' {
-			// This is code for Posix style properties - [:Ll:] or [:IsTibetan:].
-			// It currently doesn't do anything other than skip the whole thing!
+		} else if ch == '[' && p.useJava() {
+			// Java nests classes, [a-c[x-z]] is the union of both
+			if inRange {
+				inRange = false
+				if !scanOnly {
+					cc.addRange(chPrev, chPrev)
+					cc.addChar('-')
+				}
+			}
+			set, err := p.scanCharSet(caseInsensitive, scanOnly)
+			if err != nil {
+				return nil, err
+			}
+			if !scanOnly {
+				cc.addNested(set)
+			}
+			continue
+		} else if ch == '&' && p.useJava() && !inRange && p.charsRight() > 0 && p.rightChar(0) == '&' {
+			// Java intersection, [a-z&&[^aeiou]]; the rest of the class
+			// is the right hand side, so it also takes our closing ]
+			p.moveRight(1)
+			if p.charsRight() > 0 && p.rightChar(0) == ']' {
+				// nothing to intersect with, [a-z&&] is [a-z]
+				continue
+			}
+			right, err := p.scanCharSet(caseInsensitive, scanOnly)
+			if err != nil || scanOnly {
+				return nil, err
+			}
+			if caseInsensitive {
+				cc.addLowercase(p.specialCase, p.useOptionInvariant())
+			}
+			negate := cc.negate
+			cc.negate = false
+			set := intersectSets(cc, right)
+			if negate {
+				set = complementSet(set)
+			}
+			return set, nil
+		} else if ch == '[' && p.usePOSIXClasses() {
+			// POSIX bracket expressions, [[:alpha:]] and [[:^digit:]];
+			// without the closing :] the [ is just a member
 			if p.charsRight() > 0 && p.rightChar(0) == ':' && !inRange {
 				savePos := p.textpos()
 
@@ -1512,17 +2232,17 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 				}
 
 				nm := p.scanCapname() // snag the name
-				if !scanOnly && p.useRE2() {
-					// look up the name since these are valid for RE2
-					// add the group based on the name
-					if ok := cc.addNamedASCII(nm, negate); !ok {
-						return nil, p.getErr(ErrInvalidCharRange)
-					}
-				}
 				if p.charsRight() < 2 || p.moveRightGetChar() != ':' || p.moveRightGetChar() != ']' {
 					p.textto(savePos)
-				} else if p.useRE2() {
-					// move on
+				} else {
+					if !scanOnly {
+						if isRegistered(nm) {
+							// one of the application's own, by way of RegisterClass
+							cc.addCategory(nm, negate, caseInsensitive, p.patternRaw)
+						} else if ok := cc.addNamedASCII(nm, negate); !ok {
+							return nil, p.getErr(ErrUnknownPOSIXClass, nm)
+						}
+					}
 					continue
 				}
 			}
@@ -1531,7 +2251,7 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 		if inRange {
 			inRange = false
 			if !scanOnly {
-				if ch == '[' && !fTranslatedChar && !firstChar {
+				if ch == '[' && !fTranslatedChar && !firstChar && p.useDotNetClasses() {
 					// We thought we were in a range, but we're actually starting a subtraction.
 					// In that case, we'll add chPrev to our char class, skip the opening [, and
 					// scan the new character class recursively.
@@ -1543,7 +2263,7 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 					cc.addSubtraction(sub)
 
 					if p.charsRight() > 0 && p.rightChar(0) != ']' {
-						return nil, p.getErr(ErrSubtractionMustBeLast)
+						return nil, p.getErrAt(p.textpos(), ErrSubtractionMustBeLast)
 					}
 				} else {
 					// a regular range, like a-z
@@ -1553,12 +2273,12 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 					cc.addRange(chPrev, ch)
 				}
 			}
-		} else if p.charsRight() >= 2 && p.rightChar(0) == '-' && p.rightChar(1) != ']' {
+		} else if !quoted && p.charsRight() >= 2 && p.rightChar(0) == '-' && p.rightChar(1) != ']' {
 			// this could be the start of a range
 			chPrev = ch
 			inRange = true
 			p.moveRight(1)
-		} else if p.charsRight() >= 1 && ch == '-' && !fTranslatedChar && p.rightChar(0) == '[' && !firstChar {
+		} else if p.charsRight() >= 1 && ch == '-' && !fTranslatedChar && p.rightChar(0) == '[' && !firstChar && p.useDotNetClasses() {
 			// we aren't in a range, and now there is a subtraction.  Usually this happens
 			// only when a subtraction follows a range, like [a-z-[b]]
 			if !scanOnly {
@@ -1570,7 +2290,7 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 				cc.addSubtraction(sub)
 
 				if p.charsRight() > 0 && p.rightChar(0) != ']' {
-					return nil, p.getErr(ErrSubtractionMustBeLast)
+					return nil, p.getErrAt(p.textpos(), ErrSubtractionMustBeLast)
 				}
 			} else {
 				p.moveRight(1)
@@ -1584,11 +2304,11 @@ func (p *parser) scanCharSet(caseInsensitive, scanOnly bool) (*CharSet, error) {
 	}
 
 	if !closed {
-		return nil, p.getErr(ErrUnterminatedBracket)
+		return nil, p.getErrAt(start, ErrUnterminatedBracket)
 	}
 
 	if !scanOnly && caseInsensitive {
-		cc.addLowercase()
+		cc.addLowercase(p.specialCase, p.useOptionInvariant())
 	}
 
 	return cc, nil
@@ -1634,6 +2354,28 @@ func (p *parser) scanOptions() {
 			off = false
 		} else {
 			option := optionFromCode(ch)
+			if p.usePython() {
+				// (?a) is ASCII matching, (?u) the default Unicode matching
+				switch ch {
+				case 'a':
+					option = asciiOnly
+				case 'u':
+					continue
+				}
+			} else if ch == 'J' {
+				// PCRE's, but patterns written for it use it without
+				// PCRE2 mode too
+				option = DupNames
+			} else if p.useJava() {
+				// (?U) is Unicode classes; (?d) only has \n end lines and
+				// (?u) folds case by Unicode rules, which we always do
+				switch ch {
+				case 'U':
+					option = unicodeClasses
+				case 'd', 'u':
+					continue
+				}
+			}
 			if option == 0 || isOnlyTopOption(option) {
 				return
 			}
@@ -1652,6 +2394,10 @@ func (p *parser) scanCharEscape() (rune, error) {
 
 	ch := p.moveRightGetChar()
 
+	if ch == '0' && p.useJava() {
+		return p.scanJavaOctal()
+	}
+
 	if ch >= '0' && ch <= '7' {
 		p.moveLeft()
 		return p.scanOctal(), nil
@@ -1665,8 +2411,41 @@ func (p *parser) scanCharEscape() (rune, error) {
 			return p.scanHexUntilBrace()
 		}
 		return p.scanHex(2)
+	case 'o':
+		if p.usePCRE2() && p.charsRight() > 0 && p.rightChar(0) == '{' {
+			p.moveRight(1)
+			return p.scanOctalUntilBrace()
+		}
+		if !p.useOptionE() {
+			return 0, p.getErr(ErrUnrecognizedEscape, string(ch))
+		}
+		return ch, nil
 	case 'u':
+		if p.useOptionE() {
+			return p.scanECMAUnicode()
+		}
 		return p.scanHex(4)
+	case 'U':
+		if p.usePython() {
+			r, err := p.scanHex(8)
+			if err == nil && r > unicode.MaxRune {
+				return 0, p.getErr(ErrInvalidHex)
+			}
+			return r, err
+		}
+		if !p.useOptionE() {
+			return 0, p.getErr(ErrUnrecognizedEscape, string(ch))
+		}
+		return ch, nil
+	case 'N':
+		if !p.useOptionE() && p.charsRight() > 0 && p.rightChar(0) == '{' {
+			p.moveRight(1)
+			return p.scanCharName()
+		}
+		if !p.useOptionE() {
+			return 0, p.getErr(ErrUnrecognizedEscape, string(ch))
+		}
+		return ch, nil
 	case 'a':
 		return '\u0007', nil
 	case 'b':
@@ -1693,6 +2472,25 @@ func (p *parser) scanCharEscape() (rune, error) {
 	}
 }
 
+// Scans the NAME} of a \N{NAME} escape
+func (p *parser) scanCharName() (rune, error) {
+	start := p.textpos()
+	for p.charsRight() > 0 && p.rightChar(0) != '}' {
+		p.moveRight(1)
+	}
+	if p.charsRight() == 0 || p.textpos() == start {
+		return 0, p.getErr(ErrMalformedCharName)
+	}
+	name := string(p.pattern[start:p.textpos()])
+	p.moveRight(1)
+
+	r, ok := lookupCharName(name)
+	if !ok {
+		return 0, p.getErr(ErrUnknownCharName, name)
+	}
+	return r, nil
+}
+
 // Grabs and converts an ascii control character
 func (p *parser) scanControl() (rune, error) {
 	if p.charsRight() <= 0 {
@@ -1795,6 +2593,78 @@ func hexDigit(ch rune) int {
 }
 
 // Scans up to three octal digits (stops before exceeding 0377).
+// Scans \o{...} octal digits up to the closing brace, as in PCRE2
+func (p *parser) scanOctalUntilBrace() (rune, error) {
+	i := 0
+	hasContent := false
+
+	for p.charsRight() > 0 {
+		ch := p.moveRightGetChar()
+		if ch == '}' {
+			if !hasContent {
+				return 0, p.getErr(ErrTooFewOctal)
+			}
+			return rune(i), nil
+		}
+		hasContent = true
+		if ch < '0' || ch > '7' {
+			return 0, p.getErr(ErrMissingBrace)
+		}
+
+		i = i*8 + int(ch-'0')
+		if i > unicode.MaxRune {
+			return 0, p.getErr(ErrInvalidHex)
+		}
+	}
+
+	return 0, p.getErr(ErrMissingBrace)
+}
+
+// Scans what follows an ECMAScript \u: either \u{HEX}, or four hex digits
+// that are joined with a following \uXXXX when the two are a surrogate pair
+func (p *parser) scanECMAUnicode() (rune, error) {
+	if p.charsRight() > 0 && p.rightChar(0) == '{' {
+		p.moveRight(1)
+		return p.scanHexUntilBrace()
+	}
+	r, err := p.scanHex(4)
+	if err != nil || !utf16.IsSurrogate(r) || r >= 0xDC00 {
+		return r, err
+	}
+	if p.charsRight() >= 6 && p.rightChar(0) == '\\' && p.rightChar(1) == 'u' {
+		pos := p.textpos()
+		p.moveRight(2)
+		if lo, err := p.scanHex(4); err == nil && lo >= 0xDC00 && lo <= 0xDFFF {
+			return utf16.DecodeRune(r, lo), nil
+		}
+		p.textto(pos)
+	}
+	return r, nil
+}
+
+// Scans the digits of a Java \0n, \0nn or \0mnn octal escape (m <= 3)
+func (p *parser) scanJavaOctal() (rune, error) {
+	c := 3
+	if p.charsRight() > 0 && p.rightChar(0) > '3' {
+		c = 2
+	}
+
+	i, n := 0, 0
+	for ; n < c && p.charsRight() > 0; n++ {
+		d := int(p.rightChar(0) - '0')
+		if d < 0 || d > 7 {
+			break
+		}
+		p.moveRight(1)
+		i = i*8 + d
+	}
+	if n == 0 {
+		return 0, p.getErr(ErrTooFewOctal)
+	}
+
+	return rune(i), nil
+}
+
 func (p *parser) scanOctal() rune {
 	// Consume octal chars only up to 3 digits and value 0377
 
@@ -1881,6 +2751,24 @@ func (p *parser) captureSlotFromName(capname string) int {
 	return p.capnames[capname]
 }
 
+// refNode is a backreference to the group called capname, or when
+// DupNames has given more than one group that name, to the first of them
+// that has matched, as (?(1)\1|(?(3)\3|\5))
+func (p *parser) refNode(capname string) *regexNode {
+	slots := p.dupnames[capname]
+	if slots == nil {
+		return newRegexNodeM(ntRef, p.options, p.captureSlotFromName(capname))
+	}
+	n := newRegexNodeM(ntRef, p.options, slots[len(slots)-1])
+	for i := len(slots) - 2; i >= 0; i-- {
+		test := newRegexNodeM(ntTestref, p.options, slots[i])
+		test.addChild(newRegexNodeM(ntRef, p.options, slots[i]))
+		test.addChild(n)
+		n = test
+	}
+	return n
+}
+
 // True if the capture slot was noted
 func (p *parser) isCaptureSlot(i int) bool {
 	if p.caps != nil {
@@ -1913,6 +2801,11 @@ func (p *parser) useOptionI() bool {
 	return (p.options & IgnoreCase) != 0
 }
 
+// True if CultureInvariant option, casing with no culture's rules, is on.
+func (p *parser) useOptionInvariant() bool {
+	return (p.options & CultureInvariant) != 0
+}
+
 // True if M option altering meaning of $ and ^ is on.
 func (p *parser) useOptionM() bool {
 	return (p.options & Multiline) != 0
@@ -1933,11 +2826,79 @@ func (p *parser) useOptionE() bool {
 	return (p.options & ECMAScript) != 0
 }
 
+// true to expand case conversions in replacement patterns
+func (p *parser) useOptionCase() bool {
+	return (p.options & CaseConversion) != 0
+}
+
 // true to use RE2 compatibility parsing behavior.
 func (p *parser) useRE2() bool {
 	return (p.options & RE2) != 0
 }
 
+// true to use PCRE2 compatibility parsing behavior.
+func (p *parser) usePCRE2() bool {
+	return (p.options & PCRE2) != 0
+}
+
+// True if J option giving groups with the same name numbers of their own is on.
+func (p *parser) useDupNames() bool {
+	return (p.options & DupNames) != 0
+}
+
+// true to use Python compatibility parsing behavior.
+func (p *parser) usePython() bool {
+	return (p.options & Python) != 0
+}
+
+// true to use Java compatibility parsing behavior.
+func (p *parser) useJava() bool {
+	return (p.options & Java) != 0
+}
+
+// true to parse classes with the ECMAScript v flag syntax
+func (p *parser) useUnicodeSets() bool {
+	return (p.options & UnicodeSets) != 0
+}
+
+// true if \d, \w and \b only know about ASCII
+func (p *parser) useASCIIClasses() bool {
+	return (p.options&(ECMAScript|PCRE2|Java|asciiOnly)) != 0 && (p.options&unicodeClasses) == 0
+}
+
+// true if \s is ASCII-only [\t\n\v\f\r ]; ECMAScript has its own \s
+func (p *parser) useASCIISpace() bool {
+	return (p.options&(PCRE2|Java|asciiOnly)) != 0 && (p.options&unicodeClasses) == 0
+}
+
+// true if class subtraction [a-z-[aeiou]] and balancing groups are recognized
+func (p *parser) useDotNetClasses() bool {
+	return (p.options & (PCRE2 | Java)) == 0
+}
+
+// true if (?P<name>...) and (?P=name) are recognized
+func (p *parser) usePNames() bool {
+	return (p.options & (RE2 | PCRE2 | Python)) != 0
+}
+
+// true if ch after a \ is one of the \h \H \v \V space classes; \v is
+// only one in PCRE2 and Java, elsewhere it's the vertical tab
+func (p *parser) isSpaceEscape(ch rune) bool {
+	switch ch {
+	case 'h', 'H', 'V':
+		return !p.useOptionE()
+	case 'v':
+		return (p.options & (PCRE2 | Java)) != 0
+	}
+	return false
+}
+
+// true if [[:alpha:]] style classes are recognized; in ECMAScript, Python
+// and Java [[:alpha:]] is a class holding [, :, a, l, p and h followed by ]
+func (p *parser) usePOSIXClasses() bool {
+	return (p.options & (ECMAScript | Python | Java)) == 0
+}
+
 // True if options stack is empty.
 func (p *parser) emptyOptionsStack() bool {
 	return len(p.optionsStack) == 0
@@ -1950,16 +2911,39 @@ func (p *parser) addConcatenate() {
 	p.unit = nil
 }
 
-// Finish the current quantifiable (when a quantifier is found)
-func (p *parser) addConcatenate3(lazy bool, min, max int) {
-	p.concatenation.addChild(p.unit.makeQuantifier(lazy, min, max))
+// Finish the current quantifiable (when a quantifier is found), which
+// starts at pos in the pattern
+func (p *parser) addConcatenate3(lazy bool, min, max, pos int) {
+	p.concatenation.addChild(p.quantify(lazy, min, max, pos))
 	p.unit = nil
 }
 
+// Finish the current quantifiable with a possessive quantifier, which is
+// shorthand for wrapping the greedy quantifier in an atomic group
+func (p *parser) addConcatenatePossessive(min, max, pos int) {
+	atomic := newRegexNode(ntGreedy, p.options)
+	atomic.addChild(p.quantify(false, min, max, pos))
+	p.concatenation.addChild(atomic)
+	p.unit = nil
+}
+
+// quantify applies a quantifier to the current unit, noting the span of
+// pattern from pos to the end of the quantifier
+func (p *parser) quantify(lazy bool, min, max, pos int) *regexNode {
+	q := p.unit.makeQuantifier(lazy, min, max)
+	q.pos, q.end = pos, p.textpos()
+	return q
+}
+
+// fold case folds ch the way IgnoreCase compares it
+func (p *parser) fold(ch rune) rune {
+	return CaseFoldCulture(ch, p.specialCase)
+}
+
 // Sets the current unit to a single char node
 func (p *parser) addUnitOne(ch rune) {
 	if p.useOptionI() {
-		ch = unicode.ToLower(ch)
+		ch = p.fold(ch)
 	}
 
 	p.unit = newRegexNodeCh(ntOne, p.options, ch)
@@ -1968,7 +2952,7 @@ func (p *parser) addUnitOne(ch rune) {
 // Sets the current unit to a single inverse-char node
 func (p *parser) addUnitNotone(ch rune) {
 	if p.useOptionI() {
-		ch = unicode.ToLower(ch)
+		ch = p.fold(ch)
 	}
 
 	p.unit = newRegexNodeCh(ntNotone, p.options, ch)
@@ -2041,7 +3025,7 @@ func (p *parser) addToConcatenate(pos, cch int, isReplacement bool) {
 			// linguistically, but since Regex doesn't support surrogates, it's more important to be
 			// consistent.
 			for i := 0; i < len(str); i++ {
-				str[i] = unicode.ToLower(str[i])
+				str[i] = p.fold(str[i])
 			}
 		}
 
@@ -2050,7 +3034,7 @@ func (p *parser) addToConcatenate(pos, cch int, isReplacement bool) {
 		ch := p.charAt(pos)
 
 		if p.useOptionI() && !isReplacement {
-			ch = unicode.ToLower(ch)
+			ch = p.fold(ch)
 		}
 
 		node = newRegexNodeCh(ntOne, p.options, ch)
@@ -2061,6 +3045,9 @@ func (p *parser) addToConcatenate(pos, cch int, isReplacement bool) {
 
 // Push the parser state (in response to an open paren)
 func (p *parser) pushGroup() {
+	if p.depth++; p.depth > p.maxDepth {
+		p.maxDepth = p.depth
+	}
 	p.group.next = p.stack
 	p.alternation.next = p.group
 	p.concatenation.next = p.alternation
@@ -2069,6 +3056,7 @@ func (p *parser) pushGroup() {
 
 // Remember the pushed state (in response to a ')')
 func (p *parser) popGroup() error {
+	p.depth--
 	p.concatenation = p.stack
 	p.alternation = p.concatenation.next
 	p.group = p.alternation.next
@@ -2091,6 +3079,11 @@ func (p *parser) emptyStack() bool {
 	return p.stack == nil
 }
 
+// Tells whether nothing but option groups has been parsed so far
+func (p *parser) atPatternStart() bool {
+	return p.emptyStack() && p.unit == nil && len(p.alternation.children) == 0 && len(p.concatenation.children) == 0
+}
+
 // Start a new round for the parser state (in response to an open paren or string start)
 func (p *parser) startGroup(openGroup *regexNode) {
 	p.group = openGroup
@@ -2098,6 +3091,37 @@ func (p *parser) startGroup(openGroup *regexNode) {
 	p.concatenation = newRegexNode(ntConcatenate, p.options)
 }
 
+// Starts a (?|...) group; called with the options for the group pushed
+func (p *parser) startBranchReset() {
+	p.branchResets = append(p.branchResets, branchReset{
+		depth: len(p.optionsStack),
+		start: p.autocap,
+		max:   p.autocap,
+	})
+}
+
+// Rewinds the capture numbering if the | belongs to a (?|...) group
+func (p *parser) nextBranchReset() {
+	if n := len(p.branchResets); n > 0 && p.branchResets[n-1].depth == len(p.optionsStack) {
+		b := &p.branchResets[n-1]
+		if p.autocap > b.max {
+			b.max = p.autocap
+		}
+		p.autocap = b.start
+	}
+}
+
+// Continues numbering after the widest alternative if the ) closes a
+// (?|...) group; called before the group's options are popped
+func (p *parser) endBranchReset() {
+	if n := len(p.branchResets); n > 0 && p.branchResets[n-1].depth == len(p.optionsStack) {
+		if b := p.branchResets[n-1]; b.max > p.autocap {
+			p.autocap = b.max
+		}
+		p.branchResets = p.branchResets[:n-1]
+	}
+}
+
 // Finish the current concatenation (in response to a |)
 func (p *parser) addAlternate() {
 	// The | parts inside a Testgroup group go directly to the group
@@ -2177,7 +3201,8 @@ func (p *parser) isTrueQuantifier() bool {
 		}
 	}
 
-	if nChars == 0 || pos-startpos == 1 {
+	if nChars == 0 || (pos-startpos == 1 && !(ch == ',' && p.usePython())) {
+		// Python reads {,n} as {0,n}
 		return false
 	}
 	if ch == '}' {
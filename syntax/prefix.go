@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
-	"unicode"
 	"unicode/utf8"
 )
 
@@ -261,11 +260,16 @@ func (s *regexFcd) calculateFC(nt nodeType, node *regexNode, CurIndex int) {
 		s.pushFC(regexFc{cc: node.set.Copy(), nullable: node.m == 0, caseInsensitive: ci})
 		break
 
-	case ntRef:
+	case ntRef, ntCall:
 		s.pushFC(regexFc{cc: *AnyClass(), nullable: true, caseInsensitive: false})
 		break
 
-	case ntNothing, ntBol, ntEol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary, ntBeginning, ntStart, ntEndZ, ntEnd:
+	case ntGrapheme:
+		s.pushFC(regexFc{cc: *AnyClass(), nullable: false, caseInsensitive: false})
+		break
+
+	case ntNothing, ntBol, ntEol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary, ntBeginning, ntStart, ntEndZ, ntEnd, ntVerb,
+		ntWordSegBoundary, ntNonWordSegBoundary, ntGraphemeBoundary, ntNonGraphemeBoundary:
 		s.pushFC(regexFc{nullable: true})
 		break
 
@@ -300,7 +304,8 @@ func newRegexFc(ch rune, not, nullable, caseInsensitive bool) regexFc {
 
 func (r *regexFc) getFirstChars() CharSet {
 	if r.caseInsensitive {
-		r.cc.addLowercase()
+		// the chars are folded already, with the culture if there is one
+		r.cc.addLowercase(nil, false)
 	}
 
 	return r.cc
@@ -442,7 +447,7 @@ func newBmPrefix(pattern []rune, caseInsensitive, rightToLeft bool) *BmPrefix {
 			// linguistically, but since Regex doesn't support surrogates, it's more important to be
 			// consistent.
 
-			b.pattern[i] = unicode.ToLower(b.pattern[i])
+			b.pattern[i] = CaseFold(b.pattern[i])
 		}
 	}
 
@@ -603,6 +608,12 @@ Outerloop:
 	return b
 }
 
+// CaseInsensitive reports whether the prefix is matched ignoring case,
+// in which case String returns its lowercased form
+func (b *BmPrefix) CaseInsensitive() bool {
+	return b.caseInsensitive
+}
+
 func (b *BmPrefix) String() string {
 	return string(b.pattern)
 }
@@ -670,7 +681,7 @@ func (b *BmPrefix) Scan(text []rune, index, beglimit, endlimit int) int {
 		chTest = text[test]
 
 		if b.caseInsensitive {
-			chTest = unicode.ToLower(chTest)
+			chTest = CaseFold(chTest)
 		}
 
 		if chTest != chMatch {
@@ -707,7 +718,7 @@ func (b *BmPrefix) Scan(text []rune, index, beglimit, endlimit int) int {
 				chTest = text[test2]
 
 				if b.caseInsensitive {
-					chTest = unicode.ToLower(chTest)
+					chTest = CaseFold(chTest)
 				}
 
 				if chTest != b.pattern[match] {
@@ -768,7 +779,7 @@ func (b *BmPrefix) matchPattern(text []rune, index int) bool {
 	if b.caseInsensitive {
 		for i := 0; i < len(b.pattern); i++ {
 			//Debug.Assert(textinfo.ToLower(_pattern[i]) == _pattern[i], "pattern should be converted to lower case in constructor!");
-			if unicode.ToLower(text[index+i]) != b.pattern[i] {
+			if CaseFold(text[index+i]) != b.pattern[i] {
 				return false
 			}
 		}
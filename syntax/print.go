@@ -0,0 +1,533 @@
+package syntax
+
+import (
+	"bytes"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// String returns a pattern for the tree from n, in the default syntax and
+// starting with inline options to suit what comes first, so that it's
+// what the tree matches when compiled without options.  The one option
+// that can't be written inline is RightToLeft, which the pattern must be
+// compiled with if n.Flags has it.  Nested classes, which the default
+// syntax lacks, are written with lookarounds.
+func (n *Node) String() string {
+	p := &printer{}
+	p.start(n)
+	p.node(n)
+	return p.buf.String()
+}
+
+// start writes the inline options to begin a pattern for n with
+func (p *printer) start(n *Node) {
+	p.flags = inlineFlags(n)
+	if p.flags == 0 {
+		return
+	}
+	p.buf.WriteString("(?")
+	for _, f := range []struct {
+		opt RegexOptions
+		ch  byte
+	}{{IgnoreCase, 'i'}, {Multiline, 'm'}, {Singleline, 's'}} {
+		if p.flags&f.opt != 0 {
+			p.buf.WriteByte(f.ch)
+		}
+	}
+	p.buf.WriteByte(')')
+}
+
+// inlineFlags chooses the options to start a pattern for n with: those of
+// the first node that cares about case, Multiline if the first anchor is
+// for lines and Singleline if the first dot is one that matches \n
+func inlineFlags(n *Node) RegexOptions {
+	var flags, seen RegexOptions
+	Inspect(n, func(n *Node) bool {
+		if n == nil {
+			return false
+		}
+		switch n.Op {
+		case OpBeginLine, OpEndLine:
+			flags |= Multiline &^ seen
+			seen |= Multiline
+		case OpBeginText, OpEndTextNewline:
+			seen |= Multiline
+		case OpCharClass:
+			if seen&Singleline == 0 && isAnything(n.Set) {
+				flags |= Singleline
+				seen |= Singleline
+			} else if n.Set.IsSingletonInverse() && n.Set.ranges[0].first == '\n' {
+				seen |= Singleline
+			}
+		}
+		if seen&IgnoreCase == 0 && cased(n) {
+			flags |= n.Flags & IgnoreCase
+			seen |= IgnoreCase
+		}
+		return true
+	})
+	return flags
+}
+
+// cased says whether IgnoreCase can change what n matches
+func cased(n *Node) bool {
+	switch n.Op {
+	case OpBackref:
+		return true
+	case OpLiteral:
+		for _, r := range n.Runes {
+			if unicode.SimpleFold(r) != r {
+				return true
+			}
+		}
+	case OpCharClass:
+		return casedSet(n.Set)
+	}
+	return false
+}
+
+func casedSet(set *CharSet) bool {
+	if len(set.sets) > 0 || set.sub != nil && casedSet(set.sub) {
+		return true
+	}
+	for _, ct := range set.categories {
+		if ct.cat != spaceCategoryText && ct.cat != "Nd" || ct.fn != nil {
+			return true
+		}
+	}
+	for _, r := range set.ranges {
+		for ch := r.first; ch <= r.last; ch++ {
+			if unicode.SimpleFold(ch) != ch {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isAnything(set *CharSet) bool {
+	return set.sub == nil && !set.negate && len(set.categories) == 0 && len(set.sets) == 0 &&
+		len(set.ranges) == 1 && set.ranges[0].first == 0 && set.ranges[0].last == utf8.MaxRune
+}
+
+type printer struct {
+	buf     bytes.Buffer
+	flags   RegexOptions // the inline options in effect
+	unnamed int          // the unnamed groups written so far, or all of them for RE2
+
+	// writing RE2 syntax instead, and what it couldn't write
+	re2         bool
+	unsupported []RE2Construct
+}
+
+// the word characters of ECMAScript's \b
+const ecmaWordClass = `[\p{L}\p{Mn}\p{Nd}\p{Pc}]`
+
+func (p *printer) node(n *Node) {
+	if p.re2 {
+		p.checkRE2(n)
+	}
+	if p.folds(n) {
+		if n.Flags&IgnoreCase != 0 {
+			p.buf.WriteString("(?i:")
+		} else {
+			p.buf.WriteString("(?-i:")
+		}
+		p.flags ^= IgnoreCase
+		p.leaf(n)
+		p.flags ^= IgnoreCase
+		p.buf.WriteByte(')')
+		return
+	}
+
+	switch n.Op {
+	case OpNothing:
+		p.choose(p.re2, `[^\x00-\x{10FFFF}]`, "(?!)")
+	case OpEmpty:
+	case OpLiteral, OpCharClass, OpBackref:
+		p.leaf(n)
+	case OpBeginLine:
+		p.choose(p.flags&Multiline != 0, "^", "(?m:^)")
+	case OpEndLine:
+		p.choose(p.flags&Multiline != 0, "$", "(?m:$)")
+	case OpBeginText:
+		p.choose(p.flags&Multiline == 0, "^", `\A`)
+	case OpEndTextNewline:
+		p.choose(p.flags&Multiline == 0, "$", `\Z`)
+	case OpEndText:
+		p.buf.WriteString(`\z`)
+	case OpStartMatch:
+		p.buf.WriteString(`\G`)
+	case OpWordBoundary:
+		p.buf.WriteString(`\b`)
+	case OpNoWordBoundary:
+		p.buf.WriteString(`\B`)
+	case OpECMAWordBoundary:
+		p.buf.WriteString(`(?:(?<=` + ecmaWordClass + `)(?!` + ecmaWordClass + `)|(?<!` + ecmaWordClass + `)(?=` + ecmaWordClass + `))`)
+	case OpNoECMAWordBoundary:
+		p.buf.WriteString(`(?:(?<=` + ecmaWordClass + `)(?=` + ecmaWordClass + `)|(?<!` + ecmaWordClass + `)(?!` + ecmaWordClass + `))`)
+	case OpWordSegmentBoundary:
+		p.buf.WriteString(`\b{wb}`)
+	case OpNoWordSegmentBoundary:
+		p.buf.WriteString(`\B{wb}`)
+	case OpGraphemeBoundary:
+		p.buf.WriteString(`\b{g}`)
+	case OpNoGraphemeBoundary:
+		p.buf.WriteString(`\B{g}`)
+	case OpGrapheme:
+		p.buf.WriteString(`\X`)
+	case OpConcat:
+		for _, c := range n.Children {
+			if c.Op == OpAlternate {
+				p.group("(?:", c)
+			} else {
+				p.node(c)
+			}
+		}
+	case OpAlternate:
+		if len(n.Children) == 0 {
+			p.buf.WriteString("(?!)")
+		}
+		for i, c := range n.Children {
+			if i > 0 {
+				p.buf.WriteByte('|')
+			}
+			p.node(c)
+		}
+	case OpRepeat:
+		if p.atom(n.Children[0]) {
+			p.node(n.Children[0])
+		} else {
+			p.group("(?:", n.Children[0])
+		}
+		p.quantifier(n)
+	case OpCapture:
+		p.capture(n)
+	case OpLookahead:
+		p.group("(?=", n.Children[0])
+	case OpNegativeLookahead:
+		p.group("(?!", n.Children[0])
+	case OpLookbehind:
+		p.group("(?<=", n.Children[0])
+	case OpNegativeLookbehind:
+		p.group("(?<!", n.Children[0])
+	case OpAtomic:
+		p.group("(?>", n.Children[0])
+	case OpCondCapture:
+		p.buf.WriteString("(?(" + p.ref(n) + ")")
+		p.branches(n.Children)
+	case OpCond:
+		cond := n.Children[0]
+		switch cond.Op {
+		case OpLookahead, OpNegativeLookahead, OpLookbehind, OpNegativeLookbehind:
+			p.buf.WriteString("(?")
+			p.node(cond)
+		default:
+			// anything else is taken as a lookahead, so long as it can't
+			// be read as a group name
+			p.buf.WriteString("(?(")
+			start := p.buf.Len()
+			p.node(cond)
+			if r, _ := utf8.DecodeRune(p.buf.Bytes()[start:]); IsWordChar(r) {
+				text := p.buf.String()[start:]
+				p.buf.Truncate(start)
+				p.buf.WriteString("(?:" + text + ")")
+			}
+			p.buf.WriteByte(')')
+		}
+		p.branches(n.Children[1:])
+	case OpCall:
+		switch {
+		case n.Group == 0:
+			p.buf.WriteString("(?R)")
+		case n.Name != "":
+			p.buf.WriteString("(?&" + n.Name + ")")
+		default:
+			p.buf.WriteString("(?" + strconv.Itoa(n.Group) + ")")
+		}
+	case OpVerb:
+		p.verb(n)
+	}
+}
+
+func (p *printer) choose(cond bool, yes, no string) {
+	if cond {
+		p.buf.WriteString(yes)
+	} else {
+		p.buf.WriteString(no)
+	}
+}
+
+// folds says whether n matches with IgnoreCase other than as the
+// options in effect say, and so must have its own
+func (p *printer) folds(n *Node) bool {
+	return n.Flags&IgnoreCase != p.flags&IgnoreCase && cased(n)
+}
+
+// atom says whether n is written as something a quantifier can follow
+func (p *printer) atom(n *Node) bool {
+	if p.folds(n) {
+		return true
+	}
+	switch n.Op {
+	case OpLiteral:
+		return len(n.Runes) == 1
+	case OpCharClass, OpBackref, OpGrapheme, OpNothing, OpCapture, OpLookahead, OpNegativeLookahead,
+		OpLookbehind, OpNegativeLookbehind, OpAtomic, OpCondCapture, OpCond, OpCall,
+		OpECMAWordBoundary, OpNoECMAWordBoundary:
+		return true
+	}
+	return false
+}
+
+func (p *printer) group(open string, n *Node) {
+	p.buf.WriteString(open)
+	p.node(n)
+	p.buf.WriteByte(')')
+}
+
+// branches writes the yes and no branches of a conditional and closes it
+func (p *printer) branches(children []*Node) {
+	for i, c := range children {
+		if i > 0 {
+			p.buf.WriteByte('|')
+		}
+		if c.Op == OpAlternate {
+			p.group("(?:", c)
+		} else {
+			p.node(c)
+		}
+	}
+	p.buf.WriteByte(')')
+}
+
+func (p *printer) quantifier(n *Node) {
+	switch {
+	case n.Min == 0 && n.Max == -1:
+		p.buf.WriteByte('*')
+	case n.Min == 1 && n.Max == -1:
+		p.buf.WriteByte('+')
+	case n.Min == 0 && n.Max == 1:
+		p.buf.WriteByte('?')
+	case n.Min == n.Max:
+		p.buf.WriteString("{" + strconv.Itoa(n.Min) + "}")
+	case n.Max == -1:
+		p.buf.WriteString("{" + strconv.Itoa(n.Min) + ",}")
+	default:
+		p.buf.WriteString("{" + strconv.Itoa(n.Min) + "," + strconv.Itoa(n.Max) + "}")
+	}
+	if n.Lazy {
+		p.buf.WriteByte('?')
+	}
+}
+
+func (p *printer) capture(n *Node) {
+	if p.re2 {
+		p.re2Capture(n)
+		return
+	}
+	switch {
+	case n.Group == -1:
+		p.buf.WriteString("(?<-" + strconv.Itoa(n.Uncapture) + ">")
+	case n.Name == "" && n.Uncapture == 0 && n.Group == p.unnamed+1:
+		// the next group the parser will number itself
+		p.unnamed++
+		p.buf.WriteByte('(')
+	default:
+		p.buf.WriteString("(?<" + p.ref(n))
+		if n.Uncapture != 0 {
+			p.buf.WriteString("-" + strconv.Itoa(n.Uncapture))
+		}
+		p.buf.WriteByte('>')
+	}
+	p.node(n.Children[0])
+	p.buf.WriteByte(')')
+}
+
+// ref is how n refers to its group
+func (p *printer) ref(n *Node) string {
+	if n.Name != "" {
+		return n.Name
+	}
+	return strconv.Itoa(n.Group)
+}
+
+func (p *printer) verb(n *Node) {
+	names := map[int]string{VerbCommit: "COMMIT", VerbPrune: "PRUNE", VerbSkip: "SKIP", VerbMark: "MARK"}
+	p.buf.WriteString("(*" + names[n.Verb])
+	if len(n.Runes) > 0 {
+		p.buf.WriteString(":" + string(n.Runes))
+	}
+	p.buf.WriteByte(')')
+}
+
+// leaf writes a Literal, CharClass or Backref, whose IgnoreCase is the
+// one in effect
+func (p *printer) leaf(n *Node) {
+	switch n.Op {
+	case OpLiteral:
+		for _, r := range n.Runes {
+			p.char(r, `\.+*?()|[]{}^$`)
+		}
+	case OpBackref:
+		p.buf.WriteString(`\k<` + p.ref(n) + ">")
+	case OpCharClass:
+		if p.re2 {
+			p.re2Class(n.Set)
+		} else {
+			p.class(n.Set, n.Flags&RightToLeft != 0)
+		}
+	}
+}
+
+func (p *printer) class(set *CharSet, rtl bool) {
+	switch {
+	case len(set.sets) > 0 || set.sub != nil && len(set.sub.sets) > 0:
+		p.nestedClass(set, rtl)
+	case set.IsSingleton():
+		p.char(set.ranges[0].first, `\.+*?()|[]{}^$`)
+	case set.IsSingletonInverse() && set.ranges[0].first == '\n' && p.flags&Singleline == 0:
+		p.buf.WriteByte('.')
+	case isAnything(set):
+		p.choose(p.flags&Singleline != 0, ".", `[\s\S]`)
+	case set.sub == nil && len(set.ranges) == 0 && len(set.categories) == 0:
+		p.choose(set.negate, `[\s\S]`, `[^\s\S]`)
+	case set.sub == nil && len(set.ranges) == 0 && len(set.categories) == 1:
+		ct := set.categories[0]
+		ct.negate = ct.negate != set.negate
+		p.buf.WriteString(categoryEscape(ct))
+	default:
+		p.buf.WriteByte('[')
+		if set.negate {
+			p.buf.WriteByte('^')
+		}
+		p.classItems(set)
+		if set.sub != nil {
+			p.buf.WriteString("-[")
+			if set.sub.negate {
+				p.buf.WriteByte('^')
+			}
+			p.classItems(set.sub)
+			p.buf.WriteByte(']')
+		}
+		p.buf.WriteByte(']')
+	}
+}
+
+// classItems writes the ranges and categories of set as they go between
+// its brackets
+func (p *printer) classItems(set *CharSet) {
+	for _, r := range set.ranges {
+		p.char(r.first, `\[]^-`)
+		if r.last != r.first {
+			if r.last > r.first+1 {
+				p.buf.WriteByte('-')
+			}
+			p.char(r.last, `\[]^-`)
+		}
+	}
+	for _, ct := range set.categories {
+		p.buf.WriteString(categoryEscape(ct))
+	}
+}
+
+// nestedClass writes a class with nested classes as a rune that one of
+// them, or the class's own ranges and categories, matches ahead; or,
+// going right to left, behind.
+func (p *printer) nestedClass(set *CharSet, rtl bool) {
+	p.buf.WriteString("(?:")
+	look, nlook := "(?=", "(?!"
+	if rtl {
+		look, nlook = "(?<=", "(?<!"
+		p.buf.WriteString(`[\s\S]`)
+	}
+	if set.negate {
+		p.buf.WriteString(nlook)
+	} else {
+		p.buf.WriteString(look)
+	}
+	own := *set
+	own.sets, own.sub, own.negate = nil, nil, false
+	first := true
+	if len(own.ranges) > 0 || len(own.categories) > 0 {
+		p.class(&own, rtl)
+		first = false
+	}
+	for _, s := range set.sets {
+		if !first {
+			p.buf.WriteByte('|')
+		}
+		p.class(s, rtl)
+		first = false
+	}
+	p.buf.WriteByte(')')
+	if set.sub != nil {
+		p.buf.WriteString(nlook)
+		p.class(set.sub, rtl)
+		p.buf.WriteByte(')')
+	}
+	if !rtl {
+		p.buf.WriteString(`[\s\S]`)
+	}
+	p.buf.WriteByte(')')
+}
+
+func categoryEscape(ct category) string {
+	var esc byte
+	switch ct.cat {
+	case spaceCategoryText:
+		esc = 's'
+	case wordCategoryText:
+		esc = 'w'
+	case "Nd":
+		esc = 'd'
+	default:
+		if ct.negate {
+			return `\P{` + ct.cat + "}"
+		}
+		return `\p{` + ct.cat + "}"
+	}
+	if ct.negate {
+		esc -= 'a' - 'A'
+	}
+	return `\` + string(esc)
+}
+
+// char writes r, escaped if it's one of special or isn't printable
+func (p *printer) char(r rune, special string) {
+	const hex = "0123456789abcdef"
+	switch {
+	case r < utf8.RuneSelf && bytes.IndexByte([]byte(special), byte(r)) >= 0:
+		p.buf.WriteByte('\\')
+		p.buf.WriteRune(r)
+	case r == ' ' || unicode.IsPrint(r):
+		p.buf.WriteRune(r)
+	case r == '\t':
+		p.buf.WriteString(`\t`)
+	case r == '\n':
+		p.buf.WriteString(`\n`)
+	case r == '\r':
+		p.buf.WriteString(`\r`)
+	case r == '\f':
+		p.buf.WriteString(`\f`)
+	case r == '\a':
+		p.buf.WriteString(`\a`)
+	case r == 0x1b && !p.re2:
+		p.buf.WriteString(`\e`)
+	case r < 0x100:
+		p.buf.WriteString(`\x`)
+		p.buf.WriteByte(hex[r>>4])
+		p.buf.WriteByte(hex[r&0xf])
+	case r < 0x10000 && !p.re2:
+		p.buf.WriteString(`\u`)
+		for shift := uint(12); ; shift -= 4 {
+			p.buf.WriteByte(hex[r>>shift&0xf])
+			if shift == 0 {
+				break
+			}
+		}
+	default:
+		p.buf.WriteString(`\x{` + strconv.FormatInt(int64(r), 16) + "}")
+	}
+}
@@ -0,0 +1,145 @@
+package syntax
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// The \p{name} tables come from the unicode package, so they are as current
+// as the Go release the program is built with.  To follow a different UCD
+// version, generate tables from its files and build with the regexp2_ucd tag:
+//
+//	go run gen_tables.go -ucd path/to/ucd -version 15.1.0
+//	go build -tags regexp2_ucd
+//
+// RegisterProperty adds or replaces single tables at run time.
+
+// UnicodeVersion is the Unicode version of the property tables
+var UnicodeVersion = func() string {
+	if ucdVersion != "" {
+		return ucdVersion
+	}
+	return unicode.Version
+}()
+
+var (
+	propertiesMu sync.RWMutex
+	// names given to RegisterProperty, which \p{name} takes as binary
+	// properties in the modes that tell the kinds of names apart
+	registeredProperties = map[string]bool{}
+	// the classes given to RegisterClass
+	classFuncs = map[string]func(rune) bool{}
+)
+
+// RegisterProperty makes \p{name} and \P{name} match the runes in table,
+// replacing any table or class of that name.  Patterns compiled before the
+// call keep the tables they were compiled with.  Registered names can also
+// be used as POSIX classes, [[:name:]], in the modes that have them.
+func RegisterProperty(name string, table *unicode.RangeTable) error {
+	if !validPropertyName(name) {
+		return errors.New("regexp2: invalid property name " + name)
+	}
+	if table == nil {
+		return errors.New("regexp2: nil table for property " + name)
+	}
+
+	propertiesMu.Lock()
+	defer propertiesMu.Unlock()
+	unicodeCategories[name] = table
+	registeredProperties[name] = true
+	delete(classFuncs, name)
+	return nil
+}
+
+// RegisterClass is RegisterProperty for classes whose members are easier
+// to test for than to list: \p{name} matches the runes fn returns true
+// for.  With IgnoreCase fn is given case folded runes.  fn is called while
+// matching, so it must be safe for concurrent use.
+func RegisterClass(name string, fn func(rune) bool) error {
+	if !validPropertyName(name) {
+		return errors.New("regexp2: invalid class name " + name)
+	}
+	if fn == nil {
+		return errors.New("regexp2: nil func for class " + name)
+	}
+
+	propertiesMu.Lock()
+	defer propertiesMu.Unlock()
+	classFuncs[name] = fn
+	registeredProperties[name] = true
+	return nil
+}
+
+func validPropertyName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " {}=:")
+}
+
+// lookupClassFunc returns the func given to RegisterClass for name
+func lookupClassFunc(name string) func(rune) bool {
+	propertiesMu.RLock()
+	defer propertiesMu.RUnlock()
+	return classFuncs[name]
+}
+
+// isRegistered reports whether name was given to RegisterProperty or
+// RegisterClass
+func isRegistered(name string) bool {
+	propertiesMu.RLock()
+	defer propertiesMu.RUnlock()
+	return registeredProperties[name]
+}
+
+// LookupProperty returns the table \p{name} matches, if there is one
+func LookupProperty(name string) (*unicode.RangeTable, bool) {
+	return lookupProperty(name)
+}
+
+func lookupProperty(name string) (*unicode.RangeTable, bool) {
+	propertiesMu.RLock()
+	t, ok := unicodeCategories[name]
+	propertiesMu.RUnlock()
+	if ok || !strings.HasPrefix(name, scxPrefix) {
+		return t, ok
+	}
+
+	// the Script_Extensions tables are built the first time they're used
+	script := name[len(scxPrefix):]
+	base, ok := lookupProperty(script)
+	if !ok || !isScript(script) {
+		return nil, false
+	}
+	t = scriptExtensionsTable(script, base)
+
+	propertiesMu.Lock()
+	defer propertiesMu.Unlock()
+	unicodeCategories[name] = t
+	return t, true
+}
+
+func isScript(name string) bool {
+	if _, ok := unicode.Scripts[name]; ok {
+		return true
+	}
+	_, ok := ucdScripts[name]
+	return ok
+}
+
+func isGeneralCategory(name string) bool {
+	if _, ok := unicode.Categories[name]; ok {
+		return true
+	}
+	_, ok := ucdCategories[name]
+	return ok
+}
+
+func isBinaryProperty(name string) bool {
+	if _, ok := unicode.Properties[name]; ok {
+		return true
+	}
+	if _, ok := ucdProperties[name]; ok {
+		return true
+	}
+	return isRegistered(name)
+}
@@ -0,0 +1,211 @@
+package syntax
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RE2Construct is a part of a pattern that RE2 syntax can't say.
+type RE2Construct struct {
+	Feature string // what it is, such as "lookbehind"
+	Text    string // the part, written as a pattern
+}
+
+// RE2Error is the error RE2 returns for a pattern that uses things RE2
+// syntax has no way to say, or that would match differently in it.
+type RE2Error struct {
+	Unsupported []RE2Construct // in the order they're written
+}
+
+func (e *RE2Error) Error() string {
+	parts := make([]string, len(e.Unsupported))
+	for i, c := range e.Unsupported {
+		parts[i] = c.Feature + " " + c.Text
+	}
+	return "can't be written in RE2 syntax: " + strings.Join(parts, ", ")
+}
+
+// RE2 returns a pattern for the tree from n in the syntax of RE2 and Go's
+// regexp package, which matches what it does, with the same groups: the
+// result of a leftmost-first search is the same.  Where \d, \s and \w
+// mean Unicode classes, they're written as those.  If there are parts RE2
+// can't say, it returns an *RE2Error listing them: back references,
+// lookarounds, atomic groups and the other things that need backtracking,
+// and also $ without Multiline, which matches before a final \n too, \b,
+// which RE2 limits to ASCII, and groups numbered other than in the order
+// they're written, as they are when a named group comes before an
+// unnamed one.
+func (n *Node) RE2() (string, error) {
+	p := &printer{re2: true}
+	if n.Flags&RightToLeft != 0 {
+		p.unsupport("right to left matching", "")
+	}
+	p.start(n)
+	p.node(n)
+	if len(p.unsupported) > 0 {
+		return "", &RE2Error{Unsupported: p.unsupported}
+	}
+	return p.buf.String(), nil
+}
+
+// the most repetitions RE2 allows in a counted repeat
+const re2MaxRepeat = 1000
+
+var re2Features = map[Op]string{
+	OpEndTextNewline:        "end of text or before a final newline",
+	OpStartMatch:            "search start anchor",
+	OpWordBoundary:          "Unicode word boundary",
+	OpNoWordBoundary:        "Unicode word boundary",
+	OpECMAWordBoundary:      "Unicode word boundary",
+	OpNoECMAWordBoundary:    "Unicode word boundary",
+	OpWordSegmentBoundary:   "word segment boundary",
+	OpNoWordSegmentBoundary: "word segment boundary",
+	OpGraphemeBoundary:      "grapheme boundary",
+	OpNoGraphemeBoundary:    "grapheme boundary",
+	OpGrapheme:              "grapheme cluster",
+	OpBackref:               "back reference",
+	OpLookahead:             "lookahead",
+	OpNegativeLookahead:     "lookahead",
+	OpLookbehind:            "lookbehind",
+	OpNegativeLookbehind:    "lookbehind",
+	OpAtomic:                "atomic group",
+	OpCondCapture:           "conditional",
+	OpCond:                  "conditional",
+	OpCall:                  "subroutine call",
+	OpVerb:                  "backtracking control verb",
+}
+
+// checkRE2 notes it if n is something RE2 can't say
+func (p *printer) checkRE2(n *Node) {
+	if feature, ok := re2Features[n.Op]; ok {
+		p.unsupport(feature, n.String())
+		return
+	}
+	switch {
+	case n.Op == OpRepeat && (n.Min > re2MaxRepeat || n.Max > re2MaxRepeat):
+		p.unsupport("repeat count over "+strconv.Itoa(re2MaxRepeat), n.String())
+	case n.Op == OpCapture && n.Uncapture != 0:
+		p.unsupport("balancing group", n.String())
+	}
+}
+
+func (p *printer) unsupport(feature, text string) {
+	for _, c := range p.unsupported {
+		if c.Feature == feature && c.Text == text {
+			return
+		}
+	}
+	p.unsupported = append(p.unsupported, RE2Construct{feature, text})
+}
+
+// re2Capture writes a group, which RE2 numbers in the order they're
+// written, named or not
+func (p *printer) re2Capture(n *Node) {
+	p.unnamed++
+	if n.Group != p.unnamed && n.Uncapture == 0 {
+		p.unsupport("group numbered "+strconv.Itoa(n.Group)+" rather than "+strconv.Itoa(p.unnamed), n.String())
+	}
+	if n.Name == "" {
+		p.buf.WriteByte('(')
+	} else {
+		for _, r := range n.Name {
+			if r >= utf8.RuneSelf {
+				p.unsupport("group name that isn't ASCII", n.Name)
+				break
+			}
+		}
+		p.buf.WriteString("(?P<" + n.Name + ">")
+	}
+	p.node(n.Children[0])
+	p.buf.WriteByte(')')
+}
+
+// the class RE2 writes for the runes unicode.IsSpace and IsWordChar take
+const (
+	re2Space = `\t-\r\x{85}\p{Z}`
+	re2Word  = `\p{L}\p{Mn}\p{Nd}\p{Pc}\x{200c}\x{200d}`
+)
+
+func (p *printer) re2Class(set *CharSet) {
+	switch {
+	case set.IsSingleton():
+		p.char(set.ranges[0].first, `\.+*?()|[]{}^$`)
+		return
+	case set.IsSingletonInverse() && set.ranges[0].first == '\n' && p.flags&Singleline == 0:
+		p.buf.WriteByte('.')
+		return
+	case isAnything(set):
+		p.choose(p.flags&Singleline != 0, ".", "(?s:.)")
+		return
+	}
+
+	if set.sub == nil && len(set.sets) == 0 && len(set.ranges) == 0 && len(set.categories) == 1 {
+		ct := set.categories[0]
+		if ct.fn == nil && (unicode.Categories[ct.cat] != nil || unicode.Scripts[ct.cat] != nil) {
+			// not \d, which RE2 limits to ASCII
+			p.choose(ct.negate != set.negate, `\P{`+ct.cat+"}", `\p{`+ct.cat+"}")
+			return
+		}
+	}
+
+	items, ok := re2ClassItems(set)
+	if !ok {
+		flat := &CharSet{}
+		for _, r := range set.RuneRanges() {
+			flat.ranges = append(flat.ranges, singleRange{r[0], r[1]})
+		}
+		if len(flat.ranges) == 0 {
+			p.buf.WriteString(`[^\x00-\x{10FFFF}]`)
+			return
+		}
+		p.re2Class(flat)
+		return
+	}
+	p.buf.WriteByte('[')
+	if set.negate {
+		p.buf.WriteByte('^')
+	}
+	p.buf.WriteString(items)
+	p.buf.WriteByte(']')
+}
+
+// re2ClassItems returns what goes between the brackets of set in RE2,
+// unless it has parts RE2 can't say there
+func re2ClassItems(set *CharSet) (string, bool) {
+	if set.sub != nil || len(set.sets) > 0 || len(set.ranges) == 0 && len(set.categories) == 0 {
+		return "", false
+	}
+	p := &printer{re2: true}
+	for _, r := range set.ranges {
+		p.char(r.first, `\[]^-`)
+		if r.last != r.first {
+			if r.last > r.first+1 {
+				p.buf.WriteByte('-')
+			}
+			p.char(r.last, `\[]^-`)
+		}
+	}
+	for _, ct := range set.categories {
+		switch {
+		case ct.fn != nil:
+			return "", false
+		case ct.cat == spaceCategoryText || ct.cat == wordCategoryText:
+			// there's no way to negate them inside a class
+			if ct.negate {
+				return "", false
+			}
+			p.buf.WriteString(map[bool]string{true: re2Space, false: re2Word}[ct.cat == spaceCategoryText])
+		case unicode.Categories[ct.cat] != nil || unicode.Scripts[ct.cat] != nil:
+			if ct.negate {
+				p.buf.WriteString(`\P{` + ct.cat + "}")
+			} else {
+				p.buf.WriteString(`\p{` + ct.cat + "}")
+			}
+		default:
+			return "", false
+		}
+	}
+	return p.buf.String(), true
+}
@@ -0,0 +1,454 @@
+package syntax
+
+import (
+	"math"
+	"sort"
+)
+
+// RiskSeverity says how fast the time a search takes can grow with the
+// length of the text because of a Risk.
+type RiskSeverity int
+
+const (
+	// RiskPolynomial is time that grows as a power of the length: two
+	// unbounded quantifiers that can trade text between them make a
+	// failing search quadratic, three cubic, and so on.
+	RiskPolynomial RiskSeverity = iota + 1
+
+	// RiskExponential is time that doubles, or worse, with each rune,
+	// so that a few dozen are enough to make a search run for hours.
+	RiskExponential
+)
+
+func (s RiskSeverity) String() string {
+	switch s {
+	case RiskPolynomial:
+		return "polynomial"
+	case RiskExponential:
+		return "exponential"
+	}
+	return "unknown"
+}
+
+// Risk is a part of a pattern that can make the backtracking engine try
+// a super-linear number of ways to match text that it goes on to fail.
+type Risk struct {
+	Severity RiskSeverity
+	Pos, End int    // the span of the pattern to blame, in runes
+	Message  string // what's wrong with it
+}
+
+// Risks looks for the shapes of pattern known to backtrack
+// catastrophically: quantifiers nested in a repeated group that can split
+// the same text between them in many ways, adjacent quantifiers that can
+// trade text between them, and alternatives of a repeated group that can
+// match the same text.  It's a static check, and so an approximate one:
+// it goes by what the runes each part can match have in common, not by
+// whether whole strings can be matched more than one way, and it doesn't
+// know about the Memoize option or the timeouts that limit the damage.
+// Parts in atomic groups, lookarounds and possessive quantifiers aren't
+// backtracked into, and aren't reported.
+func (t *RegexTree) Risks() []Risk {
+	a := &riskAnalysis{seen: map[[2]*regexNode]bool{}}
+	a.walk(t.root, nil)
+	sort.SliceStable(a.risks, func(i, j int) bool {
+		if a.risks[i].Pos != a.risks[j].Pos {
+			return a.risks[i].Pos < a.risks[j].Pos
+		}
+		return a.risks[i].End > a.risks[j].End
+	})
+	return a.risks
+}
+
+// riskChars is what one step of a match can consume: a rune, any rune but
+// one, or a set of them, along with the repeating loop whose iterations it
+// starts, if any
+type riskChars struct {
+	t    nodeType // ntOne, ntNotone or ntSet
+	ch   rune
+	set  *CharSet
+	loop *regexNode
+}
+
+// anyChar stands for text that can't be known until the match is run
+var anyChar = riskChars{t: ntNotone, ch: -1}
+
+// riskCont is what's matched after the node being walked: the rest of
+// each concatenation it's in, innermost first, with the ends of the bodies
+// of the loops it's in, where another iteration can start
+type riskCont struct {
+	nodes []*regexNode
+	loop  *regexNode // instead of nodes
+	next  *riskCont
+}
+
+type riskAnalysis struct {
+	risks []Risk
+	seen  map[[2]*regexNode]bool
+
+	// the loops that can repeat which the node being walked is in,
+	// back to the nearest atomic group or lookaround
+	loops []*regexNode
+}
+
+// walk looks for risks in n, given what follows it
+func (a *riskAnalysis) walk(n *regexNode, cont *riskCont) {
+	switch n.t {
+	case ntConcatenate:
+		for i, child := range n.children {
+			a.walk(child, &riskCont{nodes: n.children[i+1:], next: cont})
+		}
+
+	case ntAlternate, ntTestref:
+		a.alternatives(n.children)
+		for _, child := range n.children {
+			a.walk(child, cont)
+		}
+
+	case ntTestgroup:
+		a.atomic(n.children[0])
+		a.alternatives(n.children[1:])
+		for _, child := range n.children[1:] {
+			a.walk(child, cont)
+		}
+
+	case ntCapture, ntGroup:
+		a.walk(n.children[0], cont)
+
+	case ntGreedy, ntRequire, ntPrevent:
+		a.atomic(n.children[0])
+
+	case ntLoop, ntLazyloop:
+		if n.n > 1 {
+			a.loops = append(a.loops, n)
+			a.walk(n.children[0], &riskCont{loop: n, next: cont})
+			a.loops = a.loops[:len(a.loops)-1]
+		} else {
+			a.walk(n.children[0], cont)
+		}
+		a.check(n, cont)
+
+	case ntOneloop, ntNotoneloop, ntSetloop, ntOnelazy, ntNotonelazy, ntSetlazy:
+		a.check(n, cont)
+	}
+}
+
+// atomic walks n, which once matched is never backtracked into
+func (a *riskAnalysis) atomic(n *regexNode) {
+	loops := a.loops
+	a.loops = nil
+	a.walk(n, nil)
+	a.loops = loops
+}
+
+// check reports n if it's an unbounded loop that can go on to consume
+// what follows it: the start of another iteration of a loop it's in,
+// possibly after text it could have consumed itself, or another
+// unbounded loop right after it that can take over its last runes
+func (a *riskAnalysis) check(n *regexNode, cont *riskCont) {
+	if n.n != math.MaxInt32 {
+		return
+	}
+	consumed := riskConsumes(n, nil)
+	ending, _ := riskEdge(n, true)
+	adjacent := true
+	for c := cont; c != nil; c = c.next {
+		if c.loop != nil {
+			if first, _ := riskFirst(c.loop.children[0]); riskOverlaps(consumed, first) {
+				sev := RiskPolynomial
+				if c.loop.n == math.MaxInt32 {
+					sev = RiskExponential
+				}
+				a.report(sev, c.loop, nil, "nested quantifiers can split the same text between their iterations in many ways")
+				return
+			}
+			continue
+		}
+		for _, next := range c.nodes {
+			first, nullable := riskFirst(next)
+			for _, f := range first {
+				if adjacent && f.loop != nil && f.loop.n == math.MaxInt32 && riskOverlap(ending, f) {
+					a.report(RiskPolynomial, n, f.loop, "adjacent quantifiers can trade the same text between them")
+				}
+			}
+			if nullable {
+				continue
+			}
+			if fixed, ok := riskFixed(next, nil); ok && riskCovers(consumed, fixed) {
+				adjacent = false
+				continue
+			}
+			return
+		}
+	}
+}
+
+// alternatives reports the innermost loop being walked if two of alts can
+// match the same text
+func (a *riskAnalysis) alternatives(alts []*regexNode) {
+	if len(a.loops) == 0 {
+		return
+	}
+	loop := a.loops[len(a.loops)-1]
+	for i := range alts {
+		x, ok := riskFixed(alts[i], nil)
+		if !ok {
+			continue
+		}
+		for _, alt := range alts[i+1:] {
+			y, ok := riskFixed(alt, nil)
+			if !ok || len(x) != len(y) {
+				continue
+			}
+			same := true
+			for k := range x {
+				if !riskOverlap([]riskChars{x[k]}, y[k]) {
+					same = false
+					break
+				}
+			}
+			if same {
+				sev := RiskPolynomial
+				if loop.n == math.MaxInt32 {
+					sev = RiskExponential
+				}
+				a.report(sev, loop, nil, "alternatives in a repeated group can match the same text")
+				return
+			}
+		}
+	}
+}
+
+// report notes a risk from the span of n, or of n and m, once
+func (a *riskAnalysis) report(sev RiskSeverity, n, m *regexNode, msg string) {
+	key := [2]*regexNode{n, m}
+	if a.seen[key] {
+		return
+	}
+	a.seen[key] = true
+	r := Risk{Severity: sev, Pos: n.pos, End: n.end, Message: msg}
+	if m != nil {
+		if m.pos < r.Pos {
+			r.Pos = m.pos
+		}
+		if m.end > r.End {
+			r.End = m.end
+		}
+	}
+	a.risks = append(a.risks, r)
+}
+
+// riskLeaf returns what one of the char matching leaves, or an iteration
+// of one of their loops, consumes
+func riskLeaf(n *regexNode) riskChars {
+	// the One, Notone and Set kinds of each leaf are three apart
+	return riskChars{t: ntOne + n.t%3, ch: n.ch, set: n.set}
+}
+
+// riskFirst returns what can start a match of n, and whether n can match
+// nothing at all
+func riskFirst(n *regexNode) ([]riskChars, bool) {
+	return riskEdge(n, false)
+}
+
+// riskEdge returns what can start a match of n or, if last is set, end
+// one, and whether n can match nothing at all
+func riskEdge(n *regexNode, last bool) ([]riskChars, bool) {
+	switch n.t {
+	case ntOnerep, ntNotonerep, ntSetrep, ntOneloop, ntNotoneloop, ntSetloop, ntOnelazy, ntNotonelazy, ntSetlazy:
+		c := riskLeaf(n)
+		if n.n > 1 {
+			c.loop = n
+		}
+		return []riskChars{c}, n.m == 0
+
+	case ntOne, ntNotone, ntSet:
+		return []riskChars{riskLeaf(n)}, false
+
+	case ntMulti:
+		ch := n.str[0]
+		if (n.options&RightToLeft != 0) != last {
+			ch = n.str[len(n.str)-1]
+		}
+		return []riskChars{{t: ntOne, ch: ch}}, false
+
+	case ntRef, ntCall:
+		return []riskChars{anyChar}, true
+
+	case ntGrapheme:
+		return []riskChars{anyChar}, false
+
+	case ntNothing:
+		return nil, false
+
+	case ntAlternate, ntTestref, ntTestgroup:
+		alts := n.children
+		if n.t == ntTestgroup {
+			alts = alts[1:]
+		}
+		// a conditional without a "no" branch matches nothing instead
+		nullable := n.t != ntAlternate && len(alts) < 2
+		var chars []riskChars
+		for _, alt := range alts {
+			c, empty := riskEdge(alt, last)
+			chars = append(chars, c...)
+			nullable = nullable || empty
+		}
+		return chars, nullable
+
+	case ntConcatenate:
+		return riskEdgeOfSeq(n.children, last)
+
+	case ntLoop, ntLazyloop:
+		chars, nullable := riskEdge(n.children[0], last)
+		if n.n > 1 {
+			for i := range chars {
+				chars[i].loop = n
+			}
+		}
+		return chars, nullable || n.m == 0
+
+	case ntCapture, ntGroup, ntGreedy:
+		return riskEdge(n.children[0], last)
+	}
+
+	// anchors, lookarounds and the like
+	return nil, true
+}
+
+// riskEdgeOfSeq is riskEdge for nodes matched one after another
+func riskEdgeOfSeq(nodes []*regexNode, last bool) ([]riskChars, bool) {
+	var chars []riskChars
+	for i := range nodes {
+		n := nodes[i]
+		if last {
+			n = nodes[len(nodes)-1-i]
+		}
+		c, nullable := riskEdge(n, last)
+		chars = append(chars, c...)
+		if !nullable {
+			return chars, false
+		}
+	}
+	return chars, true
+}
+
+// riskConsumes adds everything n can consume to chars
+func riskConsumes(n *regexNode, chars []riskChars) []riskChars {
+	switch {
+	case n.t <= ntSet:
+		return append(chars, riskLeaf(n))
+	case n.t == ntMulti:
+		for _, ch := range n.str {
+			chars = append(chars, riskChars{t: ntOne, ch: ch})
+		}
+		return chars
+	case n.t == ntRef || n.t == ntCall || n.t == ntGrapheme:
+		return append(chars, anyChar)
+	case n.t == ntRequire || n.t == ntPrevent:
+		return chars
+	}
+	children := n.children
+	if n.t == ntTestgroup {
+		children = children[1:]
+	}
+	for _, child := range children {
+		chars = riskConsumes(child, chars)
+	}
+	return chars
+}
+
+// riskFixed adds what each rune of the text n matches must be to chars,
+// if n always matches the same number of runes one after another
+func riskFixed(n *regexNode, chars []riskChars) ([]riskChars, bool) {
+	switch n.t {
+	case ntOne, ntNotone, ntSet:
+		return append(chars, riskLeaf(n)), true
+	case ntMulti:
+		for _, ch := range n.str {
+			chars = append(chars, riskChars{t: ntOne, ch: ch})
+		}
+		return chars, true
+	case ntConcatenate:
+		for _, child := range n.children {
+			var ok bool
+			if chars, ok = riskFixed(child, chars); !ok {
+				return nil, false
+			}
+		}
+		return chars, true
+	case ntCapture, ntGroup:
+		return riskFixed(n.children[0], chars)
+	}
+	return nil, false
+}
+
+// riskSamples are runes to try when looking for one that two sets both
+// contain, besides the ends of their ranges, so sets made of categories
+// are still likely to be caught overlapping
+var riskSamples = []rune("aAzZ09_ -.,;:/\\\t\n\r\"'@#éßЖ中\u00a0\u2028😀")
+
+// riskOverlap reports whether some rune can be consumed by one of chars
+// and by c too
+func riskOverlap(chars []riskChars, c riskChars) bool {
+	for _, d := range chars {
+		if riskCharsOverlap(d, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// riskOverlaps is riskOverlap for any of others
+func riskOverlaps(chars, others []riskChars) bool {
+	for _, c := range others {
+		if riskOverlap(chars, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// riskCovers reports whether each of others overlaps chars
+func riskCovers(chars, others []riskChars) bool {
+	for _, c := range others {
+		if !riskOverlap(chars, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func riskCharsOverlap(a, b riskChars) bool {
+	if a.t > b.t {
+		a, b = b, a
+	}
+	switch {
+	case a.t == ntOne && b.t == ntOne:
+		return a.ch == b.ch
+	case a.t == ntOne && b.t == ntNotone:
+		return a.ch != b.ch
+	case a.t == ntOne:
+		return b.set.CharIn(a.ch)
+	case a.t == ntNotone && b.t == ntNotone:
+		return true
+	}
+
+	// a set and something else
+	var candidates []rune
+	for _, c := range []riskChars{a, b} {
+		if c.set == nil {
+			continue
+		}
+		for _, r := range c.set.ranges {
+			candidates = append(candidates, r.first, r.last, r.last+1)
+		}
+	}
+	candidates = append(candidates, riskSamples...)
+	for _, ch := range candidates {
+		if (a.t == ntSet && a.set.CharIn(ch) || a.t == ntNotone && a.ch != ch) && b.set.CharIn(ch) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,17 @@ type ReplacerData struct {
 	Rep     string
 	Strings []string
 	Rules   []int
+
+	// Cases holds the \U, \L, \u, \l and \E case conversions, in order,
+	// when the CaseConversion option is on
+	Cases []CaseChange
+}
+
+// CaseChange is a case conversion in a replacement pattern.  It comes
+// before the rule at Pos, and Op is the letter after the backslash.
+type CaseChange struct {
+	Pos int
+	Op  rune
 }
 
 const (
@@ -45,6 +56,7 @@ func NewReplacerData(rep string, caps map[int]int, capsize int, capnames map[str
 	var (
 		strings []string
 		rules   []int
+		cases   []CaseChange
 	)
 
 	for _, child := range concat.children {
@@ -69,6 +81,14 @@ func NewReplacerData(rep string, caps map[int]int, capsize int, capnames map[str
 
 			rules = append(rules, -replaceSpecials-1-slot)
 
+		case ntCaseChange:
+			if sb.Len() > 0 {
+				rules = append(rules, len(strings))
+				strings = append(strings, sb.String())
+				sb.Reset()
+			}
+			cases = append(cases, CaseChange{Pos: len(rules), Op: child.ch})
+
 		default:
 			panic(ErrReplacementError)
 		}
@@ -83,5 +103,6 @@ func NewReplacerData(rep string, caps map[int]int, capsize int, capnames map[str
 		Rep:     rep,
 		Strings: strings,
 		Rules:   rules,
+		Cases:   cases,
 	}, nil
 }
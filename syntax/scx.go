@@ -0,0 +1,93 @@
+package syntax
+
+import (
+	"strings"
+	"unicode"
+)
+
+//go:generate go run gen_scx.go -ucd ucd -version 14.0.0
+
+// scriptExtension lists the scripts a range of runes is used with, when
+// that's more than its Script.  Many are Common or Inherited, like U+0951
+// DEVANAGARI STRESS SIGN UDATTA, which a dozen Indic scripts share.
+type scriptExtension struct {
+	lo, hi  rune
+	scripts string // long script names, separated by spaces
+}
+
+// scxPrefix starts the names the \p{scx=Name} tables are kept under
+const scxPrefix = "scx="
+
+// scriptName resolves the script in \p{sc=script}, a long name like Greek
+// or a short one like Grek, to the long name its table is kept under
+func scriptName(script string) (string, bool) {
+	if long, ok := scriptAliases[script]; ok {
+		script = long
+	}
+	return script, isScript(script)
+}
+
+// scriptExtensionsName resolves the script in \p{scx=script} to the
+// name of its table
+func scriptExtensionsName(script string) (string, bool) {
+	script, ok := scriptName(script)
+	if !ok {
+		return "", false
+	}
+	return scxPrefix + script, true
+}
+
+// scriptExtensionsTable builds the table for \p{scx=script} from the one
+// for \p{sc=script}: the runes listed in scriptExtensions are taken out,
+// then the ones that name the script are put back in
+func scriptExtensionsTable(script string, base *unicode.RangeTable) *unicode.RangeTable {
+	listed := make([]singleRange, 0, len(scriptExtensions))
+	var extra []singleRange
+	for _, e := range scriptExtensions {
+		listed = append(listed, singleRange{first: e.lo, last: e.hi})
+		for _, s := range strings.Fields(e.scripts) {
+			if s == script {
+				extra = append(extra, singleRange{first: e.lo, last: e.hi})
+				break
+			}
+		}
+	}
+
+	rs := subtractRanges(tableRanges(mergeTables(base)), tableRanges(tableFromRanges(listed)))
+	return tableFromRanges(append(rs, extra...))
+}
+
+// tableRanges lists the ranges of a table from tableFromRanges
+func tableRanges(t *unicode.RangeTable) []singleRange {
+	rs := make([]singleRange, 0, len(t.R16)+len(t.R32))
+	for _, r := range t.R16 {
+		rs = append(rs, singleRange{first: rune(r.Lo), last: rune(r.Hi)})
+	}
+	for _, r := range t.R32 {
+		rs = append(rs, singleRange{first: rune(r.Lo), last: rune(r.Hi)})
+	}
+	return rs
+}
+
+// subtractRanges returns the parts of a not in b; both are sorted and
+// don't overlap
+func subtractRanges(a, b []singleRange) []singleRange {
+	var out []singleRange
+	j := 0
+	for _, r := range a {
+		for j < len(b) && b[j].last < r.first {
+			j++
+		}
+		lo := r.first
+		for k := j; k < len(b) && b[k].first <= r.last; k++ {
+			if b[k].first > lo {
+				out = append(out, singleRange{first: lo, last: b[k].first - 1})
+			}
+			lo = b[k].last + 1
+		}
+		if lo <= r.last {
+			out = append(out, singleRange{first: lo, last: r.last})
+		}
+	}
+	return out
+}
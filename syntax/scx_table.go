@@ -0,0 +1,286 @@
+// Code generated by gen_scx.go; DO NOT EDIT.
+
+package syntax
+
+const scxVersion = "14.0.0"
+
+// the runes whose Script_Extensions isn't just their Script
+var scriptExtensions = []scriptExtension{
+	{0x0342, 0x0342, "Greek"},
+	{0x0345, 0x0345, "Greek"},
+	{0x0363, 0x036F, "Latin"},
+	{0x0483, 0x0483, "Cyrillic Old_Permic"},
+	{0x0484, 0x0484, "Cyrillic Glagolitic"},
+	{0x0485, 0x0486, "Cyrillic Latin"},
+	{0x0487, 0x0487, "Cyrillic Glagolitic"},
+	{0x060C, 0x060C, "Arabic Nko Hanifi_Rohingya Syriac Thaana Yezidi"},
+	{0x061B, 0x061B, "Arabic Nko Hanifi_Rohingya Syriac Thaana Yezidi"},
+	{0x061C, 0x061C, "Arabic Syriac Thaana"},
+	{0x061F, 0x061F, "Adlam Arabic Nko Hanifi_Rohingya Syriac Thaana Yezidi"},
+	{0x0640, 0x0640, "Adlam Arabic Mandaic Manichaean Old_Uyghur Psalter_Pahlavi Hanifi_Rohingya Sogdian Syriac"},
+	{0x064B, 0x0655, "Arabic Syriac"},
+	{0x0660, 0x0669, "Arabic Thaana Yezidi"},
+	{0x0670, 0x0670, "Arabic Syriac"},
+	{0x06D4, 0x06D4, "Arabic Hanifi_Rohingya"},
+	{0x0951, 0x0951, "Bengali Devanagari Grantha Gujarati Gurmukhi Kannada Latin Malayalam Oriya Sharada Tamil Telugu Tirhuta"},
+	{0x0952, 0x0952, "Bengali Devanagari Grantha Gujarati Gurmukhi Kannada Latin Malayalam Oriya Tamil Telugu Tirhuta"},
+	{0x0964, 0x0964, "Bengali Devanagari Dogra Gunjala_Gondi Masaram_Gondi Grantha Gujarati Gurmukhi Kannada Mahajani Malayalam Nandinagari Oriya Khudawadi Sinhala Syloti_Nagri Takri Tamil Telugu Tirhuta"},
+	{0x0965, 0x0965, "Bengali Devanagari Dogra Gunjala_Gondi Masaram_Gondi Grantha Gujarati Gurmukhi Kannada Limbu Mahajani Malayalam Nandinagari Oriya Khudawadi Sinhala Syloti_Nagri Takri Tamil Telugu Tirhuta"},
+	{0x0966, 0x096F, "Devanagari Dogra Kaithi Mahajani"},
+	{0x09E6, 0x09EF, "Bengali Chakma Syloti_Nagri"},
+	{0x0A66, 0x0A6F, "Gurmukhi Multani"},
+	{0x0AE6, 0x0AEF, "Gujarati Khojki"},
+	{0x0BE6, 0x0BF3, "Grantha Tamil"},
+	{0x0CE6, 0x0CEF, "Kannada Nandinagari"},
+	{0x1040, 0x1049, "Chakma Myanmar Tai_Le"},
+	{0x10FB, 0x10FB, "Georgian Latin"},
+	{0x1735, 0x1736, "Buhid Hanunoo Tagbanwa Tagalog"},
+	{0x1802, 0x1803, "Mongolian Phags_Pa"},
+	{0x1805, 0x1805, "Mongolian Phags_Pa"},
+	{0x1CD0, 0x1CD0, "Bengali Devanagari Grantha Kannada"},
+	{0x1CD1, 0x1CD1, "Devanagari"},
+	{0x1CD2, 0x1CD2, "Bengali Devanagari Grantha Kannada"},
+	{0x1CD3, 0x1CD3, "Devanagari Grantha"},
+	{0x1CD4, 0x1CD4, "Devanagari"},
+	{0x1CD5, 0x1CD6, "Bengali Devanagari"},
+	{0x1CD7, 0x1CD7, "Devanagari Sharada"},
+	{0x1CD8, 0x1CD8, "Bengali Devanagari"},
+	{0x1CD9, 0x1CD9, "Devanagari Sharada"},
+	{0x1CDA, 0x1CDA, "Devanagari Kannada Malayalam Oriya Tamil Telugu"},
+	{0x1CDB, 0x1CDB, "Devanagari"},
+	{0x1CDC, 0x1CDD, "Devanagari Sharada"},
+	{0x1CDE, 0x1CDF, "Devanagari"},
+	{0x1CE0, 0x1CE0, "Devanagari Sharada"},
+	{0x1CE1, 0x1CE1, "Bengali Devanagari"},
+	{0x1CE2, 0x1CE8, "Devanagari"},
+	{0x1CE9, 0x1CE9, "Devanagari Nandinagari"},
+	{0x1CEA, 0x1CEA, "Bengali Devanagari"},
+	{0x1CEB, 0x1CEC, "Devanagari"},
+	{0x1CED, 0x1CED, "Bengali Devanagari"},
+	{0x1CEE, 0x1CF1, "Devanagari"},
+	{0x1CF2, 0x1CF2, "Bengali Devanagari Grantha Kannada Nandinagari Oriya Telugu Tirhuta"},
+	{0x1CF3, 0x1CF3, "Devanagari Grantha"},
+	{0x1CF4, 0x1CF4, "Devanagari Grantha Kannada"},
+	{0x1CF5, 0x1CF6, "Bengali Devanagari"},
+	{0x1CF7, 0x1CF7, "Bengali"},
+	{0x1CF8, 0x1CF9, "Devanagari Grantha"},
+	{0x1CFA, 0x1CFA, "Nandinagari"},
+	{0x1DF8, 0x1DF8, "Cyrillic Syriac"},
+	{0x1DFA, 0x1DFA, "Syriac"},
+	{0x202F, 0x202F, "Latin Mongolian"},
+	{0x20F0, 0x20F0, "Devanagari Grantha Latin"},
+	{0x2E43, 0x2E43, "Cyrillic Glagolitic"},
+	{0x3001, 0x3002, "Bopomofo Hangul Han Hiragana Katakana Yi"},
+	{0x3003, 0x3003, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0x3008, 0x3011, "Bopomofo Hangul Han Hiragana Katakana Yi"},
+	{0x3013, 0x3013, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0x3014, 0x301B, "Bopomofo Hangul Han Hiragana Katakana Yi"},
+	{0x301C, 0x301F, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0x302A, 0x302D, "Bopomofo Han"},
+	{0x3030, 0x3030, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0x3031, 0x3035, "Hiragana Katakana"},
+	{0x3037, 0x3037, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0x303C, 0x303D, "Han Hiragana Katakana"},
+	{0x303E, 0x303F, "Han"},
+	{0x3099, 0x309C, "Hiragana Katakana"},
+	{0x30A0, 0x30A0, "Hiragana Katakana"},
+	{0x30FB, 0x30FB, "Bopomofo Hangul Han Hiragana Katakana Yi"},
+	{0x30FC, 0x30FC, "Hiragana Katakana"},
+	{0x3190, 0x319F, "Han"},
+	{0x31C0, 0x31E3, "Han"},
+	{0x3220, 0x3247, "Han"},
+	{0x3280, 0x32B0, "Han"},
+	{0x32C0, 0x32CB, "Han"},
+	{0x32FF, 0x32FF, "Han"},
+	{0x3358, 0x3370, "Han"},
+	{0x337B, 0x337F, "Han"},
+	{0x33E0, 0x33FE, "Han"},
+	{0xA66F, 0xA66F, "Cyrillic Glagolitic"},
+	{0xA700, 0xA707, "Han Latin"},
+	{0xA830, 0xA832, "Devanagari Dogra Gujarati Gurmukhi Khojki Kannada Kaithi Mahajani Malayalam Modi Nandinagari Khudawadi Takri Tirhuta"},
+	{0xA833, 0xA835, "Devanagari Dogra Gujarati Gurmukhi Khojki Kannada Kaithi Mahajani Modi Nandinagari Khudawadi Takri Tirhuta"},
+	{0xA836, 0xA839, "Devanagari Dogra Gujarati Gurmukhi Khojki Kaithi Mahajani Modi Khudawadi Takri Tirhuta"},
+	{0xA8F1, 0xA8F1, "Bengali Devanagari"},
+	{0xA8F3, 0xA8F3, "Devanagari Tamil"},
+	{0xA92E, 0xA92E, "Kayah_Li Latin Myanmar"},
+	{0xA9CF, 0xA9CF, "Buginese Javanese"},
+	{0xFD3E, 0xFD3F, "Arabic Nko"},
+	{0xFDF2, 0xFDF2, "Arabic Thaana"},
+	{0xFDFD, 0xFDFD, "Arabic Thaana"},
+	{0xFE45, 0xFE46, "Bopomofo Hangul Han Hiragana Katakana"},
+	{0xFF61, 0xFF65, "Bopomofo Hangul Han Hiragana Katakana Yi"},
+	{0xFF70, 0xFF70, "Hiragana Katakana"},
+	{0xFF9E, 0xFF9F, "Hiragana Katakana"},
+	{0x10100, 0x10101, "Cypro_Minoan Cypriot Linear_B"},
+	{0x10102, 0x10102, "Cypriot Linear_B"},
+	{0x10107, 0x10133, "Cypriot Linear_A Linear_B"},
+	{0x10137, 0x1013F, "Cypriot Linear_B"},
+	{0x102E0, 0x102FB, "Arabic Coptic"},
+	{0x10AF2, 0x10AF2, "Manichaean Old_Uyghur"},
+	{0x11301, 0x11301, "Grantha Tamil"},
+	{0x11303, 0x11303, "Grantha Tamil"},
+	{0x1133B, 0x1133C, "Grantha Tamil"},
+	{0x11FD0, 0x11FD1, "Grantha Tamil"},
+	{0x11FD3, 0x11FD3, "Grantha Tamil"},
+	{0x1D360, 0x1D371, "Han"},
+	{0x1F250, 0x1F251, "Han"},
+}
+
+// the short script names, to the long ones
+var scriptAliases = map[string]string{
+	"Adlm": "Adlam",
+	"Aghb": "Caucasian_Albanian",
+	"Arab": "Arabic",
+	"Armi": "Imperial_Aramaic",
+	"Armn": "Armenian",
+	"Avst": "Avestan",
+	"Bali": "Balinese",
+	"Bamu": "Bamum",
+	"Bass": "Bassa_Vah",
+	"Batk": "Batak",
+	"Beng": "Bengali",
+	"Bhks": "Bhaiksuki",
+	"Bopo": "Bopomofo",
+	"Brah": "Brahmi",
+	"Brai": "Braille",
+	"Bugi": "Buginese",
+	"Buhd": "Buhid",
+	"Cakm": "Chakma",
+	"Cans": "Canadian_Aboriginal",
+	"Cari": "Carian",
+	"Cher": "Cherokee",
+	"Chrs": "Chorasmian",
+	"Copt": "Coptic",
+	"Cpmn": "Cypro_Minoan",
+	"Cprt": "Cypriot",
+	"Cyrl": "Cyrillic",
+	"Deva": "Devanagari",
+	"Diak": "Dives_Akuru",
+	"Dogr": "Dogra",
+	"Dsrt": "Deseret",
+	"Dupl": "Duployan",
+	"Egyp": "Egyptian_Hieroglyphs",
+	"Elba": "Elbasan",
+	"Elym": "Elymaic",
+	"Ethi": "Ethiopic",
+	"Geor": "Georgian",
+	"Glag": "Glagolitic",
+	"Gong": "Gunjala_Gondi",
+	"Gonm": "Masaram_Gondi",
+	"Goth": "Gothic",
+	"Gran": "Grantha",
+	"Grek": "Greek",
+	"Gujr": "Gujarati",
+	"Guru": "Gurmukhi",
+	"Hang": "Hangul",
+	"Hani": "Han",
+	"Hano": "Hanunoo",
+	"Hatr": "Hatran",
+	"Hebr": "Hebrew",
+	"Hira": "Hiragana",
+	"Hluw": "Anatolian_Hieroglyphs",
+	"Hmng": "Pahawh_Hmong",
+	"Hmnp": "Nyiakeng_Puachue_Hmong",
+	"Hung": "Old_Hungarian",
+	"Ital": "Old_Italic",
+	"Java": "Javanese",
+	"Kali": "Kayah_Li",
+	"Kana": "Katakana",
+	"Khar": "Kharoshthi",
+	"Khmr": "Khmer",
+	"Khoj": "Khojki",
+	"Kits": "Khitan_Small_Script",
+	"Knda": "Kannada",
+	"Kthi": "Kaithi",
+	"Lana": "Tai_Tham",
+	"Laoo": "Lao",
+	"Latn": "Latin",
+	"Lepc": "Lepcha",
+	"Limb": "Limbu",
+	"Lina": "Linear_A",
+	"Linb": "Linear_B",
+	"Lyci": "Lycian",
+	"Lydi": "Lydian",
+	"Mahj": "Mahajani",
+	"Maka": "Makasar",
+	"Mand": "Mandaic",
+	"Mani": "Manichaean",
+	"Marc": "Marchen",
+	"Medf": "Medefaidrin",
+	"Mend": "Mende_Kikakui",
+	"Merc": "Meroitic_Cursive",
+	"Mero": "Meroitic_Hieroglyphs",
+	"Mlym": "Malayalam",
+	"Mong": "Mongolian",
+	"Mroo": "Mro",
+	"Mtei": "Meetei_Mayek",
+	"Mult": "Multani",
+	"Mymr": "Myanmar",
+	"Nand": "Nandinagari",
+	"Narb": "Old_North_Arabian",
+	"Nbat": "Nabataean",
+	"Nkoo": "Nko",
+	"Nshu": "Nushu",
+	"Ogam": "Ogham",
+	"Olck": "Ol_Chiki",
+	"Orkh": "Old_Turkic",
+	"Orya": "Oriya",
+	"Osge": "Osage",
+	"Osma": "Osmanya",
+	"Ougr": "Old_Uyghur",
+	"Palm": "Palmyrene",
+	"Pauc": "Pau_Cin_Hau",
+	"Perm": "Old_Permic",
+	"Phag": "Phags_Pa",
+	"Phli": "Inscriptional_Pahlavi",
+	"Phlp": "Psalter_Pahlavi",
+	"Phnx": "Phoenician",
+	"Plrd": "Miao",
+	"Prti": "Inscriptional_Parthian",
+	"Rjng": "Rejang",
+	"Rohg": "Hanifi_Rohingya",
+	"Runr": "Runic",
+	"Samr": "Samaritan",
+	"Sarb": "Old_South_Arabian",
+	"Saur": "Saurashtra",
+	"Sgnw": "SignWriting",
+	"Shaw": "Shavian",
+	"Shrd": "Sharada",
+	"Sidd": "Siddham",
+	"Sind": "Khudawadi",
+	"Sinh": "Sinhala",
+	"Sogd": "Sogdian",
+	"Sogo": "Old_Sogdian",
+	"Sora": "Sora_Sompeng",
+	"Soyo": "Soyombo",
+	"Sund": "Sundanese",
+	"Sylo": "Syloti_Nagri",
+	"Syrc": "Syriac",
+	"Tagb": "Tagbanwa",
+	"Takr": "Takri",
+	"Tale": "Tai_Le",
+	"Talu": "New_Tai_Lue",
+	"Taml": "Tamil",
+	"Tang": "Tangut",
+	"Tavt": "Tai_Viet",
+	"Telu": "Telugu",
+	"Tfng": "Tifinagh",
+	"Tglg": "Tagalog",
+	"Thaa": "Thaana",
+	"Tibt": "Tibetan",
+	"Tirh": "Tirhuta",
+	"Tnsa": "Tangsa",
+	"Ugar": "Ugaritic",
+	"Vaii": "Vai",
+	"Vith": "Vithkuqi",
+	"Wara": "Warang_Citi",
+	"Wcho": "Wancho",
+	"Xpeo": "Old_Persian",
+	"Xsux": "Cuneiform",
+	"Yezi": "Yezidi",
+	"Yiii": "Yi",
+	"Zanb": "Zanabazar_Square",
+	"Zinh": "Inherited",
+	"Zyyy": "Common",
+	"Zzzz": "Unknown",
+}
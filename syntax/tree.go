@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"unicode"
 )
 
 type RegexTree struct {
@@ -14,7 +15,11 @@ type RegexTree struct {
 	captop     int
 	Capnames   map[string]int
 	Caplist    []string
+	MaxDepth   int // how deep the pattern's groups nest
 	options    RegexOptions
+	culture    unicode.SpecialCase
+
+	optimizations Optimization
 }
 
 // It is built into a parsed tree for a regular expression.
@@ -62,6 +67,10 @@ type regexNode struct {
 	n        int
 	options  RegexOptions
 	next     *regexNode
+
+	// the span of the pattern a quantifier and what it applies to came
+	// from, for reporting; only the start is kept for groups
+	pos, end int
 }
 
 type nodeType int32
@@ -113,6 +122,19 @@ const (
 
 	ntECMABoundary    = 41 //                          \b
 	ntNonECMABoundary = 42 //                          \B
+
+	ntCall     = 43 //          group           (?R) (?1) (?&name)
+	ntVerb     = 44 // m,str    kind,name       (*COMMIT) (*PRUNE) (*SKIP) (*MARK:name)
+	ntGrapheme = 45 //                          \X
+
+	ntWordSegBoundary     = 46 //                          \b{wb}
+	ntNonWordSegBoundary  = 47 //                          \B{wb}
+	ntGraphemeBoundary    = 48 //                          \b{g}
+	ntNonGraphemeBoundary = 49 //                          \B{g}
+
+	// Only in replacement patterns
+
+	ntCaseChange = 50 //          char            \U \L \u \l \E
 )
 
 func newRegexNode(t nodeType, opt RegexOptions) *regexNode {
@@ -244,7 +266,7 @@ func (n *regexNode) reduceAlternation() *regexNode {
 				n.insertChildren(i+1, at.children)
 
 				j--
-			} else if at.t == ntSet || at.t == ntOne {
+			} else if (at.t == ntSet || at.t == ntOne) && at.options&noMergeSets == 0 {
 				// Cannot merge sets if L or I options differ, or if either are negated.
 				optionsAt := at.options & (RightToLeft | IgnoreCase)
 
@@ -335,7 +357,7 @@ func (n *regexNode) reduceConcatenation() *regexNode {
 			n.insertChildren(i+1, at.children)
 
 			j--
-		} else if at.t == ntMulti || at.t == ntOne {
+		} else if (at.t == ntMulti || at.t == ntOne) && at.options&noJoinStrings == 0 {
 			// Cannot merge strings if L or I options differ
 			optionsAt = at.options & (RightToLeft | IgnoreCase)
 
@@ -397,7 +419,7 @@ func (n *regexNode) reduceRep() *regexNode {
 	min := n.m
 	max := n.n
 
-	for {
+	for n.options&noCoalesceLoops == 0 {
 		if len(u.children) == 0 {
 			break
 		}
@@ -440,6 +462,9 @@ func (n *regexNode) reduceRep() *regexNode {
 	if math.MaxInt32 == min {
 		return newRegexNode(ntNothing, n.options)
 	}
+	if u != n {
+		u.pos, u.end = n.pos, n.end
+	}
 	return u
 
 }
@@ -548,6 +573,9 @@ var typeStr = []string{
 	"Unknown", "Unknown", "Unknown",
 	"Unknown", "Unknown", "Unknown",
 	"ECMABoundary", "NonECMABoundary",
+	"Call", "Verb", "Grapheme",
+	"WordSegBoundary", "NonWordSegBoundary", "GraphemeBoundary", "NonGraphemeBoundary",
+	"CaseChange",
 }
 
 func (n *regexNode) description() string {
@@ -584,12 +612,15 @@ func (n *regexNode) description() string {
 	case ntCapture:
 		buf.WriteString("(index = " + strconv.Itoa(n.m) + ", unindex = " + strconv.Itoa(n.n) + ")")
 		break
-	case ntRef, ntTestref:
+	case ntRef, ntTestref, ntCall:
 		buf.WriteString("(index = " + strconv.Itoa(n.m) + ")")
 		break
 	case ntMulti:
 		fmt.Fprintf(buf, "(String = %s)", string(n.str))
 		break
+	case ntVerb:
+		fmt.Fprintf(buf, "(Kind = %d, Name = %s)", n.m, string(n.str))
+		break
 	case ntSet, ntSetloop, ntSetlazy:
 		buf.WriteString("(Set = " + n.set.String() + ")")
 		break
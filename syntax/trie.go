@@ -0,0 +1,290 @@
+package syntax
+
+import (
+	"bytes"
+	"fmt"
+	"unicode"
+)
+
+// minTrieBranches is how many literal alternatives an alternation needs
+// before it's matched with a LiteralTrie.  Below that, trying the branches
+// one at a time is as quick.
+const minTrieBranches = 8
+
+// LiteralTrie matches an alternation made only of literals, such as a
+// keyword list, in a single walk over the text instead of trying each
+// branch in turn.  It gives the same results as the branches would: of
+// the literals found at a position, the leftmost branch is tried first
+// and the others, in order, when the rest of the pattern backtracks.
+//
+// Its Aho–Corasick failure links let the runner also use it to jump to
+// the next position where any of the literals occurs, when a match has
+// to start with one of them.
+type LiteralTrie struct {
+	nodes      []trieNode
+	strs       [][]rune // the literals, by branch
+	maxLen     int
+	ignoreCase bool
+	culture    unicode.SpecialCase
+}
+
+type trieNode struct {
+	next   map[rune]int
+	branch int // first branch whose literal ends here, or -1
+	depth  int // length of the literal spelled by the path to here
+	fail   int // node for the longest proper suffix of the path that is in the trie
+	output int // nearest node along the failure links with a branch, or -1
+}
+
+// literalAlternation reports whether node is an alternation that's worth
+// turning into a LiteralTrie
+func literalAlternation(node *regexNode) bool {
+	if node.t != ntAlternate || len(node.children) < minTrieBranches {
+		return false
+	}
+	options := node.children[0].options & (IgnoreCase | RightToLeft)
+	if options&RightToLeft != 0 {
+		return false
+	}
+	for _, child := range node.children {
+		if child.t != ntOne && child.t != ntMulti {
+			return false
+		}
+		if child.options&(IgnoreCase|RightToLeft) != options {
+			return false
+		}
+	}
+	return true
+}
+
+// alternationTrie builds the trie for an alternation accepted by
+// literalAlternation
+func alternationTrie(node *regexNode, culture unicode.SpecialCase) *LiteralTrie {
+	strs := make([][]rune, len(node.children))
+	for i, child := range node.children {
+		strs[i] = child.str
+		if child.t == ntOne {
+			strs[i] = []rune{child.ch}
+		}
+	}
+	return newLiteralTrie(strs, node.children[0].options&IgnoreCase != 0, culture)
+}
+
+// newLiteralTrie builds the trie for the branches strs, which are case
+// folded already if ignoreCase is set
+func newLiteralTrie(strs [][]rune, ignoreCase bool, culture unicode.SpecialCase) *LiteralTrie {
+	t := &LiteralTrie{strs: strs, ignoreCase: ignoreCase, culture: culture}
+	t.nodes = append(t.nodes, trieNode{branch: -1, output: -1})
+
+	for i, str := range strs {
+		if len(str) > t.maxLen {
+			t.maxLen = len(str)
+		}
+
+		cur := 0
+		for _, ch := range str {
+			nx, ok := t.nodes[cur].next[ch]
+			if !ok {
+				nx = len(t.nodes)
+				t.nodes = append(t.nodes, trieNode{branch: -1, output: -1, depth: t.nodes[cur].depth + 1})
+				if t.nodes[cur].next == nil {
+					t.nodes[cur].next = make(map[rune]int)
+				}
+				t.nodes[cur].next[ch] = nx
+			}
+			cur = nx
+		}
+		// a literal repeated later in the alternation can never be the
+		// one that matches, since the earlier copy was tried first
+		if t.nodes[cur].branch < 0 {
+			t.nodes[cur].branch = i
+		}
+	}
+
+	t.link()
+	return t
+}
+
+// link fills in the failure and output links, breadth first so that the
+// links of shallower nodes are there when deeper ones need them
+func (t *LiteralTrie) link() {
+	queue := []int{}
+	for _, nx := range t.nodes[0].next {
+		queue = append(queue, nx)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for ch, nx := range t.nodes[cur].next {
+			f := t.nodes[cur].fail
+			for {
+				if g, ok := t.nodes[f].next[ch]; ok {
+					t.nodes[nx].fail = g
+					break
+				}
+				if f == 0 {
+					break
+				}
+				f = t.nodes[f].fail
+			}
+			fl := t.nodes[nx].fail
+			if t.nodes[fl].branch >= 0 {
+				t.nodes[nx].output = fl
+			} else {
+				t.nodes[nx].output = t.nodes[fl].output
+			}
+			queue = append(queue, nx)
+		}
+	}
+}
+
+// Match finds the first branch after the given one whose literal occurs
+// in text at pos, and returns it with the position just past the literal.
+// Pass after = -1 for the first branch that matches.  branch is -1 when
+// no more branches match.
+func (t *LiteralTrie) Match(text []rune, pos, after int) (branch, end int) {
+	branch = -1
+	cur := 0
+	for i := pos; i < len(text); i++ {
+		ch := text[i]
+		if t.ignoreCase {
+			ch = CaseFoldCulture(ch, t.culture)
+		}
+		nx, ok := t.nodes[cur].next[ch]
+		if !ok {
+			break
+		}
+		cur = nx
+		if b := t.nodes[cur].branch; b > after && (branch < 0 || b < branch) {
+			branch, end = b, i+1
+		}
+	}
+	return branch, end
+}
+
+// Next returns the leftmost position at or after pos where one of the
+// literals occurs, or -1 if there's none.
+func (t *LiteralTrie) Next(text []rune, pos int) int {
+	best := -1
+	cur := 0
+	for i := pos; i < len(text); i++ {
+		if best >= 0 && i+1-t.maxLen >= best {
+			// anything ending from here on starts after best
+			break
+		}
+		ch := text[i]
+		if t.ignoreCase {
+			ch = CaseFoldCulture(ch, t.culture)
+		}
+		for {
+			if nx, ok := t.nodes[cur].next[ch]; ok {
+				cur = nx
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = t.nodes[cur].fail
+		}
+
+		o := cur
+		if t.nodes[o].branch < 0 {
+			o = t.nodes[o].output
+		}
+		// the longest literal ending here starts first
+		if o > 0 {
+			if start := i + 1 - t.nodes[o].depth; best < 0 || start < best {
+				best = start
+			}
+		}
+	}
+	return best
+}
+
+// Len returns the number of branches in the trie.
+func (t *LiteralTrie) Len() int {
+	return len(t.strs)
+}
+
+func (t *LiteralTrie) String() string {
+	buf := &bytes.Buffer{}
+	for i, s := range t.strs {
+		if i > 0 {
+			buf.WriteByte('|')
+		}
+		buf.WriteString(Escape(string(s)))
+	}
+	if t.ignoreCase {
+		return fmt.Sprintf("(?i:%s)", buf.String())
+	}
+	return buf.String()
+}
+
+func zeroWidthTest(t nodeType) bool {
+	switch t {
+	case ntBol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary:
+		return true
+	}
+	return false
+}
+
+// leadingTrie returns the alternation a match of tree has to start with,
+// if it's one that will be written as a LiteralTrie
+func leadingTrie(tree *RegexTree) *regexNode {
+	if tree.options&RightToLeft != 0 {
+		return nil
+	}
+	node := tree.root
+	for len(node.children) > 0 {
+		switch node.t {
+		case ntCapture, ntGroup, ntGreedy:
+			if node.t == ntCapture && node.n != -1 {
+				return nil
+			}
+			node = node.children[0]
+		case ntConcatenate:
+			// zero-width tests like the \b in \b(?:if|else|...)\b don't
+			// move the start
+			i := 0
+			for i < len(node.children)-1 && zeroWidthTest(node.children[i].t) {
+				i++
+			}
+			node = node.children[i]
+		case ntAlternate:
+			if literalAlternation(node) {
+				return node
+			}
+			return nil
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// Unfinished returns the first branch after the given one whose literal
+// is longer than what's left of text from pos and starts with all of it,
+// so that it could still match if the text went on, or -1 if there's none.
+func (t *LiteralTrie) Unfinished(text []rune, pos, after int) int {
+	rest := text[pos:]
+	for i := after + 1; i < len(t.strs); i++ {
+		str := t.strs[i]
+		if len(str) <= len(rest) {
+			continue
+		}
+		j := 0
+		for ; j < len(rest); j++ {
+			ch := rest[j]
+			if t.ignoreCase {
+				ch = CaseFoldCulture(ch, t.culture)
+			}
+			if ch != str[j] {
+				break
+			}
+		}
+		if j == len(rest) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,17 @@
+//go:build !regexp2_ucd
+// +build !regexp2_ucd
+
+package syntax
+
+import "unicode"
+
+// Without the regexp2_ucd tag there are no generated tables and the ones
+// from the unicode package are used as they are.  gen_tables.go writes
+// ucd_tables.go, which fills these in.
+const ucdVersion = ""
+
+var (
+	ucdScripts    map[string]*unicode.RangeTable
+	ucdCategories map[string]*unicode.RangeTable
+	ucdProperties map[string]*unicode.RangeTable
+)
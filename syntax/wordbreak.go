@@ -0,0 +1,208 @@
+package syntax
+
+import (
+	"unicode"
+)
+
+// Word_Break values from UAX #29
+type wordBreak uint8
+
+const (
+	wbOther wordBreak = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbZWJ
+	wbRegionalIndicator
+	wbFormat
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+)
+
+var (
+	wbKatakanaTable = mergeTables(unicode.Katakana,
+		rangeTable(0x3031, 0x3035, 0x309B, 0x309C, 0x30A0, 0x30A0, 0x30FC, 0x30FC, 0xFF70, 0xFF70))
+	// scripts written without spaces, whose words need a dictionary to find
+	wbNoSpaceScripts = mergeTables(unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer,
+		unicode.Tai_Le, unicode.New_Tai_Lue, unicode.Tai_Tham, unicode.Tai_Viet, unicode.Ahom)
+	wbALetterTable      = mergeTables(unicode.L, unicode.Nl, unicode.Other_Alphabetic)
+	wbMidNumLetTable    = rangeTable('.', '.', 0x2018, 0x2019, 0x2024, 0x2024, 0xFE52, 0xFE52, 0xFF07, 0xFF07, 0xFF0E, 0xFF0E)
+	wbMidLetterTable    = rangeTable(':', ':', 0x00B7, 0x00B7, 0x0387, 0x0387, 0x055F, 0x055F, 0x05F4, 0x05F4, 0x2027, 0x2027, 0xFE13, 0xFE13, 0xFE55, 0xFE55, 0xFF1A, 0xFF1A)
+	wbMidNumTable       = rangeTable(',', ',', ';', ';', 0x037E, 0x037E, 0x0589, 0x0589, 0x060C, 0x060D, 0x066C, 0x066C, 0x07F8, 0x07F8, 0x2044, 0x2044, 0xFE10, 0xFE10, 0xFE14, 0xFE14, 0xFE50, 0xFE50, 0xFE54, 0xFE54, 0xFF0C, 0xFF0C, 0xFF1B, 0xFF1B)
+	wbExtendNumLetTable = mergeTables(unicode.Pc, rangeTable(0x202F, 0x202F))
+	wbWSegSpaceTable    = rangeTable(' ', ' ', 0x1680, 0x1680, 0x2000, 0x2006, 0x2008, 0x200A, 0x205F, 0x205F, 0x3000, 0x3000)
+)
+
+func wordBreakOf(r rune) wordBreak {
+	switch {
+	case r == '\r':
+		return wbCR
+	case r == '\n':
+		return wbLF
+	case r == 0x0B || r == 0x0C || r == 0x85 || r == 0x2028 || r == 0x2029:
+		return wbNewline
+	case r == 0x200D:
+		return wbZWJ
+	case r == '\'':
+		return wbSingleQuote
+	case r == '"':
+		return wbDoubleQuote
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return wbRegionalIndicator
+	}
+
+	switch graphemeBreakOf(r) {
+	case gbExtend, gbSpacingMark:
+		return wbExtend
+	}
+
+	switch {
+	case unicode.Is(unicode.Cf, r):
+		return wbFormat
+	case unicode.Is(wbKatakanaTable, r):
+		return wbKatakana
+	case unicode.Is(unicode.Hebrew, r) && unicode.Is(unicode.Lo, r):
+		return wbHebrewLetter
+	case unicode.Is(wbALetterTable, r) && !unicode.Is(unicode.Ideographic, r) &&
+		!unicode.Is(unicode.Hiragana, r) && !unicode.Is(wbNoSpaceScripts, r):
+		return wbALetter
+	case unicode.Is(wbMidNumLetTable, r):
+		return wbMidNumLet
+	case unicode.Is(wbMidLetterTable, r):
+		return wbMidLetter
+	case unicode.Is(wbMidNumTable, r):
+		return wbMidNum
+	case unicode.Is(unicode.Nd, r) && !(r >= 0xFF10 && r <= 0xFF19), r == 0x066B:
+		return wbNumeric
+	case unicode.Is(wbExtendNumLetTable, r):
+		return wbExtendNumLet
+	case unicode.Is(wbWSegSpaceTable, r):
+		return wbWSegSpace
+	}
+	return wbOther
+}
+
+// ignored after any char but a line break (WB4)
+func wbIgnorable(w wordBreak) bool {
+	return w == wbExtend || w == wbFormat || w == wbZWJ
+}
+
+func wbAHLetter(w wordBreak) bool {
+	return w == wbALetter || w == wbHebrewLetter
+}
+
+func wbMidNumLetQ(w wordBreak) bool {
+	return w == wbMidNumLet || w == wbSingleQuote
+}
+
+// wbBefore returns the index of the char that counts as the one before
+// text[i], skipping over the ones WB4 ignores, or -1
+func wbBefore(text []rune, i int) int {
+	for i--; i >= 0; i-- {
+		if !wbIgnorable(wordBreakOf(text[i])) {
+			return i
+		}
+	}
+	return -1
+}
+
+// wbAfter is like wbBefore, but looks for the char after text[i]
+func wbAfter(text []rune, i int) int {
+	for i++; i < len(text); i++ {
+		if !wbIgnorable(wordBreakOf(text[i])) {
+			return i
+		}
+	}
+	return len(text)
+}
+
+func wbClassAt(text []rune, i int) wordBreak {
+	if i < 0 || i >= len(text) {
+		return wbOther
+	}
+	return wordBreakOf(text[i])
+}
+
+// IsWordSegBoundary reports whether a UAX #29 word boundary falls between
+// text[i-1] and text[i]
+func IsWordSegBoundary(text []rune, i int) bool {
+	if len(text) == 0 {
+		return false
+	}
+	if i <= 0 || i >= len(text) {
+		return true
+	}
+
+	prev, next := wordBreakOf(text[i-1]), wordBreakOf(text[i])
+	switch {
+	case prev == wbCR && next == wbLF:
+		return false
+	case prev == wbCR || prev == wbLF || prev == wbNewline,
+		next == wbCR || next == wbLF || next == wbNewline:
+		return true
+	case prev == wbZWJ && unicode.Is(extPictTable, text[i]):
+		return false
+	case prev == wbWSegSpace && next == wbWSegSpace:
+		return false
+	case wbIgnorable(next):
+		return false
+	}
+
+	// from here on Extend, Format and ZWJ stick to the char before them
+	b := wbBefore(text, i)
+	if b < 0 {
+		return true
+	}
+	prev = wordBreakOf(text[b])
+	if prev == wbCR || prev == wbLF || prev == wbNewline {
+		return true
+	}
+	prev2 := wbClassAt(text, wbBefore(text, b))
+	next2 := wbClassAt(text, wbAfter(text, i))
+
+	switch {
+	case wbAHLetter(prev) && wbAHLetter(next):
+		return false
+	case wbAHLetter(prev) && (next == wbMidLetter || wbMidNumLetQ(next)) && wbAHLetter(next2):
+		return false
+	case wbAHLetter(prev2) && (prev == wbMidLetter || wbMidNumLetQ(prev)) && wbAHLetter(next):
+		return false
+	case prev == wbHebrewLetter && next == wbSingleQuote:
+		return false
+	case prev == wbHebrewLetter && next == wbDoubleQuote && next2 == wbHebrewLetter:
+		return false
+	case prev2 == wbHebrewLetter && prev == wbDoubleQuote && next == wbHebrewLetter:
+		return false
+	case prev == wbNumeric && next == wbNumeric,
+		wbAHLetter(prev) && next == wbNumeric,
+		prev == wbNumeric && wbAHLetter(next):
+		return false
+	case prev2 == wbNumeric && (prev == wbMidNum || wbMidNumLetQ(prev)) && next == wbNumeric:
+		return false
+	case prev == wbNumeric && (next == wbMidNum || wbMidNumLetQ(next)) && next2 == wbNumeric:
+		return false
+	case prev == wbKatakana && next == wbKatakana:
+		return false
+	case (wbAHLetter(prev) || prev == wbNumeric || prev == wbKatakana || prev == wbExtendNumLet) && next == wbExtendNumLet,
+		prev == wbExtendNumLet && (wbAHLetter(next) || next == wbNumeric || next == wbKatakana):
+		return false
+	case prev == wbRegionalIndicator && next == wbRegionalIndicator:
+		// flags pair up regional indicators from the left
+		n := 0
+		for j := b; j >= 0 && wordBreakOf(text[j]) == wbRegionalIndicator; j = wbBefore(text, j) {
+			n++
+		}
+		return n%2 == 0
+	}
+	return true
+}
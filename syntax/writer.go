@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"unicode"
 )
 
 func Write(tree *RegexTree) (*Code, error) {
@@ -38,6 +39,25 @@ type writer struct {
 	count       int
 	trackcount  int
 	caps        map[int]int
+
+	// memoization bookkeeping, see MemoLoops
+	nesting   int
+	hasRefs   bool
+	memoLoops []int
+
+	// subroutine calls: the groups that are called, where their code
+	// starts, and the Call instructions to patch once it's all emitted
+	called      map[int]bool
+	groupStarts map[int]int
+	callSites   []int
+
+	// alternations of literals written as a Trie instruction, and the
+	// table of their tries
+	useTries bool
+	leading  *regexNode
+	tries    []*LiteralTrie
+	trieNode map[*regexNode]int
+	culture  unicode.SpecialCase
 }
 
 const (
@@ -75,6 +95,15 @@ func (w *writer) codeFromTree(tree *RegexTree) (*Code, error) {
 		}
 	}
 
+	w.called = calledGroups(tree.root, nil)
+	w.groupStarts = make(map[int]int)
+	w.trieNode = make(map[*regexNode]int)
+	w.useTries = tree.optimizations&OptLiteralTries != 0
+	if w.useTries {
+		w.leading = leadingTrie(tree)
+	}
+	w.culture = tree.culture
+
 	w.counting = true
 
 	for {
@@ -90,6 +119,9 @@ func (w *writer) codeFromTree(tree *RegexTree) (*Code, error) {
 		for {
 			if len(curNode.children) == 0 {
 				w.emitFragment(curNode.t, curNode, 0)
+			} else if w.useTries && literalAlternation(curNode) {
+				// its branches are all in the trie, so they aren't visited
+				w.emit1(Trie, w.trieCode(curNode))
 			} else if curChild < len(curNode.children) {
 				w.emitFragment(curNode.t|beforeChild, curNode, curChild)
 
@@ -121,6 +153,10 @@ func (w *writer) codeFromTree(tree *RegexTree) (*Code, error) {
 		w.counting = false
 	}
 
+	for _, pos := range w.callSites {
+		w.emitted[pos+1] = w.groupStarts[w.emitted[pos+2]]
+	}
+
 	fcPrefix := getFirstCharsPrefix(tree)
 	prefix := getPrefix(tree)
 	rtl := (tree.options & RightToLeft) != 0
@@ -133,21 +169,38 @@ func (w *writer) codeFromTree(tree *RegexTree) (*Code, error) {
 			prefix.PrefixStr = prefix.PrefixStr[:MaxPrefixSize]
 		}
 		bmPrefix = newBmPrefix(prefix.PrefixStr, prefix.CaseInsensitive, rtl)
-	} else {
+	}
+	if bmPrefix != nil && bmPrefix.caseInsensitive && tree.culture != nil {
+		// Boyer-Moore only knows the invariant folding
 		bmPrefix = nil
 	}
+	var leading *LiteralTrie
+	if w.leading != nil {
+		leading = w.tries[w.trieNode[w.leading]]
+	}
+
+	anchors := getAnchors(tree)
+	capNFA := compileCaptureNFA(tree, w.caps)
 
 	return &Code{
 		Codes:       w.emitted,
 		Strings:     w.stringtable,
 		Sets:        w.settable,
+		SetsASCII:   asciiSets(w.settable),
 		TrackCount:  w.trackcount,
 		Caps:        w.caps,
 		Capsize:     capsize,
 		FcPrefix:    fcPrefix,
 		BmPrefix:    bmPrefix,
-		Anchors:     getAnchors(tree),
+		Anchors:     anchors,
 		RightToLeft: rtl,
+		MemoLoops:   w.memoizableLoops(tree),
+		NFA:         compileNFA(tree),
+		CapNFA:      capNFA,
+		OnePass:     compileOnePass(capNFA, anchors),
+		Culture:     tree.culture,
+		Tries:       w.tries,
+		LeadingTrie: leading,
 	}, nil
 }
 
@@ -167,6 +220,14 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 	}
 	ntBits := nodeType(bits)
 
+	if nodetype&(beforeChild|afterChild) != 0 && opensScope(nodetype&^(beforeChild|afterChild)) {
+		if nodetype&beforeChild != 0 {
+			w.nesting++
+		} else {
+			w.nesting--
+		}
+	}
+
 	switch nodetype {
 	case ntConcatenate | beforeChild, ntConcatenate | afterChild, ntEmpty:
 		break
@@ -191,6 +252,7 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 		break
 
 	case ntTestref | beforeChild:
+		w.hasRefs = true
 		if curIndex == 0 {
 			w.emit(Setjump)
 			w.pushInt(w.curPos())
@@ -271,6 +333,9 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 				w.emit2(InstOp(Branchcount+lazy), w.popInt(), node.n-node.m)
 			}
 		} else {
+			if lazy == 0 && w.nesting == 0 && !w.counting {
+				w.memoLoops = append(w.memoLoops, w.curPos())
+			}
 			w.emit1(InstOp(Branchmark+lazy), w.popInt())
 		}
 
@@ -281,10 +346,22 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 	case ntGroup | beforeChild, ntGroup | afterChild:
 
 	case ntCapture | beforeChild:
+		if w.called[node.m] && !w.counting {
+			if _, ok := w.groupStarts[w.mapCapnum(node.m)]; !ok {
+				w.groupStarts[w.mapCapnum(node.m)] = w.curPos()
+			}
+		}
 		w.emit(Setmark)
 
 	case ntCapture | afterChild:
+		if node.n != -1 {
+			// balancing groups can fail depending on earlier captures
+			w.hasRefs = true
+		}
 		w.emit2(Capturemark, w.mapCapnum(node.m), w.mapCapnum(node.n))
+		if w.called[node.m] {
+			w.emit1(Return, w.mapCapnum(node.m))
+		}
 
 	case ntRequire | beforeChild:
 		// NOTE: the following line causes lookahead/lookbehind to be
@@ -354,11 +431,37 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 		w.emit1(InstOp(node.t|ntBits), w.setCode(node.set))
 
 	case ntRef:
+		w.hasRefs = true
 		w.emit1(InstOp(node.t|ntBits), w.mapCapnum(node.m))
 
+	case ntCall:
+		// where a loop ends up after a call depends on the call stack
+		w.hasRefs = true
+		if !w.counting {
+			w.callSites = append(w.callSites, w.curPos())
+		}
+		w.emit2(Call, 0, w.mapCapnum(node.m))
+
+	case ntVerb:
+		name := -1
+		if node.str != nil {
+			name = w.stringCode(node.str)
+		}
+		w.emit2(Verb, node.m, name)
+
 	case ntNothing, ntBol, ntEol, ntBoundary, ntNonboundary, ntECMABoundary, ntNonECMABoundary, ntBeginning, ntStart, ntEndZ, ntEnd:
 		w.emit(InstOp(node.t))
 
+	case ntGrapheme:
+		if node.options&RightToLeft != 0 {
+			w.emit(Grapheme | Rtl)
+		} else {
+			w.emit(Grapheme)
+		}
+
+	case ntWordSegBoundary, ntNonWordSegBoundary, ntGraphemeBoundary, ntNonGraphemeBoundary:
+		w.emit(WordSegBoundary + InstOp(node.t-ntWordSegBoundary))
+
 	default:
 		return fmt.Errorf("unexpected opcode in regular expression generation: %v", nodetype)
 	}
@@ -366,6 +469,45 @@ func (w *writer) emitFragment(nodetype nodeType, node *regexNode, curIndex int)
 	return nil
 }
 
+// Returns true for the nodes whose children can be re-entered or have their
+// backtracking state discarded, which is what rules out memoizing a loop
+// nested inside them.
+func opensScope(t nodeType) bool {
+	switch t {
+	case ntLoop, ntLazyloop, ntRequire, ntPrevent, ntGreedy, ntTestref, ntTestgroup:
+		return true
+	}
+	return false
+}
+
+// Returns the set of group numbers that are the target of a subroutine
+// call somewhere in the tree.
+func calledGroups(node *regexNode, called map[int]bool) map[int]bool {
+	if node.t == ntCall {
+		if called == nil {
+			called = make(map[int]bool)
+		}
+		called[node.m] = true
+	}
+	for _, child := range node.children {
+		called = calledGroups(child, called)
+	}
+	return called
+}
+
+// Returns the greedy unbounded group loops that are safe to memoize.
+// Only loops outside any other loop, lookaround, atomic group or
+// conditional qualify: once such a loop has completed a nonempty
+// iteration, whether the rest of the pattern can match depends on the
+// text position alone.  Backreferences and balancing groups make the
+// outcome depend on captures as well, so they disable memoization.
+func (w *writer) memoizableLoops(tree *RegexTree) []int {
+	if tree.options&Memoize == 0 || w.hasRefs {
+		return nil
+	}
+	return w.memoLoops
+}
+
 // To avoid recursion, we use a simple integer stack.
 // This is the push.
 func (w *writer) pushInt(i int) {
@@ -418,6 +560,22 @@ func (w *writer) setCode(set *CharSet) int {
 	return i
 }
 
+// Returns the index in the trie table of the trie for a literal
+// alternation, building it the first time.
+func (w *writer) trieCode(node *regexNode) int {
+	if w.counting {
+		return 0
+	}
+
+	i, ok := w.trieNode[node]
+	if !ok {
+		i = len(w.tries)
+		w.trieNode[node] = i
+		w.tries = append(w.tries, alternationTrie(node, w.culture))
+	}
+	return i
+}
+
 // Returns an index in the string table for a string.
 // uses a map to eliminate duplicates.
 func (w *writer) stringCode(str []rune) int {
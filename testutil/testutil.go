@@ -0,0 +1,207 @@
+// Package testutil runs patterns through both regexp2 and the standard
+// library's regexp and compares what they match, for test suites moving
+// patterns from one engine to the other:
+//
+//	func TestPatterns(t *testing.T) {
+//		testutil.CheckCorpus(t, patterns, 0, testutil.Inputs...)
+//	}
+//
+// A pattern is given to regexp as regexp2.TranslateRE2 rewrites it, so it
+// can be written in regexp2's syntax, with its options, and the two are
+// only compared on patterns that regexp can run.
+package testutil
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jviksne/regexp2"
+)
+
+// ErrIncompatible is what the error from Compare wraps when regexp can't
+// run the pattern, because it uses a backreference, say.
+var ErrIncompatible = errors.New("testutil: pattern can't be run by regexp")
+
+// Diff is a difference in what the two engines matched.  The matches are
+// the ones FindAllStringSubmatchIndex gives: byte offsets of each match
+// and its groups, with -1 for groups that didn't take part.
+type Diff struct {
+	Pattern string // the pattern as regexp2 took it
+	RE2     string // the pattern as regexp took it
+	Input   string
+
+	Regexp  [][]int // what regexp matched
+	Regexp2 [][]int // what regexp2 matched
+}
+
+func (d *Diff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q (%q for regexp) on %q:", d.Pattern, d.RE2, d.Input)
+	for i := 0; i < len(d.Regexp) || i < len(d.Regexp2); i++ {
+		var want, got []int
+		if i < len(d.Regexp) {
+			want = d.Regexp[i]
+		}
+		if i < len(d.Regexp2) {
+			got = d.Regexp2[i]
+		}
+		if !reflect.DeepEqual(want, got) {
+			fmt.Fprintf(&b, "\n\tmatch %v: regexp found %v, regexp2 found %v", i, spans(d.Input, want), spans(d.Input, got))
+		}
+	}
+	return b.String()
+}
+
+// spans describes a match's groups with their text
+func spans(input string, loc []int) string {
+	if loc == nil {
+		return "nothing"
+	}
+	var parts []string
+	for i := 0; i+1 < len(loc); i += 2 {
+		if loc[i] < 0 {
+			parts = append(parts, "-")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%v-%v %q", loc[i], loc[i+1], input[loc[i]:loc[i+1]]))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// Compare matches pattern, compiled with opt, against input with both
+// engines, and returns how they differ, or nil if they don't.
+func Compare(pattern string, opt regexp2.RegexOptions, input string) (*Diff, error) {
+	re, re2, err := compile(pattern, opt)
+	if err != nil {
+		return nil, err
+	}
+	return compare(re, re2, input), nil
+}
+
+// compile compiles pattern for both engines
+func compile(pattern string, opt regexp2.RegexOptions) (*regexp2.Regexp, *regexp.Regexp, error) {
+	re, err := regexp2.Compile(pattern, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	translated, err := regexp2.TranslateRE2(pattern, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrIncompatible, err)
+	}
+	re2, err := regexp.Compile(translated)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrIncompatible, err)
+	}
+	return re, re2, nil
+}
+
+func compare(re *regexp2.Regexp, re2 *regexp.Regexp, input string) *Diff {
+	want := re2.FindAllStringSubmatchIndex(input, -1)
+	got := regexp2.Std(re).FindAllStringSubmatchIndex(input, -1)
+	if reflect.DeepEqual(want, got) {
+		return nil
+	}
+	return &Diff{Pattern: re.String(), RE2: re2.String(), Input: input, Regexp: want, Regexp2: got}
+}
+
+// Check reports an error on t for each of inputs that the engines match
+// pattern differently on.  It fails t if pattern doesn't compile, and
+// skips it if regexp can't run it.
+func Check(t testing.TB, pattern string, opt regexp2.RegexOptions, inputs ...string) {
+	t.Helper()
+	re, re2, err := compile(pattern, opt)
+	if errors.Is(err, ErrIncompatible) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range inputs {
+		if d := compare(re, re2, input); d != nil {
+			t.Error(d)
+		}
+	}
+}
+
+// CheckCorpus is Check for each of patterns, but logs the ones regexp
+// can't run rather than skipping t, and goes on after the ones that don't
+// compile.
+func CheckCorpus(t testing.TB, patterns []string, opt regexp2.RegexOptions, inputs ...string) {
+	t.Helper()
+	for _, pattern := range patterns {
+		re, re2, err := compile(pattern, opt)
+		if errors.Is(err, ErrIncompatible) {
+			t.Logf("%q: %v", pattern, err)
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", pattern, err)
+			continue
+		}
+		for _, input := range inputs {
+			if d := compare(re, re2, input); d != nil {
+				t.Error(d)
+			}
+		}
+	}
+}
+
+// Inputs are texts that tend to show up differences between engines:
+// empty text, line ends of each kind, word boundaries, case, and text
+// outside ASCII.
+var Inputs = []string{
+	"",
+	"a",
+	"abc abc",
+	"aaa",
+	"ab\nab\n",
+	"line one\r\nline two\r\n",
+	"\n\n",
+	"The Quick brown fox, 42 times.",
+	"foo_bar-baz 1.5e10",
+	"  tabs\tand spaces  ",
+	"héllo wörld ΣΑΣ straße",
+	"日本語のテキスト",
+	"emoji 😀 and   nbsp",
+	"x=1; y=22; z=333",
+	"<a href=\"x\">link</a>",
+}
+
+// ReadCorpus reads a corpus of patterns or inputs from the file name, one
+// to a line.  Blank lines and lines starting with # are skipped, and a
+// line in double quotes or backquotes is unquoted as a Go string, for one
+// with a newline, or spaces at the ends.
+func ReadCorpus(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var corpus []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if len(line) >= 2 && (line[0] == '"' || line[0] == '`') && line[len(line)-1] == line[0] {
+			if line, err = strconv.Unquote(line); err != nil {
+				return nil, fmt.Errorf("%v:%v: %v", name, n, err)
+			}
+		}
+		corpus = append(corpus, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return corpus, nil
+}
@@ -0,0 +1,77 @@
+package testutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/jviksne/regexp2"
+)
+
+func TestCheckCorpus(t *testing.T) {
+	patterns := []string{
+		`a`, `\w+`, `\d+`, `(?m)^\w+$`, `(a|ab)(c|bcd)?`, `a*`, `x*?`, `(?i)σ`,
+		`[^a-z]+`, `\s+`, `.+`, `(?s).+`, `(\w+)=(\d+)`, `(?<tag><\w+)`,
+		`\p{L}+`, `[[:alpha:]]+`, `(?:a|b)*c`, `(a)|b`,
+	}
+	CheckCorpus(t, patterns, 0, Inputs...)
+	CheckCorpus(t, patterns, regexp2.RE2, Inputs...)
+}
+
+func TestCompare(t *testing.T) {
+	if d, err := Compare(`(\w+) (\w+)`, 0, "abc abc"); d != nil || err != nil {
+		t.Fatalf("wanted no difference, got %v, %v", d, err)
+	}
+	if _, err := Compare(`(a)\1`, 0, "aa"); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("wanted ErrIncompatible, got %v", err)
+	}
+	if _, err := Compare(`(a`, 0, "aa"); err == nil || errors.Is(err, ErrIncompatible) {
+		t.Fatalf("wanted a parse error, got %v", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	d := compare(regexp2.MustCompile(`(a)+|(b)`, 0), regexp.MustCompile(`(a)|(b)`), "aab")
+	if d == nil {
+		t.Fatal("wanted a difference")
+	}
+	if want := [][]int{{0, 2, 1, 2, -1, -1}, {2, 3, -1, -1, 2, 3}}; !reflect.DeepEqual(d.Regexp2, want) {
+		t.Errorf("wanted %v, got %v", want, d.Regexp2)
+	}
+	s := d.String()
+	for _, want := range []string{
+		`match 0: regexp found [0-1 "a", 0-1 "a", -], regexp2 found [0-2 "aa", 1-2 "a", -]`,
+		`match 1: regexp found [1-2 "a", 1-2 "a", -], regexp2 found [2-3 "b", -, 2-3 "b"]`,
+		`match 2: regexp found [2-3 "b", -, 2-3 "b"], regexp2 found nothing`,
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("wanted %v in:\n%v", want, s)
+		}
+	}
+}
+
+func TestReadCorpus(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "corpus.txt")
+	text := "# a comment\nplain line\n\n\"two\\nlines\"\n`  spaced  `\n"
+	if err := os.WriteFile(name, []byte(text), 0666); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadCorpus(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"plain line", "two\nlines", "  spaced  "}; !reflect.DeepEqual(want, got) {
+		t.Fatalf("wanted %q, got %q", want, got)
+	}
+
+	if err := os.WriteFile(name, []byte("\"bad \\q\"\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadCorpus(name); err == nil || !strings.Contains(err.Error(), ":1:") {
+		t.Fatalf("wanted an error for line 1, got %v", err)
+	}
+}
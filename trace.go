@@ -0,0 +1,112 @@
+package regexp2
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Tracer is told what the backtracking engine does while it searches, for
+// working out why a pattern is slow or why it matched what it did.  Set
+// one as a Regexp's Tracer; it's called from the goroutine doing the
+// search, so a Regexp that's used concurrently needs a Tracer that can be.
+//
+// Tracing makes searches much slower.  It also turns off the quick
+// automaton and generated matchers, so what's traced is the backtracker
+// doing all of the work, though positions the prefix scans rule out still
+// aren't tried.
+type Tracer interface {
+	Trace(e TraceEvent)
+}
+
+// TracerFunc is a function used as a Tracer.
+type TracerFunc func(e TraceEvent)
+
+// Trace calls f(e).
+func (f TracerFunc) Trace(e TraceEvent) {
+	f(e)
+}
+
+// TraceKind is what a TraceEvent is about.
+type TraceKind int
+
+const (
+	// TraceAttempt is the start of an attempt to match at Pos.
+	TraceAttempt TraceKind = iota + 1
+
+	// TraceStep is an instruction run with the text at Pos, going forward
+	// or, if Op ends in Back or Back2, backtracking into it.  Branches
+	// taken show up as the steps after them.
+	TraceStep
+
+	// TraceBacktrack is a failure that sends the engine back to the last
+	// choice it made, the instruction at Instruction.
+	TraceBacktrack
+
+	// TraceCapture is Group capturing the text from Start to End.
+	TraceCapture
+
+	// TraceMatch is an attempt that matched, from Start to End.
+	TraceMatch
+
+	// TraceFail is an attempt at Pos that didn't match.
+	TraceFail
+)
+
+var traceKindNames = []string{"", "attempt", "step", "backtrack", "capture", "match", "fail"}
+
+func (k TraceKind) String() string {
+	if k > 0 && int(k) < len(traceKindNames) {
+		return traceKindNames[k]
+	}
+	return "TraceKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// TraceEvent is one thing the engine did.  Positions are rune indexes into
+// the text searched, and instructions are offsets into the compiled
+// program, as in the Debug option's output.
+type TraceEvent struct {
+	Kind        TraceKind
+	Pos         int    // where in the text the engine is
+	Instruction int    // the instruction run, or gone back to
+	Op          string // a description of the instruction, for TraceStep
+
+	Group      string // the group's name or number, for TraceCapture
+	Start, End int    // the text captured or matched
+}
+
+func (e TraceEvent) String() string {
+	switch e.Kind {
+	case TraceStep:
+		return fmt.Sprintf("step at %v: %v", e.Pos, e.Op)
+	case TraceBacktrack:
+		return fmt.Sprintf("backtrack at %v to %06d", e.Pos, e.Instruction)
+	case TraceCapture:
+		return fmt.Sprintf("capture group %v: %v-%v", e.Group, e.Start, e.End)
+	case TraceMatch:
+		return fmt.Sprintf("match: %v-%v", e.Start, e.End)
+	}
+	return fmt.Sprintf("%v at %v", e.Kind, e.Pos)
+}
+
+func (r *runner) traceStep() {
+	r.tracer.Trace(TraceEvent{
+		Kind:        TraceStep,
+		Pos:         r.runtextpos,
+		Instruction: r.codepos,
+		Op:          r.code.OpcodeDescription(r.codepos) + backDescription(r.operator),
+	})
+}
+
+func (r *runner) traceCapture(capnum, start, end int) {
+	name := strconv.Itoa(capnum)
+	if r.re.capslist != nil && capnum < len(r.re.capslist) {
+		name = r.re.capslist[capnum]
+	}
+	r.tracer.Trace(TraceEvent{
+		Kind:  TraceCapture,
+		Pos:   r.runtextpos,
+		Group: name,
+		Start: start,
+		End:   end,
+	})
+}
@@ -0,0 +1,54 @@
+package regexp2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTracer(t *testing.T) {
+	var events []TraceEvent
+	re := MustCompile(`(?<x>b+)c`, 0)
+	re.Tracer = TracerFunc(func(e TraceEvent) { events = append(events, e) })
+	m, err := re.FindStringMatch("abbbd bc")
+	if err != nil || m == nil {
+		t.Fatalf("no match: %v", err)
+	}
+
+	counts := map[TraceKind]int{}
+	var captures, matches []string
+	for _, e := range events {
+		counts[e.Kind]++
+		switch e.Kind {
+		case TraceCapture:
+			captures = append(captures, e.String())
+		case TraceMatch:
+			matches = append(matches, e.String())
+		case TraceStep:
+			if e.Op == "" {
+				t.Errorf("step with no description: %v", e)
+			}
+		}
+	}
+	if counts[TraceAttempt] == 0 || counts[TraceAttempt] != counts[TraceFail]+1 {
+		t.Errorf("got %v attempts and %v failures", counts[TraceAttempt], counts[TraceFail])
+	}
+	if counts[TraceBacktrack] == 0 || counts[TraceStep] == 0 {
+		t.Errorf("got %v backtracks and %v steps", counts[TraceBacktrack], counts[TraceStep])
+	}
+	// b+ is captured each way it's backtracked through before c fails
+	want := []string{"capture group x: 1-4", "capture group x: 1-3", "capture group x: 1-2", "capture group x: 2-4",
+		"capture group x: 2-3", "capture group x: 3-4", "capture group x: 6-7", "capture group 0: 6-8"}
+	if strings.Join(captures, ";") != strings.Join(want, ";") {
+		t.Errorf("got captures %q, want %q", captures, want)
+	}
+	if want := "match: 6-8"; len(matches) != 1 || matches[0] != want {
+		t.Errorf("got matches %q, want %q", matches, want)
+	}
+
+	// a Regexp without a Tracer goes back to the quick paths
+	re.Tracer = nil
+	events = nil
+	if ok, _ := re.MatchString("abbbd bc"); !ok || len(events) != 0 {
+		t.Errorf("got %v, %v events", ok, len(events))
+	}
+}
@@ -0,0 +1,106 @@
+package regexp2
+
+import (
+	"errors"
+	"sort"
+	"unicode/utf16"
+)
+
+// The UTF-16 functions match text held as UTF-16 code units, as
+// JavaScript and Java strings are, and give positions in code units, so
+// an interpreter for such a language can use them on its strings as they
+// are.  Surrogate pairs are matched as the one character they encode;
+// a surrogate that isn't part of a pair is matched as itself, so \uD800
+// still finds it.
+
+// MatchUTF16 reports whether the UTF-16 text s contains a match.
+func (re *Regexp) MatchUTF16(s []uint16) (bool, error) {
+	input, _ := decodeUTF16(s)
+	m, err := re.run(true, -1, input)
+	if err != nil {
+		return false, err
+	}
+	return m != nil, nil
+}
+
+// FindUTF16SubmatchIndex returns the positions, in code units, of the first
+// match in s at or after startAt, also a code unit offset, and of its
+// groups, in pairs as FindStringSubmatchIndex does.  A group that took no
+// part in the match has -1 for both.  startAt of -1 searches all of s,
+// from the end if the Regexp is RightToLeft.  It returns nil if there's
+// no match.
+func (re *Regexp) FindUTF16SubmatchIndex(s []uint16, startAt int) ([]int, error) {
+	input, offs := decodeUTF16(s)
+	if startAt >= 0 {
+		if startAt > len(s) {
+			return nil, errors.New("startAt must not be past the end of the input")
+		}
+		if offs != nil {
+			// the first character that starts there or after
+			startAt = sort.SearchInts(offs, startAt)
+		}
+	}
+
+	m, err := re.run(false, startAt, input)
+	if m == nil || err != nil {
+		return nil, err
+	}
+	loc := make([]int, 0, 2*len(m.matchcount))
+	for i, count := range m.matchcount {
+		if count == 0 {
+			loc = append(loc, -1, -1)
+			continue
+		}
+		index, length := m.matches[i][(count-1)*2], m.matches[i][count*2-1]
+		loc = append(loc, offs.at(index), offs.at(index+length))
+	}
+	return loc, nil
+}
+
+// FindAllUTF16Index returns the positions, in code units, of up to n
+// successive matches in s (all of them if n < 0), as FindAllStringIndex
+// does.
+func (re *Regexp) FindAllUTF16Index(s []uint16, n int) [][]int {
+	if n == 0 {
+		return nil
+	}
+	input, offs := decodeUTF16(s)
+	return re.appendAllRunesIndex(nil, input, n, false, offs)
+}
+
+// decodeUTF16 returns the characters of s, and the offset in s of each
+// one, with one more for the end of s, or nil if there are no surrogate
+// pairs and so the offsets are the indexes
+func decodeUTF16(s []uint16) ([]rune, byteOffsets) {
+	pairs := false
+	for i := 0; i+1 < len(s); i++ {
+		if isSurrogatePair(s[i], s[i+1]) {
+			pairs = true
+			break
+		}
+	}
+
+	runes := make([]rune, 0, len(s))
+	if !pairs {
+		for _, c := range s {
+			runes = append(runes, rune(c))
+		}
+		return runes, nil
+	}
+
+	offs := make(byteOffsets, 0, len(s)+1)
+	for i := 0; i < len(s); i++ {
+		offs = append(offs, i)
+		if i+1 < len(s) && isSurrogatePair(s[i], s[i+1]) {
+			runes = append(runes, utf16.DecodeRune(rune(s[i]), rune(s[i+1])))
+			i++
+			continue
+		}
+		runes = append(runes, rune(s[i]))
+	}
+	return runes, append(offs, len(s))
+}
+
+func isSurrogatePair(hi, lo uint16) bool {
+	return 0xd800 <= hi && hi < 0xdc00 && 0xdc00 <= lo && lo < 0xe000
+}
@@ -0,0 +1,47 @@
+package regexp2
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestUTF16(t *testing.T) {
+	s := utf16.Encode([]rune("a😀b😀c"))
+
+	if got := MustCompile(`.`, 0).FindAllUTF16Index(s, -1); !reflect.DeepEqual(got, [][]int{{0, 1}, {1, 3}, {3, 4}, {4, 6}, {6, 7}}) {
+		t.Errorf("unexpected matches %v", got)
+	}
+	if got := MustCompile(`\w+`, 0).FindAllUTF16Index(utf16.Encode([]rune("ab cd")), 1); !reflect.DeepEqual(got, [][]int{{0, 2}}) {
+		t.Errorf("unexpected matches %v", got)
+	}
+
+	tests := []struct {
+		pattern string
+		opt     RegexOptions
+		input   []uint16
+		startAt int
+		want    []int
+	}{
+		{`(x)?(b)`, 0, s, -1, []int{3, 4, -1, -1, 3, 4}},
+		{`😀(.)`, 0, s, -1, []int{1, 4, 3, 4}},
+		{`😀`, 0, s, 2, []int{4, 6}},
+		{`.`, 0, s, 2, []int{3, 4}},
+		{`😀`, RightToLeft, s, -1, []int{4, 6}},
+		{`\uD800`, 0, []uint16{'a', 0xd800, 'b'}, -1, []int{1, 2}},
+		{`z`, 0, s, -1, nil},
+	}
+	for _, test := range tests {
+		got, err := MustCompile(test.pattern, test.opt).FindUTF16SubmatchIndex(test.input, test.startAt)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v from %v: wanted %v, got %v %v", test.pattern, test.startAt, test.want, got, err)
+		}
+	}
+	if _, err := MustCompile(`a`, 0).FindUTF16SubmatchIndex(s, 8); err == nil {
+		t.Error("expected an error for startAt past the end")
+	}
+
+	if ok, _ := MustCompile(`b😀c$`, 0).MatchUTF16(s); !ok {
+		t.Error("expected a match")
+	}
+}
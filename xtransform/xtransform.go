@@ -0,0 +1,168 @@
+// Package xtransform replaces the matches of a regexp2 pattern in text
+// going through a golang.org/x/text/transform chain, so a replacement can
+// follow a charset decoder and be written through transform.NewWriter or
+// read through transform.NewReader without holding all the text at once.
+//
+//	t, err := xtransform.New(regexp2.MustCompile(`\bcolour\b`, 0), "color")
+//	r := transform.NewReader(f, transform.Chain(charmap.ISO8859_1.NewDecoder(), t))
+//
+// It's a package of its own so that regexp2 itself doesn't depend on
+// golang.org/x/text.
+package xtransform
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/jviksne/regexp2"
+	"golang.org/x/text/transform"
+)
+
+// Transformer is a transform.Transformer that replaces each match of a
+// Regexp.  Matches are found as Replace would find them in the whole
+// text, with two limits: lookbehinds and \b see at most
+// regexp2.DefaultStreamLookbehind runes of the text already written, and
+// a match, or a match that's still possible, must fit in the source
+// buffer, which is 4096 bytes for transform.NewReader and NewWriter.
+// RightToLeft patterns can't be used.
+type Transformer struct {
+	re   *regexp2.Regexp
+	repl func(m *regexp2.Match) (string, error)
+
+	ctx     []rune // the end of the text already consumed
+	pending []byte // replaced text that didn't fit in dst
+}
+
+var _ transform.Transformer = (*Transformer)(nil)
+
+// New returns a Transformer that replaces each match of re with the
+// replacement pattern, which is expanded as in Replace.  $`, $' and $_
+// can't be used, since the text around a match isn't all there.
+func New(re *regexp2.Regexp, replacement string) (*Transformer, error) {
+	for i := 0; i < len(replacement)-1; i++ {
+		if replacement[i] != '$' {
+			continue
+		}
+		switch replacement[i+1] {
+		case '`', '\'', '_':
+			return nil, errors.New("xtransform: replacement patterns with $`, $' or $_ can't be used")
+		}
+		i++ // past $$ and the like
+	}
+	return newTransformer(re, func(m *regexp2.Match) (string, error) {
+		return m.Result(replacement)
+	})
+}
+
+// NewFunc returns a Transformer that replaces each match of re with what
+// the evaluator returns for it.
+func NewFunc(re *regexp2.Regexp, evaluator regexp2.MatchEvaluator) (*Transformer, error) {
+	return newTransformer(re, func(m *regexp2.Match) (string, error) {
+		return evaluator(*m), nil
+	})
+}
+
+func newTransformer(re *regexp2.Regexp, repl func(m *regexp2.Match) (string, error)) (*Transformer, error) {
+	if re.RightToLeft() {
+		return nil, errors.New("xtransform: RightToLeft patterns can't be used")
+	}
+	return &Transformer{re: re, repl: repl}, nil
+}
+
+// Reset implements transform.Transformer, readying t for new text.
+func (t *Transformer) Reset() {
+	t.ctx = t.ctx[:0]
+	t.pending = t.pending[:0]
+}
+
+// Transform implements transform.Transformer.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if len(t.pending) > 0 {
+		nDst = copy(dst, t.pending)
+		t.pending = t.pending[:copy(t.pending, t.pending[nDst:])]
+		if len(t.pending) > 0 {
+			return nDst, 0, transform.ErrShortDst
+		}
+	}
+
+	valid := len(src)
+	if !atEOF {
+		// leave a character that's cut off for the next call
+		for i := len(src) - 1; i >= 0 && i >= len(src)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(src[i]) {
+				if !utf8.FullRune(src[i:]) {
+					valid = i
+				}
+				break
+			}
+		}
+	}
+	s := string(src[:valid])
+	offs := make([]int, 0, len(s)+1) // byte offset in src of each rune
+	for i := range s {
+		offs = append(offs, i)
+	}
+	offs = append(offs, valid)
+
+	// the text searched is what's kept of the consumed text, then src
+	base := len(t.ctx)
+	text := append(t.ctx, []rune(s)...)
+	at := func(i int) int { return offs[i-base] }
+
+	mode := regexp2.PartialHard
+	if atEOF {
+		mode = 0
+	}
+	out := t.pending
+	consumed, start := base, base
+	more := valid < len(src)
+	for start <= len(text) {
+		m, err := t.re.FindRunesPartialMatchStartingAt(text, start, mode)
+		if err != nil {
+			return nDst, 0, err
+		}
+		if m == nil {
+			break
+		}
+		if m.Partial() || !atEOF && m.Length == 0 && m.Index == len(text) {
+			// more text decides this one
+			out = append(out, src[at(consumed):at(m.Index)]...)
+			consumed = m.Index
+			more = true
+			break
+		}
+		r, err := t.repl(m)
+		if err != nil {
+			return nDst, 0, err
+		}
+		out = append(out, src[at(consumed):at(m.Index)]...)
+		out = append(out, r...)
+		consumed = m.Index + m.Length
+		start = consumed
+		if m.Length == 0 {
+			start++
+		}
+	}
+	if !more {
+		out = append(out, src[at(consumed):valid]...)
+		consumed = len(text)
+	}
+	nSrc = at(consumed)
+
+	keep := consumed - regexp2.DefaultStreamLookbehind
+	if keep < 0 {
+		keep = 0
+	}
+	t.ctx = append(text[:0], text[keep:consumed]...)
+
+	n := copy(dst[nDst:], out)
+	nDst += n
+	t.pending = append(out[:0], out[n:]...)
+	if len(t.pending) > 0 {
+		return nDst, nSrc, transform.ErrShortDst
+	}
+	if more && !atEOF {
+		return nDst, nSrc, transform.ErrShortSrc
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,99 @@
+package xtransform
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jviksne/regexp2"
+	"golang.org/x/text/transform"
+)
+
+func TestTransformer(t *testing.T) {
+	long := strings.Repeat("colour and flavour, ", 2000)
+	tests := []struct {
+		pattern, replacement, input string
+	}{
+		{`\bcolou?r\b`, "color", "colour, colours and colour"},
+		{`(\w+)@(\w+)\.com`, "$2 at $1", "mail me@example.com or you@test.com"},
+		{`a*`, "-", "baaacaa"},
+		{`(?<=\d)x`, "*", "1x x 2x"},
+		{`é+`, "e", "ééé aé"},
+		{`our\b`, "or", long},
+		{`x`, "y", ""},
+	}
+	for _, tt := range tests {
+		re := regexp2.MustCompile(tt.pattern, 0)
+		want, err := re.Replace(tt.input, tt.replacement, -1, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tr, err := New(re, tt.replacement)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, _, err := transform.String(tr, tt.input)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.pattern, err)
+		}
+		if got != want {
+			t.Errorf("%q String: got %q, want %q", tt.pattern, got, want)
+		}
+
+		b, err := ioutil.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(tt.input)), tr))
+		if err != nil {
+			t.Fatalf("%q: %v", tt.pattern, err)
+		}
+		if string(b) != want {
+			t.Errorf("%q Reader: got %q, want %q", tt.pattern, b, want)
+		}
+
+		var buf bytes.Buffer
+		w := transform.NewWriter(&buf, tr)
+		for i := 0; i < len(tt.input); i += 5 {
+			end := i + 5
+			if end > len(tt.input) {
+				end = len(tt.input)
+			}
+			if _, err := w.Write([]byte(tt.input[i:end])); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != want {
+			t.Errorf("%q Writer: got %q, want %q", tt.pattern, buf.String(), want)
+		}
+	}
+
+	// a small dst has the rest kept for the next call
+	tr, _ := NewFunc(regexp2.MustCompile(`x`, 0), func(m regexp2.Match) string { return "0123456789" })
+	dst := make([]byte, 4)
+	var out []byte
+	src := []byte("axb")
+	for {
+		nDst, nSrc, err := tr.Transform(dst, src, true)
+		out = append(out, dst[:nDst]...)
+		src = src[nSrc:]
+		if err == nil {
+			break
+		}
+		if err != transform.ErrShortDst {
+			t.Fatal(err)
+		}
+	}
+	if string(out) != "a0123456789b" {
+		t.Errorf("got %q", out)
+	}
+
+	if _, err := New(regexp2.MustCompile(`a`, 0), "$`"); err == nil {
+		t.Error("expected an error for $`")
+	}
+	if _, err := New(regexp2.MustCompile(`a`, 0), "$$`"); err != nil {
+		t.Errorf("$$` is a literal: %v", err)
+	}
+}